@@ -0,0 +1,363 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// AgentAction is the model entity for the AgentAction schema.
+type AgentAction struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Unique action identifier
+	ID string `json:"id,omitempty"`
+	// Tamper-evident digest over the record and its parents
+	ProvenanceHash string `json:"provenance_hash,omitempty"`
+	// Timestamp when action was taken
+	Timestamp time.Time `json:"timestamp,omitempty"`
+	// ID of the agent that took the action
+	AgentID string `json:"agent_id,omitempty"`
+	// Type of agent (aria, persona, conductor, etc.)
+	AgentType string `json:"agent_type,omitempty"`
+	// Type of action taken
+	ActionType string `json:"action_type,omitempty"`
+	// Human-readable action name
+	ActionName string `json:"action_name,omitempty"`
+	// Action parameters
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+	// Resource the action targets
+	TargetResource string `json:"target_resource,omitempty"`
+	// Current status of the action
+	Status agentaction.Status `json:"status,omitempty"`
+	// Result of the action
+	Result string `json:"result,omitempty"`
+	// Error message if failed
+	Error string `json:"error,omitempty"`
+	// Execution latency in milliseconds
+	LatencyMs float64 `json:"latency_ms,omitempty"`
+	// Session identifier
+	SessionID string `json:"session_id,omitempty"`
+	// User who initiated the action
+	UserID string `json:"user_id,omitempty"`
+	// Additional metadata
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AgentActionQuery when eager-loading is set.
+	Edges        AgentActionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AgentActionEdges holds the relations/edges for other nodes in the graph.
+type AgentActionEdges struct {
+	// Routing decisions that triggered this action
+	Decisions []*RoutingDecision `json:"decisions,omitempty"`
+	// Workflow executions performed by this action
+	Workflows []*WorkflowExecution `json:"workflows,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+
+	namedDecisions map[string][]*RoutingDecision
+	namedWorkflows map[string][]*WorkflowExecution
+}
+
+// DecisionsOrErr returns the Decisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e AgentActionEdges) DecisionsOrErr() ([]*RoutingDecision, error) {
+	if e.loadedTypes[0] {
+		return e.Decisions, nil
+	}
+	return nil, &NotLoadedError{edge: "decisions"}
+}
+
+// WorkflowsOrErr returns the Workflows value or an error if the edge
+// was not loaded in eager-loading.
+func (e AgentActionEdges) WorkflowsOrErr() ([]*WorkflowExecution, error) {
+	if e.loadedTypes[1] {
+		return e.Workflows, nil
+	}
+	return nil, &NotLoadedError{edge: "workflows"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AgentAction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case agentaction.FieldParameters, agentaction.FieldMetadata:
+			values[i] = new([]byte)
+		case agentaction.FieldLatencyMs:
+			values[i] = new(sql.NullFloat64)
+		case agentaction.FieldID, agentaction.FieldProvenanceHash, agentaction.FieldAgentID, agentaction.FieldAgentType, agentaction.FieldActionType, agentaction.FieldActionName, agentaction.FieldTargetResource, agentaction.FieldStatus, agentaction.FieldResult, agentaction.FieldError, agentaction.FieldSessionID, agentaction.FieldUserID:
+			values[i] = new(sql.NullString)
+		case agentaction.FieldTimestamp:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AgentAction fields.
+func (aa *AgentAction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case agentaction.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				aa.ID = value.String
+			}
+		case agentaction.FieldProvenanceHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provenance_hash", values[i])
+			} else if value.Valid {
+				aa.ProvenanceHash = value.String
+			}
+		case agentaction.FieldTimestamp:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
+			} else if value.Valid {
+				aa.Timestamp = value.Time
+			}
+		case agentaction.FieldAgentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_id", values[i])
+			} else if value.Valid {
+				aa.AgentID = value.String
+			}
+		case agentaction.FieldAgentType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field agent_type", values[i])
+			} else if value.Valid {
+				aa.AgentType = value.String
+			}
+		case agentaction.FieldActionType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action_type", values[i])
+			} else if value.Valid {
+				aa.ActionType = value.String
+			}
+		case agentaction.FieldActionName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action_name", values[i])
+			} else if value.Valid {
+				aa.ActionName = value.String
+			}
+		case agentaction.FieldParameters:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field parameters", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &aa.Parameters); err != nil {
+					return fmt.Errorf("unmarshal field parameters: %w", err)
+				}
+			}
+		case agentaction.FieldTargetResource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_resource", values[i])
+			} else if value.Valid {
+				aa.TargetResource = value.String
+			}
+		case agentaction.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				aa.Status = agentaction.Status(value.String)
+			}
+		case agentaction.FieldResult:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field result", values[i])
+			} else if value.Valid {
+				aa.Result = value.String
+			}
+		case agentaction.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				aa.Error = value.String
+			}
+		case agentaction.FieldLatencyMs:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field latency_ms", values[i])
+			} else if value.Valid {
+				aa.LatencyMs = value.Float64
+			}
+		case agentaction.FieldSessionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field session_id", values[i])
+			} else if value.Valid {
+				aa.SessionID = value.String
+			}
+		case agentaction.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				aa.UserID = value.String
+			}
+		case agentaction.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &aa.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		default:
+			aa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AgentAction.
+// This includes values selected through modifiers, order, etc.
+func (aa *AgentAction) Value(name string) (ent.Value, error) {
+	return aa.selectValues.Get(name)
+}
+
+// QueryDecisions queries the "decisions" edge of the AgentAction entity.
+func (aa *AgentAction) QueryDecisions() *RoutingDecisionQuery {
+	return NewAgentActionClient(aa.config).QueryDecisions(aa)
+}
+
+// QueryWorkflows queries the "workflows" edge of the AgentAction entity.
+func (aa *AgentAction) QueryWorkflows() *WorkflowExecutionQuery {
+	return NewAgentActionClient(aa.config).QueryWorkflows(aa)
+}
+
+// Update returns a builder for updating this AgentAction.
+// Note that you need to call AgentAction.Unwrap() before calling this method if this AgentAction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (aa *AgentAction) Update() *AgentActionUpdateOne {
+	return NewAgentActionClient(aa.config).UpdateOne(aa)
+}
+
+// Unwrap unwraps the AgentAction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (aa *AgentAction) Unwrap() *AgentAction {
+	_tx, ok := aa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AgentAction is not a transactional entity")
+	}
+	aa.config.driver = _tx.drv
+	return aa
+}
+
+// String implements the fmt.Stringer.
+func (aa *AgentAction) String() string {
+	var builder strings.Builder
+	builder.WriteString("AgentAction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", aa.ID))
+	builder.WriteString("provenance_hash=")
+	builder.WriteString(aa.ProvenanceHash)
+	builder.WriteString(", ")
+	builder.WriteString("timestamp=")
+	builder.WriteString(aa.Timestamp.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("agent_id=")
+	builder.WriteString(aa.AgentID)
+	builder.WriteString(", ")
+	builder.WriteString("agent_type=")
+	builder.WriteString(aa.AgentType)
+	builder.WriteString(", ")
+	builder.WriteString("action_type=")
+	builder.WriteString(aa.ActionType)
+	builder.WriteString(", ")
+	builder.WriteString("action_name=")
+	builder.WriteString(aa.ActionName)
+	builder.WriteString(", ")
+	builder.WriteString("parameters=")
+	builder.WriteString(fmt.Sprintf("%v", aa.Parameters))
+	builder.WriteString(", ")
+	builder.WriteString("target_resource=")
+	builder.WriteString(aa.TargetResource)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", aa.Status))
+	builder.WriteString(", ")
+	builder.WriteString("result=")
+	builder.WriteString(aa.Result)
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(aa.Error)
+	builder.WriteString(", ")
+	builder.WriteString("latency_ms=")
+	builder.WriteString(fmt.Sprintf("%v", aa.LatencyMs))
+	builder.WriteString(", ")
+	builder.WriteString("session_id=")
+	builder.WriteString(aa.SessionID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(aa.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", aa.Metadata))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedDecisions returns the Decisions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (aa *AgentAction) NamedDecisions(name string) ([]*RoutingDecision, error) {
+	if aa.Edges.namedDecisions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := aa.Edges.namedDecisions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (aa *AgentAction) appendNamedDecisions(name string, edges ...*RoutingDecision) {
+	if aa.Edges.namedDecisions == nil {
+		aa.Edges.namedDecisions = make(map[string][]*RoutingDecision)
+	}
+	if len(edges) == 0 {
+		aa.Edges.namedDecisions[name] = []*RoutingDecision{}
+	} else {
+		aa.Edges.namedDecisions[name] = append(aa.Edges.namedDecisions[name], edges...)
+	}
+}
+
+// NamedWorkflows returns the Workflows named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (aa *AgentAction) NamedWorkflows(name string) ([]*WorkflowExecution, error) {
+	if aa.Edges.namedWorkflows == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := aa.Edges.namedWorkflows[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (aa *AgentAction) appendNamedWorkflows(name string, edges ...*WorkflowExecution) {
+	if aa.Edges.namedWorkflows == nil {
+		aa.Edges.namedWorkflows = make(map[string][]*WorkflowExecution)
+	}
+	if len(edges) == 0 {
+		aa.Edges.namedWorkflows[name] = []*WorkflowExecution{}
+	} else {
+		aa.Edges.namedWorkflows[name] = append(aa.Edges.namedWorkflows[name], edges...)
+	}
+}
+
+// AgentActions is a parsable slice of AgentAction.
+type AgentActions []*AgentAction
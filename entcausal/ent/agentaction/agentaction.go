@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package agentaction
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the agentaction type in the database.
+	Label = "agent_action"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProvenanceHash holds the string denoting the provenance_hash field in the database.
+	FieldProvenanceHash = "provenance_hash"
+	// FieldTimestamp holds the string denoting the timestamp field in the database.
+	FieldTimestamp = "timestamp"
+	// FieldAgentID holds the string denoting the agent_id field in the database.
+	FieldAgentID = "agent_id"
+	// FieldAgentType holds the string denoting the agent_type field in the database.
+	FieldAgentType = "agent_type"
+	// FieldActionType holds the string denoting the action_type field in the database.
+	FieldActionType = "action_type"
+	// FieldActionName holds the string denoting the action_name field in the database.
+	FieldActionName = "action_name"
+	// FieldParameters holds the string denoting the parameters field in the database.
+	FieldParameters = "parameters"
+	// FieldTargetResource holds the string denoting the target_resource field in the database.
+	FieldTargetResource = "target_resource"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldResult holds the string denoting the result field in the database.
+	FieldResult = "result"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldLatencyMs holds the string denoting the latency_ms field in the database.
+	FieldLatencyMs = "latency_ms"
+	// FieldSessionID holds the string denoting the session_id field in the database.
+	FieldSessionID = "session_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// EdgeDecisions holds the string denoting the decisions edge name in mutations.
+	EdgeDecisions = "decisions"
+	// EdgeWorkflows holds the string denoting the workflows edge name in mutations.
+	EdgeWorkflows = "workflows"
+	// Table holds the table name of the agentaction in the database.
+	Table = "agent_actions"
+	// DecisionsTable is the table that holds the decisions relation/edge. The primary key declared below.
+	DecisionsTable = "routing_decision_actions"
+	// DecisionsInverseTable is the table name for the RoutingDecision entity.
+	// It exists in this package in order to avoid circular dependency with the "routingdecision" package.
+	DecisionsInverseTable = "routing_decisions"
+	// WorkflowsTable is the table that holds the workflows relation/edge. The primary key declared below.
+	WorkflowsTable = "agent_action_workflows"
+	// WorkflowsInverseTable is the table name for the WorkflowExecution entity.
+	// It exists in this package in order to avoid circular dependency with the "workflowexecution" package.
+	WorkflowsInverseTable = "workflow_executions"
+)
+
+// Columns holds all SQL columns for agentaction fields.
+var Columns = []string{
+	FieldID,
+	FieldProvenanceHash,
+	FieldTimestamp,
+	FieldAgentID,
+	FieldAgentType,
+	FieldActionType,
+	FieldActionName,
+	FieldParameters,
+	FieldTargetResource,
+	FieldStatus,
+	FieldResult,
+	FieldError,
+	FieldLatencyMs,
+	FieldSessionID,
+	FieldUserID,
+	FieldMetadata,
+}
+
+var (
+	// DecisionsPrimaryKey and DecisionsColumn2 are the table columns denoting the
+	// primary key for the decisions relation (M2M).
+	DecisionsPrimaryKey = []string{"routing_decision_id", "agent_action_id"}
+	// WorkflowsPrimaryKey and WorkflowsColumn2 are the table columns denoting the
+	// primary key for the workflows relation (M2M).
+	WorkflowsPrimaryKey = []string{"agent_action_id", "workflow_execution_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTimestamp holds the default value on creation for the "timestamp" field.
+	DefaultTimestamp func() time.Time
+	// AgentIDValidator is a validator for the "agent_id" field. It is called by the builders before save.
+	AgentIDValidator func(string) error
+	// AgentTypeValidator is a validator for the "agent_type" field. It is called by the builders before save.
+	AgentTypeValidator func(string) error
+	// ActionTypeValidator is a validator for the "action_type" field. It is called by the builders before save.
+	ActionTypeValidator func(string) error
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "pending"
+	StatusExecuting Status = "executing"
+	StatusCompleted Status = "completed"
+	StatusFailed    Status = "failed"
+	StatusCancelled Status = "cancelled"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusExecuting, StatusCompleted, StatusFailed, StatusCancelled:
+		return nil
+	default:
+		return fmt.Errorf("agentaction: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the AgentAction queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProvenanceHash orders the results by the provenance_hash field.
+func ByProvenanceHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvenanceHash, opts...).ToFunc()
+}
+
+// ByTimestamp orders the results by the timestamp field.
+func ByTimestamp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimestamp, opts...).ToFunc()
+}
+
+// ByAgentID orders the results by the agent_id field.
+func ByAgentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgentID, opts...).ToFunc()
+}
+
+// ByAgentType orders the results by the agent_type field.
+func ByAgentType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAgentType, opts...).ToFunc()
+}
+
+// ByActionType orders the results by the action_type field.
+func ByActionType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActionType, opts...).ToFunc()
+}
+
+// ByActionName orders the results by the action_name field.
+func ByActionName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActionName, opts...).ToFunc()
+}
+
+// ByTargetResource orders the results by the target_resource field.
+func ByTargetResource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetResource, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByResult orders the results by the result field.
+func ByResult(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResult, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByLatencyMs orders the results by the latency_ms field.
+func ByLatencyMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatencyMs, opts...).ToFunc()
+}
+
+// BySessionID orders the results by the session_id field.
+func BySessionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByDecisionsCount orders the results by decisions count.
+func ByDecisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDecisionsStep(), opts...)
+	}
+}
+
+// ByDecisions orders the results by decisions terms.
+func ByDecisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDecisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWorkflowsCount orders the results by workflows count.
+func ByWorkflowsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWorkflowsStep(), opts...)
+	}
+}
+
+// ByWorkflows orders the results by workflows terms.
+func ByWorkflows(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWorkflowsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newDecisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DecisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, DecisionsTable, DecisionsPrimaryKey...),
+	)
+}
+func newWorkflowsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WorkflowsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, WorkflowsTable, WorkflowsPrimaryKey...),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Status) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Status) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Status(str)
+	if err := StatusValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Status", str)
+	}
+	return nil
+}
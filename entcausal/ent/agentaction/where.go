@@ -0,0 +1,1037 @@
+// Code generated by ent, DO NOT EDIT.
+
+package agentaction
+
+import (
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldContainsFold(FieldID, id))
+}
+
+// ProvenanceHash applies equality check predicate on the "provenance_hash" field. It's identical to ProvenanceHashEQ.
+func ProvenanceHash(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldProvenanceHash, v))
+}
+
+// Timestamp applies equality check predicate on the "timestamp" field. It's identical to TimestampEQ.
+func Timestamp(v time.Time) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// AgentID applies equality check predicate on the "agent_id" field. It's identical to AgentIDEQ.
+func AgentID(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldAgentID, v))
+}
+
+// AgentType applies equality check predicate on the "agent_type" field. It's identical to AgentTypeEQ.
+func AgentType(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldAgentType, v))
+}
+
+// ActionType applies equality check predicate on the "action_type" field. It's identical to ActionTypeEQ.
+func ActionType(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldActionType, v))
+}
+
+// ActionName applies equality check predicate on the "action_name" field. It's identical to ActionNameEQ.
+func ActionName(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldActionName, v))
+}
+
+// TargetResource applies equality check predicate on the "target_resource" field. It's identical to TargetResourceEQ.
+func TargetResource(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldTargetResource, v))
+}
+
+// Result applies equality check predicate on the "result" field. It's identical to ResultEQ.
+func Result(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldResult, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldError, v))
+}
+
+// LatencyMs applies equality check predicate on the "latency_ms" field. It's identical to LatencyMsEQ.
+func LatencyMs(v float64) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldLatencyMs, v))
+}
+
+// SessionID applies equality check predicate on the "session_id" field. It's identical to SessionIDEQ.
+func SessionID(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldSessionID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldUserID, v))
+}
+
+// ProvenanceHashEQ applies the EQ predicate on the "provenance_hash" field.
+func ProvenanceHashEQ(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashNEQ applies the NEQ predicate on the "provenance_hash" field.
+func ProvenanceHashNEQ(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNEQ(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashIn applies the In predicate on the "provenance_hash" field.
+func ProvenanceHashIn(vs ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIn(FieldProvenanceHash, vs...))
+}
+
+// ProvenanceHashNotIn applies the NotIn predicate on the "provenance_hash" field.
+func ProvenanceHashNotIn(vs ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotIn(FieldProvenanceHash, vs...))
+}
+
+// ProvenanceHashGT applies the GT predicate on the "provenance_hash" field.
+func ProvenanceHashGT(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGT(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashGTE applies the GTE predicate on the "provenance_hash" field.
+func ProvenanceHashGTE(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGTE(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashLT applies the LT predicate on the "provenance_hash" field.
+func ProvenanceHashLT(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLT(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashLTE applies the LTE predicate on the "provenance_hash" field.
+func ProvenanceHashLTE(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLTE(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashContains applies the Contains predicate on the "provenance_hash" field.
+func ProvenanceHashContains(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldContains(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashHasPrefix applies the HasPrefix predicate on the "provenance_hash" field.
+func ProvenanceHashHasPrefix(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldHasPrefix(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashHasSuffix applies the HasSuffix predicate on the "provenance_hash" field.
+func ProvenanceHashHasSuffix(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldHasSuffix(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashIsNil applies the IsNil predicate on the "provenance_hash" field.
+func ProvenanceHashIsNil() predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIsNull(FieldProvenanceHash))
+}
+
+// ProvenanceHashNotNil applies the NotNil predicate on the "provenance_hash" field.
+func ProvenanceHashNotNil() predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotNull(FieldProvenanceHash))
+}
+
+// ProvenanceHashEqualFold applies the EqualFold predicate on the "provenance_hash" field.
+func ProvenanceHashEqualFold(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEqualFold(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashContainsFold applies the ContainsFold predicate on the "provenance_hash" field.
+func ProvenanceHashContainsFold(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldContainsFold(FieldProvenanceHash, v))
+}
+
+// TimestampEQ applies the EQ predicate on the "timestamp" field.
+func TimestampEQ(v time.Time) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// TimestampNEQ applies the NEQ predicate on the "timestamp" field.
+func TimestampNEQ(v time.Time) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNEQ(FieldTimestamp, v))
+}
+
+// TimestampIn applies the In predicate on the "timestamp" field.
+func TimestampIn(vs ...time.Time) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIn(FieldTimestamp, vs...))
+}
+
+// TimestampNotIn applies the NotIn predicate on the "timestamp" field.
+func TimestampNotIn(vs ...time.Time) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotIn(FieldTimestamp, vs...))
+}
+
+// TimestampGT applies the GT predicate on the "timestamp" field.
+func TimestampGT(v time.Time) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGT(FieldTimestamp, v))
+}
+
+// TimestampGTE applies the GTE predicate on the "timestamp" field.
+func TimestampGTE(v time.Time) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGTE(FieldTimestamp, v))
+}
+
+// TimestampLT applies the LT predicate on the "timestamp" field.
+func TimestampLT(v time.Time) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLT(FieldTimestamp, v))
+}
+
+// TimestampLTE applies the LTE predicate on the "timestamp" field.
+func TimestampLTE(v time.Time) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLTE(FieldTimestamp, v))
+}
+
+// AgentIDEQ applies the EQ predicate on the "agent_id" field.
+func AgentIDEQ(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldAgentID, v))
+}
+
+// AgentIDNEQ applies the NEQ predicate on the "agent_id" field.
+func AgentIDNEQ(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNEQ(FieldAgentID, v))
+}
+
+// AgentIDIn applies the In predicate on the "agent_id" field.
+func AgentIDIn(vs ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIn(FieldAgentID, vs...))
+}
+
+// AgentIDNotIn applies the NotIn predicate on the "agent_id" field.
+func AgentIDNotIn(vs ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotIn(FieldAgentID, vs...))
+}
+
+// AgentIDGT applies the GT predicate on the "agent_id" field.
+func AgentIDGT(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGT(FieldAgentID, v))
+}
+
+// AgentIDGTE applies the GTE predicate on the "agent_id" field.
+func AgentIDGTE(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGTE(FieldAgentID, v))
+}
+
+// AgentIDLT applies the LT predicate on the "agent_id" field.
+func AgentIDLT(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLT(FieldAgentID, v))
+}
+
+// AgentIDLTE applies the LTE predicate on the "agent_id" field.
+func AgentIDLTE(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLTE(FieldAgentID, v))
+}
+
+// AgentIDContains applies the Contains predicate on the "agent_id" field.
+func AgentIDContains(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldContains(FieldAgentID, v))
+}
+
+// AgentIDHasPrefix applies the HasPrefix predicate on the "agent_id" field.
+func AgentIDHasPrefix(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldHasPrefix(FieldAgentID, v))
+}
+
+// AgentIDHasSuffix applies the HasSuffix predicate on the "agent_id" field.
+func AgentIDHasSuffix(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldHasSuffix(FieldAgentID, v))
+}
+
+// AgentIDEqualFold applies the EqualFold predicate on the "agent_id" field.
+func AgentIDEqualFold(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEqualFold(FieldAgentID, v))
+}
+
+// AgentIDContainsFold applies the ContainsFold predicate on the "agent_id" field.
+func AgentIDContainsFold(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldContainsFold(FieldAgentID, v))
+}
+
+// AgentTypeEQ applies the EQ predicate on the "agent_type" field.
+func AgentTypeEQ(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldAgentType, v))
+}
+
+// AgentTypeNEQ applies the NEQ predicate on the "agent_type" field.
+func AgentTypeNEQ(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNEQ(FieldAgentType, v))
+}
+
+// AgentTypeIn applies the In predicate on the "agent_type" field.
+func AgentTypeIn(vs ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIn(FieldAgentType, vs...))
+}
+
+// AgentTypeNotIn applies the NotIn predicate on the "agent_type" field.
+func AgentTypeNotIn(vs ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotIn(FieldAgentType, vs...))
+}
+
+// AgentTypeGT applies the GT predicate on the "agent_type" field.
+func AgentTypeGT(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGT(FieldAgentType, v))
+}
+
+// AgentTypeGTE applies the GTE predicate on the "agent_type" field.
+func AgentTypeGTE(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGTE(FieldAgentType, v))
+}
+
+// AgentTypeLT applies the LT predicate on the "agent_type" field.
+func AgentTypeLT(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLT(FieldAgentType, v))
+}
+
+// AgentTypeLTE applies the LTE predicate on the "agent_type" field.
+func AgentTypeLTE(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLTE(FieldAgentType, v))
+}
+
+// AgentTypeContains applies the Contains predicate on the "agent_type" field.
+func AgentTypeContains(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldContains(FieldAgentType, v))
+}
+
+// AgentTypeHasPrefix applies the HasPrefix predicate on the "agent_type" field.
+func AgentTypeHasPrefix(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldHasPrefix(FieldAgentType, v))
+}
+
+// AgentTypeHasSuffix applies the HasSuffix predicate on the "agent_type" field.
+func AgentTypeHasSuffix(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldHasSuffix(FieldAgentType, v))
+}
+
+// AgentTypeEqualFold applies the EqualFold predicate on the "agent_type" field.
+func AgentTypeEqualFold(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEqualFold(FieldAgentType, v))
+}
+
+// AgentTypeContainsFold applies the ContainsFold predicate on the "agent_type" field.
+func AgentTypeContainsFold(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldContainsFold(FieldAgentType, v))
+}
+
+// ActionTypeEQ applies the EQ predicate on the "action_type" field.
+func ActionTypeEQ(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldActionType, v))
+}
+
+// ActionTypeNEQ applies the NEQ predicate on the "action_type" field.
+func ActionTypeNEQ(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNEQ(FieldActionType, v))
+}
+
+// ActionTypeIn applies the In predicate on the "action_type" field.
+func ActionTypeIn(vs ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIn(FieldActionType, vs...))
+}
+
+// ActionTypeNotIn applies the NotIn predicate on the "action_type" field.
+func ActionTypeNotIn(vs ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotIn(FieldActionType, vs...))
+}
+
+// ActionTypeGT applies the GT predicate on the "action_type" field.
+func ActionTypeGT(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGT(FieldActionType, v))
+}
+
+// ActionTypeGTE applies the GTE predicate on the "action_type" field.
+func ActionTypeGTE(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGTE(FieldActionType, v))
+}
+
+// ActionTypeLT applies the LT predicate on the "action_type" field.
+func ActionTypeLT(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLT(FieldActionType, v))
+}
+
+// ActionTypeLTE applies the LTE predicate on the "action_type" field.
+func ActionTypeLTE(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLTE(FieldActionType, v))
+}
+
+// ActionTypeContains applies the Contains predicate on the "action_type" field.
+func ActionTypeContains(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldContains(FieldActionType, v))
+}
+
+// ActionTypeHasPrefix applies the HasPrefix predicate on the "action_type" field.
+func ActionTypeHasPrefix(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldHasPrefix(FieldActionType, v))
+}
+
+// ActionTypeHasSuffix applies the HasSuffix predicate on the "action_type" field.
+func ActionTypeHasSuffix(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldHasSuffix(FieldActionType, v))
+}
+
+// ActionTypeEqualFold applies the EqualFold predicate on the "action_type" field.
+func ActionTypeEqualFold(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEqualFold(FieldActionType, v))
+}
+
+// ActionTypeContainsFold applies the ContainsFold predicate on the "action_type" field.
+func ActionTypeContainsFold(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldContainsFold(FieldActionType, v))
+}
+
+// ActionNameEQ applies the EQ predicate on the "action_name" field.
+func ActionNameEQ(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldActionName, v))
+}
+
+// ActionNameNEQ applies the NEQ predicate on the "action_name" field.
+func ActionNameNEQ(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNEQ(FieldActionName, v))
+}
+
+// ActionNameIn applies the In predicate on the "action_name" field.
+func ActionNameIn(vs ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIn(FieldActionName, vs...))
+}
+
+// ActionNameNotIn applies the NotIn predicate on the "action_name" field.
+func ActionNameNotIn(vs ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotIn(FieldActionName, vs...))
+}
+
+// ActionNameGT applies the GT predicate on the "action_name" field.
+func ActionNameGT(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGT(FieldActionName, v))
+}
+
+// ActionNameGTE applies the GTE predicate on the "action_name" field.
+func ActionNameGTE(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGTE(FieldActionName, v))
+}
+
+// ActionNameLT applies the LT predicate on the "action_name" field.
+func ActionNameLT(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLT(FieldActionName, v))
+}
+
+// ActionNameLTE applies the LTE predicate on the "action_name" field.
+func ActionNameLTE(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLTE(FieldActionName, v))
+}
+
+// ActionNameContains applies the Contains predicate on the "action_name" field.
+func ActionNameContains(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldContains(FieldActionName, v))
+}
+
+// ActionNameHasPrefix applies the HasPrefix predicate on the "action_name" field.
+func ActionNameHasPrefix(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldHasPrefix(FieldActionName, v))
+}
+
+// ActionNameHasSuffix applies the HasSuffix predicate on the "action_name" field.
+func ActionNameHasSuffix(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldHasSuffix(FieldActionName, v))
+}
+
+// ActionNameIsNil applies the IsNil predicate on the "action_name" field.
+func ActionNameIsNil() predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIsNull(FieldActionName))
+}
+
+// ActionNameNotNil applies the NotNil predicate on the "action_name" field.
+func ActionNameNotNil() predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotNull(FieldActionName))
+}
+
+// ActionNameEqualFold applies the EqualFold predicate on the "action_name" field.
+func ActionNameEqualFold(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEqualFold(FieldActionName, v))
+}
+
+// ActionNameContainsFold applies the ContainsFold predicate on the "action_name" field.
+func ActionNameContainsFold(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldContainsFold(FieldActionName, v))
+}
+
+// ParametersIsNil applies the IsNil predicate on the "parameters" field.
+func ParametersIsNil() predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIsNull(FieldParameters))
+}
+
+// ParametersNotNil applies the NotNil predicate on the "parameters" field.
+func ParametersNotNil() predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotNull(FieldParameters))
+}
+
+// TargetResourceEQ applies the EQ predicate on the "target_resource" field.
+func TargetResourceEQ(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldTargetResource, v))
+}
+
+// TargetResourceNEQ applies the NEQ predicate on the "target_resource" field.
+func TargetResourceNEQ(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNEQ(FieldTargetResource, v))
+}
+
+// TargetResourceIn applies the In predicate on the "target_resource" field.
+func TargetResourceIn(vs ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIn(FieldTargetResource, vs...))
+}
+
+// TargetResourceNotIn applies the NotIn predicate on the "target_resource" field.
+func TargetResourceNotIn(vs ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotIn(FieldTargetResource, vs...))
+}
+
+// TargetResourceGT applies the GT predicate on the "target_resource" field.
+func TargetResourceGT(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGT(FieldTargetResource, v))
+}
+
+// TargetResourceGTE applies the GTE predicate on the "target_resource" field.
+func TargetResourceGTE(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGTE(FieldTargetResource, v))
+}
+
+// TargetResourceLT applies the LT predicate on the "target_resource" field.
+func TargetResourceLT(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLT(FieldTargetResource, v))
+}
+
+// TargetResourceLTE applies the LTE predicate on the "target_resource" field.
+func TargetResourceLTE(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLTE(FieldTargetResource, v))
+}
+
+// TargetResourceContains applies the Contains predicate on the "target_resource" field.
+func TargetResourceContains(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldContains(FieldTargetResource, v))
+}
+
+// TargetResourceHasPrefix applies the HasPrefix predicate on the "target_resource" field.
+func TargetResourceHasPrefix(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldHasPrefix(FieldTargetResource, v))
+}
+
+// TargetResourceHasSuffix applies the HasSuffix predicate on the "target_resource" field.
+func TargetResourceHasSuffix(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldHasSuffix(FieldTargetResource, v))
+}
+
+// TargetResourceIsNil applies the IsNil predicate on the "target_resource" field.
+func TargetResourceIsNil() predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIsNull(FieldTargetResource))
+}
+
+// TargetResourceNotNil applies the NotNil predicate on the "target_resource" field.
+func TargetResourceNotNil() predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotNull(FieldTargetResource))
+}
+
+// TargetResourceEqualFold applies the EqualFold predicate on the "target_resource" field.
+func TargetResourceEqualFold(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEqualFold(FieldTargetResource, v))
+}
+
+// TargetResourceContainsFold applies the ContainsFold predicate on the "target_resource" field.
+func TargetResourceContainsFold(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldContainsFold(FieldTargetResource, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ResultEQ applies the EQ predicate on the "result" field.
+func ResultEQ(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldResult, v))
+}
+
+// ResultNEQ applies the NEQ predicate on the "result" field.
+func ResultNEQ(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNEQ(FieldResult, v))
+}
+
+// ResultIn applies the In predicate on the "result" field.
+func ResultIn(vs ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIn(FieldResult, vs...))
+}
+
+// ResultNotIn applies the NotIn predicate on the "result" field.
+func ResultNotIn(vs ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotIn(FieldResult, vs...))
+}
+
+// ResultGT applies the GT predicate on the "result" field.
+func ResultGT(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGT(FieldResult, v))
+}
+
+// ResultGTE applies the GTE predicate on the "result" field.
+func ResultGTE(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGTE(FieldResult, v))
+}
+
+// ResultLT applies the LT predicate on the "result" field.
+func ResultLT(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLT(FieldResult, v))
+}
+
+// ResultLTE applies the LTE predicate on the "result" field.
+func ResultLTE(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLTE(FieldResult, v))
+}
+
+// ResultContains applies the Contains predicate on the "result" field.
+func ResultContains(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldContains(FieldResult, v))
+}
+
+// ResultHasPrefix applies the HasPrefix predicate on the "result" field.
+func ResultHasPrefix(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldHasPrefix(FieldResult, v))
+}
+
+// ResultHasSuffix applies the HasSuffix predicate on the "result" field.
+func ResultHasSuffix(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldHasSuffix(FieldResult, v))
+}
+
+// ResultIsNil applies the IsNil predicate on the "result" field.
+func ResultIsNil() predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIsNull(FieldResult))
+}
+
+// ResultNotNil applies the NotNil predicate on the "result" field.
+func ResultNotNil() predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotNull(FieldResult))
+}
+
+// ResultEqualFold applies the EqualFold predicate on the "result" field.
+func ResultEqualFold(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEqualFold(FieldResult, v))
+}
+
+// ResultContainsFold applies the ContainsFold predicate on the "result" field.
+func ResultContainsFold(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldContainsFold(FieldResult, v))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldContainsFold(FieldError, v))
+}
+
+// LatencyMsEQ applies the EQ predicate on the "latency_ms" field.
+func LatencyMsEQ(v float64) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldLatencyMs, v))
+}
+
+// LatencyMsNEQ applies the NEQ predicate on the "latency_ms" field.
+func LatencyMsNEQ(v float64) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNEQ(FieldLatencyMs, v))
+}
+
+// LatencyMsIn applies the In predicate on the "latency_ms" field.
+func LatencyMsIn(vs ...float64) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIn(FieldLatencyMs, vs...))
+}
+
+// LatencyMsNotIn applies the NotIn predicate on the "latency_ms" field.
+func LatencyMsNotIn(vs ...float64) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotIn(FieldLatencyMs, vs...))
+}
+
+// LatencyMsGT applies the GT predicate on the "latency_ms" field.
+func LatencyMsGT(v float64) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGT(FieldLatencyMs, v))
+}
+
+// LatencyMsGTE applies the GTE predicate on the "latency_ms" field.
+func LatencyMsGTE(v float64) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGTE(FieldLatencyMs, v))
+}
+
+// LatencyMsLT applies the LT predicate on the "latency_ms" field.
+func LatencyMsLT(v float64) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLT(FieldLatencyMs, v))
+}
+
+// LatencyMsLTE applies the LTE predicate on the "latency_ms" field.
+func LatencyMsLTE(v float64) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLTE(FieldLatencyMs, v))
+}
+
+// LatencyMsIsNil applies the IsNil predicate on the "latency_ms" field.
+func LatencyMsIsNil() predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIsNull(FieldLatencyMs))
+}
+
+// LatencyMsNotNil applies the NotNil predicate on the "latency_ms" field.
+func LatencyMsNotNil() predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotNull(FieldLatencyMs))
+}
+
+// SessionIDEQ applies the EQ predicate on the "session_id" field.
+func SessionIDEQ(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldSessionID, v))
+}
+
+// SessionIDNEQ applies the NEQ predicate on the "session_id" field.
+func SessionIDNEQ(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNEQ(FieldSessionID, v))
+}
+
+// SessionIDIn applies the In predicate on the "session_id" field.
+func SessionIDIn(vs ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIn(FieldSessionID, vs...))
+}
+
+// SessionIDNotIn applies the NotIn predicate on the "session_id" field.
+func SessionIDNotIn(vs ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotIn(FieldSessionID, vs...))
+}
+
+// SessionIDGT applies the GT predicate on the "session_id" field.
+func SessionIDGT(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGT(FieldSessionID, v))
+}
+
+// SessionIDGTE applies the GTE predicate on the "session_id" field.
+func SessionIDGTE(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGTE(FieldSessionID, v))
+}
+
+// SessionIDLT applies the LT predicate on the "session_id" field.
+func SessionIDLT(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLT(FieldSessionID, v))
+}
+
+// SessionIDLTE applies the LTE predicate on the "session_id" field.
+func SessionIDLTE(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLTE(FieldSessionID, v))
+}
+
+// SessionIDContains applies the Contains predicate on the "session_id" field.
+func SessionIDContains(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldContains(FieldSessionID, v))
+}
+
+// SessionIDHasPrefix applies the HasPrefix predicate on the "session_id" field.
+func SessionIDHasPrefix(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldHasPrefix(FieldSessionID, v))
+}
+
+// SessionIDHasSuffix applies the HasSuffix predicate on the "session_id" field.
+func SessionIDHasSuffix(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldHasSuffix(FieldSessionID, v))
+}
+
+// SessionIDIsNil applies the IsNil predicate on the "session_id" field.
+func SessionIDIsNil() predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIsNull(FieldSessionID))
+}
+
+// SessionIDNotNil applies the NotNil predicate on the "session_id" field.
+func SessionIDNotNil() predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotNull(FieldSessionID))
+}
+
+// SessionIDEqualFold applies the EqualFold predicate on the "session_id" field.
+func SessionIDEqualFold(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEqualFold(FieldSessionID, v))
+}
+
+// SessionIDContainsFold applies the ContainsFold predicate on the "session_id" field.
+func SessionIDContainsFold(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldContainsFold(FieldSessionID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotNull(FieldUserID))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.AgentAction {
+	return predicate.AgentAction(sql.FieldNotNull(FieldMetadata))
+}
+
+// HasDecisions applies the HasEdge predicate on the "decisions" edge.
+func HasDecisions() predicate.AgentAction {
+	return predicate.AgentAction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, DecisionsTable, DecisionsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDecisionsWith applies the HasEdge predicate on the "decisions" edge with a given conditions (other predicates).
+func HasDecisionsWith(preds ...predicate.RoutingDecision) predicate.AgentAction {
+	return predicate.AgentAction(func(s *sql.Selector) {
+		step := newDecisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWorkflows applies the HasEdge predicate on the "workflows" edge.
+func HasWorkflows() predicate.AgentAction {
+	return predicate.AgentAction(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, WorkflowsTable, WorkflowsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkflowsWith applies the HasEdge predicate on the "workflows" edge with a given conditions (other predicates).
+func HasWorkflowsWith(preds ...predicate.WorkflowExecution) predicate.AgentAction {
+	return predicate.AgentAction(func(s *sql.Selector) {
+		step := newWorkflowsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AgentAction) predicate.AgentAction {
+	return predicate.AgentAction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AgentAction) predicate.AgentAction {
+	return predicate.AgentAction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AgentAction) predicate.AgentAction {
+	return predicate.AgentAction(sql.NotPredicates(p))
+}
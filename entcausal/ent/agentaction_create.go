@@ -0,0 +1,525 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentActionCreate is the builder for creating a AgentAction entity.
+type AgentActionCreate struct {
+	config
+	mutation *AgentActionMutation
+	hooks    []Hook
+}
+
+// SetProvenanceHash sets the "provenance_hash" field.
+func (aac *AgentActionCreate) SetProvenanceHash(s string) *AgentActionCreate {
+	aac.mutation.SetProvenanceHash(s)
+	return aac
+}
+
+// SetNillableProvenanceHash sets the "provenance_hash" field if the given value is not nil.
+func (aac *AgentActionCreate) SetNillableProvenanceHash(s *string) *AgentActionCreate {
+	if s != nil {
+		aac.SetProvenanceHash(*s)
+	}
+	return aac
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (aac *AgentActionCreate) SetTimestamp(t time.Time) *AgentActionCreate {
+	aac.mutation.SetTimestamp(t)
+	return aac
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (aac *AgentActionCreate) SetNillableTimestamp(t *time.Time) *AgentActionCreate {
+	if t != nil {
+		aac.SetTimestamp(*t)
+	}
+	return aac
+}
+
+// SetAgentID sets the "agent_id" field.
+func (aac *AgentActionCreate) SetAgentID(s string) *AgentActionCreate {
+	aac.mutation.SetAgentID(s)
+	return aac
+}
+
+// SetAgentType sets the "agent_type" field.
+func (aac *AgentActionCreate) SetAgentType(s string) *AgentActionCreate {
+	aac.mutation.SetAgentType(s)
+	return aac
+}
+
+// SetActionType sets the "action_type" field.
+func (aac *AgentActionCreate) SetActionType(s string) *AgentActionCreate {
+	aac.mutation.SetActionType(s)
+	return aac
+}
+
+// SetActionName sets the "action_name" field.
+func (aac *AgentActionCreate) SetActionName(s string) *AgentActionCreate {
+	aac.mutation.SetActionName(s)
+	return aac
+}
+
+// SetNillableActionName sets the "action_name" field if the given value is not nil.
+func (aac *AgentActionCreate) SetNillableActionName(s *string) *AgentActionCreate {
+	if s != nil {
+		aac.SetActionName(*s)
+	}
+	return aac
+}
+
+// SetParameters sets the "parameters" field.
+func (aac *AgentActionCreate) SetParameters(m map[string]interface{}) *AgentActionCreate {
+	aac.mutation.SetParameters(m)
+	return aac
+}
+
+// SetTargetResource sets the "target_resource" field.
+func (aac *AgentActionCreate) SetTargetResource(s string) *AgentActionCreate {
+	aac.mutation.SetTargetResource(s)
+	return aac
+}
+
+// SetNillableTargetResource sets the "target_resource" field if the given value is not nil.
+func (aac *AgentActionCreate) SetNillableTargetResource(s *string) *AgentActionCreate {
+	if s != nil {
+		aac.SetTargetResource(*s)
+	}
+	return aac
+}
+
+// SetStatus sets the "status" field.
+func (aac *AgentActionCreate) SetStatus(a agentaction.Status) *AgentActionCreate {
+	aac.mutation.SetStatus(a)
+	return aac
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (aac *AgentActionCreate) SetNillableStatus(a *agentaction.Status) *AgentActionCreate {
+	if a != nil {
+		aac.SetStatus(*a)
+	}
+	return aac
+}
+
+// SetResult sets the "result" field.
+func (aac *AgentActionCreate) SetResult(s string) *AgentActionCreate {
+	aac.mutation.SetResult(s)
+	return aac
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (aac *AgentActionCreate) SetNillableResult(s *string) *AgentActionCreate {
+	if s != nil {
+		aac.SetResult(*s)
+	}
+	return aac
+}
+
+// SetError sets the "error" field.
+func (aac *AgentActionCreate) SetError(s string) *AgentActionCreate {
+	aac.mutation.SetError(s)
+	return aac
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (aac *AgentActionCreate) SetNillableError(s *string) *AgentActionCreate {
+	if s != nil {
+		aac.SetError(*s)
+	}
+	return aac
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (aac *AgentActionCreate) SetLatencyMs(f float64) *AgentActionCreate {
+	aac.mutation.SetLatencyMs(f)
+	return aac
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (aac *AgentActionCreate) SetNillableLatencyMs(f *float64) *AgentActionCreate {
+	if f != nil {
+		aac.SetLatencyMs(*f)
+	}
+	return aac
+}
+
+// SetSessionID sets the "session_id" field.
+func (aac *AgentActionCreate) SetSessionID(s string) *AgentActionCreate {
+	aac.mutation.SetSessionID(s)
+	return aac
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (aac *AgentActionCreate) SetNillableSessionID(s *string) *AgentActionCreate {
+	if s != nil {
+		aac.SetSessionID(*s)
+	}
+	return aac
+}
+
+// SetUserID sets the "user_id" field.
+func (aac *AgentActionCreate) SetUserID(s string) *AgentActionCreate {
+	aac.mutation.SetUserID(s)
+	return aac
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (aac *AgentActionCreate) SetNillableUserID(s *string) *AgentActionCreate {
+	if s != nil {
+		aac.SetUserID(*s)
+	}
+	return aac
+}
+
+// SetMetadata sets the "metadata" field.
+func (aac *AgentActionCreate) SetMetadata(m map[string]interface{}) *AgentActionCreate {
+	aac.mutation.SetMetadata(m)
+	return aac
+}
+
+// SetID sets the "id" field.
+func (aac *AgentActionCreate) SetID(s string) *AgentActionCreate {
+	aac.mutation.SetID(s)
+	return aac
+}
+
+// AddDecisionIDs adds the "decisions" edge to the RoutingDecision entity by IDs.
+func (aac *AgentActionCreate) AddDecisionIDs(ids ...string) *AgentActionCreate {
+	aac.mutation.AddDecisionIDs(ids...)
+	return aac
+}
+
+// AddDecisions adds the "decisions" edges to the RoutingDecision entity.
+func (aac *AgentActionCreate) AddDecisions(r ...*RoutingDecision) *AgentActionCreate {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return aac.AddDecisionIDs(ids...)
+}
+
+// AddWorkflowIDs adds the "workflows" edge to the WorkflowExecution entity by IDs.
+func (aac *AgentActionCreate) AddWorkflowIDs(ids ...string) *AgentActionCreate {
+	aac.mutation.AddWorkflowIDs(ids...)
+	return aac
+}
+
+// AddWorkflows adds the "workflows" edges to the WorkflowExecution entity.
+func (aac *AgentActionCreate) AddWorkflows(w ...*WorkflowExecution) *AgentActionCreate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return aac.AddWorkflowIDs(ids...)
+}
+
+// Mutation returns the AgentActionMutation object of the builder.
+func (aac *AgentActionCreate) Mutation() *AgentActionMutation {
+	return aac.mutation
+}
+
+// Save creates the AgentAction in the database.
+func (aac *AgentActionCreate) Save(ctx context.Context) (*AgentAction, error) {
+	aac.defaults()
+	return withHooks(ctx, aac.sqlSave, aac.mutation, aac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aac *AgentActionCreate) SaveX(ctx context.Context) *AgentAction {
+	v, err := aac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aac *AgentActionCreate) Exec(ctx context.Context) error {
+	_, err := aac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aac *AgentActionCreate) ExecX(ctx context.Context) {
+	if err := aac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aac *AgentActionCreate) defaults() {
+	if _, ok := aac.mutation.Timestamp(); !ok {
+		v := agentaction.DefaultTimestamp()
+		aac.mutation.SetTimestamp(v)
+	}
+	if _, ok := aac.mutation.Status(); !ok {
+		v := agentaction.DefaultStatus
+		aac.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aac *AgentActionCreate) check() error {
+	if _, ok := aac.mutation.Timestamp(); !ok {
+		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "AgentAction.timestamp"`)}
+	}
+	if _, ok := aac.mutation.AgentID(); !ok {
+		return &ValidationError{Name: "agent_id", err: errors.New(`ent: missing required field "AgentAction.agent_id"`)}
+	}
+	if v, ok := aac.mutation.AgentID(); ok {
+		if err := agentaction.AgentIDValidator(v); err != nil {
+			return &ValidationError{Name: "agent_id", err: fmt.Errorf(`ent: validator failed for field "AgentAction.agent_id": %w`, err)}
+		}
+	}
+	if _, ok := aac.mutation.AgentType(); !ok {
+		return &ValidationError{Name: "agent_type", err: errors.New(`ent: missing required field "AgentAction.agent_type"`)}
+	}
+	if v, ok := aac.mutation.AgentType(); ok {
+		if err := agentaction.AgentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "agent_type", err: fmt.Errorf(`ent: validator failed for field "AgentAction.agent_type": %w`, err)}
+		}
+	}
+	if _, ok := aac.mutation.ActionType(); !ok {
+		return &ValidationError{Name: "action_type", err: errors.New(`ent: missing required field "AgentAction.action_type"`)}
+	}
+	if v, ok := aac.mutation.ActionType(); ok {
+		if err := agentaction.ActionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "action_type", err: fmt.Errorf(`ent: validator failed for field "AgentAction.action_type": %w`, err)}
+		}
+	}
+	if _, ok := aac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "AgentAction.status"`)}
+	}
+	if v, ok := aac.mutation.Status(); ok {
+		if err := agentaction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AgentAction.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aac *AgentActionCreate) sqlSave(ctx context.Context) (*AgentAction, error) {
+	if err := aac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := aac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected AgentAction.ID type: %T", _spec.ID.Value)
+		}
+	}
+	aac.mutation.id = &_node.ID
+	aac.mutation.done = true
+	return _node, nil
+}
+
+func (aac *AgentActionCreate) createSpec() (*AgentAction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AgentAction{config: aac.config}
+		_spec = sqlgraph.NewCreateSpec(agentaction.Table, sqlgraph.NewFieldSpec(agentaction.FieldID, field.TypeString))
+	)
+	if id, ok := aac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := aac.mutation.ProvenanceHash(); ok {
+		_spec.SetField(agentaction.FieldProvenanceHash, field.TypeString, value)
+		_node.ProvenanceHash = value
+	}
+	if value, ok := aac.mutation.Timestamp(); ok {
+		_spec.SetField(agentaction.FieldTimestamp, field.TypeTime, value)
+		_node.Timestamp = value
+	}
+	if value, ok := aac.mutation.AgentID(); ok {
+		_spec.SetField(agentaction.FieldAgentID, field.TypeString, value)
+		_node.AgentID = value
+	}
+	if value, ok := aac.mutation.AgentType(); ok {
+		_spec.SetField(agentaction.FieldAgentType, field.TypeString, value)
+		_node.AgentType = value
+	}
+	if value, ok := aac.mutation.ActionType(); ok {
+		_spec.SetField(agentaction.FieldActionType, field.TypeString, value)
+		_node.ActionType = value
+	}
+	if value, ok := aac.mutation.ActionName(); ok {
+		_spec.SetField(agentaction.FieldActionName, field.TypeString, value)
+		_node.ActionName = value
+	}
+	if value, ok := aac.mutation.Parameters(); ok {
+		_spec.SetField(agentaction.FieldParameters, field.TypeJSON, value)
+		_node.Parameters = value
+	}
+	if value, ok := aac.mutation.TargetResource(); ok {
+		_spec.SetField(agentaction.FieldTargetResource, field.TypeString, value)
+		_node.TargetResource = value
+	}
+	if value, ok := aac.mutation.Status(); ok {
+		_spec.SetField(agentaction.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := aac.mutation.Result(); ok {
+		_spec.SetField(agentaction.FieldResult, field.TypeString, value)
+		_node.Result = value
+	}
+	if value, ok := aac.mutation.Error(); ok {
+		_spec.SetField(agentaction.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := aac.mutation.LatencyMs(); ok {
+		_spec.SetField(agentaction.FieldLatencyMs, field.TypeFloat64, value)
+		_node.LatencyMs = value
+	}
+	if value, ok := aac.mutation.SessionID(); ok {
+		_spec.SetField(agentaction.FieldSessionID, field.TypeString, value)
+		_node.SessionID = value
+	}
+	if value, ok := aac.mutation.UserID(); ok {
+		_spec.SetField(agentaction.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := aac.mutation.Metadata(); ok {
+		_spec.SetField(agentaction.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if nodes := aac.mutation.DecisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   agentaction.DecisionsTable,
+			Columns: agentaction.DecisionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(routingdecision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := aac.mutation.WorkflowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   agentaction.WorkflowsTable,
+			Columns: agentaction.WorkflowsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AgentActionCreateBulk is the builder for creating many AgentAction entities in bulk.
+type AgentActionCreateBulk struct {
+	config
+	err      error
+	builders []*AgentActionCreate
+}
+
+// Save creates the AgentAction entities in the database.
+func (aacb *AgentActionCreateBulk) Save(ctx context.Context) ([]*AgentAction, error) {
+	if aacb.err != nil {
+		return nil, aacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(aacb.builders))
+	nodes := make([]*AgentAction, len(aacb.builders))
+	mutators := make([]Mutator, len(aacb.builders))
+	for i := range aacb.builders {
+		func(i int, root context.Context) {
+			builder := aacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AgentActionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aacb *AgentActionCreateBulk) SaveX(ctx context.Context) []*AgentAction {
+	v, err := aacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aacb *AgentActionCreateBulk) Exec(ctx context.Context) error {
+	_, err := aacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aacb *AgentActionCreateBulk) ExecX(ctx context.Context) {
+	if err := aacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
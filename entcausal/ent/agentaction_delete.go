@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentActionDelete is the builder for deleting a AgentAction entity.
+type AgentActionDelete struct {
+	config
+	hooks    []Hook
+	mutation *AgentActionMutation
+}
+
+// Where appends a list predicates to the AgentActionDelete builder.
+func (aad *AgentActionDelete) Where(ps ...predicate.AgentAction) *AgentActionDelete {
+	aad.mutation.Where(ps...)
+	return aad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (aad *AgentActionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, aad.sqlExec, aad.mutation, aad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aad *AgentActionDelete) ExecX(ctx context.Context) int {
+	n, err := aad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (aad *AgentActionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(agentaction.Table, sqlgraph.NewFieldSpec(agentaction.FieldID, field.TypeString))
+	if ps := aad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, aad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	aad.mutation.done = true
+	return affected, err
+}
+
+// AgentActionDeleteOne is the builder for deleting a single AgentAction entity.
+type AgentActionDeleteOne struct {
+	aad *AgentActionDelete
+}
+
+// Where appends a list predicates to the AgentActionDelete builder.
+func (aado *AgentActionDeleteOne) Where(ps ...predicate.AgentAction) *AgentActionDeleteOne {
+	aado.aad.mutation.Where(ps...)
+	return aado
+}
+
+// Exec executes the deletion query.
+func (aado *AgentActionDeleteOne) Exec(ctx context.Context) error {
+	n, err := aado.aad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{agentaction.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aado *AgentActionDeleteOne) ExecX(ctx context.Context) {
+	if err := aado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
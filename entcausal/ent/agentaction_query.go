@@ -0,0 +1,799 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentActionQuery is the builder for querying AgentAction entities.
+type AgentActionQuery struct {
+	config
+	ctx                *QueryContext
+	order              []agentaction.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.AgentAction
+	withDecisions      *RoutingDecisionQuery
+	withWorkflows      *WorkflowExecutionQuery
+	modifiers          []func(*sql.Selector)
+	loadTotal          []func(context.Context, []*AgentAction) error
+	withNamedDecisions map[string]*RoutingDecisionQuery
+	withNamedWorkflows map[string]*WorkflowExecutionQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the AgentActionQuery builder.
+func (aaq *AgentActionQuery) Where(ps ...predicate.AgentAction) *AgentActionQuery {
+	aaq.predicates = append(aaq.predicates, ps...)
+	return aaq
+}
+
+// Limit the number of records to be returned by this query.
+func (aaq *AgentActionQuery) Limit(limit int) *AgentActionQuery {
+	aaq.ctx.Limit = &limit
+	return aaq
+}
+
+// Offset to start from.
+func (aaq *AgentActionQuery) Offset(offset int) *AgentActionQuery {
+	aaq.ctx.Offset = &offset
+	return aaq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (aaq *AgentActionQuery) Unique(unique bool) *AgentActionQuery {
+	aaq.ctx.Unique = &unique
+	return aaq
+}
+
+// Order specifies how the records should be ordered.
+func (aaq *AgentActionQuery) Order(o ...agentaction.OrderOption) *AgentActionQuery {
+	aaq.order = append(aaq.order, o...)
+	return aaq
+}
+
+// QueryDecisions chains the current query on the "decisions" edge.
+func (aaq *AgentActionQuery) QueryDecisions() *RoutingDecisionQuery {
+	query := (&RoutingDecisionClient{config: aaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(agentaction.Table, agentaction.FieldID, selector),
+			sqlgraph.To(routingdecision.Table, routingdecision.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, agentaction.DecisionsTable, agentaction.DecisionsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(aaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWorkflows chains the current query on the "workflows" edge.
+func (aaq *AgentActionQuery) QueryWorkflows() *WorkflowExecutionQuery {
+	query := (&WorkflowExecutionClient{config: aaq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aaq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aaq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(agentaction.Table, agentaction.FieldID, selector),
+			sqlgraph.To(workflowexecution.Table, workflowexecution.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, agentaction.WorkflowsTable, agentaction.WorkflowsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(aaq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first AgentAction entity from the query.
+// Returns a *NotFoundError when no AgentAction was found.
+func (aaq *AgentActionQuery) First(ctx context.Context) (*AgentAction, error) {
+	nodes, err := aaq.Limit(1).All(setContextOp(ctx, aaq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{agentaction.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (aaq *AgentActionQuery) FirstX(ctx context.Context) *AgentAction {
+	node, err := aaq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first AgentAction ID from the query.
+// Returns a *NotFoundError when no AgentAction ID was found.
+func (aaq *AgentActionQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = aaq.Limit(1).IDs(setContextOp(ctx, aaq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{agentaction.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (aaq *AgentActionQuery) FirstIDX(ctx context.Context) string {
+	id, err := aaq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single AgentAction entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one AgentAction entity is found.
+// Returns a *NotFoundError when no AgentAction entities are found.
+func (aaq *AgentActionQuery) Only(ctx context.Context) (*AgentAction, error) {
+	nodes, err := aaq.Limit(2).All(setContextOp(ctx, aaq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{agentaction.Label}
+	default:
+		return nil, &NotSingularError{agentaction.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (aaq *AgentActionQuery) OnlyX(ctx context.Context) *AgentAction {
+	node, err := aaq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only AgentAction ID in the query.
+// Returns a *NotSingularError when more than one AgentAction ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (aaq *AgentActionQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = aaq.Limit(2).IDs(setContextOp(ctx, aaq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{agentaction.Label}
+	default:
+		err = &NotSingularError{agentaction.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (aaq *AgentActionQuery) OnlyIDX(ctx context.Context) string {
+	id, err := aaq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of AgentActions.
+func (aaq *AgentActionQuery) All(ctx context.Context) ([]*AgentAction, error) {
+	ctx = setContextOp(ctx, aaq.ctx, ent.OpQueryAll)
+	if err := aaq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*AgentAction, *AgentActionQuery]()
+	return withInterceptors[[]*AgentAction](ctx, aaq, qr, aaq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (aaq *AgentActionQuery) AllX(ctx context.Context) []*AgentAction {
+	nodes, err := aaq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of AgentAction IDs.
+func (aaq *AgentActionQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if aaq.ctx.Unique == nil && aaq.path != nil {
+		aaq.Unique(true)
+	}
+	ctx = setContextOp(ctx, aaq.ctx, ent.OpQueryIDs)
+	if err = aaq.Select(agentaction.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (aaq *AgentActionQuery) IDsX(ctx context.Context) []string {
+	ids, err := aaq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (aaq *AgentActionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, aaq.ctx, ent.OpQueryCount)
+	if err := aaq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, aaq, querierCount[*AgentActionQuery](), aaq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (aaq *AgentActionQuery) CountX(ctx context.Context) int {
+	count, err := aaq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (aaq *AgentActionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, aaq.ctx, ent.OpQueryExist)
+	switch _, err := aaq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (aaq *AgentActionQuery) ExistX(ctx context.Context) bool {
+	exist, err := aaq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the AgentActionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (aaq *AgentActionQuery) Clone() *AgentActionQuery {
+	if aaq == nil {
+		return nil
+	}
+	return &AgentActionQuery{
+		config:        aaq.config,
+		ctx:           aaq.ctx.Clone(),
+		order:         append([]agentaction.OrderOption{}, aaq.order...),
+		inters:        append([]Interceptor{}, aaq.inters...),
+		predicates:    append([]predicate.AgentAction{}, aaq.predicates...),
+		withDecisions: aaq.withDecisions.Clone(),
+		withWorkflows: aaq.withWorkflows.Clone(),
+		// clone intermediate query.
+		sql:  aaq.sql.Clone(),
+		path: aaq.path,
+	}
+}
+
+// WithDecisions tells the query-builder to eager-load the nodes that are connected to
+// the "decisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (aaq *AgentActionQuery) WithDecisions(opts ...func(*RoutingDecisionQuery)) *AgentActionQuery {
+	query := (&RoutingDecisionClient{config: aaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aaq.withDecisions = query
+	return aaq
+}
+
+// WithWorkflows tells the query-builder to eager-load the nodes that are connected to
+// the "workflows" edge. The optional arguments are used to configure the query builder of the edge.
+func (aaq *AgentActionQuery) WithWorkflows(opts ...func(*WorkflowExecutionQuery)) *AgentActionQuery {
+	query := (&WorkflowExecutionClient{config: aaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aaq.withWorkflows = query
+	return aaq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ProvenanceHash string `json:"provenance_hash,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.AgentAction.Query().
+//		GroupBy(agentaction.FieldProvenanceHash).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (aaq *AgentActionQuery) GroupBy(field string, fields ...string) *AgentActionGroupBy {
+	aaq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &AgentActionGroupBy{build: aaq}
+	grbuild.flds = &aaq.ctx.Fields
+	grbuild.label = agentaction.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ProvenanceHash string `json:"provenance_hash,omitempty"`
+//	}
+//
+//	client.AgentAction.Query().
+//		Select(agentaction.FieldProvenanceHash).
+//		Scan(ctx, &v)
+func (aaq *AgentActionQuery) Select(fields ...string) *AgentActionSelect {
+	aaq.ctx.Fields = append(aaq.ctx.Fields, fields...)
+	sbuild := &AgentActionSelect{AgentActionQuery: aaq}
+	sbuild.label = agentaction.Label
+	sbuild.flds, sbuild.scan = &aaq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a AgentActionSelect configured with the given aggregations.
+func (aaq *AgentActionQuery) Aggregate(fns ...AggregateFunc) *AgentActionSelect {
+	return aaq.Select().Aggregate(fns...)
+}
+
+func (aaq *AgentActionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range aaq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, aaq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range aaq.ctx.Fields {
+		if !agentaction.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if aaq.path != nil {
+		prev, err := aaq.path(ctx)
+		if err != nil {
+			return err
+		}
+		aaq.sql = prev
+	}
+	return nil
+}
+
+func (aaq *AgentActionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*AgentAction, error) {
+	var (
+		nodes       = []*AgentAction{}
+		_spec       = aaq.querySpec()
+		loadedTypes = [2]bool{
+			aaq.withDecisions != nil,
+			aaq.withWorkflows != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*AgentAction).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &AgentAction{config: aaq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(aaq.modifiers) > 0 {
+		_spec.Modifiers = aaq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, aaq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := aaq.withDecisions; query != nil {
+		if err := aaq.loadDecisions(ctx, query, nodes,
+			func(n *AgentAction) { n.Edges.Decisions = []*RoutingDecision{} },
+			func(n *AgentAction, e *RoutingDecision) { n.Edges.Decisions = append(n.Edges.Decisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aaq.withWorkflows; query != nil {
+		if err := aaq.loadWorkflows(ctx, query, nodes,
+			func(n *AgentAction) { n.Edges.Workflows = []*WorkflowExecution{} },
+			func(n *AgentAction, e *WorkflowExecution) { n.Edges.Workflows = append(n.Edges.Workflows, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range aaq.withNamedDecisions {
+		if err := aaq.loadDecisions(ctx, query, nodes,
+			func(n *AgentAction) { n.appendNamedDecisions(name) },
+			func(n *AgentAction, e *RoutingDecision) { n.appendNamedDecisions(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range aaq.withNamedWorkflows {
+		if err := aaq.loadWorkflows(ctx, query, nodes,
+			func(n *AgentAction) { n.appendNamedWorkflows(name) },
+			func(n *AgentAction, e *WorkflowExecution) { n.appendNamedWorkflows(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range aaq.loadTotal {
+		if err := aaq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (aaq *AgentActionQuery) loadDecisions(ctx context.Context, query *RoutingDecisionQuery, nodes []*AgentAction, init func(*AgentAction), assign func(*AgentAction, *RoutingDecision)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*AgentAction)
+	nids := make(map[string]map[*AgentAction]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(agentaction.DecisionsTable)
+		s.Join(joinT).On(s.C(routingdecision.FieldID), joinT.C(agentaction.DecisionsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(agentaction.DecisionsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(agentaction.DecisionsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*AgentAction]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*RoutingDecision](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "decisions" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (aaq *AgentActionQuery) loadWorkflows(ctx context.Context, query *WorkflowExecutionQuery, nodes []*AgentAction, init func(*AgentAction), assign func(*AgentAction, *WorkflowExecution)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*AgentAction)
+	nids := make(map[string]map[*AgentAction]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(agentaction.WorkflowsTable)
+		s.Join(joinT).On(s.C(workflowexecution.FieldID), joinT.C(agentaction.WorkflowsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(agentaction.WorkflowsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(agentaction.WorkflowsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*AgentAction]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*WorkflowExecution](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "workflows" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+
+func (aaq *AgentActionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := aaq.querySpec()
+	if len(aaq.modifiers) > 0 {
+		_spec.Modifiers = aaq.modifiers
+	}
+	_spec.Node.Columns = aaq.ctx.Fields
+	if len(aaq.ctx.Fields) > 0 {
+		_spec.Unique = aaq.ctx.Unique != nil && *aaq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, aaq.driver, _spec)
+}
+
+func (aaq *AgentActionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(agentaction.Table, agentaction.Columns, sqlgraph.NewFieldSpec(agentaction.FieldID, field.TypeString))
+	_spec.From = aaq.sql
+	if unique := aaq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if aaq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := aaq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, agentaction.FieldID)
+		for i := range fields {
+			if fields[i] != agentaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := aaq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := aaq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := aaq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := aaq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (aaq *AgentActionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(aaq.driver.Dialect())
+	t1 := builder.Table(agentaction.Table)
+	columns := aaq.ctx.Fields
+	if len(columns) == 0 {
+		columns = agentaction.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if aaq.sql != nil {
+		selector = aaq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if aaq.ctx.Unique != nil && *aaq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range aaq.predicates {
+		p(selector)
+	}
+	for _, p := range aaq.order {
+		p(selector)
+	}
+	if offset := aaq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := aaq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WithNamedDecisions tells the query-builder to eager-load the nodes that are connected to the "decisions"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (aaq *AgentActionQuery) WithNamedDecisions(name string, opts ...func(*RoutingDecisionQuery)) *AgentActionQuery {
+	query := (&RoutingDecisionClient{config: aaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if aaq.withNamedDecisions == nil {
+		aaq.withNamedDecisions = make(map[string]*RoutingDecisionQuery)
+	}
+	aaq.withNamedDecisions[name] = query
+	return aaq
+}
+
+// WithNamedWorkflows tells the query-builder to eager-load the nodes that are connected to the "workflows"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (aaq *AgentActionQuery) WithNamedWorkflows(name string, opts ...func(*WorkflowExecutionQuery)) *AgentActionQuery {
+	query := (&WorkflowExecutionClient{config: aaq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if aaq.withNamedWorkflows == nil {
+		aaq.withNamedWorkflows = make(map[string]*WorkflowExecutionQuery)
+	}
+	aaq.withNamedWorkflows[name] = query
+	return aaq
+}
+
+// AgentActionGroupBy is the group-by builder for AgentAction entities.
+type AgentActionGroupBy struct {
+	selector
+	build *AgentActionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (aagb *AgentActionGroupBy) Aggregate(fns ...AggregateFunc) *AgentActionGroupBy {
+	aagb.fns = append(aagb.fns, fns...)
+	return aagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (aagb *AgentActionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, aagb.build.ctx, ent.OpQueryGroupBy)
+	if err := aagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AgentActionQuery, *AgentActionGroupBy](ctx, aagb.build, aagb, aagb.build.inters, v)
+}
+
+func (aagb *AgentActionGroupBy) sqlScan(ctx context.Context, root *AgentActionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(aagb.fns))
+	for _, fn := range aagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*aagb.flds)+len(aagb.fns))
+		for _, f := range *aagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*aagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := aagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// AgentActionSelect is the builder for selecting fields of AgentAction entities.
+type AgentActionSelect struct {
+	*AgentActionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (aas *AgentActionSelect) Aggregate(fns ...AggregateFunc) *AgentActionSelect {
+	aas.fns = append(aas.fns, fns...)
+	return aas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (aas *AgentActionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, aas.ctx, ent.OpQuerySelect)
+	if err := aas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*AgentActionQuery, *AgentActionSelect](ctx, aas.AgentActionQuery, aas, aas.inters, v)
+}
+
+func (aas *AgentActionSelect) sqlScan(ctx context.Context, root *AgentActionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(aas.fns))
+	for _, fn := range aas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*aas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := aas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
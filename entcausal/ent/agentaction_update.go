@@ -0,0 +1,1155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AgentActionUpdate is the builder for updating AgentAction entities.
+type AgentActionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AgentActionMutation
+}
+
+// Where appends a list predicates to the AgentActionUpdate builder.
+func (aau *AgentActionUpdate) Where(ps ...predicate.AgentAction) *AgentActionUpdate {
+	aau.mutation.Where(ps...)
+	return aau
+}
+
+// SetAgentID sets the "agent_id" field.
+func (aau *AgentActionUpdate) SetAgentID(s string) *AgentActionUpdate {
+	aau.mutation.SetAgentID(s)
+	return aau
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (aau *AgentActionUpdate) SetNillableAgentID(s *string) *AgentActionUpdate {
+	if s != nil {
+		aau.SetAgentID(*s)
+	}
+	return aau
+}
+
+// SetAgentType sets the "agent_type" field.
+func (aau *AgentActionUpdate) SetAgentType(s string) *AgentActionUpdate {
+	aau.mutation.SetAgentType(s)
+	return aau
+}
+
+// SetNillableAgentType sets the "agent_type" field if the given value is not nil.
+func (aau *AgentActionUpdate) SetNillableAgentType(s *string) *AgentActionUpdate {
+	if s != nil {
+		aau.SetAgentType(*s)
+	}
+	return aau
+}
+
+// SetActionType sets the "action_type" field.
+func (aau *AgentActionUpdate) SetActionType(s string) *AgentActionUpdate {
+	aau.mutation.SetActionType(s)
+	return aau
+}
+
+// SetNillableActionType sets the "action_type" field if the given value is not nil.
+func (aau *AgentActionUpdate) SetNillableActionType(s *string) *AgentActionUpdate {
+	if s != nil {
+		aau.SetActionType(*s)
+	}
+	return aau
+}
+
+// SetActionName sets the "action_name" field.
+func (aau *AgentActionUpdate) SetActionName(s string) *AgentActionUpdate {
+	aau.mutation.SetActionName(s)
+	return aau
+}
+
+// SetNillableActionName sets the "action_name" field if the given value is not nil.
+func (aau *AgentActionUpdate) SetNillableActionName(s *string) *AgentActionUpdate {
+	if s != nil {
+		aau.SetActionName(*s)
+	}
+	return aau
+}
+
+// ClearActionName clears the value of the "action_name" field.
+func (aau *AgentActionUpdate) ClearActionName() *AgentActionUpdate {
+	aau.mutation.ClearActionName()
+	return aau
+}
+
+// SetParameters sets the "parameters" field.
+func (aau *AgentActionUpdate) SetParameters(m map[string]interface{}) *AgentActionUpdate {
+	aau.mutation.SetParameters(m)
+	return aau
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (aau *AgentActionUpdate) ClearParameters() *AgentActionUpdate {
+	aau.mutation.ClearParameters()
+	return aau
+}
+
+// SetTargetResource sets the "target_resource" field.
+func (aau *AgentActionUpdate) SetTargetResource(s string) *AgentActionUpdate {
+	aau.mutation.SetTargetResource(s)
+	return aau
+}
+
+// SetNillableTargetResource sets the "target_resource" field if the given value is not nil.
+func (aau *AgentActionUpdate) SetNillableTargetResource(s *string) *AgentActionUpdate {
+	if s != nil {
+		aau.SetTargetResource(*s)
+	}
+	return aau
+}
+
+// ClearTargetResource clears the value of the "target_resource" field.
+func (aau *AgentActionUpdate) ClearTargetResource() *AgentActionUpdate {
+	aau.mutation.ClearTargetResource()
+	return aau
+}
+
+// SetStatus sets the "status" field.
+func (aau *AgentActionUpdate) SetStatus(a agentaction.Status) *AgentActionUpdate {
+	aau.mutation.SetStatus(a)
+	return aau
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (aau *AgentActionUpdate) SetNillableStatus(a *agentaction.Status) *AgentActionUpdate {
+	if a != nil {
+		aau.SetStatus(*a)
+	}
+	return aau
+}
+
+// SetResult sets the "result" field.
+func (aau *AgentActionUpdate) SetResult(s string) *AgentActionUpdate {
+	aau.mutation.SetResult(s)
+	return aau
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (aau *AgentActionUpdate) SetNillableResult(s *string) *AgentActionUpdate {
+	if s != nil {
+		aau.SetResult(*s)
+	}
+	return aau
+}
+
+// ClearResult clears the value of the "result" field.
+func (aau *AgentActionUpdate) ClearResult() *AgentActionUpdate {
+	aau.mutation.ClearResult()
+	return aau
+}
+
+// SetError sets the "error" field.
+func (aau *AgentActionUpdate) SetError(s string) *AgentActionUpdate {
+	aau.mutation.SetError(s)
+	return aau
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (aau *AgentActionUpdate) SetNillableError(s *string) *AgentActionUpdate {
+	if s != nil {
+		aau.SetError(*s)
+	}
+	return aau
+}
+
+// ClearError clears the value of the "error" field.
+func (aau *AgentActionUpdate) ClearError() *AgentActionUpdate {
+	aau.mutation.ClearError()
+	return aau
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (aau *AgentActionUpdate) SetLatencyMs(f float64) *AgentActionUpdate {
+	aau.mutation.ResetLatencyMs()
+	aau.mutation.SetLatencyMs(f)
+	return aau
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (aau *AgentActionUpdate) SetNillableLatencyMs(f *float64) *AgentActionUpdate {
+	if f != nil {
+		aau.SetLatencyMs(*f)
+	}
+	return aau
+}
+
+// AddLatencyMs adds f to the "latency_ms" field.
+func (aau *AgentActionUpdate) AddLatencyMs(f float64) *AgentActionUpdate {
+	aau.mutation.AddLatencyMs(f)
+	return aau
+}
+
+// ClearLatencyMs clears the value of the "latency_ms" field.
+func (aau *AgentActionUpdate) ClearLatencyMs() *AgentActionUpdate {
+	aau.mutation.ClearLatencyMs()
+	return aau
+}
+
+// SetSessionID sets the "session_id" field.
+func (aau *AgentActionUpdate) SetSessionID(s string) *AgentActionUpdate {
+	aau.mutation.SetSessionID(s)
+	return aau
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (aau *AgentActionUpdate) SetNillableSessionID(s *string) *AgentActionUpdate {
+	if s != nil {
+		aau.SetSessionID(*s)
+	}
+	return aau
+}
+
+// ClearSessionID clears the value of the "session_id" field.
+func (aau *AgentActionUpdate) ClearSessionID() *AgentActionUpdate {
+	aau.mutation.ClearSessionID()
+	return aau
+}
+
+// SetUserID sets the "user_id" field.
+func (aau *AgentActionUpdate) SetUserID(s string) *AgentActionUpdate {
+	aau.mutation.SetUserID(s)
+	return aau
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (aau *AgentActionUpdate) SetNillableUserID(s *string) *AgentActionUpdate {
+	if s != nil {
+		aau.SetUserID(*s)
+	}
+	return aau
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (aau *AgentActionUpdate) ClearUserID() *AgentActionUpdate {
+	aau.mutation.ClearUserID()
+	return aau
+}
+
+// SetMetadata sets the "metadata" field.
+func (aau *AgentActionUpdate) SetMetadata(m map[string]interface{}) *AgentActionUpdate {
+	aau.mutation.SetMetadata(m)
+	return aau
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (aau *AgentActionUpdate) ClearMetadata() *AgentActionUpdate {
+	aau.mutation.ClearMetadata()
+	return aau
+}
+
+// AddDecisionIDs adds the "decisions" edge to the RoutingDecision entity by IDs.
+func (aau *AgentActionUpdate) AddDecisionIDs(ids ...string) *AgentActionUpdate {
+	aau.mutation.AddDecisionIDs(ids...)
+	return aau
+}
+
+// AddDecisions adds the "decisions" edges to the RoutingDecision entity.
+func (aau *AgentActionUpdate) AddDecisions(r ...*RoutingDecision) *AgentActionUpdate {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return aau.AddDecisionIDs(ids...)
+}
+
+// AddWorkflowIDs adds the "workflows" edge to the WorkflowExecution entity by IDs.
+func (aau *AgentActionUpdate) AddWorkflowIDs(ids ...string) *AgentActionUpdate {
+	aau.mutation.AddWorkflowIDs(ids...)
+	return aau
+}
+
+// AddWorkflows adds the "workflows" edges to the WorkflowExecution entity.
+func (aau *AgentActionUpdate) AddWorkflows(w ...*WorkflowExecution) *AgentActionUpdate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return aau.AddWorkflowIDs(ids...)
+}
+
+// Mutation returns the AgentActionMutation object of the builder.
+func (aau *AgentActionUpdate) Mutation() *AgentActionMutation {
+	return aau.mutation
+}
+
+// ClearDecisions clears all "decisions" edges to the RoutingDecision entity.
+func (aau *AgentActionUpdate) ClearDecisions() *AgentActionUpdate {
+	aau.mutation.ClearDecisions()
+	return aau
+}
+
+// RemoveDecisionIDs removes the "decisions" edge to RoutingDecision entities by IDs.
+func (aau *AgentActionUpdate) RemoveDecisionIDs(ids ...string) *AgentActionUpdate {
+	aau.mutation.RemoveDecisionIDs(ids...)
+	return aau
+}
+
+// RemoveDecisions removes "decisions" edges to RoutingDecision entities.
+func (aau *AgentActionUpdate) RemoveDecisions(r ...*RoutingDecision) *AgentActionUpdate {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return aau.RemoveDecisionIDs(ids...)
+}
+
+// ClearWorkflows clears all "workflows" edges to the WorkflowExecution entity.
+func (aau *AgentActionUpdate) ClearWorkflows() *AgentActionUpdate {
+	aau.mutation.ClearWorkflows()
+	return aau
+}
+
+// RemoveWorkflowIDs removes the "workflows" edge to WorkflowExecution entities by IDs.
+func (aau *AgentActionUpdate) RemoveWorkflowIDs(ids ...string) *AgentActionUpdate {
+	aau.mutation.RemoveWorkflowIDs(ids...)
+	return aau
+}
+
+// RemoveWorkflows removes "workflows" edges to WorkflowExecution entities.
+func (aau *AgentActionUpdate) RemoveWorkflows(w ...*WorkflowExecution) *AgentActionUpdate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return aau.RemoveWorkflowIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aau *AgentActionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, aau.sqlSave, aau.mutation, aau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aau *AgentActionUpdate) SaveX(ctx context.Context) int {
+	affected, err := aau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aau *AgentActionUpdate) Exec(ctx context.Context) error {
+	_, err := aau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aau *AgentActionUpdate) ExecX(ctx context.Context) {
+	if err := aau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aau *AgentActionUpdate) check() error {
+	if v, ok := aau.mutation.AgentID(); ok {
+		if err := agentaction.AgentIDValidator(v); err != nil {
+			return &ValidationError{Name: "agent_id", err: fmt.Errorf(`ent: validator failed for field "AgentAction.agent_id": %w`, err)}
+		}
+	}
+	if v, ok := aau.mutation.AgentType(); ok {
+		if err := agentaction.AgentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "agent_type", err: fmt.Errorf(`ent: validator failed for field "AgentAction.agent_type": %w`, err)}
+		}
+	}
+	if v, ok := aau.mutation.ActionType(); ok {
+		if err := agentaction.ActionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "action_type", err: fmt.Errorf(`ent: validator failed for field "AgentAction.action_type": %w`, err)}
+		}
+	}
+	if v, ok := aau.mutation.Status(); ok {
+		if err := agentaction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AgentAction.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aau *AgentActionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aau.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(agentaction.Table, agentaction.Columns, sqlgraph.NewFieldSpec(agentaction.FieldID, field.TypeString))
+	if ps := aau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if aau.mutation.ProvenanceHashCleared() {
+		_spec.ClearField(agentaction.FieldProvenanceHash, field.TypeString)
+	}
+	if value, ok := aau.mutation.AgentID(); ok {
+		_spec.SetField(agentaction.FieldAgentID, field.TypeString, value)
+	}
+	if value, ok := aau.mutation.AgentType(); ok {
+		_spec.SetField(agentaction.FieldAgentType, field.TypeString, value)
+	}
+	if value, ok := aau.mutation.ActionType(); ok {
+		_spec.SetField(agentaction.FieldActionType, field.TypeString, value)
+	}
+	if value, ok := aau.mutation.ActionName(); ok {
+		_spec.SetField(agentaction.FieldActionName, field.TypeString, value)
+	}
+	if aau.mutation.ActionNameCleared() {
+		_spec.ClearField(agentaction.FieldActionName, field.TypeString)
+	}
+	if value, ok := aau.mutation.Parameters(); ok {
+		_spec.SetField(agentaction.FieldParameters, field.TypeJSON, value)
+	}
+	if aau.mutation.ParametersCleared() {
+		_spec.ClearField(agentaction.FieldParameters, field.TypeJSON)
+	}
+	if value, ok := aau.mutation.TargetResource(); ok {
+		_spec.SetField(agentaction.FieldTargetResource, field.TypeString, value)
+	}
+	if aau.mutation.TargetResourceCleared() {
+		_spec.ClearField(agentaction.FieldTargetResource, field.TypeString)
+	}
+	if value, ok := aau.mutation.Status(); ok {
+		_spec.SetField(agentaction.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := aau.mutation.Result(); ok {
+		_spec.SetField(agentaction.FieldResult, field.TypeString, value)
+	}
+	if aau.mutation.ResultCleared() {
+		_spec.ClearField(agentaction.FieldResult, field.TypeString)
+	}
+	if value, ok := aau.mutation.Error(); ok {
+		_spec.SetField(agentaction.FieldError, field.TypeString, value)
+	}
+	if aau.mutation.ErrorCleared() {
+		_spec.ClearField(agentaction.FieldError, field.TypeString)
+	}
+	if value, ok := aau.mutation.LatencyMs(); ok {
+		_spec.SetField(agentaction.FieldLatencyMs, field.TypeFloat64, value)
+	}
+	if value, ok := aau.mutation.AddedLatencyMs(); ok {
+		_spec.AddField(agentaction.FieldLatencyMs, field.TypeFloat64, value)
+	}
+	if aau.mutation.LatencyMsCleared() {
+		_spec.ClearField(agentaction.FieldLatencyMs, field.TypeFloat64)
+	}
+	if value, ok := aau.mutation.SessionID(); ok {
+		_spec.SetField(agentaction.FieldSessionID, field.TypeString, value)
+	}
+	if aau.mutation.SessionIDCleared() {
+		_spec.ClearField(agentaction.FieldSessionID, field.TypeString)
+	}
+	if value, ok := aau.mutation.UserID(); ok {
+		_spec.SetField(agentaction.FieldUserID, field.TypeString, value)
+	}
+	if aau.mutation.UserIDCleared() {
+		_spec.ClearField(agentaction.FieldUserID, field.TypeString)
+	}
+	if value, ok := aau.mutation.Metadata(); ok {
+		_spec.SetField(agentaction.FieldMetadata, field.TypeJSON, value)
+	}
+	if aau.mutation.MetadataCleared() {
+		_spec.ClearField(agentaction.FieldMetadata, field.TypeJSON)
+	}
+	if aau.mutation.DecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   agentaction.DecisionsTable,
+			Columns: agentaction.DecisionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(routingdecision.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aau.mutation.RemovedDecisionsIDs(); len(nodes) > 0 && !aau.mutation.DecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   agentaction.DecisionsTable,
+			Columns: agentaction.DecisionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(routingdecision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aau.mutation.DecisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   agentaction.DecisionsTable,
+			Columns: agentaction.DecisionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(routingdecision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aau.mutation.WorkflowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   agentaction.WorkflowsTable,
+			Columns: agentaction.WorkflowsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aau.mutation.RemovedWorkflowsIDs(); len(nodes) > 0 && !aau.mutation.WorkflowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   agentaction.WorkflowsTable,
+			Columns: agentaction.WorkflowsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aau.mutation.WorkflowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   agentaction.WorkflowsTable,
+			Columns: agentaction.WorkflowsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{agentaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aau.mutation.done = true
+	return n, nil
+}
+
+// AgentActionUpdateOne is the builder for updating a single AgentAction entity.
+type AgentActionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AgentActionMutation
+}
+
+// SetAgentID sets the "agent_id" field.
+func (aauo *AgentActionUpdateOne) SetAgentID(s string) *AgentActionUpdateOne {
+	aauo.mutation.SetAgentID(s)
+	return aauo
+}
+
+// SetNillableAgentID sets the "agent_id" field if the given value is not nil.
+func (aauo *AgentActionUpdateOne) SetNillableAgentID(s *string) *AgentActionUpdateOne {
+	if s != nil {
+		aauo.SetAgentID(*s)
+	}
+	return aauo
+}
+
+// SetAgentType sets the "agent_type" field.
+func (aauo *AgentActionUpdateOne) SetAgentType(s string) *AgentActionUpdateOne {
+	aauo.mutation.SetAgentType(s)
+	return aauo
+}
+
+// SetNillableAgentType sets the "agent_type" field if the given value is not nil.
+func (aauo *AgentActionUpdateOne) SetNillableAgentType(s *string) *AgentActionUpdateOne {
+	if s != nil {
+		aauo.SetAgentType(*s)
+	}
+	return aauo
+}
+
+// SetActionType sets the "action_type" field.
+func (aauo *AgentActionUpdateOne) SetActionType(s string) *AgentActionUpdateOne {
+	aauo.mutation.SetActionType(s)
+	return aauo
+}
+
+// SetNillableActionType sets the "action_type" field if the given value is not nil.
+func (aauo *AgentActionUpdateOne) SetNillableActionType(s *string) *AgentActionUpdateOne {
+	if s != nil {
+		aauo.SetActionType(*s)
+	}
+	return aauo
+}
+
+// SetActionName sets the "action_name" field.
+func (aauo *AgentActionUpdateOne) SetActionName(s string) *AgentActionUpdateOne {
+	aauo.mutation.SetActionName(s)
+	return aauo
+}
+
+// SetNillableActionName sets the "action_name" field if the given value is not nil.
+func (aauo *AgentActionUpdateOne) SetNillableActionName(s *string) *AgentActionUpdateOne {
+	if s != nil {
+		aauo.SetActionName(*s)
+	}
+	return aauo
+}
+
+// ClearActionName clears the value of the "action_name" field.
+func (aauo *AgentActionUpdateOne) ClearActionName() *AgentActionUpdateOne {
+	aauo.mutation.ClearActionName()
+	return aauo
+}
+
+// SetParameters sets the "parameters" field.
+func (aauo *AgentActionUpdateOne) SetParameters(m map[string]interface{}) *AgentActionUpdateOne {
+	aauo.mutation.SetParameters(m)
+	return aauo
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (aauo *AgentActionUpdateOne) ClearParameters() *AgentActionUpdateOne {
+	aauo.mutation.ClearParameters()
+	return aauo
+}
+
+// SetTargetResource sets the "target_resource" field.
+func (aauo *AgentActionUpdateOne) SetTargetResource(s string) *AgentActionUpdateOne {
+	aauo.mutation.SetTargetResource(s)
+	return aauo
+}
+
+// SetNillableTargetResource sets the "target_resource" field if the given value is not nil.
+func (aauo *AgentActionUpdateOne) SetNillableTargetResource(s *string) *AgentActionUpdateOne {
+	if s != nil {
+		aauo.SetTargetResource(*s)
+	}
+	return aauo
+}
+
+// ClearTargetResource clears the value of the "target_resource" field.
+func (aauo *AgentActionUpdateOne) ClearTargetResource() *AgentActionUpdateOne {
+	aauo.mutation.ClearTargetResource()
+	return aauo
+}
+
+// SetStatus sets the "status" field.
+func (aauo *AgentActionUpdateOne) SetStatus(a agentaction.Status) *AgentActionUpdateOne {
+	aauo.mutation.SetStatus(a)
+	return aauo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (aauo *AgentActionUpdateOne) SetNillableStatus(a *agentaction.Status) *AgentActionUpdateOne {
+	if a != nil {
+		aauo.SetStatus(*a)
+	}
+	return aauo
+}
+
+// SetResult sets the "result" field.
+func (aauo *AgentActionUpdateOne) SetResult(s string) *AgentActionUpdateOne {
+	aauo.mutation.SetResult(s)
+	return aauo
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (aauo *AgentActionUpdateOne) SetNillableResult(s *string) *AgentActionUpdateOne {
+	if s != nil {
+		aauo.SetResult(*s)
+	}
+	return aauo
+}
+
+// ClearResult clears the value of the "result" field.
+func (aauo *AgentActionUpdateOne) ClearResult() *AgentActionUpdateOne {
+	aauo.mutation.ClearResult()
+	return aauo
+}
+
+// SetError sets the "error" field.
+func (aauo *AgentActionUpdateOne) SetError(s string) *AgentActionUpdateOne {
+	aauo.mutation.SetError(s)
+	return aauo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (aauo *AgentActionUpdateOne) SetNillableError(s *string) *AgentActionUpdateOne {
+	if s != nil {
+		aauo.SetError(*s)
+	}
+	return aauo
+}
+
+// ClearError clears the value of the "error" field.
+func (aauo *AgentActionUpdateOne) ClearError() *AgentActionUpdateOne {
+	aauo.mutation.ClearError()
+	return aauo
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (aauo *AgentActionUpdateOne) SetLatencyMs(f float64) *AgentActionUpdateOne {
+	aauo.mutation.ResetLatencyMs()
+	aauo.mutation.SetLatencyMs(f)
+	return aauo
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (aauo *AgentActionUpdateOne) SetNillableLatencyMs(f *float64) *AgentActionUpdateOne {
+	if f != nil {
+		aauo.SetLatencyMs(*f)
+	}
+	return aauo
+}
+
+// AddLatencyMs adds f to the "latency_ms" field.
+func (aauo *AgentActionUpdateOne) AddLatencyMs(f float64) *AgentActionUpdateOne {
+	aauo.mutation.AddLatencyMs(f)
+	return aauo
+}
+
+// ClearLatencyMs clears the value of the "latency_ms" field.
+func (aauo *AgentActionUpdateOne) ClearLatencyMs() *AgentActionUpdateOne {
+	aauo.mutation.ClearLatencyMs()
+	return aauo
+}
+
+// SetSessionID sets the "session_id" field.
+func (aauo *AgentActionUpdateOne) SetSessionID(s string) *AgentActionUpdateOne {
+	aauo.mutation.SetSessionID(s)
+	return aauo
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (aauo *AgentActionUpdateOne) SetNillableSessionID(s *string) *AgentActionUpdateOne {
+	if s != nil {
+		aauo.SetSessionID(*s)
+	}
+	return aauo
+}
+
+// ClearSessionID clears the value of the "session_id" field.
+func (aauo *AgentActionUpdateOne) ClearSessionID() *AgentActionUpdateOne {
+	aauo.mutation.ClearSessionID()
+	return aauo
+}
+
+// SetUserID sets the "user_id" field.
+func (aauo *AgentActionUpdateOne) SetUserID(s string) *AgentActionUpdateOne {
+	aauo.mutation.SetUserID(s)
+	return aauo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (aauo *AgentActionUpdateOne) SetNillableUserID(s *string) *AgentActionUpdateOne {
+	if s != nil {
+		aauo.SetUserID(*s)
+	}
+	return aauo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (aauo *AgentActionUpdateOne) ClearUserID() *AgentActionUpdateOne {
+	aauo.mutation.ClearUserID()
+	return aauo
+}
+
+// SetMetadata sets the "metadata" field.
+func (aauo *AgentActionUpdateOne) SetMetadata(m map[string]interface{}) *AgentActionUpdateOne {
+	aauo.mutation.SetMetadata(m)
+	return aauo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (aauo *AgentActionUpdateOne) ClearMetadata() *AgentActionUpdateOne {
+	aauo.mutation.ClearMetadata()
+	return aauo
+}
+
+// AddDecisionIDs adds the "decisions" edge to the RoutingDecision entity by IDs.
+func (aauo *AgentActionUpdateOne) AddDecisionIDs(ids ...string) *AgentActionUpdateOne {
+	aauo.mutation.AddDecisionIDs(ids...)
+	return aauo
+}
+
+// AddDecisions adds the "decisions" edges to the RoutingDecision entity.
+func (aauo *AgentActionUpdateOne) AddDecisions(r ...*RoutingDecision) *AgentActionUpdateOne {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return aauo.AddDecisionIDs(ids...)
+}
+
+// AddWorkflowIDs adds the "workflows" edge to the WorkflowExecution entity by IDs.
+func (aauo *AgentActionUpdateOne) AddWorkflowIDs(ids ...string) *AgentActionUpdateOne {
+	aauo.mutation.AddWorkflowIDs(ids...)
+	return aauo
+}
+
+// AddWorkflows adds the "workflows" edges to the WorkflowExecution entity.
+func (aauo *AgentActionUpdateOne) AddWorkflows(w ...*WorkflowExecution) *AgentActionUpdateOne {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return aauo.AddWorkflowIDs(ids...)
+}
+
+// Mutation returns the AgentActionMutation object of the builder.
+func (aauo *AgentActionUpdateOne) Mutation() *AgentActionMutation {
+	return aauo.mutation
+}
+
+// ClearDecisions clears all "decisions" edges to the RoutingDecision entity.
+func (aauo *AgentActionUpdateOne) ClearDecisions() *AgentActionUpdateOne {
+	aauo.mutation.ClearDecisions()
+	return aauo
+}
+
+// RemoveDecisionIDs removes the "decisions" edge to RoutingDecision entities by IDs.
+func (aauo *AgentActionUpdateOne) RemoveDecisionIDs(ids ...string) *AgentActionUpdateOne {
+	aauo.mutation.RemoveDecisionIDs(ids...)
+	return aauo
+}
+
+// RemoveDecisions removes "decisions" edges to RoutingDecision entities.
+func (aauo *AgentActionUpdateOne) RemoveDecisions(r ...*RoutingDecision) *AgentActionUpdateOne {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return aauo.RemoveDecisionIDs(ids...)
+}
+
+// ClearWorkflows clears all "workflows" edges to the WorkflowExecution entity.
+func (aauo *AgentActionUpdateOne) ClearWorkflows() *AgentActionUpdateOne {
+	aauo.mutation.ClearWorkflows()
+	return aauo
+}
+
+// RemoveWorkflowIDs removes the "workflows" edge to WorkflowExecution entities by IDs.
+func (aauo *AgentActionUpdateOne) RemoveWorkflowIDs(ids ...string) *AgentActionUpdateOne {
+	aauo.mutation.RemoveWorkflowIDs(ids...)
+	return aauo
+}
+
+// RemoveWorkflows removes "workflows" edges to WorkflowExecution entities.
+func (aauo *AgentActionUpdateOne) RemoveWorkflows(w ...*WorkflowExecution) *AgentActionUpdateOne {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return aauo.RemoveWorkflowIDs(ids...)
+}
+
+// Where appends a list predicates to the AgentActionUpdate builder.
+func (aauo *AgentActionUpdateOne) Where(ps ...predicate.AgentAction) *AgentActionUpdateOne {
+	aauo.mutation.Where(ps...)
+	return aauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aauo *AgentActionUpdateOne) Select(field string, fields ...string) *AgentActionUpdateOne {
+	aauo.fields = append([]string{field}, fields...)
+	return aauo
+}
+
+// Save executes the query and returns the updated AgentAction entity.
+func (aauo *AgentActionUpdateOne) Save(ctx context.Context) (*AgentAction, error) {
+	return withHooks(ctx, aauo.sqlSave, aauo.mutation, aauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aauo *AgentActionUpdateOne) SaveX(ctx context.Context) *AgentAction {
+	node, err := aauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aauo *AgentActionUpdateOne) Exec(ctx context.Context) error {
+	_, err := aauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aauo *AgentActionUpdateOne) ExecX(ctx context.Context) {
+	if err := aauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aauo *AgentActionUpdateOne) check() error {
+	if v, ok := aauo.mutation.AgentID(); ok {
+		if err := agentaction.AgentIDValidator(v); err != nil {
+			return &ValidationError{Name: "agent_id", err: fmt.Errorf(`ent: validator failed for field "AgentAction.agent_id": %w`, err)}
+		}
+	}
+	if v, ok := aauo.mutation.AgentType(); ok {
+		if err := agentaction.AgentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "agent_type", err: fmt.Errorf(`ent: validator failed for field "AgentAction.agent_type": %w`, err)}
+		}
+	}
+	if v, ok := aauo.mutation.ActionType(); ok {
+		if err := agentaction.ActionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "action_type", err: fmt.Errorf(`ent: validator failed for field "AgentAction.action_type": %w`, err)}
+		}
+	}
+	if v, ok := aauo.mutation.Status(); ok {
+		if err := agentaction.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "AgentAction.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aauo *AgentActionUpdateOne) sqlSave(ctx context.Context) (_node *AgentAction, err error) {
+	if err := aauo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(agentaction.Table, agentaction.Columns, sqlgraph.NewFieldSpec(agentaction.FieldID, field.TypeString))
+	id, ok := aauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AgentAction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, agentaction.FieldID)
+		for _, f := range fields {
+			if !agentaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != agentaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if aauo.mutation.ProvenanceHashCleared() {
+		_spec.ClearField(agentaction.FieldProvenanceHash, field.TypeString)
+	}
+	if value, ok := aauo.mutation.AgentID(); ok {
+		_spec.SetField(agentaction.FieldAgentID, field.TypeString, value)
+	}
+	if value, ok := aauo.mutation.AgentType(); ok {
+		_spec.SetField(agentaction.FieldAgentType, field.TypeString, value)
+	}
+	if value, ok := aauo.mutation.ActionType(); ok {
+		_spec.SetField(agentaction.FieldActionType, field.TypeString, value)
+	}
+	if value, ok := aauo.mutation.ActionName(); ok {
+		_spec.SetField(agentaction.FieldActionName, field.TypeString, value)
+	}
+	if aauo.mutation.ActionNameCleared() {
+		_spec.ClearField(agentaction.FieldActionName, field.TypeString)
+	}
+	if value, ok := aauo.mutation.Parameters(); ok {
+		_spec.SetField(agentaction.FieldParameters, field.TypeJSON, value)
+	}
+	if aauo.mutation.ParametersCleared() {
+		_spec.ClearField(agentaction.FieldParameters, field.TypeJSON)
+	}
+	if value, ok := aauo.mutation.TargetResource(); ok {
+		_spec.SetField(agentaction.FieldTargetResource, field.TypeString, value)
+	}
+	if aauo.mutation.TargetResourceCleared() {
+		_spec.ClearField(agentaction.FieldTargetResource, field.TypeString)
+	}
+	if value, ok := aauo.mutation.Status(); ok {
+		_spec.SetField(agentaction.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := aauo.mutation.Result(); ok {
+		_spec.SetField(agentaction.FieldResult, field.TypeString, value)
+	}
+	if aauo.mutation.ResultCleared() {
+		_spec.ClearField(agentaction.FieldResult, field.TypeString)
+	}
+	if value, ok := aauo.mutation.Error(); ok {
+		_spec.SetField(agentaction.FieldError, field.TypeString, value)
+	}
+	if aauo.mutation.ErrorCleared() {
+		_spec.ClearField(agentaction.FieldError, field.TypeString)
+	}
+	if value, ok := aauo.mutation.LatencyMs(); ok {
+		_spec.SetField(agentaction.FieldLatencyMs, field.TypeFloat64, value)
+	}
+	if value, ok := aauo.mutation.AddedLatencyMs(); ok {
+		_spec.AddField(agentaction.FieldLatencyMs, field.TypeFloat64, value)
+	}
+	if aauo.mutation.LatencyMsCleared() {
+		_spec.ClearField(agentaction.FieldLatencyMs, field.TypeFloat64)
+	}
+	if value, ok := aauo.mutation.SessionID(); ok {
+		_spec.SetField(agentaction.FieldSessionID, field.TypeString, value)
+	}
+	if aauo.mutation.SessionIDCleared() {
+		_spec.ClearField(agentaction.FieldSessionID, field.TypeString)
+	}
+	if value, ok := aauo.mutation.UserID(); ok {
+		_spec.SetField(agentaction.FieldUserID, field.TypeString, value)
+	}
+	if aauo.mutation.UserIDCleared() {
+		_spec.ClearField(agentaction.FieldUserID, field.TypeString)
+	}
+	if value, ok := aauo.mutation.Metadata(); ok {
+		_spec.SetField(agentaction.FieldMetadata, field.TypeJSON, value)
+	}
+	if aauo.mutation.MetadataCleared() {
+		_spec.ClearField(agentaction.FieldMetadata, field.TypeJSON)
+	}
+	if aauo.mutation.DecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   agentaction.DecisionsTable,
+			Columns: agentaction.DecisionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(routingdecision.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aauo.mutation.RemovedDecisionsIDs(); len(nodes) > 0 && !aauo.mutation.DecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   agentaction.DecisionsTable,
+			Columns: agentaction.DecisionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(routingdecision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aauo.mutation.DecisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   agentaction.DecisionsTable,
+			Columns: agentaction.DecisionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(routingdecision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if aauo.mutation.WorkflowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   agentaction.WorkflowsTable,
+			Columns: agentaction.WorkflowsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aauo.mutation.RemovedWorkflowsIDs(); len(nodes) > 0 && !aauo.mutation.WorkflowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   agentaction.WorkflowsTable,
+			Columns: agentaction.WorkflowsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aauo.mutation.WorkflowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   agentaction.WorkflowsTable,
+			Columns: agentaction.WorkflowsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AgentAction{config: aauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{agentaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aauo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,1075 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+
+	"entgo.io/contrib/entcausal/ent/migrate"
+	"entgo.io/ent"
+
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/spikeevent"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// Client is the client that holds all ent builders.
+type Client struct {
+	config
+	// Schema is the client for creating, migrating and dropping schema.
+	Schema *migrate.Schema
+	// AgentAction is the client for interacting with the AgentAction builders.
+	AgentAction *AgentActionClient
+	// ExternalOutput is the client for interacting with the ExternalOutput builders.
+	ExternalOutput *ExternalOutputClient
+	// RoutingDecision is the client for interacting with the RoutingDecision builders.
+	RoutingDecision *RoutingDecisionClient
+	// SpikeEvent is the client for interacting with the SpikeEvent builders.
+	SpikeEvent *SpikeEventClient
+	// WorkflowExecution is the client for interacting with the WorkflowExecution builders.
+	WorkflowExecution *WorkflowExecutionClient
+}
+
+// NewClient creates a new client configured with the given options.
+func NewClient(opts ...Option) *Client {
+	client := &Client{config: newConfig(opts...)}
+	client.init()
+	return client
+}
+
+func (c *Client) init() {
+	c.Schema = migrate.NewSchema(c.driver)
+	c.AgentAction = NewAgentActionClient(c.config)
+	c.ExternalOutput = NewExternalOutputClient(c.config)
+	c.RoutingDecision = NewRoutingDecisionClient(c.config)
+	c.SpikeEvent = NewSpikeEventClient(c.config)
+	c.WorkflowExecution = NewWorkflowExecutionClient(c.config)
+}
+
+type (
+	// config is the configuration for the client and its builder.
+	config struct {
+		// driver used for executing database requests.
+		driver dialect.Driver
+		// debug enable a debug logging.
+		debug bool
+		// log used for logging on debug mode.
+		log func(...any)
+		// hooks to execute on mutations.
+		hooks *hooks
+		// interceptors to execute on queries.
+		inters *inters
+	}
+	// Option function to configure the client.
+	Option func(*config)
+)
+
+// newConfig creates a new config for the client.
+func newConfig(opts ...Option) config {
+	cfg := config{log: log.Println, hooks: &hooks{}, inters: &inters{}}
+	cfg.options(opts...)
+	return cfg
+}
+
+// options applies the options on the config object.
+func (c *config) options(opts ...Option) {
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.debug {
+		c.driver = dialect.Debug(c.driver, c.log)
+	}
+}
+
+// Debug enables debug logging on the ent.Driver.
+func Debug() Option {
+	return func(c *config) {
+		c.debug = true
+	}
+}
+
+// Log sets the logging function for debug mode.
+func Log(fn func(...any)) Option {
+	return func(c *config) {
+		c.log = fn
+	}
+}
+
+// Driver configures the client driver.
+func Driver(driver dialect.Driver) Option {
+	return func(c *config) {
+		c.driver = driver
+	}
+}
+
+// Open opens a database/sql.DB specified by the driver name and
+// the data source name, and returns a new client attached to it.
+// Optional parameters can be added for configuring the client.
+func Open(driverName, dataSourceName string, options ...Option) (*Client, error) {
+	switch driverName {
+	case dialect.MySQL, dialect.Postgres, dialect.SQLite:
+		drv, err := sql.Open(driverName, dataSourceName)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(append(options, Driver(drv))...), nil
+	default:
+		return nil, fmt.Errorf("unsupported driver: %q", driverName)
+	}
+}
+
+// ErrTxStarted is returned when trying to start a new transaction from a transactional client.
+var ErrTxStarted = errors.New("ent: cannot start a transaction within a transaction")
+
+// Tx returns a new transactional client. The provided context
+// is used until the transaction is committed or rolled back.
+func (c *Client) Tx(ctx context.Context) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, ErrTxStarted
+	}
+	tx, err := newTx(ctx, c.driver)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = tx
+	return &Tx{
+		ctx:               ctx,
+		config:            cfg,
+		AgentAction:       NewAgentActionClient(cfg),
+		ExternalOutput:    NewExternalOutputClient(cfg),
+		RoutingDecision:   NewRoutingDecisionClient(cfg),
+		SpikeEvent:        NewSpikeEventClient(cfg),
+		WorkflowExecution: NewWorkflowExecutionClient(cfg),
+	}, nil
+}
+
+// BeginTx returns a transactional client with specified options.
+func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	if _, ok := c.driver.(*txDriver); ok {
+		return nil, errors.New("ent: cannot start a transaction within a transaction")
+	}
+	tx, err := c.driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}).BeginTx(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
+	}
+	cfg := c.config
+	cfg.driver = &txDriver{tx: tx, drv: c.driver}
+	return &Tx{
+		ctx:               ctx,
+		config:            cfg,
+		AgentAction:       NewAgentActionClient(cfg),
+		ExternalOutput:    NewExternalOutputClient(cfg),
+		RoutingDecision:   NewRoutingDecisionClient(cfg),
+		SpikeEvent:        NewSpikeEventClient(cfg),
+		WorkflowExecution: NewWorkflowExecutionClient(cfg),
+	}, nil
+}
+
+// Debug returns a new debug-client. It's used to get verbose logging on specific operations.
+//
+//	client.Debug().
+//		AgentAction.
+//		Query().
+//		Count(ctx)
+func (c *Client) Debug() *Client {
+	if c.debug {
+		return c
+	}
+	cfg := c.config
+	cfg.driver = dialect.Debug(c.driver, c.log)
+	client := &Client{config: cfg}
+	client.init()
+	return client
+}
+
+// Close closes the database connection and prevents new queries from starting.
+func (c *Client) Close() error {
+	return c.driver.Close()
+}
+
+// Use adds the mutation hooks to all the entity clients.
+// In order to add hooks to a specific client, call: `client.Node.Use(...)`.
+func (c *Client) Use(hooks ...Hook) {
+	c.AgentAction.Use(hooks...)
+	c.ExternalOutput.Use(hooks...)
+	c.RoutingDecision.Use(hooks...)
+	c.SpikeEvent.Use(hooks...)
+	c.WorkflowExecution.Use(hooks...)
+}
+
+// Intercept adds the query interceptors to all the entity clients.
+// In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
+func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.AgentAction.Intercept(interceptors...)
+	c.ExternalOutput.Intercept(interceptors...)
+	c.RoutingDecision.Intercept(interceptors...)
+	c.SpikeEvent.Intercept(interceptors...)
+	c.WorkflowExecution.Intercept(interceptors...)
+}
+
+// Mutate implements the ent.Mutator interface.
+func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
+	switch m := m.(type) {
+	case *AgentActionMutation:
+		return c.AgentAction.mutate(ctx, m)
+	case *ExternalOutputMutation:
+		return c.ExternalOutput.mutate(ctx, m)
+	case *RoutingDecisionMutation:
+		return c.RoutingDecision.mutate(ctx, m)
+	case *SpikeEventMutation:
+		return c.SpikeEvent.mutate(ctx, m)
+	case *WorkflowExecutionMutation:
+		return c.WorkflowExecution.mutate(ctx, m)
+	default:
+		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
+	}
+}
+
+// AgentActionClient is a client for the AgentAction schema.
+type AgentActionClient struct {
+	config
+}
+
+// NewAgentActionClient returns a client for the AgentAction from the given config.
+func NewAgentActionClient(c config) *AgentActionClient {
+	return &AgentActionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `agentaction.Hooks(f(g(h())))`.
+func (c *AgentActionClient) Use(hooks ...Hook) {
+	c.hooks.AgentAction = append(c.hooks.AgentAction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `agentaction.Intercept(f(g(h())))`.
+func (c *AgentActionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AgentAction = append(c.inters.AgentAction, interceptors...)
+}
+
+// Create returns a builder for creating a AgentAction entity.
+func (c *AgentActionClient) Create() *AgentActionCreate {
+	mutation := newAgentActionMutation(c.config, OpCreate)
+	return &AgentActionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AgentAction entities.
+func (c *AgentActionClient) CreateBulk(builders ...*AgentActionCreate) *AgentActionCreateBulk {
+	return &AgentActionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AgentActionClient) MapCreateBulk(slice any, setFunc func(*AgentActionCreate, int)) *AgentActionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AgentActionCreateBulk{err: fmt.Errorf("calling to AgentActionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AgentActionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AgentActionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AgentAction.
+func (c *AgentActionClient) Update() *AgentActionUpdate {
+	mutation := newAgentActionMutation(c.config, OpUpdate)
+	return &AgentActionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AgentActionClient) UpdateOne(aa *AgentAction) *AgentActionUpdateOne {
+	mutation := newAgentActionMutation(c.config, OpUpdateOne, withAgentAction(aa))
+	return &AgentActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AgentActionClient) UpdateOneID(id string) *AgentActionUpdateOne {
+	mutation := newAgentActionMutation(c.config, OpUpdateOne, withAgentActionID(id))
+	return &AgentActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AgentAction.
+func (c *AgentActionClient) Delete() *AgentActionDelete {
+	mutation := newAgentActionMutation(c.config, OpDelete)
+	return &AgentActionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AgentActionClient) DeleteOne(aa *AgentAction) *AgentActionDeleteOne {
+	return c.DeleteOneID(aa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AgentActionClient) DeleteOneID(id string) *AgentActionDeleteOne {
+	builder := c.Delete().Where(agentaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AgentActionDeleteOne{builder}
+}
+
+// Query returns a query builder for AgentAction.
+func (c *AgentActionClient) Query() *AgentActionQuery {
+	return &AgentActionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAgentAction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AgentAction entity by its id.
+func (c *AgentActionClient) Get(ctx context.Context, id string) (*AgentAction, error) {
+	return c.Query().Where(agentaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AgentActionClient) GetX(ctx context.Context, id string) *AgentAction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDecisions queries the decisions edge of a AgentAction.
+func (c *AgentActionClient) QueryDecisions(aa *AgentAction) *RoutingDecisionQuery {
+	query := (&RoutingDecisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := aa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(agentaction.Table, agentaction.FieldID, id),
+			sqlgraph.To(routingdecision.Table, routingdecision.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, agentaction.DecisionsTable, agentaction.DecisionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(aa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWorkflows queries the workflows edge of a AgentAction.
+func (c *AgentActionClient) QueryWorkflows(aa *AgentAction) *WorkflowExecutionQuery {
+	query := (&WorkflowExecutionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := aa.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(agentaction.Table, agentaction.FieldID, id),
+			sqlgraph.To(workflowexecution.Table, workflowexecution.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, agentaction.WorkflowsTable, agentaction.WorkflowsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(aa.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AgentActionClient) Hooks() []Hook {
+	return c.hooks.AgentAction
+}
+
+// Interceptors returns the client interceptors.
+func (c *AgentActionClient) Interceptors() []Interceptor {
+	return c.inters.AgentAction
+}
+
+func (c *AgentActionClient) mutate(ctx context.Context, m *AgentActionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AgentActionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AgentActionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AgentActionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AgentActionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AgentAction mutation op: %q", m.Op())
+	}
+}
+
+// ExternalOutputClient is a client for the ExternalOutput schema.
+type ExternalOutputClient struct {
+	config
+}
+
+// NewExternalOutputClient returns a client for the ExternalOutput from the given config.
+func NewExternalOutputClient(c config) *ExternalOutputClient {
+	return &ExternalOutputClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `externaloutput.Hooks(f(g(h())))`.
+func (c *ExternalOutputClient) Use(hooks ...Hook) {
+	c.hooks.ExternalOutput = append(c.hooks.ExternalOutput, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `externaloutput.Intercept(f(g(h())))`.
+func (c *ExternalOutputClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExternalOutput = append(c.inters.ExternalOutput, interceptors...)
+}
+
+// Create returns a builder for creating a ExternalOutput entity.
+func (c *ExternalOutputClient) Create() *ExternalOutputCreate {
+	mutation := newExternalOutputMutation(c.config, OpCreate)
+	return &ExternalOutputCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExternalOutput entities.
+func (c *ExternalOutputClient) CreateBulk(builders ...*ExternalOutputCreate) *ExternalOutputCreateBulk {
+	return &ExternalOutputCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExternalOutputClient) MapCreateBulk(slice any, setFunc func(*ExternalOutputCreate, int)) *ExternalOutputCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExternalOutputCreateBulk{err: fmt.Errorf("calling to ExternalOutputClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExternalOutputCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExternalOutputCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExternalOutput.
+func (c *ExternalOutputClient) Update() *ExternalOutputUpdate {
+	mutation := newExternalOutputMutation(c.config, OpUpdate)
+	return &ExternalOutputUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExternalOutputClient) UpdateOne(eo *ExternalOutput) *ExternalOutputUpdateOne {
+	mutation := newExternalOutputMutation(c.config, OpUpdateOne, withExternalOutput(eo))
+	return &ExternalOutputUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExternalOutputClient) UpdateOneID(id string) *ExternalOutputUpdateOne {
+	mutation := newExternalOutputMutation(c.config, OpUpdateOne, withExternalOutputID(id))
+	return &ExternalOutputUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExternalOutput.
+func (c *ExternalOutputClient) Delete() *ExternalOutputDelete {
+	mutation := newExternalOutputMutation(c.config, OpDelete)
+	return &ExternalOutputDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExternalOutputClient) DeleteOne(eo *ExternalOutput) *ExternalOutputDeleteOne {
+	return c.DeleteOneID(eo.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExternalOutputClient) DeleteOneID(id string) *ExternalOutputDeleteOne {
+	builder := c.Delete().Where(externaloutput.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExternalOutputDeleteOne{builder}
+}
+
+// Query returns a query builder for ExternalOutput.
+func (c *ExternalOutputClient) Query() *ExternalOutputQuery {
+	return &ExternalOutputQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExternalOutput},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExternalOutput entity by its id.
+func (c *ExternalOutputClient) Get(ctx context.Context, id string) (*ExternalOutput, error) {
+	return c.Query().Where(externaloutput.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExternalOutputClient) GetX(ctx context.Context, id string) *ExternalOutput {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWorkflows queries the workflows edge of a ExternalOutput.
+func (c *ExternalOutputClient) QueryWorkflows(eo *ExternalOutput) *WorkflowExecutionQuery {
+	query := (&WorkflowExecutionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := eo.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(externaloutput.Table, externaloutput.FieldID, id),
+			sqlgraph.To(workflowexecution.Table, workflowexecution.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, externaloutput.WorkflowsTable, externaloutput.WorkflowsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(eo.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ExternalOutputClient) Hooks() []Hook {
+	return c.hooks.ExternalOutput
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExternalOutputClient) Interceptors() []Interceptor {
+	return c.inters.ExternalOutput
+}
+
+func (c *ExternalOutputClient) mutate(ctx context.Context, m *ExternalOutputMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExternalOutputCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExternalOutputUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExternalOutputUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExternalOutputDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExternalOutput mutation op: %q", m.Op())
+	}
+}
+
+// RoutingDecisionClient is a client for the RoutingDecision schema.
+type RoutingDecisionClient struct {
+	config
+}
+
+// NewRoutingDecisionClient returns a client for the RoutingDecision from the given config.
+func NewRoutingDecisionClient(c config) *RoutingDecisionClient {
+	return &RoutingDecisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `routingdecision.Hooks(f(g(h())))`.
+func (c *RoutingDecisionClient) Use(hooks ...Hook) {
+	c.hooks.RoutingDecision = append(c.hooks.RoutingDecision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `routingdecision.Intercept(f(g(h())))`.
+func (c *RoutingDecisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RoutingDecision = append(c.inters.RoutingDecision, interceptors...)
+}
+
+// Create returns a builder for creating a RoutingDecision entity.
+func (c *RoutingDecisionClient) Create() *RoutingDecisionCreate {
+	mutation := newRoutingDecisionMutation(c.config, OpCreate)
+	return &RoutingDecisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RoutingDecision entities.
+func (c *RoutingDecisionClient) CreateBulk(builders ...*RoutingDecisionCreate) *RoutingDecisionCreateBulk {
+	return &RoutingDecisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RoutingDecisionClient) MapCreateBulk(slice any, setFunc func(*RoutingDecisionCreate, int)) *RoutingDecisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RoutingDecisionCreateBulk{err: fmt.Errorf("calling to RoutingDecisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RoutingDecisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RoutingDecisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RoutingDecision.
+func (c *RoutingDecisionClient) Update() *RoutingDecisionUpdate {
+	mutation := newRoutingDecisionMutation(c.config, OpUpdate)
+	return &RoutingDecisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RoutingDecisionClient) UpdateOne(rd *RoutingDecision) *RoutingDecisionUpdateOne {
+	mutation := newRoutingDecisionMutation(c.config, OpUpdateOne, withRoutingDecision(rd))
+	return &RoutingDecisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RoutingDecisionClient) UpdateOneID(id string) *RoutingDecisionUpdateOne {
+	mutation := newRoutingDecisionMutation(c.config, OpUpdateOne, withRoutingDecisionID(id))
+	return &RoutingDecisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RoutingDecision.
+func (c *RoutingDecisionClient) Delete() *RoutingDecisionDelete {
+	mutation := newRoutingDecisionMutation(c.config, OpDelete)
+	return &RoutingDecisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RoutingDecisionClient) DeleteOne(rd *RoutingDecision) *RoutingDecisionDeleteOne {
+	return c.DeleteOneID(rd.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RoutingDecisionClient) DeleteOneID(id string) *RoutingDecisionDeleteOne {
+	builder := c.Delete().Where(routingdecision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RoutingDecisionDeleteOne{builder}
+}
+
+// Query returns a query builder for RoutingDecision.
+func (c *RoutingDecisionClient) Query() *RoutingDecisionQuery {
+	return &RoutingDecisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRoutingDecision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RoutingDecision entity by its id.
+func (c *RoutingDecisionClient) Get(ctx context.Context, id string) (*RoutingDecision, error) {
+	return c.Query().Where(routingdecision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RoutingDecisionClient) GetX(ctx context.Context, id string) *RoutingDecision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySpikeEvents queries the spike_events edge of a RoutingDecision.
+func (c *RoutingDecisionClient) QuerySpikeEvents(rd *RoutingDecision) *SpikeEventQuery {
+	query := (&SpikeEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(routingdecision.Table, routingdecision.FieldID, id),
+			sqlgraph.To(spikeevent.Table, spikeevent.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, routingdecision.SpikeEventsTable, routingdecision.SpikeEventsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(rd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryActions queries the actions edge of a RoutingDecision.
+func (c *RoutingDecisionClient) QueryActions(rd *RoutingDecision) *AgentActionQuery {
+	query := (&AgentActionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(routingdecision.Table, routingdecision.FieldID, id),
+			sqlgraph.To(agentaction.Table, agentaction.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, routingdecision.ActionsTable, routingdecision.ActionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(rd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RoutingDecisionClient) Hooks() []Hook {
+	return c.hooks.RoutingDecision
+}
+
+// Interceptors returns the client interceptors.
+func (c *RoutingDecisionClient) Interceptors() []Interceptor {
+	return c.inters.RoutingDecision
+}
+
+func (c *RoutingDecisionClient) mutate(ctx context.Context, m *RoutingDecisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RoutingDecisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RoutingDecisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RoutingDecisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RoutingDecisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RoutingDecision mutation op: %q", m.Op())
+	}
+}
+
+// SpikeEventClient is a client for the SpikeEvent schema.
+type SpikeEventClient struct {
+	config
+}
+
+// NewSpikeEventClient returns a client for the SpikeEvent from the given config.
+func NewSpikeEventClient(c config) *SpikeEventClient {
+	return &SpikeEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `spikeevent.Hooks(f(g(h())))`.
+func (c *SpikeEventClient) Use(hooks ...Hook) {
+	c.hooks.SpikeEvent = append(c.hooks.SpikeEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `spikeevent.Intercept(f(g(h())))`.
+func (c *SpikeEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SpikeEvent = append(c.inters.SpikeEvent, interceptors...)
+}
+
+// Create returns a builder for creating a SpikeEvent entity.
+func (c *SpikeEventClient) Create() *SpikeEventCreate {
+	mutation := newSpikeEventMutation(c.config, OpCreate)
+	return &SpikeEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SpikeEvent entities.
+func (c *SpikeEventClient) CreateBulk(builders ...*SpikeEventCreate) *SpikeEventCreateBulk {
+	return &SpikeEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SpikeEventClient) MapCreateBulk(slice any, setFunc func(*SpikeEventCreate, int)) *SpikeEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SpikeEventCreateBulk{err: fmt.Errorf("calling to SpikeEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SpikeEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SpikeEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SpikeEvent.
+func (c *SpikeEventClient) Update() *SpikeEventUpdate {
+	mutation := newSpikeEventMutation(c.config, OpUpdate)
+	return &SpikeEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SpikeEventClient) UpdateOne(se *SpikeEvent) *SpikeEventUpdateOne {
+	mutation := newSpikeEventMutation(c.config, OpUpdateOne, withSpikeEvent(se))
+	return &SpikeEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SpikeEventClient) UpdateOneID(id string) *SpikeEventUpdateOne {
+	mutation := newSpikeEventMutation(c.config, OpUpdateOne, withSpikeEventID(id))
+	return &SpikeEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SpikeEvent.
+func (c *SpikeEventClient) Delete() *SpikeEventDelete {
+	mutation := newSpikeEventMutation(c.config, OpDelete)
+	return &SpikeEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SpikeEventClient) DeleteOne(se *SpikeEvent) *SpikeEventDeleteOne {
+	return c.DeleteOneID(se.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SpikeEventClient) DeleteOneID(id string) *SpikeEventDeleteOne {
+	builder := c.Delete().Where(spikeevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SpikeEventDeleteOne{builder}
+}
+
+// Query returns a query builder for SpikeEvent.
+func (c *SpikeEventClient) Query() *SpikeEventQuery {
+	return &SpikeEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSpikeEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SpikeEvent entity by its id.
+func (c *SpikeEventClient) Get(ctx context.Context, id string) (*SpikeEvent, error) {
+	return c.Query().Where(spikeevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SpikeEventClient) GetX(ctx context.Context, id string) *SpikeEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDecisions queries the decisions edge of a SpikeEvent.
+func (c *SpikeEventClient) QueryDecisions(se *SpikeEvent) *RoutingDecisionQuery {
+	query := (&RoutingDecisionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := se.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(spikeevent.Table, spikeevent.FieldID, id),
+			sqlgraph.To(routingdecision.Table, routingdecision.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, spikeevent.DecisionsTable, spikeevent.DecisionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(se.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SpikeEventClient) Hooks() []Hook {
+	return c.hooks.SpikeEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *SpikeEventClient) Interceptors() []Interceptor {
+	return c.inters.SpikeEvent
+}
+
+func (c *SpikeEventClient) mutate(ctx context.Context, m *SpikeEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SpikeEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SpikeEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SpikeEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SpikeEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SpikeEvent mutation op: %q", m.Op())
+	}
+}
+
+// WorkflowExecutionClient is a client for the WorkflowExecution schema.
+type WorkflowExecutionClient struct {
+	config
+}
+
+// NewWorkflowExecutionClient returns a client for the WorkflowExecution from the given config.
+func NewWorkflowExecutionClient(c config) *WorkflowExecutionClient {
+	return &WorkflowExecutionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `workflowexecution.Hooks(f(g(h())))`.
+func (c *WorkflowExecutionClient) Use(hooks ...Hook) {
+	c.hooks.WorkflowExecution = append(c.hooks.WorkflowExecution, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `workflowexecution.Intercept(f(g(h())))`.
+func (c *WorkflowExecutionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WorkflowExecution = append(c.inters.WorkflowExecution, interceptors...)
+}
+
+// Create returns a builder for creating a WorkflowExecution entity.
+func (c *WorkflowExecutionClient) Create() *WorkflowExecutionCreate {
+	mutation := newWorkflowExecutionMutation(c.config, OpCreate)
+	return &WorkflowExecutionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WorkflowExecution entities.
+func (c *WorkflowExecutionClient) CreateBulk(builders ...*WorkflowExecutionCreate) *WorkflowExecutionCreateBulk {
+	return &WorkflowExecutionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WorkflowExecutionClient) MapCreateBulk(slice any, setFunc func(*WorkflowExecutionCreate, int)) *WorkflowExecutionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WorkflowExecutionCreateBulk{err: fmt.Errorf("calling to WorkflowExecutionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WorkflowExecutionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WorkflowExecutionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WorkflowExecution.
+func (c *WorkflowExecutionClient) Update() *WorkflowExecutionUpdate {
+	mutation := newWorkflowExecutionMutation(c.config, OpUpdate)
+	return &WorkflowExecutionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WorkflowExecutionClient) UpdateOne(we *WorkflowExecution) *WorkflowExecutionUpdateOne {
+	mutation := newWorkflowExecutionMutation(c.config, OpUpdateOne, withWorkflowExecution(we))
+	return &WorkflowExecutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WorkflowExecutionClient) UpdateOneID(id string) *WorkflowExecutionUpdateOne {
+	mutation := newWorkflowExecutionMutation(c.config, OpUpdateOne, withWorkflowExecutionID(id))
+	return &WorkflowExecutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WorkflowExecution.
+func (c *WorkflowExecutionClient) Delete() *WorkflowExecutionDelete {
+	mutation := newWorkflowExecutionMutation(c.config, OpDelete)
+	return &WorkflowExecutionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WorkflowExecutionClient) DeleteOne(we *WorkflowExecution) *WorkflowExecutionDeleteOne {
+	return c.DeleteOneID(we.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WorkflowExecutionClient) DeleteOneID(id string) *WorkflowExecutionDeleteOne {
+	builder := c.Delete().Where(workflowexecution.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WorkflowExecutionDeleteOne{builder}
+}
+
+// Query returns a query builder for WorkflowExecution.
+func (c *WorkflowExecutionClient) Query() *WorkflowExecutionQuery {
+	return &WorkflowExecutionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWorkflowExecution},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WorkflowExecution entity by its id.
+func (c *WorkflowExecutionClient) Get(ctx context.Context, id string) (*WorkflowExecution, error) {
+	return c.Query().Where(workflowexecution.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WorkflowExecutionClient) GetX(ctx context.Context, id string) *WorkflowExecution {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryActions queries the actions edge of a WorkflowExecution.
+func (c *WorkflowExecutionClient) QueryActions(we *WorkflowExecution) *AgentActionQuery {
+	query := (&AgentActionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := we.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflowexecution.Table, workflowexecution.FieldID, id),
+			sqlgraph.To(agentaction.Table, agentaction.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, workflowexecution.ActionsTable, workflowexecution.ActionsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(we.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOutputs queries the outputs edge of a WorkflowExecution.
+func (c *WorkflowExecutionClient) QueryOutputs(we *WorkflowExecution) *ExternalOutputQuery {
+	query := (&ExternalOutputClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := we.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflowexecution.Table, workflowexecution.FieldID, id),
+			sqlgraph.To(externaloutput.Table, externaloutput.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, workflowexecution.OutputsTable, workflowexecution.OutputsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(we.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParentExecution queries the parent_execution edge of a WorkflowExecution.
+func (c *WorkflowExecutionClient) QueryParentExecution(we *WorkflowExecution) *WorkflowExecutionQuery {
+	query := (&WorkflowExecutionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := we.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflowexecution.Table, workflowexecution.FieldID, id),
+			sqlgraph.To(workflowexecution.Table, workflowexecution.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, workflowexecution.ParentExecutionTable, workflowexecution.ParentExecutionColumn),
+		)
+		fromV = sqlgraph.Neighbors(we.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildExecutions queries the child_executions edge of a WorkflowExecution.
+func (c *WorkflowExecutionClient) QueryChildExecutions(we *WorkflowExecution) *WorkflowExecutionQuery {
+	query := (&WorkflowExecutionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := we.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflowexecution.Table, workflowexecution.FieldID, id),
+			sqlgraph.To(workflowexecution.Table, workflowexecution.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workflowexecution.ChildExecutionsTable, workflowexecution.ChildExecutionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(we.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WorkflowExecutionClient) Hooks() []Hook {
+	return c.hooks.WorkflowExecution
+}
+
+// Interceptors returns the client interceptors.
+func (c *WorkflowExecutionClient) Interceptors() []Interceptor {
+	return c.inters.WorkflowExecution
+}
+
+func (c *WorkflowExecutionClient) mutate(ctx context.Context, m *WorkflowExecutionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WorkflowExecutionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WorkflowExecutionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WorkflowExecutionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WorkflowExecutionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WorkflowExecution mutation op: %q", m.Op())
+	}
+}
+
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		AgentAction, ExternalOutput, RoutingDecision, SpikeEvent,
+		WorkflowExecution []ent.Hook
+	}
+	inters struct {
+		AgentAction, ExternalOutput, RoutingDecision, SpikeEvent,
+		WorkflowExecution []ent.Interceptor
+	}
+)
@@ -0,0 +1,322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// ExternalOutput is the model entity for the ExternalOutput schema.
+type ExternalOutput struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Unique output identifier
+	ID string `json:"id,omitempty"`
+	// Tamper-evident digest over the record and its parents
+	ProvenanceHash string `json:"provenance_hash,omitempty"`
+	// When output was produced
+	Timestamp time.Time `json:"timestamp,omitempty"`
+	// Type of external output
+	OutputType externaloutput.OutputType `json:"output_type,omitempty"`
+	// Destination system/service
+	Destination string `json:"destination,omitempty"`
+	// ID in the destination system
+	DestinationID string `json:"destination_id,omitempty"`
+	// Transaction ID for blockchain outputs
+	TransactionID string `json:"transaction_id,omitempty"`
+	// Block hash for blockchain outputs
+	BlockHash string `json:"block_hash,omitempty"`
+	// Block number for blockchain outputs
+	BlockNumber int64 `json:"block_number,omitempty"`
+	// Hash of the output content
+	ContentHash string `json:"content_hash,omitempty"`
+	// Size of the output in bytes
+	ContentSize int64 `json:"content_size,omitempty"`
+	// Status of the output
+	Status externaloutput.Status `json:"status,omitempty"`
+	// Domain context (trading, medical, etc.)
+	Domain string `json:"domain,omitempty"`
+	// Compliance metadata (SEC, HIPAA, etc.)
+	Compliance map[string]interface{} `json:"compliance,omitempty"`
+	// Retention period in years
+	RetentionYears int `json:"retention_years,omitempty"`
+	// Additional metadata
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ExternalOutputQuery when eager-loading is set.
+	Edges        ExternalOutputEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ExternalOutputEdges holds the relations/edges for other nodes in the graph.
+type ExternalOutputEdges struct {
+	// Workflow executions that produced this output
+	Workflows []*WorkflowExecution `json:"workflows,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+
+	namedWorkflows map[string][]*WorkflowExecution
+}
+
+// WorkflowsOrErr returns the Workflows value or an error if the edge
+// was not loaded in eager-loading.
+func (e ExternalOutputEdges) WorkflowsOrErr() ([]*WorkflowExecution, error) {
+	if e.loadedTypes[0] {
+		return e.Workflows, nil
+	}
+	return nil, &NotLoadedError{edge: "workflows"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ExternalOutput) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case externaloutput.FieldCompliance, externaloutput.FieldMetadata:
+			values[i] = new([]byte)
+		case externaloutput.FieldBlockNumber, externaloutput.FieldContentSize, externaloutput.FieldRetentionYears:
+			values[i] = new(sql.NullInt64)
+		case externaloutput.FieldID, externaloutput.FieldProvenanceHash, externaloutput.FieldOutputType, externaloutput.FieldDestination, externaloutput.FieldDestinationID, externaloutput.FieldTransactionID, externaloutput.FieldBlockHash, externaloutput.FieldContentHash, externaloutput.FieldStatus, externaloutput.FieldDomain:
+			values[i] = new(sql.NullString)
+		case externaloutput.FieldTimestamp:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ExternalOutput fields.
+func (eo *ExternalOutput) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case externaloutput.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				eo.ID = value.String
+			}
+		case externaloutput.FieldProvenanceHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provenance_hash", values[i])
+			} else if value.Valid {
+				eo.ProvenanceHash = value.String
+			}
+		case externaloutput.FieldTimestamp:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
+			} else if value.Valid {
+				eo.Timestamp = value.Time
+			}
+		case externaloutput.FieldOutputType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field output_type", values[i])
+			} else if value.Valid {
+				eo.OutputType = externaloutput.OutputType(value.String)
+			}
+		case externaloutput.FieldDestination:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field destination", values[i])
+			} else if value.Valid {
+				eo.Destination = value.String
+			}
+		case externaloutput.FieldDestinationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field destination_id", values[i])
+			} else if value.Valid {
+				eo.DestinationID = value.String
+			}
+		case externaloutput.FieldTransactionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
+			} else if value.Valid {
+				eo.TransactionID = value.String
+			}
+		case externaloutput.FieldBlockHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field block_hash", values[i])
+			} else if value.Valid {
+				eo.BlockHash = value.String
+			}
+		case externaloutput.FieldBlockNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field block_number", values[i])
+			} else if value.Valid {
+				eo.BlockNumber = value.Int64
+			}
+		case externaloutput.FieldContentHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_hash", values[i])
+			} else if value.Valid {
+				eo.ContentHash = value.String
+			}
+		case externaloutput.FieldContentSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field content_size", values[i])
+			} else if value.Valid {
+				eo.ContentSize = value.Int64
+			}
+		case externaloutput.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				eo.Status = externaloutput.Status(value.String)
+			}
+		case externaloutput.FieldDomain:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field domain", values[i])
+			} else if value.Valid {
+				eo.Domain = value.String
+			}
+		case externaloutput.FieldCompliance:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field compliance", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &eo.Compliance); err != nil {
+					return fmt.Errorf("unmarshal field compliance: %w", err)
+				}
+			}
+		case externaloutput.FieldRetentionYears:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field retention_years", values[i])
+			} else if value.Valid {
+				eo.RetentionYears = int(value.Int64)
+			}
+		case externaloutput.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &eo.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		default:
+			eo.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ExternalOutput.
+// This includes values selected through modifiers, order, etc.
+func (eo *ExternalOutput) Value(name string) (ent.Value, error) {
+	return eo.selectValues.Get(name)
+}
+
+// QueryWorkflows queries the "workflows" edge of the ExternalOutput entity.
+func (eo *ExternalOutput) QueryWorkflows() *WorkflowExecutionQuery {
+	return NewExternalOutputClient(eo.config).QueryWorkflows(eo)
+}
+
+// Update returns a builder for updating this ExternalOutput.
+// Note that you need to call ExternalOutput.Unwrap() before calling this method if this ExternalOutput
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (eo *ExternalOutput) Update() *ExternalOutputUpdateOne {
+	return NewExternalOutputClient(eo.config).UpdateOne(eo)
+}
+
+// Unwrap unwraps the ExternalOutput entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (eo *ExternalOutput) Unwrap() *ExternalOutput {
+	_tx, ok := eo.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ExternalOutput is not a transactional entity")
+	}
+	eo.config.driver = _tx.drv
+	return eo
+}
+
+// String implements the fmt.Stringer.
+func (eo *ExternalOutput) String() string {
+	var builder strings.Builder
+	builder.WriteString("ExternalOutput(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", eo.ID))
+	builder.WriteString("provenance_hash=")
+	builder.WriteString(eo.ProvenanceHash)
+	builder.WriteString(", ")
+	builder.WriteString("timestamp=")
+	builder.WriteString(eo.Timestamp.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("output_type=")
+	builder.WriteString(fmt.Sprintf("%v", eo.OutputType))
+	builder.WriteString(", ")
+	builder.WriteString("destination=")
+	builder.WriteString(eo.Destination)
+	builder.WriteString(", ")
+	builder.WriteString("destination_id=")
+	builder.WriteString(eo.DestinationID)
+	builder.WriteString(", ")
+	builder.WriteString("transaction_id=")
+	builder.WriteString(eo.TransactionID)
+	builder.WriteString(", ")
+	builder.WriteString("block_hash=")
+	builder.WriteString(eo.BlockHash)
+	builder.WriteString(", ")
+	builder.WriteString("block_number=")
+	builder.WriteString(fmt.Sprintf("%v", eo.BlockNumber))
+	builder.WriteString(", ")
+	builder.WriteString("content_hash=")
+	builder.WriteString(eo.ContentHash)
+	builder.WriteString(", ")
+	builder.WriteString("content_size=")
+	builder.WriteString(fmt.Sprintf("%v", eo.ContentSize))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", eo.Status))
+	builder.WriteString(", ")
+	builder.WriteString("domain=")
+	builder.WriteString(eo.Domain)
+	builder.WriteString(", ")
+	builder.WriteString("compliance=")
+	builder.WriteString(fmt.Sprintf("%v", eo.Compliance))
+	builder.WriteString(", ")
+	builder.WriteString("retention_years=")
+	builder.WriteString(fmt.Sprintf("%v", eo.RetentionYears))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", eo.Metadata))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedWorkflows returns the Workflows named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (eo *ExternalOutput) NamedWorkflows(name string) ([]*WorkflowExecution, error) {
+	if eo.Edges.namedWorkflows == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := eo.Edges.namedWorkflows[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (eo *ExternalOutput) appendNamedWorkflows(name string, edges ...*WorkflowExecution) {
+	if eo.Edges.namedWorkflows == nil {
+		eo.Edges.namedWorkflows = make(map[string][]*WorkflowExecution)
+	}
+	if len(edges) == 0 {
+		eo.Edges.namedWorkflows[name] = []*WorkflowExecution{}
+	} else {
+		eo.Edges.namedWorkflows[name] = append(eo.Edges.namedWorkflows[name], edges...)
+	}
+}
+
+// ExternalOutputs is a parsable slice of ExternalOutput.
+type ExternalOutputs []*ExternalOutput
@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package externaloutput
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the externaloutput type in the database.
+	Label = "external_output"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProvenanceHash holds the string denoting the provenance_hash field in the database.
+	FieldProvenanceHash = "provenance_hash"
+	// FieldTimestamp holds the string denoting the timestamp field in the database.
+	FieldTimestamp = "timestamp"
+	// FieldOutputType holds the string denoting the output_type field in the database.
+	FieldOutputType = "output_type"
+	// FieldDestination holds the string denoting the destination field in the database.
+	FieldDestination = "destination"
+	// FieldDestinationID holds the string denoting the destination_id field in the database.
+	FieldDestinationID = "destination_id"
+	// FieldTransactionID holds the string denoting the transaction_id field in the database.
+	FieldTransactionID = "transaction_id"
+	// FieldBlockHash holds the string denoting the block_hash field in the database.
+	FieldBlockHash = "block_hash"
+	// FieldBlockNumber holds the string denoting the block_number field in the database.
+	FieldBlockNumber = "block_number"
+	// FieldContentHash holds the string denoting the content_hash field in the database.
+	FieldContentHash = "content_hash"
+	// FieldContentSize holds the string denoting the content_size field in the database.
+	FieldContentSize = "content_size"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldDomain holds the string denoting the domain field in the database.
+	FieldDomain = "domain"
+	// FieldCompliance holds the string denoting the compliance field in the database.
+	FieldCompliance = "compliance"
+	// FieldRetentionYears holds the string denoting the retention_years field in the database.
+	FieldRetentionYears = "retention_years"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// EdgeWorkflows holds the string denoting the workflows edge name in mutations.
+	EdgeWorkflows = "workflows"
+	// Table holds the table name of the externaloutput in the database.
+	Table = "external_outputs"
+	// WorkflowsTable is the table that holds the workflows relation/edge. The primary key declared below.
+	WorkflowsTable = "workflow_execution_outputs"
+	// WorkflowsInverseTable is the table name for the WorkflowExecution entity.
+	// It exists in this package in order to avoid circular dependency with the "workflowexecution" package.
+	WorkflowsInverseTable = "workflow_executions"
+)
+
+// Columns holds all SQL columns for externaloutput fields.
+var Columns = []string{
+	FieldID,
+	FieldProvenanceHash,
+	FieldTimestamp,
+	FieldOutputType,
+	FieldDestination,
+	FieldDestinationID,
+	FieldTransactionID,
+	FieldBlockHash,
+	FieldBlockNumber,
+	FieldContentHash,
+	FieldContentSize,
+	FieldStatus,
+	FieldDomain,
+	FieldCompliance,
+	FieldRetentionYears,
+	FieldMetadata,
+}
+
+var (
+	// WorkflowsPrimaryKey and WorkflowsColumn2 are the table columns denoting the
+	// primary key for the workflows relation (M2M).
+	WorkflowsPrimaryKey = []string{"workflow_execution_id", "external_output_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTimestamp holds the default value on creation for the "timestamp" field.
+	DefaultTimestamp func() time.Time
+	// ContentHashValidator is a validator for the "content_hash" field. It is called by the builders before save.
+	ContentHashValidator func(string) error
+	// DefaultRetentionYears holds the default value on creation for the "retention_years" field.
+	DefaultRetentionYears int
+)
+
+// OutputType defines the type for the "output_type" enum field.
+type OutputType string
+
+// OutputType values.
+const (
+	OutputTypeFoundationTx   OutputType = "foundation_tx"
+	OutputTypeAWSRecord      OutputType = "aws_record"
+	OutputTypeTradeExecution OutputType = "trade_execution"
+	OutputTypeDocument       OutputType = "document"
+	OutputTypeAPIResponse    OutputType = "api_response"
+	OutputTypeNotification   OutputType = "notification"
+	OutputTypeFile           OutputType = "file"
+	OutputTypeOther          OutputType = "other"
+)
+
+func (ot OutputType) String() string {
+	return string(ot)
+}
+
+// OutputTypeValidator is a validator for the "output_type" field enum values. It is called by the builders before save.
+func OutputTypeValidator(ot OutputType) error {
+	switch ot {
+	case OutputTypeFoundationTx, OutputTypeAWSRecord, OutputTypeTradeExecution, OutputTypeDocument, OutputTypeAPIResponse, OutputTypeNotification, OutputTypeFile, OutputTypeOther:
+		return nil
+	default:
+		return fmt.Errorf("externaloutput: invalid enum value for output_type field: %q", ot)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "pending"
+	StatusConfirmed Status = "confirmed"
+	StatusFailed    Status = "failed"
+	StatusReverted  Status = "reverted"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusConfirmed, StatusFailed, StatusReverted:
+		return nil
+	default:
+		return fmt.Errorf("externaloutput: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the ExternalOutput queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProvenanceHash orders the results by the provenance_hash field.
+func ByProvenanceHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvenanceHash, opts...).ToFunc()
+}
+
+// ByTimestamp orders the results by the timestamp field.
+func ByTimestamp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimestamp, opts...).ToFunc()
+}
+
+// ByOutputType orders the results by the output_type field.
+func ByOutputType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutputType, opts...).ToFunc()
+}
+
+// ByDestination orders the results by the destination field.
+func ByDestination(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDestination, opts...).ToFunc()
+}
+
+// ByDestinationID orders the results by the destination_id field.
+func ByDestinationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDestinationID, opts...).ToFunc()
+}
+
+// ByTransactionID orders the results by the transaction_id field.
+func ByTransactionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransactionID, opts...).ToFunc()
+}
+
+// ByBlockHash orders the results by the block_hash field.
+func ByBlockHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockHash, opts...).ToFunc()
+}
+
+// ByBlockNumber orders the results by the block_number field.
+func ByBlockNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlockNumber, opts...).ToFunc()
+}
+
+// ByContentHash orders the results by the content_hash field.
+func ByContentHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentHash, opts...).ToFunc()
+}
+
+// ByContentSize orders the results by the content_size field.
+func ByContentSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentSize, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByDomain orders the results by the domain field.
+func ByDomain(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDomain, opts...).ToFunc()
+}
+
+// ByRetentionYears orders the results by the retention_years field.
+func ByRetentionYears(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRetentionYears, opts...).ToFunc()
+}
+
+// ByWorkflowsCount orders the results by workflows count.
+func ByWorkflowsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWorkflowsStep(), opts...)
+	}
+}
+
+// ByWorkflows orders the results by workflows terms.
+func ByWorkflows(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWorkflowsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newWorkflowsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WorkflowsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, WorkflowsTable, WorkflowsPrimaryKey...),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e OutputType) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *OutputType) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = OutputType(str)
+	if err := OutputTypeValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid OutputType", str)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Status) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Status) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Status(str)
+	if err := StatusValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Status", str)
+	}
+	return nil
+}
@@ -0,0 +1,914 @@
+// Code generated by ent, DO NOT EDIT.
+
+package externaloutput
+
+import (
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldContainsFold(FieldID, id))
+}
+
+// ProvenanceHash applies equality check predicate on the "provenance_hash" field. It's identical to ProvenanceHashEQ.
+func ProvenanceHash(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldProvenanceHash, v))
+}
+
+// Timestamp applies equality check predicate on the "timestamp" field. It's identical to TimestampEQ.
+func Timestamp(v time.Time) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// Destination applies equality check predicate on the "destination" field. It's identical to DestinationEQ.
+func Destination(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldDestination, v))
+}
+
+// DestinationID applies equality check predicate on the "destination_id" field. It's identical to DestinationIDEQ.
+func DestinationID(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldDestinationID, v))
+}
+
+// TransactionID applies equality check predicate on the "transaction_id" field. It's identical to TransactionIDEQ.
+func TransactionID(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// BlockHash applies equality check predicate on the "block_hash" field. It's identical to BlockHashEQ.
+func BlockHash(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldBlockHash, v))
+}
+
+// BlockNumber applies equality check predicate on the "block_number" field. It's identical to BlockNumberEQ.
+func BlockNumber(v int64) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldBlockNumber, v))
+}
+
+// ContentHash applies equality check predicate on the "content_hash" field. It's identical to ContentHashEQ.
+func ContentHash(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ContentSize applies equality check predicate on the "content_size" field. It's identical to ContentSizeEQ.
+func ContentSize(v int64) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldContentSize, v))
+}
+
+// Domain applies equality check predicate on the "domain" field. It's identical to DomainEQ.
+func Domain(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldDomain, v))
+}
+
+// RetentionYears applies equality check predicate on the "retention_years" field. It's identical to RetentionYearsEQ.
+func RetentionYears(v int) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldRetentionYears, v))
+}
+
+// ProvenanceHashEQ applies the EQ predicate on the "provenance_hash" field.
+func ProvenanceHashEQ(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashNEQ applies the NEQ predicate on the "provenance_hash" field.
+func ProvenanceHashNEQ(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNEQ(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashIn applies the In predicate on the "provenance_hash" field.
+func ProvenanceHashIn(vs ...string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIn(FieldProvenanceHash, vs...))
+}
+
+// ProvenanceHashNotIn applies the NotIn predicate on the "provenance_hash" field.
+func ProvenanceHashNotIn(vs ...string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotIn(FieldProvenanceHash, vs...))
+}
+
+// ProvenanceHashGT applies the GT predicate on the "provenance_hash" field.
+func ProvenanceHashGT(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGT(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashGTE applies the GTE predicate on the "provenance_hash" field.
+func ProvenanceHashGTE(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGTE(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashLT applies the LT predicate on the "provenance_hash" field.
+func ProvenanceHashLT(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLT(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashLTE applies the LTE predicate on the "provenance_hash" field.
+func ProvenanceHashLTE(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLTE(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashContains applies the Contains predicate on the "provenance_hash" field.
+func ProvenanceHashContains(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldContains(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashHasPrefix applies the HasPrefix predicate on the "provenance_hash" field.
+func ProvenanceHashHasPrefix(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldHasPrefix(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashHasSuffix applies the HasSuffix predicate on the "provenance_hash" field.
+func ProvenanceHashHasSuffix(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldHasSuffix(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashIsNil applies the IsNil predicate on the "provenance_hash" field.
+func ProvenanceHashIsNil() predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIsNull(FieldProvenanceHash))
+}
+
+// ProvenanceHashNotNil applies the NotNil predicate on the "provenance_hash" field.
+func ProvenanceHashNotNil() predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotNull(FieldProvenanceHash))
+}
+
+// ProvenanceHashEqualFold applies the EqualFold predicate on the "provenance_hash" field.
+func ProvenanceHashEqualFold(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEqualFold(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashContainsFold applies the ContainsFold predicate on the "provenance_hash" field.
+func ProvenanceHashContainsFold(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldContainsFold(FieldProvenanceHash, v))
+}
+
+// TimestampEQ applies the EQ predicate on the "timestamp" field.
+func TimestampEQ(v time.Time) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// TimestampNEQ applies the NEQ predicate on the "timestamp" field.
+func TimestampNEQ(v time.Time) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNEQ(FieldTimestamp, v))
+}
+
+// TimestampIn applies the In predicate on the "timestamp" field.
+func TimestampIn(vs ...time.Time) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIn(FieldTimestamp, vs...))
+}
+
+// TimestampNotIn applies the NotIn predicate on the "timestamp" field.
+func TimestampNotIn(vs ...time.Time) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotIn(FieldTimestamp, vs...))
+}
+
+// TimestampGT applies the GT predicate on the "timestamp" field.
+func TimestampGT(v time.Time) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGT(FieldTimestamp, v))
+}
+
+// TimestampGTE applies the GTE predicate on the "timestamp" field.
+func TimestampGTE(v time.Time) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGTE(FieldTimestamp, v))
+}
+
+// TimestampLT applies the LT predicate on the "timestamp" field.
+func TimestampLT(v time.Time) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLT(FieldTimestamp, v))
+}
+
+// TimestampLTE applies the LTE predicate on the "timestamp" field.
+func TimestampLTE(v time.Time) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLTE(FieldTimestamp, v))
+}
+
+// OutputTypeEQ applies the EQ predicate on the "output_type" field.
+func OutputTypeEQ(v OutputType) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldOutputType, v))
+}
+
+// OutputTypeNEQ applies the NEQ predicate on the "output_type" field.
+func OutputTypeNEQ(v OutputType) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNEQ(FieldOutputType, v))
+}
+
+// OutputTypeIn applies the In predicate on the "output_type" field.
+func OutputTypeIn(vs ...OutputType) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIn(FieldOutputType, vs...))
+}
+
+// OutputTypeNotIn applies the NotIn predicate on the "output_type" field.
+func OutputTypeNotIn(vs ...OutputType) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotIn(FieldOutputType, vs...))
+}
+
+// DestinationEQ applies the EQ predicate on the "destination" field.
+func DestinationEQ(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldDestination, v))
+}
+
+// DestinationNEQ applies the NEQ predicate on the "destination" field.
+func DestinationNEQ(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNEQ(FieldDestination, v))
+}
+
+// DestinationIn applies the In predicate on the "destination" field.
+func DestinationIn(vs ...string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIn(FieldDestination, vs...))
+}
+
+// DestinationNotIn applies the NotIn predicate on the "destination" field.
+func DestinationNotIn(vs ...string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotIn(FieldDestination, vs...))
+}
+
+// DestinationGT applies the GT predicate on the "destination" field.
+func DestinationGT(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGT(FieldDestination, v))
+}
+
+// DestinationGTE applies the GTE predicate on the "destination" field.
+func DestinationGTE(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGTE(FieldDestination, v))
+}
+
+// DestinationLT applies the LT predicate on the "destination" field.
+func DestinationLT(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLT(FieldDestination, v))
+}
+
+// DestinationLTE applies the LTE predicate on the "destination" field.
+func DestinationLTE(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLTE(FieldDestination, v))
+}
+
+// DestinationContains applies the Contains predicate on the "destination" field.
+func DestinationContains(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldContains(FieldDestination, v))
+}
+
+// DestinationHasPrefix applies the HasPrefix predicate on the "destination" field.
+func DestinationHasPrefix(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldHasPrefix(FieldDestination, v))
+}
+
+// DestinationHasSuffix applies the HasSuffix predicate on the "destination" field.
+func DestinationHasSuffix(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldHasSuffix(FieldDestination, v))
+}
+
+// DestinationIsNil applies the IsNil predicate on the "destination" field.
+func DestinationIsNil() predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIsNull(FieldDestination))
+}
+
+// DestinationNotNil applies the NotNil predicate on the "destination" field.
+func DestinationNotNil() predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotNull(FieldDestination))
+}
+
+// DestinationEqualFold applies the EqualFold predicate on the "destination" field.
+func DestinationEqualFold(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEqualFold(FieldDestination, v))
+}
+
+// DestinationContainsFold applies the ContainsFold predicate on the "destination" field.
+func DestinationContainsFold(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldContainsFold(FieldDestination, v))
+}
+
+// DestinationIDEQ applies the EQ predicate on the "destination_id" field.
+func DestinationIDEQ(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldDestinationID, v))
+}
+
+// DestinationIDNEQ applies the NEQ predicate on the "destination_id" field.
+func DestinationIDNEQ(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNEQ(FieldDestinationID, v))
+}
+
+// DestinationIDIn applies the In predicate on the "destination_id" field.
+func DestinationIDIn(vs ...string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIn(FieldDestinationID, vs...))
+}
+
+// DestinationIDNotIn applies the NotIn predicate on the "destination_id" field.
+func DestinationIDNotIn(vs ...string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotIn(FieldDestinationID, vs...))
+}
+
+// DestinationIDGT applies the GT predicate on the "destination_id" field.
+func DestinationIDGT(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGT(FieldDestinationID, v))
+}
+
+// DestinationIDGTE applies the GTE predicate on the "destination_id" field.
+func DestinationIDGTE(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGTE(FieldDestinationID, v))
+}
+
+// DestinationIDLT applies the LT predicate on the "destination_id" field.
+func DestinationIDLT(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLT(FieldDestinationID, v))
+}
+
+// DestinationIDLTE applies the LTE predicate on the "destination_id" field.
+func DestinationIDLTE(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLTE(FieldDestinationID, v))
+}
+
+// DestinationIDContains applies the Contains predicate on the "destination_id" field.
+func DestinationIDContains(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldContains(FieldDestinationID, v))
+}
+
+// DestinationIDHasPrefix applies the HasPrefix predicate on the "destination_id" field.
+func DestinationIDHasPrefix(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldHasPrefix(FieldDestinationID, v))
+}
+
+// DestinationIDHasSuffix applies the HasSuffix predicate on the "destination_id" field.
+func DestinationIDHasSuffix(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldHasSuffix(FieldDestinationID, v))
+}
+
+// DestinationIDIsNil applies the IsNil predicate on the "destination_id" field.
+func DestinationIDIsNil() predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIsNull(FieldDestinationID))
+}
+
+// DestinationIDNotNil applies the NotNil predicate on the "destination_id" field.
+func DestinationIDNotNil() predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotNull(FieldDestinationID))
+}
+
+// DestinationIDEqualFold applies the EqualFold predicate on the "destination_id" field.
+func DestinationIDEqualFold(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEqualFold(FieldDestinationID, v))
+}
+
+// DestinationIDContainsFold applies the ContainsFold predicate on the "destination_id" field.
+func DestinationIDContainsFold(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldContainsFold(FieldDestinationID, v))
+}
+
+// TransactionIDEQ applies the EQ predicate on the "transaction_id" field.
+func TransactionIDEQ(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// TransactionIDNEQ applies the NEQ predicate on the "transaction_id" field.
+func TransactionIDNEQ(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNEQ(FieldTransactionID, v))
+}
+
+// TransactionIDIn applies the In predicate on the "transaction_id" field.
+func TransactionIDIn(vs ...string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDNotIn applies the NotIn predicate on the "transaction_id" field.
+func TransactionIDNotIn(vs ...string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDGT applies the GT predicate on the "transaction_id" field.
+func TransactionIDGT(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGT(FieldTransactionID, v))
+}
+
+// TransactionIDGTE applies the GTE predicate on the "transaction_id" field.
+func TransactionIDGTE(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGTE(FieldTransactionID, v))
+}
+
+// TransactionIDLT applies the LT predicate on the "transaction_id" field.
+func TransactionIDLT(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLT(FieldTransactionID, v))
+}
+
+// TransactionIDLTE applies the LTE predicate on the "transaction_id" field.
+func TransactionIDLTE(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLTE(FieldTransactionID, v))
+}
+
+// TransactionIDContains applies the Contains predicate on the "transaction_id" field.
+func TransactionIDContains(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldContains(FieldTransactionID, v))
+}
+
+// TransactionIDHasPrefix applies the HasPrefix predicate on the "transaction_id" field.
+func TransactionIDHasPrefix(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldHasPrefix(FieldTransactionID, v))
+}
+
+// TransactionIDHasSuffix applies the HasSuffix predicate on the "transaction_id" field.
+func TransactionIDHasSuffix(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldHasSuffix(FieldTransactionID, v))
+}
+
+// TransactionIDIsNil applies the IsNil predicate on the "transaction_id" field.
+func TransactionIDIsNil() predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIsNull(FieldTransactionID))
+}
+
+// TransactionIDNotNil applies the NotNil predicate on the "transaction_id" field.
+func TransactionIDNotNil() predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotNull(FieldTransactionID))
+}
+
+// TransactionIDEqualFold applies the EqualFold predicate on the "transaction_id" field.
+func TransactionIDEqualFold(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEqualFold(FieldTransactionID, v))
+}
+
+// TransactionIDContainsFold applies the ContainsFold predicate on the "transaction_id" field.
+func TransactionIDContainsFold(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldContainsFold(FieldTransactionID, v))
+}
+
+// BlockHashEQ applies the EQ predicate on the "block_hash" field.
+func BlockHashEQ(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldBlockHash, v))
+}
+
+// BlockHashNEQ applies the NEQ predicate on the "block_hash" field.
+func BlockHashNEQ(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNEQ(FieldBlockHash, v))
+}
+
+// BlockHashIn applies the In predicate on the "block_hash" field.
+func BlockHashIn(vs ...string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIn(FieldBlockHash, vs...))
+}
+
+// BlockHashNotIn applies the NotIn predicate on the "block_hash" field.
+func BlockHashNotIn(vs ...string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotIn(FieldBlockHash, vs...))
+}
+
+// BlockHashGT applies the GT predicate on the "block_hash" field.
+func BlockHashGT(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGT(FieldBlockHash, v))
+}
+
+// BlockHashGTE applies the GTE predicate on the "block_hash" field.
+func BlockHashGTE(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGTE(FieldBlockHash, v))
+}
+
+// BlockHashLT applies the LT predicate on the "block_hash" field.
+func BlockHashLT(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLT(FieldBlockHash, v))
+}
+
+// BlockHashLTE applies the LTE predicate on the "block_hash" field.
+func BlockHashLTE(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLTE(FieldBlockHash, v))
+}
+
+// BlockHashContains applies the Contains predicate on the "block_hash" field.
+func BlockHashContains(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldContains(FieldBlockHash, v))
+}
+
+// BlockHashHasPrefix applies the HasPrefix predicate on the "block_hash" field.
+func BlockHashHasPrefix(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldHasPrefix(FieldBlockHash, v))
+}
+
+// BlockHashHasSuffix applies the HasSuffix predicate on the "block_hash" field.
+func BlockHashHasSuffix(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldHasSuffix(FieldBlockHash, v))
+}
+
+// BlockHashIsNil applies the IsNil predicate on the "block_hash" field.
+func BlockHashIsNil() predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIsNull(FieldBlockHash))
+}
+
+// BlockHashNotNil applies the NotNil predicate on the "block_hash" field.
+func BlockHashNotNil() predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotNull(FieldBlockHash))
+}
+
+// BlockHashEqualFold applies the EqualFold predicate on the "block_hash" field.
+func BlockHashEqualFold(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEqualFold(FieldBlockHash, v))
+}
+
+// BlockHashContainsFold applies the ContainsFold predicate on the "block_hash" field.
+func BlockHashContainsFold(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldContainsFold(FieldBlockHash, v))
+}
+
+// BlockNumberEQ applies the EQ predicate on the "block_number" field.
+func BlockNumberEQ(v int64) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldBlockNumber, v))
+}
+
+// BlockNumberNEQ applies the NEQ predicate on the "block_number" field.
+func BlockNumberNEQ(v int64) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNEQ(FieldBlockNumber, v))
+}
+
+// BlockNumberIn applies the In predicate on the "block_number" field.
+func BlockNumberIn(vs ...int64) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIn(FieldBlockNumber, vs...))
+}
+
+// BlockNumberNotIn applies the NotIn predicate on the "block_number" field.
+func BlockNumberNotIn(vs ...int64) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotIn(FieldBlockNumber, vs...))
+}
+
+// BlockNumberGT applies the GT predicate on the "block_number" field.
+func BlockNumberGT(v int64) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGT(FieldBlockNumber, v))
+}
+
+// BlockNumberGTE applies the GTE predicate on the "block_number" field.
+func BlockNumberGTE(v int64) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGTE(FieldBlockNumber, v))
+}
+
+// BlockNumberLT applies the LT predicate on the "block_number" field.
+func BlockNumberLT(v int64) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLT(FieldBlockNumber, v))
+}
+
+// BlockNumberLTE applies the LTE predicate on the "block_number" field.
+func BlockNumberLTE(v int64) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLTE(FieldBlockNumber, v))
+}
+
+// BlockNumberIsNil applies the IsNil predicate on the "block_number" field.
+func BlockNumberIsNil() predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIsNull(FieldBlockNumber))
+}
+
+// BlockNumberNotNil applies the NotNil predicate on the "block_number" field.
+func BlockNumberNotNil() predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotNull(FieldBlockNumber))
+}
+
+// ContentHashEQ applies the EQ predicate on the "content_hash" field.
+func ContentHashEQ(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ContentHashNEQ applies the NEQ predicate on the "content_hash" field.
+func ContentHashNEQ(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNEQ(FieldContentHash, v))
+}
+
+// ContentHashIn applies the In predicate on the "content_hash" field.
+func ContentHashIn(vs ...string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIn(FieldContentHash, vs...))
+}
+
+// ContentHashNotIn applies the NotIn predicate on the "content_hash" field.
+func ContentHashNotIn(vs ...string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotIn(FieldContentHash, vs...))
+}
+
+// ContentHashGT applies the GT predicate on the "content_hash" field.
+func ContentHashGT(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGT(FieldContentHash, v))
+}
+
+// ContentHashGTE applies the GTE predicate on the "content_hash" field.
+func ContentHashGTE(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGTE(FieldContentHash, v))
+}
+
+// ContentHashLT applies the LT predicate on the "content_hash" field.
+func ContentHashLT(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLT(FieldContentHash, v))
+}
+
+// ContentHashLTE applies the LTE predicate on the "content_hash" field.
+func ContentHashLTE(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLTE(FieldContentHash, v))
+}
+
+// ContentHashContains applies the Contains predicate on the "content_hash" field.
+func ContentHashContains(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldContains(FieldContentHash, v))
+}
+
+// ContentHashHasPrefix applies the HasPrefix predicate on the "content_hash" field.
+func ContentHashHasPrefix(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldHasPrefix(FieldContentHash, v))
+}
+
+// ContentHashHasSuffix applies the HasSuffix predicate on the "content_hash" field.
+func ContentHashHasSuffix(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldHasSuffix(FieldContentHash, v))
+}
+
+// ContentHashEqualFold applies the EqualFold predicate on the "content_hash" field.
+func ContentHashEqualFold(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEqualFold(FieldContentHash, v))
+}
+
+// ContentHashContainsFold applies the ContainsFold predicate on the "content_hash" field.
+func ContentHashContainsFold(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldContainsFold(FieldContentHash, v))
+}
+
+// ContentSizeEQ applies the EQ predicate on the "content_size" field.
+func ContentSizeEQ(v int64) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldContentSize, v))
+}
+
+// ContentSizeNEQ applies the NEQ predicate on the "content_size" field.
+func ContentSizeNEQ(v int64) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNEQ(FieldContentSize, v))
+}
+
+// ContentSizeIn applies the In predicate on the "content_size" field.
+func ContentSizeIn(vs ...int64) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIn(FieldContentSize, vs...))
+}
+
+// ContentSizeNotIn applies the NotIn predicate on the "content_size" field.
+func ContentSizeNotIn(vs ...int64) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotIn(FieldContentSize, vs...))
+}
+
+// ContentSizeGT applies the GT predicate on the "content_size" field.
+func ContentSizeGT(v int64) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGT(FieldContentSize, v))
+}
+
+// ContentSizeGTE applies the GTE predicate on the "content_size" field.
+func ContentSizeGTE(v int64) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGTE(FieldContentSize, v))
+}
+
+// ContentSizeLT applies the LT predicate on the "content_size" field.
+func ContentSizeLT(v int64) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLT(FieldContentSize, v))
+}
+
+// ContentSizeLTE applies the LTE predicate on the "content_size" field.
+func ContentSizeLTE(v int64) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLTE(FieldContentSize, v))
+}
+
+// ContentSizeIsNil applies the IsNil predicate on the "content_size" field.
+func ContentSizeIsNil() predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIsNull(FieldContentSize))
+}
+
+// ContentSizeNotNil applies the NotNil predicate on the "content_size" field.
+func ContentSizeNotNil() predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotNull(FieldContentSize))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// DomainEQ applies the EQ predicate on the "domain" field.
+func DomainEQ(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldDomain, v))
+}
+
+// DomainNEQ applies the NEQ predicate on the "domain" field.
+func DomainNEQ(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNEQ(FieldDomain, v))
+}
+
+// DomainIn applies the In predicate on the "domain" field.
+func DomainIn(vs ...string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIn(FieldDomain, vs...))
+}
+
+// DomainNotIn applies the NotIn predicate on the "domain" field.
+func DomainNotIn(vs ...string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotIn(FieldDomain, vs...))
+}
+
+// DomainGT applies the GT predicate on the "domain" field.
+func DomainGT(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGT(FieldDomain, v))
+}
+
+// DomainGTE applies the GTE predicate on the "domain" field.
+func DomainGTE(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGTE(FieldDomain, v))
+}
+
+// DomainLT applies the LT predicate on the "domain" field.
+func DomainLT(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLT(FieldDomain, v))
+}
+
+// DomainLTE applies the LTE predicate on the "domain" field.
+func DomainLTE(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLTE(FieldDomain, v))
+}
+
+// DomainContains applies the Contains predicate on the "domain" field.
+func DomainContains(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldContains(FieldDomain, v))
+}
+
+// DomainHasPrefix applies the HasPrefix predicate on the "domain" field.
+func DomainHasPrefix(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldHasPrefix(FieldDomain, v))
+}
+
+// DomainHasSuffix applies the HasSuffix predicate on the "domain" field.
+func DomainHasSuffix(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldHasSuffix(FieldDomain, v))
+}
+
+// DomainIsNil applies the IsNil predicate on the "domain" field.
+func DomainIsNil() predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIsNull(FieldDomain))
+}
+
+// DomainNotNil applies the NotNil predicate on the "domain" field.
+func DomainNotNil() predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotNull(FieldDomain))
+}
+
+// DomainEqualFold applies the EqualFold predicate on the "domain" field.
+func DomainEqualFold(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEqualFold(FieldDomain, v))
+}
+
+// DomainContainsFold applies the ContainsFold predicate on the "domain" field.
+func DomainContainsFold(v string) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldContainsFold(FieldDomain, v))
+}
+
+// ComplianceIsNil applies the IsNil predicate on the "compliance" field.
+func ComplianceIsNil() predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIsNull(FieldCompliance))
+}
+
+// ComplianceNotNil applies the NotNil predicate on the "compliance" field.
+func ComplianceNotNil() predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotNull(FieldCompliance))
+}
+
+// RetentionYearsEQ applies the EQ predicate on the "retention_years" field.
+func RetentionYearsEQ(v int) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldEQ(FieldRetentionYears, v))
+}
+
+// RetentionYearsNEQ applies the NEQ predicate on the "retention_years" field.
+func RetentionYearsNEQ(v int) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNEQ(FieldRetentionYears, v))
+}
+
+// RetentionYearsIn applies the In predicate on the "retention_years" field.
+func RetentionYearsIn(vs ...int) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIn(FieldRetentionYears, vs...))
+}
+
+// RetentionYearsNotIn applies the NotIn predicate on the "retention_years" field.
+func RetentionYearsNotIn(vs ...int) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotIn(FieldRetentionYears, vs...))
+}
+
+// RetentionYearsGT applies the GT predicate on the "retention_years" field.
+func RetentionYearsGT(v int) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGT(FieldRetentionYears, v))
+}
+
+// RetentionYearsGTE applies the GTE predicate on the "retention_years" field.
+func RetentionYearsGTE(v int) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldGTE(FieldRetentionYears, v))
+}
+
+// RetentionYearsLT applies the LT predicate on the "retention_years" field.
+func RetentionYearsLT(v int) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLT(FieldRetentionYears, v))
+}
+
+// RetentionYearsLTE applies the LTE predicate on the "retention_years" field.
+func RetentionYearsLTE(v int) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldLTE(FieldRetentionYears, v))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.FieldNotNull(FieldMetadata))
+}
+
+// HasWorkflows applies the HasEdge predicate on the "workflows" edge.
+func HasWorkflows() predicate.ExternalOutput {
+	return predicate.ExternalOutput(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, WorkflowsTable, WorkflowsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWorkflowsWith applies the HasEdge predicate on the "workflows" edge with a given conditions (other predicates).
+func HasWorkflowsWith(preds ...predicate.WorkflowExecution) predicate.ExternalOutput {
+	return predicate.ExternalOutput(func(s *sql.Selector) {
+		step := newWorkflowsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ExternalOutput) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ExternalOutput) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ExternalOutput) predicate.ExternalOutput {
+	return predicate.ExternalOutput(sql.NotPredicates(p))
+}
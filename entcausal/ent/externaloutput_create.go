@@ -0,0 +1,500 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExternalOutputCreate is the builder for creating a ExternalOutput entity.
+type ExternalOutputCreate struct {
+	config
+	mutation *ExternalOutputMutation
+	hooks    []Hook
+}
+
+// SetProvenanceHash sets the "provenance_hash" field.
+func (eoc *ExternalOutputCreate) SetProvenanceHash(s string) *ExternalOutputCreate {
+	eoc.mutation.SetProvenanceHash(s)
+	return eoc
+}
+
+// SetNillableProvenanceHash sets the "provenance_hash" field if the given value is not nil.
+func (eoc *ExternalOutputCreate) SetNillableProvenanceHash(s *string) *ExternalOutputCreate {
+	if s != nil {
+		eoc.SetProvenanceHash(*s)
+	}
+	return eoc
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (eoc *ExternalOutputCreate) SetTimestamp(t time.Time) *ExternalOutputCreate {
+	eoc.mutation.SetTimestamp(t)
+	return eoc
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (eoc *ExternalOutputCreate) SetNillableTimestamp(t *time.Time) *ExternalOutputCreate {
+	if t != nil {
+		eoc.SetTimestamp(*t)
+	}
+	return eoc
+}
+
+// SetOutputType sets the "output_type" field.
+func (eoc *ExternalOutputCreate) SetOutputType(et externaloutput.OutputType) *ExternalOutputCreate {
+	eoc.mutation.SetOutputType(et)
+	return eoc
+}
+
+// SetDestination sets the "destination" field.
+func (eoc *ExternalOutputCreate) SetDestination(s string) *ExternalOutputCreate {
+	eoc.mutation.SetDestination(s)
+	return eoc
+}
+
+// SetNillableDestination sets the "destination" field if the given value is not nil.
+func (eoc *ExternalOutputCreate) SetNillableDestination(s *string) *ExternalOutputCreate {
+	if s != nil {
+		eoc.SetDestination(*s)
+	}
+	return eoc
+}
+
+// SetDestinationID sets the "destination_id" field.
+func (eoc *ExternalOutputCreate) SetDestinationID(s string) *ExternalOutputCreate {
+	eoc.mutation.SetDestinationID(s)
+	return eoc
+}
+
+// SetNillableDestinationID sets the "destination_id" field if the given value is not nil.
+func (eoc *ExternalOutputCreate) SetNillableDestinationID(s *string) *ExternalOutputCreate {
+	if s != nil {
+		eoc.SetDestinationID(*s)
+	}
+	return eoc
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (eoc *ExternalOutputCreate) SetTransactionID(s string) *ExternalOutputCreate {
+	eoc.mutation.SetTransactionID(s)
+	return eoc
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (eoc *ExternalOutputCreate) SetNillableTransactionID(s *string) *ExternalOutputCreate {
+	if s != nil {
+		eoc.SetTransactionID(*s)
+	}
+	return eoc
+}
+
+// SetBlockHash sets the "block_hash" field.
+func (eoc *ExternalOutputCreate) SetBlockHash(s string) *ExternalOutputCreate {
+	eoc.mutation.SetBlockHash(s)
+	return eoc
+}
+
+// SetNillableBlockHash sets the "block_hash" field if the given value is not nil.
+func (eoc *ExternalOutputCreate) SetNillableBlockHash(s *string) *ExternalOutputCreate {
+	if s != nil {
+		eoc.SetBlockHash(*s)
+	}
+	return eoc
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (eoc *ExternalOutputCreate) SetBlockNumber(i int64) *ExternalOutputCreate {
+	eoc.mutation.SetBlockNumber(i)
+	return eoc
+}
+
+// SetNillableBlockNumber sets the "block_number" field if the given value is not nil.
+func (eoc *ExternalOutputCreate) SetNillableBlockNumber(i *int64) *ExternalOutputCreate {
+	if i != nil {
+		eoc.SetBlockNumber(*i)
+	}
+	return eoc
+}
+
+// SetContentHash sets the "content_hash" field.
+func (eoc *ExternalOutputCreate) SetContentHash(s string) *ExternalOutputCreate {
+	eoc.mutation.SetContentHash(s)
+	return eoc
+}
+
+// SetContentSize sets the "content_size" field.
+func (eoc *ExternalOutputCreate) SetContentSize(i int64) *ExternalOutputCreate {
+	eoc.mutation.SetContentSize(i)
+	return eoc
+}
+
+// SetNillableContentSize sets the "content_size" field if the given value is not nil.
+func (eoc *ExternalOutputCreate) SetNillableContentSize(i *int64) *ExternalOutputCreate {
+	if i != nil {
+		eoc.SetContentSize(*i)
+	}
+	return eoc
+}
+
+// SetStatus sets the "status" field.
+func (eoc *ExternalOutputCreate) SetStatus(e externaloutput.Status) *ExternalOutputCreate {
+	eoc.mutation.SetStatus(e)
+	return eoc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (eoc *ExternalOutputCreate) SetNillableStatus(e *externaloutput.Status) *ExternalOutputCreate {
+	if e != nil {
+		eoc.SetStatus(*e)
+	}
+	return eoc
+}
+
+// SetDomain sets the "domain" field.
+func (eoc *ExternalOutputCreate) SetDomain(s string) *ExternalOutputCreate {
+	eoc.mutation.SetDomain(s)
+	return eoc
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (eoc *ExternalOutputCreate) SetNillableDomain(s *string) *ExternalOutputCreate {
+	if s != nil {
+		eoc.SetDomain(*s)
+	}
+	return eoc
+}
+
+// SetCompliance sets the "compliance" field.
+func (eoc *ExternalOutputCreate) SetCompliance(m map[string]interface{}) *ExternalOutputCreate {
+	eoc.mutation.SetCompliance(m)
+	return eoc
+}
+
+// SetRetentionYears sets the "retention_years" field.
+func (eoc *ExternalOutputCreate) SetRetentionYears(i int) *ExternalOutputCreate {
+	eoc.mutation.SetRetentionYears(i)
+	return eoc
+}
+
+// SetNillableRetentionYears sets the "retention_years" field if the given value is not nil.
+func (eoc *ExternalOutputCreate) SetNillableRetentionYears(i *int) *ExternalOutputCreate {
+	if i != nil {
+		eoc.SetRetentionYears(*i)
+	}
+	return eoc
+}
+
+// SetMetadata sets the "metadata" field.
+func (eoc *ExternalOutputCreate) SetMetadata(m map[string]interface{}) *ExternalOutputCreate {
+	eoc.mutation.SetMetadata(m)
+	return eoc
+}
+
+// SetID sets the "id" field.
+func (eoc *ExternalOutputCreate) SetID(s string) *ExternalOutputCreate {
+	eoc.mutation.SetID(s)
+	return eoc
+}
+
+// AddWorkflowIDs adds the "workflows" edge to the WorkflowExecution entity by IDs.
+func (eoc *ExternalOutputCreate) AddWorkflowIDs(ids ...string) *ExternalOutputCreate {
+	eoc.mutation.AddWorkflowIDs(ids...)
+	return eoc
+}
+
+// AddWorkflows adds the "workflows" edges to the WorkflowExecution entity.
+func (eoc *ExternalOutputCreate) AddWorkflows(w ...*WorkflowExecution) *ExternalOutputCreate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return eoc.AddWorkflowIDs(ids...)
+}
+
+// Mutation returns the ExternalOutputMutation object of the builder.
+func (eoc *ExternalOutputCreate) Mutation() *ExternalOutputMutation {
+	return eoc.mutation
+}
+
+// Save creates the ExternalOutput in the database.
+func (eoc *ExternalOutputCreate) Save(ctx context.Context) (*ExternalOutput, error) {
+	eoc.defaults()
+	return withHooks(ctx, eoc.sqlSave, eoc.mutation, eoc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (eoc *ExternalOutputCreate) SaveX(ctx context.Context) *ExternalOutput {
+	v, err := eoc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (eoc *ExternalOutputCreate) Exec(ctx context.Context) error {
+	_, err := eoc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eoc *ExternalOutputCreate) ExecX(ctx context.Context) {
+	if err := eoc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (eoc *ExternalOutputCreate) defaults() {
+	if _, ok := eoc.mutation.Timestamp(); !ok {
+		v := externaloutput.DefaultTimestamp()
+		eoc.mutation.SetTimestamp(v)
+	}
+	if _, ok := eoc.mutation.Status(); !ok {
+		v := externaloutput.DefaultStatus
+		eoc.mutation.SetStatus(v)
+	}
+	if _, ok := eoc.mutation.RetentionYears(); !ok {
+		v := externaloutput.DefaultRetentionYears
+		eoc.mutation.SetRetentionYears(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eoc *ExternalOutputCreate) check() error {
+	if _, ok := eoc.mutation.Timestamp(); !ok {
+		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "ExternalOutput.timestamp"`)}
+	}
+	if _, ok := eoc.mutation.OutputType(); !ok {
+		return &ValidationError{Name: "output_type", err: errors.New(`ent: missing required field "ExternalOutput.output_type"`)}
+	}
+	if v, ok := eoc.mutation.OutputType(); ok {
+		if err := externaloutput.OutputTypeValidator(v); err != nil {
+			return &ValidationError{Name: "output_type", err: fmt.Errorf(`ent: validator failed for field "ExternalOutput.output_type": %w`, err)}
+		}
+	}
+	if _, ok := eoc.mutation.ContentHash(); !ok {
+		return &ValidationError{Name: "content_hash", err: errors.New(`ent: missing required field "ExternalOutput.content_hash"`)}
+	}
+	if v, ok := eoc.mutation.ContentHash(); ok {
+		if err := externaloutput.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "ExternalOutput.content_hash": %w`, err)}
+		}
+	}
+	if _, ok := eoc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ExternalOutput.status"`)}
+	}
+	if v, ok := eoc.mutation.Status(); ok {
+		if err := externaloutput.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ExternalOutput.status": %w`, err)}
+		}
+	}
+	if _, ok := eoc.mutation.RetentionYears(); !ok {
+		return &ValidationError{Name: "retention_years", err: errors.New(`ent: missing required field "ExternalOutput.retention_years"`)}
+	}
+	return nil
+}
+
+func (eoc *ExternalOutputCreate) sqlSave(ctx context.Context) (*ExternalOutput, error) {
+	if err := eoc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := eoc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, eoc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ExternalOutput.ID type: %T", _spec.ID.Value)
+		}
+	}
+	eoc.mutation.id = &_node.ID
+	eoc.mutation.done = true
+	return _node, nil
+}
+
+func (eoc *ExternalOutputCreate) createSpec() (*ExternalOutput, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ExternalOutput{config: eoc.config}
+		_spec = sqlgraph.NewCreateSpec(externaloutput.Table, sqlgraph.NewFieldSpec(externaloutput.FieldID, field.TypeString))
+	)
+	if id, ok := eoc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := eoc.mutation.ProvenanceHash(); ok {
+		_spec.SetField(externaloutput.FieldProvenanceHash, field.TypeString, value)
+		_node.ProvenanceHash = value
+	}
+	if value, ok := eoc.mutation.Timestamp(); ok {
+		_spec.SetField(externaloutput.FieldTimestamp, field.TypeTime, value)
+		_node.Timestamp = value
+	}
+	if value, ok := eoc.mutation.OutputType(); ok {
+		_spec.SetField(externaloutput.FieldOutputType, field.TypeEnum, value)
+		_node.OutputType = value
+	}
+	if value, ok := eoc.mutation.Destination(); ok {
+		_spec.SetField(externaloutput.FieldDestination, field.TypeString, value)
+		_node.Destination = value
+	}
+	if value, ok := eoc.mutation.DestinationID(); ok {
+		_spec.SetField(externaloutput.FieldDestinationID, field.TypeString, value)
+		_node.DestinationID = value
+	}
+	if value, ok := eoc.mutation.TransactionID(); ok {
+		_spec.SetField(externaloutput.FieldTransactionID, field.TypeString, value)
+		_node.TransactionID = value
+	}
+	if value, ok := eoc.mutation.BlockHash(); ok {
+		_spec.SetField(externaloutput.FieldBlockHash, field.TypeString, value)
+		_node.BlockHash = value
+	}
+	if value, ok := eoc.mutation.BlockNumber(); ok {
+		_spec.SetField(externaloutput.FieldBlockNumber, field.TypeInt64, value)
+		_node.BlockNumber = value
+	}
+	if value, ok := eoc.mutation.ContentHash(); ok {
+		_spec.SetField(externaloutput.FieldContentHash, field.TypeString, value)
+		_node.ContentHash = value
+	}
+	if value, ok := eoc.mutation.ContentSize(); ok {
+		_spec.SetField(externaloutput.FieldContentSize, field.TypeInt64, value)
+		_node.ContentSize = value
+	}
+	if value, ok := eoc.mutation.Status(); ok {
+		_spec.SetField(externaloutput.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := eoc.mutation.Domain(); ok {
+		_spec.SetField(externaloutput.FieldDomain, field.TypeString, value)
+		_node.Domain = value
+	}
+	if value, ok := eoc.mutation.Compliance(); ok {
+		_spec.SetField(externaloutput.FieldCompliance, field.TypeJSON, value)
+		_node.Compliance = value
+	}
+	if value, ok := eoc.mutation.RetentionYears(); ok {
+		_spec.SetField(externaloutput.FieldRetentionYears, field.TypeInt, value)
+		_node.RetentionYears = value
+	}
+	if value, ok := eoc.mutation.Metadata(); ok {
+		_spec.SetField(externaloutput.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if nodes := eoc.mutation.WorkflowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   externaloutput.WorkflowsTable,
+			Columns: externaloutput.WorkflowsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ExternalOutputCreateBulk is the builder for creating many ExternalOutput entities in bulk.
+type ExternalOutputCreateBulk struct {
+	config
+	err      error
+	builders []*ExternalOutputCreate
+}
+
+// Save creates the ExternalOutput entities in the database.
+func (eocb *ExternalOutputCreateBulk) Save(ctx context.Context) ([]*ExternalOutput, error) {
+	if eocb.err != nil {
+		return nil, eocb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(eocb.builders))
+	nodes := make([]*ExternalOutput, len(eocb.builders))
+	mutators := make([]Mutator, len(eocb.builders))
+	for i := range eocb.builders {
+		func(i int, root context.Context) {
+			builder := eocb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExternalOutputMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, eocb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, eocb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, eocb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eocb *ExternalOutputCreateBulk) SaveX(ctx context.Context) []*ExternalOutput {
+	v, err := eocb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (eocb *ExternalOutputCreateBulk) Exec(ctx context.Context) error {
+	_, err := eocb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eocb *ExternalOutputCreateBulk) ExecX(ctx context.Context) {
+	if err := eocb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
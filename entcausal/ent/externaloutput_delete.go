@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExternalOutputDelete is the builder for deleting a ExternalOutput entity.
+type ExternalOutputDelete struct {
+	config
+	hooks    []Hook
+	mutation *ExternalOutputMutation
+}
+
+// Where appends a list predicates to the ExternalOutputDelete builder.
+func (eod *ExternalOutputDelete) Where(ps ...predicate.ExternalOutput) *ExternalOutputDelete {
+	eod.mutation.Where(ps...)
+	return eod
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (eod *ExternalOutputDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, eod.sqlExec, eod.mutation, eod.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eod *ExternalOutputDelete) ExecX(ctx context.Context) int {
+	n, err := eod.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (eod *ExternalOutputDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(externaloutput.Table, sqlgraph.NewFieldSpec(externaloutput.FieldID, field.TypeString))
+	if ps := eod.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, eod.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	eod.mutation.done = true
+	return affected, err
+}
+
+// ExternalOutputDeleteOne is the builder for deleting a single ExternalOutput entity.
+type ExternalOutputDeleteOne struct {
+	eod *ExternalOutputDelete
+}
+
+// Where appends a list predicates to the ExternalOutputDelete builder.
+func (eodo *ExternalOutputDeleteOne) Where(ps ...predicate.ExternalOutput) *ExternalOutputDeleteOne {
+	eodo.eod.mutation.Where(ps...)
+	return eodo
+}
+
+// Exec executes the deletion query.
+func (eodo *ExternalOutputDeleteOne) Exec(ctx context.Context) error {
+	n, err := eodo.eod.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{externaloutput.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eodo *ExternalOutputDeleteOne) ExecX(ctx context.Context) {
+	if err := eodo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
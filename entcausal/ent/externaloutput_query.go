@@ -0,0 +1,672 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExternalOutputQuery is the builder for querying ExternalOutput entities.
+type ExternalOutputQuery struct {
+	config
+	ctx                *QueryContext
+	order              []externaloutput.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.ExternalOutput
+	withWorkflows      *WorkflowExecutionQuery
+	modifiers          []func(*sql.Selector)
+	loadTotal          []func(context.Context, []*ExternalOutput) error
+	withNamedWorkflows map[string]*WorkflowExecutionQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ExternalOutputQuery builder.
+func (eoq *ExternalOutputQuery) Where(ps ...predicate.ExternalOutput) *ExternalOutputQuery {
+	eoq.predicates = append(eoq.predicates, ps...)
+	return eoq
+}
+
+// Limit the number of records to be returned by this query.
+func (eoq *ExternalOutputQuery) Limit(limit int) *ExternalOutputQuery {
+	eoq.ctx.Limit = &limit
+	return eoq
+}
+
+// Offset to start from.
+func (eoq *ExternalOutputQuery) Offset(offset int) *ExternalOutputQuery {
+	eoq.ctx.Offset = &offset
+	return eoq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (eoq *ExternalOutputQuery) Unique(unique bool) *ExternalOutputQuery {
+	eoq.ctx.Unique = &unique
+	return eoq
+}
+
+// Order specifies how the records should be ordered.
+func (eoq *ExternalOutputQuery) Order(o ...externaloutput.OrderOption) *ExternalOutputQuery {
+	eoq.order = append(eoq.order, o...)
+	return eoq
+}
+
+// QueryWorkflows chains the current query on the "workflows" edge.
+func (eoq *ExternalOutputQuery) QueryWorkflows() *WorkflowExecutionQuery {
+	query := (&WorkflowExecutionClient{config: eoq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := eoq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := eoq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(externaloutput.Table, externaloutput.FieldID, selector),
+			sqlgraph.To(workflowexecution.Table, workflowexecution.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, externaloutput.WorkflowsTable, externaloutput.WorkflowsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(eoq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ExternalOutput entity from the query.
+// Returns a *NotFoundError when no ExternalOutput was found.
+func (eoq *ExternalOutputQuery) First(ctx context.Context) (*ExternalOutput, error) {
+	nodes, err := eoq.Limit(1).All(setContextOp(ctx, eoq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{externaloutput.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (eoq *ExternalOutputQuery) FirstX(ctx context.Context) *ExternalOutput {
+	node, err := eoq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ExternalOutput ID from the query.
+// Returns a *NotFoundError when no ExternalOutput ID was found.
+func (eoq *ExternalOutputQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = eoq.Limit(1).IDs(setContextOp(ctx, eoq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{externaloutput.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (eoq *ExternalOutputQuery) FirstIDX(ctx context.Context) string {
+	id, err := eoq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ExternalOutput entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ExternalOutput entity is found.
+// Returns a *NotFoundError when no ExternalOutput entities are found.
+func (eoq *ExternalOutputQuery) Only(ctx context.Context) (*ExternalOutput, error) {
+	nodes, err := eoq.Limit(2).All(setContextOp(ctx, eoq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{externaloutput.Label}
+	default:
+		return nil, &NotSingularError{externaloutput.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (eoq *ExternalOutputQuery) OnlyX(ctx context.Context) *ExternalOutput {
+	node, err := eoq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ExternalOutput ID in the query.
+// Returns a *NotSingularError when more than one ExternalOutput ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (eoq *ExternalOutputQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = eoq.Limit(2).IDs(setContextOp(ctx, eoq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{externaloutput.Label}
+	default:
+		err = &NotSingularError{externaloutput.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (eoq *ExternalOutputQuery) OnlyIDX(ctx context.Context) string {
+	id, err := eoq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ExternalOutputs.
+func (eoq *ExternalOutputQuery) All(ctx context.Context) ([]*ExternalOutput, error) {
+	ctx = setContextOp(ctx, eoq.ctx, ent.OpQueryAll)
+	if err := eoq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ExternalOutput, *ExternalOutputQuery]()
+	return withInterceptors[[]*ExternalOutput](ctx, eoq, qr, eoq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (eoq *ExternalOutputQuery) AllX(ctx context.Context) []*ExternalOutput {
+	nodes, err := eoq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ExternalOutput IDs.
+func (eoq *ExternalOutputQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if eoq.ctx.Unique == nil && eoq.path != nil {
+		eoq.Unique(true)
+	}
+	ctx = setContextOp(ctx, eoq.ctx, ent.OpQueryIDs)
+	if err = eoq.Select(externaloutput.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (eoq *ExternalOutputQuery) IDsX(ctx context.Context) []string {
+	ids, err := eoq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (eoq *ExternalOutputQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, eoq.ctx, ent.OpQueryCount)
+	if err := eoq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, eoq, querierCount[*ExternalOutputQuery](), eoq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (eoq *ExternalOutputQuery) CountX(ctx context.Context) int {
+	count, err := eoq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (eoq *ExternalOutputQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, eoq.ctx, ent.OpQueryExist)
+	switch _, err := eoq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (eoq *ExternalOutputQuery) ExistX(ctx context.Context) bool {
+	exist, err := eoq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ExternalOutputQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (eoq *ExternalOutputQuery) Clone() *ExternalOutputQuery {
+	if eoq == nil {
+		return nil
+	}
+	return &ExternalOutputQuery{
+		config:        eoq.config,
+		ctx:           eoq.ctx.Clone(),
+		order:         append([]externaloutput.OrderOption{}, eoq.order...),
+		inters:        append([]Interceptor{}, eoq.inters...),
+		predicates:    append([]predicate.ExternalOutput{}, eoq.predicates...),
+		withWorkflows: eoq.withWorkflows.Clone(),
+		// clone intermediate query.
+		sql:  eoq.sql.Clone(),
+		path: eoq.path,
+	}
+}
+
+// WithWorkflows tells the query-builder to eager-load the nodes that are connected to
+// the "workflows" edge. The optional arguments are used to configure the query builder of the edge.
+func (eoq *ExternalOutputQuery) WithWorkflows(opts ...func(*WorkflowExecutionQuery)) *ExternalOutputQuery {
+	query := (&WorkflowExecutionClient{config: eoq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	eoq.withWorkflows = query
+	return eoq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ProvenanceHash string `json:"provenance_hash,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ExternalOutput.Query().
+//		GroupBy(externaloutput.FieldProvenanceHash).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (eoq *ExternalOutputQuery) GroupBy(field string, fields ...string) *ExternalOutputGroupBy {
+	eoq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ExternalOutputGroupBy{build: eoq}
+	grbuild.flds = &eoq.ctx.Fields
+	grbuild.label = externaloutput.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ProvenanceHash string `json:"provenance_hash,omitempty"`
+//	}
+//
+//	client.ExternalOutput.Query().
+//		Select(externaloutput.FieldProvenanceHash).
+//		Scan(ctx, &v)
+func (eoq *ExternalOutputQuery) Select(fields ...string) *ExternalOutputSelect {
+	eoq.ctx.Fields = append(eoq.ctx.Fields, fields...)
+	sbuild := &ExternalOutputSelect{ExternalOutputQuery: eoq}
+	sbuild.label = externaloutput.Label
+	sbuild.flds, sbuild.scan = &eoq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ExternalOutputSelect configured with the given aggregations.
+func (eoq *ExternalOutputQuery) Aggregate(fns ...AggregateFunc) *ExternalOutputSelect {
+	return eoq.Select().Aggregate(fns...)
+}
+
+func (eoq *ExternalOutputQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range eoq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, eoq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range eoq.ctx.Fields {
+		if !externaloutput.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if eoq.path != nil {
+		prev, err := eoq.path(ctx)
+		if err != nil {
+			return err
+		}
+		eoq.sql = prev
+	}
+	return nil
+}
+
+func (eoq *ExternalOutputQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ExternalOutput, error) {
+	var (
+		nodes       = []*ExternalOutput{}
+		_spec       = eoq.querySpec()
+		loadedTypes = [1]bool{
+			eoq.withWorkflows != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ExternalOutput).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ExternalOutput{config: eoq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(eoq.modifiers) > 0 {
+		_spec.Modifiers = eoq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, eoq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := eoq.withWorkflows; query != nil {
+		if err := eoq.loadWorkflows(ctx, query, nodes,
+			func(n *ExternalOutput) { n.Edges.Workflows = []*WorkflowExecution{} },
+			func(n *ExternalOutput, e *WorkflowExecution) { n.Edges.Workflows = append(n.Edges.Workflows, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range eoq.withNamedWorkflows {
+		if err := eoq.loadWorkflows(ctx, query, nodes,
+			func(n *ExternalOutput) { n.appendNamedWorkflows(name) },
+			func(n *ExternalOutput, e *WorkflowExecution) { n.appendNamedWorkflows(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range eoq.loadTotal {
+		if err := eoq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (eoq *ExternalOutputQuery) loadWorkflows(ctx context.Context, query *WorkflowExecutionQuery, nodes []*ExternalOutput, init func(*ExternalOutput), assign func(*ExternalOutput, *WorkflowExecution)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*ExternalOutput)
+	nids := make(map[string]map[*ExternalOutput]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(externaloutput.WorkflowsTable)
+		s.Join(joinT).On(s.C(workflowexecution.FieldID), joinT.C(externaloutput.WorkflowsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(externaloutput.WorkflowsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(externaloutput.WorkflowsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*ExternalOutput]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*WorkflowExecution](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "workflows" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+
+func (eoq *ExternalOutputQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := eoq.querySpec()
+	if len(eoq.modifiers) > 0 {
+		_spec.Modifiers = eoq.modifiers
+	}
+	_spec.Node.Columns = eoq.ctx.Fields
+	if len(eoq.ctx.Fields) > 0 {
+		_spec.Unique = eoq.ctx.Unique != nil && *eoq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, eoq.driver, _spec)
+}
+
+func (eoq *ExternalOutputQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(externaloutput.Table, externaloutput.Columns, sqlgraph.NewFieldSpec(externaloutput.FieldID, field.TypeString))
+	_spec.From = eoq.sql
+	if unique := eoq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if eoq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := eoq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, externaloutput.FieldID)
+		for i := range fields {
+			if fields[i] != externaloutput.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := eoq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := eoq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := eoq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := eoq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (eoq *ExternalOutputQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(eoq.driver.Dialect())
+	t1 := builder.Table(externaloutput.Table)
+	columns := eoq.ctx.Fields
+	if len(columns) == 0 {
+		columns = externaloutput.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if eoq.sql != nil {
+		selector = eoq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if eoq.ctx.Unique != nil && *eoq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range eoq.predicates {
+		p(selector)
+	}
+	for _, p := range eoq.order {
+		p(selector)
+	}
+	if offset := eoq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := eoq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WithNamedWorkflows tells the query-builder to eager-load the nodes that are connected to the "workflows"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (eoq *ExternalOutputQuery) WithNamedWorkflows(name string, opts ...func(*WorkflowExecutionQuery)) *ExternalOutputQuery {
+	query := (&WorkflowExecutionClient{config: eoq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if eoq.withNamedWorkflows == nil {
+		eoq.withNamedWorkflows = make(map[string]*WorkflowExecutionQuery)
+	}
+	eoq.withNamedWorkflows[name] = query
+	return eoq
+}
+
+// ExternalOutputGroupBy is the group-by builder for ExternalOutput entities.
+type ExternalOutputGroupBy struct {
+	selector
+	build *ExternalOutputQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (eogb *ExternalOutputGroupBy) Aggregate(fns ...AggregateFunc) *ExternalOutputGroupBy {
+	eogb.fns = append(eogb.fns, fns...)
+	return eogb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (eogb *ExternalOutputGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, eogb.build.ctx, ent.OpQueryGroupBy)
+	if err := eogb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ExternalOutputQuery, *ExternalOutputGroupBy](ctx, eogb.build, eogb, eogb.build.inters, v)
+}
+
+func (eogb *ExternalOutputGroupBy) sqlScan(ctx context.Context, root *ExternalOutputQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(eogb.fns))
+	for _, fn := range eogb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*eogb.flds)+len(eogb.fns))
+		for _, f := range *eogb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*eogb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := eogb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ExternalOutputSelect is the builder for selecting fields of ExternalOutput entities.
+type ExternalOutputSelect struct {
+	*ExternalOutputQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (eos *ExternalOutputSelect) Aggregate(fns ...AggregateFunc) *ExternalOutputSelect {
+	eos.fns = append(eos.fns, fns...)
+	return eos
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (eos *ExternalOutputSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, eos.ctx, ent.OpQuerySelect)
+	if err := eos.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ExternalOutputQuery, *ExternalOutputSelect](ctx, eos.ExternalOutputQuery, eos, eos.inters, v)
+}
+
+func (eos *ExternalOutputSelect) sqlScan(ctx context.Context, root *ExternalOutputQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(eos.fns))
+	for _, fn := range eos.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*eos.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := eos.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,1022 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ExternalOutputUpdate is the builder for updating ExternalOutput entities.
+type ExternalOutputUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExternalOutputMutation
+}
+
+// Where appends a list predicates to the ExternalOutputUpdate builder.
+func (eou *ExternalOutputUpdate) Where(ps ...predicate.ExternalOutput) *ExternalOutputUpdate {
+	eou.mutation.Where(ps...)
+	return eou
+}
+
+// SetOutputType sets the "output_type" field.
+func (eou *ExternalOutputUpdate) SetOutputType(et externaloutput.OutputType) *ExternalOutputUpdate {
+	eou.mutation.SetOutputType(et)
+	return eou
+}
+
+// SetNillableOutputType sets the "output_type" field if the given value is not nil.
+func (eou *ExternalOutputUpdate) SetNillableOutputType(et *externaloutput.OutputType) *ExternalOutputUpdate {
+	if et != nil {
+		eou.SetOutputType(*et)
+	}
+	return eou
+}
+
+// SetDestination sets the "destination" field.
+func (eou *ExternalOutputUpdate) SetDestination(s string) *ExternalOutputUpdate {
+	eou.mutation.SetDestination(s)
+	return eou
+}
+
+// SetNillableDestination sets the "destination" field if the given value is not nil.
+func (eou *ExternalOutputUpdate) SetNillableDestination(s *string) *ExternalOutputUpdate {
+	if s != nil {
+		eou.SetDestination(*s)
+	}
+	return eou
+}
+
+// ClearDestination clears the value of the "destination" field.
+func (eou *ExternalOutputUpdate) ClearDestination() *ExternalOutputUpdate {
+	eou.mutation.ClearDestination()
+	return eou
+}
+
+// SetDestinationID sets the "destination_id" field.
+func (eou *ExternalOutputUpdate) SetDestinationID(s string) *ExternalOutputUpdate {
+	eou.mutation.SetDestinationID(s)
+	return eou
+}
+
+// SetNillableDestinationID sets the "destination_id" field if the given value is not nil.
+func (eou *ExternalOutputUpdate) SetNillableDestinationID(s *string) *ExternalOutputUpdate {
+	if s != nil {
+		eou.SetDestinationID(*s)
+	}
+	return eou
+}
+
+// ClearDestinationID clears the value of the "destination_id" field.
+func (eou *ExternalOutputUpdate) ClearDestinationID() *ExternalOutputUpdate {
+	eou.mutation.ClearDestinationID()
+	return eou
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (eou *ExternalOutputUpdate) SetTransactionID(s string) *ExternalOutputUpdate {
+	eou.mutation.SetTransactionID(s)
+	return eou
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (eou *ExternalOutputUpdate) SetNillableTransactionID(s *string) *ExternalOutputUpdate {
+	if s != nil {
+		eou.SetTransactionID(*s)
+	}
+	return eou
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (eou *ExternalOutputUpdate) ClearTransactionID() *ExternalOutputUpdate {
+	eou.mutation.ClearTransactionID()
+	return eou
+}
+
+// SetBlockHash sets the "block_hash" field.
+func (eou *ExternalOutputUpdate) SetBlockHash(s string) *ExternalOutputUpdate {
+	eou.mutation.SetBlockHash(s)
+	return eou
+}
+
+// SetNillableBlockHash sets the "block_hash" field if the given value is not nil.
+func (eou *ExternalOutputUpdate) SetNillableBlockHash(s *string) *ExternalOutputUpdate {
+	if s != nil {
+		eou.SetBlockHash(*s)
+	}
+	return eou
+}
+
+// ClearBlockHash clears the value of the "block_hash" field.
+func (eou *ExternalOutputUpdate) ClearBlockHash() *ExternalOutputUpdate {
+	eou.mutation.ClearBlockHash()
+	return eou
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (eou *ExternalOutputUpdate) SetBlockNumber(i int64) *ExternalOutputUpdate {
+	eou.mutation.ResetBlockNumber()
+	eou.mutation.SetBlockNumber(i)
+	return eou
+}
+
+// SetNillableBlockNumber sets the "block_number" field if the given value is not nil.
+func (eou *ExternalOutputUpdate) SetNillableBlockNumber(i *int64) *ExternalOutputUpdate {
+	if i != nil {
+		eou.SetBlockNumber(*i)
+	}
+	return eou
+}
+
+// AddBlockNumber adds i to the "block_number" field.
+func (eou *ExternalOutputUpdate) AddBlockNumber(i int64) *ExternalOutputUpdate {
+	eou.mutation.AddBlockNumber(i)
+	return eou
+}
+
+// ClearBlockNumber clears the value of the "block_number" field.
+func (eou *ExternalOutputUpdate) ClearBlockNumber() *ExternalOutputUpdate {
+	eou.mutation.ClearBlockNumber()
+	return eou
+}
+
+// SetContentHash sets the "content_hash" field.
+func (eou *ExternalOutputUpdate) SetContentHash(s string) *ExternalOutputUpdate {
+	eou.mutation.SetContentHash(s)
+	return eou
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (eou *ExternalOutputUpdate) SetNillableContentHash(s *string) *ExternalOutputUpdate {
+	if s != nil {
+		eou.SetContentHash(*s)
+	}
+	return eou
+}
+
+// SetContentSize sets the "content_size" field.
+func (eou *ExternalOutputUpdate) SetContentSize(i int64) *ExternalOutputUpdate {
+	eou.mutation.ResetContentSize()
+	eou.mutation.SetContentSize(i)
+	return eou
+}
+
+// SetNillableContentSize sets the "content_size" field if the given value is not nil.
+func (eou *ExternalOutputUpdate) SetNillableContentSize(i *int64) *ExternalOutputUpdate {
+	if i != nil {
+		eou.SetContentSize(*i)
+	}
+	return eou
+}
+
+// AddContentSize adds i to the "content_size" field.
+func (eou *ExternalOutputUpdate) AddContentSize(i int64) *ExternalOutputUpdate {
+	eou.mutation.AddContentSize(i)
+	return eou
+}
+
+// ClearContentSize clears the value of the "content_size" field.
+func (eou *ExternalOutputUpdate) ClearContentSize() *ExternalOutputUpdate {
+	eou.mutation.ClearContentSize()
+	return eou
+}
+
+// SetStatus sets the "status" field.
+func (eou *ExternalOutputUpdate) SetStatus(e externaloutput.Status) *ExternalOutputUpdate {
+	eou.mutation.SetStatus(e)
+	return eou
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (eou *ExternalOutputUpdate) SetNillableStatus(e *externaloutput.Status) *ExternalOutputUpdate {
+	if e != nil {
+		eou.SetStatus(*e)
+	}
+	return eou
+}
+
+// SetDomain sets the "domain" field.
+func (eou *ExternalOutputUpdate) SetDomain(s string) *ExternalOutputUpdate {
+	eou.mutation.SetDomain(s)
+	return eou
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (eou *ExternalOutputUpdate) SetNillableDomain(s *string) *ExternalOutputUpdate {
+	if s != nil {
+		eou.SetDomain(*s)
+	}
+	return eou
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (eou *ExternalOutputUpdate) ClearDomain() *ExternalOutputUpdate {
+	eou.mutation.ClearDomain()
+	return eou
+}
+
+// SetCompliance sets the "compliance" field.
+func (eou *ExternalOutputUpdate) SetCompliance(m map[string]interface{}) *ExternalOutputUpdate {
+	eou.mutation.SetCompliance(m)
+	return eou
+}
+
+// ClearCompliance clears the value of the "compliance" field.
+func (eou *ExternalOutputUpdate) ClearCompliance() *ExternalOutputUpdate {
+	eou.mutation.ClearCompliance()
+	return eou
+}
+
+// SetRetentionYears sets the "retention_years" field.
+func (eou *ExternalOutputUpdate) SetRetentionYears(i int) *ExternalOutputUpdate {
+	eou.mutation.ResetRetentionYears()
+	eou.mutation.SetRetentionYears(i)
+	return eou
+}
+
+// SetNillableRetentionYears sets the "retention_years" field if the given value is not nil.
+func (eou *ExternalOutputUpdate) SetNillableRetentionYears(i *int) *ExternalOutputUpdate {
+	if i != nil {
+		eou.SetRetentionYears(*i)
+	}
+	return eou
+}
+
+// AddRetentionYears adds i to the "retention_years" field.
+func (eou *ExternalOutputUpdate) AddRetentionYears(i int) *ExternalOutputUpdate {
+	eou.mutation.AddRetentionYears(i)
+	return eou
+}
+
+// SetMetadata sets the "metadata" field.
+func (eou *ExternalOutputUpdate) SetMetadata(m map[string]interface{}) *ExternalOutputUpdate {
+	eou.mutation.SetMetadata(m)
+	return eou
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (eou *ExternalOutputUpdate) ClearMetadata() *ExternalOutputUpdate {
+	eou.mutation.ClearMetadata()
+	return eou
+}
+
+// AddWorkflowIDs adds the "workflows" edge to the WorkflowExecution entity by IDs.
+func (eou *ExternalOutputUpdate) AddWorkflowIDs(ids ...string) *ExternalOutputUpdate {
+	eou.mutation.AddWorkflowIDs(ids...)
+	return eou
+}
+
+// AddWorkflows adds the "workflows" edges to the WorkflowExecution entity.
+func (eou *ExternalOutputUpdate) AddWorkflows(w ...*WorkflowExecution) *ExternalOutputUpdate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return eou.AddWorkflowIDs(ids...)
+}
+
+// Mutation returns the ExternalOutputMutation object of the builder.
+func (eou *ExternalOutputUpdate) Mutation() *ExternalOutputMutation {
+	return eou.mutation
+}
+
+// ClearWorkflows clears all "workflows" edges to the WorkflowExecution entity.
+func (eou *ExternalOutputUpdate) ClearWorkflows() *ExternalOutputUpdate {
+	eou.mutation.ClearWorkflows()
+	return eou
+}
+
+// RemoveWorkflowIDs removes the "workflows" edge to WorkflowExecution entities by IDs.
+func (eou *ExternalOutputUpdate) RemoveWorkflowIDs(ids ...string) *ExternalOutputUpdate {
+	eou.mutation.RemoveWorkflowIDs(ids...)
+	return eou
+}
+
+// RemoveWorkflows removes "workflows" edges to WorkflowExecution entities.
+func (eou *ExternalOutputUpdate) RemoveWorkflows(w ...*WorkflowExecution) *ExternalOutputUpdate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return eou.RemoveWorkflowIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eou *ExternalOutputUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, eou.sqlSave, eou.mutation, eou.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eou *ExternalOutputUpdate) SaveX(ctx context.Context) int {
+	affected, err := eou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eou *ExternalOutputUpdate) Exec(ctx context.Context) error {
+	_, err := eou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eou *ExternalOutputUpdate) ExecX(ctx context.Context) {
+	if err := eou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eou *ExternalOutputUpdate) check() error {
+	if v, ok := eou.mutation.OutputType(); ok {
+		if err := externaloutput.OutputTypeValidator(v); err != nil {
+			return &ValidationError{Name: "output_type", err: fmt.Errorf(`ent: validator failed for field "ExternalOutput.output_type": %w`, err)}
+		}
+	}
+	if v, ok := eou.mutation.ContentHash(); ok {
+		if err := externaloutput.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "ExternalOutput.content_hash": %w`, err)}
+		}
+	}
+	if v, ok := eou.mutation.Status(); ok {
+		if err := externaloutput.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ExternalOutput.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (eou *ExternalOutputUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := eou.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(externaloutput.Table, externaloutput.Columns, sqlgraph.NewFieldSpec(externaloutput.FieldID, field.TypeString))
+	if ps := eou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if eou.mutation.ProvenanceHashCleared() {
+		_spec.ClearField(externaloutput.FieldProvenanceHash, field.TypeString)
+	}
+	if value, ok := eou.mutation.OutputType(); ok {
+		_spec.SetField(externaloutput.FieldOutputType, field.TypeEnum, value)
+	}
+	if value, ok := eou.mutation.Destination(); ok {
+		_spec.SetField(externaloutput.FieldDestination, field.TypeString, value)
+	}
+	if eou.mutation.DestinationCleared() {
+		_spec.ClearField(externaloutput.FieldDestination, field.TypeString)
+	}
+	if value, ok := eou.mutation.DestinationID(); ok {
+		_spec.SetField(externaloutput.FieldDestinationID, field.TypeString, value)
+	}
+	if eou.mutation.DestinationIDCleared() {
+		_spec.ClearField(externaloutput.FieldDestinationID, field.TypeString)
+	}
+	if value, ok := eou.mutation.TransactionID(); ok {
+		_spec.SetField(externaloutput.FieldTransactionID, field.TypeString, value)
+	}
+	if eou.mutation.TransactionIDCleared() {
+		_spec.ClearField(externaloutput.FieldTransactionID, field.TypeString)
+	}
+	if value, ok := eou.mutation.BlockHash(); ok {
+		_spec.SetField(externaloutput.FieldBlockHash, field.TypeString, value)
+	}
+	if eou.mutation.BlockHashCleared() {
+		_spec.ClearField(externaloutput.FieldBlockHash, field.TypeString)
+	}
+	if value, ok := eou.mutation.BlockNumber(); ok {
+		_spec.SetField(externaloutput.FieldBlockNumber, field.TypeInt64, value)
+	}
+	if value, ok := eou.mutation.AddedBlockNumber(); ok {
+		_spec.AddField(externaloutput.FieldBlockNumber, field.TypeInt64, value)
+	}
+	if eou.mutation.BlockNumberCleared() {
+		_spec.ClearField(externaloutput.FieldBlockNumber, field.TypeInt64)
+	}
+	if value, ok := eou.mutation.ContentHash(); ok {
+		_spec.SetField(externaloutput.FieldContentHash, field.TypeString, value)
+	}
+	if value, ok := eou.mutation.ContentSize(); ok {
+		_spec.SetField(externaloutput.FieldContentSize, field.TypeInt64, value)
+	}
+	if value, ok := eou.mutation.AddedContentSize(); ok {
+		_spec.AddField(externaloutput.FieldContentSize, field.TypeInt64, value)
+	}
+	if eou.mutation.ContentSizeCleared() {
+		_spec.ClearField(externaloutput.FieldContentSize, field.TypeInt64)
+	}
+	if value, ok := eou.mutation.Status(); ok {
+		_spec.SetField(externaloutput.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := eou.mutation.Domain(); ok {
+		_spec.SetField(externaloutput.FieldDomain, field.TypeString, value)
+	}
+	if eou.mutation.DomainCleared() {
+		_spec.ClearField(externaloutput.FieldDomain, field.TypeString)
+	}
+	if value, ok := eou.mutation.Compliance(); ok {
+		_spec.SetField(externaloutput.FieldCompliance, field.TypeJSON, value)
+	}
+	if eou.mutation.ComplianceCleared() {
+		_spec.ClearField(externaloutput.FieldCompliance, field.TypeJSON)
+	}
+	if value, ok := eou.mutation.RetentionYears(); ok {
+		_spec.SetField(externaloutput.FieldRetentionYears, field.TypeInt, value)
+	}
+	if value, ok := eou.mutation.AddedRetentionYears(); ok {
+		_spec.AddField(externaloutput.FieldRetentionYears, field.TypeInt, value)
+	}
+	if value, ok := eou.mutation.Metadata(); ok {
+		_spec.SetField(externaloutput.FieldMetadata, field.TypeJSON, value)
+	}
+	if eou.mutation.MetadataCleared() {
+		_spec.ClearField(externaloutput.FieldMetadata, field.TypeJSON)
+	}
+	if eou.mutation.WorkflowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   externaloutput.WorkflowsTable,
+			Columns: externaloutput.WorkflowsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eou.mutation.RemovedWorkflowsIDs(); len(nodes) > 0 && !eou.mutation.WorkflowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   externaloutput.WorkflowsTable,
+			Columns: externaloutput.WorkflowsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eou.mutation.WorkflowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   externaloutput.WorkflowsTable,
+			Columns: externaloutput.WorkflowsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{externaloutput.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eou.mutation.done = true
+	return n, nil
+}
+
+// ExternalOutputUpdateOne is the builder for updating a single ExternalOutput entity.
+type ExternalOutputUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExternalOutputMutation
+}
+
+// SetOutputType sets the "output_type" field.
+func (eouo *ExternalOutputUpdateOne) SetOutputType(et externaloutput.OutputType) *ExternalOutputUpdateOne {
+	eouo.mutation.SetOutputType(et)
+	return eouo
+}
+
+// SetNillableOutputType sets the "output_type" field if the given value is not nil.
+func (eouo *ExternalOutputUpdateOne) SetNillableOutputType(et *externaloutput.OutputType) *ExternalOutputUpdateOne {
+	if et != nil {
+		eouo.SetOutputType(*et)
+	}
+	return eouo
+}
+
+// SetDestination sets the "destination" field.
+func (eouo *ExternalOutputUpdateOne) SetDestination(s string) *ExternalOutputUpdateOne {
+	eouo.mutation.SetDestination(s)
+	return eouo
+}
+
+// SetNillableDestination sets the "destination" field if the given value is not nil.
+func (eouo *ExternalOutputUpdateOne) SetNillableDestination(s *string) *ExternalOutputUpdateOne {
+	if s != nil {
+		eouo.SetDestination(*s)
+	}
+	return eouo
+}
+
+// ClearDestination clears the value of the "destination" field.
+func (eouo *ExternalOutputUpdateOne) ClearDestination() *ExternalOutputUpdateOne {
+	eouo.mutation.ClearDestination()
+	return eouo
+}
+
+// SetDestinationID sets the "destination_id" field.
+func (eouo *ExternalOutputUpdateOne) SetDestinationID(s string) *ExternalOutputUpdateOne {
+	eouo.mutation.SetDestinationID(s)
+	return eouo
+}
+
+// SetNillableDestinationID sets the "destination_id" field if the given value is not nil.
+func (eouo *ExternalOutputUpdateOne) SetNillableDestinationID(s *string) *ExternalOutputUpdateOne {
+	if s != nil {
+		eouo.SetDestinationID(*s)
+	}
+	return eouo
+}
+
+// ClearDestinationID clears the value of the "destination_id" field.
+func (eouo *ExternalOutputUpdateOne) ClearDestinationID() *ExternalOutputUpdateOne {
+	eouo.mutation.ClearDestinationID()
+	return eouo
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (eouo *ExternalOutputUpdateOne) SetTransactionID(s string) *ExternalOutputUpdateOne {
+	eouo.mutation.SetTransactionID(s)
+	return eouo
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (eouo *ExternalOutputUpdateOne) SetNillableTransactionID(s *string) *ExternalOutputUpdateOne {
+	if s != nil {
+		eouo.SetTransactionID(*s)
+	}
+	return eouo
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (eouo *ExternalOutputUpdateOne) ClearTransactionID() *ExternalOutputUpdateOne {
+	eouo.mutation.ClearTransactionID()
+	return eouo
+}
+
+// SetBlockHash sets the "block_hash" field.
+func (eouo *ExternalOutputUpdateOne) SetBlockHash(s string) *ExternalOutputUpdateOne {
+	eouo.mutation.SetBlockHash(s)
+	return eouo
+}
+
+// SetNillableBlockHash sets the "block_hash" field if the given value is not nil.
+func (eouo *ExternalOutputUpdateOne) SetNillableBlockHash(s *string) *ExternalOutputUpdateOne {
+	if s != nil {
+		eouo.SetBlockHash(*s)
+	}
+	return eouo
+}
+
+// ClearBlockHash clears the value of the "block_hash" field.
+func (eouo *ExternalOutputUpdateOne) ClearBlockHash() *ExternalOutputUpdateOne {
+	eouo.mutation.ClearBlockHash()
+	return eouo
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (eouo *ExternalOutputUpdateOne) SetBlockNumber(i int64) *ExternalOutputUpdateOne {
+	eouo.mutation.ResetBlockNumber()
+	eouo.mutation.SetBlockNumber(i)
+	return eouo
+}
+
+// SetNillableBlockNumber sets the "block_number" field if the given value is not nil.
+func (eouo *ExternalOutputUpdateOne) SetNillableBlockNumber(i *int64) *ExternalOutputUpdateOne {
+	if i != nil {
+		eouo.SetBlockNumber(*i)
+	}
+	return eouo
+}
+
+// AddBlockNumber adds i to the "block_number" field.
+func (eouo *ExternalOutputUpdateOne) AddBlockNumber(i int64) *ExternalOutputUpdateOne {
+	eouo.mutation.AddBlockNumber(i)
+	return eouo
+}
+
+// ClearBlockNumber clears the value of the "block_number" field.
+func (eouo *ExternalOutputUpdateOne) ClearBlockNumber() *ExternalOutputUpdateOne {
+	eouo.mutation.ClearBlockNumber()
+	return eouo
+}
+
+// SetContentHash sets the "content_hash" field.
+func (eouo *ExternalOutputUpdateOne) SetContentHash(s string) *ExternalOutputUpdateOne {
+	eouo.mutation.SetContentHash(s)
+	return eouo
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (eouo *ExternalOutputUpdateOne) SetNillableContentHash(s *string) *ExternalOutputUpdateOne {
+	if s != nil {
+		eouo.SetContentHash(*s)
+	}
+	return eouo
+}
+
+// SetContentSize sets the "content_size" field.
+func (eouo *ExternalOutputUpdateOne) SetContentSize(i int64) *ExternalOutputUpdateOne {
+	eouo.mutation.ResetContentSize()
+	eouo.mutation.SetContentSize(i)
+	return eouo
+}
+
+// SetNillableContentSize sets the "content_size" field if the given value is not nil.
+func (eouo *ExternalOutputUpdateOne) SetNillableContentSize(i *int64) *ExternalOutputUpdateOne {
+	if i != nil {
+		eouo.SetContentSize(*i)
+	}
+	return eouo
+}
+
+// AddContentSize adds i to the "content_size" field.
+func (eouo *ExternalOutputUpdateOne) AddContentSize(i int64) *ExternalOutputUpdateOne {
+	eouo.mutation.AddContentSize(i)
+	return eouo
+}
+
+// ClearContentSize clears the value of the "content_size" field.
+func (eouo *ExternalOutputUpdateOne) ClearContentSize() *ExternalOutputUpdateOne {
+	eouo.mutation.ClearContentSize()
+	return eouo
+}
+
+// SetStatus sets the "status" field.
+func (eouo *ExternalOutputUpdateOne) SetStatus(e externaloutput.Status) *ExternalOutputUpdateOne {
+	eouo.mutation.SetStatus(e)
+	return eouo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (eouo *ExternalOutputUpdateOne) SetNillableStatus(e *externaloutput.Status) *ExternalOutputUpdateOne {
+	if e != nil {
+		eouo.SetStatus(*e)
+	}
+	return eouo
+}
+
+// SetDomain sets the "domain" field.
+func (eouo *ExternalOutputUpdateOne) SetDomain(s string) *ExternalOutputUpdateOne {
+	eouo.mutation.SetDomain(s)
+	return eouo
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (eouo *ExternalOutputUpdateOne) SetNillableDomain(s *string) *ExternalOutputUpdateOne {
+	if s != nil {
+		eouo.SetDomain(*s)
+	}
+	return eouo
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (eouo *ExternalOutputUpdateOne) ClearDomain() *ExternalOutputUpdateOne {
+	eouo.mutation.ClearDomain()
+	return eouo
+}
+
+// SetCompliance sets the "compliance" field.
+func (eouo *ExternalOutputUpdateOne) SetCompliance(m map[string]interface{}) *ExternalOutputUpdateOne {
+	eouo.mutation.SetCompliance(m)
+	return eouo
+}
+
+// ClearCompliance clears the value of the "compliance" field.
+func (eouo *ExternalOutputUpdateOne) ClearCompliance() *ExternalOutputUpdateOne {
+	eouo.mutation.ClearCompliance()
+	return eouo
+}
+
+// SetRetentionYears sets the "retention_years" field.
+func (eouo *ExternalOutputUpdateOne) SetRetentionYears(i int) *ExternalOutputUpdateOne {
+	eouo.mutation.ResetRetentionYears()
+	eouo.mutation.SetRetentionYears(i)
+	return eouo
+}
+
+// SetNillableRetentionYears sets the "retention_years" field if the given value is not nil.
+func (eouo *ExternalOutputUpdateOne) SetNillableRetentionYears(i *int) *ExternalOutputUpdateOne {
+	if i != nil {
+		eouo.SetRetentionYears(*i)
+	}
+	return eouo
+}
+
+// AddRetentionYears adds i to the "retention_years" field.
+func (eouo *ExternalOutputUpdateOne) AddRetentionYears(i int) *ExternalOutputUpdateOne {
+	eouo.mutation.AddRetentionYears(i)
+	return eouo
+}
+
+// SetMetadata sets the "metadata" field.
+func (eouo *ExternalOutputUpdateOne) SetMetadata(m map[string]interface{}) *ExternalOutputUpdateOne {
+	eouo.mutation.SetMetadata(m)
+	return eouo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (eouo *ExternalOutputUpdateOne) ClearMetadata() *ExternalOutputUpdateOne {
+	eouo.mutation.ClearMetadata()
+	return eouo
+}
+
+// AddWorkflowIDs adds the "workflows" edge to the WorkflowExecution entity by IDs.
+func (eouo *ExternalOutputUpdateOne) AddWorkflowIDs(ids ...string) *ExternalOutputUpdateOne {
+	eouo.mutation.AddWorkflowIDs(ids...)
+	return eouo
+}
+
+// AddWorkflows adds the "workflows" edges to the WorkflowExecution entity.
+func (eouo *ExternalOutputUpdateOne) AddWorkflows(w ...*WorkflowExecution) *ExternalOutputUpdateOne {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return eouo.AddWorkflowIDs(ids...)
+}
+
+// Mutation returns the ExternalOutputMutation object of the builder.
+func (eouo *ExternalOutputUpdateOne) Mutation() *ExternalOutputMutation {
+	return eouo.mutation
+}
+
+// ClearWorkflows clears all "workflows" edges to the WorkflowExecution entity.
+func (eouo *ExternalOutputUpdateOne) ClearWorkflows() *ExternalOutputUpdateOne {
+	eouo.mutation.ClearWorkflows()
+	return eouo
+}
+
+// RemoveWorkflowIDs removes the "workflows" edge to WorkflowExecution entities by IDs.
+func (eouo *ExternalOutputUpdateOne) RemoveWorkflowIDs(ids ...string) *ExternalOutputUpdateOne {
+	eouo.mutation.RemoveWorkflowIDs(ids...)
+	return eouo
+}
+
+// RemoveWorkflows removes "workflows" edges to WorkflowExecution entities.
+func (eouo *ExternalOutputUpdateOne) RemoveWorkflows(w ...*WorkflowExecution) *ExternalOutputUpdateOne {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return eouo.RemoveWorkflowIDs(ids...)
+}
+
+// Where appends a list predicates to the ExternalOutputUpdate builder.
+func (eouo *ExternalOutputUpdateOne) Where(ps ...predicate.ExternalOutput) *ExternalOutputUpdateOne {
+	eouo.mutation.Where(ps...)
+	return eouo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (eouo *ExternalOutputUpdateOne) Select(field string, fields ...string) *ExternalOutputUpdateOne {
+	eouo.fields = append([]string{field}, fields...)
+	return eouo
+}
+
+// Save executes the query and returns the updated ExternalOutput entity.
+func (eouo *ExternalOutputUpdateOne) Save(ctx context.Context) (*ExternalOutput, error) {
+	return withHooks(ctx, eouo.sqlSave, eouo.mutation, eouo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eouo *ExternalOutputUpdateOne) SaveX(ctx context.Context) *ExternalOutput {
+	node, err := eouo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (eouo *ExternalOutputUpdateOne) Exec(ctx context.Context) error {
+	_, err := eouo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eouo *ExternalOutputUpdateOne) ExecX(ctx context.Context) {
+	if err := eouo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eouo *ExternalOutputUpdateOne) check() error {
+	if v, ok := eouo.mutation.OutputType(); ok {
+		if err := externaloutput.OutputTypeValidator(v); err != nil {
+			return &ValidationError{Name: "output_type", err: fmt.Errorf(`ent: validator failed for field "ExternalOutput.output_type": %w`, err)}
+		}
+	}
+	if v, ok := eouo.mutation.ContentHash(); ok {
+		if err := externaloutput.ContentHashValidator(v); err != nil {
+			return &ValidationError{Name: "content_hash", err: fmt.Errorf(`ent: validator failed for field "ExternalOutput.content_hash": %w`, err)}
+		}
+	}
+	if v, ok := eouo.mutation.Status(); ok {
+		if err := externaloutput.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ExternalOutput.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (eouo *ExternalOutputUpdateOne) sqlSave(ctx context.Context) (_node *ExternalOutput, err error) {
+	if err := eouo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(externaloutput.Table, externaloutput.Columns, sqlgraph.NewFieldSpec(externaloutput.FieldID, field.TypeString))
+	id, ok := eouo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ExternalOutput.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := eouo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, externaloutput.FieldID)
+		for _, f := range fields {
+			if !externaloutput.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != externaloutput.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := eouo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if eouo.mutation.ProvenanceHashCleared() {
+		_spec.ClearField(externaloutput.FieldProvenanceHash, field.TypeString)
+	}
+	if value, ok := eouo.mutation.OutputType(); ok {
+		_spec.SetField(externaloutput.FieldOutputType, field.TypeEnum, value)
+	}
+	if value, ok := eouo.mutation.Destination(); ok {
+		_spec.SetField(externaloutput.FieldDestination, field.TypeString, value)
+	}
+	if eouo.mutation.DestinationCleared() {
+		_spec.ClearField(externaloutput.FieldDestination, field.TypeString)
+	}
+	if value, ok := eouo.mutation.DestinationID(); ok {
+		_spec.SetField(externaloutput.FieldDestinationID, field.TypeString, value)
+	}
+	if eouo.mutation.DestinationIDCleared() {
+		_spec.ClearField(externaloutput.FieldDestinationID, field.TypeString)
+	}
+	if value, ok := eouo.mutation.TransactionID(); ok {
+		_spec.SetField(externaloutput.FieldTransactionID, field.TypeString, value)
+	}
+	if eouo.mutation.TransactionIDCleared() {
+		_spec.ClearField(externaloutput.FieldTransactionID, field.TypeString)
+	}
+	if value, ok := eouo.mutation.BlockHash(); ok {
+		_spec.SetField(externaloutput.FieldBlockHash, field.TypeString, value)
+	}
+	if eouo.mutation.BlockHashCleared() {
+		_spec.ClearField(externaloutput.FieldBlockHash, field.TypeString)
+	}
+	if value, ok := eouo.mutation.BlockNumber(); ok {
+		_spec.SetField(externaloutput.FieldBlockNumber, field.TypeInt64, value)
+	}
+	if value, ok := eouo.mutation.AddedBlockNumber(); ok {
+		_spec.AddField(externaloutput.FieldBlockNumber, field.TypeInt64, value)
+	}
+	if eouo.mutation.BlockNumberCleared() {
+		_spec.ClearField(externaloutput.FieldBlockNumber, field.TypeInt64)
+	}
+	if value, ok := eouo.mutation.ContentHash(); ok {
+		_spec.SetField(externaloutput.FieldContentHash, field.TypeString, value)
+	}
+	if value, ok := eouo.mutation.ContentSize(); ok {
+		_spec.SetField(externaloutput.FieldContentSize, field.TypeInt64, value)
+	}
+	if value, ok := eouo.mutation.AddedContentSize(); ok {
+		_spec.AddField(externaloutput.FieldContentSize, field.TypeInt64, value)
+	}
+	if eouo.mutation.ContentSizeCleared() {
+		_spec.ClearField(externaloutput.FieldContentSize, field.TypeInt64)
+	}
+	if value, ok := eouo.mutation.Status(); ok {
+		_spec.SetField(externaloutput.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := eouo.mutation.Domain(); ok {
+		_spec.SetField(externaloutput.FieldDomain, field.TypeString, value)
+	}
+	if eouo.mutation.DomainCleared() {
+		_spec.ClearField(externaloutput.FieldDomain, field.TypeString)
+	}
+	if value, ok := eouo.mutation.Compliance(); ok {
+		_spec.SetField(externaloutput.FieldCompliance, field.TypeJSON, value)
+	}
+	if eouo.mutation.ComplianceCleared() {
+		_spec.ClearField(externaloutput.FieldCompliance, field.TypeJSON)
+	}
+	if value, ok := eouo.mutation.RetentionYears(); ok {
+		_spec.SetField(externaloutput.FieldRetentionYears, field.TypeInt, value)
+	}
+	if value, ok := eouo.mutation.AddedRetentionYears(); ok {
+		_spec.AddField(externaloutput.FieldRetentionYears, field.TypeInt, value)
+	}
+	if value, ok := eouo.mutation.Metadata(); ok {
+		_spec.SetField(externaloutput.FieldMetadata, field.TypeJSON, value)
+	}
+	if eouo.mutation.MetadataCleared() {
+		_spec.ClearField(externaloutput.FieldMetadata, field.TypeJSON)
+	}
+	if eouo.mutation.WorkflowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   externaloutput.WorkflowsTable,
+			Columns: externaloutput.WorkflowsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eouo.mutation.RemovedWorkflowsIDs(); len(nodes) > 0 && !eouo.mutation.WorkflowsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   externaloutput.WorkflowsTable,
+			Columns: externaloutput.WorkflowsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eouo.mutation.WorkflowsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   externaloutput.WorkflowsTable,
+			Columns: externaloutput.WorkflowsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ExternalOutput{config: eouo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, eouo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{externaloutput.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	eouo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,984 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/spikeevent"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (aa *AgentActionQuery) CollectFields(ctx context.Context, satisfies ...string) (*AgentActionQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return aa, nil
+	}
+	if err := aa.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return aa, nil
+}
+
+func (aa *AgentActionQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(agentaction.Columns))
+		selectedFields = []string{agentaction.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "decisions":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&RoutingDecisionClient{config: aa.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, routingdecisionImplementors)...); err != nil {
+				return err
+			}
+			aa.WithNamedDecisions(alias, func(wq *RoutingDecisionQuery) {
+				*wq = *query
+			})
+
+		case "workflows":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&WorkflowExecutionClient{config: aa.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, workflowexecutionImplementors)...); err != nil {
+				return err
+			}
+			aa.WithNamedWorkflows(alias, func(wq *WorkflowExecutionQuery) {
+				*wq = *query
+			})
+		case "provenanceHash":
+			if _, ok := fieldSeen[agentaction.FieldProvenanceHash]; !ok {
+				selectedFields = append(selectedFields, agentaction.FieldProvenanceHash)
+				fieldSeen[agentaction.FieldProvenanceHash] = struct{}{}
+			}
+		case "timestamp":
+			if _, ok := fieldSeen[agentaction.FieldTimestamp]; !ok {
+				selectedFields = append(selectedFields, agentaction.FieldTimestamp)
+				fieldSeen[agentaction.FieldTimestamp] = struct{}{}
+			}
+		case "agentID":
+			if _, ok := fieldSeen[agentaction.FieldAgentID]; !ok {
+				selectedFields = append(selectedFields, agentaction.FieldAgentID)
+				fieldSeen[agentaction.FieldAgentID] = struct{}{}
+			}
+		case "agentType":
+			if _, ok := fieldSeen[agentaction.FieldAgentType]; !ok {
+				selectedFields = append(selectedFields, agentaction.FieldAgentType)
+				fieldSeen[agentaction.FieldAgentType] = struct{}{}
+			}
+		case "actionType":
+			if _, ok := fieldSeen[agentaction.FieldActionType]; !ok {
+				selectedFields = append(selectedFields, agentaction.FieldActionType)
+				fieldSeen[agentaction.FieldActionType] = struct{}{}
+			}
+		case "actionName":
+			if _, ok := fieldSeen[agentaction.FieldActionName]; !ok {
+				selectedFields = append(selectedFields, agentaction.FieldActionName)
+				fieldSeen[agentaction.FieldActionName] = struct{}{}
+			}
+		case "parameters":
+			if _, ok := fieldSeen[agentaction.FieldParameters]; !ok {
+				selectedFields = append(selectedFields, agentaction.FieldParameters)
+				fieldSeen[agentaction.FieldParameters] = struct{}{}
+			}
+		case "targetResource":
+			if _, ok := fieldSeen[agentaction.FieldTargetResource]; !ok {
+				selectedFields = append(selectedFields, agentaction.FieldTargetResource)
+				fieldSeen[agentaction.FieldTargetResource] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[agentaction.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, agentaction.FieldStatus)
+				fieldSeen[agentaction.FieldStatus] = struct{}{}
+			}
+		case "result":
+			if _, ok := fieldSeen[agentaction.FieldResult]; !ok {
+				selectedFields = append(selectedFields, agentaction.FieldResult)
+				fieldSeen[agentaction.FieldResult] = struct{}{}
+			}
+		case "error":
+			if _, ok := fieldSeen[agentaction.FieldError]; !ok {
+				selectedFields = append(selectedFields, agentaction.FieldError)
+				fieldSeen[agentaction.FieldError] = struct{}{}
+			}
+		case "latencyMs":
+			if _, ok := fieldSeen[agentaction.FieldLatencyMs]; !ok {
+				selectedFields = append(selectedFields, agentaction.FieldLatencyMs)
+				fieldSeen[agentaction.FieldLatencyMs] = struct{}{}
+			}
+		case "sessionID":
+			if _, ok := fieldSeen[agentaction.FieldSessionID]; !ok {
+				selectedFields = append(selectedFields, agentaction.FieldSessionID)
+				fieldSeen[agentaction.FieldSessionID] = struct{}{}
+			}
+		case "userID":
+			if _, ok := fieldSeen[agentaction.FieldUserID]; !ok {
+				selectedFields = append(selectedFields, agentaction.FieldUserID)
+				fieldSeen[agentaction.FieldUserID] = struct{}{}
+			}
+		case "metadata":
+			if _, ok := fieldSeen[agentaction.FieldMetadata]; !ok {
+				selectedFields = append(selectedFields, agentaction.FieldMetadata)
+				fieldSeen[agentaction.FieldMetadata] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		aa.Select(selectedFields...)
+	}
+	return nil
+}
+
+type agentactionPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []AgentActionPaginateOption
+}
+
+func newAgentActionPaginateArgs(rv map[string]any) *agentactionPaginateArgs {
+	args := &agentactionPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &AgentActionOrder{Field: &AgentActionOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithAgentActionOrder(order))
+			}
+		case *AgentActionOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithAgentActionOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*AgentActionWhereInput); ok {
+		args.opts = append(args.opts, WithAgentActionFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (eo *ExternalOutputQuery) CollectFields(ctx context.Context, satisfies ...string) (*ExternalOutputQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return eo, nil
+	}
+	if err := eo.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return eo, nil
+}
+
+func (eo *ExternalOutputQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(externaloutput.Columns))
+		selectedFields = []string{externaloutput.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "workflows":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&WorkflowExecutionClient{config: eo.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, workflowexecutionImplementors)...); err != nil {
+				return err
+			}
+			eo.WithNamedWorkflows(alias, func(wq *WorkflowExecutionQuery) {
+				*wq = *query
+			})
+		case "provenanceHash":
+			if _, ok := fieldSeen[externaloutput.FieldProvenanceHash]; !ok {
+				selectedFields = append(selectedFields, externaloutput.FieldProvenanceHash)
+				fieldSeen[externaloutput.FieldProvenanceHash] = struct{}{}
+			}
+		case "timestamp":
+			if _, ok := fieldSeen[externaloutput.FieldTimestamp]; !ok {
+				selectedFields = append(selectedFields, externaloutput.FieldTimestamp)
+				fieldSeen[externaloutput.FieldTimestamp] = struct{}{}
+			}
+		case "outputType":
+			if _, ok := fieldSeen[externaloutput.FieldOutputType]; !ok {
+				selectedFields = append(selectedFields, externaloutput.FieldOutputType)
+				fieldSeen[externaloutput.FieldOutputType] = struct{}{}
+			}
+		case "destination":
+			if _, ok := fieldSeen[externaloutput.FieldDestination]; !ok {
+				selectedFields = append(selectedFields, externaloutput.FieldDestination)
+				fieldSeen[externaloutput.FieldDestination] = struct{}{}
+			}
+		case "destinationID":
+			if _, ok := fieldSeen[externaloutput.FieldDestinationID]; !ok {
+				selectedFields = append(selectedFields, externaloutput.FieldDestinationID)
+				fieldSeen[externaloutput.FieldDestinationID] = struct{}{}
+			}
+		case "transactionID":
+			if _, ok := fieldSeen[externaloutput.FieldTransactionID]; !ok {
+				selectedFields = append(selectedFields, externaloutput.FieldTransactionID)
+				fieldSeen[externaloutput.FieldTransactionID] = struct{}{}
+			}
+		case "blockHash":
+			if _, ok := fieldSeen[externaloutput.FieldBlockHash]; !ok {
+				selectedFields = append(selectedFields, externaloutput.FieldBlockHash)
+				fieldSeen[externaloutput.FieldBlockHash] = struct{}{}
+			}
+		case "blockNumber":
+			if _, ok := fieldSeen[externaloutput.FieldBlockNumber]; !ok {
+				selectedFields = append(selectedFields, externaloutput.FieldBlockNumber)
+				fieldSeen[externaloutput.FieldBlockNumber] = struct{}{}
+			}
+		case "contentHash":
+			if _, ok := fieldSeen[externaloutput.FieldContentHash]; !ok {
+				selectedFields = append(selectedFields, externaloutput.FieldContentHash)
+				fieldSeen[externaloutput.FieldContentHash] = struct{}{}
+			}
+		case "contentSize":
+			if _, ok := fieldSeen[externaloutput.FieldContentSize]; !ok {
+				selectedFields = append(selectedFields, externaloutput.FieldContentSize)
+				fieldSeen[externaloutput.FieldContentSize] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[externaloutput.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, externaloutput.FieldStatus)
+				fieldSeen[externaloutput.FieldStatus] = struct{}{}
+			}
+		case "domain":
+			if _, ok := fieldSeen[externaloutput.FieldDomain]; !ok {
+				selectedFields = append(selectedFields, externaloutput.FieldDomain)
+				fieldSeen[externaloutput.FieldDomain] = struct{}{}
+			}
+		case "compliance":
+			if _, ok := fieldSeen[externaloutput.FieldCompliance]; !ok {
+				selectedFields = append(selectedFields, externaloutput.FieldCompliance)
+				fieldSeen[externaloutput.FieldCompliance] = struct{}{}
+			}
+		case "retentionYears":
+			if _, ok := fieldSeen[externaloutput.FieldRetentionYears]; !ok {
+				selectedFields = append(selectedFields, externaloutput.FieldRetentionYears)
+				fieldSeen[externaloutput.FieldRetentionYears] = struct{}{}
+			}
+		case "metadata":
+			if _, ok := fieldSeen[externaloutput.FieldMetadata]; !ok {
+				selectedFields = append(selectedFields, externaloutput.FieldMetadata)
+				fieldSeen[externaloutput.FieldMetadata] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		eo.Select(selectedFields...)
+	}
+	return nil
+}
+
+type externaloutputPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []ExternalOutputPaginateOption
+}
+
+func newExternalOutputPaginateArgs(rv map[string]any) *externaloutputPaginateArgs {
+	args := &externaloutputPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &ExternalOutputOrder{Field: &ExternalOutputOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithExternalOutputOrder(order))
+			}
+		case *ExternalOutputOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithExternalOutputOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*ExternalOutputWhereInput); ok {
+		args.opts = append(args.opts, WithExternalOutputFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (rd *RoutingDecisionQuery) CollectFields(ctx context.Context, satisfies ...string) (*RoutingDecisionQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return rd, nil
+	}
+	if err := rd.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return rd, nil
+}
+
+func (rd *RoutingDecisionQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(routingdecision.Columns))
+		selectedFields = []string{routingdecision.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "spikeEvents":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&SpikeEventClient{config: rd.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, spikeeventImplementors)...); err != nil {
+				return err
+			}
+			rd.WithNamedSpikeEvents(alias, func(wq *SpikeEventQuery) {
+				*wq = *query
+			})
+
+		case "actions":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&AgentActionClient{config: rd.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, agentactionImplementors)...); err != nil {
+				return err
+			}
+			rd.WithNamedActions(alias, func(wq *AgentActionQuery) {
+				*wq = *query
+			})
+		case "provenanceHash":
+			if _, ok := fieldSeen[routingdecision.FieldProvenanceHash]; !ok {
+				selectedFields = append(selectedFields, routingdecision.FieldProvenanceHash)
+				fieldSeen[routingdecision.FieldProvenanceHash] = struct{}{}
+			}
+		case "timestamp":
+			if _, ok := fieldSeen[routingdecision.FieldTimestamp]; !ok {
+				selectedFields = append(selectedFields, routingdecision.FieldTimestamp)
+				fieldSeen[routingdecision.FieldTimestamp] = struct{}{}
+			}
+		case "inferenceID":
+			if _, ok := fieldSeen[routingdecision.FieldInferenceID]; !ok {
+				selectedFields = append(selectedFields, routingdecision.FieldInferenceID)
+				fieldSeen[routingdecision.FieldInferenceID] = struct{}{}
+			}
+		case "decisionType":
+			if _, ok := fieldSeen[routingdecision.FieldDecisionType]; !ok {
+				selectedFields = append(selectedFields, routingdecision.FieldDecisionType)
+				fieldSeen[routingdecision.FieldDecisionType] = struct{}{}
+			}
+		case "layerIndex":
+			if _, ok := fieldSeen[routingdecision.FieldLayerIndex]; !ok {
+				selectedFields = append(selectedFields, routingdecision.FieldLayerIndex)
+				fieldSeen[routingdecision.FieldLayerIndex] = struct{}{}
+			}
+		case "gateProbability":
+			if _, ok := fieldSeen[routingdecision.FieldGateProbability]; !ok {
+				selectedFields = append(selectedFields, routingdecision.FieldGateProbability)
+				fieldSeen[routingdecision.FieldGateProbability] = struct{}{}
+			}
+		case "selectedModel":
+			if _, ok := fieldSeen[routingdecision.FieldSelectedModel]; !ok {
+				selectedFields = append(selectedFields, routingdecision.FieldSelectedModel)
+				fieldSeen[routingdecision.FieldSelectedModel] = struct{}{}
+			}
+		case "iterationCount":
+			if _, ok := fieldSeen[routingdecision.FieldIterationCount]; !ok {
+				selectedFields = append(selectedFields, routingdecision.FieldIterationCount)
+				fieldSeen[routingdecision.FieldIterationCount] = struct{}{}
+			}
+		case "confidence":
+			if _, ok := fieldSeen[routingdecision.FieldConfidence]; !ok {
+				selectedFields = append(selectedFields, routingdecision.FieldConfidence)
+				fieldSeen[routingdecision.FieldConfidence] = struct{}{}
+			}
+		case "domain":
+			if _, ok := fieldSeen[routingdecision.FieldDomain]; !ok {
+				selectedFields = append(selectedFields, routingdecision.FieldDomain)
+				fieldSeen[routingdecision.FieldDomain] = struct{}{}
+			}
+		case "metadata":
+			if _, ok := fieldSeen[routingdecision.FieldMetadata]; !ok {
+				selectedFields = append(selectedFields, routingdecision.FieldMetadata)
+				fieldSeen[routingdecision.FieldMetadata] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		rd.Select(selectedFields...)
+	}
+	return nil
+}
+
+type routingdecisionPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []RoutingDecisionPaginateOption
+}
+
+func newRoutingDecisionPaginateArgs(rv map[string]any) *routingdecisionPaginateArgs {
+	args := &routingdecisionPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &RoutingDecisionOrder{Field: &RoutingDecisionOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithRoutingDecisionOrder(order))
+			}
+		case *RoutingDecisionOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithRoutingDecisionOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*RoutingDecisionWhereInput); ok {
+		args.opts = append(args.opts, WithRoutingDecisionFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (se *SpikeEventQuery) CollectFields(ctx context.Context, satisfies ...string) (*SpikeEventQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return se, nil
+	}
+	if err := se.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return se, nil
+}
+
+func (se *SpikeEventQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(spikeevent.Columns))
+		selectedFields = []string{spikeevent.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "decisions":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&RoutingDecisionClient{config: se.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, routingdecisionImplementors)...); err != nil {
+				return err
+			}
+			se.WithNamedDecisions(alias, func(wq *RoutingDecisionQuery) {
+				*wq = *query
+			})
+		case "provenanceHash":
+			if _, ok := fieldSeen[spikeevent.FieldProvenanceHash]; !ok {
+				selectedFields = append(selectedFields, spikeevent.FieldProvenanceHash)
+				fieldSeen[spikeevent.FieldProvenanceHash] = struct{}{}
+			}
+		case "timestamp":
+			if _, ok := fieldSeen[spikeevent.FieldTimestamp]; !ok {
+				selectedFields = append(selectedFields, spikeevent.FieldTimestamp)
+				fieldSeen[spikeevent.FieldTimestamp] = struct{}{}
+			}
+		case "timestampNs":
+			if _, ok := fieldSeen[spikeevent.FieldTimestampNs]; !ok {
+				selectedFields = append(selectedFields, spikeevent.FieldTimestampNs)
+				fieldSeen[spikeevent.FieldTimestampNs] = struct{}{}
+			}
+		case "populationID":
+			if _, ok := fieldSeen[spikeevent.FieldPopulationID]; !ok {
+				selectedFields = append(selectedFields, spikeevent.FieldPopulationID)
+				fieldSeen[spikeevent.FieldPopulationID] = struct{}{}
+			}
+		case "layerIndex":
+			if _, ok := fieldSeen[spikeevent.FieldLayerIndex]; !ok {
+				selectedFields = append(selectedFields, spikeevent.FieldLayerIndex)
+				fieldSeen[spikeevent.FieldLayerIndex] = struct{}{}
+			}
+		case "neuronIndices":
+			if _, ok := fieldSeen[spikeevent.FieldNeuronIndices]; !ok {
+				selectedFields = append(selectedFields, spikeevent.FieldNeuronIndices)
+				fieldSeen[spikeevent.FieldNeuronIndices] = struct{}{}
+			}
+		case "spikeCounts":
+			if _, ok := fieldSeen[spikeevent.FieldSpikeCounts]; !ok {
+				selectedFields = append(selectedFields, spikeevent.FieldSpikeCounts)
+				fieldSeen[spikeevent.FieldSpikeCounts] = struct{}{}
+			}
+		case "membranePotentials":
+			if _, ok := fieldSeen[spikeevent.FieldMembranePotentials]; !ok {
+				selectedFields = append(selectedFields, spikeevent.FieldMembranePotentials)
+				fieldSeen[spikeevent.FieldMembranePotentials] = struct{}{}
+			}
+		case "inputCurrents":
+			if _, ok := fieldSeen[spikeevent.FieldInputCurrents]; !ok {
+				selectedFields = append(selectedFields, spikeevent.FieldInputCurrents)
+				fieldSeen[spikeevent.FieldInputCurrents] = struct{}{}
+			}
+		case "patternHash":
+			if _, ok := fieldSeen[spikeevent.FieldPatternHash]; !ok {
+				selectedFields = append(selectedFields, spikeevent.FieldPatternHash)
+				fieldSeen[spikeevent.FieldPatternHash] = struct{}{}
+			}
+		case "inferenceID":
+			if _, ok := fieldSeen[spikeevent.FieldInferenceID]; !ok {
+				selectedFields = append(selectedFields, spikeevent.FieldInferenceID)
+				fieldSeen[spikeevent.FieldInferenceID] = struct{}{}
+			}
+		case "isEmergent":
+			if _, ok := fieldSeen[spikeevent.FieldIsEmergent]; !ok {
+				selectedFields = append(selectedFields, spikeevent.FieldIsEmergent)
+				fieldSeen[spikeevent.FieldIsEmergent] = struct{}{}
+			}
+		case "entropy":
+			if _, ok := fieldSeen[spikeevent.FieldEntropy]; !ok {
+				selectedFields = append(selectedFields, spikeevent.FieldEntropy)
+				fieldSeen[spikeevent.FieldEntropy] = struct{}{}
+			}
+		case "metadata":
+			if _, ok := fieldSeen[spikeevent.FieldMetadata]; !ok {
+				selectedFields = append(selectedFields, spikeevent.FieldMetadata)
+				fieldSeen[spikeevent.FieldMetadata] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		se.Select(selectedFields...)
+	}
+	return nil
+}
+
+type spikeeventPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []SpikeEventPaginateOption
+}
+
+func newSpikeEventPaginateArgs(rv map[string]any) *spikeeventPaginateArgs {
+	args := &spikeeventPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &SpikeEventOrder{Field: &SpikeEventOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithSpikeEventOrder(order))
+			}
+		case *SpikeEventOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithSpikeEventOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*SpikeEventWhereInput); ok {
+		args.opts = append(args.opts, WithSpikeEventFilter(v.Filter))
+	}
+	return args
+}
+
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (we *WorkflowExecutionQuery) CollectFields(ctx context.Context, satisfies ...string) (*WorkflowExecutionQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return we, nil
+	}
+	if err := we.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return we, nil
+}
+
+func (we *WorkflowExecutionQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(workflowexecution.Columns))
+		selectedFields = []string{workflowexecution.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "actions":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&AgentActionClient{config: we.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, agentactionImplementors)...); err != nil {
+				return err
+			}
+			we.WithNamedActions(alias, func(wq *AgentActionQuery) {
+				*wq = *query
+			})
+
+		case "outputs":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&ExternalOutputClient{config: we.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, externaloutputImplementors)...); err != nil {
+				return err
+			}
+			we.WithNamedOutputs(alias, func(wq *ExternalOutputQuery) {
+				*wq = *query
+			})
+
+		case "parentExecution":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&WorkflowExecutionClient{config: we.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, workflowexecutionImplementors)...); err != nil {
+				return err
+			}
+			we.withParentExecution = query
+			if _, ok := fieldSeen[workflowexecution.FieldParentExecutionID]; !ok {
+				selectedFields = append(selectedFields, workflowexecution.FieldParentExecutionID)
+				fieldSeen[workflowexecution.FieldParentExecutionID] = struct{}{}
+			}
+
+		case "childExecutions":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&WorkflowExecutionClient{config: we.config}).Query()
+			)
+			if err := query.collectField(ctx, false, opCtx, field, path, mayAddCondition(satisfies, workflowexecutionImplementors)...); err != nil {
+				return err
+			}
+			we.WithNamedChildExecutions(alias, func(wq *WorkflowExecutionQuery) {
+				*wq = *query
+			})
+		case "provenanceHash":
+			if _, ok := fieldSeen[workflowexecution.FieldProvenanceHash]; !ok {
+				selectedFields = append(selectedFields, workflowexecution.FieldProvenanceHash)
+				fieldSeen[workflowexecution.FieldProvenanceHash] = struct{}{}
+			}
+		case "startedAt":
+			if _, ok := fieldSeen[workflowexecution.FieldStartedAt]; !ok {
+				selectedFields = append(selectedFields, workflowexecution.FieldStartedAt)
+				fieldSeen[workflowexecution.FieldStartedAt] = struct{}{}
+			}
+		case "completedAt":
+			if _, ok := fieldSeen[workflowexecution.FieldCompletedAt]; !ok {
+				selectedFields = append(selectedFields, workflowexecution.FieldCompletedAt)
+				fieldSeen[workflowexecution.FieldCompletedAt] = struct{}{}
+			}
+		case "workflowID":
+			if _, ok := fieldSeen[workflowexecution.FieldWorkflowID]; !ok {
+				selectedFields = append(selectedFields, workflowexecution.FieldWorkflowID)
+				fieldSeen[workflowexecution.FieldWorkflowID] = struct{}{}
+			}
+		case "workflowName":
+			if _, ok := fieldSeen[workflowexecution.FieldWorkflowName]; !ok {
+				selectedFields = append(selectedFields, workflowexecution.FieldWorkflowName)
+				fieldSeen[workflowexecution.FieldWorkflowName] = struct{}{}
+			}
+		case "stepID":
+			if _, ok := fieldSeen[workflowexecution.FieldStepID]; !ok {
+				selectedFields = append(selectedFields, workflowexecution.FieldStepID)
+				fieldSeen[workflowexecution.FieldStepID] = struct{}{}
+			}
+		case "stepName":
+			if _, ok := fieldSeen[workflowexecution.FieldStepName]; !ok {
+				selectedFields = append(selectedFields, workflowexecution.FieldStepName)
+				fieldSeen[workflowexecution.FieldStepName] = struct{}{}
+			}
+		case "stepIndex":
+			if _, ok := fieldSeen[workflowexecution.FieldStepIndex]; !ok {
+				selectedFields = append(selectedFields, workflowexecution.FieldStepIndex)
+				fieldSeen[workflowexecution.FieldStepIndex] = struct{}{}
+			}
+		case "status":
+			if _, ok := fieldSeen[workflowexecution.FieldStatus]; !ok {
+				selectedFields = append(selectedFields, workflowexecution.FieldStatus)
+				fieldSeen[workflowexecution.FieldStatus] = struct{}{}
+			}
+		case "inputs":
+			if _, ok := fieldSeen[workflowexecution.FieldInputs]; !ok {
+				selectedFields = append(selectedFields, workflowexecution.FieldInputs)
+				fieldSeen[workflowexecution.FieldInputs] = struct{}{}
+			}
+		case "outputData":
+			if _, ok := fieldSeen[workflowexecution.FieldOutputData]; !ok {
+				selectedFields = append(selectedFields, workflowexecution.FieldOutputData)
+				fieldSeen[workflowexecution.FieldOutputData] = struct{}{}
+			}
+		case "error":
+			if _, ok := fieldSeen[workflowexecution.FieldError]; !ok {
+				selectedFields = append(selectedFields, workflowexecution.FieldError)
+				fieldSeen[workflowexecution.FieldError] = struct{}{}
+			}
+		case "durationMs":
+			if _, ok := fieldSeen[workflowexecution.FieldDurationMs]; !ok {
+				selectedFields = append(selectedFields, workflowexecution.FieldDurationMs)
+				fieldSeen[workflowexecution.FieldDurationMs] = struct{}{}
+			}
+		case "parentExecutionID":
+			if _, ok := fieldSeen[workflowexecution.FieldParentExecutionID]; !ok {
+				selectedFields = append(selectedFields, workflowexecution.FieldParentExecutionID)
+				fieldSeen[workflowexecution.FieldParentExecutionID] = struct{}{}
+			}
+		case "metadata":
+			if _, ok := fieldSeen[workflowexecution.FieldMetadata]; !ok {
+				selectedFields = append(selectedFields, workflowexecution.FieldMetadata)
+				fieldSeen[workflowexecution.FieldMetadata] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		we.Select(selectedFields...)
+	}
+	return nil
+}
+
+type workflowexecutionPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []WorkflowExecutionPaginateOption
+}
+
+func newWorkflowExecutionPaginateArgs(rv map[string]any) *workflowexecutionPaginateArgs {
+	args := &workflowexecutionPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &WorkflowExecutionOrder{Field: &WorkflowExecutionOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithWorkflowExecutionOrder(order))
+			}
+		case *WorkflowExecutionOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithWorkflowExecutionOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*WorkflowExecutionWhereInput); ok {
+		args.opts = append(args.opts, WithWorkflowExecutionFilter(v.Filter))
+	}
+	return args
+}
+
+const (
+	afterField     = "after"
+	firstField     = "first"
+	beforeField    = "before"
+	lastField      = "last"
+	orderByField   = "orderBy"
+	directionField = "direction"
+	fieldField     = "field"
+	whereField     = "where"
+)
+
+func fieldArgs(ctx context.Context, whereInput any, path ...string) map[string]any {
+	field := collectedField(ctx, path...)
+	if field == nil || field.Arguments == nil {
+		return nil
+	}
+	oc := graphql.GetOperationContext(ctx)
+	args := field.ArgumentMap(oc.Variables)
+	return unmarshalArgs(ctx, whereInput, args)
+}
+
+// unmarshalArgs allows extracting the field arguments from their raw representation.
+func unmarshalArgs(ctx context.Context, whereInput any, args map[string]any) map[string]any {
+	for _, k := range []string{firstField, lastField} {
+		v, ok := args[k]
+		if !ok || v == nil {
+			continue
+		}
+		i, err := graphql.UnmarshalInt(v)
+		if err == nil {
+			args[k] = &i
+		}
+	}
+	for _, k := range []string{beforeField, afterField} {
+		v, ok := args[k]
+		if !ok {
+			continue
+		}
+		c := &Cursor{}
+		if c.UnmarshalGQL(v) == nil {
+			args[k] = c
+		}
+	}
+	if v, ok := args[whereField]; ok && whereInput != nil {
+		if err := graphql.UnmarshalInputFromContext(ctx, v, whereInput); err == nil {
+			args[whereField] = whereInput
+		}
+	}
+
+	return args
+}
+
+// mayAddCondition appends another type condition to the satisfies list
+// if it does not exist in the list.
+func mayAddCondition(satisfies []string, typeCond []string) []string {
+Cond:
+	for _, c := range typeCond {
+		for _, s := range satisfies {
+			if c == s {
+				continue Cond
+			}
+		}
+		satisfies = append(satisfies, c)
+	}
+	return satisfies
+}
@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func (aa *AgentAction) Decisions(ctx context.Context) (result []*RoutingDecision, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = aa.NamedDecisions(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = aa.Edges.DecisionsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = aa.QueryDecisions().All(ctx)
+	}
+	return result, err
+}
+
+func (aa *AgentAction) Workflows(ctx context.Context) (result []*WorkflowExecution, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = aa.NamedWorkflows(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = aa.Edges.WorkflowsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = aa.QueryWorkflows().All(ctx)
+	}
+	return result, err
+}
+
+func (eo *ExternalOutput) Workflows(ctx context.Context) (result []*WorkflowExecution, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = eo.NamedWorkflows(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = eo.Edges.WorkflowsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = eo.QueryWorkflows().All(ctx)
+	}
+	return result, err
+}
+
+func (rd *RoutingDecision) SpikeEvents(ctx context.Context) (result []*SpikeEvent, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = rd.NamedSpikeEvents(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = rd.Edges.SpikeEventsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = rd.QuerySpikeEvents().All(ctx)
+	}
+	return result, err
+}
+
+func (rd *RoutingDecision) Actions(ctx context.Context) (result []*AgentAction, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = rd.NamedActions(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = rd.Edges.ActionsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = rd.QueryActions().All(ctx)
+	}
+	return result, err
+}
+
+func (se *SpikeEvent) Decisions(ctx context.Context) (result []*RoutingDecision, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = se.NamedDecisions(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = se.Edges.DecisionsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = se.QueryDecisions().All(ctx)
+	}
+	return result, err
+}
+
+func (we *WorkflowExecution) Actions(ctx context.Context) (result []*AgentAction, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = we.NamedActions(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = we.Edges.ActionsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = we.QueryActions().All(ctx)
+	}
+	return result, err
+}
+
+func (we *WorkflowExecution) Outputs(ctx context.Context) (result []*ExternalOutput, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = we.NamedOutputs(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = we.Edges.OutputsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = we.QueryOutputs().All(ctx)
+	}
+	return result, err
+}
+
+func (we *WorkflowExecution) ParentExecution(ctx context.Context) (*WorkflowExecution, error) {
+	result, err := we.Edges.ParentExecutionOrErr()
+	if IsNotLoaded(err) {
+		result, err = we.QueryParentExecution().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
+func (we *WorkflowExecution) ChildExecutions(ctx context.Context) (result []*WorkflowExecution, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = we.NamedChildExecutions(graphql.GetFieldContext(ctx).Field.Alias)
+	} else {
+		result, err = we.Edges.ChildExecutionsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = we.QueryChildExecutions().All(ctx)
+	}
+	return result, err
+}
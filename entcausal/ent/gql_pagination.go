@@ -0,0 +1,1609 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/spikeevent"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Common entgql types.
+type (
+	Cursor         = entgql.Cursor[string]
+	PageInfo       = entgql.PageInfo[string]
+	OrderDirection = entgql.OrderDirection
+)
+
+func orderFunc(o OrderDirection, field string) func(*sql.Selector) {
+	if o.Desc() {
+		return Desc(field)
+	}
+	return Asc(field)
+}
+
+const errInvalidPagination = "INVALID_PAGINATION"
+
+func validateFirstLast(first, last *int) (err *gqlerror.Error) {
+	switch {
+	case first != nil && last != nil:
+		err = &gqlerror.Error{
+			Message: "Passing both `first` and `last` to paginate a connection is not supported.",
+		}
+	case first != nil && *first < 0:
+		err = &gqlerror.Error{
+			Message: "`first` on a connection cannot be less than zero.",
+		}
+		errcode.Set(err, errInvalidPagination)
+	case last != nil && *last < 0:
+		err = &gqlerror.Error{
+			Message: "`last` on a connection cannot be less than zero.",
+		}
+		errcode.Set(err, errInvalidPagination)
+	}
+	return err
+}
+
+func collectedField(ctx context.Context, path ...string) *graphql.CollectedField {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return nil
+	}
+	field := fc.Field
+	oc := graphql.GetOperationContext(ctx)
+walk:
+	for _, name := range path {
+		for _, f := range graphql.CollectFields(oc, field.Selections, nil) {
+			if f.Alias == name {
+				field = f
+				continue walk
+			}
+		}
+		return nil
+	}
+	return &field
+}
+
+func hasCollectedField(ctx context.Context, path ...string) bool {
+	if graphql.GetFieldContext(ctx) == nil {
+		return true
+	}
+	return collectedField(ctx, path...) != nil
+}
+
+const (
+	edgesField      = "edges"
+	nodeField       = "node"
+	pageInfoField   = "pageInfo"
+	totalCountField = "totalCount"
+	aggregateField  = "aggregate"
+)
+
+func paginateLimit(first, last *int) int {
+	var limit int
+	if first != nil {
+		limit = *first + 1
+	} else if last != nil {
+		limit = *last + 1
+	}
+	return limit
+}
+
+// AgentActionEdge is the edge representation of AgentAction.
+type AgentActionEdge struct {
+	Node   *AgentAction `json:"node"`
+	Cursor Cursor       `json:"cursor"`
+}
+
+// AgentActionConnection is the connection containing edges to AgentAction.
+type AgentActionConnection struct {
+	Edges      []*AgentActionEdge `json:"edges"`
+	PageInfo   PageInfo           `json:"pageInfo"`
+	TotalCount int                `json:"totalCount"`
+}
+
+func (c *AgentActionConnection) build(nodes []*AgentAction, pager *agentactionPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *AgentAction
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *AgentAction {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *AgentAction {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*AgentActionEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &AgentActionEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// AgentActionPaginateOption enables pagination customization.
+type AgentActionPaginateOption func(*agentactionPager) error
+
+// WithAgentActionOrder configures pagination ordering.
+func WithAgentActionOrder(order *AgentActionOrder) AgentActionPaginateOption {
+	if order == nil {
+		order = DefaultAgentActionOrder
+	}
+	o := *order
+	return func(pager *agentactionPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultAgentActionOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithAgentActionFilter configures pagination filter.
+func WithAgentActionFilter(filter func(*AgentActionQuery) (*AgentActionQuery, error)) AgentActionPaginateOption {
+	return func(pager *agentactionPager) error {
+		if filter == nil {
+			return errors.New("AgentActionQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type agentactionPager struct {
+	reverse bool
+	order   *AgentActionOrder
+	filter  func(*AgentActionQuery) (*AgentActionQuery, error)
+}
+
+func newAgentActionPager(opts []AgentActionPaginateOption, reverse bool) (*agentactionPager, error) {
+	pager := &agentactionPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultAgentActionOrder
+	}
+	return pager, nil
+}
+
+func (p *agentactionPager) applyFilter(query *AgentActionQuery) (*AgentActionQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *agentactionPager) toCursor(aa *AgentAction) Cursor {
+	return p.order.Field.toCursor(aa)
+}
+
+func (p *agentactionPager) applyCursors(query *AgentActionQuery, after, before *Cursor) (*AgentActionQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	cursorsPredicate := entgql.CursorsPredicate[string]
+	if p.order.Field.nullable {
+		cursorsPredicate = entgql.NullableCursorsPredicate[string]
+	}
+	for _, predicate := range cursorsPredicate(after, before, DefaultAgentActionOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *agentactionPager) applyOrder(query *AgentActionQuery) *AgentActionQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(entgql.OrderByTerm(direction, p.order.Field.column, p.order.Field.toTerm))
+	if p.order.Field != DefaultAgentActionOrder.Field {
+		query = query.Order(DefaultAgentActionOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *agentactionPager) orderExpr(query *AgentActionQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Join(direction.OrderExpr(p.order.Field.column))
+		if p.order.Field != DefaultAgentActionOrder.Field {
+			b.Comma().Join(direction.OrderExpr(DefaultAgentActionOrder.Field.column))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to AgentAction.
+func (aa *AgentActionQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...AgentActionPaginateOption,
+) (*AgentActionConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newAgentActionPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if aa, err = pager.applyFilter(aa); err != nil {
+		return nil, err
+	}
+	conn := &AgentActionConnection{Edges: []*AgentActionEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := aa.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if aa, err = pager.applyCursors(aa, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		aa.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := aa.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	aa = pager.applyOrder(aa)
+	nodes, err := aa.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// AgentActionOrderFieldTimestamp orders AgentAction by timestamp.
+	AgentActionOrderFieldTimestamp = &AgentActionOrderField{
+		Value: func(aa *AgentAction) (ent.Value, error) {
+			return aa.Timestamp, nil
+		},
+		column: agentaction.FieldTimestamp,
+		toTerm: agentaction.ByTimestamp,
+		toCursor: func(aa *AgentAction) Cursor {
+			return Cursor{
+				ID:    aa.ID,
+				Value: aa.Timestamp,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f AgentActionOrderField) String() string {
+	var str string
+	switch f.column {
+	case AgentActionOrderFieldTimestamp.column:
+		str = "TIMESTAMP"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f AgentActionOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *AgentActionOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("AgentActionOrderField %T must be a string", v)
+	}
+	switch str {
+	case "TIMESTAMP":
+		*f = *AgentActionOrderFieldTimestamp
+	default:
+		return fmt.Errorf("%s is not a valid AgentActionOrderField", str)
+	}
+	return nil
+}
+
+// AgentActionOrderField defines the ordering field of AgentAction.
+type AgentActionOrderField struct {
+	// Value extracts the ordering value from the given AgentAction.
+	Value    func(*AgentAction) (ent.Value, error)
+	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
+	toTerm   func(...sql.OrderTermOption) agentaction.OrderOption
+	toCursor func(*AgentAction) Cursor
+}
+
+// AgentActionOrder defines the ordering of AgentAction.
+type AgentActionOrder struct {
+	Direction OrderDirection         `json:"direction"`
+	Field     *AgentActionOrderField `json:"field"`
+}
+
+// DefaultAgentActionOrder is the default ordering of AgentAction.
+var DefaultAgentActionOrder = &AgentActionOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &AgentActionOrderField{
+		Value: func(aa *AgentAction) (ent.Value, error) {
+			return aa.ID, nil
+		},
+		column: agentaction.FieldID,
+		toTerm: agentaction.ByID,
+		toCursor: func(aa *AgentAction) Cursor {
+			return Cursor{ID: aa.ID}
+		},
+	},
+}
+
+// ToEdge converts AgentAction into AgentActionEdge.
+func (aa *AgentAction) ToEdge(order *AgentActionOrder) *AgentActionEdge {
+	if order == nil {
+		order = DefaultAgentActionOrder
+	}
+	return &AgentActionEdge{
+		Node:   aa,
+		Cursor: order.Field.toCursor(aa),
+	}
+}
+
+// ExternalOutputEdge is the edge representation of ExternalOutput.
+type ExternalOutputEdge struct {
+	Node   *ExternalOutput `json:"node"`
+	Cursor Cursor          `json:"cursor"`
+}
+
+// ExternalOutputConnection is the connection containing edges to ExternalOutput.
+type ExternalOutputConnection struct {
+	Edges      []*ExternalOutputEdge `json:"edges"`
+	PageInfo   PageInfo              `json:"pageInfo"`
+	TotalCount int                   `json:"totalCount"`
+}
+
+func (c *ExternalOutputConnection) build(nodes []*ExternalOutput, pager *externaloutputPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *ExternalOutput
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *ExternalOutput {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *ExternalOutput {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*ExternalOutputEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &ExternalOutputEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// ExternalOutputPaginateOption enables pagination customization.
+type ExternalOutputPaginateOption func(*externaloutputPager) error
+
+// WithExternalOutputOrder configures pagination ordering.
+func WithExternalOutputOrder(order *ExternalOutputOrder) ExternalOutputPaginateOption {
+	if order == nil {
+		order = DefaultExternalOutputOrder
+	}
+	o := *order
+	return func(pager *externaloutputPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultExternalOutputOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithExternalOutputFilter configures pagination filter.
+func WithExternalOutputFilter(filter func(*ExternalOutputQuery) (*ExternalOutputQuery, error)) ExternalOutputPaginateOption {
+	return func(pager *externaloutputPager) error {
+		if filter == nil {
+			return errors.New("ExternalOutputQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type externaloutputPager struct {
+	reverse bool
+	order   *ExternalOutputOrder
+	filter  func(*ExternalOutputQuery) (*ExternalOutputQuery, error)
+}
+
+func newExternalOutputPager(opts []ExternalOutputPaginateOption, reverse bool) (*externaloutputPager, error) {
+	pager := &externaloutputPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultExternalOutputOrder
+	}
+	return pager, nil
+}
+
+func (p *externaloutputPager) applyFilter(query *ExternalOutputQuery) (*ExternalOutputQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *externaloutputPager) toCursor(eo *ExternalOutput) Cursor {
+	return p.order.Field.toCursor(eo)
+}
+
+func (p *externaloutputPager) applyCursors(query *ExternalOutputQuery, after, before *Cursor) (*ExternalOutputQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	cursorsPredicate := entgql.CursorsPredicate[string]
+	if p.order.Field.nullable {
+		cursorsPredicate = entgql.NullableCursorsPredicate[string]
+	}
+	for _, predicate := range cursorsPredicate(after, before, DefaultExternalOutputOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *externaloutputPager) applyOrder(query *ExternalOutputQuery) *ExternalOutputQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(entgql.OrderByTerm(direction, p.order.Field.column, p.order.Field.toTerm))
+	if p.order.Field != DefaultExternalOutputOrder.Field {
+		query = query.Order(DefaultExternalOutputOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *externaloutputPager) orderExpr(query *ExternalOutputQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Join(direction.OrderExpr(p.order.Field.column))
+		if p.order.Field != DefaultExternalOutputOrder.Field {
+			b.Comma().Join(direction.OrderExpr(DefaultExternalOutputOrder.Field.column))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to ExternalOutput.
+func (eo *ExternalOutputQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...ExternalOutputPaginateOption,
+) (*ExternalOutputConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newExternalOutputPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if eo, err = pager.applyFilter(eo); err != nil {
+		return nil, err
+	}
+	conn := &ExternalOutputConnection{Edges: []*ExternalOutputEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := eo.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if eo, err = pager.applyCursors(eo, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		eo.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := eo.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	eo = pager.applyOrder(eo)
+	nodes, err := eo.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// ExternalOutputOrderFieldTimestamp orders ExternalOutput by timestamp.
+	ExternalOutputOrderFieldTimestamp = &ExternalOutputOrderField{
+		Value: func(eo *ExternalOutput) (ent.Value, error) {
+			return eo.Timestamp, nil
+		},
+		column: externaloutput.FieldTimestamp,
+		toTerm: externaloutput.ByTimestamp,
+		toCursor: func(eo *ExternalOutput) Cursor {
+			return Cursor{
+				ID:    eo.ID,
+				Value: eo.Timestamp,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f ExternalOutputOrderField) String() string {
+	var str string
+	switch f.column {
+	case ExternalOutputOrderFieldTimestamp.column:
+		str = "TIMESTAMP"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f ExternalOutputOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *ExternalOutputOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("ExternalOutputOrderField %T must be a string", v)
+	}
+	switch str {
+	case "TIMESTAMP":
+		*f = *ExternalOutputOrderFieldTimestamp
+	default:
+		return fmt.Errorf("%s is not a valid ExternalOutputOrderField", str)
+	}
+	return nil
+}
+
+// ExternalOutputOrderField defines the ordering field of ExternalOutput.
+type ExternalOutputOrderField struct {
+	// Value extracts the ordering value from the given ExternalOutput.
+	Value    func(*ExternalOutput) (ent.Value, error)
+	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
+	toTerm   func(...sql.OrderTermOption) externaloutput.OrderOption
+	toCursor func(*ExternalOutput) Cursor
+}
+
+// ExternalOutputOrder defines the ordering of ExternalOutput.
+type ExternalOutputOrder struct {
+	Direction OrderDirection            `json:"direction"`
+	Field     *ExternalOutputOrderField `json:"field"`
+}
+
+// DefaultExternalOutputOrder is the default ordering of ExternalOutput.
+var DefaultExternalOutputOrder = &ExternalOutputOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &ExternalOutputOrderField{
+		Value: func(eo *ExternalOutput) (ent.Value, error) {
+			return eo.ID, nil
+		},
+		column: externaloutput.FieldID,
+		toTerm: externaloutput.ByID,
+		toCursor: func(eo *ExternalOutput) Cursor {
+			return Cursor{ID: eo.ID}
+		},
+	},
+}
+
+// ToEdge converts ExternalOutput into ExternalOutputEdge.
+func (eo *ExternalOutput) ToEdge(order *ExternalOutputOrder) *ExternalOutputEdge {
+	if order == nil {
+		order = DefaultExternalOutputOrder
+	}
+	return &ExternalOutputEdge{
+		Node:   eo,
+		Cursor: order.Field.toCursor(eo),
+	}
+}
+
+// RoutingDecisionEdge is the edge representation of RoutingDecision.
+type RoutingDecisionEdge struct {
+	Node   *RoutingDecision `json:"node"`
+	Cursor Cursor           `json:"cursor"`
+}
+
+// RoutingDecisionConnection is the connection containing edges to RoutingDecision.
+type RoutingDecisionConnection struct {
+	Edges      []*RoutingDecisionEdge `json:"edges"`
+	PageInfo   PageInfo               `json:"pageInfo"`
+	TotalCount int                    `json:"totalCount"`
+}
+
+func (c *RoutingDecisionConnection) build(nodes []*RoutingDecision, pager *routingdecisionPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *RoutingDecision
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *RoutingDecision {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *RoutingDecision {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*RoutingDecisionEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &RoutingDecisionEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// RoutingDecisionPaginateOption enables pagination customization.
+type RoutingDecisionPaginateOption func(*routingdecisionPager) error
+
+// WithRoutingDecisionOrder configures pagination ordering.
+func WithRoutingDecisionOrder(order *RoutingDecisionOrder) RoutingDecisionPaginateOption {
+	if order == nil {
+		order = DefaultRoutingDecisionOrder
+	}
+	o := *order
+	return func(pager *routingdecisionPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultRoutingDecisionOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithRoutingDecisionFilter configures pagination filter.
+func WithRoutingDecisionFilter(filter func(*RoutingDecisionQuery) (*RoutingDecisionQuery, error)) RoutingDecisionPaginateOption {
+	return func(pager *routingdecisionPager) error {
+		if filter == nil {
+			return errors.New("RoutingDecisionQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type routingdecisionPager struct {
+	reverse bool
+	order   *RoutingDecisionOrder
+	filter  func(*RoutingDecisionQuery) (*RoutingDecisionQuery, error)
+}
+
+func newRoutingDecisionPager(opts []RoutingDecisionPaginateOption, reverse bool) (*routingdecisionPager, error) {
+	pager := &routingdecisionPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultRoutingDecisionOrder
+	}
+	return pager, nil
+}
+
+func (p *routingdecisionPager) applyFilter(query *RoutingDecisionQuery) (*RoutingDecisionQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *routingdecisionPager) toCursor(rd *RoutingDecision) Cursor {
+	return p.order.Field.toCursor(rd)
+}
+
+func (p *routingdecisionPager) applyCursors(query *RoutingDecisionQuery, after, before *Cursor) (*RoutingDecisionQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	cursorsPredicate := entgql.CursorsPredicate[string]
+	if p.order.Field.nullable {
+		cursorsPredicate = entgql.NullableCursorsPredicate[string]
+	}
+	for _, predicate := range cursorsPredicate(after, before, DefaultRoutingDecisionOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *routingdecisionPager) applyOrder(query *RoutingDecisionQuery) *RoutingDecisionQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(entgql.OrderByTerm(direction, p.order.Field.column, p.order.Field.toTerm))
+	if p.order.Field != DefaultRoutingDecisionOrder.Field {
+		query = query.Order(DefaultRoutingDecisionOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *routingdecisionPager) orderExpr(query *RoutingDecisionQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Join(direction.OrderExpr(p.order.Field.column))
+		if p.order.Field != DefaultRoutingDecisionOrder.Field {
+			b.Comma().Join(direction.OrderExpr(DefaultRoutingDecisionOrder.Field.column))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to RoutingDecision.
+func (rd *RoutingDecisionQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...RoutingDecisionPaginateOption,
+) (*RoutingDecisionConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newRoutingDecisionPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if rd, err = pager.applyFilter(rd); err != nil {
+		return nil, err
+	}
+	conn := &RoutingDecisionConnection{Edges: []*RoutingDecisionEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := rd.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if rd, err = pager.applyCursors(rd, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		rd.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := rd.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	rd = pager.applyOrder(rd)
+	nodes, err := rd.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// RoutingDecisionOrderFieldTimestamp orders RoutingDecision by timestamp.
+	RoutingDecisionOrderFieldTimestamp = &RoutingDecisionOrderField{
+		Value: func(rd *RoutingDecision) (ent.Value, error) {
+			return rd.Timestamp, nil
+		},
+		column: routingdecision.FieldTimestamp,
+		toTerm: routingdecision.ByTimestamp,
+		toCursor: func(rd *RoutingDecision) Cursor {
+			return Cursor{
+				ID:    rd.ID,
+				Value: rd.Timestamp,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f RoutingDecisionOrderField) String() string {
+	var str string
+	switch f.column {
+	case RoutingDecisionOrderFieldTimestamp.column:
+		str = "TIMESTAMP"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f RoutingDecisionOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *RoutingDecisionOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("RoutingDecisionOrderField %T must be a string", v)
+	}
+	switch str {
+	case "TIMESTAMP":
+		*f = *RoutingDecisionOrderFieldTimestamp
+	default:
+		return fmt.Errorf("%s is not a valid RoutingDecisionOrderField", str)
+	}
+	return nil
+}
+
+// RoutingDecisionOrderField defines the ordering field of RoutingDecision.
+type RoutingDecisionOrderField struct {
+	// Value extracts the ordering value from the given RoutingDecision.
+	Value    func(*RoutingDecision) (ent.Value, error)
+	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
+	toTerm   func(...sql.OrderTermOption) routingdecision.OrderOption
+	toCursor func(*RoutingDecision) Cursor
+}
+
+// RoutingDecisionOrder defines the ordering of RoutingDecision.
+type RoutingDecisionOrder struct {
+	Direction OrderDirection             `json:"direction"`
+	Field     *RoutingDecisionOrderField `json:"field"`
+}
+
+// DefaultRoutingDecisionOrder is the default ordering of RoutingDecision.
+var DefaultRoutingDecisionOrder = &RoutingDecisionOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &RoutingDecisionOrderField{
+		Value: func(rd *RoutingDecision) (ent.Value, error) {
+			return rd.ID, nil
+		},
+		column: routingdecision.FieldID,
+		toTerm: routingdecision.ByID,
+		toCursor: func(rd *RoutingDecision) Cursor {
+			return Cursor{ID: rd.ID}
+		},
+	},
+}
+
+// ToEdge converts RoutingDecision into RoutingDecisionEdge.
+func (rd *RoutingDecision) ToEdge(order *RoutingDecisionOrder) *RoutingDecisionEdge {
+	if order == nil {
+		order = DefaultRoutingDecisionOrder
+	}
+	return &RoutingDecisionEdge{
+		Node:   rd,
+		Cursor: order.Field.toCursor(rd),
+	}
+}
+
+// SpikeEventEdge is the edge representation of SpikeEvent.
+type SpikeEventEdge struct {
+	Node   *SpikeEvent `json:"node"`
+	Cursor Cursor      `json:"cursor"`
+}
+
+// SpikeEventConnection is the connection containing edges to SpikeEvent.
+type SpikeEventConnection struct {
+	Edges      []*SpikeEventEdge `json:"edges"`
+	PageInfo   PageInfo          `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
+}
+
+func (c *SpikeEventConnection) build(nodes []*SpikeEvent, pager *spikeeventPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *SpikeEvent
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *SpikeEvent {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *SpikeEvent {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*SpikeEventEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &SpikeEventEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// SpikeEventPaginateOption enables pagination customization.
+type SpikeEventPaginateOption func(*spikeeventPager) error
+
+// WithSpikeEventOrder configures pagination ordering.
+func WithSpikeEventOrder(order *SpikeEventOrder) SpikeEventPaginateOption {
+	if order == nil {
+		order = DefaultSpikeEventOrder
+	}
+	o := *order
+	return func(pager *spikeeventPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultSpikeEventOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithSpikeEventFilter configures pagination filter.
+func WithSpikeEventFilter(filter func(*SpikeEventQuery) (*SpikeEventQuery, error)) SpikeEventPaginateOption {
+	return func(pager *spikeeventPager) error {
+		if filter == nil {
+			return errors.New("SpikeEventQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type spikeeventPager struct {
+	reverse bool
+	order   *SpikeEventOrder
+	filter  func(*SpikeEventQuery) (*SpikeEventQuery, error)
+}
+
+func newSpikeEventPager(opts []SpikeEventPaginateOption, reverse bool) (*spikeeventPager, error) {
+	pager := &spikeeventPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultSpikeEventOrder
+	}
+	return pager, nil
+}
+
+func (p *spikeeventPager) applyFilter(query *SpikeEventQuery) (*SpikeEventQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *spikeeventPager) toCursor(se *SpikeEvent) Cursor {
+	return p.order.Field.toCursor(se)
+}
+
+func (p *spikeeventPager) applyCursors(query *SpikeEventQuery, after, before *Cursor) (*SpikeEventQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	cursorsPredicate := entgql.CursorsPredicate[string]
+	if p.order.Field.nullable {
+		cursorsPredicate = entgql.NullableCursorsPredicate[string]
+	}
+	for _, predicate := range cursorsPredicate(after, before, DefaultSpikeEventOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *spikeeventPager) applyOrder(query *SpikeEventQuery) *SpikeEventQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(entgql.OrderByTerm(direction, p.order.Field.column, p.order.Field.toTerm))
+	if p.order.Field != DefaultSpikeEventOrder.Field {
+		query = query.Order(DefaultSpikeEventOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *spikeeventPager) orderExpr(query *SpikeEventQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Join(direction.OrderExpr(p.order.Field.column))
+		if p.order.Field != DefaultSpikeEventOrder.Field {
+			b.Comma().Join(direction.OrderExpr(DefaultSpikeEventOrder.Field.column))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to SpikeEvent.
+func (se *SpikeEventQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...SpikeEventPaginateOption,
+) (*SpikeEventConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newSpikeEventPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if se, err = pager.applyFilter(se); err != nil {
+		return nil, err
+	}
+	conn := &SpikeEventConnection{Edges: []*SpikeEventEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := se.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if se, err = pager.applyCursors(se, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		se.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := se.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	se = pager.applyOrder(se)
+	nodes, err := se.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// SpikeEventOrderFieldTimestamp orders SpikeEvent by timestamp.
+	SpikeEventOrderFieldTimestamp = &SpikeEventOrderField{
+		Value: func(se *SpikeEvent) (ent.Value, error) {
+			return se.Timestamp, nil
+		},
+		column: spikeevent.FieldTimestamp,
+		toTerm: spikeevent.ByTimestamp,
+		toCursor: func(se *SpikeEvent) Cursor {
+			return Cursor{
+				ID:    se.ID,
+				Value: se.Timestamp,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f SpikeEventOrderField) String() string {
+	var str string
+	switch f.column {
+	case SpikeEventOrderFieldTimestamp.column:
+		str = "TIMESTAMP"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f SpikeEventOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *SpikeEventOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("SpikeEventOrderField %T must be a string", v)
+	}
+	switch str {
+	case "TIMESTAMP":
+		*f = *SpikeEventOrderFieldTimestamp
+	default:
+		return fmt.Errorf("%s is not a valid SpikeEventOrderField", str)
+	}
+	return nil
+}
+
+// SpikeEventOrderField defines the ordering field of SpikeEvent.
+type SpikeEventOrderField struct {
+	// Value extracts the ordering value from the given SpikeEvent.
+	Value    func(*SpikeEvent) (ent.Value, error)
+	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
+	toTerm   func(...sql.OrderTermOption) spikeevent.OrderOption
+	toCursor func(*SpikeEvent) Cursor
+}
+
+// SpikeEventOrder defines the ordering of SpikeEvent.
+type SpikeEventOrder struct {
+	Direction OrderDirection        `json:"direction"`
+	Field     *SpikeEventOrderField `json:"field"`
+}
+
+// DefaultSpikeEventOrder is the default ordering of SpikeEvent.
+var DefaultSpikeEventOrder = &SpikeEventOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &SpikeEventOrderField{
+		Value: func(se *SpikeEvent) (ent.Value, error) {
+			return se.ID, nil
+		},
+		column: spikeevent.FieldID,
+		toTerm: spikeevent.ByID,
+		toCursor: func(se *SpikeEvent) Cursor {
+			return Cursor{ID: se.ID}
+		},
+	},
+}
+
+// ToEdge converts SpikeEvent into SpikeEventEdge.
+func (se *SpikeEvent) ToEdge(order *SpikeEventOrder) *SpikeEventEdge {
+	if order == nil {
+		order = DefaultSpikeEventOrder
+	}
+	return &SpikeEventEdge{
+		Node:   se,
+		Cursor: order.Field.toCursor(se),
+	}
+}
+
+// WorkflowExecutionEdge is the edge representation of WorkflowExecution.
+type WorkflowExecutionEdge struct {
+	Node   *WorkflowExecution `json:"node"`
+	Cursor Cursor             `json:"cursor"`
+}
+
+// WorkflowExecutionConnection is the connection containing edges to WorkflowExecution.
+type WorkflowExecutionConnection struct {
+	Edges      []*WorkflowExecutionEdge `json:"edges"`
+	PageInfo   PageInfo                 `json:"pageInfo"`
+	TotalCount int                      `json:"totalCount"`
+}
+
+func (c *WorkflowExecutionConnection) build(nodes []*WorkflowExecution, pager *workflowexecutionPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:len(nodes)-1]
+	}
+	var nodeAt func(int) *WorkflowExecution
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *WorkflowExecution {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *WorkflowExecution {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*WorkflowExecutionEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &WorkflowExecutionEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// WorkflowExecutionPaginateOption enables pagination customization.
+type WorkflowExecutionPaginateOption func(*workflowexecutionPager) error
+
+// WithWorkflowExecutionOrder configures pagination ordering.
+func WithWorkflowExecutionOrder(order *WorkflowExecutionOrder) WorkflowExecutionPaginateOption {
+	if order == nil {
+		order = DefaultWorkflowExecutionOrder
+	}
+	o := *order
+	return func(pager *workflowexecutionPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultWorkflowExecutionOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithWorkflowExecutionFilter configures pagination filter.
+func WithWorkflowExecutionFilter(filter func(*WorkflowExecutionQuery) (*WorkflowExecutionQuery, error)) WorkflowExecutionPaginateOption {
+	return func(pager *workflowexecutionPager) error {
+		if filter == nil {
+			return errors.New("WorkflowExecutionQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type workflowexecutionPager struct {
+	reverse bool
+	order   *WorkflowExecutionOrder
+	filter  func(*WorkflowExecutionQuery) (*WorkflowExecutionQuery, error)
+}
+
+func newWorkflowExecutionPager(opts []WorkflowExecutionPaginateOption, reverse bool) (*workflowexecutionPager, error) {
+	pager := &workflowexecutionPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultWorkflowExecutionOrder
+	}
+	return pager, nil
+}
+
+func (p *workflowexecutionPager) applyFilter(query *WorkflowExecutionQuery) (*WorkflowExecutionQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *workflowexecutionPager) toCursor(we *WorkflowExecution) Cursor {
+	return p.order.Field.toCursor(we)
+}
+
+func (p *workflowexecutionPager) applyCursors(query *WorkflowExecutionQuery, after, before *Cursor) (*WorkflowExecutionQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	cursorsPredicate := entgql.CursorsPredicate[string]
+	if p.order.Field.nullable {
+		cursorsPredicate = entgql.NullableCursorsPredicate[string]
+	}
+	for _, predicate := range cursorsPredicate(after, before, DefaultWorkflowExecutionOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *workflowexecutionPager) applyOrder(query *WorkflowExecutionQuery) *WorkflowExecutionQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(entgql.OrderByTerm(direction, p.order.Field.column, p.order.Field.toTerm))
+	if p.order.Field != DefaultWorkflowExecutionOrder.Field {
+		query = query.Order(DefaultWorkflowExecutionOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *workflowexecutionPager) orderExpr(query *WorkflowExecutionQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Join(direction.OrderExpr(p.order.Field.column))
+		if p.order.Field != DefaultWorkflowExecutionOrder.Field {
+			b.Comma().Join(direction.OrderExpr(DefaultWorkflowExecutionOrder.Field.column))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to WorkflowExecution.
+func (we *WorkflowExecutionQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...WorkflowExecutionPaginateOption,
+) (*WorkflowExecutionConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newWorkflowExecutionPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if we, err = pager.applyFilter(we); err != nil {
+		return nil, err
+	}
+	conn := &WorkflowExecutionConnection{Edges: []*WorkflowExecutionEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := we.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.Count(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if ignoredEdges || (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if we, err = pager.applyCursors(we, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		we.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := we.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	we = pager.applyOrder(we)
+	nodes, err := we.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// WorkflowExecutionOrderFieldStartedAt orders WorkflowExecution by started_at.
+	WorkflowExecutionOrderFieldStartedAt = &WorkflowExecutionOrderField{
+		Value: func(we *WorkflowExecution) (ent.Value, error) {
+			return we.StartedAt, nil
+		},
+		column: workflowexecution.FieldStartedAt,
+		toTerm: workflowexecution.ByStartedAt,
+		toCursor: func(we *WorkflowExecution) Cursor {
+			return Cursor{
+				ID:    we.ID,
+				Value: we.StartedAt,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f WorkflowExecutionOrderField) String() string {
+	var str string
+	switch f.column {
+	case WorkflowExecutionOrderFieldStartedAt.column:
+		str = "STARTED_AT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f WorkflowExecutionOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *WorkflowExecutionOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("WorkflowExecutionOrderField %T must be a string", v)
+	}
+	switch str {
+	case "STARTED_AT":
+		*f = *WorkflowExecutionOrderFieldStartedAt
+	default:
+		return fmt.Errorf("%s is not a valid WorkflowExecutionOrderField", str)
+	}
+	return nil
+}
+
+// WorkflowExecutionOrderField defines the ordering field of WorkflowExecution.
+type WorkflowExecutionOrderField struct {
+	// Value extracts the ordering value from the given WorkflowExecution.
+	Value    func(*WorkflowExecution) (ent.Value, error)
+	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
+	toTerm   func(...sql.OrderTermOption) workflowexecution.OrderOption
+	toCursor func(*WorkflowExecution) Cursor
+}
+
+// WorkflowExecutionOrder defines the ordering of WorkflowExecution.
+type WorkflowExecutionOrder struct {
+	Direction OrderDirection               `json:"direction"`
+	Field     *WorkflowExecutionOrderField `json:"field"`
+}
+
+// DefaultWorkflowExecutionOrder is the default ordering of WorkflowExecution.
+var DefaultWorkflowExecutionOrder = &WorkflowExecutionOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &WorkflowExecutionOrderField{
+		Value: func(we *WorkflowExecution) (ent.Value, error) {
+			return we.ID, nil
+		},
+		column: workflowexecution.FieldID,
+		toTerm: workflowexecution.ByID,
+		toCursor: func(we *WorkflowExecution) Cursor {
+			return Cursor{ID: we.ID}
+		},
+	},
+}
+
+// ToEdge converts WorkflowExecution into WorkflowExecutionEdge.
+func (we *WorkflowExecution) ToEdge(order *WorkflowExecutionOrder) *WorkflowExecutionEdge {
+	if order == nil {
+		order = DefaultWorkflowExecutionOrder
+	}
+	return &WorkflowExecutionEdge{
+		Node:   we,
+		Cursor: order.Field.toCursor(we),
+	}
+}
@@ -0,0 +1,3610 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/spikeevent"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+)
+
+// AgentActionWhereInput represents a where input for filtering AgentAction queries.
+type AgentActionWhereInput struct {
+	Predicates []predicate.AgentAction  `json:"-"`
+	Not        *AgentActionWhereInput   `json:"not,omitempty"`
+	Or         []*AgentActionWhereInput `json:"or,omitempty"`
+	And        []*AgentActionWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID             *string  `json:"id,omitempty"`
+	IDNEQ          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGT           *string  `json:"idGT,omitempty"`
+	IDGTE          *string  `json:"idGTE,omitempty"`
+	IDLT           *string  `json:"idLT,omitempty"`
+	IDLTE          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+
+	// "provenance_hash" field predicates.
+	ProvenanceHash             *string  `json:"provenanceHash,omitempty"`
+	ProvenanceHashNEQ          *string  `json:"provenanceHashNEQ,omitempty"`
+	ProvenanceHashIn           []string `json:"provenanceHashIn,omitempty"`
+	ProvenanceHashNotIn        []string `json:"provenanceHashNotIn,omitempty"`
+	ProvenanceHashGT           *string  `json:"provenanceHashGT,omitempty"`
+	ProvenanceHashGTE          *string  `json:"provenanceHashGTE,omitempty"`
+	ProvenanceHashLT           *string  `json:"provenanceHashLT,omitempty"`
+	ProvenanceHashLTE          *string  `json:"provenanceHashLTE,omitempty"`
+	ProvenanceHashContains     *string  `json:"provenanceHashContains,omitempty"`
+	ProvenanceHashHasPrefix    *string  `json:"provenanceHashHasPrefix,omitempty"`
+	ProvenanceHashHasSuffix    *string  `json:"provenanceHashHasSuffix,omitempty"`
+	ProvenanceHashIsNil        bool     `json:"provenanceHashIsNil,omitempty"`
+	ProvenanceHashNotNil       bool     `json:"provenanceHashNotNil,omitempty"`
+	ProvenanceHashEqualFold    *string  `json:"provenanceHashEqualFold,omitempty"`
+	ProvenanceHashContainsFold *string  `json:"provenanceHashContainsFold,omitempty"`
+
+	// "timestamp" field predicates.
+	Timestamp      *time.Time  `json:"timestamp,omitempty"`
+	TimestampNEQ   *time.Time  `json:"timestampNEQ,omitempty"`
+	TimestampIn    []time.Time `json:"timestampIn,omitempty"`
+	TimestampNotIn []time.Time `json:"timestampNotIn,omitempty"`
+	TimestampGT    *time.Time  `json:"timestampGT,omitempty"`
+	TimestampGTE   *time.Time  `json:"timestampGTE,omitempty"`
+	TimestampLT    *time.Time  `json:"timestampLT,omitempty"`
+	TimestampLTE   *time.Time  `json:"timestampLTE,omitempty"`
+
+	// "agent_id" field predicates.
+	AgentID             *string  `json:"agentID,omitempty"`
+	AgentIDNEQ          *string  `json:"agentIDNEQ,omitempty"`
+	AgentIDIn           []string `json:"agentIDIn,omitempty"`
+	AgentIDNotIn        []string `json:"agentIDNotIn,omitempty"`
+	AgentIDGT           *string  `json:"agentIDGT,omitempty"`
+	AgentIDGTE          *string  `json:"agentIDGTE,omitempty"`
+	AgentIDLT           *string  `json:"agentIDLT,omitempty"`
+	AgentIDLTE          *string  `json:"agentIDLTE,omitempty"`
+	AgentIDContains     *string  `json:"agentIDContains,omitempty"`
+	AgentIDHasPrefix    *string  `json:"agentIDHasPrefix,omitempty"`
+	AgentIDHasSuffix    *string  `json:"agentIDHasSuffix,omitempty"`
+	AgentIDEqualFold    *string  `json:"agentIDEqualFold,omitempty"`
+	AgentIDContainsFold *string  `json:"agentIDContainsFold,omitempty"`
+
+	// "agent_type" field predicates.
+	AgentType             *string  `json:"agentType,omitempty"`
+	AgentTypeNEQ          *string  `json:"agentTypeNEQ,omitempty"`
+	AgentTypeIn           []string `json:"agentTypeIn,omitempty"`
+	AgentTypeNotIn        []string `json:"agentTypeNotIn,omitempty"`
+	AgentTypeGT           *string  `json:"agentTypeGT,omitempty"`
+	AgentTypeGTE          *string  `json:"agentTypeGTE,omitempty"`
+	AgentTypeLT           *string  `json:"agentTypeLT,omitempty"`
+	AgentTypeLTE          *string  `json:"agentTypeLTE,omitempty"`
+	AgentTypeContains     *string  `json:"agentTypeContains,omitempty"`
+	AgentTypeHasPrefix    *string  `json:"agentTypeHasPrefix,omitempty"`
+	AgentTypeHasSuffix    *string  `json:"agentTypeHasSuffix,omitempty"`
+	AgentTypeEqualFold    *string  `json:"agentTypeEqualFold,omitempty"`
+	AgentTypeContainsFold *string  `json:"agentTypeContainsFold,omitempty"`
+
+	// "action_type" field predicates.
+	ActionType             *string  `json:"actionType,omitempty"`
+	ActionTypeNEQ          *string  `json:"actionTypeNEQ,omitempty"`
+	ActionTypeIn           []string `json:"actionTypeIn,omitempty"`
+	ActionTypeNotIn        []string `json:"actionTypeNotIn,omitempty"`
+	ActionTypeGT           *string  `json:"actionTypeGT,omitempty"`
+	ActionTypeGTE          *string  `json:"actionTypeGTE,omitempty"`
+	ActionTypeLT           *string  `json:"actionTypeLT,omitempty"`
+	ActionTypeLTE          *string  `json:"actionTypeLTE,omitempty"`
+	ActionTypeContains     *string  `json:"actionTypeContains,omitempty"`
+	ActionTypeHasPrefix    *string  `json:"actionTypeHasPrefix,omitempty"`
+	ActionTypeHasSuffix    *string  `json:"actionTypeHasSuffix,omitempty"`
+	ActionTypeEqualFold    *string  `json:"actionTypeEqualFold,omitempty"`
+	ActionTypeContainsFold *string  `json:"actionTypeContainsFold,omitempty"`
+
+	// "action_name" field predicates.
+	ActionName             *string  `json:"actionName,omitempty"`
+	ActionNameNEQ          *string  `json:"actionNameNEQ,omitempty"`
+	ActionNameIn           []string `json:"actionNameIn,omitempty"`
+	ActionNameNotIn        []string `json:"actionNameNotIn,omitempty"`
+	ActionNameGT           *string  `json:"actionNameGT,omitempty"`
+	ActionNameGTE          *string  `json:"actionNameGTE,omitempty"`
+	ActionNameLT           *string  `json:"actionNameLT,omitempty"`
+	ActionNameLTE          *string  `json:"actionNameLTE,omitempty"`
+	ActionNameContains     *string  `json:"actionNameContains,omitempty"`
+	ActionNameHasPrefix    *string  `json:"actionNameHasPrefix,omitempty"`
+	ActionNameHasSuffix    *string  `json:"actionNameHasSuffix,omitempty"`
+	ActionNameIsNil        bool     `json:"actionNameIsNil,omitempty"`
+	ActionNameNotNil       bool     `json:"actionNameNotNil,omitempty"`
+	ActionNameEqualFold    *string  `json:"actionNameEqualFold,omitempty"`
+	ActionNameContainsFold *string  `json:"actionNameContainsFold,omitempty"`
+
+	// "target_resource" field predicates.
+	TargetResource             *string  `json:"targetResource,omitempty"`
+	TargetResourceNEQ          *string  `json:"targetResourceNEQ,omitempty"`
+	TargetResourceIn           []string `json:"targetResourceIn,omitempty"`
+	TargetResourceNotIn        []string `json:"targetResourceNotIn,omitempty"`
+	TargetResourceGT           *string  `json:"targetResourceGT,omitempty"`
+	TargetResourceGTE          *string  `json:"targetResourceGTE,omitempty"`
+	TargetResourceLT           *string  `json:"targetResourceLT,omitempty"`
+	TargetResourceLTE          *string  `json:"targetResourceLTE,omitempty"`
+	TargetResourceContains     *string  `json:"targetResourceContains,omitempty"`
+	TargetResourceHasPrefix    *string  `json:"targetResourceHasPrefix,omitempty"`
+	TargetResourceHasSuffix    *string  `json:"targetResourceHasSuffix,omitempty"`
+	TargetResourceIsNil        bool     `json:"targetResourceIsNil,omitempty"`
+	TargetResourceNotNil       bool     `json:"targetResourceNotNil,omitempty"`
+	TargetResourceEqualFold    *string  `json:"targetResourceEqualFold,omitempty"`
+	TargetResourceContainsFold *string  `json:"targetResourceContainsFold,omitempty"`
+
+	// "status" field predicates.
+	Status      *agentaction.Status  `json:"status,omitempty"`
+	StatusNEQ   *agentaction.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []agentaction.Status `json:"statusIn,omitempty"`
+	StatusNotIn []agentaction.Status `json:"statusNotIn,omitempty"`
+
+	// "result" field predicates.
+	Result             *string  `json:"result,omitempty"`
+	ResultNEQ          *string  `json:"resultNEQ,omitempty"`
+	ResultIn           []string `json:"resultIn,omitempty"`
+	ResultNotIn        []string `json:"resultNotIn,omitempty"`
+	ResultGT           *string  `json:"resultGT,omitempty"`
+	ResultGTE          *string  `json:"resultGTE,omitempty"`
+	ResultLT           *string  `json:"resultLT,omitempty"`
+	ResultLTE          *string  `json:"resultLTE,omitempty"`
+	ResultContains     *string  `json:"resultContains,omitempty"`
+	ResultHasPrefix    *string  `json:"resultHasPrefix,omitempty"`
+	ResultHasSuffix    *string  `json:"resultHasSuffix,omitempty"`
+	ResultIsNil        bool     `json:"resultIsNil,omitempty"`
+	ResultNotNil       bool     `json:"resultNotNil,omitempty"`
+	ResultEqualFold    *string  `json:"resultEqualFold,omitempty"`
+	ResultContainsFold *string  `json:"resultContainsFold,omitempty"`
+
+	// "error" field predicates.
+	Error             *string  `json:"error,omitempty"`
+	ErrorNEQ          *string  `json:"errorNEQ,omitempty"`
+	ErrorIn           []string `json:"errorIn,omitempty"`
+	ErrorNotIn        []string `json:"errorNotIn,omitempty"`
+	ErrorGT           *string  `json:"errorGT,omitempty"`
+	ErrorGTE          *string  `json:"errorGTE,omitempty"`
+	ErrorLT           *string  `json:"errorLT,omitempty"`
+	ErrorLTE          *string  `json:"errorLTE,omitempty"`
+	ErrorContains     *string  `json:"errorContains,omitempty"`
+	ErrorHasPrefix    *string  `json:"errorHasPrefix,omitempty"`
+	ErrorHasSuffix    *string  `json:"errorHasSuffix,omitempty"`
+	ErrorIsNil        bool     `json:"errorIsNil,omitempty"`
+	ErrorNotNil       bool     `json:"errorNotNil,omitempty"`
+	ErrorEqualFold    *string  `json:"errorEqualFold,omitempty"`
+	ErrorContainsFold *string  `json:"errorContainsFold,omitempty"`
+
+	// "latency_ms" field predicates.
+	LatencyMs       *float64  `json:"latencyMs,omitempty"`
+	LatencyMsNEQ    *float64  `json:"latencyMsNEQ,omitempty"`
+	LatencyMsIn     []float64 `json:"latencyMsIn,omitempty"`
+	LatencyMsNotIn  []float64 `json:"latencyMsNotIn,omitempty"`
+	LatencyMsGT     *float64  `json:"latencyMsGT,omitempty"`
+	LatencyMsGTE    *float64  `json:"latencyMsGTE,omitempty"`
+	LatencyMsLT     *float64  `json:"latencyMsLT,omitempty"`
+	LatencyMsLTE    *float64  `json:"latencyMsLTE,omitempty"`
+	LatencyMsIsNil  bool      `json:"latencyMsIsNil,omitempty"`
+	LatencyMsNotNil bool      `json:"latencyMsNotNil,omitempty"`
+
+	// "session_id" field predicates.
+	SessionID             *string  `json:"sessionID,omitempty"`
+	SessionIDNEQ          *string  `json:"sessionIDNEQ,omitempty"`
+	SessionIDIn           []string `json:"sessionIDIn,omitempty"`
+	SessionIDNotIn        []string `json:"sessionIDNotIn,omitempty"`
+	SessionIDGT           *string  `json:"sessionIDGT,omitempty"`
+	SessionIDGTE          *string  `json:"sessionIDGTE,omitempty"`
+	SessionIDLT           *string  `json:"sessionIDLT,omitempty"`
+	SessionIDLTE          *string  `json:"sessionIDLTE,omitempty"`
+	SessionIDContains     *string  `json:"sessionIDContains,omitempty"`
+	SessionIDHasPrefix    *string  `json:"sessionIDHasPrefix,omitempty"`
+	SessionIDHasSuffix    *string  `json:"sessionIDHasSuffix,omitempty"`
+	SessionIDIsNil        bool     `json:"sessionIDIsNil,omitempty"`
+	SessionIDNotNil       bool     `json:"sessionIDNotNil,omitempty"`
+	SessionIDEqualFold    *string  `json:"sessionIDEqualFold,omitempty"`
+	SessionIDContainsFold *string  `json:"sessionIDContainsFold,omitempty"`
+
+	// "user_id" field predicates.
+	UserID             *string  `json:"userID,omitempty"`
+	UserIDNEQ          *string  `json:"userIDNEQ,omitempty"`
+	UserIDIn           []string `json:"userIDIn,omitempty"`
+	UserIDNotIn        []string `json:"userIDNotIn,omitempty"`
+	UserIDGT           *string  `json:"userIDGT,omitempty"`
+	UserIDGTE          *string  `json:"userIDGTE,omitempty"`
+	UserIDLT           *string  `json:"userIDLT,omitempty"`
+	UserIDLTE          *string  `json:"userIDLTE,omitempty"`
+	UserIDContains     *string  `json:"userIDContains,omitempty"`
+	UserIDHasPrefix    *string  `json:"userIDHasPrefix,omitempty"`
+	UserIDHasSuffix    *string  `json:"userIDHasSuffix,omitempty"`
+	UserIDIsNil        bool     `json:"userIDIsNil,omitempty"`
+	UserIDNotNil       bool     `json:"userIDNotNil,omitempty"`
+	UserIDEqualFold    *string  `json:"userIDEqualFold,omitempty"`
+	UserIDContainsFold *string  `json:"userIDContainsFold,omitempty"`
+
+	// "decisions" edge predicates.
+	HasDecisions     *bool                        `json:"hasDecisions,omitempty"`
+	HasDecisionsWith []*RoutingDecisionWhereInput `json:"hasDecisionsWith,omitempty"`
+
+	// "workflows" edge predicates.
+	HasWorkflows     *bool                          `json:"hasWorkflows,omitempty"`
+	HasWorkflowsWith []*WorkflowExecutionWhereInput `json:"hasWorkflowsWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *AgentActionWhereInput) AddPredicates(predicates ...predicate.AgentAction) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the AgentActionWhereInput filter on the AgentActionQuery builder.
+func (i *AgentActionWhereInput) Filter(q *AgentActionQuery) (*AgentActionQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyAgentActionWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyAgentActionWhereInput is returned in case the AgentActionWhereInput is empty.
+var ErrEmptyAgentActionWhereInput = errors.New("ent: empty predicate AgentActionWhereInput")
+
+// P returns a predicate for filtering agentactions.
+// An error is returned if the input is empty or invalid.
+func (i *AgentActionWhereInput) P() (predicate.AgentAction, error) {
+	var predicates []predicate.AgentAction
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, agentaction.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.AgentAction, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, agentaction.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.AgentAction, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, agentaction.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, agentaction.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, agentaction.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, agentaction.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, agentaction.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, agentaction.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, agentaction.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, agentaction.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, agentaction.IDLTE(*i.IDLTE))
+	}
+	if i.IDEqualFold != nil {
+		predicates = append(predicates, agentaction.IDEqualFold(*i.IDEqualFold))
+	}
+	if i.IDContainsFold != nil {
+		predicates = append(predicates, agentaction.IDContainsFold(*i.IDContainsFold))
+	}
+	if i.ProvenanceHash != nil {
+		predicates = append(predicates, agentaction.ProvenanceHashEQ(*i.ProvenanceHash))
+	}
+	if i.ProvenanceHashNEQ != nil {
+		predicates = append(predicates, agentaction.ProvenanceHashNEQ(*i.ProvenanceHashNEQ))
+	}
+	if len(i.ProvenanceHashIn) > 0 {
+		predicates = append(predicates, agentaction.ProvenanceHashIn(i.ProvenanceHashIn...))
+	}
+	if len(i.ProvenanceHashNotIn) > 0 {
+		predicates = append(predicates, agentaction.ProvenanceHashNotIn(i.ProvenanceHashNotIn...))
+	}
+	if i.ProvenanceHashGT != nil {
+		predicates = append(predicates, agentaction.ProvenanceHashGT(*i.ProvenanceHashGT))
+	}
+	if i.ProvenanceHashGTE != nil {
+		predicates = append(predicates, agentaction.ProvenanceHashGTE(*i.ProvenanceHashGTE))
+	}
+	if i.ProvenanceHashLT != nil {
+		predicates = append(predicates, agentaction.ProvenanceHashLT(*i.ProvenanceHashLT))
+	}
+	if i.ProvenanceHashLTE != nil {
+		predicates = append(predicates, agentaction.ProvenanceHashLTE(*i.ProvenanceHashLTE))
+	}
+	if i.ProvenanceHashContains != nil {
+		predicates = append(predicates, agentaction.ProvenanceHashContains(*i.ProvenanceHashContains))
+	}
+	if i.ProvenanceHashHasPrefix != nil {
+		predicates = append(predicates, agentaction.ProvenanceHashHasPrefix(*i.ProvenanceHashHasPrefix))
+	}
+	if i.ProvenanceHashHasSuffix != nil {
+		predicates = append(predicates, agentaction.ProvenanceHashHasSuffix(*i.ProvenanceHashHasSuffix))
+	}
+	if i.ProvenanceHashIsNil {
+		predicates = append(predicates, agentaction.ProvenanceHashIsNil())
+	}
+	if i.ProvenanceHashNotNil {
+		predicates = append(predicates, agentaction.ProvenanceHashNotNil())
+	}
+	if i.ProvenanceHashEqualFold != nil {
+		predicates = append(predicates, agentaction.ProvenanceHashEqualFold(*i.ProvenanceHashEqualFold))
+	}
+	if i.ProvenanceHashContainsFold != nil {
+		predicates = append(predicates, agentaction.ProvenanceHashContainsFold(*i.ProvenanceHashContainsFold))
+	}
+	if i.Timestamp != nil {
+		predicates = append(predicates, agentaction.TimestampEQ(*i.Timestamp))
+	}
+	if i.TimestampNEQ != nil {
+		predicates = append(predicates, agentaction.TimestampNEQ(*i.TimestampNEQ))
+	}
+	if len(i.TimestampIn) > 0 {
+		predicates = append(predicates, agentaction.TimestampIn(i.TimestampIn...))
+	}
+	if len(i.TimestampNotIn) > 0 {
+		predicates = append(predicates, agentaction.TimestampNotIn(i.TimestampNotIn...))
+	}
+	if i.TimestampGT != nil {
+		predicates = append(predicates, agentaction.TimestampGT(*i.TimestampGT))
+	}
+	if i.TimestampGTE != nil {
+		predicates = append(predicates, agentaction.TimestampGTE(*i.TimestampGTE))
+	}
+	if i.TimestampLT != nil {
+		predicates = append(predicates, agentaction.TimestampLT(*i.TimestampLT))
+	}
+	if i.TimestampLTE != nil {
+		predicates = append(predicates, agentaction.TimestampLTE(*i.TimestampLTE))
+	}
+	if i.AgentID != nil {
+		predicates = append(predicates, agentaction.AgentIDEQ(*i.AgentID))
+	}
+	if i.AgentIDNEQ != nil {
+		predicates = append(predicates, agentaction.AgentIDNEQ(*i.AgentIDNEQ))
+	}
+	if len(i.AgentIDIn) > 0 {
+		predicates = append(predicates, agentaction.AgentIDIn(i.AgentIDIn...))
+	}
+	if len(i.AgentIDNotIn) > 0 {
+		predicates = append(predicates, agentaction.AgentIDNotIn(i.AgentIDNotIn...))
+	}
+	if i.AgentIDGT != nil {
+		predicates = append(predicates, agentaction.AgentIDGT(*i.AgentIDGT))
+	}
+	if i.AgentIDGTE != nil {
+		predicates = append(predicates, agentaction.AgentIDGTE(*i.AgentIDGTE))
+	}
+	if i.AgentIDLT != nil {
+		predicates = append(predicates, agentaction.AgentIDLT(*i.AgentIDLT))
+	}
+	if i.AgentIDLTE != nil {
+		predicates = append(predicates, agentaction.AgentIDLTE(*i.AgentIDLTE))
+	}
+	if i.AgentIDContains != nil {
+		predicates = append(predicates, agentaction.AgentIDContains(*i.AgentIDContains))
+	}
+	if i.AgentIDHasPrefix != nil {
+		predicates = append(predicates, agentaction.AgentIDHasPrefix(*i.AgentIDHasPrefix))
+	}
+	if i.AgentIDHasSuffix != nil {
+		predicates = append(predicates, agentaction.AgentIDHasSuffix(*i.AgentIDHasSuffix))
+	}
+	if i.AgentIDEqualFold != nil {
+		predicates = append(predicates, agentaction.AgentIDEqualFold(*i.AgentIDEqualFold))
+	}
+	if i.AgentIDContainsFold != nil {
+		predicates = append(predicates, agentaction.AgentIDContainsFold(*i.AgentIDContainsFold))
+	}
+	if i.AgentType != nil {
+		predicates = append(predicates, agentaction.AgentTypeEQ(*i.AgentType))
+	}
+	if i.AgentTypeNEQ != nil {
+		predicates = append(predicates, agentaction.AgentTypeNEQ(*i.AgentTypeNEQ))
+	}
+	if len(i.AgentTypeIn) > 0 {
+		predicates = append(predicates, agentaction.AgentTypeIn(i.AgentTypeIn...))
+	}
+	if len(i.AgentTypeNotIn) > 0 {
+		predicates = append(predicates, agentaction.AgentTypeNotIn(i.AgentTypeNotIn...))
+	}
+	if i.AgentTypeGT != nil {
+		predicates = append(predicates, agentaction.AgentTypeGT(*i.AgentTypeGT))
+	}
+	if i.AgentTypeGTE != nil {
+		predicates = append(predicates, agentaction.AgentTypeGTE(*i.AgentTypeGTE))
+	}
+	if i.AgentTypeLT != nil {
+		predicates = append(predicates, agentaction.AgentTypeLT(*i.AgentTypeLT))
+	}
+	if i.AgentTypeLTE != nil {
+		predicates = append(predicates, agentaction.AgentTypeLTE(*i.AgentTypeLTE))
+	}
+	if i.AgentTypeContains != nil {
+		predicates = append(predicates, agentaction.AgentTypeContains(*i.AgentTypeContains))
+	}
+	if i.AgentTypeHasPrefix != nil {
+		predicates = append(predicates, agentaction.AgentTypeHasPrefix(*i.AgentTypeHasPrefix))
+	}
+	if i.AgentTypeHasSuffix != nil {
+		predicates = append(predicates, agentaction.AgentTypeHasSuffix(*i.AgentTypeHasSuffix))
+	}
+	if i.AgentTypeEqualFold != nil {
+		predicates = append(predicates, agentaction.AgentTypeEqualFold(*i.AgentTypeEqualFold))
+	}
+	if i.AgentTypeContainsFold != nil {
+		predicates = append(predicates, agentaction.AgentTypeContainsFold(*i.AgentTypeContainsFold))
+	}
+	if i.ActionType != nil {
+		predicates = append(predicates, agentaction.ActionTypeEQ(*i.ActionType))
+	}
+	if i.ActionTypeNEQ != nil {
+		predicates = append(predicates, agentaction.ActionTypeNEQ(*i.ActionTypeNEQ))
+	}
+	if len(i.ActionTypeIn) > 0 {
+		predicates = append(predicates, agentaction.ActionTypeIn(i.ActionTypeIn...))
+	}
+	if len(i.ActionTypeNotIn) > 0 {
+		predicates = append(predicates, agentaction.ActionTypeNotIn(i.ActionTypeNotIn...))
+	}
+	if i.ActionTypeGT != nil {
+		predicates = append(predicates, agentaction.ActionTypeGT(*i.ActionTypeGT))
+	}
+	if i.ActionTypeGTE != nil {
+		predicates = append(predicates, agentaction.ActionTypeGTE(*i.ActionTypeGTE))
+	}
+	if i.ActionTypeLT != nil {
+		predicates = append(predicates, agentaction.ActionTypeLT(*i.ActionTypeLT))
+	}
+	if i.ActionTypeLTE != nil {
+		predicates = append(predicates, agentaction.ActionTypeLTE(*i.ActionTypeLTE))
+	}
+	if i.ActionTypeContains != nil {
+		predicates = append(predicates, agentaction.ActionTypeContains(*i.ActionTypeContains))
+	}
+	if i.ActionTypeHasPrefix != nil {
+		predicates = append(predicates, agentaction.ActionTypeHasPrefix(*i.ActionTypeHasPrefix))
+	}
+	if i.ActionTypeHasSuffix != nil {
+		predicates = append(predicates, agentaction.ActionTypeHasSuffix(*i.ActionTypeHasSuffix))
+	}
+	if i.ActionTypeEqualFold != nil {
+		predicates = append(predicates, agentaction.ActionTypeEqualFold(*i.ActionTypeEqualFold))
+	}
+	if i.ActionTypeContainsFold != nil {
+		predicates = append(predicates, agentaction.ActionTypeContainsFold(*i.ActionTypeContainsFold))
+	}
+	if i.ActionName != nil {
+		predicates = append(predicates, agentaction.ActionNameEQ(*i.ActionName))
+	}
+	if i.ActionNameNEQ != nil {
+		predicates = append(predicates, agentaction.ActionNameNEQ(*i.ActionNameNEQ))
+	}
+	if len(i.ActionNameIn) > 0 {
+		predicates = append(predicates, agentaction.ActionNameIn(i.ActionNameIn...))
+	}
+	if len(i.ActionNameNotIn) > 0 {
+		predicates = append(predicates, agentaction.ActionNameNotIn(i.ActionNameNotIn...))
+	}
+	if i.ActionNameGT != nil {
+		predicates = append(predicates, agentaction.ActionNameGT(*i.ActionNameGT))
+	}
+	if i.ActionNameGTE != nil {
+		predicates = append(predicates, agentaction.ActionNameGTE(*i.ActionNameGTE))
+	}
+	if i.ActionNameLT != nil {
+		predicates = append(predicates, agentaction.ActionNameLT(*i.ActionNameLT))
+	}
+	if i.ActionNameLTE != nil {
+		predicates = append(predicates, agentaction.ActionNameLTE(*i.ActionNameLTE))
+	}
+	if i.ActionNameContains != nil {
+		predicates = append(predicates, agentaction.ActionNameContains(*i.ActionNameContains))
+	}
+	if i.ActionNameHasPrefix != nil {
+		predicates = append(predicates, agentaction.ActionNameHasPrefix(*i.ActionNameHasPrefix))
+	}
+	if i.ActionNameHasSuffix != nil {
+		predicates = append(predicates, agentaction.ActionNameHasSuffix(*i.ActionNameHasSuffix))
+	}
+	if i.ActionNameIsNil {
+		predicates = append(predicates, agentaction.ActionNameIsNil())
+	}
+	if i.ActionNameNotNil {
+		predicates = append(predicates, agentaction.ActionNameNotNil())
+	}
+	if i.ActionNameEqualFold != nil {
+		predicates = append(predicates, agentaction.ActionNameEqualFold(*i.ActionNameEqualFold))
+	}
+	if i.ActionNameContainsFold != nil {
+		predicates = append(predicates, agentaction.ActionNameContainsFold(*i.ActionNameContainsFold))
+	}
+	if i.TargetResource != nil {
+		predicates = append(predicates, agentaction.TargetResourceEQ(*i.TargetResource))
+	}
+	if i.TargetResourceNEQ != nil {
+		predicates = append(predicates, agentaction.TargetResourceNEQ(*i.TargetResourceNEQ))
+	}
+	if len(i.TargetResourceIn) > 0 {
+		predicates = append(predicates, agentaction.TargetResourceIn(i.TargetResourceIn...))
+	}
+	if len(i.TargetResourceNotIn) > 0 {
+		predicates = append(predicates, agentaction.TargetResourceNotIn(i.TargetResourceNotIn...))
+	}
+	if i.TargetResourceGT != nil {
+		predicates = append(predicates, agentaction.TargetResourceGT(*i.TargetResourceGT))
+	}
+	if i.TargetResourceGTE != nil {
+		predicates = append(predicates, agentaction.TargetResourceGTE(*i.TargetResourceGTE))
+	}
+	if i.TargetResourceLT != nil {
+		predicates = append(predicates, agentaction.TargetResourceLT(*i.TargetResourceLT))
+	}
+	if i.TargetResourceLTE != nil {
+		predicates = append(predicates, agentaction.TargetResourceLTE(*i.TargetResourceLTE))
+	}
+	if i.TargetResourceContains != nil {
+		predicates = append(predicates, agentaction.TargetResourceContains(*i.TargetResourceContains))
+	}
+	if i.TargetResourceHasPrefix != nil {
+		predicates = append(predicates, agentaction.TargetResourceHasPrefix(*i.TargetResourceHasPrefix))
+	}
+	if i.TargetResourceHasSuffix != nil {
+		predicates = append(predicates, agentaction.TargetResourceHasSuffix(*i.TargetResourceHasSuffix))
+	}
+	if i.TargetResourceIsNil {
+		predicates = append(predicates, agentaction.TargetResourceIsNil())
+	}
+	if i.TargetResourceNotNil {
+		predicates = append(predicates, agentaction.TargetResourceNotNil())
+	}
+	if i.TargetResourceEqualFold != nil {
+		predicates = append(predicates, agentaction.TargetResourceEqualFold(*i.TargetResourceEqualFold))
+	}
+	if i.TargetResourceContainsFold != nil {
+		predicates = append(predicates, agentaction.TargetResourceContainsFold(*i.TargetResourceContainsFold))
+	}
+	if i.Status != nil {
+		predicates = append(predicates, agentaction.StatusEQ(*i.Status))
+	}
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, agentaction.StatusNEQ(*i.StatusNEQ))
+	}
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, agentaction.StatusIn(i.StatusIn...))
+	}
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, agentaction.StatusNotIn(i.StatusNotIn...))
+	}
+	if i.Result != nil {
+		predicates = append(predicates, agentaction.ResultEQ(*i.Result))
+	}
+	if i.ResultNEQ != nil {
+		predicates = append(predicates, agentaction.ResultNEQ(*i.ResultNEQ))
+	}
+	if len(i.ResultIn) > 0 {
+		predicates = append(predicates, agentaction.ResultIn(i.ResultIn...))
+	}
+	if len(i.ResultNotIn) > 0 {
+		predicates = append(predicates, agentaction.ResultNotIn(i.ResultNotIn...))
+	}
+	if i.ResultGT != nil {
+		predicates = append(predicates, agentaction.ResultGT(*i.ResultGT))
+	}
+	if i.ResultGTE != nil {
+		predicates = append(predicates, agentaction.ResultGTE(*i.ResultGTE))
+	}
+	if i.ResultLT != nil {
+		predicates = append(predicates, agentaction.ResultLT(*i.ResultLT))
+	}
+	if i.ResultLTE != nil {
+		predicates = append(predicates, agentaction.ResultLTE(*i.ResultLTE))
+	}
+	if i.ResultContains != nil {
+		predicates = append(predicates, agentaction.ResultContains(*i.ResultContains))
+	}
+	if i.ResultHasPrefix != nil {
+		predicates = append(predicates, agentaction.ResultHasPrefix(*i.ResultHasPrefix))
+	}
+	if i.ResultHasSuffix != nil {
+		predicates = append(predicates, agentaction.ResultHasSuffix(*i.ResultHasSuffix))
+	}
+	if i.ResultIsNil {
+		predicates = append(predicates, agentaction.ResultIsNil())
+	}
+	if i.ResultNotNil {
+		predicates = append(predicates, agentaction.ResultNotNil())
+	}
+	if i.ResultEqualFold != nil {
+		predicates = append(predicates, agentaction.ResultEqualFold(*i.ResultEqualFold))
+	}
+	if i.ResultContainsFold != nil {
+		predicates = append(predicates, agentaction.ResultContainsFold(*i.ResultContainsFold))
+	}
+	if i.Error != nil {
+		predicates = append(predicates, agentaction.ErrorEQ(*i.Error))
+	}
+	if i.ErrorNEQ != nil {
+		predicates = append(predicates, agentaction.ErrorNEQ(*i.ErrorNEQ))
+	}
+	if len(i.ErrorIn) > 0 {
+		predicates = append(predicates, agentaction.ErrorIn(i.ErrorIn...))
+	}
+	if len(i.ErrorNotIn) > 0 {
+		predicates = append(predicates, agentaction.ErrorNotIn(i.ErrorNotIn...))
+	}
+	if i.ErrorGT != nil {
+		predicates = append(predicates, agentaction.ErrorGT(*i.ErrorGT))
+	}
+	if i.ErrorGTE != nil {
+		predicates = append(predicates, agentaction.ErrorGTE(*i.ErrorGTE))
+	}
+	if i.ErrorLT != nil {
+		predicates = append(predicates, agentaction.ErrorLT(*i.ErrorLT))
+	}
+	if i.ErrorLTE != nil {
+		predicates = append(predicates, agentaction.ErrorLTE(*i.ErrorLTE))
+	}
+	if i.ErrorContains != nil {
+		predicates = append(predicates, agentaction.ErrorContains(*i.ErrorContains))
+	}
+	if i.ErrorHasPrefix != nil {
+		predicates = append(predicates, agentaction.ErrorHasPrefix(*i.ErrorHasPrefix))
+	}
+	if i.ErrorHasSuffix != nil {
+		predicates = append(predicates, agentaction.ErrorHasSuffix(*i.ErrorHasSuffix))
+	}
+	if i.ErrorIsNil {
+		predicates = append(predicates, agentaction.ErrorIsNil())
+	}
+	if i.ErrorNotNil {
+		predicates = append(predicates, agentaction.ErrorNotNil())
+	}
+	if i.ErrorEqualFold != nil {
+		predicates = append(predicates, agentaction.ErrorEqualFold(*i.ErrorEqualFold))
+	}
+	if i.ErrorContainsFold != nil {
+		predicates = append(predicates, agentaction.ErrorContainsFold(*i.ErrorContainsFold))
+	}
+	if i.LatencyMs != nil {
+		predicates = append(predicates, agentaction.LatencyMsEQ(*i.LatencyMs))
+	}
+	if i.LatencyMsNEQ != nil {
+		predicates = append(predicates, agentaction.LatencyMsNEQ(*i.LatencyMsNEQ))
+	}
+	if len(i.LatencyMsIn) > 0 {
+		predicates = append(predicates, agentaction.LatencyMsIn(i.LatencyMsIn...))
+	}
+	if len(i.LatencyMsNotIn) > 0 {
+		predicates = append(predicates, agentaction.LatencyMsNotIn(i.LatencyMsNotIn...))
+	}
+	if i.LatencyMsGT != nil {
+		predicates = append(predicates, agentaction.LatencyMsGT(*i.LatencyMsGT))
+	}
+	if i.LatencyMsGTE != nil {
+		predicates = append(predicates, agentaction.LatencyMsGTE(*i.LatencyMsGTE))
+	}
+	if i.LatencyMsLT != nil {
+		predicates = append(predicates, agentaction.LatencyMsLT(*i.LatencyMsLT))
+	}
+	if i.LatencyMsLTE != nil {
+		predicates = append(predicates, agentaction.LatencyMsLTE(*i.LatencyMsLTE))
+	}
+	if i.LatencyMsIsNil {
+		predicates = append(predicates, agentaction.LatencyMsIsNil())
+	}
+	if i.LatencyMsNotNil {
+		predicates = append(predicates, agentaction.LatencyMsNotNil())
+	}
+	if i.SessionID != nil {
+		predicates = append(predicates, agentaction.SessionIDEQ(*i.SessionID))
+	}
+	if i.SessionIDNEQ != nil {
+		predicates = append(predicates, agentaction.SessionIDNEQ(*i.SessionIDNEQ))
+	}
+	if len(i.SessionIDIn) > 0 {
+		predicates = append(predicates, agentaction.SessionIDIn(i.SessionIDIn...))
+	}
+	if len(i.SessionIDNotIn) > 0 {
+		predicates = append(predicates, agentaction.SessionIDNotIn(i.SessionIDNotIn...))
+	}
+	if i.SessionIDGT != nil {
+		predicates = append(predicates, agentaction.SessionIDGT(*i.SessionIDGT))
+	}
+	if i.SessionIDGTE != nil {
+		predicates = append(predicates, agentaction.SessionIDGTE(*i.SessionIDGTE))
+	}
+	if i.SessionIDLT != nil {
+		predicates = append(predicates, agentaction.SessionIDLT(*i.SessionIDLT))
+	}
+	if i.SessionIDLTE != nil {
+		predicates = append(predicates, agentaction.SessionIDLTE(*i.SessionIDLTE))
+	}
+	if i.SessionIDContains != nil {
+		predicates = append(predicates, agentaction.SessionIDContains(*i.SessionIDContains))
+	}
+	if i.SessionIDHasPrefix != nil {
+		predicates = append(predicates, agentaction.SessionIDHasPrefix(*i.SessionIDHasPrefix))
+	}
+	if i.SessionIDHasSuffix != nil {
+		predicates = append(predicates, agentaction.SessionIDHasSuffix(*i.SessionIDHasSuffix))
+	}
+	if i.SessionIDIsNil {
+		predicates = append(predicates, agentaction.SessionIDIsNil())
+	}
+	if i.SessionIDNotNil {
+		predicates = append(predicates, agentaction.SessionIDNotNil())
+	}
+	if i.SessionIDEqualFold != nil {
+		predicates = append(predicates, agentaction.SessionIDEqualFold(*i.SessionIDEqualFold))
+	}
+	if i.SessionIDContainsFold != nil {
+		predicates = append(predicates, agentaction.SessionIDContainsFold(*i.SessionIDContainsFold))
+	}
+	if i.UserID != nil {
+		predicates = append(predicates, agentaction.UserIDEQ(*i.UserID))
+	}
+	if i.UserIDNEQ != nil {
+		predicates = append(predicates, agentaction.UserIDNEQ(*i.UserIDNEQ))
+	}
+	if len(i.UserIDIn) > 0 {
+		predicates = append(predicates, agentaction.UserIDIn(i.UserIDIn...))
+	}
+	if len(i.UserIDNotIn) > 0 {
+		predicates = append(predicates, agentaction.UserIDNotIn(i.UserIDNotIn...))
+	}
+	if i.UserIDGT != nil {
+		predicates = append(predicates, agentaction.UserIDGT(*i.UserIDGT))
+	}
+	if i.UserIDGTE != nil {
+		predicates = append(predicates, agentaction.UserIDGTE(*i.UserIDGTE))
+	}
+	if i.UserIDLT != nil {
+		predicates = append(predicates, agentaction.UserIDLT(*i.UserIDLT))
+	}
+	if i.UserIDLTE != nil {
+		predicates = append(predicates, agentaction.UserIDLTE(*i.UserIDLTE))
+	}
+	if i.UserIDContains != nil {
+		predicates = append(predicates, agentaction.UserIDContains(*i.UserIDContains))
+	}
+	if i.UserIDHasPrefix != nil {
+		predicates = append(predicates, agentaction.UserIDHasPrefix(*i.UserIDHasPrefix))
+	}
+	if i.UserIDHasSuffix != nil {
+		predicates = append(predicates, agentaction.UserIDHasSuffix(*i.UserIDHasSuffix))
+	}
+	if i.UserIDIsNil {
+		predicates = append(predicates, agentaction.UserIDIsNil())
+	}
+	if i.UserIDNotNil {
+		predicates = append(predicates, agentaction.UserIDNotNil())
+	}
+	if i.UserIDEqualFold != nil {
+		predicates = append(predicates, agentaction.UserIDEqualFold(*i.UserIDEqualFold))
+	}
+	if i.UserIDContainsFold != nil {
+		predicates = append(predicates, agentaction.UserIDContainsFold(*i.UserIDContainsFold))
+	}
+
+	if i.HasDecisions != nil {
+		p := agentaction.HasDecisions()
+		if !*i.HasDecisions {
+			p = agentaction.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasDecisionsWith) > 0 {
+		with := make([]predicate.RoutingDecision, 0, len(i.HasDecisionsWith))
+		for _, w := range i.HasDecisionsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasDecisionsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, agentaction.HasDecisionsWith(with...))
+	}
+	if i.HasWorkflows != nil {
+		p := agentaction.HasWorkflows()
+		if !*i.HasWorkflows {
+			p = agentaction.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasWorkflowsWith) > 0 {
+		with := make([]predicate.WorkflowExecution, 0, len(i.HasWorkflowsWith))
+		for _, w := range i.HasWorkflowsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasWorkflowsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, agentaction.HasWorkflowsWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyAgentActionWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return agentaction.And(predicates...), nil
+	}
+}
+
+// ExternalOutputWhereInput represents a where input for filtering ExternalOutput queries.
+type ExternalOutputWhereInput struct {
+	Predicates []predicate.ExternalOutput  `json:"-"`
+	Not        *ExternalOutputWhereInput   `json:"not,omitempty"`
+	Or         []*ExternalOutputWhereInput `json:"or,omitempty"`
+	And        []*ExternalOutputWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID             *string  `json:"id,omitempty"`
+	IDNEQ          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGT           *string  `json:"idGT,omitempty"`
+	IDGTE          *string  `json:"idGTE,omitempty"`
+	IDLT           *string  `json:"idLT,omitempty"`
+	IDLTE          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+
+	// "provenance_hash" field predicates.
+	ProvenanceHash             *string  `json:"provenanceHash,omitempty"`
+	ProvenanceHashNEQ          *string  `json:"provenanceHashNEQ,omitempty"`
+	ProvenanceHashIn           []string `json:"provenanceHashIn,omitempty"`
+	ProvenanceHashNotIn        []string `json:"provenanceHashNotIn,omitempty"`
+	ProvenanceHashGT           *string  `json:"provenanceHashGT,omitempty"`
+	ProvenanceHashGTE          *string  `json:"provenanceHashGTE,omitempty"`
+	ProvenanceHashLT           *string  `json:"provenanceHashLT,omitempty"`
+	ProvenanceHashLTE          *string  `json:"provenanceHashLTE,omitempty"`
+	ProvenanceHashContains     *string  `json:"provenanceHashContains,omitempty"`
+	ProvenanceHashHasPrefix    *string  `json:"provenanceHashHasPrefix,omitempty"`
+	ProvenanceHashHasSuffix    *string  `json:"provenanceHashHasSuffix,omitempty"`
+	ProvenanceHashIsNil        bool     `json:"provenanceHashIsNil,omitempty"`
+	ProvenanceHashNotNil       bool     `json:"provenanceHashNotNil,omitempty"`
+	ProvenanceHashEqualFold    *string  `json:"provenanceHashEqualFold,omitempty"`
+	ProvenanceHashContainsFold *string  `json:"provenanceHashContainsFold,omitempty"`
+
+	// "timestamp" field predicates.
+	Timestamp      *time.Time  `json:"timestamp,omitempty"`
+	TimestampNEQ   *time.Time  `json:"timestampNEQ,omitempty"`
+	TimestampIn    []time.Time `json:"timestampIn,omitempty"`
+	TimestampNotIn []time.Time `json:"timestampNotIn,omitempty"`
+	TimestampGT    *time.Time  `json:"timestampGT,omitempty"`
+	TimestampGTE   *time.Time  `json:"timestampGTE,omitempty"`
+	TimestampLT    *time.Time  `json:"timestampLT,omitempty"`
+	TimestampLTE   *time.Time  `json:"timestampLTE,omitempty"`
+
+	// "output_type" field predicates.
+	OutputType      *externaloutput.OutputType  `json:"outputType,omitempty"`
+	OutputTypeNEQ   *externaloutput.OutputType  `json:"outputTypeNEQ,omitempty"`
+	OutputTypeIn    []externaloutput.OutputType `json:"outputTypeIn,omitempty"`
+	OutputTypeNotIn []externaloutput.OutputType `json:"outputTypeNotIn,omitempty"`
+
+	// "destination" field predicates.
+	Destination             *string  `json:"destination,omitempty"`
+	DestinationNEQ          *string  `json:"destinationNEQ,omitempty"`
+	DestinationIn           []string `json:"destinationIn,omitempty"`
+	DestinationNotIn        []string `json:"destinationNotIn,omitempty"`
+	DestinationGT           *string  `json:"destinationGT,omitempty"`
+	DestinationGTE          *string  `json:"destinationGTE,omitempty"`
+	DestinationLT           *string  `json:"destinationLT,omitempty"`
+	DestinationLTE          *string  `json:"destinationLTE,omitempty"`
+	DestinationContains     *string  `json:"destinationContains,omitempty"`
+	DestinationHasPrefix    *string  `json:"destinationHasPrefix,omitempty"`
+	DestinationHasSuffix    *string  `json:"destinationHasSuffix,omitempty"`
+	DestinationIsNil        bool     `json:"destinationIsNil,omitempty"`
+	DestinationNotNil       bool     `json:"destinationNotNil,omitempty"`
+	DestinationEqualFold    *string  `json:"destinationEqualFold,omitempty"`
+	DestinationContainsFold *string  `json:"destinationContainsFold,omitempty"`
+
+	// "destination_id" field predicates.
+	DestinationID             *string  `json:"destinationID,omitempty"`
+	DestinationIDNEQ          *string  `json:"destinationIDNEQ,omitempty"`
+	DestinationIDIn           []string `json:"destinationIDIn,omitempty"`
+	DestinationIDNotIn        []string `json:"destinationIDNotIn,omitempty"`
+	DestinationIDGT           *string  `json:"destinationIDGT,omitempty"`
+	DestinationIDGTE          *string  `json:"destinationIDGTE,omitempty"`
+	DestinationIDLT           *string  `json:"destinationIDLT,omitempty"`
+	DestinationIDLTE          *string  `json:"destinationIDLTE,omitempty"`
+	DestinationIDContains     *string  `json:"destinationIDContains,omitempty"`
+	DestinationIDHasPrefix    *string  `json:"destinationIDHasPrefix,omitempty"`
+	DestinationIDHasSuffix    *string  `json:"destinationIDHasSuffix,omitempty"`
+	DestinationIDIsNil        bool     `json:"destinationIDIsNil,omitempty"`
+	DestinationIDNotNil       bool     `json:"destinationIDNotNil,omitempty"`
+	DestinationIDEqualFold    *string  `json:"destinationIDEqualFold,omitempty"`
+	DestinationIDContainsFold *string  `json:"destinationIDContainsFold,omitempty"`
+
+	// "transaction_id" field predicates.
+	TransactionID             *string  `json:"transactionID,omitempty"`
+	TransactionIDNEQ          *string  `json:"transactionIDNEQ,omitempty"`
+	TransactionIDIn           []string `json:"transactionIDIn,omitempty"`
+	TransactionIDNotIn        []string `json:"transactionIDNotIn,omitempty"`
+	TransactionIDGT           *string  `json:"transactionIDGT,omitempty"`
+	TransactionIDGTE          *string  `json:"transactionIDGTE,omitempty"`
+	TransactionIDLT           *string  `json:"transactionIDLT,omitempty"`
+	TransactionIDLTE          *string  `json:"transactionIDLTE,omitempty"`
+	TransactionIDContains     *string  `json:"transactionIDContains,omitempty"`
+	TransactionIDHasPrefix    *string  `json:"transactionIDHasPrefix,omitempty"`
+	TransactionIDHasSuffix    *string  `json:"transactionIDHasSuffix,omitempty"`
+	TransactionIDIsNil        bool     `json:"transactionIDIsNil,omitempty"`
+	TransactionIDNotNil       bool     `json:"transactionIDNotNil,omitempty"`
+	TransactionIDEqualFold    *string  `json:"transactionIDEqualFold,omitempty"`
+	TransactionIDContainsFold *string  `json:"transactionIDContainsFold,omitempty"`
+
+	// "block_hash" field predicates.
+	BlockHash             *string  `json:"blockHash,omitempty"`
+	BlockHashNEQ          *string  `json:"blockHashNEQ,omitempty"`
+	BlockHashIn           []string `json:"blockHashIn,omitempty"`
+	BlockHashNotIn        []string `json:"blockHashNotIn,omitempty"`
+	BlockHashGT           *string  `json:"blockHashGT,omitempty"`
+	BlockHashGTE          *string  `json:"blockHashGTE,omitempty"`
+	BlockHashLT           *string  `json:"blockHashLT,omitempty"`
+	BlockHashLTE          *string  `json:"blockHashLTE,omitempty"`
+	BlockHashContains     *string  `json:"blockHashContains,omitempty"`
+	BlockHashHasPrefix    *string  `json:"blockHashHasPrefix,omitempty"`
+	BlockHashHasSuffix    *string  `json:"blockHashHasSuffix,omitempty"`
+	BlockHashIsNil        bool     `json:"blockHashIsNil,omitempty"`
+	BlockHashNotNil       bool     `json:"blockHashNotNil,omitempty"`
+	BlockHashEqualFold    *string  `json:"blockHashEqualFold,omitempty"`
+	BlockHashContainsFold *string  `json:"blockHashContainsFold,omitempty"`
+
+	// "block_number" field predicates.
+	BlockNumber       *int64  `json:"blockNumber,omitempty"`
+	BlockNumberNEQ    *int64  `json:"blockNumberNEQ,omitempty"`
+	BlockNumberIn     []int64 `json:"blockNumberIn,omitempty"`
+	BlockNumberNotIn  []int64 `json:"blockNumberNotIn,omitempty"`
+	BlockNumberGT     *int64  `json:"blockNumberGT,omitempty"`
+	BlockNumberGTE    *int64  `json:"blockNumberGTE,omitempty"`
+	BlockNumberLT     *int64  `json:"blockNumberLT,omitempty"`
+	BlockNumberLTE    *int64  `json:"blockNumberLTE,omitempty"`
+	BlockNumberIsNil  bool    `json:"blockNumberIsNil,omitempty"`
+	BlockNumberNotNil bool    `json:"blockNumberNotNil,omitempty"`
+
+	// "content_hash" field predicates.
+	ContentHash             *string  `json:"contentHash,omitempty"`
+	ContentHashNEQ          *string  `json:"contentHashNEQ,omitempty"`
+	ContentHashIn           []string `json:"contentHashIn,omitempty"`
+	ContentHashNotIn        []string `json:"contentHashNotIn,omitempty"`
+	ContentHashGT           *string  `json:"contentHashGT,omitempty"`
+	ContentHashGTE          *string  `json:"contentHashGTE,omitempty"`
+	ContentHashLT           *string  `json:"contentHashLT,omitempty"`
+	ContentHashLTE          *string  `json:"contentHashLTE,omitempty"`
+	ContentHashContains     *string  `json:"contentHashContains,omitempty"`
+	ContentHashHasPrefix    *string  `json:"contentHashHasPrefix,omitempty"`
+	ContentHashHasSuffix    *string  `json:"contentHashHasSuffix,omitempty"`
+	ContentHashEqualFold    *string  `json:"contentHashEqualFold,omitempty"`
+	ContentHashContainsFold *string  `json:"contentHashContainsFold,omitempty"`
+
+	// "content_size" field predicates.
+	ContentSize       *int64  `json:"contentSize,omitempty"`
+	ContentSizeNEQ    *int64  `json:"contentSizeNEQ,omitempty"`
+	ContentSizeIn     []int64 `json:"contentSizeIn,omitempty"`
+	ContentSizeNotIn  []int64 `json:"contentSizeNotIn,omitempty"`
+	ContentSizeGT     *int64  `json:"contentSizeGT,omitempty"`
+	ContentSizeGTE    *int64  `json:"contentSizeGTE,omitempty"`
+	ContentSizeLT     *int64  `json:"contentSizeLT,omitempty"`
+	ContentSizeLTE    *int64  `json:"contentSizeLTE,omitempty"`
+	ContentSizeIsNil  bool    `json:"contentSizeIsNil,omitempty"`
+	ContentSizeNotNil bool    `json:"contentSizeNotNil,omitempty"`
+
+	// "status" field predicates.
+	Status      *externaloutput.Status  `json:"status,omitempty"`
+	StatusNEQ   *externaloutput.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []externaloutput.Status `json:"statusIn,omitempty"`
+	StatusNotIn []externaloutput.Status `json:"statusNotIn,omitempty"`
+
+	// "domain" field predicates.
+	Domain             *string  `json:"domain,omitempty"`
+	DomainNEQ          *string  `json:"domainNEQ,omitempty"`
+	DomainIn           []string `json:"domainIn,omitempty"`
+	DomainNotIn        []string `json:"domainNotIn,omitempty"`
+	DomainGT           *string  `json:"domainGT,omitempty"`
+	DomainGTE          *string  `json:"domainGTE,omitempty"`
+	DomainLT           *string  `json:"domainLT,omitempty"`
+	DomainLTE          *string  `json:"domainLTE,omitempty"`
+	DomainContains     *string  `json:"domainContains,omitempty"`
+	DomainHasPrefix    *string  `json:"domainHasPrefix,omitempty"`
+	DomainHasSuffix    *string  `json:"domainHasSuffix,omitempty"`
+	DomainIsNil        bool     `json:"domainIsNil,omitempty"`
+	DomainNotNil       bool     `json:"domainNotNil,omitempty"`
+	DomainEqualFold    *string  `json:"domainEqualFold,omitempty"`
+	DomainContainsFold *string  `json:"domainContainsFold,omitempty"`
+
+	// "retention_years" field predicates.
+	RetentionYears      *int  `json:"retentionYears,omitempty"`
+	RetentionYearsNEQ   *int  `json:"retentionYearsNEQ,omitempty"`
+	RetentionYearsIn    []int `json:"retentionYearsIn,omitempty"`
+	RetentionYearsNotIn []int `json:"retentionYearsNotIn,omitempty"`
+	RetentionYearsGT    *int  `json:"retentionYearsGT,omitempty"`
+	RetentionYearsGTE   *int  `json:"retentionYearsGTE,omitempty"`
+	RetentionYearsLT    *int  `json:"retentionYearsLT,omitempty"`
+	RetentionYearsLTE   *int  `json:"retentionYearsLTE,omitempty"`
+
+	// "workflows" edge predicates.
+	HasWorkflows     *bool                          `json:"hasWorkflows,omitempty"`
+	HasWorkflowsWith []*WorkflowExecutionWhereInput `json:"hasWorkflowsWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *ExternalOutputWhereInput) AddPredicates(predicates ...predicate.ExternalOutput) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the ExternalOutputWhereInput filter on the ExternalOutputQuery builder.
+func (i *ExternalOutputWhereInput) Filter(q *ExternalOutputQuery) (*ExternalOutputQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyExternalOutputWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyExternalOutputWhereInput is returned in case the ExternalOutputWhereInput is empty.
+var ErrEmptyExternalOutputWhereInput = errors.New("ent: empty predicate ExternalOutputWhereInput")
+
+// P returns a predicate for filtering externaloutputs.
+// An error is returned if the input is empty or invalid.
+func (i *ExternalOutputWhereInput) P() (predicate.ExternalOutput, error) {
+	var predicates []predicate.ExternalOutput
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, externaloutput.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.ExternalOutput, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, externaloutput.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.ExternalOutput, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, externaloutput.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, externaloutput.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, externaloutput.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, externaloutput.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, externaloutput.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, externaloutput.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, externaloutput.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, externaloutput.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, externaloutput.IDLTE(*i.IDLTE))
+	}
+	if i.IDEqualFold != nil {
+		predicates = append(predicates, externaloutput.IDEqualFold(*i.IDEqualFold))
+	}
+	if i.IDContainsFold != nil {
+		predicates = append(predicates, externaloutput.IDContainsFold(*i.IDContainsFold))
+	}
+	if i.ProvenanceHash != nil {
+		predicates = append(predicates, externaloutput.ProvenanceHashEQ(*i.ProvenanceHash))
+	}
+	if i.ProvenanceHashNEQ != nil {
+		predicates = append(predicates, externaloutput.ProvenanceHashNEQ(*i.ProvenanceHashNEQ))
+	}
+	if len(i.ProvenanceHashIn) > 0 {
+		predicates = append(predicates, externaloutput.ProvenanceHashIn(i.ProvenanceHashIn...))
+	}
+	if len(i.ProvenanceHashNotIn) > 0 {
+		predicates = append(predicates, externaloutput.ProvenanceHashNotIn(i.ProvenanceHashNotIn...))
+	}
+	if i.ProvenanceHashGT != nil {
+		predicates = append(predicates, externaloutput.ProvenanceHashGT(*i.ProvenanceHashGT))
+	}
+	if i.ProvenanceHashGTE != nil {
+		predicates = append(predicates, externaloutput.ProvenanceHashGTE(*i.ProvenanceHashGTE))
+	}
+	if i.ProvenanceHashLT != nil {
+		predicates = append(predicates, externaloutput.ProvenanceHashLT(*i.ProvenanceHashLT))
+	}
+	if i.ProvenanceHashLTE != nil {
+		predicates = append(predicates, externaloutput.ProvenanceHashLTE(*i.ProvenanceHashLTE))
+	}
+	if i.ProvenanceHashContains != nil {
+		predicates = append(predicates, externaloutput.ProvenanceHashContains(*i.ProvenanceHashContains))
+	}
+	if i.ProvenanceHashHasPrefix != nil {
+		predicates = append(predicates, externaloutput.ProvenanceHashHasPrefix(*i.ProvenanceHashHasPrefix))
+	}
+	if i.ProvenanceHashHasSuffix != nil {
+		predicates = append(predicates, externaloutput.ProvenanceHashHasSuffix(*i.ProvenanceHashHasSuffix))
+	}
+	if i.ProvenanceHashIsNil {
+		predicates = append(predicates, externaloutput.ProvenanceHashIsNil())
+	}
+	if i.ProvenanceHashNotNil {
+		predicates = append(predicates, externaloutput.ProvenanceHashNotNil())
+	}
+	if i.ProvenanceHashEqualFold != nil {
+		predicates = append(predicates, externaloutput.ProvenanceHashEqualFold(*i.ProvenanceHashEqualFold))
+	}
+	if i.ProvenanceHashContainsFold != nil {
+		predicates = append(predicates, externaloutput.ProvenanceHashContainsFold(*i.ProvenanceHashContainsFold))
+	}
+	if i.Timestamp != nil {
+		predicates = append(predicates, externaloutput.TimestampEQ(*i.Timestamp))
+	}
+	if i.TimestampNEQ != nil {
+		predicates = append(predicates, externaloutput.TimestampNEQ(*i.TimestampNEQ))
+	}
+	if len(i.TimestampIn) > 0 {
+		predicates = append(predicates, externaloutput.TimestampIn(i.TimestampIn...))
+	}
+	if len(i.TimestampNotIn) > 0 {
+		predicates = append(predicates, externaloutput.TimestampNotIn(i.TimestampNotIn...))
+	}
+	if i.TimestampGT != nil {
+		predicates = append(predicates, externaloutput.TimestampGT(*i.TimestampGT))
+	}
+	if i.TimestampGTE != nil {
+		predicates = append(predicates, externaloutput.TimestampGTE(*i.TimestampGTE))
+	}
+	if i.TimestampLT != nil {
+		predicates = append(predicates, externaloutput.TimestampLT(*i.TimestampLT))
+	}
+	if i.TimestampLTE != nil {
+		predicates = append(predicates, externaloutput.TimestampLTE(*i.TimestampLTE))
+	}
+	if i.OutputType != nil {
+		predicates = append(predicates, externaloutput.OutputTypeEQ(*i.OutputType))
+	}
+	if i.OutputTypeNEQ != nil {
+		predicates = append(predicates, externaloutput.OutputTypeNEQ(*i.OutputTypeNEQ))
+	}
+	if len(i.OutputTypeIn) > 0 {
+		predicates = append(predicates, externaloutput.OutputTypeIn(i.OutputTypeIn...))
+	}
+	if len(i.OutputTypeNotIn) > 0 {
+		predicates = append(predicates, externaloutput.OutputTypeNotIn(i.OutputTypeNotIn...))
+	}
+	if i.Destination != nil {
+		predicates = append(predicates, externaloutput.DestinationEQ(*i.Destination))
+	}
+	if i.DestinationNEQ != nil {
+		predicates = append(predicates, externaloutput.DestinationNEQ(*i.DestinationNEQ))
+	}
+	if len(i.DestinationIn) > 0 {
+		predicates = append(predicates, externaloutput.DestinationIn(i.DestinationIn...))
+	}
+	if len(i.DestinationNotIn) > 0 {
+		predicates = append(predicates, externaloutput.DestinationNotIn(i.DestinationNotIn...))
+	}
+	if i.DestinationGT != nil {
+		predicates = append(predicates, externaloutput.DestinationGT(*i.DestinationGT))
+	}
+	if i.DestinationGTE != nil {
+		predicates = append(predicates, externaloutput.DestinationGTE(*i.DestinationGTE))
+	}
+	if i.DestinationLT != nil {
+		predicates = append(predicates, externaloutput.DestinationLT(*i.DestinationLT))
+	}
+	if i.DestinationLTE != nil {
+		predicates = append(predicates, externaloutput.DestinationLTE(*i.DestinationLTE))
+	}
+	if i.DestinationContains != nil {
+		predicates = append(predicates, externaloutput.DestinationContains(*i.DestinationContains))
+	}
+	if i.DestinationHasPrefix != nil {
+		predicates = append(predicates, externaloutput.DestinationHasPrefix(*i.DestinationHasPrefix))
+	}
+	if i.DestinationHasSuffix != nil {
+		predicates = append(predicates, externaloutput.DestinationHasSuffix(*i.DestinationHasSuffix))
+	}
+	if i.DestinationIsNil {
+		predicates = append(predicates, externaloutput.DestinationIsNil())
+	}
+	if i.DestinationNotNil {
+		predicates = append(predicates, externaloutput.DestinationNotNil())
+	}
+	if i.DestinationEqualFold != nil {
+		predicates = append(predicates, externaloutput.DestinationEqualFold(*i.DestinationEqualFold))
+	}
+	if i.DestinationContainsFold != nil {
+		predicates = append(predicates, externaloutput.DestinationContainsFold(*i.DestinationContainsFold))
+	}
+	if i.DestinationID != nil {
+		predicates = append(predicates, externaloutput.DestinationIDEQ(*i.DestinationID))
+	}
+	if i.DestinationIDNEQ != nil {
+		predicates = append(predicates, externaloutput.DestinationIDNEQ(*i.DestinationIDNEQ))
+	}
+	if len(i.DestinationIDIn) > 0 {
+		predicates = append(predicates, externaloutput.DestinationIDIn(i.DestinationIDIn...))
+	}
+	if len(i.DestinationIDNotIn) > 0 {
+		predicates = append(predicates, externaloutput.DestinationIDNotIn(i.DestinationIDNotIn...))
+	}
+	if i.DestinationIDGT != nil {
+		predicates = append(predicates, externaloutput.DestinationIDGT(*i.DestinationIDGT))
+	}
+	if i.DestinationIDGTE != nil {
+		predicates = append(predicates, externaloutput.DestinationIDGTE(*i.DestinationIDGTE))
+	}
+	if i.DestinationIDLT != nil {
+		predicates = append(predicates, externaloutput.DestinationIDLT(*i.DestinationIDLT))
+	}
+	if i.DestinationIDLTE != nil {
+		predicates = append(predicates, externaloutput.DestinationIDLTE(*i.DestinationIDLTE))
+	}
+	if i.DestinationIDContains != nil {
+		predicates = append(predicates, externaloutput.DestinationIDContains(*i.DestinationIDContains))
+	}
+	if i.DestinationIDHasPrefix != nil {
+		predicates = append(predicates, externaloutput.DestinationIDHasPrefix(*i.DestinationIDHasPrefix))
+	}
+	if i.DestinationIDHasSuffix != nil {
+		predicates = append(predicates, externaloutput.DestinationIDHasSuffix(*i.DestinationIDHasSuffix))
+	}
+	if i.DestinationIDIsNil {
+		predicates = append(predicates, externaloutput.DestinationIDIsNil())
+	}
+	if i.DestinationIDNotNil {
+		predicates = append(predicates, externaloutput.DestinationIDNotNil())
+	}
+	if i.DestinationIDEqualFold != nil {
+		predicates = append(predicates, externaloutput.DestinationIDEqualFold(*i.DestinationIDEqualFold))
+	}
+	if i.DestinationIDContainsFold != nil {
+		predicates = append(predicates, externaloutput.DestinationIDContainsFold(*i.DestinationIDContainsFold))
+	}
+	if i.TransactionID != nil {
+		predicates = append(predicates, externaloutput.TransactionIDEQ(*i.TransactionID))
+	}
+	if i.TransactionIDNEQ != nil {
+		predicates = append(predicates, externaloutput.TransactionIDNEQ(*i.TransactionIDNEQ))
+	}
+	if len(i.TransactionIDIn) > 0 {
+		predicates = append(predicates, externaloutput.TransactionIDIn(i.TransactionIDIn...))
+	}
+	if len(i.TransactionIDNotIn) > 0 {
+		predicates = append(predicates, externaloutput.TransactionIDNotIn(i.TransactionIDNotIn...))
+	}
+	if i.TransactionIDGT != nil {
+		predicates = append(predicates, externaloutput.TransactionIDGT(*i.TransactionIDGT))
+	}
+	if i.TransactionIDGTE != nil {
+		predicates = append(predicates, externaloutput.TransactionIDGTE(*i.TransactionIDGTE))
+	}
+	if i.TransactionIDLT != nil {
+		predicates = append(predicates, externaloutput.TransactionIDLT(*i.TransactionIDLT))
+	}
+	if i.TransactionIDLTE != nil {
+		predicates = append(predicates, externaloutput.TransactionIDLTE(*i.TransactionIDLTE))
+	}
+	if i.TransactionIDContains != nil {
+		predicates = append(predicates, externaloutput.TransactionIDContains(*i.TransactionIDContains))
+	}
+	if i.TransactionIDHasPrefix != nil {
+		predicates = append(predicates, externaloutput.TransactionIDHasPrefix(*i.TransactionIDHasPrefix))
+	}
+	if i.TransactionIDHasSuffix != nil {
+		predicates = append(predicates, externaloutput.TransactionIDHasSuffix(*i.TransactionIDHasSuffix))
+	}
+	if i.TransactionIDIsNil {
+		predicates = append(predicates, externaloutput.TransactionIDIsNil())
+	}
+	if i.TransactionIDNotNil {
+		predicates = append(predicates, externaloutput.TransactionIDNotNil())
+	}
+	if i.TransactionIDEqualFold != nil {
+		predicates = append(predicates, externaloutput.TransactionIDEqualFold(*i.TransactionIDEqualFold))
+	}
+	if i.TransactionIDContainsFold != nil {
+		predicates = append(predicates, externaloutput.TransactionIDContainsFold(*i.TransactionIDContainsFold))
+	}
+	if i.BlockHash != nil {
+		predicates = append(predicates, externaloutput.BlockHashEQ(*i.BlockHash))
+	}
+	if i.BlockHashNEQ != nil {
+		predicates = append(predicates, externaloutput.BlockHashNEQ(*i.BlockHashNEQ))
+	}
+	if len(i.BlockHashIn) > 0 {
+		predicates = append(predicates, externaloutput.BlockHashIn(i.BlockHashIn...))
+	}
+	if len(i.BlockHashNotIn) > 0 {
+		predicates = append(predicates, externaloutput.BlockHashNotIn(i.BlockHashNotIn...))
+	}
+	if i.BlockHashGT != nil {
+		predicates = append(predicates, externaloutput.BlockHashGT(*i.BlockHashGT))
+	}
+	if i.BlockHashGTE != nil {
+		predicates = append(predicates, externaloutput.BlockHashGTE(*i.BlockHashGTE))
+	}
+	if i.BlockHashLT != nil {
+		predicates = append(predicates, externaloutput.BlockHashLT(*i.BlockHashLT))
+	}
+	if i.BlockHashLTE != nil {
+		predicates = append(predicates, externaloutput.BlockHashLTE(*i.BlockHashLTE))
+	}
+	if i.BlockHashContains != nil {
+		predicates = append(predicates, externaloutput.BlockHashContains(*i.BlockHashContains))
+	}
+	if i.BlockHashHasPrefix != nil {
+		predicates = append(predicates, externaloutput.BlockHashHasPrefix(*i.BlockHashHasPrefix))
+	}
+	if i.BlockHashHasSuffix != nil {
+		predicates = append(predicates, externaloutput.BlockHashHasSuffix(*i.BlockHashHasSuffix))
+	}
+	if i.BlockHashIsNil {
+		predicates = append(predicates, externaloutput.BlockHashIsNil())
+	}
+	if i.BlockHashNotNil {
+		predicates = append(predicates, externaloutput.BlockHashNotNil())
+	}
+	if i.BlockHashEqualFold != nil {
+		predicates = append(predicates, externaloutput.BlockHashEqualFold(*i.BlockHashEqualFold))
+	}
+	if i.BlockHashContainsFold != nil {
+		predicates = append(predicates, externaloutput.BlockHashContainsFold(*i.BlockHashContainsFold))
+	}
+	if i.BlockNumber != nil {
+		predicates = append(predicates, externaloutput.BlockNumberEQ(*i.BlockNumber))
+	}
+	if i.BlockNumberNEQ != nil {
+		predicates = append(predicates, externaloutput.BlockNumberNEQ(*i.BlockNumberNEQ))
+	}
+	if len(i.BlockNumberIn) > 0 {
+		predicates = append(predicates, externaloutput.BlockNumberIn(i.BlockNumberIn...))
+	}
+	if len(i.BlockNumberNotIn) > 0 {
+		predicates = append(predicates, externaloutput.BlockNumberNotIn(i.BlockNumberNotIn...))
+	}
+	if i.BlockNumberGT != nil {
+		predicates = append(predicates, externaloutput.BlockNumberGT(*i.BlockNumberGT))
+	}
+	if i.BlockNumberGTE != nil {
+		predicates = append(predicates, externaloutput.BlockNumberGTE(*i.BlockNumberGTE))
+	}
+	if i.BlockNumberLT != nil {
+		predicates = append(predicates, externaloutput.BlockNumberLT(*i.BlockNumberLT))
+	}
+	if i.BlockNumberLTE != nil {
+		predicates = append(predicates, externaloutput.BlockNumberLTE(*i.BlockNumberLTE))
+	}
+	if i.BlockNumberIsNil {
+		predicates = append(predicates, externaloutput.BlockNumberIsNil())
+	}
+	if i.BlockNumberNotNil {
+		predicates = append(predicates, externaloutput.BlockNumberNotNil())
+	}
+	if i.ContentHash != nil {
+		predicates = append(predicates, externaloutput.ContentHashEQ(*i.ContentHash))
+	}
+	if i.ContentHashNEQ != nil {
+		predicates = append(predicates, externaloutput.ContentHashNEQ(*i.ContentHashNEQ))
+	}
+	if len(i.ContentHashIn) > 0 {
+		predicates = append(predicates, externaloutput.ContentHashIn(i.ContentHashIn...))
+	}
+	if len(i.ContentHashNotIn) > 0 {
+		predicates = append(predicates, externaloutput.ContentHashNotIn(i.ContentHashNotIn...))
+	}
+	if i.ContentHashGT != nil {
+		predicates = append(predicates, externaloutput.ContentHashGT(*i.ContentHashGT))
+	}
+	if i.ContentHashGTE != nil {
+		predicates = append(predicates, externaloutput.ContentHashGTE(*i.ContentHashGTE))
+	}
+	if i.ContentHashLT != nil {
+		predicates = append(predicates, externaloutput.ContentHashLT(*i.ContentHashLT))
+	}
+	if i.ContentHashLTE != nil {
+		predicates = append(predicates, externaloutput.ContentHashLTE(*i.ContentHashLTE))
+	}
+	if i.ContentHashContains != nil {
+		predicates = append(predicates, externaloutput.ContentHashContains(*i.ContentHashContains))
+	}
+	if i.ContentHashHasPrefix != nil {
+		predicates = append(predicates, externaloutput.ContentHashHasPrefix(*i.ContentHashHasPrefix))
+	}
+	if i.ContentHashHasSuffix != nil {
+		predicates = append(predicates, externaloutput.ContentHashHasSuffix(*i.ContentHashHasSuffix))
+	}
+	if i.ContentHashEqualFold != nil {
+		predicates = append(predicates, externaloutput.ContentHashEqualFold(*i.ContentHashEqualFold))
+	}
+	if i.ContentHashContainsFold != nil {
+		predicates = append(predicates, externaloutput.ContentHashContainsFold(*i.ContentHashContainsFold))
+	}
+	if i.ContentSize != nil {
+		predicates = append(predicates, externaloutput.ContentSizeEQ(*i.ContentSize))
+	}
+	if i.ContentSizeNEQ != nil {
+		predicates = append(predicates, externaloutput.ContentSizeNEQ(*i.ContentSizeNEQ))
+	}
+	if len(i.ContentSizeIn) > 0 {
+		predicates = append(predicates, externaloutput.ContentSizeIn(i.ContentSizeIn...))
+	}
+	if len(i.ContentSizeNotIn) > 0 {
+		predicates = append(predicates, externaloutput.ContentSizeNotIn(i.ContentSizeNotIn...))
+	}
+	if i.ContentSizeGT != nil {
+		predicates = append(predicates, externaloutput.ContentSizeGT(*i.ContentSizeGT))
+	}
+	if i.ContentSizeGTE != nil {
+		predicates = append(predicates, externaloutput.ContentSizeGTE(*i.ContentSizeGTE))
+	}
+	if i.ContentSizeLT != nil {
+		predicates = append(predicates, externaloutput.ContentSizeLT(*i.ContentSizeLT))
+	}
+	if i.ContentSizeLTE != nil {
+		predicates = append(predicates, externaloutput.ContentSizeLTE(*i.ContentSizeLTE))
+	}
+	if i.ContentSizeIsNil {
+		predicates = append(predicates, externaloutput.ContentSizeIsNil())
+	}
+	if i.ContentSizeNotNil {
+		predicates = append(predicates, externaloutput.ContentSizeNotNil())
+	}
+	if i.Status != nil {
+		predicates = append(predicates, externaloutput.StatusEQ(*i.Status))
+	}
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, externaloutput.StatusNEQ(*i.StatusNEQ))
+	}
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, externaloutput.StatusIn(i.StatusIn...))
+	}
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, externaloutput.StatusNotIn(i.StatusNotIn...))
+	}
+	if i.Domain != nil {
+		predicates = append(predicates, externaloutput.DomainEQ(*i.Domain))
+	}
+	if i.DomainNEQ != nil {
+		predicates = append(predicates, externaloutput.DomainNEQ(*i.DomainNEQ))
+	}
+	if len(i.DomainIn) > 0 {
+		predicates = append(predicates, externaloutput.DomainIn(i.DomainIn...))
+	}
+	if len(i.DomainNotIn) > 0 {
+		predicates = append(predicates, externaloutput.DomainNotIn(i.DomainNotIn...))
+	}
+	if i.DomainGT != nil {
+		predicates = append(predicates, externaloutput.DomainGT(*i.DomainGT))
+	}
+	if i.DomainGTE != nil {
+		predicates = append(predicates, externaloutput.DomainGTE(*i.DomainGTE))
+	}
+	if i.DomainLT != nil {
+		predicates = append(predicates, externaloutput.DomainLT(*i.DomainLT))
+	}
+	if i.DomainLTE != nil {
+		predicates = append(predicates, externaloutput.DomainLTE(*i.DomainLTE))
+	}
+	if i.DomainContains != nil {
+		predicates = append(predicates, externaloutput.DomainContains(*i.DomainContains))
+	}
+	if i.DomainHasPrefix != nil {
+		predicates = append(predicates, externaloutput.DomainHasPrefix(*i.DomainHasPrefix))
+	}
+	if i.DomainHasSuffix != nil {
+		predicates = append(predicates, externaloutput.DomainHasSuffix(*i.DomainHasSuffix))
+	}
+	if i.DomainIsNil {
+		predicates = append(predicates, externaloutput.DomainIsNil())
+	}
+	if i.DomainNotNil {
+		predicates = append(predicates, externaloutput.DomainNotNil())
+	}
+	if i.DomainEqualFold != nil {
+		predicates = append(predicates, externaloutput.DomainEqualFold(*i.DomainEqualFold))
+	}
+	if i.DomainContainsFold != nil {
+		predicates = append(predicates, externaloutput.DomainContainsFold(*i.DomainContainsFold))
+	}
+	if i.RetentionYears != nil {
+		predicates = append(predicates, externaloutput.RetentionYearsEQ(*i.RetentionYears))
+	}
+	if i.RetentionYearsNEQ != nil {
+		predicates = append(predicates, externaloutput.RetentionYearsNEQ(*i.RetentionYearsNEQ))
+	}
+	if len(i.RetentionYearsIn) > 0 {
+		predicates = append(predicates, externaloutput.RetentionYearsIn(i.RetentionYearsIn...))
+	}
+	if len(i.RetentionYearsNotIn) > 0 {
+		predicates = append(predicates, externaloutput.RetentionYearsNotIn(i.RetentionYearsNotIn...))
+	}
+	if i.RetentionYearsGT != nil {
+		predicates = append(predicates, externaloutput.RetentionYearsGT(*i.RetentionYearsGT))
+	}
+	if i.RetentionYearsGTE != nil {
+		predicates = append(predicates, externaloutput.RetentionYearsGTE(*i.RetentionYearsGTE))
+	}
+	if i.RetentionYearsLT != nil {
+		predicates = append(predicates, externaloutput.RetentionYearsLT(*i.RetentionYearsLT))
+	}
+	if i.RetentionYearsLTE != nil {
+		predicates = append(predicates, externaloutput.RetentionYearsLTE(*i.RetentionYearsLTE))
+	}
+
+	if i.HasWorkflows != nil {
+		p := externaloutput.HasWorkflows()
+		if !*i.HasWorkflows {
+			p = externaloutput.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasWorkflowsWith) > 0 {
+		with := make([]predicate.WorkflowExecution, 0, len(i.HasWorkflowsWith))
+		for _, w := range i.HasWorkflowsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasWorkflowsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, externaloutput.HasWorkflowsWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyExternalOutputWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return externaloutput.And(predicates...), nil
+	}
+}
+
+// RoutingDecisionWhereInput represents a where input for filtering RoutingDecision queries.
+type RoutingDecisionWhereInput struct {
+	Predicates []predicate.RoutingDecision  `json:"-"`
+	Not        *RoutingDecisionWhereInput   `json:"not,omitempty"`
+	Or         []*RoutingDecisionWhereInput `json:"or,omitempty"`
+	And        []*RoutingDecisionWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID             *string  `json:"id,omitempty"`
+	IDNEQ          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGT           *string  `json:"idGT,omitempty"`
+	IDGTE          *string  `json:"idGTE,omitempty"`
+	IDLT           *string  `json:"idLT,omitempty"`
+	IDLTE          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+
+	// "provenance_hash" field predicates.
+	ProvenanceHash             *string  `json:"provenanceHash,omitempty"`
+	ProvenanceHashNEQ          *string  `json:"provenanceHashNEQ,omitempty"`
+	ProvenanceHashIn           []string `json:"provenanceHashIn,omitempty"`
+	ProvenanceHashNotIn        []string `json:"provenanceHashNotIn,omitempty"`
+	ProvenanceHashGT           *string  `json:"provenanceHashGT,omitempty"`
+	ProvenanceHashGTE          *string  `json:"provenanceHashGTE,omitempty"`
+	ProvenanceHashLT           *string  `json:"provenanceHashLT,omitempty"`
+	ProvenanceHashLTE          *string  `json:"provenanceHashLTE,omitempty"`
+	ProvenanceHashContains     *string  `json:"provenanceHashContains,omitempty"`
+	ProvenanceHashHasPrefix    *string  `json:"provenanceHashHasPrefix,omitempty"`
+	ProvenanceHashHasSuffix    *string  `json:"provenanceHashHasSuffix,omitempty"`
+	ProvenanceHashIsNil        bool     `json:"provenanceHashIsNil,omitempty"`
+	ProvenanceHashNotNil       bool     `json:"provenanceHashNotNil,omitempty"`
+	ProvenanceHashEqualFold    *string  `json:"provenanceHashEqualFold,omitempty"`
+	ProvenanceHashContainsFold *string  `json:"provenanceHashContainsFold,omitempty"`
+
+	// "timestamp" field predicates.
+	Timestamp      *time.Time  `json:"timestamp,omitempty"`
+	TimestampNEQ   *time.Time  `json:"timestampNEQ,omitempty"`
+	TimestampIn    []time.Time `json:"timestampIn,omitempty"`
+	TimestampNotIn []time.Time `json:"timestampNotIn,omitempty"`
+	TimestampGT    *time.Time  `json:"timestampGT,omitempty"`
+	TimestampGTE   *time.Time  `json:"timestampGTE,omitempty"`
+	TimestampLT    *time.Time  `json:"timestampLT,omitempty"`
+	TimestampLTE   *time.Time  `json:"timestampLTE,omitempty"`
+
+	// "inference_id" field predicates.
+	InferenceID             *string  `json:"inferenceID,omitempty"`
+	InferenceIDNEQ          *string  `json:"inferenceIDNEQ,omitempty"`
+	InferenceIDIn           []string `json:"inferenceIDIn,omitempty"`
+	InferenceIDNotIn        []string `json:"inferenceIDNotIn,omitempty"`
+	InferenceIDGT           *string  `json:"inferenceIDGT,omitempty"`
+	InferenceIDGTE          *string  `json:"inferenceIDGTE,omitempty"`
+	InferenceIDLT           *string  `json:"inferenceIDLT,omitempty"`
+	InferenceIDLTE          *string  `json:"inferenceIDLTE,omitempty"`
+	InferenceIDContains     *string  `json:"inferenceIDContains,omitempty"`
+	InferenceIDHasPrefix    *string  `json:"inferenceIDHasPrefix,omitempty"`
+	InferenceIDHasSuffix    *string  `json:"inferenceIDHasSuffix,omitempty"`
+	InferenceIDEqualFold    *string  `json:"inferenceIDEqualFold,omitempty"`
+	InferenceIDContainsFold *string  `json:"inferenceIDContainsFold,omitempty"`
+
+	// "decision_type" field predicates.
+	DecisionType      *routingdecision.DecisionType  `json:"decisionType,omitempty"`
+	DecisionTypeNEQ   *routingdecision.DecisionType  `json:"decisionTypeNEQ,omitempty"`
+	DecisionTypeIn    []routingdecision.DecisionType `json:"decisionTypeIn,omitempty"`
+	DecisionTypeNotIn []routingdecision.DecisionType `json:"decisionTypeNotIn,omitempty"`
+
+	// "layer_index" field predicates.
+	LayerIndex      *int  `json:"layerIndex,omitempty"`
+	LayerIndexNEQ   *int  `json:"layerIndexNEQ,omitempty"`
+	LayerIndexIn    []int `json:"layerIndexIn,omitempty"`
+	LayerIndexNotIn []int `json:"layerIndexNotIn,omitempty"`
+	LayerIndexGT    *int  `json:"layerIndexGT,omitempty"`
+	LayerIndexGTE   *int  `json:"layerIndexGTE,omitempty"`
+	LayerIndexLT    *int  `json:"layerIndexLT,omitempty"`
+	LayerIndexLTE   *int  `json:"layerIndexLTE,omitempty"`
+
+	// "gate_probability" field predicates.
+	GateProbability      *float64  `json:"gateProbability,omitempty"`
+	GateProbabilityNEQ   *float64  `json:"gateProbabilityNEQ,omitempty"`
+	GateProbabilityIn    []float64 `json:"gateProbabilityIn,omitempty"`
+	GateProbabilityNotIn []float64 `json:"gateProbabilityNotIn,omitempty"`
+	GateProbabilityGT    *float64  `json:"gateProbabilityGT,omitempty"`
+	GateProbabilityGTE   *float64  `json:"gateProbabilityGTE,omitempty"`
+	GateProbabilityLT    *float64  `json:"gateProbabilityLT,omitempty"`
+	GateProbabilityLTE   *float64  `json:"gateProbabilityLTE,omitempty"`
+
+	// "selected_model" field predicates.
+	SelectedModel             *string  `json:"selectedModel,omitempty"`
+	SelectedModelNEQ          *string  `json:"selectedModelNEQ,omitempty"`
+	SelectedModelIn           []string `json:"selectedModelIn,omitempty"`
+	SelectedModelNotIn        []string `json:"selectedModelNotIn,omitempty"`
+	SelectedModelGT           *string  `json:"selectedModelGT,omitempty"`
+	SelectedModelGTE          *string  `json:"selectedModelGTE,omitempty"`
+	SelectedModelLT           *string  `json:"selectedModelLT,omitempty"`
+	SelectedModelLTE          *string  `json:"selectedModelLTE,omitempty"`
+	SelectedModelContains     *string  `json:"selectedModelContains,omitempty"`
+	SelectedModelHasPrefix    *string  `json:"selectedModelHasPrefix,omitempty"`
+	SelectedModelHasSuffix    *string  `json:"selectedModelHasSuffix,omitempty"`
+	SelectedModelIsNil        bool     `json:"selectedModelIsNil,omitempty"`
+	SelectedModelNotNil       bool     `json:"selectedModelNotNil,omitempty"`
+	SelectedModelEqualFold    *string  `json:"selectedModelEqualFold,omitempty"`
+	SelectedModelContainsFold *string  `json:"selectedModelContainsFold,omitempty"`
+
+	// "iteration_count" field predicates.
+	IterationCount      *int  `json:"iterationCount,omitempty"`
+	IterationCountNEQ   *int  `json:"iterationCountNEQ,omitempty"`
+	IterationCountIn    []int `json:"iterationCountIn,omitempty"`
+	IterationCountNotIn []int `json:"iterationCountNotIn,omitempty"`
+	IterationCountGT    *int  `json:"iterationCountGT,omitempty"`
+	IterationCountGTE   *int  `json:"iterationCountGTE,omitempty"`
+	IterationCountLT    *int  `json:"iterationCountLT,omitempty"`
+	IterationCountLTE   *int  `json:"iterationCountLTE,omitempty"`
+
+	// "confidence" field predicates.
+	Confidence      *float64  `json:"confidence,omitempty"`
+	ConfidenceNEQ   *float64  `json:"confidenceNEQ,omitempty"`
+	ConfidenceIn    []float64 `json:"confidenceIn,omitempty"`
+	ConfidenceNotIn []float64 `json:"confidenceNotIn,omitempty"`
+	ConfidenceGT    *float64  `json:"confidenceGT,omitempty"`
+	ConfidenceGTE   *float64  `json:"confidenceGTE,omitempty"`
+	ConfidenceLT    *float64  `json:"confidenceLT,omitempty"`
+	ConfidenceLTE   *float64  `json:"confidenceLTE,omitempty"`
+
+	// "domain" field predicates.
+	Domain             *string  `json:"domain,omitempty"`
+	DomainNEQ          *string  `json:"domainNEQ,omitempty"`
+	DomainIn           []string `json:"domainIn,omitempty"`
+	DomainNotIn        []string `json:"domainNotIn,omitempty"`
+	DomainGT           *string  `json:"domainGT,omitempty"`
+	DomainGTE          *string  `json:"domainGTE,omitempty"`
+	DomainLT           *string  `json:"domainLT,omitempty"`
+	DomainLTE          *string  `json:"domainLTE,omitempty"`
+	DomainContains     *string  `json:"domainContains,omitempty"`
+	DomainHasPrefix    *string  `json:"domainHasPrefix,omitempty"`
+	DomainHasSuffix    *string  `json:"domainHasSuffix,omitempty"`
+	DomainIsNil        bool     `json:"domainIsNil,omitempty"`
+	DomainNotNil       bool     `json:"domainNotNil,omitempty"`
+	DomainEqualFold    *string  `json:"domainEqualFold,omitempty"`
+	DomainContainsFold *string  `json:"domainContainsFold,omitempty"`
+
+	// "spike_events" edge predicates.
+	HasSpikeEvents     *bool                   `json:"hasSpikeEvents,omitempty"`
+	HasSpikeEventsWith []*SpikeEventWhereInput `json:"hasSpikeEventsWith,omitempty"`
+
+	// "actions" edge predicates.
+	HasActions     *bool                    `json:"hasActions,omitempty"`
+	HasActionsWith []*AgentActionWhereInput `json:"hasActionsWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *RoutingDecisionWhereInput) AddPredicates(predicates ...predicate.RoutingDecision) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the RoutingDecisionWhereInput filter on the RoutingDecisionQuery builder.
+func (i *RoutingDecisionWhereInput) Filter(q *RoutingDecisionQuery) (*RoutingDecisionQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyRoutingDecisionWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyRoutingDecisionWhereInput is returned in case the RoutingDecisionWhereInput is empty.
+var ErrEmptyRoutingDecisionWhereInput = errors.New("ent: empty predicate RoutingDecisionWhereInput")
+
+// P returns a predicate for filtering routingdecisions.
+// An error is returned if the input is empty or invalid.
+func (i *RoutingDecisionWhereInput) P() (predicate.RoutingDecision, error) {
+	var predicates []predicate.RoutingDecision
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, routingdecision.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.RoutingDecision, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, routingdecision.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.RoutingDecision, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, routingdecision.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, routingdecision.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, routingdecision.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, routingdecision.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, routingdecision.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, routingdecision.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, routingdecision.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, routingdecision.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, routingdecision.IDLTE(*i.IDLTE))
+	}
+	if i.IDEqualFold != nil {
+		predicates = append(predicates, routingdecision.IDEqualFold(*i.IDEqualFold))
+	}
+	if i.IDContainsFold != nil {
+		predicates = append(predicates, routingdecision.IDContainsFold(*i.IDContainsFold))
+	}
+	if i.ProvenanceHash != nil {
+		predicates = append(predicates, routingdecision.ProvenanceHashEQ(*i.ProvenanceHash))
+	}
+	if i.ProvenanceHashNEQ != nil {
+		predicates = append(predicates, routingdecision.ProvenanceHashNEQ(*i.ProvenanceHashNEQ))
+	}
+	if len(i.ProvenanceHashIn) > 0 {
+		predicates = append(predicates, routingdecision.ProvenanceHashIn(i.ProvenanceHashIn...))
+	}
+	if len(i.ProvenanceHashNotIn) > 0 {
+		predicates = append(predicates, routingdecision.ProvenanceHashNotIn(i.ProvenanceHashNotIn...))
+	}
+	if i.ProvenanceHashGT != nil {
+		predicates = append(predicates, routingdecision.ProvenanceHashGT(*i.ProvenanceHashGT))
+	}
+	if i.ProvenanceHashGTE != nil {
+		predicates = append(predicates, routingdecision.ProvenanceHashGTE(*i.ProvenanceHashGTE))
+	}
+	if i.ProvenanceHashLT != nil {
+		predicates = append(predicates, routingdecision.ProvenanceHashLT(*i.ProvenanceHashLT))
+	}
+	if i.ProvenanceHashLTE != nil {
+		predicates = append(predicates, routingdecision.ProvenanceHashLTE(*i.ProvenanceHashLTE))
+	}
+	if i.ProvenanceHashContains != nil {
+		predicates = append(predicates, routingdecision.ProvenanceHashContains(*i.ProvenanceHashContains))
+	}
+	if i.ProvenanceHashHasPrefix != nil {
+		predicates = append(predicates, routingdecision.ProvenanceHashHasPrefix(*i.ProvenanceHashHasPrefix))
+	}
+	if i.ProvenanceHashHasSuffix != nil {
+		predicates = append(predicates, routingdecision.ProvenanceHashHasSuffix(*i.ProvenanceHashHasSuffix))
+	}
+	if i.ProvenanceHashIsNil {
+		predicates = append(predicates, routingdecision.ProvenanceHashIsNil())
+	}
+	if i.ProvenanceHashNotNil {
+		predicates = append(predicates, routingdecision.ProvenanceHashNotNil())
+	}
+	if i.ProvenanceHashEqualFold != nil {
+		predicates = append(predicates, routingdecision.ProvenanceHashEqualFold(*i.ProvenanceHashEqualFold))
+	}
+	if i.ProvenanceHashContainsFold != nil {
+		predicates = append(predicates, routingdecision.ProvenanceHashContainsFold(*i.ProvenanceHashContainsFold))
+	}
+	if i.Timestamp != nil {
+		predicates = append(predicates, routingdecision.TimestampEQ(*i.Timestamp))
+	}
+	if i.TimestampNEQ != nil {
+		predicates = append(predicates, routingdecision.TimestampNEQ(*i.TimestampNEQ))
+	}
+	if len(i.TimestampIn) > 0 {
+		predicates = append(predicates, routingdecision.TimestampIn(i.TimestampIn...))
+	}
+	if len(i.TimestampNotIn) > 0 {
+		predicates = append(predicates, routingdecision.TimestampNotIn(i.TimestampNotIn...))
+	}
+	if i.TimestampGT != nil {
+		predicates = append(predicates, routingdecision.TimestampGT(*i.TimestampGT))
+	}
+	if i.TimestampGTE != nil {
+		predicates = append(predicates, routingdecision.TimestampGTE(*i.TimestampGTE))
+	}
+	if i.TimestampLT != nil {
+		predicates = append(predicates, routingdecision.TimestampLT(*i.TimestampLT))
+	}
+	if i.TimestampLTE != nil {
+		predicates = append(predicates, routingdecision.TimestampLTE(*i.TimestampLTE))
+	}
+	if i.InferenceID != nil {
+		predicates = append(predicates, routingdecision.InferenceIDEQ(*i.InferenceID))
+	}
+	if i.InferenceIDNEQ != nil {
+		predicates = append(predicates, routingdecision.InferenceIDNEQ(*i.InferenceIDNEQ))
+	}
+	if len(i.InferenceIDIn) > 0 {
+		predicates = append(predicates, routingdecision.InferenceIDIn(i.InferenceIDIn...))
+	}
+	if len(i.InferenceIDNotIn) > 0 {
+		predicates = append(predicates, routingdecision.InferenceIDNotIn(i.InferenceIDNotIn...))
+	}
+	if i.InferenceIDGT != nil {
+		predicates = append(predicates, routingdecision.InferenceIDGT(*i.InferenceIDGT))
+	}
+	if i.InferenceIDGTE != nil {
+		predicates = append(predicates, routingdecision.InferenceIDGTE(*i.InferenceIDGTE))
+	}
+	if i.InferenceIDLT != nil {
+		predicates = append(predicates, routingdecision.InferenceIDLT(*i.InferenceIDLT))
+	}
+	if i.InferenceIDLTE != nil {
+		predicates = append(predicates, routingdecision.InferenceIDLTE(*i.InferenceIDLTE))
+	}
+	if i.InferenceIDContains != nil {
+		predicates = append(predicates, routingdecision.InferenceIDContains(*i.InferenceIDContains))
+	}
+	if i.InferenceIDHasPrefix != nil {
+		predicates = append(predicates, routingdecision.InferenceIDHasPrefix(*i.InferenceIDHasPrefix))
+	}
+	if i.InferenceIDHasSuffix != nil {
+		predicates = append(predicates, routingdecision.InferenceIDHasSuffix(*i.InferenceIDHasSuffix))
+	}
+	if i.InferenceIDEqualFold != nil {
+		predicates = append(predicates, routingdecision.InferenceIDEqualFold(*i.InferenceIDEqualFold))
+	}
+	if i.InferenceIDContainsFold != nil {
+		predicates = append(predicates, routingdecision.InferenceIDContainsFold(*i.InferenceIDContainsFold))
+	}
+	if i.DecisionType != nil {
+		predicates = append(predicates, routingdecision.DecisionTypeEQ(*i.DecisionType))
+	}
+	if i.DecisionTypeNEQ != nil {
+		predicates = append(predicates, routingdecision.DecisionTypeNEQ(*i.DecisionTypeNEQ))
+	}
+	if len(i.DecisionTypeIn) > 0 {
+		predicates = append(predicates, routingdecision.DecisionTypeIn(i.DecisionTypeIn...))
+	}
+	if len(i.DecisionTypeNotIn) > 0 {
+		predicates = append(predicates, routingdecision.DecisionTypeNotIn(i.DecisionTypeNotIn...))
+	}
+	if i.LayerIndex != nil {
+		predicates = append(predicates, routingdecision.LayerIndexEQ(*i.LayerIndex))
+	}
+	if i.LayerIndexNEQ != nil {
+		predicates = append(predicates, routingdecision.LayerIndexNEQ(*i.LayerIndexNEQ))
+	}
+	if len(i.LayerIndexIn) > 0 {
+		predicates = append(predicates, routingdecision.LayerIndexIn(i.LayerIndexIn...))
+	}
+	if len(i.LayerIndexNotIn) > 0 {
+		predicates = append(predicates, routingdecision.LayerIndexNotIn(i.LayerIndexNotIn...))
+	}
+	if i.LayerIndexGT != nil {
+		predicates = append(predicates, routingdecision.LayerIndexGT(*i.LayerIndexGT))
+	}
+	if i.LayerIndexGTE != nil {
+		predicates = append(predicates, routingdecision.LayerIndexGTE(*i.LayerIndexGTE))
+	}
+	if i.LayerIndexLT != nil {
+		predicates = append(predicates, routingdecision.LayerIndexLT(*i.LayerIndexLT))
+	}
+	if i.LayerIndexLTE != nil {
+		predicates = append(predicates, routingdecision.LayerIndexLTE(*i.LayerIndexLTE))
+	}
+	if i.GateProbability != nil {
+		predicates = append(predicates, routingdecision.GateProbabilityEQ(*i.GateProbability))
+	}
+	if i.GateProbabilityNEQ != nil {
+		predicates = append(predicates, routingdecision.GateProbabilityNEQ(*i.GateProbabilityNEQ))
+	}
+	if len(i.GateProbabilityIn) > 0 {
+		predicates = append(predicates, routingdecision.GateProbabilityIn(i.GateProbabilityIn...))
+	}
+	if len(i.GateProbabilityNotIn) > 0 {
+		predicates = append(predicates, routingdecision.GateProbabilityNotIn(i.GateProbabilityNotIn...))
+	}
+	if i.GateProbabilityGT != nil {
+		predicates = append(predicates, routingdecision.GateProbabilityGT(*i.GateProbabilityGT))
+	}
+	if i.GateProbabilityGTE != nil {
+		predicates = append(predicates, routingdecision.GateProbabilityGTE(*i.GateProbabilityGTE))
+	}
+	if i.GateProbabilityLT != nil {
+		predicates = append(predicates, routingdecision.GateProbabilityLT(*i.GateProbabilityLT))
+	}
+	if i.GateProbabilityLTE != nil {
+		predicates = append(predicates, routingdecision.GateProbabilityLTE(*i.GateProbabilityLTE))
+	}
+	if i.SelectedModel != nil {
+		predicates = append(predicates, routingdecision.SelectedModelEQ(*i.SelectedModel))
+	}
+	if i.SelectedModelNEQ != nil {
+		predicates = append(predicates, routingdecision.SelectedModelNEQ(*i.SelectedModelNEQ))
+	}
+	if len(i.SelectedModelIn) > 0 {
+		predicates = append(predicates, routingdecision.SelectedModelIn(i.SelectedModelIn...))
+	}
+	if len(i.SelectedModelNotIn) > 0 {
+		predicates = append(predicates, routingdecision.SelectedModelNotIn(i.SelectedModelNotIn...))
+	}
+	if i.SelectedModelGT != nil {
+		predicates = append(predicates, routingdecision.SelectedModelGT(*i.SelectedModelGT))
+	}
+	if i.SelectedModelGTE != nil {
+		predicates = append(predicates, routingdecision.SelectedModelGTE(*i.SelectedModelGTE))
+	}
+	if i.SelectedModelLT != nil {
+		predicates = append(predicates, routingdecision.SelectedModelLT(*i.SelectedModelLT))
+	}
+	if i.SelectedModelLTE != nil {
+		predicates = append(predicates, routingdecision.SelectedModelLTE(*i.SelectedModelLTE))
+	}
+	if i.SelectedModelContains != nil {
+		predicates = append(predicates, routingdecision.SelectedModelContains(*i.SelectedModelContains))
+	}
+	if i.SelectedModelHasPrefix != nil {
+		predicates = append(predicates, routingdecision.SelectedModelHasPrefix(*i.SelectedModelHasPrefix))
+	}
+	if i.SelectedModelHasSuffix != nil {
+		predicates = append(predicates, routingdecision.SelectedModelHasSuffix(*i.SelectedModelHasSuffix))
+	}
+	if i.SelectedModelIsNil {
+		predicates = append(predicates, routingdecision.SelectedModelIsNil())
+	}
+	if i.SelectedModelNotNil {
+		predicates = append(predicates, routingdecision.SelectedModelNotNil())
+	}
+	if i.SelectedModelEqualFold != nil {
+		predicates = append(predicates, routingdecision.SelectedModelEqualFold(*i.SelectedModelEqualFold))
+	}
+	if i.SelectedModelContainsFold != nil {
+		predicates = append(predicates, routingdecision.SelectedModelContainsFold(*i.SelectedModelContainsFold))
+	}
+	if i.IterationCount != nil {
+		predicates = append(predicates, routingdecision.IterationCountEQ(*i.IterationCount))
+	}
+	if i.IterationCountNEQ != nil {
+		predicates = append(predicates, routingdecision.IterationCountNEQ(*i.IterationCountNEQ))
+	}
+	if len(i.IterationCountIn) > 0 {
+		predicates = append(predicates, routingdecision.IterationCountIn(i.IterationCountIn...))
+	}
+	if len(i.IterationCountNotIn) > 0 {
+		predicates = append(predicates, routingdecision.IterationCountNotIn(i.IterationCountNotIn...))
+	}
+	if i.IterationCountGT != nil {
+		predicates = append(predicates, routingdecision.IterationCountGT(*i.IterationCountGT))
+	}
+	if i.IterationCountGTE != nil {
+		predicates = append(predicates, routingdecision.IterationCountGTE(*i.IterationCountGTE))
+	}
+	if i.IterationCountLT != nil {
+		predicates = append(predicates, routingdecision.IterationCountLT(*i.IterationCountLT))
+	}
+	if i.IterationCountLTE != nil {
+		predicates = append(predicates, routingdecision.IterationCountLTE(*i.IterationCountLTE))
+	}
+	if i.Confidence != nil {
+		predicates = append(predicates, routingdecision.ConfidenceEQ(*i.Confidence))
+	}
+	if i.ConfidenceNEQ != nil {
+		predicates = append(predicates, routingdecision.ConfidenceNEQ(*i.ConfidenceNEQ))
+	}
+	if len(i.ConfidenceIn) > 0 {
+		predicates = append(predicates, routingdecision.ConfidenceIn(i.ConfidenceIn...))
+	}
+	if len(i.ConfidenceNotIn) > 0 {
+		predicates = append(predicates, routingdecision.ConfidenceNotIn(i.ConfidenceNotIn...))
+	}
+	if i.ConfidenceGT != nil {
+		predicates = append(predicates, routingdecision.ConfidenceGT(*i.ConfidenceGT))
+	}
+	if i.ConfidenceGTE != nil {
+		predicates = append(predicates, routingdecision.ConfidenceGTE(*i.ConfidenceGTE))
+	}
+	if i.ConfidenceLT != nil {
+		predicates = append(predicates, routingdecision.ConfidenceLT(*i.ConfidenceLT))
+	}
+	if i.ConfidenceLTE != nil {
+		predicates = append(predicates, routingdecision.ConfidenceLTE(*i.ConfidenceLTE))
+	}
+	if i.Domain != nil {
+		predicates = append(predicates, routingdecision.DomainEQ(*i.Domain))
+	}
+	if i.DomainNEQ != nil {
+		predicates = append(predicates, routingdecision.DomainNEQ(*i.DomainNEQ))
+	}
+	if len(i.DomainIn) > 0 {
+		predicates = append(predicates, routingdecision.DomainIn(i.DomainIn...))
+	}
+	if len(i.DomainNotIn) > 0 {
+		predicates = append(predicates, routingdecision.DomainNotIn(i.DomainNotIn...))
+	}
+	if i.DomainGT != nil {
+		predicates = append(predicates, routingdecision.DomainGT(*i.DomainGT))
+	}
+	if i.DomainGTE != nil {
+		predicates = append(predicates, routingdecision.DomainGTE(*i.DomainGTE))
+	}
+	if i.DomainLT != nil {
+		predicates = append(predicates, routingdecision.DomainLT(*i.DomainLT))
+	}
+	if i.DomainLTE != nil {
+		predicates = append(predicates, routingdecision.DomainLTE(*i.DomainLTE))
+	}
+	if i.DomainContains != nil {
+		predicates = append(predicates, routingdecision.DomainContains(*i.DomainContains))
+	}
+	if i.DomainHasPrefix != nil {
+		predicates = append(predicates, routingdecision.DomainHasPrefix(*i.DomainHasPrefix))
+	}
+	if i.DomainHasSuffix != nil {
+		predicates = append(predicates, routingdecision.DomainHasSuffix(*i.DomainHasSuffix))
+	}
+	if i.DomainIsNil {
+		predicates = append(predicates, routingdecision.DomainIsNil())
+	}
+	if i.DomainNotNil {
+		predicates = append(predicates, routingdecision.DomainNotNil())
+	}
+	if i.DomainEqualFold != nil {
+		predicates = append(predicates, routingdecision.DomainEqualFold(*i.DomainEqualFold))
+	}
+	if i.DomainContainsFold != nil {
+		predicates = append(predicates, routingdecision.DomainContainsFold(*i.DomainContainsFold))
+	}
+
+	if i.HasSpikeEvents != nil {
+		p := routingdecision.HasSpikeEvents()
+		if !*i.HasSpikeEvents {
+			p = routingdecision.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasSpikeEventsWith) > 0 {
+		with := make([]predicate.SpikeEvent, 0, len(i.HasSpikeEventsWith))
+		for _, w := range i.HasSpikeEventsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasSpikeEventsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, routingdecision.HasSpikeEventsWith(with...))
+	}
+	if i.HasActions != nil {
+		p := routingdecision.HasActions()
+		if !*i.HasActions {
+			p = routingdecision.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasActionsWith) > 0 {
+		with := make([]predicate.AgentAction, 0, len(i.HasActionsWith))
+		for _, w := range i.HasActionsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasActionsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, routingdecision.HasActionsWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyRoutingDecisionWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return routingdecision.And(predicates...), nil
+	}
+}
+
+// SpikeEventWhereInput represents a where input for filtering SpikeEvent queries.
+type SpikeEventWhereInput struct {
+	Predicates []predicate.SpikeEvent  `json:"-"`
+	Not        *SpikeEventWhereInput   `json:"not,omitempty"`
+	Or         []*SpikeEventWhereInput `json:"or,omitempty"`
+	And        []*SpikeEventWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID             *string  `json:"id,omitempty"`
+	IDNEQ          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGT           *string  `json:"idGT,omitempty"`
+	IDGTE          *string  `json:"idGTE,omitempty"`
+	IDLT           *string  `json:"idLT,omitempty"`
+	IDLTE          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+
+	// "provenance_hash" field predicates.
+	ProvenanceHash             *string  `json:"provenanceHash,omitempty"`
+	ProvenanceHashNEQ          *string  `json:"provenanceHashNEQ,omitempty"`
+	ProvenanceHashIn           []string `json:"provenanceHashIn,omitempty"`
+	ProvenanceHashNotIn        []string `json:"provenanceHashNotIn,omitempty"`
+	ProvenanceHashGT           *string  `json:"provenanceHashGT,omitempty"`
+	ProvenanceHashGTE          *string  `json:"provenanceHashGTE,omitempty"`
+	ProvenanceHashLT           *string  `json:"provenanceHashLT,omitempty"`
+	ProvenanceHashLTE          *string  `json:"provenanceHashLTE,omitempty"`
+	ProvenanceHashContains     *string  `json:"provenanceHashContains,omitempty"`
+	ProvenanceHashHasPrefix    *string  `json:"provenanceHashHasPrefix,omitempty"`
+	ProvenanceHashHasSuffix    *string  `json:"provenanceHashHasSuffix,omitempty"`
+	ProvenanceHashIsNil        bool     `json:"provenanceHashIsNil,omitempty"`
+	ProvenanceHashNotNil       bool     `json:"provenanceHashNotNil,omitempty"`
+	ProvenanceHashEqualFold    *string  `json:"provenanceHashEqualFold,omitempty"`
+	ProvenanceHashContainsFold *string  `json:"provenanceHashContainsFold,omitempty"`
+
+	// "timestamp" field predicates.
+	Timestamp      *time.Time  `json:"timestamp,omitempty"`
+	TimestampNEQ   *time.Time  `json:"timestampNEQ,omitempty"`
+	TimestampIn    []time.Time `json:"timestampIn,omitempty"`
+	TimestampNotIn []time.Time `json:"timestampNotIn,omitempty"`
+	TimestampGT    *time.Time  `json:"timestampGT,omitempty"`
+	TimestampGTE   *time.Time  `json:"timestampGTE,omitempty"`
+	TimestampLT    *time.Time  `json:"timestampLT,omitempty"`
+	TimestampLTE   *time.Time  `json:"timestampLTE,omitempty"`
+
+	// "timestamp_ns" field predicates.
+	TimestampNs       *int64  `json:"timestampNs,omitempty"`
+	TimestampNsNEQ    *int64  `json:"timestampNsNEQ,omitempty"`
+	TimestampNsIn     []int64 `json:"timestampNsIn,omitempty"`
+	TimestampNsNotIn  []int64 `json:"timestampNsNotIn,omitempty"`
+	TimestampNsGT     *int64  `json:"timestampNsGT,omitempty"`
+	TimestampNsGTE    *int64  `json:"timestampNsGTE,omitempty"`
+	TimestampNsLT     *int64  `json:"timestampNsLT,omitempty"`
+	TimestampNsLTE    *int64  `json:"timestampNsLTE,omitempty"`
+	TimestampNsIsNil  bool    `json:"timestampNsIsNil,omitempty"`
+	TimestampNsNotNil bool    `json:"timestampNsNotNil,omitempty"`
+
+	// "population_id" field predicates.
+	PopulationID             *string  `json:"populationID,omitempty"`
+	PopulationIDNEQ          *string  `json:"populationIDNEQ,omitempty"`
+	PopulationIDIn           []string `json:"populationIDIn,omitempty"`
+	PopulationIDNotIn        []string `json:"populationIDNotIn,omitempty"`
+	PopulationIDGT           *string  `json:"populationIDGT,omitempty"`
+	PopulationIDGTE          *string  `json:"populationIDGTE,omitempty"`
+	PopulationIDLT           *string  `json:"populationIDLT,omitempty"`
+	PopulationIDLTE          *string  `json:"populationIDLTE,omitempty"`
+	PopulationIDContains     *string  `json:"populationIDContains,omitempty"`
+	PopulationIDHasPrefix    *string  `json:"populationIDHasPrefix,omitempty"`
+	PopulationIDHasSuffix    *string  `json:"populationIDHasSuffix,omitempty"`
+	PopulationIDEqualFold    *string  `json:"populationIDEqualFold,omitempty"`
+	PopulationIDContainsFold *string  `json:"populationIDContainsFold,omitempty"`
+
+	// "layer_index" field predicates.
+	LayerIndex      *int  `json:"layerIndex,omitempty"`
+	LayerIndexNEQ   *int  `json:"layerIndexNEQ,omitempty"`
+	LayerIndexIn    []int `json:"layerIndexIn,omitempty"`
+	LayerIndexNotIn []int `json:"layerIndexNotIn,omitempty"`
+	LayerIndexGT    *int  `json:"layerIndexGT,omitempty"`
+	LayerIndexGTE   *int  `json:"layerIndexGTE,omitempty"`
+	LayerIndexLT    *int  `json:"layerIndexLT,omitempty"`
+	LayerIndexLTE   *int  `json:"layerIndexLTE,omitempty"`
+
+	// "pattern_hash" field predicates.
+	PatternHash             *string  `json:"patternHash,omitempty"`
+	PatternHashNEQ          *string  `json:"patternHashNEQ,omitempty"`
+	PatternHashIn           []string `json:"patternHashIn,omitempty"`
+	PatternHashNotIn        []string `json:"patternHashNotIn,omitempty"`
+	PatternHashGT           *string  `json:"patternHashGT,omitempty"`
+	PatternHashGTE          *string  `json:"patternHashGTE,omitempty"`
+	PatternHashLT           *string  `json:"patternHashLT,omitempty"`
+	PatternHashLTE          *string  `json:"patternHashLTE,omitempty"`
+	PatternHashContains     *string  `json:"patternHashContains,omitempty"`
+	PatternHashHasPrefix    *string  `json:"patternHashHasPrefix,omitempty"`
+	PatternHashHasSuffix    *string  `json:"patternHashHasSuffix,omitempty"`
+	PatternHashEqualFold    *string  `json:"patternHashEqualFold,omitempty"`
+	PatternHashContainsFold *string  `json:"patternHashContainsFold,omitempty"`
+
+	// "inference_id" field predicates.
+	InferenceID             *string  `json:"inferenceID,omitempty"`
+	InferenceIDNEQ          *string  `json:"inferenceIDNEQ,omitempty"`
+	InferenceIDIn           []string `json:"inferenceIDIn,omitempty"`
+	InferenceIDNotIn        []string `json:"inferenceIDNotIn,omitempty"`
+	InferenceIDGT           *string  `json:"inferenceIDGT,omitempty"`
+	InferenceIDGTE          *string  `json:"inferenceIDGTE,omitempty"`
+	InferenceIDLT           *string  `json:"inferenceIDLT,omitempty"`
+	InferenceIDLTE          *string  `json:"inferenceIDLTE,omitempty"`
+	InferenceIDContains     *string  `json:"inferenceIDContains,omitempty"`
+	InferenceIDHasPrefix    *string  `json:"inferenceIDHasPrefix,omitempty"`
+	InferenceIDHasSuffix    *string  `json:"inferenceIDHasSuffix,omitempty"`
+	InferenceIDIsNil        bool     `json:"inferenceIDIsNil,omitempty"`
+	InferenceIDNotNil       bool     `json:"inferenceIDNotNil,omitempty"`
+	InferenceIDEqualFold    *string  `json:"inferenceIDEqualFold,omitempty"`
+	InferenceIDContainsFold *string  `json:"inferenceIDContainsFold,omitempty"`
+
+	// "is_emergent" field predicates.
+	IsEmergent    *bool `json:"isEmergent,omitempty"`
+	IsEmergentNEQ *bool `json:"isEmergentNEQ,omitempty"`
+
+	// "entropy" field predicates.
+	Entropy      *float64  `json:"entropy,omitempty"`
+	EntropyNEQ   *float64  `json:"entropyNEQ,omitempty"`
+	EntropyIn    []float64 `json:"entropyIn,omitempty"`
+	EntropyNotIn []float64 `json:"entropyNotIn,omitempty"`
+	EntropyGT    *float64  `json:"entropyGT,omitempty"`
+	EntropyGTE   *float64  `json:"entropyGTE,omitempty"`
+	EntropyLT    *float64  `json:"entropyLT,omitempty"`
+	EntropyLTE   *float64  `json:"entropyLTE,omitempty"`
+
+	// "decisions" edge predicates.
+	HasDecisions     *bool                        `json:"hasDecisions,omitempty"`
+	HasDecisionsWith []*RoutingDecisionWhereInput `json:"hasDecisionsWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *SpikeEventWhereInput) AddPredicates(predicates ...predicate.SpikeEvent) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the SpikeEventWhereInput filter on the SpikeEventQuery builder.
+func (i *SpikeEventWhereInput) Filter(q *SpikeEventQuery) (*SpikeEventQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptySpikeEventWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptySpikeEventWhereInput is returned in case the SpikeEventWhereInput is empty.
+var ErrEmptySpikeEventWhereInput = errors.New("ent: empty predicate SpikeEventWhereInput")
+
+// P returns a predicate for filtering spikeevents.
+// An error is returned if the input is empty or invalid.
+func (i *SpikeEventWhereInput) P() (predicate.SpikeEvent, error) {
+	var predicates []predicate.SpikeEvent
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, spikeevent.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.SpikeEvent, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, spikeevent.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.SpikeEvent, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, spikeevent.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, spikeevent.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, spikeevent.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, spikeevent.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, spikeevent.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, spikeevent.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, spikeevent.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, spikeevent.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, spikeevent.IDLTE(*i.IDLTE))
+	}
+	if i.IDEqualFold != nil {
+		predicates = append(predicates, spikeevent.IDEqualFold(*i.IDEqualFold))
+	}
+	if i.IDContainsFold != nil {
+		predicates = append(predicates, spikeevent.IDContainsFold(*i.IDContainsFold))
+	}
+	if i.ProvenanceHash != nil {
+		predicates = append(predicates, spikeevent.ProvenanceHashEQ(*i.ProvenanceHash))
+	}
+	if i.ProvenanceHashNEQ != nil {
+		predicates = append(predicates, spikeevent.ProvenanceHashNEQ(*i.ProvenanceHashNEQ))
+	}
+	if len(i.ProvenanceHashIn) > 0 {
+		predicates = append(predicates, spikeevent.ProvenanceHashIn(i.ProvenanceHashIn...))
+	}
+	if len(i.ProvenanceHashNotIn) > 0 {
+		predicates = append(predicates, spikeevent.ProvenanceHashNotIn(i.ProvenanceHashNotIn...))
+	}
+	if i.ProvenanceHashGT != nil {
+		predicates = append(predicates, spikeevent.ProvenanceHashGT(*i.ProvenanceHashGT))
+	}
+	if i.ProvenanceHashGTE != nil {
+		predicates = append(predicates, spikeevent.ProvenanceHashGTE(*i.ProvenanceHashGTE))
+	}
+	if i.ProvenanceHashLT != nil {
+		predicates = append(predicates, spikeevent.ProvenanceHashLT(*i.ProvenanceHashLT))
+	}
+	if i.ProvenanceHashLTE != nil {
+		predicates = append(predicates, spikeevent.ProvenanceHashLTE(*i.ProvenanceHashLTE))
+	}
+	if i.ProvenanceHashContains != nil {
+		predicates = append(predicates, spikeevent.ProvenanceHashContains(*i.ProvenanceHashContains))
+	}
+	if i.ProvenanceHashHasPrefix != nil {
+		predicates = append(predicates, spikeevent.ProvenanceHashHasPrefix(*i.ProvenanceHashHasPrefix))
+	}
+	if i.ProvenanceHashHasSuffix != nil {
+		predicates = append(predicates, spikeevent.ProvenanceHashHasSuffix(*i.ProvenanceHashHasSuffix))
+	}
+	if i.ProvenanceHashIsNil {
+		predicates = append(predicates, spikeevent.ProvenanceHashIsNil())
+	}
+	if i.ProvenanceHashNotNil {
+		predicates = append(predicates, spikeevent.ProvenanceHashNotNil())
+	}
+	if i.ProvenanceHashEqualFold != nil {
+		predicates = append(predicates, spikeevent.ProvenanceHashEqualFold(*i.ProvenanceHashEqualFold))
+	}
+	if i.ProvenanceHashContainsFold != nil {
+		predicates = append(predicates, spikeevent.ProvenanceHashContainsFold(*i.ProvenanceHashContainsFold))
+	}
+	if i.Timestamp != nil {
+		predicates = append(predicates, spikeevent.TimestampEQ(*i.Timestamp))
+	}
+	if i.TimestampNEQ != nil {
+		predicates = append(predicates, spikeevent.TimestampNEQ(*i.TimestampNEQ))
+	}
+	if len(i.TimestampIn) > 0 {
+		predicates = append(predicates, spikeevent.TimestampIn(i.TimestampIn...))
+	}
+	if len(i.TimestampNotIn) > 0 {
+		predicates = append(predicates, spikeevent.TimestampNotIn(i.TimestampNotIn...))
+	}
+	if i.TimestampGT != nil {
+		predicates = append(predicates, spikeevent.TimestampGT(*i.TimestampGT))
+	}
+	if i.TimestampGTE != nil {
+		predicates = append(predicates, spikeevent.TimestampGTE(*i.TimestampGTE))
+	}
+	if i.TimestampLT != nil {
+		predicates = append(predicates, spikeevent.TimestampLT(*i.TimestampLT))
+	}
+	if i.TimestampLTE != nil {
+		predicates = append(predicates, spikeevent.TimestampLTE(*i.TimestampLTE))
+	}
+	if i.TimestampNs != nil {
+		predicates = append(predicates, spikeevent.TimestampNsEQ(*i.TimestampNs))
+	}
+	if i.TimestampNsNEQ != nil {
+		predicates = append(predicates, spikeevent.TimestampNsNEQ(*i.TimestampNsNEQ))
+	}
+	if len(i.TimestampNsIn) > 0 {
+		predicates = append(predicates, spikeevent.TimestampNsIn(i.TimestampNsIn...))
+	}
+	if len(i.TimestampNsNotIn) > 0 {
+		predicates = append(predicates, spikeevent.TimestampNsNotIn(i.TimestampNsNotIn...))
+	}
+	if i.TimestampNsGT != nil {
+		predicates = append(predicates, spikeevent.TimestampNsGT(*i.TimestampNsGT))
+	}
+	if i.TimestampNsGTE != nil {
+		predicates = append(predicates, spikeevent.TimestampNsGTE(*i.TimestampNsGTE))
+	}
+	if i.TimestampNsLT != nil {
+		predicates = append(predicates, spikeevent.TimestampNsLT(*i.TimestampNsLT))
+	}
+	if i.TimestampNsLTE != nil {
+		predicates = append(predicates, spikeevent.TimestampNsLTE(*i.TimestampNsLTE))
+	}
+	if i.TimestampNsIsNil {
+		predicates = append(predicates, spikeevent.TimestampNsIsNil())
+	}
+	if i.TimestampNsNotNil {
+		predicates = append(predicates, spikeevent.TimestampNsNotNil())
+	}
+	if i.PopulationID != nil {
+		predicates = append(predicates, spikeevent.PopulationIDEQ(*i.PopulationID))
+	}
+	if i.PopulationIDNEQ != nil {
+		predicates = append(predicates, spikeevent.PopulationIDNEQ(*i.PopulationIDNEQ))
+	}
+	if len(i.PopulationIDIn) > 0 {
+		predicates = append(predicates, spikeevent.PopulationIDIn(i.PopulationIDIn...))
+	}
+	if len(i.PopulationIDNotIn) > 0 {
+		predicates = append(predicates, spikeevent.PopulationIDNotIn(i.PopulationIDNotIn...))
+	}
+	if i.PopulationIDGT != nil {
+		predicates = append(predicates, spikeevent.PopulationIDGT(*i.PopulationIDGT))
+	}
+	if i.PopulationIDGTE != nil {
+		predicates = append(predicates, spikeevent.PopulationIDGTE(*i.PopulationIDGTE))
+	}
+	if i.PopulationIDLT != nil {
+		predicates = append(predicates, spikeevent.PopulationIDLT(*i.PopulationIDLT))
+	}
+	if i.PopulationIDLTE != nil {
+		predicates = append(predicates, spikeevent.PopulationIDLTE(*i.PopulationIDLTE))
+	}
+	if i.PopulationIDContains != nil {
+		predicates = append(predicates, spikeevent.PopulationIDContains(*i.PopulationIDContains))
+	}
+	if i.PopulationIDHasPrefix != nil {
+		predicates = append(predicates, spikeevent.PopulationIDHasPrefix(*i.PopulationIDHasPrefix))
+	}
+	if i.PopulationIDHasSuffix != nil {
+		predicates = append(predicates, spikeevent.PopulationIDHasSuffix(*i.PopulationIDHasSuffix))
+	}
+	if i.PopulationIDEqualFold != nil {
+		predicates = append(predicates, spikeevent.PopulationIDEqualFold(*i.PopulationIDEqualFold))
+	}
+	if i.PopulationIDContainsFold != nil {
+		predicates = append(predicates, spikeevent.PopulationIDContainsFold(*i.PopulationIDContainsFold))
+	}
+	if i.LayerIndex != nil {
+		predicates = append(predicates, spikeevent.LayerIndexEQ(*i.LayerIndex))
+	}
+	if i.LayerIndexNEQ != nil {
+		predicates = append(predicates, spikeevent.LayerIndexNEQ(*i.LayerIndexNEQ))
+	}
+	if len(i.LayerIndexIn) > 0 {
+		predicates = append(predicates, spikeevent.LayerIndexIn(i.LayerIndexIn...))
+	}
+	if len(i.LayerIndexNotIn) > 0 {
+		predicates = append(predicates, spikeevent.LayerIndexNotIn(i.LayerIndexNotIn...))
+	}
+	if i.LayerIndexGT != nil {
+		predicates = append(predicates, spikeevent.LayerIndexGT(*i.LayerIndexGT))
+	}
+	if i.LayerIndexGTE != nil {
+		predicates = append(predicates, spikeevent.LayerIndexGTE(*i.LayerIndexGTE))
+	}
+	if i.LayerIndexLT != nil {
+		predicates = append(predicates, spikeevent.LayerIndexLT(*i.LayerIndexLT))
+	}
+	if i.LayerIndexLTE != nil {
+		predicates = append(predicates, spikeevent.LayerIndexLTE(*i.LayerIndexLTE))
+	}
+	if i.PatternHash != nil {
+		predicates = append(predicates, spikeevent.PatternHashEQ(*i.PatternHash))
+	}
+	if i.PatternHashNEQ != nil {
+		predicates = append(predicates, spikeevent.PatternHashNEQ(*i.PatternHashNEQ))
+	}
+	if len(i.PatternHashIn) > 0 {
+		predicates = append(predicates, spikeevent.PatternHashIn(i.PatternHashIn...))
+	}
+	if len(i.PatternHashNotIn) > 0 {
+		predicates = append(predicates, spikeevent.PatternHashNotIn(i.PatternHashNotIn...))
+	}
+	if i.PatternHashGT != nil {
+		predicates = append(predicates, spikeevent.PatternHashGT(*i.PatternHashGT))
+	}
+	if i.PatternHashGTE != nil {
+		predicates = append(predicates, spikeevent.PatternHashGTE(*i.PatternHashGTE))
+	}
+	if i.PatternHashLT != nil {
+		predicates = append(predicates, spikeevent.PatternHashLT(*i.PatternHashLT))
+	}
+	if i.PatternHashLTE != nil {
+		predicates = append(predicates, spikeevent.PatternHashLTE(*i.PatternHashLTE))
+	}
+	if i.PatternHashContains != nil {
+		predicates = append(predicates, spikeevent.PatternHashContains(*i.PatternHashContains))
+	}
+	if i.PatternHashHasPrefix != nil {
+		predicates = append(predicates, spikeevent.PatternHashHasPrefix(*i.PatternHashHasPrefix))
+	}
+	if i.PatternHashHasSuffix != nil {
+		predicates = append(predicates, spikeevent.PatternHashHasSuffix(*i.PatternHashHasSuffix))
+	}
+	if i.PatternHashEqualFold != nil {
+		predicates = append(predicates, spikeevent.PatternHashEqualFold(*i.PatternHashEqualFold))
+	}
+	if i.PatternHashContainsFold != nil {
+		predicates = append(predicates, spikeevent.PatternHashContainsFold(*i.PatternHashContainsFold))
+	}
+	if i.InferenceID != nil {
+		predicates = append(predicates, spikeevent.InferenceIDEQ(*i.InferenceID))
+	}
+	if i.InferenceIDNEQ != nil {
+		predicates = append(predicates, spikeevent.InferenceIDNEQ(*i.InferenceIDNEQ))
+	}
+	if len(i.InferenceIDIn) > 0 {
+		predicates = append(predicates, spikeevent.InferenceIDIn(i.InferenceIDIn...))
+	}
+	if len(i.InferenceIDNotIn) > 0 {
+		predicates = append(predicates, spikeevent.InferenceIDNotIn(i.InferenceIDNotIn...))
+	}
+	if i.InferenceIDGT != nil {
+		predicates = append(predicates, spikeevent.InferenceIDGT(*i.InferenceIDGT))
+	}
+	if i.InferenceIDGTE != nil {
+		predicates = append(predicates, spikeevent.InferenceIDGTE(*i.InferenceIDGTE))
+	}
+	if i.InferenceIDLT != nil {
+		predicates = append(predicates, spikeevent.InferenceIDLT(*i.InferenceIDLT))
+	}
+	if i.InferenceIDLTE != nil {
+		predicates = append(predicates, spikeevent.InferenceIDLTE(*i.InferenceIDLTE))
+	}
+	if i.InferenceIDContains != nil {
+		predicates = append(predicates, spikeevent.InferenceIDContains(*i.InferenceIDContains))
+	}
+	if i.InferenceIDHasPrefix != nil {
+		predicates = append(predicates, spikeevent.InferenceIDHasPrefix(*i.InferenceIDHasPrefix))
+	}
+	if i.InferenceIDHasSuffix != nil {
+		predicates = append(predicates, spikeevent.InferenceIDHasSuffix(*i.InferenceIDHasSuffix))
+	}
+	if i.InferenceIDIsNil {
+		predicates = append(predicates, spikeevent.InferenceIDIsNil())
+	}
+	if i.InferenceIDNotNil {
+		predicates = append(predicates, spikeevent.InferenceIDNotNil())
+	}
+	if i.InferenceIDEqualFold != nil {
+		predicates = append(predicates, spikeevent.InferenceIDEqualFold(*i.InferenceIDEqualFold))
+	}
+	if i.InferenceIDContainsFold != nil {
+		predicates = append(predicates, spikeevent.InferenceIDContainsFold(*i.InferenceIDContainsFold))
+	}
+	if i.IsEmergent != nil {
+		predicates = append(predicates, spikeevent.IsEmergentEQ(*i.IsEmergent))
+	}
+	if i.IsEmergentNEQ != nil {
+		predicates = append(predicates, spikeevent.IsEmergentNEQ(*i.IsEmergentNEQ))
+	}
+	if i.Entropy != nil {
+		predicates = append(predicates, spikeevent.EntropyEQ(*i.Entropy))
+	}
+	if i.EntropyNEQ != nil {
+		predicates = append(predicates, spikeevent.EntropyNEQ(*i.EntropyNEQ))
+	}
+	if len(i.EntropyIn) > 0 {
+		predicates = append(predicates, spikeevent.EntropyIn(i.EntropyIn...))
+	}
+	if len(i.EntropyNotIn) > 0 {
+		predicates = append(predicates, spikeevent.EntropyNotIn(i.EntropyNotIn...))
+	}
+	if i.EntropyGT != nil {
+		predicates = append(predicates, spikeevent.EntropyGT(*i.EntropyGT))
+	}
+	if i.EntropyGTE != nil {
+		predicates = append(predicates, spikeevent.EntropyGTE(*i.EntropyGTE))
+	}
+	if i.EntropyLT != nil {
+		predicates = append(predicates, spikeevent.EntropyLT(*i.EntropyLT))
+	}
+	if i.EntropyLTE != nil {
+		predicates = append(predicates, spikeevent.EntropyLTE(*i.EntropyLTE))
+	}
+
+	if i.HasDecisions != nil {
+		p := spikeevent.HasDecisions()
+		if !*i.HasDecisions {
+			p = spikeevent.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasDecisionsWith) > 0 {
+		with := make([]predicate.RoutingDecision, 0, len(i.HasDecisionsWith))
+		for _, w := range i.HasDecisionsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasDecisionsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, spikeevent.HasDecisionsWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptySpikeEventWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return spikeevent.And(predicates...), nil
+	}
+}
+
+// WorkflowExecutionWhereInput represents a where input for filtering WorkflowExecution queries.
+type WorkflowExecutionWhereInput struct {
+	Predicates []predicate.WorkflowExecution  `json:"-"`
+	Not        *WorkflowExecutionWhereInput   `json:"not,omitempty"`
+	Or         []*WorkflowExecutionWhereInput `json:"or,omitempty"`
+	And        []*WorkflowExecutionWhereInput `json:"and,omitempty"`
+
+	// "id" field predicates.
+	ID             *string  `json:"id,omitempty"`
+	IDNEQ          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGT           *string  `json:"idGT,omitempty"`
+	IDGTE          *string  `json:"idGTE,omitempty"`
+	IDLT           *string  `json:"idLT,omitempty"`
+	IDLTE          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+
+	// "provenance_hash" field predicates.
+	ProvenanceHash             *string  `json:"provenanceHash,omitempty"`
+	ProvenanceHashNEQ          *string  `json:"provenanceHashNEQ,omitempty"`
+	ProvenanceHashIn           []string `json:"provenanceHashIn,omitempty"`
+	ProvenanceHashNotIn        []string `json:"provenanceHashNotIn,omitempty"`
+	ProvenanceHashGT           *string  `json:"provenanceHashGT,omitempty"`
+	ProvenanceHashGTE          *string  `json:"provenanceHashGTE,omitempty"`
+	ProvenanceHashLT           *string  `json:"provenanceHashLT,omitempty"`
+	ProvenanceHashLTE          *string  `json:"provenanceHashLTE,omitempty"`
+	ProvenanceHashContains     *string  `json:"provenanceHashContains,omitempty"`
+	ProvenanceHashHasPrefix    *string  `json:"provenanceHashHasPrefix,omitempty"`
+	ProvenanceHashHasSuffix    *string  `json:"provenanceHashHasSuffix,omitempty"`
+	ProvenanceHashIsNil        bool     `json:"provenanceHashIsNil,omitempty"`
+	ProvenanceHashNotNil       bool     `json:"provenanceHashNotNil,omitempty"`
+	ProvenanceHashEqualFold    *string  `json:"provenanceHashEqualFold,omitempty"`
+	ProvenanceHashContainsFold *string  `json:"provenanceHashContainsFold,omitempty"`
+
+	// "started_at" field predicates.
+	StartedAt      *time.Time  `json:"startedAt,omitempty"`
+	StartedAtNEQ   *time.Time  `json:"startedAtNEQ,omitempty"`
+	StartedAtIn    []time.Time `json:"startedAtIn,omitempty"`
+	StartedAtNotIn []time.Time `json:"startedAtNotIn,omitempty"`
+	StartedAtGT    *time.Time  `json:"startedAtGT,omitempty"`
+	StartedAtGTE   *time.Time  `json:"startedAtGTE,omitempty"`
+	StartedAtLT    *time.Time  `json:"startedAtLT,omitempty"`
+	StartedAtLTE   *time.Time  `json:"startedAtLTE,omitempty"`
+
+	// "completed_at" field predicates.
+	CompletedAt       *time.Time  `json:"completedAt,omitempty"`
+	CompletedAtNEQ    *time.Time  `json:"completedAtNEQ,omitempty"`
+	CompletedAtIn     []time.Time `json:"completedAtIn,omitempty"`
+	CompletedAtNotIn  []time.Time `json:"completedAtNotIn,omitempty"`
+	CompletedAtGT     *time.Time  `json:"completedAtGT,omitempty"`
+	CompletedAtGTE    *time.Time  `json:"completedAtGTE,omitempty"`
+	CompletedAtLT     *time.Time  `json:"completedAtLT,omitempty"`
+	CompletedAtLTE    *time.Time  `json:"completedAtLTE,omitempty"`
+	CompletedAtIsNil  bool        `json:"completedAtIsNil,omitempty"`
+	CompletedAtNotNil bool        `json:"completedAtNotNil,omitempty"`
+
+	// "workflow_id" field predicates.
+	WorkflowID             *string  `json:"workflowID,omitempty"`
+	WorkflowIDNEQ          *string  `json:"workflowIDNEQ,omitempty"`
+	WorkflowIDIn           []string `json:"workflowIDIn,omitempty"`
+	WorkflowIDNotIn        []string `json:"workflowIDNotIn,omitempty"`
+	WorkflowIDGT           *string  `json:"workflowIDGT,omitempty"`
+	WorkflowIDGTE          *string  `json:"workflowIDGTE,omitempty"`
+	WorkflowIDLT           *string  `json:"workflowIDLT,omitempty"`
+	WorkflowIDLTE          *string  `json:"workflowIDLTE,omitempty"`
+	WorkflowIDContains     *string  `json:"workflowIDContains,omitempty"`
+	WorkflowIDHasPrefix    *string  `json:"workflowIDHasPrefix,omitempty"`
+	WorkflowIDHasSuffix    *string  `json:"workflowIDHasSuffix,omitempty"`
+	WorkflowIDEqualFold    *string  `json:"workflowIDEqualFold,omitempty"`
+	WorkflowIDContainsFold *string  `json:"workflowIDContainsFold,omitempty"`
+
+	// "workflow_name" field predicates.
+	WorkflowName             *string  `json:"workflowName,omitempty"`
+	WorkflowNameNEQ          *string  `json:"workflowNameNEQ,omitempty"`
+	WorkflowNameIn           []string `json:"workflowNameIn,omitempty"`
+	WorkflowNameNotIn        []string `json:"workflowNameNotIn,omitempty"`
+	WorkflowNameGT           *string  `json:"workflowNameGT,omitempty"`
+	WorkflowNameGTE          *string  `json:"workflowNameGTE,omitempty"`
+	WorkflowNameLT           *string  `json:"workflowNameLT,omitempty"`
+	WorkflowNameLTE          *string  `json:"workflowNameLTE,omitempty"`
+	WorkflowNameContains     *string  `json:"workflowNameContains,omitempty"`
+	WorkflowNameHasPrefix    *string  `json:"workflowNameHasPrefix,omitempty"`
+	WorkflowNameHasSuffix    *string  `json:"workflowNameHasSuffix,omitempty"`
+	WorkflowNameIsNil        bool     `json:"workflowNameIsNil,omitempty"`
+	WorkflowNameNotNil       bool     `json:"workflowNameNotNil,omitempty"`
+	WorkflowNameEqualFold    *string  `json:"workflowNameEqualFold,omitempty"`
+	WorkflowNameContainsFold *string  `json:"workflowNameContainsFold,omitempty"`
+
+	// "step_id" field predicates.
+	StepID             *string  `json:"stepID,omitempty"`
+	StepIDNEQ          *string  `json:"stepIDNEQ,omitempty"`
+	StepIDIn           []string `json:"stepIDIn,omitempty"`
+	StepIDNotIn        []string `json:"stepIDNotIn,omitempty"`
+	StepIDGT           *string  `json:"stepIDGT,omitempty"`
+	StepIDGTE          *string  `json:"stepIDGTE,omitempty"`
+	StepIDLT           *string  `json:"stepIDLT,omitempty"`
+	StepIDLTE          *string  `json:"stepIDLTE,omitempty"`
+	StepIDContains     *string  `json:"stepIDContains,omitempty"`
+	StepIDHasPrefix    *string  `json:"stepIDHasPrefix,omitempty"`
+	StepIDHasSuffix    *string  `json:"stepIDHasSuffix,omitempty"`
+	StepIDIsNil        bool     `json:"stepIDIsNil,omitempty"`
+	StepIDNotNil       bool     `json:"stepIDNotNil,omitempty"`
+	StepIDEqualFold    *string  `json:"stepIDEqualFold,omitempty"`
+	StepIDContainsFold *string  `json:"stepIDContainsFold,omitempty"`
+
+	// "step_name" field predicates.
+	StepName             *string  `json:"stepName,omitempty"`
+	StepNameNEQ          *string  `json:"stepNameNEQ,omitempty"`
+	StepNameIn           []string `json:"stepNameIn,omitempty"`
+	StepNameNotIn        []string `json:"stepNameNotIn,omitempty"`
+	StepNameGT           *string  `json:"stepNameGT,omitempty"`
+	StepNameGTE          *string  `json:"stepNameGTE,omitempty"`
+	StepNameLT           *string  `json:"stepNameLT,omitempty"`
+	StepNameLTE          *string  `json:"stepNameLTE,omitempty"`
+	StepNameContains     *string  `json:"stepNameContains,omitempty"`
+	StepNameHasPrefix    *string  `json:"stepNameHasPrefix,omitempty"`
+	StepNameHasSuffix    *string  `json:"stepNameHasSuffix,omitempty"`
+	StepNameIsNil        bool     `json:"stepNameIsNil,omitempty"`
+	StepNameNotNil       bool     `json:"stepNameNotNil,omitempty"`
+	StepNameEqualFold    *string  `json:"stepNameEqualFold,omitempty"`
+	StepNameContainsFold *string  `json:"stepNameContainsFold,omitempty"`
+
+	// "step_index" field predicates.
+	StepIndex      *int  `json:"stepIndex,omitempty"`
+	StepIndexNEQ   *int  `json:"stepIndexNEQ,omitempty"`
+	StepIndexIn    []int `json:"stepIndexIn,omitempty"`
+	StepIndexNotIn []int `json:"stepIndexNotIn,omitempty"`
+	StepIndexGT    *int  `json:"stepIndexGT,omitempty"`
+	StepIndexGTE   *int  `json:"stepIndexGTE,omitempty"`
+	StepIndexLT    *int  `json:"stepIndexLT,omitempty"`
+	StepIndexLTE   *int  `json:"stepIndexLTE,omitempty"`
+
+	// "status" field predicates.
+	Status      *workflowexecution.Status  `json:"status,omitempty"`
+	StatusNEQ   *workflowexecution.Status  `json:"statusNEQ,omitempty"`
+	StatusIn    []workflowexecution.Status `json:"statusIn,omitempty"`
+	StatusNotIn []workflowexecution.Status `json:"statusNotIn,omitempty"`
+
+	// "error" field predicates.
+	Error             *string  `json:"error,omitempty"`
+	ErrorNEQ          *string  `json:"errorNEQ,omitempty"`
+	ErrorIn           []string `json:"errorIn,omitempty"`
+	ErrorNotIn        []string `json:"errorNotIn,omitempty"`
+	ErrorGT           *string  `json:"errorGT,omitempty"`
+	ErrorGTE          *string  `json:"errorGTE,omitempty"`
+	ErrorLT           *string  `json:"errorLT,omitempty"`
+	ErrorLTE          *string  `json:"errorLTE,omitempty"`
+	ErrorContains     *string  `json:"errorContains,omitempty"`
+	ErrorHasPrefix    *string  `json:"errorHasPrefix,omitempty"`
+	ErrorHasSuffix    *string  `json:"errorHasSuffix,omitempty"`
+	ErrorIsNil        bool     `json:"errorIsNil,omitempty"`
+	ErrorNotNil       bool     `json:"errorNotNil,omitempty"`
+	ErrorEqualFold    *string  `json:"errorEqualFold,omitempty"`
+	ErrorContainsFold *string  `json:"errorContainsFold,omitempty"`
+
+	// "duration_ms" field predicates.
+	DurationMs       *float64  `json:"durationMs,omitempty"`
+	DurationMsNEQ    *float64  `json:"durationMsNEQ,omitempty"`
+	DurationMsIn     []float64 `json:"durationMsIn,omitempty"`
+	DurationMsNotIn  []float64 `json:"durationMsNotIn,omitempty"`
+	DurationMsGT     *float64  `json:"durationMsGT,omitempty"`
+	DurationMsGTE    *float64  `json:"durationMsGTE,omitempty"`
+	DurationMsLT     *float64  `json:"durationMsLT,omitempty"`
+	DurationMsLTE    *float64  `json:"durationMsLTE,omitempty"`
+	DurationMsIsNil  bool      `json:"durationMsIsNil,omitempty"`
+	DurationMsNotNil bool      `json:"durationMsNotNil,omitempty"`
+
+	// "parent_execution_id" field predicates.
+	ParentExecutionID             *string  `json:"parentExecutionID,omitempty"`
+	ParentExecutionIDNEQ          *string  `json:"parentExecutionIDNEQ,omitempty"`
+	ParentExecutionIDIn           []string `json:"parentExecutionIDIn,omitempty"`
+	ParentExecutionIDNotIn        []string `json:"parentExecutionIDNotIn,omitempty"`
+	ParentExecutionIDGT           *string  `json:"parentExecutionIDGT,omitempty"`
+	ParentExecutionIDGTE          *string  `json:"parentExecutionIDGTE,omitempty"`
+	ParentExecutionIDLT           *string  `json:"parentExecutionIDLT,omitempty"`
+	ParentExecutionIDLTE          *string  `json:"parentExecutionIDLTE,omitempty"`
+	ParentExecutionIDContains     *string  `json:"parentExecutionIDContains,omitempty"`
+	ParentExecutionIDHasPrefix    *string  `json:"parentExecutionIDHasPrefix,omitempty"`
+	ParentExecutionIDHasSuffix    *string  `json:"parentExecutionIDHasSuffix,omitempty"`
+	ParentExecutionIDIsNil        bool     `json:"parentExecutionIDIsNil,omitempty"`
+	ParentExecutionIDNotNil       bool     `json:"parentExecutionIDNotNil,omitempty"`
+	ParentExecutionIDEqualFold    *string  `json:"parentExecutionIDEqualFold,omitempty"`
+	ParentExecutionIDContainsFold *string  `json:"parentExecutionIDContainsFold,omitempty"`
+
+	// "actions" edge predicates.
+	HasActions     *bool                    `json:"hasActions,omitempty"`
+	HasActionsWith []*AgentActionWhereInput `json:"hasActionsWith,omitempty"`
+
+	// "outputs" edge predicates.
+	HasOutputs     *bool                       `json:"hasOutputs,omitempty"`
+	HasOutputsWith []*ExternalOutputWhereInput `json:"hasOutputsWith,omitempty"`
+
+	// "parent_execution" edge predicates.
+	HasParentExecution     *bool                          `json:"hasParentExecution,omitempty"`
+	HasParentExecutionWith []*WorkflowExecutionWhereInput `json:"hasParentExecutionWith,omitempty"`
+
+	// "child_executions" edge predicates.
+	HasChildExecutions     *bool                          `json:"hasChildExecutions,omitempty"`
+	HasChildExecutionsWith []*WorkflowExecutionWhereInput `json:"hasChildExecutionsWith,omitempty"`
+}
+
+// AddPredicates adds custom predicates to the where input to be used during the filtering phase.
+func (i *WorkflowExecutionWhereInput) AddPredicates(predicates ...predicate.WorkflowExecution) {
+	i.Predicates = append(i.Predicates, predicates...)
+}
+
+// Filter applies the WorkflowExecutionWhereInput filter on the WorkflowExecutionQuery builder.
+func (i *WorkflowExecutionWhereInput) Filter(q *WorkflowExecutionQuery) (*WorkflowExecutionQuery, error) {
+	if i == nil {
+		return q, nil
+	}
+	p, err := i.P()
+	if err != nil {
+		if err == ErrEmptyWorkflowExecutionWhereInput {
+			return q, nil
+		}
+		return nil, err
+	}
+	return q.Where(p), nil
+}
+
+// ErrEmptyWorkflowExecutionWhereInput is returned in case the WorkflowExecutionWhereInput is empty.
+var ErrEmptyWorkflowExecutionWhereInput = errors.New("ent: empty predicate WorkflowExecutionWhereInput")
+
+// P returns a predicate for filtering workflowexecutions.
+// An error is returned if the input is empty or invalid.
+func (i *WorkflowExecutionWhereInput) P() (predicate.WorkflowExecution, error) {
+	var predicates []predicate.WorkflowExecution
+	if i.Not != nil {
+		p, err := i.Not.P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'not'", err)
+		}
+		predicates = append(predicates, workflowexecution.Not(p))
+	}
+	switch n := len(i.Or); {
+	case n == 1:
+		p, err := i.Or[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'or'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		or := make([]predicate.WorkflowExecution, 0, n)
+		for _, w := range i.Or {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'or'", err)
+			}
+			or = append(or, p)
+		}
+		predicates = append(predicates, workflowexecution.Or(or...))
+	}
+	switch n := len(i.And); {
+	case n == 1:
+		p, err := i.And[0].P()
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'and'", err)
+		}
+		predicates = append(predicates, p)
+	case n > 1:
+		and := make([]predicate.WorkflowExecution, 0, n)
+		for _, w := range i.And {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'and'", err)
+			}
+			and = append(and, p)
+		}
+		predicates = append(predicates, workflowexecution.And(and...))
+	}
+	predicates = append(predicates, i.Predicates...)
+	if i.ID != nil {
+		predicates = append(predicates, workflowexecution.IDEQ(*i.ID))
+	}
+	if i.IDNEQ != nil {
+		predicates = append(predicates, workflowexecution.IDNEQ(*i.IDNEQ))
+	}
+	if len(i.IDIn) > 0 {
+		predicates = append(predicates, workflowexecution.IDIn(i.IDIn...))
+	}
+	if len(i.IDNotIn) > 0 {
+		predicates = append(predicates, workflowexecution.IDNotIn(i.IDNotIn...))
+	}
+	if i.IDGT != nil {
+		predicates = append(predicates, workflowexecution.IDGT(*i.IDGT))
+	}
+	if i.IDGTE != nil {
+		predicates = append(predicates, workflowexecution.IDGTE(*i.IDGTE))
+	}
+	if i.IDLT != nil {
+		predicates = append(predicates, workflowexecution.IDLT(*i.IDLT))
+	}
+	if i.IDLTE != nil {
+		predicates = append(predicates, workflowexecution.IDLTE(*i.IDLTE))
+	}
+	if i.IDEqualFold != nil {
+		predicates = append(predicates, workflowexecution.IDEqualFold(*i.IDEqualFold))
+	}
+	if i.IDContainsFold != nil {
+		predicates = append(predicates, workflowexecution.IDContainsFold(*i.IDContainsFold))
+	}
+	if i.ProvenanceHash != nil {
+		predicates = append(predicates, workflowexecution.ProvenanceHashEQ(*i.ProvenanceHash))
+	}
+	if i.ProvenanceHashNEQ != nil {
+		predicates = append(predicates, workflowexecution.ProvenanceHashNEQ(*i.ProvenanceHashNEQ))
+	}
+	if len(i.ProvenanceHashIn) > 0 {
+		predicates = append(predicates, workflowexecution.ProvenanceHashIn(i.ProvenanceHashIn...))
+	}
+	if len(i.ProvenanceHashNotIn) > 0 {
+		predicates = append(predicates, workflowexecution.ProvenanceHashNotIn(i.ProvenanceHashNotIn...))
+	}
+	if i.ProvenanceHashGT != nil {
+		predicates = append(predicates, workflowexecution.ProvenanceHashGT(*i.ProvenanceHashGT))
+	}
+	if i.ProvenanceHashGTE != nil {
+		predicates = append(predicates, workflowexecution.ProvenanceHashGTE(*i.ProvenanceHashGTE))
+	}
+	if i.ProvenanceHashLT != nil {
+		predicates = append(predicates, workflowexecution.ProvenanceHashLT(*i.ProvenanceHashLT))
+	}
+	if i.ProvenanceHashLTE != nil {
+		predicates = append(predicates, workflowexecution.ProvenanceHashLTE(*i.ProvenanceHashLTE))
+	}
+	if i.ProvenanceHashContains != nil {
+		predicates = append(predicates, workflowexecution.ProvenanceHashContains(*i.ProvenanceHashContains))
+	}
+	if i.ProvenanceHashHasPrefix != nil {
+		predicates = append(predicates, workflowexecution.ProvenanceHashHasPrefix(*i.ProvenanceHashHasPrefix))
+	}
+	if i.ProvenanceHashHasSuffix != nil {
+		predicates = append(predicates, workflowexecution.ProvenanceHashHasSuffix(*i.ProvenanceHashHasSuffix))
+	}
+	if i.ProvenanceHashIsNil {
+		predicates = append(predicates, workflowexecution.ProvenanceHashIsNil())
+	}
+	if i.ProvenanceHashNotNil {
+		predicates = append(predicates, workflowexecution.ProvenanceHashNotNil())
+	}
+	if i.ProvenanceHashEqualFold != nil {
+		predicates = append(predicates, workflowexecution.ProvenanceHashEqualFold(*i.ProvenanceHashEqualFold))
+	}
+	if i.ProvenanceHashContainsFold != nil {
+		predicates = append(predicates, workflowexecution.ProvenanceHashContainsFold(*i.ProvenanceHashContainsFold))
+	}
+	if i.StartedAt != nil {
+		predicates = append(predicates, workflowexecution.StartedAtEQ(*i.StartedAt))
+	}
+	if i.StartedAtNEQ != nil {
+		predicates = append(predicates, workflowexecution.StartedAtNEQ(*i.StartedAtNEQ))
+	}
+	if len(i.StartedAtIn) > 0 {
+		predicates = append(predicates, workflowexecution.StartedAtIn(i.StartedAtIn...))
+	}
+	if len(i.StartedAtNotIn) > 0 {
+		predicates = append(predicates, workflowexecution.StartedAtNotIn(i.StartedAtNotIn...))
+	}
+	if i.StartedAtGT != nil {
+		predicates = append(predicates, workflowexecution.StartedAtGT(*i.StartedAtGT))
+	}
+	if i.StartedAtGTE != nil {
+		predicates = append(predicates, workflowexecution.StartedAtGTE(*i.StartedAtGTE))
+	}
+	if i.StartedAtLT != nil {
+		predicates = append(predicates, workflowexecution.StartedAtLT(*i.StartedAtLT))
+	}
+	if i.StartedAtLTE != nil {
+		predicates = append(predicates, workflowexecution.StartedAtLTE(*i.StartedAtLTE))
+	}
+	if i.CompletedAt != nil {
+		predicates = append(predicates, workflowexecution.CompletedAtEQ(*i.CompletedAt))
+	}
+	if i.CompletedAtNEQ != nil {
+		predicates = append(predicates, workflowexecution.CompletedAtNEQ(*i.CompletedAtNEQ))
+	}
+	if len(i.CompletedAtIn) > 0 {
+		predicates = append(predicates, workflowexecution.CompletedAtIn(i.CompletedAtIn...))
+	}
+	if len(i.CompletedAtNotIn) > 0 {
+		predicates = append(predicates, workflowexecution.CompletedAtNotIn(i.CompletedAtNotIn...))
+	}
+	if i.CompletedAtGT != nil {
+		predicates = append(predicates, workflowexecution.CompletedAtGT(*i.CompletedAtGT))
+	}
+	if i.CompletedAtGTE != nil {
+		predicates = append(predicates, workflowexecution.CompletedAtGTE(*i.CompletedAtGTE))
+	}
+	if i.CompletedAtLT != nil {
+		predicates = append(predicates, workflowexecution.CompletedAtLT(*i.CompletedAtLT))
+	}
+	if i.CompletedAtLTE != nil {
+		predicates = append(predicates, workflowexecution.CompletedAtLTE(*i.CompletedAtLTE))
+	}
+	if i.CompletedAtIsNil {
+		predicates = append(predicates, workflowexecution.CompletedAtIsNil())
+	}
+	if i.CompletedAtNotNil {
+		predicates = append(predicates, workflowexecution.CompletedAtNotNil())
+	}
+	if i.WorkflowID != nil {
+		predicates = append(predicates, workflowexecution.WorkflowIDEQ(*i.WorkflowID))
+	}
+	if i.WorkflowIDNEQ != nil {
+		predicates = append(predicates, workflowexecution.WorkflowIDNEQ(*i.WorkflowIDNEQ))
+	}
+	if len(i.WorkflowIDIn) > 0 {
+		predicates = append(predicates, workflowexecution.WorkflowIDIn(i.WorkflowIDIn...))
+	}
+	if len(i.WorkflowIDNotIn) > 0 {
+		predicates = append(predicates, workflowexecution.WorkflowIDNotIn(i.WorkflowIDNotIn...))
+	}
+	if i.WorkflowIDGT != nil {
+		predicates = append(predicates, workflowexecution.WorkflowIDGT(*i.WorkflowIDGT))
+	}
+	if i.WorkflowIDGTE != nil {
+		predicates = append(predicates, workflowexecution.WorkflowIDGTE(*i.WorkflowIDGTE))
+	}
+	if i.WorkflowIDLT != nil {
+		predicates = append(predicates, workflowexecution.WorkflowIDLT(*i.WorkflowIDLT))
+	}
+	if i.WorkflowIDLTE != nil {
+		predicates = append(predicates, workflowexecution.WorkflowIDLTE(*i.WorkflowIDLTE))
+	}
+	if i.WorkflowIDContains != nil {
+		predicates = append(predicates, workflowexecution.WorkflowIDContains(*i.WorkflowIDContains))
+	}
+	if i.WorkflowIDHasPrefix != nil {
+		predicates = append(predicates, workflowexecution.WorkflowIDHasPrefix(*i.WorkflowIDHasPrefix))
+	}
+	if i.WorkflowIDHasSuffix != nil {
+		predicates = append(predicates, workflowexecution.WorkflowIDHasSuffix(*i.WorkflowIDHasSuffix))
+	}
+	if i.WorkflowIDEqualFold != nil {
+		predicates = append(predicates, workflowexecution.WorkflowIDEqualFold(*i.WorkflowIDEqualFold))
+	}
+	if i.WorkflowIDContainsFold != nil {
+		predicates = append(predicates, workflowexecution.WorkflowIDContainsFold(*i.WorkflowIDContainsFold))
+	}
+	if i.WorkflowName != nil {
+		predicates = append(predicates, workflowexecution.WorkflowNameEQ(*i.WorkflowName))
+	}
+	if i.WorkflowNameNEQ != nil {
+		predicates = append(predicates, workflowexecution.WorkflowNameNEQ(*i.WorkflowNameNEQ))
+	}
+	if len(i.WorkflowNameIn) > 0 {
+		predicates = append(predicates, workflowexecution.WorkflowNameIn(i.WorkflowNameIn...))
+	}
+	if len(i.WorkflowNameNotIn) > 0 {
+		predicates = append(predicates, workflowexecution.WorkflowNameNotIn(i.WorkflowNameNotIn...))
+	}
+	if i.WorkflowNameGT != nil {
+		predicates = append(predicates, workflowexecution.WorkflowNameGT(*i.WorkflowNameGT))
+	}
+	if i.WorkflowNameGTE != nil {
+		predicates = append(predicates, workflowexecution.WorkflowNameGTE(*i.WorkflowNameGTE))
+	}
+	if i.WorkflowNameLT != nil {
+		predicates = append(predicates, workflowexecution.WorkflowNameLT(*i.WorkflowNameLT))
+	}
+	if i.WorkflowNameLTE != nil {
+		predicates = append(predicates, workflowexecution.WorkflowNameLTE(*i.WorkflowNameLTE))
+	}
+	if i.WorkflowNameContains != nil {
+		predicates = append(predicates, workflowexecution.WorkflowNameContains(*i.WorkflowNameContains))
+	}
+	if i.WorkflowNameHasPrefix != nil {
+		predicates = append(predicates, workflowexecution.WorkflowNameHasPrefix(*i.WorkflowNameHasPrefix))
+	}
+	if i.WorkflowNameHasSuffix != nil {
+		predicates = append(predicates, workflowexecution.WorkflowNameHasSuffix(*i.WorkflowNameHasSuffix))
+	}
+	if i.WorkflowNameIsNil {
+		predicates = append(predicates, workflowexecution.WorkflowNameIsNil())
+	}
+	if i.WorkflowNameNotNil {
+		predicates = append(predicates, workflowexecution.WorkflowNameNotNil())
+	}
+	if i.WorkflowNameEqualFold != nil {
+		predicates = append(predicates, workflowexecution.WorkflowNameEqualFold(*i.WorkflowNameEqualFold))
+	}
+	if i.WorkflowNameContainsFold != nil {
+		predicates = append(predicates, workflowexecution.WorkflowNameContainsFold(*i.WorkflowNameContainsFold))
+	}
+	if i.StepID != nil {
+		predicates = append(predicates, workflowexecution.StepIDEQ(*i.StepID))
+	}
+	if i.StepIDNEQ != nil {
+		predicates = append(predicates, workflowexecution.StepIDNEQ(*i.StepIDNEQ))
+	}
+	if len(i.StepIDIn) > 0 {
+		predicates = append(predicates, workflowexecution.StepIDIn(i.StepIDIn...))
+	}
+	if len(i.StepIDNotIn) > 0 {
+		predicates = append(predicates, workflowexecution.StepIDNotIn(i.StepIDNotIn...))
+	}
+	if i.StepIDGT != nil {
+		predicates = append(predicates, workflowexecution.StepIDGT(*i.StepIDGT))
+	}
+	if i.StepIDGTE != nil {
+		predicates = append(predicates, workflowexecution.StepIDGTE(*i.StepIDGTE))
+	}
+	if i.StepIDLT != nil {
+		predicates = append(predicates, workflowexecution.StepIDLT(*i.StepIDLT))
+	}
+	if i.StepIDLTE != nil {
+		predicates = append(predicates, workflowexecution.StepIDLTE(*i.StepIDLTE))
+	}
+	if i.StepIDContains != nil {
+		predicates = append(predicates, workflowexecution.StepIDContains(*i.StepIDContains))
+	}
+	if i.StepIDHasPrefix != nil {
+		predicates = append(predicates, workflowexecution.StepIDHasPrefix(*i.StepIDHasPrefix))
+	}
+	if i.StepIDHasSuffix != nil {
+		predicates = append(predicates, workflowexecution.StepIDHasSuffix(*i.StepIDHasSuffix))
+	}
+	if i.StepIDIsNil {
+		predicates = append(predicates, workflowexecution.StepIDIsNil())
+	}
+	if i.StepIDNotNil {
+		predicates = append(predicates, workflowexecution.StepIDNotNil())
+	}
+	if i.StepIDEqualFold != nil {
+		predicates = append(predicates, workflowexecution.StepIDEqualFold(*i.StepIDEqualFold))
+	}
+	if i.StepIDContainsFold != nil {
+		predicates = append(predicates, workflowexecution.StepIDContainsFold(*i.StepIDContainsFold))
+	}
+	if i.StepName != nil {
+		predicates = append(predicates, workflowexecution.StepNameEQ(*i.StepName))
+	}
+	if i.StepNameNEQ != nil {
+		predicates = append(predicates, workflowexecution.StepNameNEQ(*i.StepNameNEQ))
+	}
+	if len(i.StepNameIn) > 0 {
+		predicates = append(predicates, workflowexecution.StepNameIn(i.StepNameIn...))
+	}
+	if len(i.StepNameNotIn) > 0 {
+		predicates = append(predicates, workflowexecution.StepNameNotIn(i.StepNameNotIn...))
+	}
+	if i.StepNameGT != nil {
+		predicates = append(predicates, workflowexecution.StepNameGT(*i.StepNameGT))
+	}
+	if i.StepNameGTE != nil {
+		predicates = append(predicates, workflowexecution.StepNameGTE(*i.StepNameGTE))
+	}
+	if i.StepNameLT != nil {
+		predicates = append(predicates, workflowexecution.StepNameLT(*i.StepNameLT))
+	}
+	if i.StepNameLTE != nil {
+		predicates = append(predicates, workflowexecution.StepNameLTE(*i.StepNameLTE))
+	}
+	if i.StepNameContains != nil {
+		predicates = append(predicates, workflowexecution.StepNameContains(*i.StepNameContains))
+	}
+	if i.StepNameHasPrefix != nil {
+		predicates = append(predicates, workflowexecution.StepNameHasPrefix(*i.StepNameHasPrefix))
+	}
+	if i.StepNameHasSuffix != nil {
+		predicates = append(predicates, workflowexecution.StepNameHasSuffix(*i.StepNameHasSuffix))
+	}
+	if i.StepNameIsNil {
+		predicates = append(predicates, workflowexecution.StepNameIsNil())
+	}
+	if i.StepNameNotNil {
+		predicates = append(predicates, workflowexecution.StepNameNotNil())
+	}
+	if i.StepNameEqualFold != nil {
+		predicates = append(predicates, workflowexecution.StepNameEqualFold(*i.StepNameEqualFold))
+	}
+	if i.StepNameContainsFold != nil {
+		predicates = append(predicates, workflowexecution.StepNameContainsFold(*i.StepNameContainsFold))
+	}
+	if i.StepIndex != nil {
+		predicates = append(predicates, workflowexecution.StepIndexEQ(*i.StepIndex))
+	}
+	if i.StepIndexNEQ != nil {
+		predicates = append(predicates, workflowexecution.StepIndexNEQ(*i.StepIndexNEQ))
+	}
+	if len(i.StepIndexIn) > 0 {
+		predicates = append(predicates, workflowexecution.StepIndexIn(i.StepIndexIn...))
+	}
+	if len(i.StepIndexNotIn) > 0 {
+		predicates = append(predicates, workflowexecution.StepIndexNotIn(i.StepIndexNotIn...))
+	}
+	if i.StepIndexGT != nil {
+		predicates = append(predicates, workflowexecution.StepIndexGT(*i.StepIndexGT))
+	}
+	if i.StepIndexGTE != nil {
+		predicates = append(predicates, workflowexecution.StepIndexGTE(*i.StepIndexGTE))
+	}
+	if i.StepIndexLT != nil {
+		predicates = append(predicates, workflowexecution.StepIndexLT(*i.StepIndexLT))
+	}
+	if i.StepIndexLTE != nil {
+		predicates = append(predicates, workflowexecution.StepIndexLTE(*i.StepIndexLTE))
+	}
+	if i.Status != nil {
+		predicates = append(predicates, workflowexecution.StatusEQ(*i.Status))
+	}
+	if i.StatusNEQ != nil {
+		predicates = append(predicates, workflowexecution.StatusNEQ(*i.StatusNEQ))
+	}
+	if len(i.StatusIn) > 0 {
+		predicates = append(predicates, workflowexecution.StatusIn(i.StatusIn...))
+	}
+	if len(i.StatusNotIn) > 0 {
+		predicates = append(predicates, workflowexecution.StatusNotIn(i.StatusNotIn...))
+	}
+	if i.Error != nil {
+		predicates = append(predicates, workflowexecution.ErrorEQ(*i.Error))
+	}
+	if i.ErrorNEQ != nil {
+		predicates = append(predicates, workflowexecution.ErrorNEQ(*i.ErrorNEQ))
+	}
+	if len(i.ErrorIn) > 0 {
+		predicates = append(predicates, workflowexecution.ErrorIn(i.ErrorIn...))
+	}
+	if len(i.ErrorNotIn) > 0 {
+		predicates = append(predicates, workflowexecution.ErrorNotIn(i.ErrorNotIn...))
+	}
+	if i.ErrorGT != nil {
+		predicates = append(predicates, workflowexecution.ErrorGT(*i.ErrorGT))
+	}
+	if i.ErrorGTE != nil {
+		predicates = append(predicates, workflowexecution.ErrorGTE(*i.ErrorGTE))
+	}
+	if i.ErrorLT != nil {
+		predicates = append(predicates, workflowexecution.ErrorLT(*i.ErrorLT))
+	}
+	if i.ErrorLTE != nil {
+		predicates = append(predicates, workflowexecution.ErrorLTE(*i.ErrorLTE))
+	}
+	if i.ErrorContains != nil {
+		predicates = append(predicates, workflowexecution.ErrorContains(*i.ErrorContains))
+	}
+	if i.ErrorHasPrefix != nil {
+		predicates = append(predicates, workflowexecution.ErrorHasPrefix(*i.ErrorHasPrefix))
+	}
+	if i.ErrorHasSuffix != nil {
+		predicates = append(predicates, workflowexecution.ErrorHasSuffix(*i.ErrorHasSuffix))
+	}
+	if i.ErrorIsNil {
+		predicates = append(predicates, workflowexecution.ErrorIsNil())
+	}
+	if i.ErrorNotNil {
+		predicates = append(predicates, workflowexecution.ErrorNotNil())
+	}
+	if i.ErrorEqualFold != nil {
+		predicates = append(predicates, workflowexecution.ErrorEqualFold(*i.ErrorEqualFold))
+	}
+	if i.ErrorContainsFold != nil {
+		predicates = append(predicates, workflowexecution.ErrorContainsFold(*i.ErrorContainsFold))
+	}
+	if i.DurationMs != nil {
+		predicates = append(predicates, workflowexecution.DurationMsEQ(*i.DurationMs))
+	}
+	if i.DurationMsNEQ != nil {
+		predicates = append(predicates, workflowexecution.DurationMsNEQ(*i.DurationMsNEQ))
+	}
+	if len(i.DurationMsIn) > 0 {
+		predicates = append(predicates, workflowexecution.DurationMsIn(i.DurationMsIn...))
+	}
+	if len(i.DurationMsNotIn) > 0 {
+		predicates = append(predicates, workflowexecution.DurationMsNotIn(i.DurationMsNotIn...))
+	}
+	if i.DurationMsGT != nil {
+		predicates = append(predicates, workflowexecution.DurationMsGT(*i.DurationMsGT))
+	}
+	if i.DurationMsGTE != nil {
+		predicates = append(predicates, workflowexecution.DurationMsGTE(*i.DurationMsGTE))
+	}
+	if i.DurationMsLT != nil {
+		predicates = append(predicates, workflowexecution.DurationMsLT(*i.DurationMsLT))
+	}
+	if i.DurationMsLTE != nil {
+		predicates = append(predicates, workflowexecution.DurationMsLTE(*i.DurationMsLTE))
+	}
+	if i.DurationMsIsNil {
+		predicates = append(predicates, workflowexecution.DurationMsIsNil())
+	}
+	if i.DurationMsNotNil {
+		predicates = append(predicates, workflowexecution.DurationMsNotNil())
+	}
+	if i.ParentExecutionID != nil {
+		predicates = append(predicates, workflowexecution.ParentExecutionIDEQ(*i.ParentExecutionID))
+	}
+	if i.ParentExecutionIDNEQ != nil {
+		predicates = append(predicates, workflowexecution.ParentExecutionIDNEQ(*i.ParentExecutionIDNEQ))
+	}
+	if len(i.ParentExecutionIDIn) > 0 {
+		predicates = append(predicates, workflowexecution.ParentExecutionIDIn(i.ParentExecutionIDIn...))
+	}
+	if len(i.ParentExecutionIDNotIn) > 0 {
+		predicates = append(predicates, workflowexecution.ParentExecutionIDNotIn(i.ParentExecutionIDNotIn...))
+	}
+	if i.ParentExecutionIDGT != nil {
+		predicates = append(predicates, workflowexecution.ParentExecutionIDGT(*i.ParentExecutionIDGT))
+	}
+	if i.ParentExecutionIDGTE != nil {
+		predicates = append(predicates, workflowexecution.ParentExecutionIDGTE(*i.ParentExecutionIDGTE))
+	}
+	if i.ParentExecutionIDLT != nil {
+		predicates = append(predicates, workflowexecution.ParentExecutionIDLT(*i.ParentExecutionIDLT))
+	}
+	if i.ParentExecutionIDLTE != nil {
+		predicates = append(predicates, workflowexecution.ParentExecutionIDLTE(*i.ParentExecutionIDLTE))
+	}
+	if i.ParentExecutionIDContains != nil {
+		predicates = append(predicates, workflowexecution.ParentExecutionIDContains(*i.ParentExecutionIDContains))
+	}
+	if i.ParentExecutionIDHasPrefix != nil {
+		predicates = append(predicates, workflowexecution.ParentExecutionIDHasPrefix(*i.ParentExecutionIDHasPrefix))
+	}
+	if i.ParentExecutionIDHasSuffix != nil {
+		predicates = append(predicates, workflowexecution.ParentExecutionIDHasSuffix(*i.ParentExecutionIDHasSuffix))
+	}
+	if i.ParentExecutionIDIsNil {
+		predicates = append(predicates, workflowexecution.ParentExecutionIDIsNil())
+	}
+	if i.ParentExecutionIDNotNil {
+		predicates = append(predicates, workflowexecution.ParentExecutionIDNotNil())
+	}
+	if i.ParentExecutionIDEqualFold != nil {
+		predicates = append(predicates, workflowexecution.ParentExecutionIDEqualFold(*i.ParentExecutionIDEqualFold))
+	}
+	if i.ParentExecutionIDContainsFold != nil {
+		predicates = append(predicates, workflowexecution.ParentExecutionIDContainsFold(*i.ParentExecutionIDContainsFold))
+	}
+
+	if i.HasActions != nil {
+		p := workflowexecution.HasActions()
+		if !*i.HasActions {
+			p = workflowexecution.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasActionsWith) > 0 {
+		with := make([]predicate.AgentAction, 0, len(i.HasActionsWith))
+		for _, w := range i.HasActionsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasActionsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, workflowexecution.HasActionsWith(with...))
+	}
+	if i.HasOutputs != nil {
+		p := workflowexecution.HasOutputs()
+		if !*i.HasOutputs {
+			p = workflowexecution.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasOutputsWith) > 0 {
+		with := make([]predicate.ExternalOutput, 0, len(i.HasOutputsWith))
+		for _, w := range i.HasOutputsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasOutputsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, workflowexecution.HasOutputsWith(with...))
+	}
+	if i.HasParentExecution != nil {
+		p := workflowexecution.HasParentExecution()
+		if !*i.HasParentExecution {
+			p = workflowexecution.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasParentExecutionWith) > 0 {
+		with := make([]predicate.WorkflowExecution, 0, len(i.HasParentExecutionWith))
+		for _, w := range i.HasParentExecutionWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasParentExecutionWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, workflowexecution.HasParentExecutionWith(with...))
+	}
+	if i.HasChildExecutions != nil {
+		p := workflowexecution.HasChildExecutions()
+		if !*i.HasChildExecutions {
+			p = workflowexecution.Not(p)
+		}
+		predicates = append(predicates, p)
+	}
+	if len(i.HasChildExecutionsWith) > 0 {
+		with := make([]predicate.WorkflowExecution, 0, len(i.HasChildExecutionsWith))
+		for _, w := range i.HasChildExecutionsWith {
+			p, err := w.P()
+			if err != nil {
+				return nil, fmt.Errorf("%w: field 'HasChildExecutionsWith'", err)
+			}
+			with = append(with, p)
+		}
+		predicates = append(predicates, workflowexecution.HasChildExecutionsWith(with...))
+	}
+	switch len(predicates) {
+	case 0:
+		return nil, ErrEmptyWorkflowExecutionWhereInput
+	case 1:
+		return predicates[0], nil
+	default:
+		return workflowexecution.And(predicates...), nil
+	}
+}
@@ -0,0 +1,413 @@
+// Code generated by ent, DO NOT EDIT.
+
+package migrate
+
+import (
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+var (
+	// AgentActionsColumns holds the columns for the "agent_actions" table.
+	AgentActionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "provenance_hash", Type: field.TypeString, Nullable: true},
+		{Name: "timestamp", Type: field.TypeTime},
+		{Name: "agent_id", Type: field.TypeString},
+		{Name: "agent_type", Type: field.TypeString},
+		{Name: "action_type", Type: field.TypeString},
+		{Name: "action_name", Type: field.TypeString, Nullable: true},
+		{Name: "parameters", Type: field.TypeJSON, Nullable: true},
+		{Name: "target_resource", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "executing", "completed", "failed", "cancelled"}, Default: "pending"},
+		{Name: "result", Type: field.TypeString, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "latency_ms", Type: field.TypeFloat64, Nullable: true},
+		{Name: "session_id", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+	}
+	// AgentActionsTable holds the schema information for the "agent_actions" table.
+	AgentActionsTable = &schema.Table{
+		Name:       "agent_actions",
+		Columns:    AgentActionsColumns,
+		PrimaryKey: []*schema.Column{AgentActionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "agentaction_agent_id",
+				Unique:  false,
+				Columns: []*schema.Column{AgentActionsColumns[3]},
+			},
+			{
+				Name:    "agentaction_agent_type",
+				Unique:  false,
+				Columns: []*schema.Column{AgentActionsColumns[4]},
+			},
+			{
+				Name:    "agentaction_action_type",
+				Unique:  false,
+				Columns: []*schema.Column{AgentActionsColumns[5]},
+			},
+			{
+				Name:    "agentaction_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{AgentActionsColumns[2]},
+			},
+			{
+				Name:    "agentaction_status",
+				Unique:  false,
+				Columns: []*schema.Column{AgentActionsColumns[9]},
+			},
+			{
+				Name:    "agentaction_session_id",
+				Unique:  false,
+				Columns: []*schema.Column{AgentActionsColumns[13]},
+			},
+			{
+				Name:    "agentaction_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{AgentActionsColumns[14]},
+			},
+		},
+	}
+	// ExternalOutputsColumns holds the columns for the "external_outputs" table.
+	ExternalOutputsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "provenance_hash", Type: field.TypeString, Nullable: true},
+		{Name: "timestamp", Type: field.TypeTime},
+		{Name: "output_type", Type: field.TypeEnum, Enums: []string{"foundation_tx", "aws_record", "trade_execution", "document", "api_response", "notification", "file", "other"}},
+		{Name: "destination", Type: field.TypeString, Nullable: true},
+		{Name: "destination_id", Type: field.TypeString, Nullable: true},
+		{Name: "transaction_id", Type: field.TypeString, Nullable: true},
+		{Name: "block_hash", Type: field.TypeString, Nullable: true},
+		{Name: "block_number", Type: field.TypeInt64, Nullable: true},
+		{Name: "content_hash", Type: field.TypeString},
+		{Name: "content_size", Type: field.TypeInt64, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "confirmed", "failed", "reverted"}, Default: "pending"},
+		{Name: "domain", Type: field.TypeString, Nullable: true},
+		{Name: "compliance", Type: field.TypeJSON, Nullable: true},
+		{Name: "retention_years", Type: field.TypeInt, Default: 7},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+	}
+	// ExternalOutputsTable holds the schema information for the "external_outputs" table.
+	ExternalOutputsTable = &schema.Table{
+		Name:       "external_outputs",
+		Columns:    ExternalOutputsColumns,
+		PrimaryKey: []*schema.Column{ExternalOutputsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "externaloutput_output_type",
+				Unique:  false,
+				Columns: []*schema.Column{ExternalOutputsColumns[3]},
+			},
+			{
+				Name:    "externaloutput_destination",
+				Unique:  false,
+				Columns: []*schema.Column{ExternalOutputsColumns[4]},
+			},
+			{
+				Name:    "externaloutput_transaction_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExternalOutputsColumns[6]},
+			},
+			{
+				Name:    "externaloutput_content_hash",
+				Unique:  false,
+				Columns: []*schema.Column{ExternalOutputsColumns[9]},
+			},
+			{
+				Name:    "externaloutput_status",
+				Unique:  false,
+				Columns: []*schema.Column{ExternalOutputsColumns[11]},
+			},
+			{
+				Name:    "externaloutput_domain",
+				Unique:  false,
+				Columns: []*schema.Column{ExternalOutputsColumns[12]},
+			},
+			{
+				Name:    "externaloutput_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{ExternalOutputsColumns[2]},
+			},
+		},
+	}
+	// RoutingDecisionsColumns holds the columns for the "routing_decisions" table.
+	RoutingDecisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "provenance_hash", Type: field.TypeString, Nullable: true},
+		{Name: "timestamp", Type: field.TypeTime},
+		{Name: "inference_id", Type: field.TypeString},
+		{Name: "decision_type", Type: field.TypeEnum, Enums: []string{"exit", "skip", "route", "escalate", "iterate"}},
+		{Name: "layer_index", Type: field.TypeInt, Default: 0},
+		{Name: "gate_probability", Type: field.TypeFloat64, Default: 0},
+		{Name: "selected_model", Type: field.TypeString, Nullable: true},
+		{Name: "iteration_count", Type: field.TypeInt, Default: 0},
+		{Name: "confidence", Type: field.TypeFloat64, Default: 0},
+		{Name: "domain", Type: field.TypeString, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+	}
+	// RoutingDecisionsTable holds the schema information for the "routing_decisions" table.
+	RoutingDecisionsTable = &schema.Table{
+		Name:       "routing_decisions",
+		Columns:    RoutingDecisionsColumns,
+		PrimaryKey: []*schema.Column{RoutingDecisionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "routingdecision_inference_id",
+				Unique:  false,
+				Columns: []*schema.Column{RoutingDecisionsColumns[3]},
+			},
+			{
+				Name:    "routingdecision_decision_type",
+				Unique:  false,
+				Columns: []*schema.Column{RoutingDecisionsColumns[4]},
+			},
+			{
+				Name:    "routingdecision_selected_model",
+				Unique:  false,
+				Columns: []*schema.Column{RoutingDecisionsColumns[7]},
+			},
+			{
+				Name:    "routingdecision_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{RoutingDecisionsColumns[2]},
+			},
+			{
+				Name:    "routingdecision_domain",
+				Unique:  false,
+				Columns: []*schema.Column{RoutingDecisionsColumns[10]},
+			},
+		},
+	}
+	// SpikeEventsColumns holds the columns for the "spike_events" table.
+	SpikeEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "provenance_hash", Type: field.TypeString, Nullable: true},
+		{Name: "timestamp", Type: field.TypeTime},
+		{Name: "timestamp_ns", Type: field.TypeInt64, Nullable: true},
+		{Name: "population_id", Type: field.TypeString},
+		{Name: "layer_index", Type: field.TypeInt, Default: 0},
+		{Name: "neuron_indices", Type: field.TypeJSON},
+		{Name: "spike_counts", Type: field.TypeJSON, Nullable: true},
+		{Name: "membrane_potentials", Type: field.TypeJSON, Nullable: true},
+		{Name: "input_currents", Type: field.TypeJSON, Nullable: true},
+		{Name: "pattern_hash", Type: field.TypeString},
+		{Name: "inference_id", Type: field.TypeString, Nullable: true},
+		{Name: "is_emergent", Type: field.TypeBool, Default: false},
+		{Name: "entropy", Type: field.TypeFloat64, Default: 0},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+	}
+	// SpikeEventsTable holds the schema information for the "spike_events" table.
+	SpikeEventsTable = &schema.Table{
+		Name:       "spike_events",
+		Columns:    SpikeEventsColumns,
+		PrimaryKey: []*schema.Column{SpikeEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "spikeevent_pattern_hash",
+				Unique:  false,
+				Columns: []*schema.Column{SpikeEventsColumns[10]},
+			},
+			{
+				Name:    "spikeevent_inference_id",
+				Unique:  false,
+				Columns: []*schema.Column{SpikeEventsColumns[11]},
+			},
+			{
+				Name:    "spikeevent_population_id",
+				Unique:  false,
+				Columns: []*schema.Column{SpikeEventsColumns[4]},
+			},
+			{
+				Name:    "spikeevent_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{SpikeEventsColumns[2]},
+			},
+			{
+				Name:    "spikeevent_is_emergent",
+				Unique:  false,
+				Columns: []*schema.Column{SpikeEventsColumns[12]},
+			},
+		},
+	}
+	// WorkflowExecutionsColumns holds the columns for the "workflow_executions" table.
+	WorkflowExecutionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "provenance_hash", Type: field.TypeString, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "workflow_id", Type: field.TypeString},
+		{Name: "workflow_name", Type: field.TypeString, Nullable: true},
+		{Name: "step_id", Type: field.TypeString, Nullable: true},
+		{Name: "step_name", Type: field.TypeString, Nullable: true},
+		{Name: "step_index", Type: field.TypeInt, Default: 0},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "completed", "failed", "cancelled", "paused"}, Default: "pending"},
+		{Name: "inputs", Type: field.TypeJSON, Nullable: true},
+		{Name: "outputs", Type: field.TypeJSON, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "duration_ms", Type: field.TypeFloat64, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "parent_execution_id", Type: field.TypeString, Nullable: true},
+	}
+	// WorkflowExecutionsTable holds the schema information for the "workflow_executions" table.
+	WorkflowExecutionsTable = &schema.Table{
+		Name:       "workflow_executions",
+		Columns:    WorkflowExecutionsColumns,
+		PrimaryKey: []*schema.Column{WorkflowExecutionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "workflow_executions_workflow_executions_child_executions",
+				Columns:    []*schema.Column{WorkflowExecutionsColumns[15]},
+				RefColumns: []*schema.Column{WorkflowExecutionsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "workflowexecution_workflow_id",
+				Unique:  false,
+				Columns: []*schema.Column{WorkflowExecutionsColumns[4]},
+			},
+			{
+				Name:    "workflowexecution_status",
+				Unique:  false,
+				Columns: []*schema.Column{WorkflowExecutionsColumns[9]},
+			},
+			{
+				Name:    "workflowexecution_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{WorkflowExecutionsColumns[2]},
+			},
+			{
+				Name:    "workflowexecution_parent_execution_id",
+				Unique:  false,
+				Columns: []*schema.Column{WorkflowExecutionsColumns[15]},
+			},
+		},
+	}
+	// AgentActionWorkflowsColumns holds the columns for the "agent_action_workflows" table.
+	AgentActionWorkflowsColumns = []*schema.Column{
+		{Name: "agent_action_id", Type: field.TypeString},
+		{Name: "workflow_execution_id", Type: field.TypeString},
+	}
+	// AgentActionWorkflowsTable holds the schema information for the "agent_action_workflows" table.
+	AgentActionWorkflowsTable = &schema.Table{
+		Name:       "agent_action_workflows",
+		Columns:    AgentActionWorkflowsColumns,
+		PrimaryKey: []*schema.Column{AgentActionWorkflowsColumns[0], AgentActionWorkflowsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "agent_action_workflows_agent_action_id",
+				Columns:    []*schema.Column{AgentActionWorkflowsColumns[0]},
+				RefColumns: []*schema.Column{AgentActionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "agent_action_workflows_workflow_execution_id",
+				Columns:    []*schema.Column{AgentActionWorkflowsColumns[1]},
+				RefColumns: []*schema.Column{WorkflowExecutionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// RoutingDecisionActionsColumns holds the columns for the "routing_decision_actions" table.
+	RoutingDecisionActionsColumns = []*schema.Column{
+		{Name: "routing_decision_id", Type: field.TypeString},
+		{Name: "agent_action_id", Type: field.TypeString},
+	}
+	// RoutingDecisionActionsTable holds the schema information for the "routing_decision_actions" table.
+	RoutingDecisionActionsTable = &schema.Table{
+		Name:       "routing_decision_actions",
+		Columns:    RoutingDecisionActionsColumns,
+		PrimaryKey: []*schema.Column{RoutingDecisionActionsColumns[0], RoutingDecisionActionsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "routing_decision_actions_routing_decision_id",
+				Columns:    []*schema.Column{RoutingDecisionActionsColumns[0]},
+				RefColumns: []*schema.Column{RoutingDecisionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "routing_decision_actions_agent_action_id",
+				Columns:    []*schema.Column{RoutingDecisionActionsColumns[1]},
+				RefColumns: []*schema.Column{AgentActionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// SpikeEventDecisionsColumns holds the columns for the "spike_event_decisions" table.
+	SpikeEventDecisionsColumns = []*schema.Column{
+		{Name: "spike_event_id", Type: field.TypeString},
+		{Name: "routing_decision_id", Type: field.TypeString},
+	}
+	// SpikeEventDecisionsTable holds the schema information for the "spike_event_decisions" table.
+	SpikeEventDecisionsTable = &schema.Table{
+		Name:       "spike_event_decisions",
+		Columns:    SpikeEventDecisionsColumns,
+		PrimaryKey: []*schema.Column{SpikeEventDecisionsColumns[0], SpikeEventDecisionsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "spike_event_decisions_spike_event_id",
+				Columns:    []*schema.Column{SpikeEventDecisionsColumns[0]},
+				RefColumns: []*schema.Column{SpikeEventsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "spike_event_decisions_routing_decision_id",
+				Columns:    []*schema.Column{SpikeEventDecisionsColumns[1]},
+				RefColumns: []*schema.Column{RoutingDecisionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// WorkflowExecutionOutputsColumns holds the columns for the "workflow_execution_outputs" table.
+	WorkflowExecutionOutputsColumns = []*schema.Column{
+		{Name: "workflow_execution_id", Type: field.TypeString},
+		{Name: "external_output_id", Type: field.TypeString},
+	}
+	// WorkflowExecutionOutputsTable holds the schema information for the "workflow_execution_outputs" table.
+	WorkflowExecutionOutputsTable = &schema.Table{
+		Name:       "workflow_execution_outputs",
+		Columns:    WorkflowExecutionOutputsColumns,
+		PrimaryKey: []*schema.Column{WorkflowExecutionOutputsColumns[0], WorkflowExecutionOutputsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "workflow_execution_outputs_workflow_execution_id",
+				Columns:    []*schema.Column{WorkflowExecutionOutputsColumns[0]},
+				RefColumns: []*schema.Column{WorkflowExecutionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "workflow_execution_outputs_external_output_id",
+				Columns:    []*schema.Column{WorkflowExecutionOutputsColumns[1]},
+				RefColumns: []*schema.Column{ExternalOutputsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// Tables holds all the tables in the schema.
+	Tables = []*schema.Table{
+		AgentActionsTable,
+		ExternalOutputsTable,
+		RoutingDecisionsTable,
+		SpikeEventsTable,
+		WorkflowExecutionsTable,
+		AgentActionWorkflowsTable,
+		RoutingDecisionActionsTable,
+		SpikeEventDecisionsTable,
+		WorkflowExecutionOutputsTable,
+	}
+)
+
+func init() {
+	WorkflowExecutionsTable.ForeignKeys[0].RefTable = WorkflowExecutionsTable
+	AgentActionWorkflowsTable.ForeignKeys[0].RefTable = AgentActionsTable
+	AgentActionWorkflowsTable.ForeignKeys[1].RefTable = WorkflowExecutionsTable
+	RoutingDecisionActionsTable.ForeignKeys[0].RefTable = RoutingDecisionsTable
+	RoutingDecisionActionsTable.ForeignKeys[1].RefTable = AgentActionsTable
+	SpikeEventDecisionsTable.ForeignKeys[0].RefTable = SpikeEventsTable
+	SpikeEventDecisionsTable.ForeignKeys[1].RefTable = RoutingDecisionsTable
+	WorkflowExecutionOutputsTable.ForeignKeys[0].RefTable = WorkflowExecutionsTable
+	WorkflowExecutionOutputsTable.ForeignKeys[1].RefTable = ExternalOutputsTable
+}
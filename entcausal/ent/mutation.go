@@ -0,0 +1,7378 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/spikeevent"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Operation types.
+	OpCreate    = ent.OpCreate
+	OpDelete    = ent.OpDelete
+	OpDeleteOne = ent.OpDeleteOne
+	OpUpdate    = ent.OpUpdate
+	OpUpdateOne = ent.OpUpdateOne
+
+	// Node types.
+	TypeAgentAction       = "AgentAction"
+	TypeExternalOutput    = "ExternalOutput"
+	TypeRoutingDecision   = "RoutingDecision"
+	TypeSpikeEvent        = "SpikeEvent"
+	TypeWorkflowExecution = "WorkflowExecution"
+)
+
+// AgentActionMutation represents an operation that mutates the AgentAction nodes in the graph.
+type AgentActionMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *string
+	provenance_hash  *string
+	timestamp        *time.Time
+	agent_id         *string
+	agent_type       *string
+	action_type      *string
+	action_name      *string
+	parameters       *map[string]interface{}
+	target_resource  *string
+	status           *agentaction.Status
+	result           *string
+	error            *string
+	latency_ms       *float64
+	addlatency_ms    *float64
+	session_id       *string
+	user_id          *string
+	metadata         *map[string]interface{}
+	clearedFields    map[string]struct{}
+	decisions        map[string]struct{}
+	removeddecisions map[string]struct{}
+	cleareddecisions bool
+	workflows        map[string]struct{}
+	removedworkflows map[string]struct{}
+	clearedworkflows bool
+	done             bool
+	oldValue         func(context.Context) (*AgentAction, error)
+	predicates       []predicate.AgentAction
+}
+
+var _ ent.Mutation = (*AgentActionMutation)(nil)
+
+// agentactionOption allows management of the mutation configuration using functional options.
+type agentactionOption func(*AgentActionMutation)
+
+// newAgentActionMutation creates new mutation for the AgentAction entity.
+func newAgentActionMutation(c config, op Op, opts ...agentactionOption) *AgentActionMutation {
+	m := &AgentActionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAgentAction,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAgentActionID sets the ID field of the mutation.
+func withAgentActionID(id string) agentactionOption {
+	return func(m *AgentActionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AgentAction
+		)
+		m.oldValue = func(ctx context.Context) (*AgentAction, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AgentAction.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAgentAction sets the old AgentAction of the mutation.
+func withAgentAction(node *AgentAction) agentactionOption {
+	return func(m *AgentActionMutation) {
+		m.oldValue = func(context.Context) (*AgentAction, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AgentActionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AgentActionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of AgentAction entities.
+func (m *AgentActionMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AgentActionMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AgentActionMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AgentAction.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProvenanceHash sets the "provenance_hash" field.
+func (m *AgentActionMutation) SetProvenanceHash(s string) {
+	m.provenance_hash = &s
+}
+
+// ProvenanceHash returns the value of the "provenance_hash" field in the mutation.
+func (m *AgentActionMutation) ProvenanceHash() (r string, exists bool) {
+	v := m.provenance_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvenanceHash returns the old "provenance_hash" field's value of the AgentAction entity.
+// If the AgentAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentActionMutation) OldProvenanceHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvenanceHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvenanceHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvenanceHash: %w", err)
+	}
+	return oldValue.ProvenanceHash, nil
+}
+
+// ClearProvenanceHash clears the value of the "provenance_hash" field.
+func (m *AgentActionMutation) ClearProvenanceHash() {
+	m.provenance_hash = nil
+	m.clearedFields[agentaction.FieldProvenanceHash] = struct{}{}
+}
+
+// ProvenanceHashCleared returns if the "provenance_hash" field was cleared in this mutation.
+func (m *AgentActionMutation) ProvenanceHashCleared() bool {
+	_, ok := m.clearedFields[agentaction.FieldProvenanceHash]
+	return ok
+}
+
+// ResetProvenanceHash resets all changes to the "provenance_hash" field.
+func (m *AgentActionMutation) ResetProvenanceHash() {
+	m.provenance_hash = nil
+	delete(m.clearedFields, agentaction.FieldProvenanceHash)
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *AgentActionMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *AgentActionMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the AgentAction entity.
+// If the AgentAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentActionMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *AgentActionMutation) ResetTimestamp() {
+	m.timestamp = nil
+}
+
+// SetAgentID sets the "agent_id" field.
+func (m *AgentActionMutation) SetAgentID(s string) {
+	m.agent_id = &s
+}
+
+// AgentID returns the value of the "agent_id" field in the mutation.
+func (m *AgentActionMutation) AgentID() (r string, exists bool) {
+	v := m.agent_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAgentID returns the old "agent_id" field's value of the AgentAction entity.
+// If the AgentAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentActionMutation) OldAgentID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgentID: %w", err)
+	}
+	return oldValue.AgentID, nil
+}
+
+// ResetAgentID resets all changes to the "agent_id" field.
+func (m *AgentActionMutation) ResetAgentID() {
+	m.agent_id = nil
+}
+
+// SetAgentType sets the "agent_type" field.
+func (m *AgentActionMutation) SetAgentType(s string) {
+	m.agent_type = &s
+}
+
+// AgentType returns the value of the "agent_type" field in the mutation.
+func (m *AgentActionMutation) AgentType() (r string, exists bool) {
+	v := m.agent_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAgentType returns the old "agent_type" field's value of the AgentAction entity.
+// If the AgentAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentActionMutation) OldAgentType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAgentType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAgentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAgentType: %w", err)
+	}
+	return oldValue.AgentType, nil
+}
+
+// ResetAgentType resets all changes to the "agent_type" field.
+func (m *AgentActionMutation) ResetAgentType() {
+	m.agent_type = nil
+}
+
+// SetActionType sets the "action_type" field.
+func (m *AgentActionMutation) SetActionType(s string) {
+	m.action_type = &s
+}
+
+// ActionType returns the value of the "action_type" field in the mutation.
+func (m *AgentActionMutation) ActionType() (r string, exists bool) {
+	v := m.action_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActionType returns the old "action_type" field's value of the AgentAction entity.
+// If the AgentAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentActionMutation) OldActionType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActionType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActionType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActionType: %w", err)
+	}
+	return oldValue.ActionType, nil
+}
+
+// ResetActionType resets all changes to the "action_type" field.
+func (m *AgentActionMutation) ResetActionType() {
+	m.action_type = nil
+}
+
+// SetActionName sets the "action_name" field.
+func (m *AgentActionMutation) SetActionName(s string) {
+	m.action_name = &s
+}
+
+// ActionName returns the value of the "action_name" field in the mutation.
+func (m *AgentActionMutation) ActionName() (r string, exists bool) {
+	v := m.action_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActionName returns the old "action_name" field's value of the AgentAction entity.
+// If the AgentAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentActionMutation) OldActionName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActionName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActionName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActionName: %w", err)
+	}
+	return oldValue.ActionName, nil
+}
+
+// ClearActionName clears the value of the "action_name" field.
+func (m *AgentActionMutation) ClearActionName() {
+	m.action_name = nil
+	m.clearedFields[agentaction.FieldActionName] = struct{}{}
+}
+
+// ActionNameCleared returns if the "action_name" field was cleared in this mutation.
+func (m *AgentActionMutation) ActionNameCleared() bool {
+	_, ok := m.clearedFields[agentaction.FieldActionName]
+	return ok
+}
+
+// ResetActionName resets all changes to the "action_name" field.
+func (m *AgentActionMutation) ResetActionName() {
+	m.action_name = nil
+	delete(m.clearedFields, agentaction.FieldActionName)
+}
+
+// SetParameters sets the "parameters" field.
+func (m *AgentActionMutation) SetParameters(value map[string]interface{}) {
+	m.parameters = &value
+}
+
+// Parameters returns the value of the "parameters" field in the mutation.
+func (m *AgentActionMutation) Parameters() (r map[string]interface{}, exists bool) {
+	v := m.parameters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParameters returns the old "parameters" field's value of the AgentAction entity.
+// If the AgentAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentActionMutation) OldParameters(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParameters is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParameters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParameters: %w", err)
+	}
+	return oldValue.Parameters, nil
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (m *AgentActionMutation) ClearParameters() {
+	m.parameters = nil
+	m.clearedFields[agentaction.FieldParameters] = struct{}{}
+}
+
+// ParametersCleared returns if the "parameters" field was cleared in this mutation.
+func (m *AgentActionMutation) ParametersCleared() bool {
+	_, ok := m.clearedFields[agentaction.FieldParameters]
+	return ok
+}
+
+// ResetParameters resets all changes to the "parameters" field.
+func (m *AgentActionMutation) ResetParameters() {
+	m.parameters = nil
+	delete(m.clearedFields, agentaction.FieldParameters)
+}
+
+// SetTargetResource sets the "target_resource" field.
+func (m *AgentActionMutation) SetTargetResource(s string) {
+	m.target_resource = &s
+}
+
+// TargetResource returns the value of the "target_resource" field in the mutation.
+func (m *AgentActionMutation) TargetResource() (r string, exists bool) {
+	v := m.target_resource
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetResource returns the old "target_resource" field's value of the AgentAction entity.
+// If the AgentAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentActionMutation) OldTargetResource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetResource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetResource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetResource: %w", err)
+	}
+	return oldValue.TargetResource, nil
+}
+
+// ClearTargetResource clears the value of the "target_resource" field.
+func (m *AgentActionMutation) ClearTargetResource() {
+	m.target_resource = nil
+	m.clearedFields[agentaction.FieldTargetResource] = struct{}{}
+}
+
+// TargetResourceCleared returns if the "target_resource" field was cleared in this mutation.
+func (m *AgentActionMutation) TargetResourceCleared() bool {
+	_, ok := m.clearedFields[agentaction.FieldTargetResource]
+	return ok
+}
+
+// ResetTargetResource resets all changes to the "target_resource" field.
+func (m *AgentActionMutation) ResetTargetResource() {
+	m.target_resource = nil
+	delete(m.clearedFields, agentaction.FieldTargetResource)
+}
+
+// SetStatus sets the "status" field.
+func (m *AgentActionMutation) SetStatus(a agentaction.Status) {
+	m.status = &a
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *AgentActionMutation) Status() (r agentaction.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the AgentAction entity.
+// If the AgentAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentActionMutation) OldStatus(ctx context.Context) (v agentaction.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *AgentActionMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetResult sets the "result" field.
+func (m *AgentActionMutation) SetResult(s string) {
+	m.result = &s
+}
+
+// Result returns the value of the "result" field in the mutation.
+func (m *AgentActionMutation) Result() (r string, exists bool) {
+	v := m.result
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResult returns the old "result" field's value of the AgentAction entity.
+// If the AgentAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentActionMutation) OldResult(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResult is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResult requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResult: %w", err)
+	}
+	return oldValue.Result, nil
+}
+
+// ClearResult clears the value of the "result" field.
+func (m *AgentActionMutation) ClearResult() {
+	m.result = nil
+	m.clearedFields[agentaction.FieldResult] = struct{}{}
+}
+
+// ResultCleared returns if the "result" field was cleared in this mutation.
+func (m *AgentActionMutation) ResultCleared() bool {
+	_, ok := m.clearedFields[agentaction.FieldResult]
+	return ok
+}
+
+// ResetResult resets all changes to the "result" field.
+func (m *AgentActionMutation) ResetResult() {
+	m.result = nil
+	delete(m.clearedFields, agentaction.FieldResult)
+}
+
+// SetError sets the "error" field.
+func (m *AgentActionMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *AgentActionMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the AgentAction entity.
+// If the AgentAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentActionMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *AgentActionMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[agentaction.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *AgentActionMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[agentaction.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *AgentActionMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, agentaction.FieldError)
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (m *AgentActionMutation) SetLatencyMs(f float64) {
+	m.latency_ms = &f
+	m.addlatency_ms = nil
+}
+
+// LatencyMs returns the value of the "latency_ms" field in the mutation.
+func (m *AgentActionMutation) LatencyMs() (r float64, exists bool) {
+	v := m.latency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatencyMs returns the old "latency_ms" field's value of the AgentAction entity.
+// If the AgentAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentActionMutation) OldLatencyMs(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatencyMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatencyMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatencyMs: %w", err)
+	}
+	return oldValue.LatencyMs, nil
+}
+
+// AddLatencyMs adds f to the "latency_ms" field.
+func (m *AgentActionMutation) AddLatencyMs(f float64) {
+	if m.addlatency_ms != nil {
+		*m.addlatency_ms += f
+	} else {
+		m.addlatency_ms = &f
+	}
+}
+
+// AddedLatencyMs returns the value that was added to the "latency_ms" field in this mutation.
+func (m *AgentActionMutation) AddedLatencyMs() (r float64, exists bool) {
+	v := m.addlatency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLatencyMs clears the value of the "latency_ms" field.
+func (m *AgentActionMutation) ClearLatencyMs() {
+	m.latency_ms = nil
+	m.addlatency_ms = nil
+	m.clearedFields[agentaction.FieldLatencyMs] = struct{}{}
+}
+
+// LatencyMsCleared returns if the "latency_ms" field was cleared in this mutation.
+func (m *AgentActionMutation) LatencyMsCleared() bool {
+	_, ok := m.clearedFields[agentaction.FieldLatencyMs]
+	return ok
+}
+
+// ResetLatencyMs resets all changes to the "latency_ms" field.
+func (m *AgentActionMutation) ResetLatencyMs() {
+	m.latency_ms = nil
+	m.addlatency_ms = nil
+	delete(m.clearedFields, agentaction.FieldLatencyMs)
+}
+
+// SetSessionID sets the "session_id" field.
+func (m *AgentActionMutation) SetSessionID(s string) {
+	m.session_id = &s
+}
+
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *AgentActionMutation) SessionID() (r string, exists bool) {
+	v := m.session_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionID returns the old "session_id" field's value of the AgentAction entity.
+// If the AgentAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentActionMutation) OldSessionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+	}
+	return oldValue.SessionID, nil
+}
+
+// ClearSessionID clears the value of the "session_id" field.
+func (m *AgentActionMutation) ClearSessionID() {
+	m.session_id = nil
+	m.clearedFields[agentaction.FieldSessionID] = struct{}{}
+}
+
+// SessionIDCleared returns if the "session_id" field was cleared in this mutation.
+func (m *AgentActionMutation) SessionIDCleared() bool {
+	_, ok := m.clearedFields[agentaction.FieldSessionID]
+	return ok
+}
+
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *AgentActionMutation) ResetSessionID() {
+	m.session_id = nil
+	delete(m.clearedFields, agentaction.FieldSessionID)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *AgentActionMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *AgentActionMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the AgentAction entity.
+// If the AgentAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentActionMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *AgentActionMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[agentaction.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *AgentActionMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[agentaction.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *AgentActionMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, agentaction.FieldUserID)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *AgentActionMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *AgentActionMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the AgentAction entity.
+// If the AgentAction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AgentActionMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *AgentActionMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[agentaction.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *AgentActionMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[agentaction.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *AgentActionMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, agentaction.FieldMetadata)
+}
+
+// AddDecisionIDs adds the "decisions" edge to the RoutingDecision entity by ids.
+func (m *AgentActionMutation) AddDecisionIDs(ids ...string) {
+	if m.decisions == nil {
+		m.decisions = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.decisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDecisions clears the "decisions" edge to the RoutingDecision entity.
+func (m *AgentActionMutation) ClearDecisions() {
+	m.cleareddecisions = true
+}
+
+// DecisionsCleared reports if the "decisions" edge to the RoutingDecision entity was cleared.
+func (m *AgentActionMutation) DecisionsCleared() bool {
+	return m.cleareddecisions
+}
+
+// RemoveDecisionIDs removes the "decisions" edge to the RoutingDecision entity by IDs.
+func (m *AgentActionMutation) RemoveDecisionIDs(ids ...string) {
+	if m.removeddecisions == nil {
+		m.removeddecisions = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.decisions, ids[i])
+		m.removeddecisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDecisions returns the removed IDs of the "decisions" edge to the RoutingDecision entity.
+func (m *AgentActionMutation) RemovedDecisionsIDs() (ids []string) {
+	for id := range m.removeddecisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DecisionsIDs returns the "decisions" edge IDs in the mutation.
+func (m *AgentActionMutation) DecisionsIDs() (ids []string) {
+	for id := range m.decisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDecisions resets all changes to the "decisions" edge.
+func (m *AgentActionMutation) ResetDecisions() {
+	m.decisions = nil
+	m.cleareddecisions = false
+	m.removeddecisions = nil
+}
+
+// AddWorkflowIDs adds the "workflows" edge to the WorkflowExecution entity by ids.
+func (m *AgentActionMutation) AddWorkflowIDs(ids ...string) {
+	if m.workflows == nil {
+		m.workflows = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.workflows[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWorkflows clears the "workflows" edge to the WorkflowExecution entity.
+func (m *AgentActionMutation) ClearWorkflows() {
+	m.clearedworkflows = true
+}
+
+// WorkflowsCleared reports if the "workflows" edge to the WorkflowExecution entity was cleared.
+func (m *AgentActionMutation) WorkflowsCleared() bool {
+	return m.clearedworkflows
+}
+
+// RemoveWorkflowIDs removes the "workflows" edge to the WorkflowExecution entity by IDs.
+func (m *AgentActionMutation) RemoveWorkflowIDs(ids ...string) {
+	if m.removedworkflows == nil {
+		m.removedworkflows = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.workflows, ids[i])
+		m.removedworkflows[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWorkflows returns the removed IDs of the "workflows" edge to the WorkflowExecution entity.
+func (m *AgentActionMutation) RemovedWorkflowsIDs() (ids []string) {
+	for id := range m.removedworkflows {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WorkflowsIDs returns the "workflows" edge IDs in the mutation.
+func (m *AgentActionMutation) WorkflowsIDs() (ids []string) {
+	for id := range m.workflows {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWorkflows resets all changes to the "workflows" edge.
+func (m *AgentActionMutation) ResetWorkflows() {
+	m.workflows = nil
+	m.clearedworkflows = false
+	m.removedworkflows = nil
+}
+
+// Where appends a list predicates to the AgentActionMutation builder.
+func (m *AgentActionMutation) Where(ps ...predicate.AgentAction) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AgentActionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AgentActionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AgentAction, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AgentActionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AgentActionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AgentAction).
+func (m *AgentActionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AgentActionMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.provenance_hash != nil {
+		fields = append(fields, agentaction.FieldProvenanceHash)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, agentaction.FieldTimestamp)
+	}
+	if m.agent_id != nil {
+		fields = append(fields, agentaction.FieldAgentID)
+	}
+	if m.agent_type != nil {
+		fields = append(fields, agentaction.FieldAgentType)
+	}
+	if m.action_type != nil {
+		fields = append(fields, agentaction.FieldActionType)
+	}
+	if m.action_name != nil {
+		fields = append(fields, agentaction.FieldActionName)
+	}
+	if m.parameters != nil {
+		fields = append(fields, agentaction.FieldParameters)
+	}
+	if m.target_resource != nil {
+		fields = append(fields, agentaction.FieldTargetResource)
+	}
+	if m.status != nil {
+		fields = append(fields, agentaction.FieldStatus)
+	}
+	if m.result != nil {
+		fields = append(fields, agentaction.FieldResult)
+	}
+	if m.error != nil {
+		fields = append(fields, agentaction.FieldError)
+	}
+	if m.latency_ms != nil {
+		fields = append(fields, agentaction.FieldLatencyMs)
+	}
+	if m.session_id != nil {
+		fields = append(fields, agentaction.FieldSessionID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, agentaction.FieldUserID)
+	}
+	if m.metadata != nil {
+		fields = append(fields, agentaction.FieldMetadata)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AgentActionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case agentaction.FieldProvenanceHash:
+		return m.ProvenanceHash()
+	case agentaction.FieldTimestamp:
+		return m.Timestamp()
+	case agentaction.FieldAgentID:
+		return m.AgentID()
+	case agentaction.FieldAgentType:
+		return m.AgentType()
+	case agentaction.FieldActionType:
+		return m.ActionType()
+	case agentaction.FieldActionName:
+		return m.ActionName()
+	case agentaction.FieldParameters:
+		return m.Parameters()
+	case agentaction.FieldTargetResource:
+		return m.TargetResource()
+	case agentaction.FieldStatus:
+		return m.Status()
+	case agentaction.FieldResult:
+		return m.Result()
+	case agentaction.FieldError:
+		return m.Error()
+	case agentaction.FieldLatencyMs:
+		return m.LatencyMs()
+	case agentaction.FieldSessionID:
+		return m.SessionID()
+	case agentaction.FieldUserID:
+		return m.UserID()
+	case agentaction.FieldMetadata:
+		return m.Metadata()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AgentActionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case agentaction.FieldProvenanceHash:
+		return m.OldProvenanceHash(ctx)
+	case agentaction.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case agentaction.FieldAgentID:
+		return m.OldAgentID(ctx)
+	case agentaction.FieldAgentType:
+		return m.OldAgentType(ctx)
+	case agentaction.FieldActionType:
+		return m.OldActionType(ctx)
+	case agentaction.FieldActionName:
+		return m.OldActionName(ctx)
+	case agentaction.FieldParameters:
+		return m.OldParameters(ctx)
+	case agentaction.FieldTargetResource:
+		return m.OldTargetResource(ctx)
+	case agentaction.FieldStatus:
+		return m.OldStatus(ctx)
+	case agentaction.FieldResult:
+		return m.OldResult(ctx)
+	case agentaction.FieldError:
+		return m.OldError(ctx)
+	case agentaction.FieldLatencyMs:
+		return m.OldLatencyMs(ctx)
+	case agentaction.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case agentaction.FieldUserID:
+		return m.OldUserID(ctx)
+	case agentaction.FieldMetadata:
+		return m.OldMetadata(ctx)
+	}
+	return nil, fmt.Errorf("unknown AgentAction field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AgentActionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case agentaction.FieldProvenanceHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvenanceHash(v)
+		return nil
+	case agentaction.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case agentaction.FieldAgentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAgentID(v)
+		return nil
+	case agentaction.FieldAgentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAgentType(v)
+		return nil
+	case agentaction.FieldActionType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActionType(v)
+		return nil
+	case agentaction.FieldActionName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActionName(v)
+		return nil
+	case agentaction.FieldParameters:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParameters(v)
+		return nil
+	case agentaction.FieldTargetResource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetResource(v)
+		return nil
+	case agentaction.FieldStatus:
+		v, ok := value.(agentaction.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case agentaction.FieldResult:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResult(v)
+		return nil
+	case agentaction.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case agentaction.FieldLatencyMs:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatencyMs(v)
+		return nil
+	case agentaction.FieldSessionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionID(v)
+		return nil
+	case agentaction.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case agentaction.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AgentAction field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AgentActionMutation) AddedFields() []string {
+	var fields []string
+	if m.addlatency_ms != nil {
+		fields = append(fields, agentaction.FieldLatencyMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AgentActionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case agentaction.FieldLatencyMs:
+		return m.AddedLatencyMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AgentActionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case agentaction.FieldLatencyMs:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatencyMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AgentAction numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AgentActionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(agentaction.FieldProvenanceHash) {
+		fields = append(fields, agentaction.FieldProvenanceHash)
+	}
+	if m.FieldCleared(agentaction.FieldActionName) {
+		fields = append(fields, agentaction.FieldActionName)
+	}
+	if m.FieldCleared(agentaction.FieldParameters) {
+		fields = append(fields, agentaction.FieldParameters)
+	}
+	if m.FieldCleared(agentaction.FieldTargetResource) {
+		fields = append(fields, agentaction.FieldTargetResource)
+	}
+	if m.FieldCleared(agentaction.FieldResult) {
+		fields = append(fields, agentaction.FieldResult)
+	}
+	if m.FieldCleared(agentaction.FieldError) {
+		fields = append(fields, agentaction.FieldError)
+	}
+	if m.FieldCleared(agentaction.FieldLatencyMs) {
+		fields = append(fields, agentaction.FieldLatencyMs)
+	}
+	if m.FieldCleared(agentaction.FieldSessionID) {
+		fields = append(fields, agentaction.FieldSessionID)
+	}
+	if m.FieldCleared(agentaction.FieldUserID) {
+		fields = append(fields, agentaction.FieldUserID)
+	}
+	if m.FieldCleared(agentaction.FieldMetadata) {
+		fields = append(fields, agentaction.FieldMetadata)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AgentActionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AgentActionMutation) ClearField(name string) error {
+	switch name {
+	case agentaction.FieldProvenanceHash:
+		m.ClearProvenanceHash()
+		return nil
+	case agentaction.FieldActionName:
+		m.ClearActionName()
+		return nil
+	case agentaction.FieldParameters:
+		m.ClearParameters()
+		return nil
+	case agentaction.FieldTargetResource:
+		m.ClearTargetResource()
+		return nil
+	case agentaction.FieldResult:
+		m.ClearResult()
+		return nil
+	case agentaction.FieldError:
+		m.ClearError()
+		return nil
+	case agentaction.FieldLatencyMs:
+		m.ClearLatencyMs()
+		return nil
+	case agentaction.FieldSessionID:
+		m.ClearSessionID()
+		return nil
+	case agentaction.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case agentaction.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown AgentAction nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AgentActionMutation) ResetField(name string) error {
+	switch name {
+	case agentaction.FieldProvenanceHash:
+		m.ResetProvenanceHash()
+		return nil
+	case agentaction.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	case agentaction.FieldAgentID:
+		m.ResetAgentID()
+		return nil
+	case agentaction.FieldAgentType:
+		m.ResetAgentType()
+		return nil
+	case agentaction.FieldActionType:
+		m.ResetActionType()
+		return nil
+	case agentaction.FieldActionName:
+		m.ResetActionName()
+		return nil
+	case agentaction.FieldParameters:
+		m.ResetParameters()
+		return nil
+	case agentaction.FieldTargetResource:
+		m.ResetTargetResource()
+		return nil
+	case agentaction.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case agentaction.FieldResult:
+		m.ResetResult()
+		return nil
+	case agentaction.FieldError:
+		m.ResetError()
+		return nil
+	case agentaction.FieldLatencyMs:
+		m.ResetLatencyMs()
+		return nil
+	case agentaction.FieldSessionID:
+		m.ResetSessionID()
+		return nil
+	case agentaction.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case agentaction.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown AgentAction field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AgentActionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.decisions != nil {
+		edges = append(edges, agentaction.EdgeDecisions)
+	}
+	if m.workflows != nil {
+		edges = append(edges, agentaction.EdgeWorkflows)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AgentActionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case agentaction.EdgeDecisions:
+		ids := make([]ent.Value, 0, len(m.decisions))
+		for id := range m.decisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case agentaction.EdgeWorkflows:
+		ids := make([]ent.Value, 0, len(m.workflows))
+		for id := range m.workflows {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AgentActionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddecisions != nil {
+		edges = append(edges, agentaction.EdgeDecisions)
+	}
+	if m.removedworkflows != nil {
+		edges = append(edges, agentaction.EdgeWorkflows)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AgentActionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case agentaction.EdgeDecisions:
+		ids := make([]ent.Value, 0, len(m.removeddecisions))
+		for id := range m.removeddecisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case agentaction.EdgeWorkflows:
+		ids := make([]ent.Value, 0, len(m.removedworkflows))
+		for id := range m.removedworkflows {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AgentActionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareddecisions {
+		edges = append(edges, agentaction.EdgeDecisions)
+	}
+	if m.clearedworkflows {
+		edges = append(edges, agentaction.EdgeWorkflows)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AgentActionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case agentaction.EdgeDecisions:
+		return m.cleareddecisions
+	case agentaction.EdgeWorkflows:
+		return m.clearedworkflows
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AgentActionMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AgentAction unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AgentActionMutation) ResetEdge(name string) error {
+	switch name {
+	case agentaction.EdgeDecisions:
+		m.ResetDecisions()
+		return nil
+	case agentaction.EdgeWorkflows:
+		m.ResetWorkflows()
+		return nil
+	}
+	return fmt.Errorf("unknown AgentAction edge %s", name)
+}
+
+// ExternalOutputMutation represents an operation that mutates the ExternalOutput nodes in the graph.
+type ExternalOutputMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	provenance_hash    *string
+	timestamp          *time.Time
+	output_type        *externaloutput.OutputType
+	destination        *string
+	destination_id     *string
+	transaction_id     *string
+	block_hash         *string
+	block_number       *int64
+	addblock_number    *int64
+	content_hash       *string
+	content_size       *int64
+	addcontent_size    *int64
+	status             *externaloutput.Status
+	domain             *string
+	compliance         *map[string]interface{}
+	retention_years    *int
+	addretention_years *int
+	metadata           *map[string]interface{}
+	clearedFields      map[string]struct{}
+	workflows          map[string]struct{}
+	removedworkflows   map[string]struct{}
+	clearedworkflows   bool
+	done               bool
+	oldValue           func(context.Context) (*ExternalOutput, error)
+	predicates         []predicate.ExternalOutput
+}
+
+var _ ent.Mutation = (*ExternalOutputMutation)(nil)
+
+// externaloutputOption allows management of the mutation configuration using functional options.
+type externaloutputOption func(*ExternalOutputMutation)
+
+// newExternalOutputMutation creates new mutation for the ExternalOutput entity.
+func newExternalOutputMutation(c config, op Op, opts ...externaloutputOption) *ExternalOutputMutation {
+	m := &ExternalOutputMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExternalOutput,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExternalOutputID sets the ID field of the mutation.
+func withExternalOutputID(id string) externaloutputOption {
+	return func(m *ExternalOutputMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExternalOutput
+		)
+		m.oldValue = func(ctx context.Context) (*ExternalOutput, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExternalOutput.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExternalOutput sets the old ExternalOutput of the mutation.
+func withExternalOutput(node *ExternalOutput) externaloutputOption {
+	return func(m *ExternalOutputMutation) {
+		m.oldValue = func(context.Context) (*ExternalOutput, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExternalOutputMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExternalOutputMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ExternalOutput entities.
+func (m *ExternalOutputMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExternalOutputMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExternalOutputMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExternalOutput.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProvenanceHash sets the "provenance_hash" field.
+func (m *ExternalOutputMutation) SetProvenanceHash(s string) {
+	m.provenance_hash = &s
+}
+
+// ProvenanceHash returns the value of the "provenance_hash" field in the mutation.
+func (m *ExternalOutputMutation) ProvenanceHash() (r string, exists bool) {
+	v := m.provenance_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvenanceHash returns the old "provenance_hash" field's value of the ExternalOutput entity.
+// If the ExternalOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalOutputMutation) OldProvenanceHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvenanceHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvenanceHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvenanceHash: %w", err)
+	}
+	return oldValue.ProvenanceHash, nil
+}
+
+// ClearProvenanceHash clears the value of the "provenance_hash" field.
+func (m *ExternalOutputMutation) ClearProvenanceHash() {
+	m.provenance_hash = nil
+	m.clearedFields[externaloutput.FieldProvenanceHash] = struct{}{}
+}
+
+// ProvenanceHashCleared returns if the "provenance_hash" field was cleared in this mutation.
+func (m *ExternalOutputMutation) ProvenanceHashCleared() bool {
+	_, ok := m.clearedFields[externaloutput.FieldProvenanceHash]
+	return ok
+}
+
+// ResetProvenanceHash resets all changes to the "provenance_hash" field.
+func (m *ExternalOutputMutation) ResetProvenanceHash() {
+	m.provenance_hash = nil
+	delete(m.clearedFields, externaloutput.FieldProvenanceHash)
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *ExternalOutputMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *ExternalOutputMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the ExternalOutput entity.
+// If the ExternalOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalOutputMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *ExternalOutputMutation) ResetTimestamp() {
+	m.timestamp = nil
+}
+
+// SetOutputType sets the "output_type" field.
+func (m *ExternalOutputMutation) SetOutputType(et externaloutput.OutputType) {
+	m.output_type = &et
+}
+
+// OutputType returns the value of the "output_type" field in the mutation.
+func (m *ExternalOutputMutation) OutputType() (r externaloutput.OutputType, exists bool) {
+	v := m.output_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutputType returns the old "output_type" field's value of the ExternalOutput entity.
+// If the ExternalOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalOutputMutation) OldOutputType(ctx context.Context) (v externaloutput.OutputType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutputType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutputType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutputType: %w", err)
+	}
+	return oldValue.OutputType, nil
+}
+
+// ResetOutputType resets all changes to the "output_type" field.
+func (m *ExternalOutputMutation) ResetOutputType() {
+	m.output_type = nil
+}
+
+// SetDestination sets the "destination" field.
+func (m *ExternalOutputMutation) SetDestination(s string) {
+	m.destination = &s
+}
+
+// Destination returns the value of the "destination" field in the mutation.
+func (m *ExternalOutputMutation) Destination() (r string, exists bool) {
+	v := m.destination
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDestination returns the old "destination" field's value of the ExternalOutput entity.
+// If the ExternalOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalOutputMutation) OldDestination(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDestination is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDestination requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDestination: %w", err)
+	}
+	return oldValue.Destination, nil
+}
+
+// ClearDestination clears the value of the "destination" field.
+func (m *ExternalOutputMutation) ClearDestination() {
+	m.destination = nil
+	m.clearedFields[externaloutput.FieldDestination] = struct{}{}
+}
+
+// DestinationCleared returns if the "destination" field was cleared in this mutation.
+func (m *ExternalOutputMutation) DestinationCleared() bool {
+	_, ok := m.clearedFields[externaloutput.FieldDestination]
+	return ok
+}
+
+// ResetDestination resets all changes to the "destination" field.
+func (m *ExternalOutputMutation) ResetDestination() {
+	m.destination = nil
+	delete(m.clearedFields, externaloutput.FieldDestination)
+}
+
+// SetDestinationID sets the "destination_id" field.
+func (m *ExternalOutputMutation) SetDestinationID(s string) {
+	m.destination_id = &s
+}
+
+// DestinationID returns the value of the "destination_id" field in the mutation.
+func (m *ExternalOutputMutation) DestinationID() (r string, exists bool) {
+	v := m.destination_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDestinationID returns the old "destination_id" field's value of the ExternalOutput entity.
+// If the ExternalOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalOutputMutation) OldDestinationID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDestinationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDestinationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDestinationID: %w", err)
+	}
+	return oldValue.DestinationID, nil
+}
+
+// ClearDestinationID clears the value of the "destination_id" field.
+func (m *ExternalOutputMutation) ClearDestinationID() {
+	m.destination_id = nil
+	m.clearedFields[externaloutput.FieldDestinationID] = struct{}{}
+}
+
+// DestinationIDCleared returns if the "destination_id" field was cleared in this mutation.
+func (m *ExternalOutputMutation) DestinationIDCleared() bool {
+	_, ok := m.clearedFields[externaloutput.FieldDestinationID]
+	return ok
+}
+
+// ResetDestinationID resets all changes to the "destination_id" field.
+func (m *ExternalOutputMutation) ResetDestinationID() {
+	m.destination_id = nil
+	delete(m.clearedFields, externaloutput.FieldDestinationID)
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (m *ExternalOutputMutation) SetTransactionID(s string) {
+	m.transaction_id = &s
+}
+
+// TransactionID returns the value of the "transaction_id" field in the mutation.
+func (m *ExternalOutputMutation) TransactionID() (r string, exists bool) {
+	v := m.transaction_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransactionID returns the old "transaction_id" field's value of the ExternalOutput entity.
+// If the ExternalOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalOutputMutation) OldTransactionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransactionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransactionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransactionID: %w", err)
+	}
+	return oldValue.TransactionID, nil
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (m *ExternalOutputMutation) ClearTransactionID() {
+	m.transaction_id = nil
+	m.clearedFields[externaloutput.FieldTransactionID] = struct{}{}
+}
+
+// TransactionIDCleared returns if the "transaction_id" field was cleared in this mutation.
+func (m *ExternalOutputMutation) TransactionIDCleared() bool {
+	_, ok := m.clearedFields[externaloutput.FieldTransactionID]
+	return ok
+}
+
+// ResetTransactionID resets all changes to the "transaction_id" field.
+func (m *ExternalOutputMutation) ResetTransactionID() {
+	m.transaction_id = nil
+	delete(m.clearedFields, externaloutput.FieldTransactionID)
+}
+
+// SetBlockHash sets the "block_hash" field.
+func (m *ExternalOutputMutation) SetBlockHash(s string) {
+	m.block_hash = &s
+}
+
+// BlockHash returns the value of the "block_hash" field in the mutation.
+func (m *ExternalOutputMutation) BlockHash() (r string, exists bool) {
+	v := m.block_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockHash returns the old "block_hash" field's value of the ExternalOutput entity.
+// If the ExternalOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalOutputMutation) OldBlockHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockHash: %w", err)
+	}
+	return oldValue.BlockHash, nil
+}
+
+// ClearBlockHash clears the value of the "block_hash" field.
+func (m *ExternalOutputMutation) ClearBlockHash() {
+	m.block_hash = nil
+	m.clearedFields[externaloutput.FieldBlockHash] = struct{}{}
+}
+
+// BlockHashCleared returns if the "block_hash" field was cleared in this mutation.
+func (m *ExternalOutputMutation) BlockHashCleared() bool {
+	_, ok := m.clearedFields[externaloutput.FieldBlockHash]
+	return ok
+}
+
+// ResetBlockHash resets all changes to the "block_hash" field.
+func (m *ExternalOutputMutation) ResetBlockHash() {
+	m.block_hash = nil
+	delete(m.clearedFields, externaloutput.FieldBlockHash)
+}
+
+// SetBlockNumber sets the "block_number" field.
+func (m *ExternalOutputMutation) SetBlockNumber(i int64) {
+	m.block_number = &i
+	m.addblock_number = nil
+}
+
+// BlockNumber returns the value of the "block_number" field in the mutation.
+func (m *ExternalOutputMutation) BlockNumber() (r int64, exists bool) {
+	v := m.block_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlockNumber returns the old "block_number" field's value of the ExternalOutput entity.
+// If the ExternalOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalOutputMutation) OldBlockNumber(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlockNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlockNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlockNumber: %w", err)
+	}
+	return oldValue.BlockNumber, nil
+}
+
+// AddBlockNumber adds i to the "block_number" field.
+func (m *ExternalOutputMutation) AddBlockNumber(i int64) {
+	if m.addblock_number != nil {
+		*m.addblock_number += i
+	} else {
+		m.addblock_number = &i
+	}
+}
+
+// AddedBlockNumber returns the value that was added to the "block_number" field in this mutation.
+func (m *ExternalOutputMutation) AddedBlockNumber() (r int64, exists bool) {
+	v := m.addblock_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBlockNumber clears the value of the "block_number" field.
+func (m *ExternalOutputMutation) ClearBlockNumber() {
+	m.block_number = nil
+	m.addblock_number = nil
+	m.clearedFields[externaloutput.FieldBlockNumber] = struct{}{}
+}
+
+// BlockNumberCleared returns if the "block_number" field was cleared in this mutation.
+func (m *ExternalOutputMutation) BlockNumberCleared() bool {
+	_, ok := m.clearedFields[externaloutput.FieldBlockNumber]
+	return ok
+}
+
+// ResetBlockNumber resets all changes to the "block_number" field.
+func (m *ExternalOutputMutation) ResetBlockNumber() {
+	m.block_number = nil
+	m.addblock_number = nil
+	delete(m.clearedFields, externaloutput.FieldBlockNumber)
+}
+
+// SetContentHash sets the "content_hash" field.
+func (m *ExternalOutputMutation) SetContentHash(s string) {
+	m.content_hash = &s
+}
+
+// ContentHash returns the value of the "content_hash" field in the mutation.
+func (m *ExternalOutputMutation) ContentHash() (r string, exists bool) {
+	v := m.content_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentHash returns the old "content_hash" field's value of the ExternalOutput entity.
+// If the ExternalOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalOutputMutation) OldContentHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentHash: %w", err)
+	}
+	return oldValue.ContentHash, nil
+}
+
+// ResetContentHash resets all changes to the "content_hash" field.
+func (m *ExternalOutputMutation) ResetContentHash() {
+	m.content_hash = nil
+}
+
+// SetContentSize sets the "content_size" field.
+func (m *ExternalOutputMutation) SetContentSize(i int64) {
+	m.content_size = &i
+	m.addcontent_size = nil
+}
+
+// ContentSize returns the value of the "content_size" field in the mutation.
+func (m *ExternalOutputMutation) ContentSize() (r int64, exists bool) {
+	v := m.content_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentSize returns the old "content_size" field's value of the ExternalOutput entity.
+// If the ExternalOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalOutputMutation) OldContentSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentSize: %w", err)
+	}
+	return oldValue.ContentSize, nil
+}
+
+// AddContentSize adds i to the "content_size" field.
+func (m *ExternalOutputMutation) AddContentSize(i int64) {
+	if m.addcontent_size != nil {
+		*m.addcontent_size += i
+	} else {
+		m.addcontent_size = &i
+	}
+}
+
+// AddedContentSize returns the value that was added to the "content_size" field in this mutation.
+func (m *ExternalOutputMutation) AddedContentSize() (r int64, exists bool) {
+	v := m.addcontent_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearContentSize clears the value of the "content_size" field.
+func (m *ExternalOutputMutation) ClearContentSize() {
+	m.content_size = nil
+	m.addcontent_size = nil
+	m.clearedFields[externaloutput.FieldContentSize] = struct{}{}
+}
+
+// ContentSizeCleared returns if the "content_size" field was cleared in this mutation.
+func (m *ExternalOutputMutation) ContentSizeCleared() bool {
+	_, ok := m.clearedFields[externaloutput.FieldContentSize]
+	return ok
+}
+
+// ResetContentSize resets all changes to the "content_size" field.
+func (m *ExternalOutputMutation) ResetContentSize() {
+	m.content_size = nil
+	m.addcontent_size = nil
+	delete(m.clearedFields, externaloutput.FieldContentSize)
+}
+
+// SetStatus sets the "status" field.
+func (m *ExternalOutputMutation) SetStatus(e externaloutput.Status) {
+	m.status = &e
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ExternalOutputMutation) Status() (r externaloutput.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ExternalOutput entity.
+// If the ExternalOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalOutputMutation) OldStatus(ctx context.Context) (v externaloutput.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ExternalOutputMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetDomain sets the "domain" field.
+func (m *ExternalOutputMutation) SetDomain(s string) {
+	m.domain = &s
+}
+
+// Domain returns the value of the "domain" field in the mutation.
+func (m *ExternalOutputMutation) Domain() (r string, exists bool) {
+	v := m.domain
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDomain returns the old "domain" field's value of the ExternalOutput entity.
+// If the ExternalOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalOutputMutation) OldDomain(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDomain is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDomain requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDomain: %w", err)
+	}
+	return oldValue.Domain, nil
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (m *ExternalOutputMutation) ClearDomain() {
+	m.domain = nil
+	m.clearedFields[externaloutput.FieldDomain] = struct{}{}
+}
+
+// DomainCleared returns if the "domain" field was cleared in this mutation.
+func (m *ExternalOutputMutation) DomainCleared() bool {
+	_, ok := m.clearedFields[externaloutput.FieldDomain]
+	return ok
+}
+
+// ResetDomain resets all changes to the "domain" field.
+func (m *ExternalOutputMutation) ResetDomain() {
+	m.domain = nil
+	delete(m.clearedFields, externaloutput.FieldDomain)
+}
+
+// SetCompliance sets the "compliance" field.
+func (m *ExternalOutputMutation) SetCompliance(value map[string]interface{}) {
+	m.compliance = &value
+}
+
+// Compliance returns the value of the "compliance" field in the mutation.
+func (m *ExternalOutputMutation) Compliance() (r map[string]interface{}, exists bool) {
+	v := m.compliance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompliance returns the old "compliance" field's value of the ExternalOutput entity.
+// If the ExternalOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalOutputMutation) OldCompliance(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompliance is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompliance requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompliance: %w", err)
+	}
+	return oldValue.Compliance, nil
+}
+
+// ClearCompliance clears the value of the "compliance" field.
+func (m *ExternalOutputMutation) ClearCompliance() {
+	m.compliance = nil
+	m.clearedFields[externaloutput.FieldCompliance] = struct{}{}
+}
+
+// ComplianceCleared returns if the "compliance" field was cleared in this mutation.
+func (m *ExternalOutputMutation) ComplianceCleared() bool {
+	_, ok := m.clearedFields[externaloutput.FieldCompliance]
+	return ok
+}
+
+// ResetCompliance resets all changes to the "compliance" field.
+func (m *ExternalOutputMutation) ResetCompliance() {
+	m.compliance = nil
+	delete(m.clearedFields, externaloutput.FieldCompliance)
+}
+
+// SetRetentionYears sets the "retention_years" field.
+func (m *ExternalOutputMutation) SetRetentionYears(i int) {
+	m.retention_years = &i
+	m.addretention_years = nil
+}
+
+// RetentionYears returns the value of the "retention_years" field in the mutation.
+func (m *ExternalOutputMutation) RetentionYears() (r int, exists bool) {
+	v := m.retention_years
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetentionYears returns the old "retention_years" field's value of the ExternalOutput entity.
+// If the ExternalOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalOutputMutation) OldRetentionYears(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetentionYears is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetentionYears requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetentionYears: %w", err)
+	}
+	return oldValue.RetentionYears, nil
+}
+
+// AddRetentionYears adds i to the "retention_years" field.
+func (m *ExternalOutputMutation) AddRetentionYears(i int) {
+	if m.addretention_years != nil {
+		*m.addretention_years += i
+	} else {
+		m.addretention_years = &i
+	}
+}
+
+// AddedRetentionYears returns the value that was added to the "retention_years" field in this mutation.
+func (m *ExternalOutputMutation) AddedRetentionYears() (r int, exists bool) {
+	v := m.addretention_years
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRetentionYears resets all changes to the "retention_years" field.
+func (m *ExternalOutputMutation) ResetRetentionYears() {
+	m.retention_years = nil
+	m.addretention_years = nil
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *ExternalOutputMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *ExternalOutputMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the ExternalOutput entity.
+// If the ExternalOutput object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalOutputMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *ExternalOutputMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[externaloutput.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *ExternalOutputMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[externaloutput.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *ExternalOutputMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, externaloutput.FieldMetadata)
+}
+
+// AddWorkflowIDs adds the "workflows" edge to the WorkflowExecution entity by ids.
+func (m *ExternalOutputMutation) AddWorkflowIDs(ids ...string) {
+	if m.workflows == nil {
+		m.workflows = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.workflows[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWorkflows clears the "workflows" edge to the WorkflowExecution entity.
+func (m *ExternalOutputMutation) ClearWorkflows() {
+	m.clearedworkflows = true
+}
+
+// WorkflowsCleared reports if the "workflows" edge to the WorkflowExecution entity was cleared.
+func (m *ExternalOutputMutation) WorkflowsCleared() bool {
+	return m.clearedworkflows
+}
+
+// RemoveWorkflowIDs removes the "workflows" edge to the WorkflowExecution entity by IDs.
+func (m *ExternalOutputMutation) RemoveWorkflowIDs(ids ...string) {
+	if m.removedworkflows == nil {
+		m.removedworkflows = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.workflows, ids[i])
+		m.removedworkflows[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWorkflows returns the removed IDs of the "workflows" edge to the WorkflowExecution entity.
+func (m *ExternalOutputMutation) RemovedWorkflowsIDs() (ids []string) {
+	for id := range m.removedworkflows {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WorkflowsIDs returns the "workflows" edge IDs in the mutation.
+func (m *ExternalOutputMutation) WorkflowsIDs() (ids []string) {
+	for id := range m.workflows {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWorkflows resets all changes to the "workflows" edge.
+func (m *ExternalOutputMutation) ResetWorkflows() {
+	m.workflows = nil
+	m.clearedworkflows = false
+	m.removedworkflows = nil
+}
+
+// Where appends a list predicates to the ExternalOutputMutation builder.
+func (m *ExternalOutputMutation) Where(ps ...predicate.ExternalOutput) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ExternalOutputMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExternalOutputMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExternalOutput, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ExternalOutputMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ExternalOutputMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ExternalOutput).
+func (m *ExternalOutputMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExternalOutputMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.provenance_hash != nil {
+		fields = append(fields, externaloutput.FieldProvenanceHash)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, externaloutput.FieldTimestamp)
+	}
+	if m.output_type != nil {
+		fields = append(fields, externaloutput.FieldOutputType)
+	}
+	if m.destination != nil {
+		fields = append(fields, externaloutput.FieldDestination)
+	}
+	if m.destination_id != nil {
+		fields = append(fields, externaloutput.FieldDestinationID)
+	}
+	if m.transaction_id != nil {
+		fields = append(fields, externaloutput.FieldTransactionID)
+	}
+	if m.block_hash != nil {
+		fields = append(fields, externaloutput.FieldBlockHash)
+	}
+	if m.block_number != nil {
+		fields = append(fields, externaloutput.FieldBlockNumber)
+	}
+	if m.content_hash != nil {
+		fields = append(fields, externaloutput.FieldContentHash)
+	}
+	if m.content_size != nil {
+		fields = append(fields, externaloutput.FieldContentSize)
+	}
+	if m.status != nil {
+		fields = append(fields, externaloutput.FieldStatus)
+	}
+	if m.domain != nil {
+		fields = append(fields, externaloutput.FieldDomain)
+	}
+	if m.compliance != nil {
+		fields = append(fields, externaloutput.FieldCompliance)
+	}
+	if m.retention_years != nil {
+		fields = append(fields, externaloutput.FieldRetentionYears)
+	}
+	if m.metadata != nil {
+		fields = append(fields, externaloutput.FieldMetadata)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExternalOutputMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case externaloutput.FieldProvenanceHash:
+		return m.ProvenanceHash()
+	case externaloutput.FieldTimestamp:
+		return m.Timestamp()
+	case externaloutput.FieldOutputType:
+		return m.OutputType()
+	case externaloutput.FieldDestination:
+		return m.Destination()
+	case externaloutput.FieldDestinationID:
+		return m.DestinationID()
+	case externaloutput.FieldTransactionID:
+		return m.TransactionID()
+	case externaloutput.FieldBlockHash:
+		return m.BlockHash()
+	case externaloutput.FieldBlockNumber:
+		return m.BlockNumber()
+	case externaloutput.FieldContentHash:
+		return m.ContentHash()
+	case externaloutput.FieldContentSize:
+		return m.ContentSize()
+	case externaloutput.FieldStatus:
+		return m.Status()
+	case externaloutput.FieldDomain:
+		return m.Domain()
+	case externaloutput.FieldCompliance:
+		return m.Compliance()
+	case externaloutput.FieldRetentionYears:
+		return m.RetentionYears()
+	case externaloutput.FieldMetadata:
+		return m.Metadata()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExternalOutputMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case externaloutput.FieldProvenanceHash:
+		return m.OldProvenanceHash(ctx)
+	case externaloutput.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case externaloutput.FieldOutputType:
+		return m.OldOutputType(ctx)
+	case externaloutput.FieldDestination:
+		return m.OldDestination(ctx)
+	case externaloutput.FieldDestinationID:
+		return m.OldDestinationID(ctx)
+	case externaloutput.FieldTransactionID:
+		return m.OldTransactionID(ctx)
+	case externaloutput.FieldBlockHash:
+		return m.OldBlockHash(ctx)
+	case externaloutput.FieldBlockNumber:
+		return m.OldBlockNumber(ctx)
+	case externaloutput.FieldContentHash:
+		return m.OldContentHash(ctx)
+	case externaloutput.FieldContentSize:
+		return m.OldContentSize(ctx)
+	case externaloutput.FieldStatus:
+		return m.OldStatus(ctx)
+	case externaloutput.FieldDomain:
+		return m.OldDomain(ctx)
+	case externaloutput.FieldCompliance:
+		return m.OldCompliance(ctx)
+	case externaloutput.FieldRetentionYears:
+		return m.OldRetentionYears(ctx)
+	case externaloutput.FieldMetadata:
+		return m.OldMetadata(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExternalOutput field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExternalOutputMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case externaloutput.FieldProvenanceHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvenanceHash(v)
+		return nil
+	case externaloutput.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case externaloutput.FieldOutputType:
+		v, ok := value.(externaloutput.OutputType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutputType(v)
+		return nil
+	case externaloutput.FieldDestination:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDestination(v)
+		return nil
+	case externaloutput.FieldDestinationID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDestinationID(v)
+		return nil
+	case externaloutput.FieldTransactionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransactionID(v)
+		return nil
+	case externaloutput.FieldBlockHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockHash(v)
+		return nil
+	case externaloutput.FieldBlockNumber:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlockNumber(v)
+		return nil
+	case externaloutput.FieldContentHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentHash(v)
+		return nil
+	case externaloutput.FieldContentSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentSize(v)
+		return nil
+	case externaloutput.FieldStatus:
+		v, ok := value.(externaloutput.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case externaloutput.FieldDomain:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDomain(v)
+		return nil
+	case externaloutput.FieldCompliance:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompliance(v)
+		return nil
+	case externaloutput.FieldRetentionYears:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetentionYears(v)
+		return nil
+	case externaloutput.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExternalOutput field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExternalOutputMutation) AddedFields() []string {
+	var fields []string
+	if m.addblock_number != nil {
+		fields = append(fields, externaloutput.FieldBlockNumber)
+	}
+	if m.addcontent_size != nil {
+		fields = append(fields, externaloutput.FieldContentSize)
+	}
+	if m.addretention_years != nil {
+		fields = append(fields, externaloutput.FieldRetentionYears)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExternalOutputMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case externaloutput.FieldBlockNumber:
+		return m.AddedBlockNumber()
+	case externaloutput.FieldContentSize:
+		return m.AddedContentSize()
+	case externaloutput.FieldRetentionYears:
+		return m.AddedRetentionYears()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExternalOutputMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case externaloutput.FieldBlockNumber:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBlockNumber(v)
+		return nil
+	case externaloutput.FieldContentSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddContentSize(v)
+		return nil
+	case externaloutput.FieldRetentionYears:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRetentionYears(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExternalOutput numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExternalOutputMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(externaloutput.FieldProvenanceHash) {
+		fields = append(fields, externaloutput.FieldProvenanceHash)
+	}
+	if m.FieldCleared(externaloutput.FieldDestination) {
+		fields = append(fields, externaloutput.FieldDestination)
+	}
+	if m.FieldCleared(externaloutput.FieldDestinationID) {
+		fields = append(fields, externaloutput.FieldDestinationID)
+	}
+	if m.FieldCleared(externaloutput.FieldTransactionID) {
+		fields = append(fields, externaloutput.FieldTransactionID)
+	}
+	if m.FieldCleared(externaloutput.FieldBlockHash) {
+		fields = append(fields, externaloutput.FieldBlockHash)
+	}
+	if m.FieldCleared(externaloutput.FieldBlockNumber) {
+		fields = append(fields, externaloutput.FieldBlockNumber)
+	}
+	if m.FieldCleared(externaloutput.FieldContentSize) {
+		fields = append(fields, externaloutput.FieldContentSize)
+	}
+	if m.FieldCleared(externaloutput.FieldDomain) {
+		fields = append(fields, externaloutput.FieldDomain)
+	}
+	if m.FieldCleared(externaloutput.FieldCompliance) {
+		fields = append(fields, externaloutput.FieldCompliance)
+	}
+	if m.FieldCleared(externaloutput.FieldMetadata) {
+		fields = append(fields, externaloutput.FieldMetadata)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExternalOutputMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExternalOutputMutation) ClearField(name string) error {
+	switch name {
+	case externaloutput.FieldProvenanceHash:
+		m.ClearProvenanceHash()
+		return nil
+	case externaloutput.FieldDestination:
+		m.ClearDestination()
+		return nil
+	case externaloutput.FieldDestinationID:
+		m.ClearDestinationID()
+		return nil
+	case externaloutput.FieldTransactionID:
+		m.ClearTransactionID()
+		return nil
+	case externaloutput.FieldBlockHash:
+		m.ClearBlockHash()
+		return nil
+	case externaloutput.FieldBlockNumber:
+		m.ClearBlockNumber()
+		return nil
+	case externaloutput.FieldContentSize:
+		m.ClearContentSize()
+		return nil
+	case externaloutput.FieldDomain:
+		m.ClearDomain()
+		return nil
+	case externaloutput.FieldCompliance:
+		m.ClearCompliance()
+		return nil
+	case externaloutput.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown ExternalOutput nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExternalOutputMutation) ResetField(name string) error {
+	switch name {
+	case externaloutput.FieldProvenanceHash:
+		m.ResetProvenanceHash()
+		return nil
+	case externaloutput.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	case externaloutput.FieldOutputType:
+		m.ResetOutputType()
+		return nil
+	case externaloutput.FieldDestination:
+		m.ResetDestination()
+		return nil
+	case externaloutput.FieldDestinationID:
+		m.ResetDestinationID()
+		return nil
+	case externaloutput.FieldTransactionID:
+		m.ResetTransactionID()
+		return nil
+	case externaloutput.FieldBlockHash:
+		m.ResetBlockHash()
+		return nil
+	case externaloutput.FieldBlockNumber:
+		m.ResetBlockNumber()
+		return nil
+	case externaloutput.FieldContentHash:
+		m.ResetContentHash()
+		return nil
+	case externaloutput.FieldContentSize:
+		m.ResetContentSize()
+		return nil
+	case externaloutput.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case externaloutput.FieldDomain:
+		m.ResetDomain()
+		return nil
+	case externaloutput.FieldCompliance:
+		m.ResetCompliance()
+		return nil
+	case externaloutput.FieldRetentionYears:
+		m.ResetRetentionYears()
+		return nil
+	case externaloutput.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown ExternalOutput field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExternalOutputMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.workflows != nil {
+		edges = append(edges, externaloutput.EdgeWorkflows)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExternalOutputMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case externaloutput.EdgeWorkflows:
+		ids := make([]ent.Value, 0, len(m.workflows))
+		for id := range m.workflows {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExternalOutputMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedworkflows != nil {
+		edges = append(edges, externaloutput.EdgeWorkflows)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExternalOutputMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case externaloutput.EdgeWorkflows:
+		ids := make([]ent.Value, 0, len(m.removedworkflows))
+		for id := range m.removedworkflows {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExternalOutputMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedworkflows {
+		edges = append(edges, externaloutput.EdgeWorkflows)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExternalOutputMutation) EdgeCleared(name string) bool {
+	switch name {
+	case externaloutput.EdgeWorkflows:
+		return m.clearedworkflows
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExternalOutputMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown ExternalOutput unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExternalOutputMutation) ResetEdge(name string) error {
+	switch name {
+	case externaloutput.EdgeWorkflows:
+		m.ResetWorkflows()
+		return nil
+	}
+	return fmt.Errorf("unknown ExternalOutput edge %s", name)
+}
+
+// RoutingDecisionMutation represents an operation that mutates the RoutingDecision nodes in the graph.
+type RoutingDecisionMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *string
+	provenance_hash     *string
+	timestamp           *time.Time
+	inference_id        *string
+	decision_type       *routingdecision.DecisionType
+	layer_index         *int
+	addlayer_index      *int
+	gate_probability    *float64
+	addgate_probability *float64
+	selected_model      *string
+	iteration_count     *int
+	additeration_count  *int
+	confidence          *float64
+	addconfidence       *float64
+	domain              *string
+	metadata            *map[string]interface{}
+	clearedFields       map[string]struct{}
+	spike_events        map[string]struct{}
+	removedspike_events map[string]struct{}
+	clearedspike_events bool
+	actions             map[string]struct{}
+	removedactions      map[string]struct{}
+	clearedactions      bool
+	done                bool
+	oldValue            func(context.Context) (*RoutingDecision, error)
+	predicates          []predicate.RoutingDecision
+}
+
+var _ ent.Mutation = (*RoutingDecisionMutation)(nil)
+
+// routingdecisionOption allows management of the mutation configuration using functional options.
+type routingdecisionOption func(*RoutingDecisionMutation)
+
+// newRoutingDecisionMutation creates new mutation for the RoutingDecision entity.
+func newRoutingDecisionMutation(c config, op Op, opts ...routingdecisionOption) *RoutingDecisionMutation {
+	m := &RoutingDecisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRoutingDecision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoutingDecisionID sets the ID field of the mutation.
+func withRoutingDecisionID(id string) routingdecisionOption {
+	return func(m *RoutingDecisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RoutingDecision
+		)
+		m.oldValue = func(ctx context.Context) (*RoutingDecision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RoutingDecision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRoutingDecision sets the old RoutingDecision of the mutation.
+func withRoutingDecision(node *RoutingDecision) routingdecisionOption {
+	return func(m *RoutingDecisionMutation) {
+		m.oldValue = func(context.Context) (*RoutingDecision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoutingDecisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoutingDecisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RoutingDecision entities.
+func (m *RoutingDecisionMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoutingDecisionMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoutingDecisionMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RoutingDecision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProvenanceHash sets the "provenance_hash" field.
+func (m *RoutingDecisionMutation) SetProvenanceHash(s string) {
+	m.provenance_hash = &s
+}
+
+// ProvenanceHash returns the value of the "provenance_hash" field in the mutation.
+func (m *RoutingDecisionMutation) ProvenanceHash() (r string, exists bool) {
+	v := m.provenance_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvenanceHash returns the old "provenance_hash" field's value of the RoutingDecision entity.
+// If the RoutingDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoutingDecisionMutation) OldProvenanceHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvenanceHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvenanceHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvenanceHash: %w", err)
+	}
+	return oldValue.ProvenanceHash, nil
+}
+
+// ClearProvenanceHash clears the value of the "provenance_hash" field.
+func (m *RoutingDecisionMutation) ClearProvenanceHash() {
+	m.provenance_hash = nil
+	m.clearedFields[routingdecision.FieldProvenanceHash] = struct{}{}
+}
+
+// ProvenanceHashCleared returns if the "provenance_hash" field was cleared in this mutation.
+func (m *RoutingDecisionMutation) ProvenanceHashCleared() bool {
+	_, ok := m.clearedFields[routingdecision.FieldProvenanceHash]
+	return ok
+}
+
+// ResetProvenanceHash resets all changes to the "provenance_hash" field.
+func (m *RoutingDecisionMutation) ResetProvenanceHash() {
+	m.provenance_hash = nil
+	delete(m.clearedFields, routingdecision.FieldProvenanceHash)
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *RoutingDecisionMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *RoutingDecisionMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the RoutingDecision entity.
+// If the RoutingDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoutingDecisionMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *RoutingDecisionMutation) ResetTimestamp() {
+	m.timestamp = nil
+}
+
+// SetInferenceID sets the "inference_id" field.
+func (m *RoutingDecisionMutation) SetInferenceID(s string) {
+	m.inference_id = &s
+}
+
+// InferenceID returns the value of the "inference_id" field in the mutation.
+func (m *RoutingDecisionMutation) InferenceID() (r string, exists bool) {
+	v := m.inference_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInferenceID returns the old "inference_id" field's value of the RoutingDecision entity.
+// If the RoutingDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoutingDecisionMutation) OldInferenceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInferenceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInferenceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInferenceID: %w", err)
+	}
+	return oldValue.InferenceID, nil
+}
+
+// ResetInferenceID resets all changes to the "inference_id" field.
+func (m *RoutingDecisionMutation) ResetInferenceID() {
+	m.inference_id = nil
+}
+
+// SetDecisionType sets the "decision_type" field.
+func (m *RoutingDecisionMutation) SetDecisionType(rt routingdecision.DecisionType) {
+	m.decision_type = &rt
+}
+
+// DecisionType returns the value of the "decision_type" field in the mutation.
+func (m *RoutingDecisionMutation) DecisionType() (r routingdecision.DecisionType, exists bool) {
+	v := m.decision_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDecisionType returns the old "decision_type" field's value of the RoutingDecision entity.
+// If the RoutingDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoutingDecisionMutation) OldDecisionType(ctx context.Context) (v routingdecision.DecisionType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDecisionType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDecisionType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDecisionType: %w", err)
+	}
+	return oldValue.DecisionType, nil
+}
+
+// ResetDecisionType resets all changes to the "decision_type" field.
+func (m *RoutingDecisionMutation) ResetDecisionType() {
+	m.decision_type = nil
+}
+
+// SetLayerIndex sets the "layer_index" field.
+func (m *RoutingDecisionMutation) SetLayerIndex(i int) {
+	m.layer_index = &i
+	m.addlayer_index = nil
+}
+
+// LayerIndex returns the value of the "layer_index" field in the mutation.
+func (m *RoutingDecisionMutation) LayerIndex() (r int, exists bool) {
+	v := m.layer_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLayerIndex returns the old "layer_index" field's value of the RoutingDecision entity.
+// If the RoutingDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoutingDecisionMutation) OldLayerIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLayerIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLayerIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLayerIndex: %w", err)
+	}
+	return oldValue.LayerIndex, nil
+}
+
+// AddLayerIndex adds i to the "layer_index" field.
+func (m *RoutingDecisionMutation) AddLayerIndex(i int) {
+	if m.addlayer_index != nil {
+		*m.addlayer_index += i
+	} else {
+		m.addlayer_index = &i
+	}
+}
+
+// AddedLayerIndex returns the value that was added to the "layer_index" field in this mutation.
+func (m *RoutingDecisionMutation) AddedLayerIndex() (r int, exists bool) {
+	v := m.addlayer_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLayerIndex resets all changes to the "layer_index" field.
+func (m *RoutingDecisionMutation) ResetLayerIndex() {
+	m.layer_index = nil
+	m.addlayer_index = nil
+}
+
+// SetGateProbability sets the "gate_probability" field.
+func (m *RoutingDecisionMutation) SetGateProbability(f float64) {
+	m.gate_probability = &f
+	m.addgate_probability = nil
+}
+
+// GateProbability returns the value of the "gate_probability" field in the mutation.
+func (m *RoutingDecisionMutation) GateProbability() (r float64, exists bool) {
+	v := m.gate_probability
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGateProbability returns the old "gate_probability" field's value of the RoutingDecision entity.
+// If the RoutingDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoutingDecisionMutation) OldGateProbability(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGateProbability is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGateProbability requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGateProbability: %w", err)
+	}
+	return oldValue.GateProbability, nil
+}
+
+// AddGateProbability adds f to the "gate_probability" field.
+func (m *RoutingDecisionMutation) AddGateProbability(f float64) {
+	if m.addgate_probability != nil {
+		*m.addgate_probability += f
+	} else {
+		m.addgate_probability = &f
+	}
+}
+
+// AddedGateProbability returns the value that was added to the "gate_probability" field in this mutation.
+func (m *RoutingDecisionMutation) AddedGateProbability() (r float64, exists bool) {
+	v := m.addgate_probability
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetGateProbability resets all changes to the "gate_probability" field.
+func (m *RoutingDecisionMutation) ResetGateProbability() {
+	m.gate_probability = nil
+	m.addgate_probability = nil
+}
+
+// SetSelectedModel sets the "selected_model" field.
+func (m *RoutingDecisionMutation) SetSelectedModel(s string) {
+	m.selected_model = &s
+}
+
+// SelectedModel returns the value of the "selected_model" field in the mutation.
+func (m *RoutingDecisionMutation) SelectedModel() (r string, exists bool) {
+	v := m.selected_model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSelectedModel returns the old "selected_model" field's value of the RoutingDecision entity.
+// If the RoutingDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoutingDecisionMutation) OldSelectedModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSelectedModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSelectedModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSelectedModel: %w", err)
+	}
+	return oldValue.SelectedModel, nil
+}
+
+// ClearSelectedModel clears the value of the "selected_model" field.
+func (m *RoutingDecisionMutation) ClearSelectedModel() {
+	m.selected_model = nil
+	m.clearedFields[routingdecision.FieldSelectedModel] = struct{}{}
+}
+
+// SelectedModelCleared returns if the "selected_model" field was cleared in this mutation.
+func (m *RoutingDecisionMutation) SelectedModelCleared() bool {
+	_, ok := m.clearedFields[routingdecision.FieldSelectedModel]
+	return ok
+}
+
+// ResetSelectedModel resets all changes to the "selected_model" field.
+func (m *RoutingDecisionMutation) ResetSelectedModel() {
+	m.selected_model = nil
+	delete(m.clearedFields, routingdecision.FieldSelectedModel)
+}
+
+// SetIterationCount sets the "iteration_count" field.
+func (m *RoutingDecisionMutation) SetIterationCount(i int) {
+	m.iteration_count = &i
+	m.additeration_count = nil
+}
+
+// IterationCount returns the value of the "iteration_count" field in the mutation.
+func (m *RoutingDecisionMutation) IterationCount() (r int, exists bool) {
+	v := m.iteration_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIterationCount returns the old "iteration_count" field's value of the RoutingDecision entity.
+// If the RoutingDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoutingDecisionMutation) OldIterationCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIterationCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIterationCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIterationCount: %w", err)
+	}
+	return oldValue.IterationCount, nil
+}
+
+// AddIterationCount adds i to the "iteration_count" field.
+func (m *RoutingDecisionMutation) AddIterationCount(i int) {
+	if m.additeration_count != nil {
+		*m.additeration_count += i
+	} else {
+		m.additeration_count = &i
+	}
+}
+
+// AddedIterationCount returns the value that was added to the "iteration_count" field in this mutation.
+func (m *RoutingDecisionMutation) AddedIterationCount() (r int, exists bool) {
+	v := m.additeration_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIterationCount resets all changes to the "iteration_count" field.
+func (m *RoutingDecisionMutation) ResetIterationCount() {
+	m.iteration_count = nil
+	m.additeration_count = nil
+}
+
+// SetConfidence sets the "confidence" field.
+func (m *RoutingDecisionMutation) SetConfidence(f float64) {
+	m.confidence = &f
+	m.addconfidence = nil
+}
+
+// Confidence returns the value of the "confidence" field in the mutation.
+func (m *RoutingDecisionMutation) Confidence() (r float64, exists bool) {
+	v := m.confidence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConfidence returns the old "confidence" field's value of the RoutingDecision entity.
+// If the RoutingDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoutingDecisionMutation) OldConfidence(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConfidence is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConfidence requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConfidence: %w", err)
+	}
+	return oldValue.Confidence, nil
+}
+
+// AddConfidence adds f to the "confidence" field.
+func (m *RoutingDecisionMutation) AddConfidence(f float64) {
+	if m.addconfidence != nil {
+		*m.addconfidence += f
+	} else {
+		m.addconfidence = &f
+	}
+}
+
+// AddedConfidence returns the value that was added to the "confidence" field in this mutation.
+func (m *RoutingDecisionMutation) AddedConfidence() (r float64, exists bool) {
+	v := m.addconfidence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetConfidence resets all changes to the "confidence" field.
+func (m *RoutingDecisionMutation) ResetConfidence() {
+	m.confidence = nil
+	m.addconfidence = nil
+}
+
+// SetDomain sets the "domain" field.
+func (m *RoutingDecisionMutation) SetDomain(s string) {
+	m.domain = &s
+}
+
+// Domain returns the value of the "domain" field in the mutation.
+func (m *RoutingDecisionMutation) Domain() (r string, exists bool) {
+	v := m.domain
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDomain returns the old "domain" field's value of the RoutingDecision entity.
+// If the RoutingDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoutingDecisionMutation) OldDomain(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDomain is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDomain requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDomain: %w", err)
+	}
+	return oldValue.Domain, nil
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (m *RoutingDecisionMutation) ClearDomain() {
+	m.domain = nil
+	m.clearedFields[routingdecision.FieldDomain] = struct{}{}
+}
+
+// DomainCleared returns if the "domain" field was cleared in this mutation.
+func (m *RoutingDecisionMutation) DomainCleared() bool {
+	_, ok := m.clearedFields[routingdecision.FieldDomain]
+	return ok
+}
+
+// ResetDomain resets all changes to the "domain" field.
+func (m *RoutingDecisionMutation) ResetDomain() {
+	m.domain = nil
+	delete(m.clearedFields, routingdecision.FieldDomain)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *RoutingDecisionMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *RoutingDecisionMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the RoutingDecision entity.
+// If the RoutingDecision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoutingDecisionMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *RoutingDecisionMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[routingdecision.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *RoutingDecisionMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[routingdecision.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *RoutingDecisionMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, routingdecision.FieldMetadata)
+}
+
+// AddSpikeEventIDs adds the "spike_events" edge to the SpikeEvent entity by ids.
+func (m *RoutingDecisionMutation) AddSpikeEventIDs(ids ...string) {
+	if m.spike_events == nil {
+		m.spike_events = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.spike_events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSpikeEvents clears the "spike_events" edge to the SpikeEvent entity.
+func (m *RoutingDecisionMutation) ClearSpikeEvents() {
+	m.clearedspike_events = true
+}
+
+// SpikeEventsCleared reports if the "spike_events" edge to the SpikeEvent entity was cleared.
+func (m *RoutingDecisionMutation) SpikeEventsCleared() bool {
+	return m.clearedspike_events
+}
+
+// RemoveSpikeEventIDs removes the "spike_events" edge to the SpikeEvent entity by IDs.
+func (m *RoutingDecisionMutation) RemoveSpikeEventIDs(ids ...string) {
+	if m.removedspike_events == nil {
+		m.removedspike_events = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.spike_events, ids[i])
+		m.removedspike_events[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSpikeEvents returns the removed IDs of the "spike_events" edge to the SpikeEvent entity.
+func (m *RoutingDecisionMutation) RemovedSpikeEventsIDs() (ids []string) {
+	for id := range m.removedspike_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SpikeEventsIDs returns the "spike_events" edge IDs in the mutation.
+func (m *RoutingDecisionMutation) SpikeEventsIDs() (ids []string) {
+	for id := range m.spike_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSpikeEvents resets all changes to the "spike_events" edge.
+func (m *RoutingDecisionMutation) ResetSpikeEvents() {
+	m.spike_events = nil
+	m.clearedspike_events = false
+	m.removedspike_events = nil
+}
+
+// AddActionIDs adds the "actions" edge to the AgentAction entity by ids.
+func (m *RoutingDecisionMutation) AddActionIDs(ids ...string) {
+	if m.actions == nil {
+		m.actions = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.actions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearActions clears the "actions" edge to the AgentAction entity.
+func (m *RoutingDecisionMutation) ClearActions() {
+	m.clearedactions = true
+}
+
+// ActionsCleared reports if the "actions" edge to the AgentAction entity was cleared.
+func (m *RoutingDecisionMutation) ActionsCleared() bool {
+	return m.clearedactions
+}
+
+// RemoveActionIDs removes the "actions" edge to the AgentAction entity by IDs.
+func (m *RoutingDecisionMutation) RemoveActionIDs(ids ...string) {
+	if m.removedactions == nil {
+		m.removedactions = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.actions, ids[i])
+		m.removedactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedActions returns the removed IDs of the "actions" edge to the AgentAction entity.
+func (m *RoutingDecisionMutation) RemovedActionsIDs() (ids []string) {
+	for id := range m.removedactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ActionsIDs returns the "actions" edge IDs in the mutation.
+func (m *RoutingDecisionMutation) ActionsIDs() (ids []string) {
+	for id := range m.actions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetActions resets all changes to the "actions" edge.
+func (m *RoutingDecisionMutation) ResetActions() {
+	m.actions = nil
+	m.clearedactions = false
+	m.removedactions = nil
+}
+
+// Where appends a list predicates to the RoutingDecisionMutation builder.
+func (m *RoutingDecisionMutation) Where(ps ...predicate.RoutingDecision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoutingDecisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoutingDecisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RoutingDecision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoutingDecisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoutingDecisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RoutingDecision).
+func (m *RoutingDecisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoutingDecisionMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.provenance_hash != nil {
+		fields = append(fields, routingdecision.FieldProvenanceHash)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, routingdecision.FieldTimestamp)
+	}
+	if m.inference_id != nil {
+		fields = append(fields, routingdecision.FieldInferenceID)
+	}
+	if m.decision_type != nil {
+		fields = append(fields, routingdecision.FieldDecisionType)
+	}
+	if m.layer_index != nil {
+		fields = append(fields, routingdecision.FieldLayerIndex)
+	}
+	if m.gate_probability != nil {
+		fields = append(fields, routingdecision.FieldGateProbability)
+	}
+	if m.selected_model != nil {
+		fields = append(fields, routingdecision.FieldSelectedModel)
+	}
+	if m.iteration_count != nil {
+		fields = append(fields, routingdecision.FieldIterationCount)
+	}
+	if m.confidence != nil {
+		fields = append(fields, routingdecision.FieldConfidence)
+	}
+	if m.domain != nil {
+		fields = append(fields, routingdecision.FieldDomain)
+	}
+	if m.metadata != nil {
+		fields = append(fields, routingdecision.FieldMetadata)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoutingDecisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case routingdecision.FieldProvenanceHash:
+		return m.ProvenanceHash()
+	case routingdecision.FieldTimestamp:
+		return m.Timestamp()
+	case routingdecision.FieldInferenceID:
+		return m.InferenceID()
+	case routingdecision.FieldDecisionType:
+		return m.DecisionType()
+	case routingdecision.FieldLayerIndex:
+		return m.LayerIndex()
+	case routingdecision.FieldGateProbability:
+		return m.GateProbability()
+	case routingdecision.FieldSelectedModel:
+		return m.SelectedModel()
+	case routingdecision.FieldIterationCount:
+		return m.IterationCount()
+	case routingdecision.FieldConfidence:
+		return m.Confidence()
+	case routingdecision.FieldDomain:
+		return m.Domain()
+	case routingdecision.FieldMetadata:
+		return m.Metadata()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoutingDecisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case routingdecision.FieldProvenanceHash:
+		return m.OldProvenanceHash(ctx)
+	case routingdecision.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case routingdecision.FieldInferenceID:
+		return m.OldInferenceID(ctx)
+	case routingdecision.FieldDecisionType:
+		return m.OldDecisionType(ctx)
+	case routingdecision.FieldLayerIndex:
+		return m.OldLayerIndex(ctx)
+	case routingdecision.FieldGateProbability:
+		return m.OldGateProbability(ctx)
+	case routingdecision.FieldSelectedModel:
+		return m.OldSelectedModel(ctx)
+	case routingdecision.FieldIterationCount:
+		return m.OldIterationCount(ctx)
+	case routingdecision.FieldConfidence:
+		return m.OldConfidence(ctx)
+	case routingdecision.FieldDomain:
+		return m.OldDomain(ctx)
+	case routingdecision.FieldMetadata:
+		return m.OldMetadata(ctx)
+	}
+	return nil, fmt.Errorf("unknown RoutingDecision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoutingDecisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case routingdecision.FieldProvenanceHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvenanceHash(v)
+		return nil
+	case routingdecision.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case routingdecision.FieldInferenceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInferenceID(v)
+		return nil
+	case routingdecision.FieldDecisionType:
+		v, ok := value.(routingdecision.DecisionType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDecisionType(v)
+		return nil
+	case routingdecision.FieldLayerIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLayerIndex(v)
+		return nil
+	case routingdecision.FieldGateProbability:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGateProbability(v)
+		return nil
+	case routingdecision.FieldSelectedModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSelectedModel(v)
+		return nil
+	case routingdecision.FieldIterationCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIterationCount(v)
+		return nil
+	case routingdecision.FieldConfidence:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConfidence(v)
+		return nil
+	case routingdecision.FieldDomain:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDomain(v)
+		return nil
+	case routingdecision.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoutingDecision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoutingDecisionMutation) AddedFields() []string {
+	var fields []string
+	if m.addlayer_index != nil {
+		fields = append(fields, routingdecision.FieldLayerIndex)
+	}
+	if m.addgate_probability != nil {
+		fields = append(fields, routingdecision.FieldGateProbability)
+	}
+	if m.additeration_count != nil {
+		fields = append(fields, routingdecision.FieldIterationCount)
+	}
+	if m.addconfidence != nil {
+		fields = append(fields, routingdecision.FieldConfidence)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoutingDecisionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case routingdecision.FieldLayerIndex:
+		return m.AddedLayerIndex()
+	case routingdecision.FieldGateProbability:
+		return m.AddedGateProbability()
+	case routingdecision.FieldIterationCount:
+		return m.AddedIterationCount()
+	case routingdecision.FieldConfidence:
+		return m.AddedConfidence()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoutingDecisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case routingdecision.FieldLayerIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLayerIndex(v)
+		return nil
+	case routingdecision.FieldGateProbability:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGateProbability(v)
+		return nil
+	case routingdecision.FieldIterationCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIterationCount(v)
+		return nil
+	case routingdecision.FieldConfidence:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddConfidence(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RoutingDecision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoutingDecisionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(routingdecision.FieldProvenanceHash) {
+		fields = append(fields, routingdecision.FieldProvenanceHash)
+	}
+	if m.FieldCleared(routingdecision.FieldSelectedModel) {
+		fields = append(fields, routingdecision.FieldSelectedModel)
+	}
+	if m.FieldCleared(routingdecision.FieldDomain) {
+		fields = append(fields, routingdecision.FieldDomain)
+	}
+	if m.FieldCleared(routingdecision.FieldMetadata) {
+		fields = append(fields, routingdecision.FieldMetadata)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoutingDecisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoutingDecisionMutation) ClearField(name string) error {
+	switch name {
+	case routingdecision.FieldProvenanceHash:
+		m.ClearProvenanceHash()
+		return nil
+	case routingdecision.FieldSelectedModel:
+		m.ClearSelectedModel()
+		return nil
+	case routingdecision.FieldDomain:
+		m.ClearDomain()
+		return nil
+	case routingdecision.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown RoutingDecision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoutingDecisionMutation) ResetField(name string) error {
+	switch name {
+	case routingdecision.FieldProvenanceHash:
+		m.ResetProvenanceHash()
+		return nil
+	case routingdecision.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	case routingdecision.FieldInferenceID:
+		m.ResetInferenceID()
+		return nil
+	case routingdecision.FieldDecisionType:
+		m.ResetDecisionType()
+		return nil
+	case routingdecision.FieldLayerIndex:
+		m.ResetLayerIndex()
+		return nil
+	case routingdecision.FieldGateProbability:
+		m.ResetGateProbability()
+		return nil
+	case routingdecision.FieldSelectedModel:
+		m.ResetSelectedModel()
+		return nil
+	case routingdecision.FieldIterationCount:
+		m.ResetIterationCount()
+		return nil
+	case routingdecision.FieldConfidence:
+		m.ResetConfidence()
+		return nil
+	case routingdecision.FieldDomain:
+		m.ResetDomain()
+		return nil
+	case routingdecision.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown RoutingDecision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoutingDecisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.spike_events != nil {
+		edges = append(edges, routingdecision.EdgeSpikeEvents)
+	}
+	if m.actions != nil {
+		edges = append(edges, routingdecision.EdgeActions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoutingDecisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case routingdecision.EdgeSpikeEvents:
+		ids := make([]ent.Value, 0, len(m.spike_events))
+		for id := range m.spike_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case routingdecision.EdgeActions:
+		ids := make([]ent.Value, 0, len(m.actions))
+		for id := range m.actions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoutingDecisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedspike_events != nil {
+		edges = append(edges, routingdecision.EdgeSpikeEvents)
+	}
+	if m.removedactions != nil {
+		edges = append(edges, routingdecision.EdgeActions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoutingDecisionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case routingdecision.EdgeSpikeEvents:
+		ids := make([]ent.Value, 0, len(m.removedspike_events))
+		for id := range m.removedspike_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case routingdecision.EdgeActions:
+		ids := make([]ent.Value, 0, len(m.removedactions))
+		for id := range m.removedactions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoutingDecisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedspike_events {
+		edges = append(edges, routingdecision.EdgeSpikeEvents)
+	}
+	if m.clearedactions {
+		edges = append(edges, routingdecision.EdgeActions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoutingDecisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case routingdecision.EdgeSpikeEvents:
+		return m.clearedspike_events
+	case routingdecision.EdgeActions:
+		return m.clearedactions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoutingDecisionMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RoutingDecision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoutingDecisionMutation) ResetEdge(name string) error {
+	switch name {
+	case routingdecision.EdgeSpikeEvents:
+		m.ResetSpikeEvents()
+		return nil
+	case routingdecision.EdgeActions:
+		m.ResetActions()
+		return nil
+	}
+	return fmt.Errorf("unknown RoutingDecision edge %s", name)
+}
+
+// SpikeEventMutation represents an operation that mutates the SpikeEvent nodes in the graph.
+type SpikeEventMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *string
+	provenance_hash           *string
+	timestamp                 *time.Time
+	timestamp_ns              *int64
+	addtimestamp_ns           *int64
+	population_id             *string
+	layer_index               *int
+	addlayer_index            *int
+	neuron_indices            *[]int
+	appendneuron_indices      []int
+	spike_counts              *[]int
+	appendspike_counts        []int
+	membrane_potentials       *[]float64
+	appendmembrane_potentials []float64
+	input_currents            *[]float64
+	appendinput_currents      []float64
+	pattern_hash              *string
+	inference_id              *string
+	is_emergent               *bool
+	entropy                   *float64
+	addentropy                *float64
+	metadata                  *map[string]interface{}
+	clearedFields             map[string]struct{}
+	decisions                 map[string]struct{}
+	removeddecisions          map[string]struct{}
+	cleareddecisions          bool
+	done                      bool
+	oldValue                  func(context.Context) (*SpikeEvent, error)
+	predicates                []predicate.SpikeEvent
+}
+
+var _ ent.Mutation = (*SpikeEventMutation)(nil)
+
+// spikeeventOption allows management of the mutation configuration using functional options.
+type spikeeventOption func(*SpikeEventMutation)
+
+// newSpikeEventMutation creates new mutation for the SpikeEvent entity.
+func newSpikeEventMutation(c config, op Op, opts ...spikeeventOption) *SpikeEventMutation {
+	m := &SpikeEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSpikeEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSpikeEventID sets the ID field of the mutation.
+func withSpikeEventID(id string) spikeeventOption {
+	return func(m *SpikeEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SpikeEvent
+		)
+		m.oldValue = func(ctx context.Context) (*SpikeEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SpikeEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSpikeEvent sets the old SpikeEvent of the mutation.
+func withSpikeEvent(node *SpikeEvent) spikeeventOption {
+	return func(m *SpikeEventMutation) {
+		m.oldValue = func(context.Context) (*SpikeEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SpikeEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SpikeEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SpikeEvent entities.
+func (m *SpikeEventMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SpikeEventMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SpikeEventMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SpikeEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProvenanceHash sets the "provenance_hash" field.
+func (m *SpikeEventMutation) SetProvenanceHash(s string) {
+	m.provenance_hash = &s
+}
+
+// ProvenanceHash returns the value of the "provenance_hash" field in the mutation.
+func (m *SpikeEventMutation) ProvenanceHash() (r string, exists bool) {
+	v := m.provenance_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvenanceHash returns the old "provenance_hash" field's value of the SpikeEvent entity.
+// If the SpikeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpikeEventMutation) OldProvenanceHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvenanceHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvenanceHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvenanceHash: %w", err)
+	}
+	return oldValue.ProvenanceHash, nil
+}
+
+// ClearProvenanceHash clears the value of the "provenance_hash" field.
+func (m *SpikeEventMutation) ClearProvenanceHash() {
+	m.provenance_hash = nil
+	m.clearedFields[spikeevent.FieldProvenanceHash] = struct{}{}
+}
+
+// ProvenanceHashCleared returns if the "provenance_hash" field was cleared in this mutation.
+func (m *SpikeEventMutation) ProvenanceHashCleared() bool {
+	_, ok := m.clearedFields[spikeevent.FieldProvenanceHash]
+	return ok
+}
+
+// ResetProvenanceHash resets all changes to the "provenance_hash" field.
+func (m *SpikeEventMutation) ResetProvenanceHash() {
+	m.provenance_hash = nil
+	delete(m.clearedFields, spikeevent.FieldProvenanceHash)
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *SpikeEventMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *SpikeEventMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the SpikeEvent entity.
+// If the SpikeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpikeEventMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *SpikeEventMutation) ResetTimestamp() {
+	m.timestamp = nil
+}
+
+// SetTimestampNs sets the "timestamp_ns" field.
+func (m *SpikeEventMutation) SetTimestampNs(i int64) {
+	m.timestamp_ns = &i
+	m.addtimestamp_ns = nil
+}
+
+// TimestampNs returns the value of the "timestamp_ns" field in the mutation.
+func (m *SpikeEventMutation) TimestampNs() (r int64, exists bool) {
+	v := m.timestamp_ns
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestampNs returns the old "timestamp_ns" field's value of the SpikeEvent entity.
+// If the SpikeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpikeEventMutation) OldTimestampNs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestampNs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestampNs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestampNs: %w", err)
+	}
+	return oldValue.TimestampNs, nil
+}
+
+// AddTimestampNs adds i to the "timestamp_ns" field.
+func (m *SpikeEventMutation) AddTimestampNs(i int64) {
+	if m.addtimestamp_ns != nil {
+		*m.addtimestamp_ns += i
+	} else {
+		m.addtimestamp_ns = &i
+	}
+}
+
+// AddedTimestampNs returns the value that was added to the "timestamp_ns" field in this mutation.
+func (m *SpikeEventMutation) AddedTimestampNs() (r int64, exists bool) {
+	v := m.addtimestamp_ns
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTimestampNs clears the value of the "timestamp_ns" field.
+func (m *SpikeEventMutation) ClearTimestampNs() {
+	m.timestamp_ns = nil
+	m.addtimestamp_ns = nil
+	m.clearedFields[spikeevent.FieldTimestampNs] = struct{}{}
+}
+
+// TimestampNsCleared returns if the "timestamp_ns" field was cleared in this mutation.
+func (m *SpikeEventMutation) TimestampNsCleared() bool {
+	_, ok := m.clearedFields[spikeevent.FieldTimestampNs]
+	return ok
+}
+
+// ResetTimestampNs resets all changes to the "timestamp_ns" field.
+func (m *SpikeEventMutation) ResetTimestampNs() {
+	m.timestamp_ns = nil
+	m.addtimestamp_ns = nil
+	delete(m.clearedFields, spikeevent.FieldTimestampNs)
+}
+
+// SetPopulationID sets the "population_id" field.
+func (m *SpikeEventMutation) SetPopulationID(s string) {
+	m.population_id = &s
+}
+
+// PopulationID returns the value of the "population_id" field in the mutation.
+func (m *SpikeEventMutation) PopulationID() (r string, exists bool) {
+	v := m.population_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPopulationID returns the old "population_id" field's value of the SpikeEvent entity.
+// If the SpikeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpikeEventMutation) OldPopulationID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPopulationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPopulationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPopulationID: %w", err)
+	}
+	return oldValue.PopulationID, nil
+}
+
+// ResetPopulationID resets all changes to the "population_id" field.
+func (m *SpikeEventMutation) ResetPopulationID() {
+	m.population_id = nil
+}
+
+// SetLayerIndex sets the "layer_index" field.
+func (m *SpikeEventMutation) SetLayerIndex(i int) {
+	m.layer_index = &i
+	m.addlayer_index = nil
+}
+
+// LayerIndex returns the value of the "layer_index" field in the mutation.
+func (m *SpikeEventMutation) LayerIndex() (r int, exists bool) {
+	v := m.layer_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLayerIndex returns the old "layer_index" field's value of the SpikeEvent entity.
+// If the SpikeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpikeEventMutation) OldLayerIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLayerIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLayerIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLayerIndex: %w", err)
+	}
+	return oldValue.LayerIndex, nil
+}
+
+// AddLayerIndex adds i to the "layer_index" field.
+func (m *SpikeEventMutation) AddLayerIndex(i int) {
+	if m.addlayer_index != nil {
+		*m.addlayer_index += i
+	} else {
+		m.addlayer_index = &i
+	}
+}
+
+// AddedLayerIndex returns the value that was added to the "layer_index" field in this mutation.
+func (m *SpikeEventMutation) AddedLayerIndex() (r int, exists bool) {
+	v := m.addlayer_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLayerIndex resets all changes to the "layer_index" field.
+func (m *SpikeEventMutation) ResetLayerIndex() {
+	m.layer_index = nil
+	m.addlayer_index = nil
+}
+
+// SetNeuronIndices sets the "neuron_indices" field.
+func (m *SpikeEventMutation) SetNeuronIndices(i []int) {
+	m.neuron_indices = &i
+	m.appendneuron_indices = nil
+}
+
+// NeuronIndices returns the value of the "neuron_indices" field in the mutation.
+func (m *SpikeEventMutation) NeuronIndices() (r []int, exists bool) {
+	v := m.neuron_indices
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNeuronIndices returns the old "neuron_indices" field's value of the SpikeEvent entity.
+// If the SpikeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpikeEventMutation) OldNeuronIndices(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNeuronIndices is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNeuronIndices requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNeuronIndices: %w", err)
+	}
+	return oldValue.NeuronIndices, nil
+}
+
+// AppendNeuronIndices adds i to the "neuron_indices" field.
+func (m *SpikeEventMutation) AppendNeuronIndices(i []int) {
+	m.appendneuron_indices = append(m.appendneuron_indices, i...)
+}
+
+// AppendedNeuronIndices returns the list of values that were appended to the "neuron_indices" field in this mutation.
+func (m *SpikeEventMutation) AppendedNeuronIndices() ([]int, bool) {
+	if len(m.appendneuron_indices) == 0 {
+		return nil, false
+	}
+	return m.appendneuron_indices, true
+}
+
+// ResetNeuronIndices resets all changes to the "neuron_indices" field.
+func (m *SpikeEventMutation) ResetNeuronIndices() {
+	m.neuron_indices = nil
+	m.appendneuron_indices = nil
+}
+
+// SetSpikeCounts sets the "spike_counts" field.
+func (m *SpikeEventMutation) SetSpikeCounts(i []int) {
+	m.spike_counts = &i
+	m.appendspike_counts = nil
+}
+
+// SpikeCounts returns the value of the "spike_counts" field in the mutation.
+func (m *SpikeEventMutation) SpikeCounts() (r []int, exists bool) {
+	v := m.spike_counts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpikeCounts returns the old "spike_counts" field's value of the SpikeEvent entity.
+// If the SpikeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpikeEventMutation) OldSpikeCounts(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpikeCounts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpikeCounts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpikeCounts: %w", err)
+	}
+	return oldValue.SpikeCounts, nil
+}
+
+// AppendSpikeCounts adds i to the "spike_counts" field.
+func (m *SpikeEventMutation) AppendSpikeCounts(i []int) {
+	m.appendspike_counts = append(m.appendspike_counts, i...)
+}
+
+// AppendedSpikeCounts returns the list of values that were appended to the "spike_counts" field in this mutation.
+func (m *SpikeEventMutation) AppendedSpikeCounts() ([]int, bool) {
+	if len(m.appendspike_counts) == 0 {
+		return nil, false
+	}
+	return m.appendspike_counts, true
+}
+
+// ClearSpikeCounts clears the value of the "spike_counts" field.
+func (m *SpikeEventMutation) ClearSpikeCounts() {
+	m.spike_counts = nil
+	m.appendspike_counts = nil
+	m.clearedFields[spikeevent.FieldSpikeCounts] = struct{}{}
+}
+
+// SpikeCountsCleared returns if the "spike_counts" field was cleared in this mutation.
+func (m *SpikeEventMutation) SpikeCountsCleared() bool {
+	_, ok := m.clearedFields[spikeevent.FieldSpikeCounts]
+	return ok
+}
+
+// ResetSpikeCounts resets all changes to the "spike_counts" field.
+func (m *SpikeEventMutation) ResetSpikeCounts() {
+	m.spike_counts = nil
+	m.appendspike_counts = nil
+	delete(m.clearedFields, spikeevent.FieldSpikeCounts)
+}
+
+// SetMembranePotentials sets the "membrane_potentials" field.
+func (m *SpikeEventMutation) SetMembranePotentials(f []float64) {
+	m.membrane_potentials = &f
+	m.appendmembrane_potentials = nil
+}
+
+// MembranePotentials returns the value of the "membrane_potentials" field in the mutation.
+func (m *SpikeEventMutation) MembranePotentials() (r []float64, exists bool) {
+	v := m.membrane_potentials
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMembranePotentials returns the old "membrane_potentials" field's value of the SpikeEvent entity.
+// If the SpikeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpikeEventMutation) OldMembranePotentials(ctx context.Context) (v []float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMembranePotentials is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMembranePotentials requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMembranePotentials: %w", err)
+	}
+	return oldValue.MembranePotentials, nil
+}
+
+// AppendMembranePotentials adds f to the "membrane_potentials" field.
+func (m *SpikeEventMutation) AppendMembranePotentials(f []float64) {
+	m.appendmembrane_potentials = append(m.appendmembrane_potentials, f...)
+}
+
+// AppendedMembranePotentials returns the list of values that were appended to the "membrane_potentials" field in this mutation.
+func (m *SpikeEventMutation) AppendedMembranePotentials() ([]float64, bool) {
+	if len(m.appendmembrane_potentials) == 0 {
+		return nil, false
+	}
+	return m.appendmembrane_potentials, true
+}
+
+// ClearMembranePotentials clears the value of the "membrane_potentials" field.
+func (m *SpikeEventMutation) ClearMembranePotentials() {
+	m.membrane_potentials = nil
+	m.appendmembrane_potentials = nil
+	m.clearedFields[spikeevent.FieldMembranePotentials] = struct{}{}
+}
+
+// MembranePotentialsCleared returns if the "membrane_potentials" field was cleared in this mutation.
+func (m *SpikeEventMutation) MembranePotentialsCleared() bool {
+	_, ok := m.clearedFields[spikeevent.FieldMembranePotentials]
+	return ok
+}
+
+// ResetMembranePotentials resets all changes to the "membrane_potentials" field.
+func (m *SpikeEventMutation) ResetMembranePotentials() {
+	m.membrane_potentials = nil
+	m.appendmembrane_potentials = nil
+	delete(m.clearedFields, spikeevent.FieldMembranePotentials)
+}
+
+// SetInputCurrents sets the "input_currents" field.
+func (m *SpikeEventMutation) SetInputCurrents(f []float64) {
+	m.input_currents = &f
+	m.appendinput_currents = nil
+}
+
+// InputCurrents returns the value of the "input_currents" field in the mutation.
+func (m *SpikeEventMutation) InputCurrents() (r []float64, exists bool) {
+	v := m.input_currents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInputCurrents returns the old "input_currents" field's value of the SpikeEvent entity.
+// If the SpikeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpikeEventMutation) OldInputCurrents(ctx context.Context) (v []float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInputCurrents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInputCurrents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInputCurrents: %w", err)
+	}
+	return oldValue.InputCurrents, nil
+}
+
+// AppendInputCurrents adds f to the "input_currents" field.
+func (m *SpikeEventMutation) AppendInputCurrents(f []float64) {
+	m.appendinput_currents = append(m.appendinput_currents, f...)
+}
+
+// AppendedInputCurrents returns the list of values that were appended to the "input_currents" field in this mutation.
+func (m *SpikeEventMutation) AppendedInputCurrents() ([]float64, bool) {
+	if len(m.appendinput_currents) == 0 {
+		return nil, false
+	}
+	return m.appendinput_currents, true
+}
+
+// ClearInputCurrents clears the value of the "input_currents" field.
+func (m *SpikeEventMutation) ClearInputCurrents() {
+	m.input_currents = nil
+	m.appendinput_currents = nil
+	m.clearedFields[spikeevent.FieldInputCurrents] = struct{}{}
+}
+
+// InputCurrentsCleared returns if the "input_currents" field was cleared in this mutation.
+func (m *SpikeEventMutation) InputCurrentsCleared() bool {
+	_, ok := m.clearedFields[spikeevent.FieldInputCurrents]
+	return ok
+}
+
+// ResetInputCurrents resets all changes to the "input_currents" field.
+func (m *SpikeEventMutation) ResetInputCurrents() {
+	m.input_currents = nil
+	m.appendinput_currents = nil
+	delete(m.clearedFields, spikeevent.FieldInputCurrents)
+}
+
+// SetPatternHash sets the "pattern_hash" field.
+func (m *SpikeEventMutation) SetPatternHash(s string) {
+	m.pattern_hash = &s
+}
+
+// PatternHash returns the value of the "pattern_hash" field in the mutation.
+func (m *SpikeEventMutation) PatternHash() (r string, exists bool) {
+	v := m.pattern_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPatternHash returns the old "pattern_hash" field's value of the SpikeEvent entity.
+// If the SpikeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpikeEventMutation) OldPatternHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPatternHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPatternHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPatternHash: %w", err)
+	}
+	return oldValue.PatternHash, nil
+}
+
+// ResetPatternHash resets all changes to the "pattern_hash" field.
+func (m *SpikeEventMutation) ResetPatternHash() {
+	m.pattern_hash = nil
+}
+
+// SetInferenceID sets the "inference_id" field.
+func (m *SpikeEventMutation) SetInferenceID(s string) {
+	m.inference_id = &s
+}
+
+// InferenceID returns the value of the "inference_id" field in the mutation.
+func (m *SpikeEventMutation) InferenceID() (r string, exists bool) {
+	v := m.inference_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInferenceID returns the old "inference_id" field's value of the SpikeEvent entity.
+// If the SpikeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpikeEventMutation) OldInferenceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInferenceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInferenceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInferenceID: %w", err)
+	}
+	return oldValue.InferenceID, nil
+}
+
+// ClearInferenceID clears the value of the "inference_id" field.
+func (m *SpikeEventMutation) ClearInferenceID() {
+	m.inference_id = nil
+	m.clearedFields[spikeevent.FieldInferenceID] = struct{}{}
+}
+
+// InferenceIDCleared returns if the "inference_id" field was cleared in this mutation.
+func (m *SpikeEventMutation) InferenceIDCleared() bool {
+	_, ok := m.clearedFields[spikeevent.FieldInferenceID]
+	return ok
+}
+
+// ResetInferenceID resets all changes to the "inference_id" field.
+func (m *SpikeEventMutation) ResetInferenceID() {
+	m.inference_id = nil
+	delete(m.clearedFields, spikeevent.FieldInferenceID)
+}
+
+// SetIsEmergent sets the "is_emergent" field.
+func (m *SpikeEventMutation) SetIsEmergent(b bool) {
+	m.is_emergent = &b
+}
+
+// IsEmergent returns the value of the "is_emergent" field in the mutation.
+func (m *SpikeEventMutation) IsEmergent() (r bool, exists bool) {
+	v := m.is_emergent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsEmergent returns the old "is_emergent" field's value of the SpikeEvent entity.
+// If the SpikeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpikeEventMutation) OldIsEmergent(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsEmergent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsEmergent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsEmergent: %w", err)
+	}
+	return oldValue.IsEmergent, nil
+}
+
+// ResetIsEmergent resets all changes to the "is_emergent" field.
+func (m *SpikeEventMutation) ResetIsEmergent() {
+	m.is_emergent = nil
+}
+
+// SetEntropy sets the "entropy" field.
+func (m *SpikeEventMutation) SetEntropy(f float64) {
+	m.entropy = &f
+	m.addentropy = nil
+}
+
+// Entropy returns the value of the "entropy" field in the mutation.
+func (m *SpikeEventMutation) Entropy() (r float64, exists bool) {
+	v := m.entropy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntropy returns the old "entropy" field's value of the SpikeEvent entity.
+// If the SpikeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpikeEventMutation) OldEntropy(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntropy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntropy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntropy: %w", err)
+	}
+	return oldValue.Entropy, nil
+}
+
+// AddEntropy adds f to the "entropy" field.
+func (m *SpikeEventMutation) AddEntropy(f float64) {
+	if m.addentropy != nil {
+		*m.addentropy += f
+	} else {
+		m.addentropy = &f
+	}
+}
+
+// AddedEntropy returns the value that was added to the "entropy" field in this mutation.
+func (m *SpikeEventMutation) AddedEntropy() (r float64, exists bool) {
+	v := m.addentropy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEntropy resets all changes to the "entropy" field.
+func (m *SpikeEventMutation) ResetEntropy() {
+	m.entropy = nil
+	m.addentropy = nil
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *SpikeEventMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *SpikeEventMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the SpikeEvent entity.
+// If the SpikeEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SpikeEventMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *SpikeEventMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[spikeevent.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *SpikeEventMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[spikeevent.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *SpikeEventMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, spikeevent.FieldMetadata)
+}
+
+// AddDecisionIDs adds the "decisions" edge to the RoutingDecision entity by ids.
+func (m *SpikeEventMutation) AddDecisionIDs(ids ...string) {
+	if m.decisions == nil {
+		m.decisions = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.decisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDecisions clears the "decisions" edge to the RoutingDecision entity.
+func (m *SpikeEventMutation) ClearDecisions() {
+	m.cleareddecisions = true
+}
+
+// DecisionsCleared reports if the "decisions" edge to the RoutingDecision entity was cleared.
+func (m *SpikeEventMutation) DecisionsCleared() bool {
+	return m.cleareddecisions
+}
+
+// RemoveDecisionIDs removes the "decisions" edge to the RoutingDecision entity by IDs.
+func (m *SpikeEventMutation) RemoveDecisionIDs(ids ...string) {
+	if m.removeddecisions == nil {
+		m.removeddecisions = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.decisions, ids[i])
+		m.removeddecisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDecisions returns the removed IDs of the "decisions" edge to the RoutingDecision entity.
+func (m *SpikeEventMutation) RemovedDecisionsIDs() (ids []string) {
+	for id := range m.removeddecisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DecisionsIDs returns the "decisions" edge IDs in the mutation.
+func (m *SpikeEventMutation) DecisionsIDs() (ids []string) {
+	for id := range m.decisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDecisions resets all changes to the "decisions" edge.
+func (m *SpikeEventMutation) ResetDecisions() {
+	m.decisions = nil
+	m.cleareddecisions = false
+	m.removeddecisions = nil
+}
+
+// Where appends a list predicates to the SpikeEventMutation builder.
+func (m *SpikeEventMutation) Where(ps ...predicate.SpikeEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SpikeEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SpikeEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SpikeEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SpikeEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SpikeEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SpikeEvent).
+func (m *SpikeEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SpikeEventMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.provenance_hash != nil {
+		fields = append(fields, spikeevent.FieldProvenanceHash)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, spikeevent.FieldTimestamp)
+	}
+	if m.timestamp_ns != nil {
+		fields = append(fields, spikeevent.FieldTimestampNs)
+	}
+	if m.population_id != nil {
+		fields = append(fields, spikeevent.FieldPopulationID)
+	}
+	if m.layer_index != nil {
+		fields = append(fields, spikeevent.FieldLayerIndex)
+	}
+	if m.neuron_indices != nil {
+		fields = append(fields, spikeevent.FieldNeuronIndices)
+	}
+	if m.spike_counts != nil {
+		fields = append(fields, spikeevent.FieldSpikeCounts)
+	}
+	if m.membrane_potentials != nil {
+		fields = append(fields, spikeevent.FieldMembranePotentials)
+	}
+	if m.input_currents != nil {
+		fields = append(fields, spikeevent.FieldInputCurrents)
+	}
+	if m.pattern_hash != nil {
+		fields = append(fields, spikeevent.FieldPatternHash)
+	}
+	if m.inference_id != nil {
+		fields = append(fields, spikeevent.FieldInferenceID)
+	}
+	if m.is_emergent != nil {
+		fields = append(fields, spikeevent.FieldIsEmergent)
+	}
+	if m.entropy != nil {
+		fields = append(fields, spikeevent.FieldEntropy)
+	}
+	if m.metadata != nil {
+		fields = append(fields, spikeevent.FieldMetadata)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SpikeEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case spikeevent.FieldProvenanceHash:
+		return m.ProvenanceHash()
+	case spikeevent.FieldTimestamp:
+		return m.Timestamp()
+	case spikeevent.FieldTimestampNs:
+		return m.TimestampNs()
+	case spikeevent.FieldPopulationID:
+		return m.PopulationID()
+	case spikeevent.FieldLayerIndex:
+		return m.LayerIndex()
+	case spikeevent.FieldNeuronIndices:
+		return m.NeuronIndices()
+	case spikeevent.FieldSpikeCounts:
+		return m.SpikeCounts()
+	case spikeevent.FieldMembranePotentials:
+		return m.MembranePotentials()
+	case spikeevent.FieldInputCurrents:
+		return m.InputCurrents()
+	case spikeevent.FieldPatternHash:
+		return m.PatternHash()
+	case spikeevent.FieldInferenceID:
+		return m.InferenceID()
+	case spikeevent.FieldIsEmergent:
+		return m.IsEmergent()
+	case spikeevent.FieldEntropy:
+		return m.Entropy()
+	case spikeevent.FieldMetadata:
+		return m.Metadata()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SpikeEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case spikeevent.FieldProvenanceHash:
+		return m.OldProvenanceHash(ctx)
+	case spikeevent.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case spikeevent.FieldTimestampNs:
+		return m.OldTimestampNs(ctx)
+	case spikeevent.FieldPopulationID:
+		return m.OldPopulationID(ctx)
+	case spikeevent.FieldLayerIndex:
+		return m.OldLayerIndex(ctx)
+	case spikeevent.FieldNeuronIndices:
+		return m.OldNeuronIndices(ctx)
+	case spikeevent.FieldSpikeCounts:
+		return m.OldSpikeCounts(ctx)
+	case spikeevent.FieldMembranePotentials:
+		return m.OldMembranePotentials(ctx)
+	case spikeevent.FieldInputCurrents:
+		return m.OldInputCurrents(ctx)
+	case spikeevent.FieldPatternHash:
+		return m.OldPatternHash(ctx)
+	case spikeevent.FieldInferenceID:
+		return m.OldInferenceID(ctx)
+	case spikeevent.FieldIsEmergent:
+		return m.OldIsEmergent(ctx)
+	case spikeevent.FieldEntropy:
+		return m.OldEntropy(ctx)
+	case spikeevent.FieldMetadata:
+		return m.OldMetadata(ctx)
+	}
+	return nil, fmt.Errorf("unknown SpikeEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SpikeEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case spikeevent.FieldProvenanceHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvenanceHash(v)
+		return nil
+	case spikeevent.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case spikeevent.FieldTimestampNs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestampNs(v)
+		return nil
+	case spikeevent.FieldPopulationID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPopulationID(v)
+		return nil
+	case spikeevent.FieldLayerIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLayerIndex(v)
+		return nil
+	case spikeevent.FieldNeuronIndices:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNeuronIndices(v)
+		return nil
+	case spikeevent.FieldSpikeCounts:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpikeCounts(v)
+		return nil
+	case spikeevent.FieldMembranePotentials:
+		v, ok := value.([]float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMembranePotentials(v)
+		return nil
+	case spikeevent.FieldInputCurrents:
+		v, ok := value.([]float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInputCurrents(v)
+		return nil
+	case spikeevent.FieldPatternHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPatternHash(v)
+		return nil
+	case spikeevent.FieldInferenceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInferenceID(v)
+		return nil
+	case spikeevent.FieldIsEmergent:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsEmergent(v)
+		return nil
+	case spikeevent.FieldEntropy:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntropy(v)
+		return nil
+	case spikeevent.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SpikeEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SpikeEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addtimestamp_ns != nil {
+		fields = append(fields, spikeevent.FieldTimestampNs)
+	}
+	if m.addlayer_index != nil {
+		fields = append(fields, spikeevent.FieldLayerIndex)
+	}
+	if m.addentropy != nil {
+		fields = append(fields, spikeevent.FieldEntropy)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SpikeEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case spikeevent.FieldTimestampNs:
+		return m.AddedTimestampNs()
+	case spikeevent.FieldLayerIndex:
+		return m.AddedLayerIndex()
+	case spikeevent.FieldEntropy:
+		return m.AddedEntropy()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SpikeEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case spikeevent.FieldTimestampNs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimestampNs(v)
+		return nil
+	case spikeevent.FieldLayerIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLayerIndex(v)
+		return nil
+	case spikeevent.FieldEntropy:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEntropy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SpikeEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SpikeEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(spikeevent.FieldProvenanceHash) {
+		fields = append(fields, spikeevent.FieldProvenanceHash)
+	}
+	if m.FieldCleared(spikeevent.FieldTimestampNs) {
+		fields = append(fields, spikeevent.FieldTimestampNs)
+	}
+	if m.FieldCleared(spikeevent.FieldSpikeCounts) {
+		fields = append(fields, spikeevent.FieldSpikeCounts)
+	}
+	if m.FieldCleared(spikeevent.FieldMembranePotentials) {
+		fields = append(fields, spikeevent.FieldMembranePotentials)
+	}
+	if m.FieldCleared(spikeevent.FieldInputCurrents) {
+		fields = append(fields, spikeevent.FieldInputCurrents)
+	}
+	if m.FieldCleared(spikeevent.FieldInferenceID) {
+		fields = append(fields, spikeevent.FieldInferenceID)
+	}
+	if m.FieldCleared(spikeevent.FieldMetadata) {
+		fields = append(fields, spikeevent.FieldMetadata)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SpikeEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SpikeEventMutation) ClearField(name string) error {
+	switch name {
+	case spikeevent.FieldProvenanceHash:
+		m.ClearProvenanceHash()
+		return nil
+	case spikeevent.FieldTimestampNs:
+		m.ClearTimestampNs()
+		return nil
+	case spikeevent.FieldSpikeCounts:
+		m.ClearSpikeCounts()
+		return nil
+	case spikeevent.FieldMembranePotentials:
+		m.ClearMembranePotentials()
+		return nil
+	case spikeevent.FieldInputCurrents:
+		m.ClearInputCurrents()
+		return nil
+	case spikeevent.FieldInferenceID:
+		m.ClearInferenceID()
+		return nil
+	case spikeevent.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown SpikeEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SpikeEventMutation) ResetField(name string) error {
+	switch name {
+	case spikeevent.FieldProvenanceHash:
+		m.ResetProvenanceHash()
+		return nil
+	case spikeevent.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	case spikeevent.FieldTimestampNs:
+		m.ResetTimestampNs()
+		return nil
+	case spikeevent.FieldPopulationID:
+		m.ResetPopulationID()
+		return nil
+	case spikeevent.FieldLayerIndex:
+		m.ResetLayerIndex()
+		return nil
+	case spikeevent.FieldNeuronIndices:
+		m.ResetNeuronIndices()
+		return nil
+	case spikeevent.FieldSpikeCounts:
+		m.ResetSpikeCounts()
+		return nil
+	case spikeevent.FieldMembranePotentials:
+		m.ResetMembranePotentials()
+		return nil
+	case spikeevent.FieldInputCurrents:
+		m.ResetInputCurrents()
+		return nil
+	case spikeevent.FieldPatternHash:
+		m.ResetPatternHash()
+		return nil
+	case spikeevent.FieldInferenceID:
+		m.ResetInferenceID()
+		return nil
+	case spikeevent.FieldIsEmergent:
+		m.ResetIsEmergent()
+		return nil
+	case spikeevent.FieldEntropy:
+		m.ResetEntropy()
+		return nil
+	case spikeevent.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown SpikeEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SpikeEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.decisions != nil {
+		edges = append(edges, spikeevent.EdgeDecisions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SpikeEventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case spikeevent.EdgeDecisions:
+		ids := make([]ent.Value, 0, len(m.decisions))
+		for id := range m.decisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SpikeEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removeddecisions != nil {
+		edges = append(edges, spikeevent.EdgeDecisions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SpikeEventMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case spikeevent.EdgeDecisions:
+		ids := make([]ent.Value, 0, len(m.removeddecisions))
+		for id := range m.removeddecisions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SpikeEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddecisions {
+		edges = append(edges, spikeevent.EdgeDecisions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SpikeEventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case spikeevent.EdgeDecisions:
+		return m.cleareddecisions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SpikeEventMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown SpikeEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SpikeEventMutation) ResetEdge(name string) error {
+	switch name {
+	case spikeevent.EdgeDecisions:
+		m.ResetDecisions()
+		return nil
+	}
+	return fmt.Errorf("unknown SpikeEvent edge %s", name)
+}
+
+// WorkflowExecutionMutation represents an operation that mutates the WorkflowExecution nodes in the graph.
+type WorkflowExecutionMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *string
+	provenance_hash         *string
+	started_at              *time.Time
+	completed_at            *time.Time
+	workflow_id             *string
+	workflow_name           *string
+	step_id                 *string
+	step_name               *string
+	step_index              *int
+	addstep_index           *int
+	status                  *workflowexecution.Status
+	inputs                  *map[string]interface{}
+	output_data             *map[string]interface{}
+	error                   *string
+	duration_ms             *float64
+	addduration_ms          *float64
+	metadata                *map[string]interface{}
+	clearedFields           map[string]struct{}
+	actions                 map[string]struct{}
+	removedactions          map[string]struct{}
+	clearedactions          bool
+	outputs                 map[string]struct{}
+	removedoutputs          map[string]struct{}
+	clearedoutputs          bool
+	parent_execution        *string
+	clearedparent_execution bool
+	child_executions        map[string]struct{}
+	removedchild_executions map[string]struct{}
+	clearedchild_executions bool
+	done                    bool
+	oldValue                func(context.Context) (*WorkflowExecution, error)
+	predicates              []predicate.WorkflowExecution
+}
+
+var _ ent.Mutation = (*WorkflowExecutionMutation)(nil)
+
+// workflowexecutionOption allows management of the mutation configuration using functional options.
+type workflowexecutionOption func(*WorkflowExecutionMutation)
+
+// newWorkflowExecutionMutation creates new mutation for the WorkflowExecution entity.
+func newWorkflowExecutionMutation(c config, op Op, opts ...workflowexecutionOption) *WorkflowExecutionMutation {
+	m := &WorkflowExecutionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWorkflowExecution,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withWorkflowExecutionID sets the ID field of the mutation.
+func withWorkflowExecutionID(id string) workflowexecutionOption {
+	return func(m *WorkflowExecutionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WorkflowExecution
+		)
+		m.oldValue = func(ctx context.Context) (*WorkflowExecution, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WorkflowExecution.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withWorkflowExecution sets the old WorkflowExecution of the mutation.
+func withWorkflowExecution(node *WorkflowExecution) workflowexecutionOption {
+	return func(m *WorkflowExecutionMutation) {
+		m.oldValue = func(context.Context) (*WorkflowExecution, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WorkflowExecutionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WorkflowExecutionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WorkflowExecution entities.
+func (m *WorkflowExecutionMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WorkflowExecutionMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WorkflowExecutionMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WorkflowExecution.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProvenanceHash sets the "provenance_hash" field.
+func (m *WorkflowExecutionMutation) SetProvenanceHash(s string) {
+	m.provenance_hash = &s
+}
+
+// ProvenanceHash returns the value of the "provenance_hash" field in the mutation.
+func (m *WorkflowExecutionMutation) ProvenanceHash() (r string, exists bool) {
+	v := m.provenance_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvenanceHash returns the old "provenance_hash" field's value of the WorkflowExecution entity.
+// If the WorkflowExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowExecutionMutation) OldProvenanceHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvenanceHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvenanceHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvenanceHash: %w", err)
+	}
+	return oldValue.ProvenanceHash, nil
+}
+
+// ClearProvenanceHash clears the value of the "provenance_hash" field.
+func (m *WorkflowExecutionMutation) ClearProvenanceHash() {
+	m.provenance_hash = nil
+	m.clearedFields[workflowexecution.FieldProvenanceHash] = struct{}{}
+}
+
+// ProvenanceHashCleared returns if the "provenance_hash" field was cleared in this mutation.
+func (m *WorkflowExecutionMutation) ProvenanceHashCleared() bool {
+	_, ok := m.clearedFields[workflowexecution.FieldProvenanceHash]
+	return ok
+}
+
+// ResetProvenanceHash resets all changes to the "provenance_hash" field.
+func (m *WorkflowExecutionMutation) ResetProvenanceHash() {
+	m.provenance_hash = nil
+	delete(m.clearedFields, workflowexecution.FieldProvenanceHash)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *WorkflowExecutionMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *WorkflowExecutionMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the WorkflowExecution entity.
+// If the WorkflowExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowExecutionMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *WorkflowExecutionMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *WorkflowExecutionMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *WorkflowExecutionMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the WorkflowExecution entity.
+// If the WorkflowExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowExecutionMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *WorkflowExecutionMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[workflowexecution.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *WorkflowExecutionMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[workflowexecution.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *WorkflowExecutionMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, workflowexecution.FieldCompletedAt)
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (m *WorkflowExecutionMutation) SetWorkflowID(s string) {
+	m.workflow_id = &s
+}
+
+// WorkflowID returns the value of the "workflow_id" field in the mutation.
+func (m *WorkflowExecutionMutation) WorkflowID() (r string, exists bool) {
+	v := m.workflow_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkflowID returns the old "workflow_id" field's value of the WorkflowExecution entity.
+// If the WorkflowExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowExecutionMutation) OldWorkflowID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkflowID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkflowID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkflowID: %w", err)
+	}
+	return oldValue.WorkflowID, nil
+}
+
+// ResetWorkflowID resets all changes to the "workflow_id" field.
+func (m *WorkflowExecutionMutation) ResetWorkflowID() {
+	m.workflow_id = nil
+}
+
+// SetWorkflowName sets the "workflow_name" field.
+func (m *WorkflowExecutionMutation) SetWorkflowName(s string) {
+	m.workflow_name = &s
+}
+
+// WorkflowName returns the value of the "workflow_name" field in the mutation.
+func (m *WorkflowExecutionMutation) WorkflowName() (r string, exists bool) {
+	v := m.workflow_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWorkflowName returns the old "workflow_name" field's value of the WorkflowExecution entity.
+// If the WorkflowExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowExecutionMutation) OldWorkflowName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWorkflowName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWorkflowName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWorkflowName: %w", err)
+	}
+	return oldValue.WorkflowName, nil
+}
+
+// ClearWorkflowName clears the value of the "workflow_name" field.
+func (m *WorkflowExecutionMutation) ClearWorkflowName() {
+	m.workflow_name = nil
+	m.clearedFields[workflowexecution.FieldWorkflowName] = struct{}{}
+}
+
+// WorkflowNameCleared returns if the "workflow_name" field was cleared in this mutation.
+func (m *WorkflowExecutionMutation) WorkflowNameCleared() bool {
+	_, ok := m.clearedFields[workflowexecution.FieldWorkflowName]
+	return ok
+}
+
+// ResetWorkflowName resets all changes to the "workflow_name" field.
+func (m *WorkflowExecutionMutation) ResetWorkflowName() {
+	m.workflow_name = nil
+	delete(m.clearedFields, workflowexecution.FieldWorkflowName)
+}
+
+// SetStepID sets the "step_id" field.
+func (m *WorkflowExecutionMutation) SetStepID(s string) {
+	m.step_id = &s
+}
+
+// StepID returns the value of the "step_id" field in the mutation.
+func (m *WorkflowExecutionMutation) StepID() (r string, exists bool) {
+	v := m.step_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStepID returns the old "step_id" field's value of the WorkflowExecution entity.
+// If the WorkflowExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowExecutionMutation) OldStepID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStepID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStepID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStepID: %w", err)
+	}
+	return oldValue.StepID, nil
+}
+
+// ClearStepID clears the value of the "step_id" field.
+func (m *WorkflowExecutionMutation) ClearStepID() {
+	m.step_id = nil
+	m.clearedFields[workflowexecution.FieldStepID] = struct{}{}
+}
+
+// StepIDCleared returns if the "step_id" field was cleared in this mutation.
+func (m *WorkflowExecutionMutation) StepIDCleared() bool {
+	_, ok := m.clearedFields[workflowexecution.FieldStepID]
+	return ok
+}
+
+// ResetStepID resets all changes to the "step_id" field.
+func (m *WorkflowExecutionMutation) ResetStepID() {
+	m.step_id = nil
+	delete(m.clearedFields, workflowexecution.FieldStepID)
+}
+
+// SetStepName sets the "step_name" field.
+func (m *WorkflowExecutionMutation) SetStepName(s string) {
+	m.step_name = &s
+}
+
+// StepName returns the value of the "step_name" field in the mutation.
+func (m *WorkflowExecutionMutation) StepName() (r string, exists bool) {
+	v := m.step_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStepName returns the old "step_name" field's value of the WorkflowExecution entity.
+// If the WorkflowExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowExecutionMutation) OldStepName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStepName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStepName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStepName: %w", err)
+	}
+	return oldValue.StepName, nil
+}
+
+// ClearStepName clears the value of the "step_name" field.
+func (m *WorkflowExecutionMutation) ClearStepName() {
+	m.step_name = nil
+	m.clearedFields[workflowexecution.FieldStepName] = struct{}{}
+}
+
+// StepNameCleared returns if the "step_name" field was cleared in this mutation.
+func (m *WorkflowExecutionMutation) StepNameCleared() bool {
+	_, ok := m.clearedFields[workflowexecution.FieldStepName]
+	return ok
+}
+
+// ResetStepName resets all changes to the "step_name" field.
+func (m *WorkflowExecutionMutation) ResetStepName() {
+	m.step_name = nil
+	delete(m.clearedFields, workflowexecution.FieldStepName)
+}
+
+// SetStepIndex sets the "step_index" field.
+func (m *WorkflowExecutionMutation) SetStepIndex(i int) {
+	m.step_index = &i
+	m.addstep_index = nil
+}
+
+// StepIndex returns the value of the "step_index" field in the mutation.
+func (m *WorkflowExecutionMutation) StepIndex() (r int, exists bool) {
+	v := m.step_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStepIndex returns the old "step_index" field's value of the WorkflowExecution entity.
+// If the WorkflowExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowExecutionMutation) OldStepIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStepIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStepIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStepIndex: %w", err)
+	}
+	return oldValue.StepIndex, nil
+}
+
+// AddStepIndex adds i to the "step_index" field.
+func (m *WorkflowExecutionMutation) AddStepIndex(i int) {
+	if m.addstep_index != nil {
+		*m.addstep_index += i
+	} else {
+		m.addstep_index = &i
+	}
+}
+
+// AddedStepIndex returns the value that was added to the "step_index" field in this mutation.
+func (m *WorkflowExecutionMutation) AddedStepIndex() (r int, exists bool) {
+	v := m.addstep_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStepIndex resets all changes to the "step_index" field.
+func (m *WorkflowExecutionMutation) ResetStepIndex() {
+	m.step_index = nil
+	m.addstep_index = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *WorkflowExecutionMutation) SetStatus(w workflowexecution.Status) {
+	m.status = &w
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *WorkflowExecutionMutation) Status() (r workflowexecution.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the WorkflowExecution entity.
+// If the WorkflowExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowExecutionMutation) OldStatus(ctx context.Context) (v workflowexecution.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *WorkflowExecutionMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetInputs sets the "inputs" field.
+func (m *WorkflowExecutionMutation) SetInputs(value map[string]interface{}) {
+	m.inputs = &value
+}
+
+// Inputs returns the value of the "inputs" field in the mutation.
+func (m *WorkflowExecutionMutation) Inputs() (r map[string]interface{}, exists bool) {
+	v := m.inputs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInputs returns the old "inputs" field's value of the WorkflowExecution entity.
+// If the WorkflowExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowExecutionMutation) OldInputs(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInputs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInputs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInputs: %w", err)
+	}
+	return oldValue.Inputs, nil
+}
+
+// ClearInputs clears the value of the "inputs" field.
+func (m *WorkflowExecutionMutation) ClearInputs() {
+	m.inputs = nil
+	m.clearedFields[workflowexecution.FieldInputs] = struct{}{}
+}
+
+// InputsCleared returns if the "inputs" field was cleared in this mutation.
+func (m *WorkflowExecutionMutation) InputsCleared() bool {
+	_, ok := m.clearedFields[workflowexecution.FieldInputs]
+	return ok
+}
+
+// ResetInputs resets all changes to the "inputs" field.
+func (m *WorkflowExecutionMutation) ResetInputs() {
+	m.inputs = nil
+	delete(m.clearedFields, workflowexecution.FieldInputs)
+}
+
+// SetOutputData sets the "output_data" field.
+func (m *WorkflowExecutionMutation) SetOutputData(value map[string]interface{}) {
+	m.output_data = &value
+}
+
+// OutputData returns the value of the "output_data" field in the mutation.
+func (m *WorkflowExecutionMutation) OutputData() (r map[string]interface{}, exists bool) {
+	v := m.output_data
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutputData returns the old "output_data" field's value of the WorkflowExecution entity.
+// If the WorkflowExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowExecutionMutation) OldOutputData(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutputData is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutputData requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutputData: %w", err)
+	}
+	return oldValue.OutputData, nil
+}
+
+// ClearOutputData clears the value of the "output_data" field.
+func (m *WorkflowExecutionMutation) ClearOutputData() {
+	m.output_data = nil
+	m.clearedFields[workflowexecution.FieldOutputData] = struct{}{}
+}
+
+// OutputDataCleared returns if the "output_data" field was cleared in this mutation.
+func (m *WorkflowExecutionMutation) OutputDataCleared() bool {
+	_, ok := m.clearedFields[workflowexecution.FieldOutputData]
+	return ok
+}
+
+// ResetOutputData resets all changes to the "output_data" field.
+func (m *WorkflowExecutionMutation) ResetOutputData() {
+	m.output_data = nil
+	delete(m.clearedFields, workflowexecution.FieldOutputData)
+}
+
+// SetError sets the "error" field.
+func (m *WorkflowExecutionMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *WorkflowExecutionMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the WorkflowExecution entity.
+// If the WorkflowExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowExecutionMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *WorkflowExecutionMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[workflowexecution.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *WorkflowExecutionMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[workflowexecution.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *WorkflowExecutionMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, workflowexecution.FieldError)
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (m *WorkflowExecutionMutation) SetDurationMs(f float64) {
+	m.duration_ms = &f
+	m.addduration_ms = nil
+}
+
+// DurationMs returns the value of the "duration_ms" field in the mutation.
+func (m *WorkflowExecutionMutation) DurationMs() (r float64, exists bool) {
+	v := m.duration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDurationMs returns the old "duration_ms" field's value of the WorkflowExecution entity.
+// If the WorkflowExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowExecutionMutation) OldDurationMs(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDurationMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDurationMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDurationMs: %w", err)
+	}
+	return oldValue.DurationMs, nil
+}
+
+// AddDurationMs adds f to the "duration_ms" field.
+func (m *WorkflowExecutionMutation) AddDurationMs(f float64) {
+	if m.addduration_ms != nil {
+		*m.addduration_ms += f
+	} else {
+		m.addduration_ms = &f
+	}
+}
+
+// AddedDurationMs returns the value that was added to the "duration_ms" field in this mutation.
+func (m *WorkflowExecutionMutation) AddedDurationMs() (r float64, exists bool) {
+	v := m.addduration_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (m *WorkflowExecutionMutation) ClearDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+	m.clearedFields[workflowexecution.FieldDurationMs] = struct{}{}
+}
+
+// DurationMsCleared returns if the "duration_ms" field was cleared in this mutation.
+func (m *WorkflowExecutionMutation) DurationMsCleared() bool {
+	_, ok := m.clearedFields[workflowexecution.FieldDurationMs]
+	return ok
+}
+
+// ResetDurationMs resets all changes to the "duration_ms" field.
+func (m *WorkflowExecutionMutation) ResetDurationMs() {
+	m.duration_ms = nil
+	m.addduration_ms = nil
+	delete(m.clearedFields, workflowexecution.FieldDurationMs)
+}
+
+// SetParentExecutionID sets the "parent_execution_id" field.
+func (m *WorkflowExecutionMutation) SetParentExecutionID(s string) {
+	m.parent_execution = &s
+}
+
+// ParentExecutionID returns the value of the "parent_execution_id" field in the mutation.
+func (m *WorkflowExecutionMutation) ParentExecutionID() (r string, exists bool) {
+	v := m.parent_execution
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentExecutionID returns the old "parent_execution_id" field's value of the WorkflowExecution entity.
+// If the WorkflowExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowExecutionMutation) OldParentExecutionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentExecutionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentExecutionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentExecutionID: %w", err)
+	}
+	return oldValue.ParentExecutionID, nil
+}
+
+// ClearParentExecutionID clears the value of the "parent_execution_id" field.
+func (m *WorkflowExecutionMutation) ClearParentExecutionID() {
+	m.parent_execution = nil
+	m.clearedFields[workflowexecution.FieldParentExecutionID] = struct{}{}
+}
+
+// ParentExecutionIDCleared returns if the "parent_execution_id" field was cleared in this mutation.
+func (m *WorkflowExecutionMutation) ParentExecutionIDCleared() bool {
+	_, ok := m.clearedFields[workflowexecution.FieldParentExecutionID]
+	return ok
+}
+
+// ResetParentExecutionID resets all changes to the "parent_execution_id" field.
+func (m *WorkflowExecutionMutation) ResetParentExecutionID() {
+	m.parent_execution = nil
+	delete(m.clearedFields, workflowexecution.FieldParentExecutionID)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *WorkflowExecutionMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *WorkflowExecutionMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the WorkflowExecution entity.
+// If the WorkflowExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkflowExecutionMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *WorkflowExecutionMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[workflowexecution.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *WorkflowExecutionMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[workflowexecution.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *WorkflowExecutionMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, workflowexecution.FieldMetadata)
+}
+
+// AddActionIDs adds the "actions" edge to the AgentAction entity by ids.
+func (m *WorkflowExecutionMutation) AddActionIDs(ids ...string) {
+	if m.actions == nil {
+		m.actions = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.actions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearActions clears the "actions" edge to the AgentAction entity.
+func (m *WorkflowExecutionMutation) ClearActions() {
+	m.clearedactions = true
+}
+
+// ActionsCleared reports if the "actions" edge to the AgentAction entity was cleared.
+func (m *WorkflowExecutionMutation) ActionsCleared() bool {
+	return m.clearedactions
+}
+
+// RemoveActionIDs removes the "actions" edge to the AgentAction entity by IDs.
+func (m *WorkflowExecutionMutation) RemoveActionIDs(ids ...string) {
+	if m.removedactions == nil {
+		m.removedactions = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.actions, ids[i])
+		m.removedactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedActions returns the removed IDs of the "actions" edge to the AgentAction entity.
+func (m *WorkflowExecutionMutation) RemovedActionsIDs() (ids []string) {
+	for id := range m.removedactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ActionsIDs returns the "actions" edge IDs in the mutation.
+func (m *WorkflowExecutionMutation) ActionsIDs() (ids []string) {
+	for id := range m.actions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetActions resets all changes to the "actions" edge.
+func (m *WorkflowExecutionMutation) ResetActions() {
+	m.actions = nil
+	m.clearedactions = false
+	m.removedactions = nil
+}
+
+// AddOutputIDs adds the "outputs" edge to the ExternalOutput entity by ids.
+func (m *WorkflowExecutionMutation) AddOutputIDs(ids ...string) {
+	if m.outputs == nil {
+		m.outputs = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.outputs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOutputs clears the "outputs" edge to the ExternalOutput entity.
+func (m *WorkflowExecutionMutation) ClearOutputs() {
+	m.clearedoutputs = true
+}
+
+// OutputsCleared reports if the "outputs" edge to the ExternalOutput entity was cleared.
+func (m *WorkflowExecutionMutation) OutputsCleared() bool {
+	return m.clearedoutputs
+}
+
+// RemoveOutputIDs removes the "outputs" edge to the ExternalOutput entity by IDs.
+func (m *WorkflowExecutionMutation) RemoveOutputIDs(ids ...string) {
+	if m.removedoutputs == nil {
+		m.removedoutputs = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.outputs, ids[i])
+		m.removedoutputs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOutputs returns the removed IDs of the "outputs" edge to the ExternalOutput entity.
+func (m *WorkflowExecutionMutation) RemovedOutputsIDs() (ids []string) {
+	for id := range m.removedoutputs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OutputsIDs returns the "outputs" edge IDs in the mutation.
+func (m *WorkflowExecutionMutation) OutputsIDs() (ids []string) {
+	for id := range m.outputs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOutputs resets all changes to the "outputs" edge.
+func (m *WorkflowExecutionMutation) ResetOutputs() {
+	m.outputs = nil
+	m.clearedoutputs = false
+	m.removedoutputs = nil
+}
+
+// ClearParentExecution clears the "parent_execution" edge to the WorkflowExecution entity.
+func (m *WorkflowExecutionMutation) ClearParentExecution() {
+	m.clearedparent_execution = true
+	m.clearedFields[workflowexecution.FieldParentExecutionID] = struct{}{}
+}
+
+// ParentExecutionCleared reports if the "parent_execution" edge to the WorkflowExecution entity was cleared.
+func (m *WorkflowExecutionMutation) ParentExecutionCleared() bool {
+	return m.ParentExecutionIDCleared() || m.clearedparent_execution
+}
+
+// ParentExecutionIDs returns the "parent_execution" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentExecutionID instead. It exists only for internal usage by the builders.
+func (m *WorkflowExecutionMutation) ParentExecutionIDs() (ids []string) {
+	if id := m.parent_execution; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParentExecution resets all changes to the "parent_execution" edge.
+func (m *WorkflowExecutionMutation) ResetParentExecution() {
+	m.parent_execution = nil
+	m.clearedparent_execution = false
+}
+
+// AddChildExecutionIDs adds the "child_executions" edge to the WorkflowExecution entity by ids.
+func (m *WorkflowExecutionMutation) AddChildExecutionIDs(ids ...string) {
+	if m.child_executions == nil {
+		m.child_executions = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.child_executions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildExecutions clears the "child_executions" edge to the WorkflowExecution entity.
+func (m *WorkflowExecutionMutation) ClearChildExecutions() {
+	m.clearedchild_executions = true
+}
+
+// ChildExecutionsCleared reports if the "child_executions" edge to the WorkflowExecution entity was cleared.
+func (m *WorkflowExecutionMutation) ChildExecutionsCleared() bool {
+	return m.clearedchild_executions
+}
+
+// RemoveChildExecutionIDs removes the "child_executions" edge to the WorkflowExecution entity by IDs.
+func (m *WorkflowExecutionMutation) RemoveChildExecutionIDs(ids ...string) {
+	if m.removedchild_executions == nil {
+		m.removedchild_executions = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.child_executions, ids[i])
+		m.removedchild_executions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildExecutions returns the removed IDs of the "child_executions" edge to the WorkflowExecution entity.
+func (m *WorkflowExecutionMutation) RemovedChildExecutionsIDs() (ids []string) {
+	for id := range m.removedchild_executions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildExecutionsIDs returns the "child_executions" edge IDs in the mutation.
+func (m *WorkflowExecutionMutation) ChildExecutionsIDs() (ids []string) {
+	for id := range m.child_executions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildExecutions resets all changes to the "child_executions" edge.
+func (m *WorkflowExecutionMutation) ResetChildExecutions() {
+	m.child_executions = nil
+	m.clearedchild_executions = false
+	m.removedchild_executions = nil
+}
+
+// Where appends a list predicates to the WorkflowExecutionMutation builder.
+func (m *WorkflowExecutionMutation) Where(ps ...predicate.WorkflowExecution) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the WorkflowExecutionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WorkflowExecutionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WorkflowExecution, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *WorkflowExecutionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *WorkflowExecutionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (WorkflowExecution).
+func (m *WorkflowExecutionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WorkflowExecutionMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.provenance_hash != nil {
+		fields = append(fields, workflowexecution.FieldProvenanceHash)
+	}
+	if m.started_at != nil {
+		fields = append(fields, workflowexecution.FieldStartedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, workflowexecution.FieldCompletedAt)
+	}
+	if m.workflow_id != nil {
+		fields = append(fields, workflowexecution.FieldWorkflowID)
+	}
+	if m.workflow_name != nil {
+		fields = append(fields, workflowexecution.FieldWorkflowName)
+	}
+	if m.step_id != nil {
+		fields = append(fields, workflowexecution.FieldStepID)
+	}
+	if m.step_name != nil {
+		fields = append(fields, workflowexecution.FieldStepName)
+	}
+	if m.step_index != nil {
+		fields = append(fields, workflowexecution.FieldStepIndex)
+	}
+	if m.status != nil {
+		fields = append(fields, workflowexecution.FieldStatus)
+	}
+	if m.inputs != nil {
+		fields = append(fields, workflowexecution.FieldInputs)
+	}
+	if m.output_data != nil {
+		fields = append(fields, workflowexecution.FieldOutputData)
+	}
+	if m.error != nil {
+		fields = append(fields, workflowexecution.FieldError)
+	}
+	if m.duration_ms != nil {
+		fields = append(fields, workflowexecution.FieldDurationMs)
+	}
+	if m.parent_execution != nil {
+		fields = append(fields, workflowexecution.FieldParentExecutionID)
+	}
+	if m.metadata != nil {
+		fields = append(fields, workflowexecution.FieldMetadata)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WorkflowExecutionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case workflowexecution.FieldProvenanceHash:
+		return m.ProvenanceHash()
+	case workflowexecution.FieldStartedAt:
+		return m.StartedAt()
+	case workflowexecution.FieldCompletedAt:
+		return m.CompletedAt()
+	case workflowexecution.FieldWorkflowID:
+		return m.WorkflowID()
+	case workflowexecution.FieldWorkflowName:
+		return m.WorkflowName()
+	case workflowexecution.FieldStepID:
+		return m.StepID()
+	case workflowexecution.FieldStepName:
+		return m.StepName()
+	case workflowexecution.FieldStepIndex:
+		return m.StepIndex()
+	case workflowexecution.FieldStatus:
+		return m.Status()
+	case workflowexecution.FieldInputs:
+		return m.Inputs()
+	case workflowexecution.FieldOutputData:
+		return m.OutputData()
+	case workflowexecution.FieldError:
+		return m.Error()
+	case workflowexecution.FieldDurationMs:
+		return m.DurationMs()
+	case workflowexecution.FieldParentExecutionID:
+		return m.ParentExecutionID()
+	case workflowexecution.FieldMetadata:
+		return m.Metadata()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WorkflowExecutionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case workflowexecution.FieldProvenanceHash:
+		return m.OldProvenanceHash(ctx)
+	case workflowexecution.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case workflowexecution.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case workflowexecution.FieldWorkflowID:
+		return m.OldWorkflowID(ctx)
+	case workflowexecution.FieldWorkflowName:
+		return m.OldWorkflowName(ctx)
+	case workflowexecution.FieldStepID:
+		return m.OldStepID(ctx)
+	case workflowexecution.FieldStepName:
+		return m.OldStepName(ctx)
+	case workflowexecution.FieldStepIndex:
+		return m.OldStepIndex(ctx)
+	case workflowexecution.FieldStatus:
+		return m.OldStatus(ctx)
+	case workflowexecution.FieldInputs:
+		return m.OldInputs(ctx)
+	case workflowexecution.FieldOutputData:
+		return m.OldOutputData(ctx)
+	case workflowexecution.FieldError:
+		return m.OldError(ctx)
+	case workflowexecution.FieldDurationMs:
+		return m.OldDurationMs(ctx)
+	case workflowexecution.FieldParentExecutionID:
+		return m.OldParentExecutionID(ctx)
+	case workflowexecution.FieldMetadata:
+		return m.OldMetadata(ctx)
+	}
+	return nil, fmt.Errorf("unknown WorkflowExecution field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WorkflowExecutionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case workflowexecution.FieldProvenanceHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvenanceHash(v)
+		return nil
+	case workflowexecution.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case workflowexecution.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case workflowexecution.FieldWorkflowID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkflowID(v)
+		return nil
+	case workflowexecution.FieldWorkflowName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWorkflowName(v)
+		return nil
+	case workflowexecution.FieldStepID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStepID(v)
+		return nil
+	case workflowexecution.FieldStepName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStepName(v)
+		return nil
+	case workflowexecution.FieldStepIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStepIndex(v)
+		return nil
+	case workflowexecution.FieldStatus:
+		v, ok := value.(workflowexecution.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case workflowexecution.FieldInputs:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInputs(v)
+		return nil
+	case workflowexecution.FieldOutputData:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutputData(v)
+		return nil
+	case workflowexecution.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case workflowexecution.FieldDurationMs:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDurationMs(v)
+		return nil
+	case workflowexecution.FieldParentExecutionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentExecutionID(v)
+		return nil
+	case workflowexecution.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowExecution field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WorkflowExecutionMutation) AddedFields() []string {
+	var fields []string
+	if m.addstep_index != nil {
+		fields = append(fields, workflowexecution.FieldStepIndex)
+	}
+	if m.addduration_ms != nil {
+		fields = append(fields, workflowexecution.FieldDurationMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WorkflowExecutionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case workflowexecution.FieldStepIndex:
+		return m.AddedStepIndex()
+	case workflowexecution.FieldDurationMs:
+		return m.AddedDurationMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WorkflowExecutionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case workflowexecution.FieldStepIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStepIndex(v)
+		return nil
+	case workflowexecution.FieldDurationMs:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDurationMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowExecution numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WorkflowExecutionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(workflowexecution.FieldProvenanceHash) {
+		fields = append(fields, workflowexecution.FieldProvenanceHash)
+	}
+	if m.FieldCleared(workflowexecution.FieldCompletedAt) {
+		fields = append(fields, workflowexecution.FieldCompletedAt)
+	}
+	if m.FieldCleared(workflowexecution.FieldWorkflowName) {
+		fields = append(fields, workflowexecution.FieldWorkflowName)
+	}
+	if m.FieldCleared(workflowexecution.FieldStepID) {
+		fields = append(fields, workflowexecution.FieldStepID)
+	}
+	if m.FieldCleared(workflowexecution.FieldStepName) {
+		fields = append(fields, workflowexecution.FieldStepName)
+	}
+	if m.FieldCleared(workflowexecution.FieldInputs) {
+		fields = append(fields, workflowexecution.FieldInputs)
+	}
+	if m.FieldCleared(workflowexecution.FieldOutputData) {
+		fields = append(fields, workflowexecution.FieldOutputData)
+	}
+	if m.FieldCleared(workflowexecution.FieldError) {
+		fields = append(fields, workflowexecution.FieldError)
+	}
+	if m.FieldCleared(workflowexecution.FieldDurationMs) {
+		fields = append(fields, workflowexecution.FieldDurationMs)
+	}
+	if m.FieldCleared(workflowexecution.FieldParentExecutionID) {
+		fields = append(fields, workflowexecution.FieldParentExecutionID)
+	}
+	if m.FieldCleared(workflowexecution.FieldMetadata) {
+		fields = append(fields, workflowexecution.FieldMetadata)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WorkflowExecutionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WorkflowExecutionMutation) ClearField(name string) error {
+	switch name {
+	case workflowexecution.FieldProvenanceHash:
+		m.ClearProvenanceHash()
+		return nil
+	case workflowexecution.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case workflowexecution.FieldWorkflowName:
+		m.ClearWorkflowName()
+		return nil
+	case workflowexecution.FieldStepID:
+		m.ClearStepID()
+		return nil
+	case workflowexecution.FieldStepName:
+		m.ClearStepName()
+		return nil
+	case workflowexecution.FieldInputs:
+		m.ClearInputs()
+		return nil
+	case workflowexecution.FieldOutputData:
+		m.ClearOutputData()
+		return nil
+	case workflowexecution.FieldError:
+		m.ClearError()
+		return nil
+	case workflowexecution.FieldDurationMs:
+		m.ClearDurationMs()
+		return nil
+	case workflowexecution.FieldParentExecutionID:
+		m.ClearParentExecutionID()
+		return nil
+	case workflowexecution.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowExecution nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WorkflowExecutionMutation) ResetField(name string) error {
+	switch name {
+	case workflowexecution.FieldProvenanceHash:
+		m.ResetProvenanceHash()
+		return nil
+	case workflowexecution.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case workflowexecution.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case workflowexecution.FieldWorkflowID:
+		m.ResetWorkflowID()
+		return nil
+	case workflowexecution.FieldWorkflowName:
+		m.ResetWorkflowName()
+		return nil
+	case workflowexecution.FieldStepID:
+		m.ResetStepID()
+		return nil
+	case workflowexecution.FieldStepName:
+		m.ResetStepName()
+		return nil
+	case workflowexecution.FieldStepIndex:
+		m.ResetStepIndex()
+		return nil
+	case workflowexecution.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case workflowexecution.FieldInputs:
+		m.ResetInputs()
+		return nil
+	case workflowexecution.FieldOutputData:
+		m.ResetOutputData()
+		return nil
+	case workflowexecution.FieldError:
+		m.ResetError()
+		return nil
+	case workflowexecution.FieldDurationMs:
+		m.ResetDurationMs()
+		return nil
+	case workflowexecution.FieldParentExecutionID:
+		m.ResetParentExecutionID()
+		return nil
+	case workflowexecution.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowExecution field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WorkflowExecutionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.actions != nil {
+		edges = append(edges, workflowexecution.EdgeActions)
+	}
+	if m.outputs != nil {
+		edges = append(edges, workflowexecution.EdgeOutputs)
+	}
+	if m.parent_execution != nil {
+		edges = append(edges, workflowexecution.EdgeParentExecution)
+	}
+	if m.child_executions != nil {
+		edges = append(edges, workflowexecution.EdgeChildExecutions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WorkflowExecutionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case workflowexecution.EdgeActions:
+		ids := make([]ent.Value, 0, len(m.actions))
+		for id := range m.actions {
+			ids = append(ids, id)
+		}
+		return ids
+	case workflowexecution.EdgeOutputs:
+		ids := make([]ent.Value, 0, len(m.outputs))
+		for id := range m.outputs {
+			ids = append(ids, id)
+		}
+		return ids
+	case workflowexecution.EdgeParentExecution:
+		if id := m.parent_execution; id != nil {
+			return []ent.Value{*id}
+		}
+	case workflowexecution.EdgeChildExecutions:
+		ids := make([]ent.Value, 0, len(m.child_executions))
+		for id := range m.child_executions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WorkflowExecutionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedactions != nil {
+		edges = append(edges, workflowexecution.EdgeActions)
+	}
+	if m.removedoutputs != nil {
+		edges = append(edges, workflowexecution.EdgeOutputs)
+	}
+	if m.removedchild_executions != nil {
+		edges = append(edges, workflowexecution.EdgeChildExecutions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WorkflowExecutionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case workflowexecution.EdgeActions:
+		ids := make([]ent.Value, 0, len(m.removedactions))
+		for id := range m.removedactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case workflowexecution.EdgeOutputs:
+		ids := make([]ent.Value, 0, len(m.removedoutputs))
+		for id := range m.removedoutputs {
+			ids = append(ids, id)
+		}
+		return ids
+	case workflowexecution.EdgeChildExecutions:
+		ids := make([]ent.Value, 0, len(m.removedchild_executions))
+		for id := range m.removedchild_executions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WorkflowExecutionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedactions {
+		edges = append(edges, workflowexecution.EdgeActions)
+	}
+	if m.clearedoutputs {
+		edges = append(edges, workflowexecution.EdgeOutputs)
+	}
+	if m.clearedparent_execution {
+		edges = append(edges, workflowexecution.EdgeParentExecution)
+	}
+	if m.clearedchild_executions {
+		edges = append(edges, workflowexecution.EdgeChildExecutions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WorkflowExecutionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case workflowexecution.EdgeActions:
+		return m.clearedactions
+	case workflowexecution.EdgeOutputs:
+		return m.clearedoutputs
+	case workflowexecution.EdgeParentExecution:
+		return m.clearedparent_execution
+	case workflowexecution.EdgeChildExecutions:
+		return m.clearedchild_executions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WorkflowExecutionMutation) ClearEdge(name string) error {
+	switch name {
+	case workflowexecution.EdgeParentExecution:
+		m.ClearParentExecution()
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowExecution unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WorkflowExecutionMutation) ResetEdge(name string) error {
+	switch name {
+	case workflowexecution.EdgeActions:
+		m.ResetActions()
+		return nil
+	case workflowexecution.EdgeOutputs:
+		m.ResetOutputs()
+		return nil
+	case workflowexecution.EdgeParentExecution:
+		m.ResetParentExecution()
+		return nil
+	case workflowexecution.EdgeChildExecutions:
+		m.ResetChildExecutions()
+		return nil
+	}
+	return fmt.Errorf("unknown WorkflowExecution edge %s", name)
+}
@@ -0,0 +1,22 @@
+// Code generated by ent, DO NOT EDIT.
+
+package predicate
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+// AgentAction is the predicate function for agentaction builders.
+type AgentAction func(*sql.Selector)
+
+// ExternalOutput is the predicate function for externaloutput builders.
+type ExternalOutput func(*sql.Selector)
+
+// RoutingDecision is the predicate function for routingdecision builders.
+type RoutingDecision func(*sql.Selector)
+
+// SpikeEvent is the predicate function for spikeevent builders.
+type SpikeEvent func(*sql.Selector)
+
+// WorkflowExecution is the predicate function for workflowexecution builders.
+type WorkflowExecution func(*sql.Selector)
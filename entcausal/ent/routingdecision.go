@@ -0,0 +1,319 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// RoutingDecision is the model entity for the RoutingDecision schema.
+type RoutingDecision struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Unique decision identifier
+	ID string `json:"id,omitempty"`
+	// Tamper-evident digest over the record and its parents
+	ProvenanceHash string `json:"provenance_hash,omitempty"`
+	// Timestamp when decision was made
+	Timestamp time.Time `json:"timestamp,omitempty"`
+	// ID of the inference this decision belongs to
+	InferenceID string `json:"inference_id,omitempty"`
+	// Type of routing decision
+	DecisionType routingdecision.DecisionType `json:"decision_type,omitempty"`
+	// Layer where decision was made
+	LayerIndex int `json:"layer_index,omitempty"`
+	// Probability from gate network
+	GateProbability float64 `json:"gate_probability,omitempty"`
+	// Model selected for routing
+	SelectedModel string `json:"selected_model,omitempty"`
+	// Current iteration number
+	IterationCount int `json:"iteration_count,omitempty"`
+	// Confidence in the decision
+	Confidence float64 `json:"confidence,omitempty"`
+	// Domain context for the decision
+	Domain string `json:"domain,omitempty"`
+	// Additional metadata
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RoutingDecisionQuery when eager-loading is set.
+	Edges        RoutingDecisionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RoutingDecisionEdges holds the relations/edges for other nodes in the graph.
+type RoutingDecisionEdges struct {
+	// Spike events that caused this decision
+	SpikeEvents []*SpikeEvent `json:"spike_events,omitempty"`
+	// Agent actions triggered by this decision
+	Actions []*AgentAction `json:"actions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+	// totalCount holds the count of the edges above.
+	totalCount [2]map[string]int
+
+	namedSpikeEvents map[string][]*SpikeEvent
+	namedActions     map[string][]*AgentAction
+}
+
+// SpikeEventsOrErr returns the SpikeEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoutingDecisionEdges) SpikeEventsOrErr() ([]*SpikeEvent, error) {
+	if e.loadedTypes[0] {
+		return e.SpikeEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "spike_events"}
+}
+
+// ActionsOrErr returns the Actions value or an error if the edge
+// was not loaded in eager-loading.
+func (e RoutingDecisionEdges) ActionsOrErr() ([]*AgentAction, error) {
+	if e.loadedTypes[1] {
+		return e.Actions, nil
+	}
+	return nil, &NotLoadedError{edge: "actions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RoutingDecision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case routingdecision.FieldMetadata:
+			values[i] = new([]byte)
+		case routingdecision.FieldGateProbability, routingdecision.FieldConfidence:
+			values[i] = new(sql.NullFloat64)
+		case routingdecision.FieldLayerIndex, routingdecision.FieldIterationCount:
+			values[i] = new(sql.NullInt64)
+		case routingdecision.FieldID, routingdecision.FieldProvenanceHash, routingdecision.FieldInferenceID, routingdecision.FieldDecisionType, routingdecision.FieldSelectedModel, routingdecision.FieldDomain:
+			values[i] = new(sql.NullString)
+		case routingdecision.FieldTimestamp:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RoutingDecision fields.
+func (rd *RoutingDecision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case routingdecision.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				rd.ID = value.String
+			}
+		case routingdecision.FieldProvenanceHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provenance_hash", values[i])
+			} else if value.Valid {
+				rd.ProvenanceHash = value.String
+			}
+		case routingdecision.FieldTimestamp:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
+			} else if value.Valid {
+				rd.Timestamp = value.Time
+			}
+		case routingdecision.FieldInferenceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field inference_id", values[i])
+			} else if value.Valid {
+				rd.InferenceID = value.String
+			}
+		case routingdecision.FieldDecisionType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field decision_type", values[i])
+			} else if value.Valid {
+				rd.DecisionType = routingdecision.DecisionType(value.String)
+			}
+		case routingdecision.FieldLayerIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field layer_index", values[i])
+			} else if value.Valid {
+				rd.LayerIndex = int(value.Int64)
+			}
+		case routingdecision.FieldGateProbability:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field gate_probability", values[i])
+			} else if value.Valid {
+				rd.GateProbability = value.Float64
+			}
+		case routingdecision.FieldSelectedModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field selected_model", values[i])
+			} else if value.Valid {
+				rd.SelectedModel = value.String
+			}
+		case routingdecision.FieldIterationCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field iteration_count", values[i])
+			} else if value.Valid {
+				rd.IterationCount = int(value.Int64)
+			}
+		case routingdecision.FieldConfidence:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field confidence", values[i])
+			} else if value.Valid {
+				rd.Confidence = value.Float64
+			}
+		case routingdecision.FieldDomain:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field domain", values[i])
+			} else if value.Valid {
+				rd.Domain = value.String
+			}
+		case routingdecision.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &rd.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		default:
+			rd.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RoutingDecision.
+// This includes values selected through modifiers, order, etc.
+func (rd *RoutingDecision) Value(name string) (ent.Value, error) {
+	return rd.selectValues.Get(name)
+}
+
+// QuerySpikeEvents queries the "spike_events" edge of the RoutingDecision entity.
+func (rd *RoutingDecision) QuerySpikeEvents() *SpikeEventQuery {
+	return NewRoutingDecisionClient(rd.config).QuerySpikeEvents(rd)
+}
+
+// QueryActions queries the "actions" edge of the RoutingDecision entity.
+func (rd *RoutingDecision) QueryActions() *AgentActionQuery {
+	return NewRoutingDecisionClient(rd.config).QueryActions(rd)
+}
+
+// Update returns a builder for updating this RoutingDecision.
+// Note that you need to call RoutingDecision.Unwrap() before calling this method if this RoutingDecision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rd *RoutingDecision) Update() *RoutingDecisionUpdateOne {
+	return NewRoutingDecisionClient(rd.config).UpdateOne(rd)
+}
+
+// Unwrap unwraps the RoutingDecision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rd *RoutingDecision) Unwrap() *RoutingDecision {
+	_tx, ok := rd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RoutingDecision is not a transactional entity")
+	}
+	rd.config.driver = _tx.drv
+	return rd
+}
+
+// String implements the fmt.Stringer.
+func (rd *RoutingDecision) String() string {
+	var builder strings.Builder
+	builder.WriteString("RoutingDecision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rd.ID))
+	builder.WriteString("provenance_hash=")
+	builder.WriteString(rd.ProvenanceHash)
+	builder.WriteString(", ")
+	builder.WriteString("timestamp=")
+	builder.WriteString(rd.Timestamp.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("inference_id=")
+	builder.WriteString(rd.InferenceID)
+	builder.WriteString(", ")
+	builder.WriteString("decision_type=")
+	builder.WriteString(fmt.Sprintf("%v", rd.DecisionType))
+	builder.WriteString(", ")
+	builder.WriteString("layer_index=")
+	builder.WriteString(fmt.Sprintf("%v", rd.LayerIndex))
+	builder.WriteString(", ")
+	builder.WriteString("gate_probability=")
+	builder.WriteString(fmt.Sprintf("%v", rd.GateProbability))
+	builder.WriteString(", ")
+	builder.WriteString("selected_model=")
+	builder.WriteString(rd.SelectedModel)
+	builder.WriteString(", ")
+	builder.WriteString("iteration_count=")
+	builder.WriteString(fmt.Sprintf("%v", rd.IterationCount))
+	builder.WriteString(", ")
+	builder.WriteString("confidence=")
+	builder.WriteString(fmt.Sprintf("%v", rd.Confidence))
+	builder.WriteString(", ")
+	builder.WriteString("domain=")
+	builder.WriteString(rd.Domain)
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", rd.Metadata))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedSpikeEvents returns the SpikeEvents named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (rd *RoutingDecision) NamedSpikeEvents(name string) ([]*SpikeEvent, error) {
+	if rd.Edges.namedSpikeEvents == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := rd.Edges.namedSpikeEvents[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (rd *RoutingDecision) appendNamedSpikeEvents(name string, edges ...*SpikeEvent) {
+	if rd.Edges.namedSpikeEvents == nil {
+		rd.Edges.namedSpikeEvents = make(map[string][]*SpikeEvent)
+	}
+	if len(edges) == 0 {
+		rd.Edges.namedSpikeEvents[name] = []*SpikeEvent{}
+	} else {
+		rd.Edges.namedSpikeEvents[name] = append(rd.Edges.namedSpikeEvents[name], edges...)
+	}
+}
+
+// NamedActions returns the Actions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (rd *RoutingDecision) NamedActions(name string) ([]*AgentAction, error) {
+	if rd.Edges.namedActions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := rd.Edges.namedActions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (rd *RoutingDecision) appendNamedActions(name string, edges ...*AgentAction) {
+	if rd.Edges.namedActions == nil {
+		rd.Edges.namedActions = make(map[string][]*AgentAction)
+	}
+	if len(edges) == 0 {
+		rd.Edges.namedActions[name] = []*AgentAction{}
+	} else {
+		rd.Edges.namedActions[name] = append(rd.Edges.namedActions[name], edges...)
+	}
+}
+
+// RoutingDecisions is a parsable slice of RoutingDecision.
+type RoutingDecisions []*RoutingDecision
@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package routingdecision
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the routingdecision type in the database.
+	Label = "routing_decision"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProvenanceHash holds the string denoting the provenance_hash field in the database.
+	FieldProvenanceHash = "provenance_hash"
+	// FieldTimestamp holds the string denoting the timestamp field in the database.
+	FieldTimestamp = "timestamp"
+	// FieldInferenceID holds the string denoting the inference_id field in the database.
+	FieldInferenceID = "inference_id"
+	// FieldDecisionType holds the string denoting the decision_type field in the database.
+	FieldDecisionType = "decision_type"
+	// FieldLayerIndex holds the string denoting the layer_index field in the database.
+	FieldLayerIndex = "layer_index"
+	// FieldGateProbability holds the string denoting the gate_probability field in the database.
+	FieldGateProbability = "gate_probability"
+	// FieldSelectedModel holds the string denoting the selected_model field in the database.
+	FieldSelectedModel = "selected_model"
+	// FieldIterationCount holds the string denoting the iteration_count field in the database.
+	FieldIterationCount = "iteration_count"
+	// FieldConfidence holds the string denoting the confidence field in the database.
+	FieldConfidence = "confidence"
+	// FieldDomain holds the string denoting the domain field in the database.
+	FieldDomain = "domain"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// EdgeSpikeEvents holds the string denoting the spike_events edge name in mutations.
+	EdgeSpikeEvents = "spike_events"
+	// EdgeActions holds the string denoting the actions edge name in mutations.
+	EdgeActions = "actions"
+	// Table holds the table name of the routingdecision in the database.
+	Table = "routing_decisions"
+	// SpikeEventsTable is the table that holds the spike_events relation/edge. The primary key declared below.
+	SpikeEventsTable = "spike_event_decisions"
+	// SpikeEventsInverseTable is the table name for the SpikeEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "spikeevent" package.
+	SpikeEventsInverseTable = "spike_events"
+	// ActionsTable is the table that holds the actions relation/edge. The primary key declared below.
+	ActionsTable = "routing_decision_actions"
+	// ActionsInverseTable is the table name for the AgentAction entity.
+	// It exists in this package in order to avoid circular dependency with the "agentaction" package.
+	ActionsInverseTable = "agent_actions"
+)
+
+// Columns holds all SQL columns for routingdecision fields.
+var Columns = []string{
+	FieldID,
+	FieldProvenanceHash,
+	FieldTimestamp,
+	FieldInferenceID,
+	FieldDecisionType,
+	FieldLayerIndex,
+	FieldGateProbability,
+	FieldSelectedModel,
+	FieldIterationCount,
+	FieldConfidence,
+	FieldDomain,
+	FieldMetadata,
+}
+
+var (
+	// SpikeEventsPrimaryKey and SpikeEventsColumn2 are the table columns denoting the
+	// primary key for the spike_events relation (M2M).
+	SpikeEventsPrimaryKey = []string{"spike_event_id", "routing_decision_id"}
+	// ActionsPrimaryKey and ActionsColumn2 are the table columns denoting the
+	// primary key for the actions relation (M2M).
+	ActionsPrimaryKey = []string{"routing_decision_id", "agent_action_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTimestamp holds the default value on creation for the "timestamp" field.
+	DefaultTimestamp func() time.Time
+	// InferenceIDValidator is a validator for the "inference_id" field. It is called by the builders before save.
+	InferenceIDValidator func(string) error
+	// DefaultLayerIndex holds the default value on creation for the "layer_index" field.
+	DefaultLayerIndex int
+	// DefaultGateProbability holds the default value on creation for the "gate_probability" field.
+	DefaultGateProbability float64
+	// GateProbabilityValidator is a validator for the "gate_probability" field. It is called by the builders before save.
+	GateProbabilityValidator func(float64) error
+	// DefaultIterationCount holds the default value on creation for the "iteration_count" field.
+	DefaultIterationCount int
+	// DefaultConfidence holds the default value on creation for the "confidence" field.
+	DefaultConfidence float64
+	// ConfidenceValidator is a validator for the "confidence" field. It is called by the builders before save.
+	ConfidenceValidator func(float64) error
+)
+
+// DecisionType defines the type for the "decision_type" enum field.
+type DecisionType string
+
+// DecisionType values.
+const (
+	DecisionTypeExit     DecisionType = "exit"
+	DecisionTypeSkip     DecisionType = "skip"
+	DecisionTypeRoute    DecisionType = "route"
+	DecisionTypeEscalate DecisionType = "escalate"
+	DecisionTypeIterate  DecisionType = "iterate"
+)
+
+func (dt DecisionType) String() string {
+	return string(dt)
+}
+
+// DecisionTypeValidator is a validator for the "decision_type" field enum values. It is called by the builders before save.
+func DecisionTypeValidator(dt DecisionType) error {
+	switch dt {
+	case DecisionTypeExit, DecisionTypeSkip, DecisionTypeRoute, DecisionTypeEscalate, DecisionTypeIterate:
+		return nil
+	default:
+		return fmt.Errorf("routingdecision: invalid enum value for decision_type field: %q", dt)
+	}
+}
+
+// OrderOption defines the ordering options for the RoutingDecision queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProvenanceHash orders the results by the provenance_hash field.
+func ByProvenanceHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvenanceHash, opts...).ToFunc()
+}
+
+// ByTimestamp orders the results by the timestamp field.
+func ByTimestamp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimestamp, opts...).ToFunc()
+}
+
+// ByInferenceID orders the results by the inference_id field.
+func ByInferenceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInferenceID, opts...).ToFunc()
+}
+
+// ByDecisionType orders the results by the decision_type field.
+func ByDecisionType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDecisionType, opts...).ToFunc()
+}
+
+// ByLayerIndex orders the results by the layer_index field.
+func ByLayerIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLayerIndex, opts...).ToFunc()
+}
+
+// ByGateProbability orders the results by the gate_probability field.
+func ByGateProbability(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGateProbability, opts...).ToFunc()
+}
+
+// BySelectedModel orders the results by the selected_model field.
+func BySelectedModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSelectedModel, opts...).ToFunc()
+}
+
+// ByIterationCount orders the results by the iteration_count field.
+func ByIterationCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIterationCount, opts...).ToFunc()
+}
+
+// ByConfidence orders the results by the confidence field.
+func ByConfidence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConfidence, opts...).ToFunc()
+}
+
+// ByDomain orders the results by the domain field.
+func ByDomain(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDomain, opts...).ToFunc()
+}
+
+// BySpikeEventsCount orders the results by spike_events count.
+func BySpikeEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSpikeEventsStep(), opts...)
+	}
+}
+
+// BySpikeEvents orders the results by spike_events terms.
+func BySpikeEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSpikeEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByActionsCount orders the results by actions count.
+func ByActionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newActionsStep(), opts...)
+	}
+}
+
+// ByActions orders the results by actions terms.
+func ByActions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newActionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newSpikeEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SpikeEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, SpikeEventsTable, SpikeEventsPrimaryKey...),
+	)
+}
+func newActionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ActionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, ActionsTable, ActionsPrimaryKey...),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e DecisionType) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *DecisionType) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = DecisionType(str)
+	if err := DecisionTypeValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid DecisionType", str)
+	}
+	return nil
+}
@@ -0,0 +1,692 @@
+// Code generated by ent, DO NOT EDIT.
+
+package routingdecision
+
+import (
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldContainsFold(FieldID, id))
+}
+
+// ProvenanceHash applies equality check predicate on the "provenance_hash" field. It's identical to ProvenanceHashEQ.
+func ProvenanceHash(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEQ(FieldProvenanceHash, v))
+}
+
+// Timestamp applies equality check predicate on the "timestamp" field. It's identical to TimestampEQ.
+func Timestamp(v time.Time) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// InferenceID applies equality check predicate on the "inference_id" field. It's identical to InferenceIDEQ.
+func InferenceID(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEQ(FieldInferenceID, v))
+}
+
+// LayerIndex applies equality check predicate on the "layer_index" field. It's identical to LayerIndexEQ.
+func LayerIndex(v int) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEQ(FieldLayerIndex, v))
+}
+
+// GateProbability applies equality check predicate on the "gate_probability" field. It's identical to GateProbabilityEQ.
+func GateProbability(v float64) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEQ(FieldGateProbability, v))
+}
+
+// SelectedModel applies equality check predicate on the "selected_model" field. It's identical to SelectedModelEQ.
+func SelectedModel(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEQ(FieldSelectedModel, v))
+}
+
+// IterationCount applies equality check predicate on the "iteration_count" field. It's identical to IterationCountEQ.
+func IterationCount(v int) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEQ(FieldIterationCount, v))
+}
+
+// Confidence applies equality check predicate on the "confidence" field. It's identical to ConfidenceEQ.
+func Confidence(v float64) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEQ(FieldConfidence, v))
+}
+
+// Domain applies equality check predicate on the "domain" field. It's identical to DomainEQ.
+func Domain(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEQ(FieldDomain, v))
+}
+
+// ProvenanceHashEQ applies the EQ predicate on the "provenance_hash" field.
+func ProvenanceHashEQ(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEQ(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashNEQ applies the NEQ predicate on the "provenance_hash" field.
+func ProvenanceHashNEQ(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNEQ(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashIn applies the In predicate on the "provenance_hash" field.
+func ProvenanceHashIn(vs ...string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldIn(FieldProvenanceHash, vs...))
+}
+
+// ProvenanceHashNotIn applies the NotIn predicate on the "provenance_hash" field.
+func ProvenanceHashNotIn(vs ...string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNotIn(FieldProvenanceHash, vs...))
+}
+
+// ProvenanceHashGT applies the GT predicate on the "provenance_hash" field.
+func ProvenanceHashGT(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldGT(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashGTE applies the GTE predicate on the "provenance_hash" field.
+func ProvenanceHashGTE(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldGTE(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashLT applies the LT predicate on the "provenance_hash" field.
+func ProvenanceHashLT(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldLT(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashLTE applies the LTE predicate on the "provenance_hash" field.
+func ProvenanceHashLTE(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldLTE(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashContains applies the Contains predicate on the "provenance_hash" field.
+func ProvenanceHashContains(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldContains(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashHasPrefix applies the HasPrefix predicate on the "provenance_hash" field.
+func ProvenanceHashHasPrefix(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldHasPrefix(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashHasSuffix applies the HasSuffix predicate on the "provenance_hash" field.
+func ProvenanceHashHasSuffix(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldHasSuffix(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashIsNil applies the IsNil predicate on the "provenance_hash" field.
+func ProvenanceHashIsNil() predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldIsNull(FieldProvenanceHash))
+}
+
+// ProvenanceHashNotNil applies the NotNil predicate on the "provenance_hash" field.
+func ProvenanceHashNotNil() predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNotNull(FieldProvenanceHash))
+}
+
+// ProvenanceHashEqualFold applies the EqualFold predicate on the "provenance_hash" field.
+func ProvenanceHashEqualFold(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEqualFold(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashContainsFold applies the ContainsFold predicate on the "provenance_hash" field.
+func ProvenanceHashContainsFold(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldContainsFold(FieldProvenanceHash, v))
+}
+
+// TimestampEQ applies the EQ predicate on the "timestamp" field.
+func TimestampEQ(v time.Time) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// TimestampNEQ applies the NEQ predicate on the "timestamp" field.
+func TimestampNEQ(v time.Time) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNEQ(FieldTimestamp, v))
+}
+
+// TimestampIn applies the In predicate on the "timestamp" field.
+func TimestampIn(vs ...time.Time) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldIn(FieldTimestamp, vs...))
+}
+
+// TimestampNotIn applies the NotIn predicate on the "timestamp" field.
+func TimestampNotIn(vs ...time.Time) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNotIn(FieldTimestamp, vs...))
+}
+
+// TimestampGT applies the GT predicate on the "timestamp" field.
+func TimestampGT(v time.Time) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldGT(FieldTimestamp, v))
+}
+
+// TimestampGTE applies the GTE predicate on the "timestamp" field.
+func TimestampGTE(v time.Time) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldGTE(FieldTimestamp, v))
+}
+
+// TimestampLT applies the LT predicate on the "timestamp" field.
+func TimestampLT(v time.Time) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldLT(FieldTimestamp, v))
+}
+
+// TimestampLTE applies the LTE predicate on the "timestamp" field.
+func TimestampLTE(v time.Time) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldLTE(FieldTimestamp, v))
+}
+
+// InferenceIDEQ applies the EQ predicate on the "inference_id" field.
+func InferenceIDEQ(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEQ(FieldInferenceID, v))
+}
+
+// InferenceIDNEQ applies the NEQ predicate on the "inference_id" field.
+func InferenceIDNEQ(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNEQ(FieldInferenceID, v))
+}
+
+// InferenceIDIn applies the In predicate on the "inference_id" field.
+func InferenceIDIn(vs ...string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldIn(FieldInferenceID, vs...))
+}
+
+// InferenceIDNotIn applies the NotIn predicate on the "inference_id" field.
+func InferenceIDNotIn(vs ...string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNotIn(FieldInferenceID, vs...))
+}
+
+// InferenceIDGT applies the GT predicate on the "inference_id" field.
+func InferenceIDGT(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldGT(FieldInferenceID, v))
+}
+
+// InferenceIDGTE applies the GTE predicate on the "inference_id" field.
+func InferenceIDGTE(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldGTE(FieldInferenceID, v))
+}
+
+// InferenceIDLT applies the LT predicate on the "inference_id" field.
+func InferenceIDLT(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldLT(FieldInferenceID, v))
+}
+
+// InferenceIDLTE applies the LTE predicate on the "inference_id" field.
+func InferenceIDLTE(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldLTE(FieldInferenceID, v))
+}
+
+// InferenceIDContains applies the Contains predicate on the "inference_id" field.
+func InferenceIDContains(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldContains(FieldInferenceID, v))
+}
+
+// InferenceIDHasPrefix applies the HasPrefix predicate on the "inference_id" field.
+func InferenceIDHasPrefix(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldHasPrefix(FieldInferenceID, v))
+}
+
+// InferenceIDHasSuffix applies the HasSuffix predicate on the "inference_id" field.
+func InferenceIDHasSuffix(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldHasSuffix(FieldInferenceID, v))
+}
+
+// InferenceIDEqualFold applies the EqualFold predicate on the "inference_id" field.
+func InferenceIDEqualFold(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEqualFold(FieldInferenceID, v))
+}
+
+// InferenceIDContainsFold applies the ContainsFold predicate on the "inference_id" field.
+func InferenceIDContainsFold(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldContainsFold(FieldInferenceID, v))
+}
+
+// DecisionTypeEQ applies the EQ predicate on the "decision_type" field.
+func DecisionTypeEQ(v DecisionType) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEQ(FieldDecisionType, v))
+}
+
+// DecisionTypeNEQ applies the NEQ predicate on the "decision_type" field.
+func DecisionTypeNEQ(v DecisionType) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNEQ(FieldDecisionType, v))
+}
+
+// DecisionTypeIn applies the In predicate on the "decision_type" field.
+func DecisionTypeIn(vs ...DecisionType) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldIn(FieldDecisionType, vs...))
+}
+
+// DecisionTypeNotIn applies the NotIn predicate on the "decision_type" field.
+func DecisionTypeNotIn(vs ...DecisionType) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNotIn(FieldDecisionType, vs...))
+}
+
+// LayerIndexEQ applies the EQ predicate on the "layer_index" field.
+func LayerIndexEQ(v int) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEQ(FieldLayerIndex, v))
+}
+
+// LayerIndexNEQ applies the NEQ predicate on the "layer_index" field.
+func LayerIndexNEQ(v int) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNEQ(FieldLayerIndex, v))
+}
+
+// LayerIndexIn applies the In predicate on the "layer_index" field.
+func LayerIndexIn(vs ...int) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldIn(FieldLayerIndex, vs...))
+}
+
+// LayerIndexNotIn applies the NotIn predicate on the "layer_index" field.
+func LayerIndexNotIn(vs ...int) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNotIn(FieldLayerIndex, vs...))
+}
+
+// LayerIndexGT applies the GT predicate on the "layer_index" field.
+func LayerIndexGT(v int) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldGT(FieldLayerIndex, v))
+}
+
+// LayerIndexGTE applies the GTE predicate on the "layer_index" field.
+func LayerIndexGTE(v int) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldGTE(FieldLayerIndex, v))
+}
+
+// LayerIndexLT applies the LT predicate on the "layer_index" field.
+func LayerIndexLT(v int) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldLT(FieldLayerIndex, v))
+}
+
+// LayerIndexLTE applies the LTE predicate on the "layer_index" field.
+func LayerIndexLTE(v int) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldLTE(FieldLayerIndex, v))
+}
+
+// GateProbabilityEQ applies the EQ predicate on the "gate_probability" field.
+func GateProbabilityEQ(v float64) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEQ(FieldGateProbability, v))
+}
+
+// GateProbabilityNEQ applies the NEQ predicate on the "gate_probability" field.
+func GateProbabilityNEQ(v float64) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNEQ(FieldGateProbability, v))
+}
+
+// GateProbabilityIn applies the In predicate on the "gate_probability" field.
+func GateProbabilityIn(vs ...float64) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldIn(FieldGateProbability, vs...))
+}
+
+// GateProbabilityNotIn applies the NotIn predicate on the "gate_probability" field.
+func GateProbabilityNotIn(vs ...float64) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNotIn(FieldGateProbability, vs...))
+}
+
+// GateProbabilityGT applies the GT predicate on the "gate_probability" field.
+func GateProbabilityGT(v float64) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldGT(FieldGateProbability, v))
+}
+
+// GateProbabilityGTE applies the GTE predicate on the "gate_probability" field.
+func GateProbabilityGTE(v float64) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldGTE(FieldGateProbability, v))
+}
+
+// GateProbabilityLT applies the LT predicate on the "gate_probability" field.
+func GateProbabilityLT(v float64) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldLT(FieldGateProbability, v))
+}
+
+// GateProbabilityLTE applies the LTE predicate on the "gate_probability" field.
+func GateProbabilityLTE(v float64) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldLTE(FieldGateProbability, v))
+}
+
+// SelectedModelEQ applies the EQ predicate on the "selected_model" field.
+func SelectedModelEQ(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEQ(FieldSelectedModel, v))
+}
+
+// SelectedModelNEQ applies the NEQ predicate on the "selected_model" field.
+func SelectedModelNEQ(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNEQ(FieldSelectedModel, v))
+}
+
+// SelectedModelIn applies the In predicate on the "selected_model" field.
+func SelectedModelIn(vs ...string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldIn(FieldSelectedModel, vs...))
+}
+
+// SelectedModelNotIn applies the NotIn predicate on the "selected_model" field.
+func SelectedModelNotIn(vs ...string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNotIn(FieldSelectedModel, vs...))
+}
+
+// SelectedModelGT applies the GT predicate on the "selected_model" field.
+func SelectedModelGT(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldGT(FieldSelectedModel, v))
+}
+
+// SelectedModelGTE applies the GTE predicate on the "selected_model" field.
+func SelectedModelGTE(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldGTE(FieldSelectedModel, v))
+}
+
+// SelectedModelLT applies the LT predicate on the "selected_model" field.
+func SelectedModelLT(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldLT(FieldSelectedModel, v))
+}
+
+// SelectedModelLTE applies the LTE predicate on the "selected_model" field.
+func SelectedModelLTE(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldLTE(FieldSelectedModel, v))
+}
+
+// SelectedModelContains applies the Contains predicate on the "selected_model" field.
+func SelectedModelContains(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldContains(FieldSelectedModel, v))
+}
+
+// SelectedModelHasPrefix applies the HasPrefix predicate on the "selected_model" field.
+func SelectedModelHasPrefix(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldHasPrefix(FieldSelectedModel, v))
+}
+
+// SelectedModelHasSuffix applies the HasSuffix predicate on the "selected_model" field.
+func SelectedModelHasSuffix(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldHasSuffix(FieldSelectedModel, v))
+}
+
+// SelectedModelIsNil applies the IsNil predicate on the "selected_model" field.
+func SelectedModelIsNil() predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldIsNull(FieldSelectedModel))
+}
+
+// SelectedModelNotNil applies the NotNil predicate on the "selected_model" field.
+func SelectedModelNotNil() predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNotNull(FieldSelectedModel))
+}
+
+// SelectedModelEqualFold applies the EqualFold predicate on the "selected_model" field.
+func SelectedModelEqualFold(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEqualFold(FieldSelectedModel, v))
+}
+
+// SelectedModelContainsFold applies the ContainsFold predicate on the "selected_model" field.
+func SelectedModelContainsFold(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldContainsFold(FieldSelectedModel, v))
+}
+
+// IterationCountEQ applies the EQ predicate on the "iteration_count" field.
+func IterationCountEQ(v int) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEQ(FieldIterationCount, v))
+}
+
+// IterationCountNEQ applies the NEQ predicate on the "iteration_count" field.
+func IterationCountNEQ(v int) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNEQ(FieldIterationCount, v))
+}
+
+// IterationCountIn applies the In predicate on the "iteration_count" field.
+func IterationCountIn(vs ...int) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldIn(FieldIterationCount, vs...))
+}
+
+// IterationCountNotIn applies the NotIn predicate on the "iteration_count" field.
+func IterationCountNotIn(vs ...int) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNotIn(FieldIterationCount, vs...))
+}
+
+// IterationCountGT applies the GT predicate on the "iteration_count" field.
+func IterationCountGT(v int) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldGT(FieldIterationCount, v))
+}
+
+// IterationCountGTE applies the GTE predicate on the "iteration_count" field.
+func IterationCountGTE(v int) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldGTE(FieldIterationCount, v))
+}
+
+// IterationCountLT applies the LT predicate on the "iteration_count" field.
+func IterationCountLT(v int) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldLT(FieldIterationCount, v))
+}
+
+// IterationCountLTE applies the LTE predicate on the "iteration_count" field.
+func IterationCountLTE(v int) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldLTE(FieldIterationCount, v))
+}
+
+// ConfidenceEQ applies the EQ predicate on the "confidence" field.
+func ConfidenceEQ(v float64) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEQ(FieldConfidence, v))
+}
+
+// ConfidenceNEQ applies the NEQ predicate on the "confidence" field.
+func ConfidenceNEQ(v float64) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNEQ(FieldConfidence, v))
+}
+
+// ConfidenceIn applies the In predicate on the "confidence" field.
+func ConfidenceIn(vs ...float64) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldIn(FieldConfidence, vs...))
+}
+
+// ConfidenceNotIn applies the NotIn predicate on the "confidence" field.
+func ConfidenceNotIn(vs ...float64) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNotIn(FieldConfidence, vs...))
+}
+
+// ConfidenceGT applies the GT predicate on the "confidence" field.
+func ConfidenceGT(v float64) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldGT(FieldConfidence, v))
+}
+
+// ConfidenceGTE applies the GTE predicate on the "confidence" field.
+func ConfidenceGTE(v float64) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldGTE(FieldConfidence, v))
+}
+
+// ConfidenceLT applies the LT predicate on the "confidence" field.
+func ConfidenceLT(v float64) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldLT(FieldConfidence, v))
+}
+
+// ConfidenceLTE applies the LTE predicate on the "confidence" field.
+func ConfidenceLTE(v float64) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldLTE(FieldConfidence, v))
+}
+
+// DomainEQ applies the EQ predicate on the "domain" field.
+func DomainEQ(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEQ(FieldDomain, v))
+}
+
+// DomainNEQ applies the NEQ predicate on the "domain" field.
+func DomainNEQ(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNEQ(FieldDomain, v))
+}
+
+// DomainIn applies the In predicate on the "domain" field.
+func DomainIn(vs ...string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldIn(FieldDomain, vs...))
+}
+
+// DomainNotIn applies the NotIn predicate on the "domain" field.
+func DomainNotIn(vs ...string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNotIn(FieldDomain, vs...))
+}
+
+// DomainGT applies the GT predicate on the "domain" field.
+func DomainGT(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldGT(FieldDomain, v))
+}
+
+// DomainGTE applies the GTE predicate on the "domain" field.
+func DomainGTE(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldGTE(FieldDomain, v))
+}
+
+// DomainLT applies the LT predicate on the "domain" field.
+func DomainLT(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldLT(FieldDomain, v))
+}
+
+// DomainLTE applies the LTE predicate on the "domain" field.
+func DomainLTE(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldLTE(FieldDomain, v))
+}
+
+// DomainContains applies the Contains predicate on the "domain" field.
+func DomainContains(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldContains(FieldDomain, v))
+}
+
+// DomainHasPrefix applies the HasPrefix predicate on the "domain" field.
+func DomainHasPrefix(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldHasPrefix(FieldDomain, v))
+}
+
+// DomainHasSuffix applies the HasSuffix predicate on the "domain" field.
+func DomainHasSuffix(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldHasSuffix(FieldDomain, v))
+}
+
+// DomainIsNil applies the IsNil predicate on the "domain" field.
+func DomainIsNil() predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldIsNull(FieldDomain))
+}
+
+// DomainNotNil applies the NotNil predicate on the "domain" field.
+func DomainNotNil() predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNotNull(FieldDomain))
+}
+
+// DomainEqualFold applies the EqualFold predicate on the "domain" field.
+func DomainEqualFold(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldEqualFold(FieldDomain, v))
+}
+
+// DomainContainsFold applies the ContainsFold predicate on the "domain" field.
+func DomainContainsFold(v string) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldContainsFold(FieldDomain, v))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.FieldNotNull(FieldMetadata))
+}
+
+// HasSpikeEvents applies the HasEdge predicate on the "spike_events" edge.
+func HasSpikeEvents() predicate.RoutingDecision {
+	return predicate.RoutingDecision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, SpikeEventsTable, SpikeEventsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSpikeEventsWith applies the HasEdge predicate on the "spike_events" edge with a given conditions (other predicates).
+func HasSpikeEventsWith(preds ...predicate.SpikeEvent) predicate.RoutingDecision {
+	return predicate.RoutingDecision(func(s *sql.Selector) {
+		step := newSpikeEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasActions applies the HasEdge predicate on the "actions" edge.
+func HasActions() predicate.RoutingDecision {
+	return predicate.RoutingDecision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, ActionsTable, ActionsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasActionsWith applies the HasEdge predicate on the "actions" edge with a given conditions (other predicates).
+func HasActionsWith(preds ...predicate.AgentAction) predicate.RoutingDecision {
+	return predicate.RoutingDecision(func(s *sql.Selector) {
+		step := newActionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RoutingDecision) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RoutingDecision) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RoutingDecision) predicate.RoutingDecision {
+	return predicate.RoutingDecision(sql.NotPredicates(p))
+}
@@ -0,0 +1,487 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/spikeevent"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RoutingDecisionCreate is the builder for creating a RoutingDecision entity.
+type RoutingDecisionCreate struct {
+	config
+	mutation *RoutingDecisionMutation
+	hooks    []Hook
+}
+
+// SetProvenanceHash sets the "provenance_hash" field.
+func (rdc *RoutingDecisionCreate) SetProvenanceHash(s string) *RoutingDecisionCreate {
+	rdc.mutation.SetProvenanceHash(s)
+	return rdc
+}
+
+// SetNillableProvenanceHash sets the "provenance_hash" field if the given value is not nil.
+func (rdc *RoutingDecisionCreate) SetNillableProvenanceHash(s *string) *RoutingDecisionCreate {
+	if s != nil {
+		rdc.SetProvenanceHash(*s)
+	}
+	return rdc
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (rdc *RoutingDecisionCreate) SetTimestamp(t time.Time) *RoutingDecisionCreate {
+	rdc.mutation.SetTimestamp(t)
+	return rdc
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (rdc *RoutingDecisionCreate) SetNillableTimestamp(t *time.Time) *RoutingDecisionCreate {
+	if t != nil {
+		rdc.SetTimestamp(*t)
+	}
+	return rdc
+}
+
+// SetInferenceID sets the "inference_id" field.
+func (rdc *RoutingDecisionCreate) SetInferenceID(s string) *RoutingDecisionCreate {
+	rdc.mutation.SetInferenceID(s)
+	return rdc
+}
+
+// SetDecisionType sets the "decision_type" field.
+func (rdc *RoutingDecisionCreate) SetDecisionType(rt routingdecision.DecisionType) *RoutingDecisionCreate {
+	rdc.mutation.SetDecisionType(rt)
+	return rdc
+}
+
+// SetLayerIndex sets the "layer_index" field.
+func (rdc *RoutingDecisionCreate) SetLayerIndex(i int) *RoutingDecisionCreate {
+	rdc.mutation.SetLayerIndex(i)
+	return rdc
+}
+
+// SetNillableLayerIndex sets the "layer_index" field if the given value is not nil.
+func (rdc *RoutingDecisionCreate) SetNillableLayerIndex(i *int) *RoutingDecisionCreate {
+	if i != nil {
+		rdc.SetLayerIndex(*i)
+	}
+	return rdc
+}
+
+// SetGateProbability sets the "gate_probability" field.
+func (rdc *RoutingDecisionCreate) SetGateProbability(f float64) *RoutingDecisionCreate {
+	rdc.mutation.SetGateProbability(f)
+	return rdc
+}
+
+// SetNillableGateProbability sets the "gate_probability" field if the given value is not nil.
+func (rdc *RoutingDecisionCreate) SetNillableGateProbability(f *float64) *RoutingDecisionCreate {
+	if f != nil {
+		rdc.SetGateProbability(*f)
+	}
+	return rdc
+}
+
+// SetSelectedModel sets the "selected_model" field.
+func (rdc *RoutingDecisionCreate) SetSelectedModel(s string) *RoutingDecisionCreate {
+	rdc.mutation.SetSelectedModel(s)
+	return rdc
+}
+
+// SetNillableSelectedModel sets the "selected_model" field if the given value is not nil.
+func (rdc *RoutingDecisionCreate) SetNillableSelectedModel(s *string) *RoutingDecisionCreate {
+	if s != nil {
+		rdc.SetSelectedModel(*s)
+	}
+	return rdc
+}
+
+// SetIterationCount sets the "iteration_count" field.
+func (rdc *RoutingDecisionCreate) SetIterationCount(i int) *RoutingDecisionCreate {
+	rdc.mutation.SetIterationCount(i)
+	return rdc
+}
+
+// SetNillableIterationCount sets the "iteration_count" field if the given value is not nil.
+func (rdc *RoutingDecisionCreate) SetNillableIterationCount(i *int) *RoutingDecisionCreate {
+	if i != nil {
+		rdc.SetIterationCount(*i)
+	}
+	return rdc
+}
+
+// SetConfidence sets the "confidence" field.
+func (rdc *RoutingDecisionCreate) SetConfidence(f float64) *RoutingDecisionCreate {
+	rdc.mutation.SetConfidence(f)
+	return rdc
+}
+
+// SetNillableConfidence sets the "confidence" field if the given value is not nil.
+func (rdc *RoutingDecisionCreate) SetNillableConfidence(f *float64) *RoutingDecisionCreate {
+	if f != nil {
+		rdc.SetConfidence(*f)
+	}
+	return rdc
+}
+
+// SetDomain sets the "domain" field.
+func (rdc *RoutingDecisionCreate) SetDomain(s string) *RoutingDecisionCreate {
+	rdc.mutation.SetDomain(s)
+	return rdc
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (rdc *RoutingDecisionCreate) SetNillableDomain(s *string) *RoutingDecisionCreate {
+	if s != nil {
+		rdc.SetDomain(*s)
+	}
+	return rdc
+}
+
+// SetMetadata sets the "metadata" field.
+func (rdc *RoutingDecisionCreate) SetMetadata(m map[string]interface{}) *RoutingDecisionCreate {
+	rdc.mutation.SetMetadata(m)
+	return rdc
+}
+
+// SetID sets the "id" field.
+func (rdc *RoutingDecisionCreate) SetID(s string) *RoutingDecisionCreate {
+	rdc.mutation.SetID(s)
+	return rdc
+}
+
+// AddSpikeEventIDs adds the "spike_events" edge to the SpikeEvent entity by IDs.
+func (rdc *RoutingDecisionCreate) AddSpikeEventIDs(ids ...string) *RoutingDecisionCreate {
+	rdc.mutation.AddSpikeEventIDs(ids...)
+	return rdc
+}
+
+// AddSpikeEvents adds the "spike_events" edges to the SpikeEvent entity.
+func (rdc *RoutingDecisionCreate) AddSpikeEvents(s ...*SpikeEvent) *RoutingDecisionCreate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return rdc.AddSpikeEventIDs(ids...)
+}
+
+// AddActionIDs adds the "actions" edge to the AgentAction entity by IDs.
+func (rdc *RoutingDecisionCreate) AddActionIDs(ids ...string) *RoutingDecisionCreate {
+	rdc.mutation.AddActionIDs(ids...)
+	return rdc
+}
+
+// AddActions adds the "actions" edges to the AgentAction entity.
+func (rdc *RoutingDecisionCreate) AddActions(a ...*AgentAction) *RoutingDecisionCreate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return rdc.AddActionIDs(ids...)
+}
+
+// Mutation returns the RoutingDecisionMutation object of the builder.
+func (rdc *RoutingDecisionCreate) Mutation() *RoutingDecisionMutation {
+	return rdc.mutation
+}
+
+// Save creates the RoutingDecision in the database.
+func (rdc *RoutingDecisionCreate) Save(ctx context.Context) (*RoutingDecision, error) {
+	rdc.defaults()
+	return withHooks(ctx, rdc.sqlSave, rdc.mutation, rdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rdc *RoutingDecisionCreate) SaveX(ctx context.Context) *RoutingDecision {
+	v, err := rdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rdc *RoutingDecisionCreate) Exec(ctx context.Context) error {
+	_, err := rdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rdc *RoutingDecisionCreate) ExecX(ctx context.Context) {
+	if err := rdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rdc *RoutingDecisionCreate) defaults() {
+	if _, ok := rdc.mutation.Timestamp(); !ok {
+		v := routingdecision.DefaultTimestamp()
+		rdc.mutation.SetTimestamp(v)
+	}
+	if _, ok := rdc.mutation.LayerIndex(); !ok {
+		v := routingdecision.DefaultLayerIndex
+		rdc.mutation.SetLayerIndex(v)
+	}
+	if _, ok := rdc.mutation.GateProbability(); !ok {
+		v := routingdecision.DefaultGateProbability
+		rdc.mutation.SetGateProbability(v)
+	}
+	if _, ok := rdc.mutation.IterationCount(); !ok {
+		v := routingdecision.DefaultIterationCount
+		rdc.mutation.SetIterationCount(v)
+	}
+	if _, ok := rdc.mutation.Confidence(); !ok {
+		v := routingdecision.DefaultConfidence
+		rdc.mutation.SetConfidence(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rdc *RoutingDecisionCreate) check() error {
+	if _, ok := rdc.mutation.Timestamp(); !ok {
+		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "RoutingDecision.timestamp"`)}
+	}
+	if _, ok := rdc.mutation.InferenceID(); !ok {
+		return &ValidationError{Name: "inference_id", err: errors.New(`ent: missing required field "RoutingDecision.inference_id"`)}
+	}
+	if v, ok := rdc.mutation.InferenceID(); ok {
+		if err := routingdecision.InferenceIDValidator(v); err != nil {
+			return &ValidationError{Name: "inference_id", err: fmt.Errorf(`ent: validator failed for field "RoutingDecision.inference_id": %w`, err)}
+		}
+	}
+	if _, ok := rdc.mutation.DecisionType(); !ok {
+		return &ValidationError{Name: "decision_type", err: errors.New(`ent: missing required field "RoutingDecision.decision_type"`)}
+	}
+	if v, ok := rdc.mutation.DecisionType(); ok {
+		if err := routingdecision.DecisionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "decision_type", err: fmt.Errorf(`ent: validator failed for field "RoutingDecision.decision_type": %w`, err)}
+		}
+	}
+	if _, ok := rdc.mutation.LayerIndex(); !ok {
+		return &ValidationError{Name: "layer_index", err: errors.New(`ent: missing required field "RoutingDecision.layer_index"`)}
+	}
+	if _, ok := rdc.mutation.GateProbability(); !ok {
+		return &ValidationError{Name: "gate_probability", err: errors.New(`ent: missing required field "RoutingDecision.gate_probability"`)}
+	}
+	if v, ok := rdc.mutation.GateProbability(); ok {
+		if err := routingdecision.GateProbabilityValidator(v); err != nil {
+			return &ValidationError{Name: "gate_probability", err: fmt.Errorf(`ent: validator failed for field "RoutingDecision.gate_probability": %w`, err)}
+		}
+	}
+	if _, ok := rdc.mutation.IterationCount(); !ok {
+		return &ValidationError{Name: "iteration_count", err: errors.New(`ent: missing required field "RoutingDecision.iteration_count"`)}
+	}
+	if _, ok := rdc.mutation.Confidence(); !ok {
+		return &ValidationError{Name: "confidence", err: errors.New(`ent: missing required field "RoutingDecision.confidence"`)}
+	}
+	if v, ok := rdc.mutation.Confidence(); ok {
+		if err := routingdecision.ConfidenceValidator(v); err != nil {
+			return &ValidationError{Name: "confidence", err: fmt.Errorf(`ent: validator failed for field "RoutingDecision.confidence": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rdc *RoutingDecisionCreate) sqlSave(ctx context.Context) (*RoutingDecision, error) {
+	if err := rdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected RoutingDecision.ID type: %T", _spec.ID.Value)
+		}
+	}
+	rdc.mutation.id = &_node.ID
+	rdc.mutation.done = true
+	return _node, nil
+}
+
+func (rdc *RoutingDecisionCreate) createSpec() (*RoutingDecision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RoutingDecision{config: rdc.config}
+		_spec = sqlgraph.NewCreateSpec(routingdecision.Table, sqlgraph.NewFieldSpec(routingdecision.FieldID, field.TypeString))
+	)
+	if id, ok := rdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rdc.mutation.ProvenanceHash(); ok {
+		_spec.SetField(routingdecision.FieldProvenanceHash, field.TypeString, value)
+		_node.ProvenanceHash = value
+	}
+	if value, ok := rdc.mutation.Timestamp(); ok {
+		_spec.SetField(routingdecision.FieldTimestamp, field.TypeTime, value)
+		_node.Timestamp = value
+	}
+	if value, ok := rdc.mutation.InferenceID(); ok {
+		_spec.SetField(routingdecision.FieldInferenceID, field.TypeString, value)
+		_node.InferenceID = value
+	}
+	if value, ok := rdc.mutation.DecisionType(); ok {
+		_spec.SetField(routingdecision.FieldDecisionType, field.TypeEnum, value)
+		_node.DecisionType = value
+	}
+	if value, ok := rdc.mutation.LayerIndex(); ok {
+		_spec.SetField(routingdecision.FieldLayerIndex, field.TypeInt, value)
+		_node.LayerIndex = value
+	}
+	if value, ok := rdc.mutation.GateProbability(); ok {
+		_spec.SetField(routingdecision.FieldGateProbability, field.TypeFloat64, value)
+		_node.GateProbability = value
+	}
+	if value, ok := rdc.mutation.SelectedModel(); ok {
+		_spec.SetField(routingdecision.FieldSelectedModel, field.TypeString, value)
+		_node.SelectedModel = value
+	}
+	if value, ok := rdc.mutation.IterationCount(); ok {
+		_spec.SetField(routingdecision.FieldIterationCount, field.TypeInt, value)
+		_node.IterationCount = value
+	}
+	if value, ok := rdc.mutation.Confidence(); ok {
+		_spec.SetField(routingdecision.FieldConfidence, field.TypeFloat64, value)
+		_node.Confidence = value
+	}
+	if value, ok := rdc.mutation.Domain(); ok {
+		_spec.SetField(routingdecision.FieldDomain, field.TypeString, value)
+		_node.Domain = value
+	}
+	if value, ok := rdc.mutation.Metadata(); ok {
+		_spec.SetField(routingdecision.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if nodes := rdc.mutation.SpikeEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   routingdecision.SpikeEventsTable,
+			Columns: routingdecision.SpikeEventsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(spikeevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rdc.mutation.ActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   routingdecision.ActionsTable,
+			Columns: routingdecision.ActionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RoutingDecisionCreateBulk is the builder for creating many RoutingDecision entities in bulk.
+type RoutingDecisionCreateBulk struct {
+	config
+	err      error
+	builders []*RoutingDecisionCreate
+}
+
+// Save creates the RoutingDecision entities in the database.
+func (rdcb *RoutingDecisionCreateBulk) Save(ctx context.Context) ([]*RoutingDecision, error) {
+	if rdcb.err != nil {
+		return nil, rdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rdcb.builders))
+	nodes := make([]*RoutingDecision, len(rdcb.builders))
+	mutators := make([]Mutator, len(rdcb.builders))
+	for i := range rdcb.builders {
+		func(i int, root context.Context) {
+			builder := rdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RoutingDecisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rdcb *RoutingDecisionCreateBulk) SaveX(ctx context.Context) []*RoutingDecision {
+	v, err := rdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rdcb *RoutingDecisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := rdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rdcb *RoutingDecisionCreateBulk) ExecX(ctx context.Context) {
+	if err := rdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RoutingDecisionDelete is the builder for deleting a RoutingDecision entity.
+type RoutingDecisionDelete struct {
+	config
+	hooks    []Hook
+	mutation *RoutingDecisionMutation
+}
+
+// Where appends a list predicates to the RoutingDecisionDelete builder.
+func (rdd *RoutingDecisionDelete) Where(ps ...predicate.RoutingDecision) *RoutingDecisionDelete {
+	rdd.mutation.Where(ps...)
+	return rdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rdd *RoutingDecisionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rdd.sqlExec, rdd.mutation, rdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rdd *RoutingDecisionDelete) ExecX(ctx context.Context) int {
+	n, err := rdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rdd *RoutingDecisionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(routingdecision.Table, sqlgraph.NewFieldSpec(routingdecision.FieldID, field.TypeString))
+	if ps := rdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rdd.mutation.done = true
+	return affected, err
+}
+
+// RoutingDecisionDeleteOne is the builder for deleting a single RoutingDecision entity.
+type RoutingDecisionDeleteOne struct {
+	rdd *RoutingDecisionDelete
+}
+
+// Where appends a list predicates to the RoutingDecisionDelete builder.
+func (rddo *RoutingDecisionDeleteOne) Where(ps ...predicate.RoutingDecision) *RoutingDecisionDeleteOne {
+	rddo.rdd.mutation.Where(ps...)
+	return rddo
+}
+
+// Exec executes the deletion query.
+func (rddo *RoutingDecisionDeleteOne) Exec(ctx context.Context) error {
+	n, err := rddo.rdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{routingdecision.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rddo *RoutingDecisionDeleteOne) ExecX(ctx context.Context) {
+	if err := rddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
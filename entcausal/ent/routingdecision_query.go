@@ -0,0 +1,799 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/spikeevent"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RoutingDecisionQuery is the builder for querying RoutingDecision entities.
+type RoutingDecisionQuery struct {
+	config
+	ctx                  *QueryContext
+	order                []routingdecision.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.RoutingDecision
+	withSpikeEvents      *SpikeEventQuery
+	withActions          *AgentActionQuery
+	modifiers            []func(*sql.Selector)
+	loadTotal            []func(context.Context, []*RoutingDecision) error
+	withNamedSpikeEvents map[string]*SpikeEventQuery
+	withNamedActions     map[string]*AgentActionQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RoutingDecisionQuery builder.
+func (rdq *RoutingDecisionQuery) Where(ps ...predicate.RoutingDecision) *RoutingDecisionQuery {
+	rdq.predicates = append(rdq.predicates, ps...)
+	return rdq
+}
+
+// Limit the number of records to be returned by this query.
+func (rdq *RoutingDecisionQuery) Limit(limit int) *RoutingDecisionQuery {
+	rdq.ctx.Limit = &limit
+	return rdq
+}
+
+// Offset to start from.
+func (rdq *RoutingDecisionQuery) Offset(offset int) *RoutingDecisionQuery {
+	rdq.ctx.Offset = &offset
+	return rdq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rdq *RoutingDecisionQuery) Unique(unique bool) *RoutingDecisionQuery {
+	rdq.ctx.Unique = &unique
+	return rdq
+}
+
+// Order specifies how the records should be ordered.
+func (rdq *RoutingDecisionQuery) Order(o ...routingdecision.OrderOption) *RoutingDecisionQuery {
+	rdq.order = append(rdq.order, o...)
+	return rdq
+}
+
+// QuerySpikeEvents chains the current query on the "spike_events" edge.
+func (rdq *RoutingDecisionQuery) QuerySpikeEvents() *SpikeEventQuery {
+	query := (&SpikeEventClient{config: rdq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(routingdecision.Table, routingdecision.FieldID, selector),
+			sqlgraph.To(spikeevent.Table, spikeevent.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, routingdecision.SpikeEventsTable, routingdecision.SpikeEventsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(rdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryActions chains the current query on the "actions" edge.
+func (rdq *RoutingDecisionQuery) QueryActions() *AgentActionQuery {
+	query := (&AgentActionClient{config: rdq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rdq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rdq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(routingdecision.Table, routingdecision.FieldID, selector),
+			sqlgraph.To(agentaction.Table, agentaction.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, routingdecision.ActionsTable, routingdecision.ActionsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(rdq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RoutingDecision entity from the query.
+// Returns a *NotFoundError when no RoutingDecision was found.
+func (rdq *RoutingDecisionQuery) First(ctx context.Context) (*RoutingDecision, error) {
+	nodes, err := rdq.Limit(1).All(setContextOp(ctx, rdq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{routingdecision.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rdq *RoutingDecisionQuery) FirstX(ctx context.Context) *RoutingDecision {
+	node, err := rdq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RoutingDecision ID from the query.
+// Returns a *NotFoundError when no RoutingDecision ID was found.
+func (rdq *RoutingDecisionQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = rdq.Limit(1).IDs(setContextOp(ctx, rdq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{routingdecision.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rdq *RoutingDecisionQuery) FirstIDX(ctx context.Context) string {
+	id, err := rdq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RoutingDecision entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RoutingDecision entity is found.
+// Returns a *NotFoundError when no RoutingDecision entities are found.
+func (rdq *RoutingDecisionQuery) Only(ctx context.Context) (*RoutingDecision, error) {
+	nodes, err := rdq.Limit(2).All(setContextOp(ctx, rdq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{routingdecision.Label}
+	default:
+		return nil, &NotSingularError{routingdecision.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rdq *RoutingDecisionQuery) OnlyX(ctx context.Context) *RoutingDecision {
+	node, err := rdq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RoutingDecision ID in the query.
+// Returns a *NotSingularError when more than one RoutingDecision ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rdq *RoutingDecisionQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = rdq.Limit(2).IDs(setContextOp(ctx, rdq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{routingdecision.Label}
+	default:
+		err = &NotSingularError{routingdecision.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rdq *RoutingDecisionQuery) OnlyIDX(ctx context.Context) string {
+	id, err := rdq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RoutingDecisions.
+func (rdq *RoutingDecisionQuery) All(ctx context.Context) ([]*RoutingDecision, error) {
+	ctx = setContextOp(ctx, rdq.ctx, ent.OpQueryAll)
+	if err := rdq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RoutingDecision, *RoutingDecisionQuery]()
+	return withInterceptors[[]*RoutingDecision](ctx, rdq, qr, rdq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rdq *RoutingDecisionQuery) AllX(ctx context.Context) []*RoutingDecision {
+	nodes, err := rdq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RoutingDecision IDs.
+func (rdq *RoutingDecisionQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if rdq.ctx.Unique == nil && rdq.path != nil {
+		rdq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rdq.ctx, ent.OpQueryIDs)
+	if err = rdq.Select(routingdecision.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rdq *RoutingDecisionQuery) IDsX(ctx context.Context) []string {
+	ids, err := rdq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rdq *RoutingDecisionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rdq.ctx, ent.OpQueryCount)
+	if err := rdq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rdq, querierCount[*RoutingDecisionQuery](), rdq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rdq *RoutingDecisionQuery) CountX(ctx context.Context) int {
+	count, err := rdq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rdq *RoutingDecisionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rdq.ctx, ent.OpQueryExist)
+	switch _, err := rdq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rdq *RoutingDecisionQuery) ExistX(ctx context.Context) bool {
+	exist, err := rdq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RoutingDecisionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rdq *RoutingDecisionQuery) Clone() *RoutingDecisionQuery {
+	if rdq == nil {
+		return nil
+	}
+	return &RoutingDecisionQuery{
+		config:          rdq.config,
+		ctx:             rdq.ctx.Clone(),
+		order:           append([]routingdecision.OrderOption{}, rdq.order...),
+		inters:          append([]Interceptor{}, rdq.inters...),
+		predicates:      append([]predicate.RoutingDecision{}, rdq.predicates...),
+		withSpikeEvents: rdq.withSpikeEvents.Clone(),
+		withActions:     rdq.withActions.Clone(),
+		// clone intermediate query.
+		sql:  rdq.sql.Clone(),
+		path: rdq.path,
+	}
+}
+
+// WithSpikeEvents tells the query-builder to eager-load the nodes that are connected to
+// the "spike_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (rdq *RoutingDecisionQuery) WithSpikeEvents(opts ...func(*SpikeEventQuery)) *RoutingDecisionQuery {
+	query := (&SpikeEventClient{config: rdq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rdq.withSpikeEvents = query
+	return rdq
+}
+
+// WithActions tells the query-builder to eager-load the nodes that are connected to
+// the "actions" edge. The optional arguments are used to configure the query builder of the edge.
+func (rdq *RoutingDecisionQuery) WithActions(opts ...func(*AgentActionQuery)) *RoutingDecisionQuery {
+	query := (&AgentActionClient{config: rdq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rdq.withActions = query
+	return rdq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ProvenanceHash string `json:"provenance_hash,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RoutingDecision.Query().
+//		GroupBy(routingdecision.FieldProvenanceHash).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rdq *RoutingDecisionQuery) GroupBy(field string, fields ...string) *RoutingDecisionGroupBy {
+	rdq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RoutingDecisionGroupBy{build: rdq}
+	grbuild.flds = &rdq.ctx.Fields
+	grbuild.label = routingdecision.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ProvenanceHash string `json:"provenance_hash,omitempty"`
+//	}
+//
+//	client.RoutingDecision.Query().
+//		Select(routingdecision.FieldProvenanceHash).
+//		Scan(ctx, &v)
+func (rdq *RoutingDecisionQuery) Select(fields ...string) *RoutingDecisionSelect {
+	rdq.ctx.Fields = append(rdq.ctx.Fields, fields...)
+	sbuild := &RoutingDecisionSelect{RoutingDecisionQuery: rdq}
+	sbuild.label = routingdecision.Label
+	sbuild.flds, sbuild.scan = &rdq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RoutingDecisionSelect configured with the given aggregations.
+func (rdq *RoutingDecisionQuery) Aggregate(fns ...AggregateFunc) *RoutingDecisionSelect {
+	return rdq.Select().Aggregate(fns...)
+}
+
+func (rdq *RoutingDecisionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rdq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rdq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rdq.ctx.Fields {
+		if !routingdecision.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rdq.path != nil {
+		prev, err := rdq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rdq.sql = prev
+	}
+	return nil
+}
+
+func (rdq *RoutingDecisionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RoutingDecision, error) {
+	var (
+		nodes       = []*RoutingDecision{}
+		_spec       = rdq.querySpec()
+		loadedTypes = [2]bool{
+			rdq.withSpikeEvents != nil,
+			rdq.withActions != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RoutingDecision).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RoutingDecision{config: rdq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(rdq.modifiers) > 0 {
+		_spec.Modifiers = rdq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rdq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rdq.withSpikeEvents; query != nil {
+		if err := rdq.loadSpikeEvents(ctx, query, nodes,
+			func(n *RoutingDecision) { n.Edges.SpikeEvents = []*SpikeEvent{} },
+			func(n *RoutingDecision, e *SpikeEvent) { n.Edges.SpikeEvents = append(n.Edges.SpikeEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rdq.withActions; query != nil {
+		if err := rdq.loadActions(ctx, query, nodes,
+			func(n *RoutingDecision) { n.Edges.Actions = []*AgentAction{} },
+			func(n *RoutingDecision, e *AgentAction) { n.Edges.Actions = append(n.Edges.Actions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range rdq.withNamedSpikeEvents {
+		if err := rdq.loadSpikeEvents(ctx, query, nodes,
+			func(n *RoutingDecision) { n.appendNamedSpikeEvents(name) },
+			func(n *RoutingDecision, e *SpikeEvent) { n.appendNamedSpikeEvents(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range rdq.withNamedActions {
+		if err := rdq.loadActions(ctx, query, nodes,
+			func(n *RoutingDecision) { n.appendNamedActions(name) },
+			func(n *RoutingDecision, e *AgentAction) { n.appendNamedActions(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range rdq.loadTotal {
+		if err := rdq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rdq *RoutingDecisionQuery) loadSpikeEvents(ctx context.Context, query *SpikeEventQuery, nodes []*RoutingDecision, init func(*RoutingDecision), assign func(*RoutingDecision, *SpikeEvent)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*RoutingDecision)
+	nids := make(map[string]map[*RoutingDecision]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(routingdecision.SpikeEventsTable)
+		s.Join(joinT).On(s.C(spikeevent.FieldID), joinT.C(routingdecision.SpikeEventsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(routingdecision.SpikeEventsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(routingdecision.SpikeEventsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*RoutingDecision]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*SpikeEvent](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "spike_events" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (rdq *RoutingDecisionQuery) loadActions(ctx context.Context, query *AgentActionQuery, nodes []*RoutingDecision, init func(*RoutingDecision), assign func(*RoutingDecision, *AgentAction)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*RoutingDecision)
+	nids := make(map[string]map[*RoutingDecision]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(routingdecision.ActionsTable)
+		s.Join(joinT).On(s.C(agentaction.FieldID), joinT.C(routingdecision.ActionsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(routingdecision.ActionsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(routingdecision.ActionsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*RoutingDecision]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*AgentAction](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "actions" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+
+func (rdq *RoutingDecisionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rdq.querySpec()
+	if len(rdq.modifiers) > 0 {
+		_spec.Modifiers = rdq.modifiers
+	}
+	_spec.Node.Columns = rdq.ctx.Fields
+	if len(rdq.ctx.Fields) > 0 {
+		_spec.Unique = rdq.ctx.Unique != nil && *rdq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rdq.driver, _spec)
+}
+
+func (rdq *RoutingDecisionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(routingdecision.Table, routingdecision.Columns, sqlgraph.NewFieldSpec(routingdecision.FieldID, field.TypeString))
+	_spec.From = rdq.sql
+	if unique := rdq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rdq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rdq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, routingdecision.FieldID)
+		for i := range fields {
+			if fields[i] != routingdecision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rdq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rdq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rdq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rdq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rdq *RoutingDecisionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rdq.driver.Dialect())
+	t1 := builder.Table(routingdecision.Table)
+	columns := rdq.ctx.Fields
+	if len(columns) == 0 {
+		columns = routingdecision.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rdq.sql != nil {
+		selector = rdq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rdq.ctx.Unique != nil && *rdq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rdq.predicates {
+		p(selector)
+	}
+	for _, p := range rdq.order {
+		p(selector)
+	}
+	if offset := rdq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rdq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WithNamedSpikeEvents tells the query-builder to eager-load the nodes that are connected to the "spike_events"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (rdq *RoutingDecisionQuery) WithNamedSpikeEvents(name string, opts ...func(*SpikeEventQuery)) *RoutingDecisionQuery {
+	query := (&SpikeEventClient{config: rdq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if rdq.withNamedSpikeEvents == nil {
+		rdq.withNamedSpikeEvents = make(map[string]*SpikeEventQuery)
+	}
+	rdq.withNamedSpikeEvents[name] = query
+	return rdq
+}
+
+// WithNamedActions tells the query-builder to eager-load the nodes that are connected to the "actions"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (rdq *RoutingDecisionQuery) WithNamedActions(name string, opts ...func(*AgentActionQuery)) *RoutingDecisionQuery {
+	query := (&AgentActionClient{config: rdq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if rdq.withNamedActions == nil {
+		rdq.withNamedActions = make(map[string]*AgentActionQuery)
+	}
+	rdq.withNamedActions[name] = query
+	return rdq
+}
+
+// RoutingDecisionGroupBy is the group-by builder for RoutingDecision entities.
+type RoutingDecisionGroupBy struct {
+	selector
+	build *RoutingDecisionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rdgb *RoutingDecisionGroupBy) Aggregate(fns ...AggregateFunc) *RoutingDecisionGroupBy {
+	rdgb.fns = append(rdgb.fns, fns...)
+	return rdgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rdgb *RoutingDecisionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rdgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rdgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoutingDecisionQuery, *RoutingDecisionGroupBy](ctx, rdgb.build, rdgb, rdgb.build.inters, v)
+}
+
+func (rdgb *RoutingDecisionGroupBy) sqlScan(ctx context.Context, root *RoutingDecisionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rdgb.fns))
+	for _, fn := range rdgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rdgb.flds)+len(rdgb.fns))
+		for _, f := range *rdgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rdgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rdgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RoutingDecisionSelect is the builder for selecting fields of RoutingDecision entities.
+type RoutingDecisionSelect struct {
+	*RoutingDecisionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rds *RoutingDecisionSelect) Aggregate(fns ...AggregateFunc) *RoutingDecisionSelect {
+	rds.fns = append(rds.fns, fns...)
+	return rds
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rds *RoutingDecisionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rds.ctx, ent.OpQuerySelect)
+	if err := rds.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RoutingDecisionQuery, *RoutingDecisionSelect](ctx, rds.RoutingDecisionQuery, rds, rds.inters, v)
+}
+
+func (rds *RoutingDecisionSelect) sqlScan(ctx context.Context, root *RoutingDecisionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rds.fns))
+	for _, fn := range rds.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rds.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rds.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,987 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/spikeevent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RoutingDecisionUpdate is the builder for updating RoutingDecision entities.
+type RoutingDecisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RoutingDecisionMutation
+}
+
+// Where appends a list predicates to the RoutingDecisionUpdate builder.
+func (rdu *RoutingDecisionUpdate) Where(ps ...predicate.RoutingDecision) *RoutingDecisionUpdate {
+	rdu.mutation.Where(ps...)
+	return rdu
+}
+
+// SetInferenceID sets the "inference_id" field.
+func (rdu *RoutingDecisionUpdate) SetInferenceID(s string) *RoutingDecisionUpdate {
+	rdu.mutation.SetInferenceID(s)
+	return rdu
+}
+
+// SetNillableInferenceID sets the "inference_id" field if the given value is not nil.
+func (rdu *RoutingDecisionUpdate) SetNillableInferenceID(s *string) *RoutingDecisionUpdate {
+	if s != nil {
+		rdu.SetInferenceID(*s)
+	}
+	return rdu
+}
+
+// SetDecisionType sets the "decision_type" field.
+func (rdu *RoutingDecisionUpdate) SetDecisionType(rt routingdecision.DecisionType) *RoutingDecisionUpdate {
+	rdu.mutation.SetDecisionType(rt)
+	return rdu
+}
+
+// SetNillableDecisionType sets the "decision_type" field if the given value is not nil.
+func (rdu *RoutingDecisionUpdate) SetNillableDecisionType(rt *routingdecision.DecisionType) *RoutingDecisionUpdate {
+	if rt != nil {
+		rdu.SetDecisionType(*rt)
+	}
+	return rdu
+}
+
+// SetLayerIndex sets the "layer_index" field.
+func (rdu *RoutingDecisionUpdate) SetLayerIndex(i int) *RoutingDecisionUpdate {
+	rdu.mutation.ResetLayerIndex()
+	rdu.mutation.SetLayerIndex(i)
+	return rdu
+}
+
+// SetNillableLayerIndex sets the "layer_index" field if the given value is not nil.
+func (rdu *RoutingDecisionUpdate) SetNillableLayerIndex(i *int) *RoutingDecisionUpdate {
+	if i != nil {
+		rdu.SetLayerIndex(*i)
+	}
+	return rdu
+}
+
+// AddLayerIndex adds i to the "layer_index" field.
+func (rdu *RoutingDecisionUpdate) AddLayerIndex(i int) *RoutingDecisionUpdate {
+	rdu.mutation.AddLayerIndex(i)
+	return rdu
+}
+
+// SetGateProbability sets the "gate_probability" field.
+func (rdu *RoutingDecisionUpdate) SetGateProbability(f float64) *RoutingDecisionUpdate {
+	rdu.mutation.ResetGateProbability()
+	rdu.mutation.SetGateProbability(f)
+	return rdu
+}
+
+// SetNillableGateProbability sets the "gate_probability" field if the given value is not nil.
+func (rdu *RoutingDecisionUpdate) SetNillableGateProbability(f *float64) *RoutingDecisionUpdate {
+	if f != nil {
+		rdu.SetGateProbability(*f)
+	}
+	return rdu
+}
+
+// AddGateProbability adds f to the "gate_probability" field.
+func (rdu *RoutingDecisionUpdate) AddGateProbability(f float64) *RoutingDecisionUpdate {
+	rdu.mutation.AddGateProbability(f)
+	return rdu
+}
+
+// SetSelectedModel sets the "selected_model" field.
+func (rdu *RoutingDecisionUpdate) SetSelectedModel(s string) *RoutingDecisionUpdate {
+	rdu.mutation.SetSelectedModel(s)
+	return rdu
+}
+
+// SetNillableSelectedModel sets the "selected_model" field if the given value is not nil.
+func (rdu *RoutingDecisionUpdate) SetNillableSelectedModel(s *string) *RoutingDecisionUpdate {
+	if s != nil {
+		rdu.SetSelectedModel(*s)
+	}
+	return rdu
+}
+
+// ClearSelectedModel clears the value of the "selected_model" field.
+func (rdu *RoutingDecisionUpdate) ClearSelectedModel() *RoutingDecisionUpdate {
+	rdu.mutation.ClearSelectedModel()
+	return rdu
+}
+
+// SetIterationCount sets the "iteration_count" field.
+func (rdu *RoutingDecisionUpdate) SetIterationCount(i int) *RoutingDecisionUpdate {
+	rdu.mutation.ResetIterationCount()
+	rdu.mutation.SetIterationCount(i)
+	return rdu
+}
+
+// SetNillableIterationCount sets the "iteration_count" field if the given value is not nil.
+func (rdu *RoutingDecisionUpdate) SetNillableIterationCount(i *int) *RoutingDecisionUpdate {
+	if i != nil {
+		rdu.SetIterationCount(*i)
+	}
+	return rdu
+}
+
+// AddIterationCount adds i to the "iteration_count" field.
+func (rdu *RoutingDecisionUpdate) AddIterationCount(i int) *RoutingDecisionUpdate {
+	rdu.mutation.AddIterationCount(i)
+	return rdu
+}
+
+// SetConfidence sets the "confidence" field.
+func (rdu *RoutingDecisionUpdate) SetConfidence(f float64) *RoutingDecisionUpdate {
+	rdu.mutation.ResetConfidence()
+	rdu.mutation.SetConfidence(f)
+	return rdu
+}
+
+// SetNillableConfidence sets the "confidence" field if the given value is not nil.
+func (rdu *RoutingDecisionUpdate) SetNillableConfidence(f *float64) *RoutingDecisionUpdate {
+	if f != nil {
+		rdu.SetConfidence(*f)
+	}
+	return rdu
+}
+
+// AddConfidence adds f to the "confidence" field.
+func (rdu *RoutingDecisionUpdate) AddConfidence(f float64) *RoutingDecisionUpdate {
+	rdu.mutation.AddConfidence(f)
+	return rdu
+}
+
+// SetDomain sets the "domain" field.
+func (rdu *RoutingDecisionUpdate) SetDomain(s string) *RoutingDecisionUpdate {
+	rdu.mutation.SetDomain(s)
+	return rdu
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (rdu *RoutingDecisionUpdate) SetNillableDomain(s *string) *RoutingDecisionUpdate {
+	if s != nil {
+		rdu.SetDomain(*s)
+	}
+	return rdu
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (rdu *RoutingDecisionUpdate) ClearDomain() *RoutingDecisionUpdate {
+	rdu.mutation.ClearDomain()
+	return rdu
+}
+
+// SetMetadata sets the "metadata" field.
+func (rdu *RoutingDecisionUpdate) SetMetadata(m map[string]interface{}) *RoutingDecisionUpdate {
+	rdu.mutation.SetMetadata(m)
+	return rdu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (rdu *RoutingDecisionUpdate) ClearMetadata() *RoutingDecisionUpdate {
+	rdu.mutation.ClearMetadata()
+	return rdu
+}
+
+// AddSpikeEventIDs adds the "spike_events" edge to the SpikeEvent entity by IDs.
+func (rdu *RoutingDecisionUpdate) AddSpikeEventIDs(ids ...string) *RoutingDecisionUpdate {
+	rdu.mutation.AddSpikeEventIDs(ids...)
+	return rdu
+}
+
+// AddSpikeEvents adds the "spike_events" edges to the SpikeEvent entity.
+func (rdu *RoutingDecisionUpdate) AddSpikeEvents(s ...*SpikeEvent) *RoutingDecisionUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return rdu.AddSpikeEventIDs(ids...)
+}
+
+// AddActionIDs adds the "actions" edge to the AgentAction entity by IDs.
+func (rdu *RoutingDecisionUpdate) AddActionIDs(ids ...string) *RoutingDecisionUpdate {
+	rdu.mutation.AddActionIDs(ids...)
+	return rdu
+}
+
+// AddActions adds the "actions" edges to the AgentAction entity.
+func (rdu *RoutingDecisionUpdate) AddActions(a ...*AgentAction) *RoutingDecisionUpdate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return rdu.AddActionIDs(ids...)
+}
+
+// Mutation returns the RoutingDecisionMutation object of the builder.
+func (rdu *RoutingDecisionUpdate) Mutation() *RoutingDecisionMutation {
+	return rdu.mutation
+}
+
+// ClearSpikeEvents clears all "spike_events" edges to the SpikeEvent entity.
+func (rdu *RoutingDecisionUpdate) ClearSpikeEvents() *RoutingDecisionUpdate {
+	rdu.mutation.ClearSpikeEvents()
+	return rdu
+}
+
+// RemoveSpikeEventIDs removes the "spike_events" edge to SpikeEvent entities by IDs.
+func (rdu *RoutingDecisionUpdate) RemoveSpikeEventIDs(ids ...string) *RoutingDecisionUpdate {
+	rdu.mutation.RemoveSpikeEventIDs(ids...)
+	return rdu
+}
+
+// RemoveSpikeEvents removes "spike_events" edges to SpikeEvent entities.
+func (rdu *RoutingDecisionUpdate) RemoveSpikeEvents(s ...*SpikeEvent) *RoutingDecisionUpdate {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return rdu.RemoveSpikeEventIDs(ids...)
+}
+
+// ClearActions clears all "actions" edges to the AgentAction entity.
+func (rdu *RoutingDecisionUpdate) ClearActions() *RoutingDecisionUpdate {
+	rdu.mutation.ClearActions()
+	return rdu
+}
+
+// RemoveActionIDs removes the "actions" edge to AgentAction entities by IDs.
+func (rdu *RoutingDecisionUpdate) RemoveActionIDs(ids ...string) *RoutingDecisionUpdate {
+	rdu.mutation.RemoveActionIDs(ids...)
+	return rdu
+}
+
+// RemoveActions removes "actions" edges to AgentAction entities.
+func (rdu *RoutingDecisionUpdate) RemoveActions(a ...*AgentAction) *RoutingDecisionUpdate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return rdu.RemoveActionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rdu *RoutingDecisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, rdu.sqlSave, rdu.mutation, rdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rdu *RoutingDecisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := rdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rdu *RoutingDecisionUpdate) Exec(ctx context.Context) error {
+	_, err := rdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rdu *RoutingDecisionUpdate) ExecX(ctx context.Context) {
+	if err := rdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rdu *RoutingDecisionUpdate) check() error {
+	if v, ok := rdu.mutation.InferenceID(); ok {
+		if err := routingdecision.InferenceIDValidator(v); err != nil {
+			return &ValidationError{Name: "inference_id", err: fmt.Errorf(`ent: validator failed for field "RoutingDecision.inference_id": %w`, err)}
+		}
+	}
+	if v, ok := rdu.mutation.DecisionType(); ok {
+		if err := routingdecision.DecisionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "decision_type", err: fmt.Errorf(`ent: validator failed for field "RoutingDecision.decision_type": %w`, err)}
+		}
+	}
+	if v, ok := rdu.mutation.GateProbability(); ok {
+		if err := routingdecision.GateProbabilityValidator(v); err != nil {
+			return &ValidationError{Name: "gate_probability", err: fmt.Errorf(`ent: validator failed for field "RoutingDecision.gate_probability": %w`, err)}
+		}
+	}
+	if v, ok := rdu.mutation.Confidence(); ok {
+		if err := routingdecision.ConfidenceValidator(v); err != nil {
+			return &ValidationError{Name: "confidence", err: fmt.Errorf(`ent: validator failed for field "RoutingDecision.confidence": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rdu *RoutingDecisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rdu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(routingdecision.Table, routingdecision.Columns, sqlgraph.NewFieldSpec(routingdecision.FieldID, field.TypeString))
+	if ps := rdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if rdu.mutation.ProvenanceHashCleared() {
+		_spec.ClearField(routingdecision.FieldProvenanceHash, field.TypeString)
+	}
+	if value, ok := rdu.mutation.InferenceID(); ok {
+		_spec.SetField(routingdecision.FieldInferenceID, field.TypeString, value)
+	}
+	if value, ok := rdu.mutation.DecisionType(); ok {
+		_spec.SetField(routingdecision.FieldDecisionType, field.TypeEnum, value)
+	}
+	if value, ok := rdu.mutation.LayerIndex(); ok {
+		_spec.SetField(routingdecision.FieldLayerIndex, field.TypeInt, value)
+	}
+	if value, ok := rdu.mutation.AddedLayerIndex(); ok {
+		_spec.AddField(routingdecision.FieldLayerIndex, field.TypeInt, value)
+	}
+	if value, ok := rdu.mutation.GateProbability(); ok {
+		_spec.SetField(routingdecision.FieldGateProbability, field.TypeFloat64, value)
+	}
+	if value, ok := rdu.mutation.AddedGateProbability(); ok {
+		_spec.AddField(routingdecision.FieldGateProbability, field.TypeFloat64, value)
+	}
+	if value, ok := rdu.mutation.SelectedModel(); ok {
+		_spec.SetField(routingdecision.FieldSelectedModel, field.TypeString, value)
+	}
+	if rdu.mutation.SelectedModelCleared() {
+		_spec.ClearField(routingdecision.FieldSelectedModel, field.TypeString)
+	}
+	if value, ok := rdu.mutation.IterationCount(); ok {
+		_spec.SetField(routingdecision.FieldIterationCount, field.TypeInt, value)
+	}
+	if value, ok := rdu.mutation.AddedIterationCount(); ok {
+		_spec.AddField(routingdecision.FieldIterationCount, field.TypeInt, value)
+	}
+	if value, ok := rdu.mutation.Confidence(); ok {
+		_spec.SetField(routingdecision.FieldConfidence, field.TypeFloat64, value)
+	}
+	if value, ok := rdu.mutation.AddedConfidence(); ok {
+		_spec.AddField(routingdecision.FieldConfidence, field.TypeFloat64, value)
+	}
+	if value, ok := rdu.mutation.Domain(); ok {
+		_spec.SetField(routingdecision.FieldDomain, field.TypeString, value)
+	}
+	if rdu.mutation.DomainCleared() {
+		_spec.ClearField(routingdecision.FieldDomain, field.TypeString)
+	}
+	if value, ok := rdu.mutation.Metadata(); ok {
+		_spec.SetField(routingdecision.FieldMetadata, field.TypeJSON, value)
+	}
+	if rdu.mutation.MetadataCleared() {
+		_spec.ClearField(routingdecision.FieldMetadata, field.TypeJSON)
+	}
+	if rdu.mutation.SpikeEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   routingdecision.SpikeEventsTable,
+			Columns: routingdecision.SpikeEventsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(spikeevent.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rdu.mutation.RemovedSpikeEventsIDs(); len(nodes) > 0 && !rdu.mutation.SpikeEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   routingdecision.SpikeEventsTable,
+			Columns: routingdecision.SpikeEventsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(spikeevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rdu.mutation.SpikeEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   routingdecision.SpikeEventsTable,
+			Columns: routingdecision.SpikeEventsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(spikeevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rdu.mutation.ActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   routingdecision.ActionsTable,
+			Columns: routingdecision.ActionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentaction.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rdu.mutation.RemovedActionsIDs(); len(nodes) > 0 && !rdu.mutation.ActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   routingdecision.ActionsTable,
+			Columns: routingdecision.ActionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rdu.mutation.ActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   routingdecision.ActionsTable,
+			Columns: routingdecision.ActionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{routingdecision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rdu.mutation.done = true
+	return n, nil
+}
+
+// RoutingDecisionUpdateOne is the builder for updating a single RoutingDecision entity.
+type RoutingDecisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RoutingDecisionMutation
+}
+
+// SetInferenceID sets the "inference_id" field.
+func (rduo *RoutingDecisionUpdateOne) SetInferenceID(s string) *RoutingDecisionUpdateOne {
+	rduo.mutation.SetInferenceID(s)
+	return rduo
+}
+
+// SetNillableInferenceID sets the "inference_id" field if the given value is not nil.
+func (rduo *RoutingDecisionUpdateOne) SetNillableInferenceID(s *string) *RoutingDecisionUpdateOne {
+	if s != nil {
+		rduo.SetInferenceID(*s)
+	}
+	return rduo
+}
+
+// SetDecisionType sets the "decision_type" field.
+func (rduo *RoutingDecisionUpdateOne) SetDecisionType(rt routingdecision.DecisionType) *RoutingDecisionUpdateOne {
+	rduo.mutation.SetDecisionType(rt)
+	return rduo
+}
+
+// SetNillableDecisionType sets the "decision_type" field if the given value is not nil.
+func (rduo *RoutingDecisionUpdateOne) SetNillableDecisionType(rt *routingdecision.DecisionType) *RoutingDecisionUpdateOne {
+	if rt != nil {
+		rduo.SetDecisionType(*rt)
+	}
+	return rduo
+}
+
+// SetLayerIndex sets the "layer_index" field.
+func (rduo *RoutingDecisionUpdateOne) SetLayerIndex(i int) *RoutingDecisionUpdateOne {
+	rduo.mutation.ResetLayerIndex()
+	rduo.mutation.SetLayerIndex(i)
+	return rduo
+}
+
+// SetNillableLayerIndex sets the "layer_index" field if the given value is not nil.
+func (rduo *RoutingDecisionUpdateOne) SetNillableLayerIndex(i *int) *RoutingDecisionUpdateOne {
+	if i != nil {
+		rduo.SetLayerIndex(*i)
+	}
+	return rduo
+}
+
+// AddLayerIndex adds i to the "layer_index" field.
+func (rduo *RoutingDecisionUpdateOne) AddLayerIndex(i int) *RoutingDecisionUpdateOne {
+	rduo.mutation.AddLayerIndex(i)
+	return rduo
+}
+
+// SetGateProbability sets the "gate_probability" field.
+func (rduo *RoutingDecisionUpdateOne) SetGateProbability(f float64) *RoutingDecisionUpdateOne {
+	rduo.mutation.ResetGateProbability()
+	rduo.mutation.SetGateProbability(f)
+	return rduo
+}
+
+// SetNillableGateProbability sets the "gate_probability" field if the given value is not nil.
+func (rduo *RoutingDecisionUpdateOne) SetNillableGateProbability(f *float64) *RoutingDecisionUpdateOne {
+	if f != nil {
+		rduo.SetGateProbability(*f)
+	}
+	return rduo
+}
+
+// AddGateProbability adds f to the "gate_probability" field.
+func (rduo *RoutingDecisionUpdateOne) AddGateProbability(f float64) *RoutingDecisionUpdateOne {
+	rduo.mutation.AddGateProbability(f)
+	return rduo
+}
+
+// SetSelectedModel sets the "selected_model" field.
+func (rduo *RoutingDecisionUpdateOne) SetSelectedModel(s string) *RoutingDecisionUpdateOne {
+	rduo.mutation.SetSelectedModel(s)
+	return rduo
+}
+
+// SetNillableSelectedModel sets the "selected_model" field if the given value is not nil.
+func (rduo *RoutingDecisionUpdateOne) SetNillableSelectedModel(s *string) *RoutingDecisionUpdateOne {
+	if s != nil {
+		rduo.SetSelectedModel(*s)
+	}
+	return rduo
+}
+
+// ClearSelectedModel clears the value of the "selected_model" field.
+func (rduo *RoutingDecisionUpdateOne) ClearSelectedModel() *RoutingDecisionUpdateOne {
+	rduo.mutation.ClearSelectedModel()
+	return rduo
+}
+
+// SetIterationCount sets the "iteration_count" field.
+func (rduo *RoutingDecisionUpdateOne) SetIterationCount(i int) *RoutingDecisionUpdateOne {
+	rduo.mutation.ResetIterationCount()
+	rduo.mutation.SetIterationCount(i)
+	return rduo
+}
+
+// SetNillableIterationCount sets the "iteration_count" field if the given value is not nil.
+func (rduo *RoutingDecisionUpdateOne) SetNillableIterationCount(i *int) *RoutingDecisionUpdateOne {
+	if i != nil {
+		rduo.SetIterationCount(*i)
+	}
+	return rduo
+}
+
+// AddIterationCount adds i to the "iteration_count" field.
+func (rduo *RoutingDecisionUpdateOne) AddIterationCount(i int) *RoutingDecisionUpdateOne {
+	rduo.mutation.AddIterationCount(i)
+	return rduo
+}
+
+// SetConfidence sets the "confidence" field.
+func (rduo *RoutingDecisionUpdateOne) SetConfidence(f float64) *RoutingDecisionUpdateOne {
+	rduo.mutation.ResetConfidence()
+	rduo.mutation.SetConfidence(f)
+	return rduo
+}
+
+// SetNillableConfidence sets the "confidence" field if the given value is not nil.
+func (rduo *RoutingDecisionUpdateOne) SetNillableConfidence(f *float64) *RoutingDecisionUpdateOne {
+	if f != nil {
+		rduo.SetConfidence(*f)
+	}
+	return rduo
+}
+
+// AddConfidence adds f to the "confidence" field.
+func (rduo *RoutingDecisionUpdateOne) AddConfidence(f float64) *RoutingDecisionUpdateOne {
+	rduo.mutation.AddConfidence(f)
+	return rduo
+}
+
+// SetDomain sets the "domain" field.
+func (rduo *RoutingDecisionUpdateOne) SetDomain(s string) *RoutingDecisionUpdateOne {
+	rduo.mutation.SetDomain(s)
+	return rduo
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (rduo *RoutingDecisionUpdateOne) SetNillableDomain(s *string) *RoutingDecisionUpdateOne {
+	if s != nil {
+		rduo.SetDomain(*s)
+	}
+	return rduo
+}
+
+// ClearDomain clears the value of the "domain" field.
+func (rduo *RoutingDecisionUpdateOne) ClearDomain() *RoutingDecisionUpdateOne {
+	rduo.mutation.ClearDomain()
+	return rduo
+}
+
+// SetMetadata sets the "metadata" field.
+func (rduo *RoutingDecisionUpdateOne) SetMetadata(m map[string]interface{}) *RoutingDecisionUpdateOne {
+	rduo.mutation.SetMetadata(m)
+	return rduo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (rduo *RoutingDecisionUpdateOne) ClearMetadata() *RoutingDecisionUpdateOne {
+	rduo.mutation.ClearMetadata()
+	return rduo
+}
+
+// AddSpikeEventIDs adds the "spike_events" edge to the SpikeEvent entity by IDs.
+func (rduo *RoutingDecisionUpdateOne) AddSpikeEventIDs(ids ...string) *RoutingDecisionUpdateOne {
+	rduo.mutation.AddSpikeEventIDs(ids...)
+	return rduo
+}
+
+// AddSpikeEvents adds the "spike_events" edges to the SpikeEvent entity.
+func (rduo *RoutingDecisionUpdateOne) AddSpikeEvents(s ...*SpikeEvent) *RoutingDecisionUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return rduo.AddSpikeEventIDs(ids...)
+}
+
+// AddActionIDs adds the "actions" edge to the AgentAction entity by IDs.
+func (rduo *RoutingDecisionUpdateOne) AddActionIDs(ids ...string) *RoutingDecisionUpdateOne {
+	rduo.mutation.AddActionIDs(ids...)
+	return rduo
+}
+
+// AddActions adds the "actions" edges to the AgentAction entity.
+func (rduo *RoutingDecisionUpdateOne) AddActions(a ...*AgentAction) *RoutingDecisionUpdateOne {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return rduo.AddActionIDs(ids...)
+}
+
+// Mutation returns the RoutingDecisionMutation object of the builder.
+func (rduo *RoutingDecisionUpdateOne) Mutation() *RoutingDecisionMutation {
+	return rduo.mutation
+}
+
+// ClearSpikeEvents clears all "spike_events" edges to the SpikeEvent entity.
+func (rduo *RoutingDecisionUpdateOne) ClearSpikeEvents() *RoutingDecisionUpdateOne {
+	rduo.mutation.ClearSpikeEvents()
+	return rduo
+}
+
+// RemoveSpikeEventIDs removes the "spike_events" edge to SpikeEvent entities by IDs.
+func (rduo *RoutingDecisionUpdateOne) RemoveSpikeEventIDs(ids ...string) *RoutingDecisionUpdateOne {
+	rduo.mutation.RemoveSpikeEventIDs(ids...)
+	return rduo
+}
+
+// RemoveSpikeEvents removes "spike_events" edges to SpikeEvent entities.
+func (rduo *RoutingDecisionUpdateOne) RemoveSpikeEvents(s ...*SpikeEvent) *RoutingDecisionUpdateOne {
+	ids := make([]string, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return rduo.RemoveSpikeEventIDs(ids...)
+}
+
+// ClearActions clears all "actions" edges to the AgentAction entity.
+func (rduo *RoutingDecisionUpdateOne) ClearActions() *RoutingDecisionUpdateOne {
+	rduo.mutation.ClearActions()
+	return rduo
+}
+
+// RemoveActionIDs removes the "actions" edge to AgentAction entities by IDs.
+func (rduo *RoutingDecisionUpdateOne) RemoveActionIDs(ids ...string) *RoutingDecisionUpdateOne {
+	rduo.mutation.RemoveActionIDs(ids...)
+	return rduo
+}
+
+// RemoveActions removes "actions" edges to AgentAction entities.
+func (rduo *RoutingDecisionUpdateOne) RemoveActions(a ...*AgentAction) *RoutingDecisionUpdateOne {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return rduo.RemoveActionIDs(ids...)
+}
+
+// Where appends a list predicates to the RoutingDecisionUpdate builder.
+func (rduo *RoutingDecisionUpdateOne) Where(ps ...predicate.RoutingDecision) *RoutingDecisionUpdateOne {
+	rduo.mutation.Where(ps...)
+	return rduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rduo *RoutingDecisionUpdateOne) Select(field string, fields ...string) *RoutingDecisionUpdateOne {
+	rduo.fields = append([]string{field}, fields...)
+	return rduo
+}
+
+// Save executes the query and returns the updated RoutingDecision entity.
+func (rduo *RoutingDecisionUpdateOne) Save(ctx context.Context) (*RoutingDecision, error) {
+	return withHooks(ctx, rduo.sqlSave, rduo.mutation, rduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rduo *RoutingDecisionUpdateOne) SaveX(ctx context.Context) *RoutingDecision {
+	node, err := rduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rduo *RoutingDecisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := rduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rduo *RoutingDecisionUpdateOne) ExecX(ctx context.Context) {
+	if err := rduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rduo *RoutingDecisionUpdateOne) check() error {
+	if v, ok := rduo.mutation.InferenceID(); ok {
+		if err := routingdecision.InferenceIDValidator(v); err != nil {
+			return &ValidationError{Name: "inference_id", err: fmt.Errorf(`ent: validator failed for field "RoutingDecision.inference_id": %w`, err)}
+		}
+	}
+	if v, ok := rduo.mutation.DecisionType(); ok {
+		if err := routingdecision.DecisionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "decision_type", err: fmt.Errorf(`ent: validator failed for field "RoutingDecision.decision_type": %w`, err)}
+		}
+	}
+	if v, ok := rduo.mutation.GateProbability(); ok {
+		if err := routingdecision.GateProbabilityValidator(v); err != nil {
+			return &ValidationError{Name: "gate_probability", err: fmt.Errorf(`ent: validator failed for field "RoutingDecision.gate_probability": %w`, err)}
+		}
+	}
+	if v, ok := rduo.mutation.Confidence(); ok {
+		if err := routingdecision.ConfidenceValidator(v); err != nil {
+			return &ValidationError{Name: "confidence", err: fmt.Errorf(`ent: validator failed for field "RoutingDecision.confidence": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rduo *RoutingDecisionUpdateOne) sqlSave(ctx context.Context) (_node *RoutingDecision, err error) {
+	if err := rduo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(routingdecision.Table, routingdecision.Columns, sqlgraph.NewFieldSpec(routingdecision.FieldID, field.TypeString))
+	id, ok := rduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RoutingDecision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, routingdecision.FieldID)
+		for _, f := range fields {
+			if !routingdecision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != routingdecision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if rduo.mutation.ProvenanceHashCleared() {
+		_spec.ClearField(routingdecision.FieldProvenanceHash, field.TypeString)
+	}
+	if value, ok := rduo.mutation.InferenceID(); ok {
+		_spec.SetField(routingdecision.FieldInferenceID, field.TypeString, value)
+	}
+	if value, ok := rduo.mutation.DecisionType(); ok {
+		_spec.SetField(routingdecision.FieldDecisionType, field.TypeEnum, value)
+	}
+	if value, ok := rduo.mutation.LayerIndex(); ok {
+		_spec.SetField(routingdecision.FieldLayerIndex, field.TypeInt, value)
+	}
+	if value, ok := rduo.mutation.AddedLayerIndex(); ok {
+		_spec.AddField(routingdecision.FieldLayerIndex, field.TypeInt, value)
+	}
+	if value, ok := rduo.mutation.GateProbability(); ok {
+		_spec.SetField(routingdecision.FieldGateProbability, field.TypeFloat64, value)
+	}
+	if value, ok := rduo.mutation.AddedGateProbability(); ok {
+		_spec.AddField(routingdecision.FieldGateProbability, field.TypeFloat64, value)
+	}
+	if value, ok := rduo.mutation.SelectedModel(); ok {
+		_spec.SetField(routingdecision.FieldSelectedModel, field.TypeString, value)
+	}
+	if rduo.mutation.SelectedModelCleared() {
+		_spec.ClearField(routingdecision.FieldSelectedModel, field.TypeString)
+	}
+	if value, ok := rduo.mutation.IterationCount(); ok {
+		_spec.SetField(routingdecision.FieldIterationCount, field.TypeInt, value)
+	}
+	if value, ok := rduo.mutation.AddedIterationCount(); ok {
+		_spec.AddField(routingdecision.FieldIterationCount, field.TypeInt, value)
+	}
+	if value, ok := rduo.mutation.Confidence(); ok {
+		_spec.SetField(routingdecision.FieldConfidence, field.TypeFloat64, value)
+	}
+	if value, ok := rduo.mutation.AddedConfidence(); ok {
+		_spec.AddField(routingdecision.FieldConfidence, field.TypeFloat64, value)
+	}
+	if value, ok := rduo.mutation.Domain(); ok {
+		_spec.SetField(routingdecision.FieldDomain, field.TypeString, value)
+	}
+	if rduo.mutation.DomainCleared() {
+		_spec.ClearField(routingdecision.FieldDomain, field.TypeString)
+	}
+	if value, ok := rduo.mutation.Metadata(); ok {
+		_spec.SetField(routingdecision.FieldMetadata, field.TypeJSON, value)
+	}
+	if rduo.mutation.MetadataCleared() {
+		_spec.ClearField(routingdecision.FieldMetadata, field.TypeJSON)
+	}
+	if rduo.mutation.SpikeEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   routingdecision.SpikeEventsTable,
+			Columns: routingdecision.SpikeEventsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(spikeevent.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rduo.mutation.RemovedSpikeEventsIDs(); len(nodes) > 0 && !rduo.mutation.SpikeEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   routingdecision.SpikeEventsTable,
+			Columns: routingdecision.SpikeEventsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(spikeevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rduo.mutation.SpikeEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   routingdecision.SpikeEventsTable,
+			Columns: routingdecision.SpikeEventsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(spikeevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rduo.mutation.ActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   routingdecision.ActionsTable,
+			Columns: routingdecision.ActionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentaction.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rduo.mutation.RemovedActionsIDs(); len(nodes) > 0 && !rduo.mutation.ActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   routingdecision.ActionsTable,
+			Columns: routingdecision.ActionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rduo.mutation.ActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   routingdecision.ActionsTable,
+			Columns: routingdecision.ActionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RoutingDecision{config: rduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{routingdecision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rduo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/spikeevent"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+	"entgo.io/contrib/entcausal/schema"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	agentactionFields := schema.AgentAction{}.Fields()
+	_ = agentactionFields
+	// agentactionDescTimestamp is the schema descriptor for timestamp field.
+	agentactionDescTimestamp := agentactionFields[1].Descriptor()
+	// agentaction.DefaultTimestamp holds the default value on creation for the timestamp field.
+	agentaction.DefaultTimestamp = agentactionDescTimestamp.Default.(func() time.Time)
+	// agentactionDescAgentID is the schema descriptor for agent_id field.
+	agentactionDescAgentID := agentactionFields[2].Descriptor()
+	// agentaction.AgentIDValidator is a validator for the "agent_id" field. It is called by the builders before save.
+	agentaction.AgentIDValidator = agentactionDescAgentID.Validators[0].(func(string) error)
+	// agentactionDescAgentType is the schema descriptor for agent_type field.
+	agentactionDescAgentType := agentactionFields[3].Descriptor()
+	// agentaction.AgentTypeValidator is a validator for the "agent_type" field. It is called by the builders before save.
+	agentaction.AgentTypeValidator = agentactionDescAgentType.Validators[0].(func(string) error)
+	// agentactionDescActionType is the schema descriptor for action_type field.
+	agentactionDescActionType := agentactionFields[4].Descriptor()
+	// agentaction.ActionTypeValidator is a validator for the "action_type" field. It is called by the builders before save.
+	agentaction.ActionTypeValidator = agentactionDescActionType.Validators[0].(func(string) error)
+	externaloutputFields := schema.ExternalOutput{}.Fields()
+	_ = externaloutputFields
+	// externaloutputDescTimestamp is the schema descriptor for timestamp field.
+	externaloutputDescTimestamp := externaloutputFields[1].Descriptor()
+	// externaloutput.DefaultTimestamp holds the default value on creation for the timestamp field.
+	externaloutput.DefaultTimestamp = externaloutputDescTimestamp.Default.(func() time.Time)
+	// externaloutputDescContentHash is the schema descriptor for content_hash field.
+	externaloutputDescContentHash := externaloutputFields[8].Descriptor()
+	// externaloutput.ContentHashValidator is a validator for the "content_hash" field. It is called by the builders before save.
+	externaloutput.ContentHashValidator = externaloutputDescContentHash.Validators[0].(func(string) error)
+	// externaloutputDescRetentionYears is the schema descriptor for retention_years field.
+	externaloutputDescRetentionYears := externaloutputFields[13].Descriptor()
+	// externaloutput.DefaultRetentionYears holds the default value on creation for the retention_years field.
+	externaloutput.DefaultRetentionYears = externaloutputDescRetentionYears.Default.(int)
+	routingdecisionFields := schema.RoutingDecision{}.Fields()
+	_ = routingdecisionFields
+	// routingdecisionDescTimestamp is the schema descriptor for timestamp field.
+	routingdecisionDescTimestamp := routingdecisionFields[1].Descriptor()
+	// routingdecision.DefaultTimestamp holds the default value on creation for the timestamp field.
+	routingdecision.DefaultTimestamp = routingdecisionDescTimestamp.Default.(func() time.Time)
+	// routingdecisionDescInferenceID is the schema descriptor for inference_id field.
+	routingdecisionDescInferenceID := routingdecisionFields[2].Descriptor()
+	// routingdecision.InferenceIDValidator is a validator for the "inference_id" field. It is called by the builders before save.
+	routingdecision.InferenceIDValidator = routingdecisionDescInferenceID.Validators[0].(func(string) error)
+	// routingdecisionDescLayerIndex is the schema descriptor for layer_index field.
+	routingdecisionDescLayerIndex := routingdecisionFields[4].Descriptor()
+	// routingdecision.DefaultLayerIndex holds the default value on creation for the layer_index field.
+	routingdecision.DefaultLayerIndex = routingdecisionDescLayerIndex.Default.(int)
+	// routingdecisionDescGateProbability is the schema descriptor for gate_probability field.
+	routingdecisionDescGateProbability := routingdecisionFields[5].Descriptor()
+	// routingdecision.DefaultGateProbability holds the default value on creation for the gate_probability field.
+	routingdecision.DefaultGateProbability = routingdecisionDescGateProbability.Default.(float64)
+	// routingdecision.GateProbabilityValidator is a validator for the "gate_probability" field. It is called by the builders before save.
+	routingdecision.GateProbabilityValidator = func() func(float64) error {
+		validators := routingdecisionDescGateProbability.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(gate_probability float64) error {
+			for _, fn := range fns {
+				if err := fn(gate_probability); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// routingdecisionDescIterationCount is the schema descriptor for iteration_count field.
+	routingdecisionDescIterationCount := routingdecisionFields[7].Descriptor()
+	// routingdecision.DefaultIterationCount holds the default value on creation for the iteration_count field.
+	routingdecision.DefaultIterationCount = routingdecisionDescIterationCount.Default.(int)
+	// routingdecisionDescConfidence is the schema descriptor for confidence field.
+	routingdecisionDescConfidence := routingdecisionFields[8].Descriptor()
+	// routingdecision.DefaultConfidence holds the default value on creation for the confidence field.
+	routingdecision.DefaultConfidence = routingdecisionDescConfidence.Default.(float64)
+	// routingdecision.ConfidenceValidator is a validator for the "confidence" field. It is called by the builders before save.
+	routingdecision.ConfidenceValidator = func() func(float64) error {
+		validators := routingdecisionDescConfidence.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(confidence float64) error {
+			for _, fn := range fns {
+				if err := fn(confidence); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	spikeeventFields := schema.SpikeEvent{}.Fields()
+	_ = spikeeventFields
+	// spikeeventDescTimestamp is the schema descriptor for timestamp field.
+	spikeeventDescTimestamp := spikeeventFields[1].Descriptor()
+	// spikeevent.DefaultTimestamp holds the default value on creation for the timestamp field.
+	spikeevent.DefaultTimestamp = spikeeventDescTimestamp.Default.(func() time.Time)
+	// spikeeventDescPopulationID is the schema descriptor for population_id field.
+	spikeeventDescPopulationID := spikeeventFields[3].Descriptor()
+	// spikeevent.PopulationIDValidator is a validator for the "population_id" field. It is called by the builders before save.
+	spikeevent.PopulationIDValidator = spikeeventDescPopulationID.Validators[0].(func(string) error)
+	// spikeeventDescLayerIndex is the schema descriptor for layer_index field.
+	spikeeventDescLayerIndex := spikeeventFields[4].Descriptor()
+	// spikeevent.DefaultLayerIndex holds the default value on creation for the layer_index field.
+	spikeevent.DefaultLayerIndex = spikeeventDescLayerIndex.Default.(int)
+	// spikeeventDescPatternHash is the schema descriptor for pattern_hash field.
+	spikeeventDescPatternHash := spikeeventFields[9].Descriptor()
+	// spikeevent.PatternHashValidator is a validator for the "pattern_hash" field. It is called by the builders before save.
+	spikeevent.PatternHashValidator = spikeeventDescPatternHash.Validators[0].(func(string) error)
+	// spikeeventDescIsEmergent is the schema descriptor for is_emergent field.
+	spikeeventDescIsEmergent := spikeeventFields[11].Descriptor()
+	// spikeevent.DefaultIsEmergent holds the default value on creation for the is_emergent field.
+	spikeevent.DefaultIsEmergent = spikeeventDescIsEmergent.Default.(bool)
+	// spikeeventDescEntropy is the schema descriptor for entropy field.
+	spikeeventDescEntropy := spikeeventFields[12].Descriptor()
+	// spikeevent.DefaultEntropy holds the default value on creation for the entropy field.
+	spikeevent.DefaultEntropy = spikeeventDescEntropy.Default.(float64)
+	workflowexecutionFields := schema.WorkflowExecution{}.Fields()
+	_ = workflowexecutionFields
+	// workflowexecutionDescStartedAt is the schema descriptor for started_at field.
+	workflowexecutionDescStartedAt := workflowexecutionFields[1].Descriptor()
+	// workflowexecution.DefaultStartedAt holds the default value on creation for the started_at field.
+	workflowexecution.DefaultStartedAt = workflowexecutionDescStartedAt.Default.(func() time.Time)
+	// workflowexecutionDescWorkflowID is the schema descriptor for workflow_id field.
+	workflowexecutionDescWorkflowID := workflowexecutionFields[3].Descriptor()
+	// workflowexecution.WorkflowIDValidator is a validator for the "workflow_id" field. It is called by the builders before save.
+	workflowexecution.WorkflowIDValidator = workflowexecutionDescWorkflowID.Validators[0].(func(string) error)
+	// workflowexecutionDescStepIndex is the schema descriptor for step_index field.
+	workflowexecutionDescStepIndex := workflowexecutionFields[7].Descriptor()
+	// workflowexecution.DefaultStepIndex holds the default value on creation for the step_index field.
+	workflowexecution.DefaultStepIndex = workflowexecutionDescStepIndex.Default.(int)
+}
@@ -0,0 +1,10 @@
+// Code generated by ent, DO NOT EDIT.
+
+package runtime
+
+// The schema-stitching logic is generated in entgo.io/contrib/entcausal/ent/runtime.go
+
+const (
+	Version = "v0.14.2-0.20250116103911-b91f8daf0e32"           // Version of ent codegen.
+	Sum     = "h1:caGPxY54QfxAj6W9Msw3vgOPDved92NoZjHXYiTRFHI=" // Sum of ent codegen.
+)
@@ -0,0 +1,321 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/spikeevent"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SpikeEvent is the model entity for the SpikeEvent schema.
+type SpikeEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Unique spike event identifier
+	ID string `json:"id,omitempty"`
+	// Tamper-evident digest over the record and its parents
+	ProvenanceHash string `json:"provenance_hash,omitempty"`
+	// Timestamp when spike occurred
+	Timestamp time.Time `json:"timestamp,omitempty"`
+	// Nanosecond precision timestamp
+	TimestampNs int64 `json:"timestamp_ns,omitempty"`
+	// ID of the neuron population that fired
+	PopulationID string `json:"population_id,omitempty"`
+	// Layer index in the neural network
+	LayerIndex int `json:"layer_index,omitempty"`
+	// Indices of neurons that fired
+	NeuronIndices []int `json:"neuron_indices,omitempty"`
+	// Number of spikes per neuron
+	SpikeCounts []int `json:"spike_counts,omitempty"`
+	// Membrane potentials at spike time
+	MembranePotentials []float64 `json:"membrane_potentials,omitempty"`
+	// Input currents at spike time
+	InputCurrents []float64 `json:"input_currents,omitempty"`
+	// Deterministic hash of the firing pattern
+	PatternHash string `json:"pattern_hash,omitempty"`
+	// Links to the neural audit entry
+	InferenceID string `json:"inference_id,omitempty"`
+	// Whether this is an emergent pattern
+	IsEmergent bool `json:"is_emergent,omitempty"`
+	// Entropy of the spike pattern
+	Entropy float64 `json:"entropy,omitempty"`
+	// Additional metadata
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SpikeEventQuery when eager-loading is set.
+	Edges        SpikeEventEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SpikeEventEdges holds the relations/edges for other nodes in the graph.
+type SpikeEventEdges struct {
+	// Routing decisions caused by this spike event
+	Decisions []*RoutingDecision `json:"decisions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+	// totalCount holds the count of the edges above.
+	totalCount [1]map[string]int
+
+	namedDecisions map[string][]*RoutingDecision
+}
+
+// DecisionsOrErr returns the Decisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e SpikeEventEdges) DecisionsOrErr() ([]*RoutingDecision, error) {
+	if e.loadedTypes[0] {
+		return e.Decisions, nil
+	}
+	return nil, &NotLoadedError{edge: "decisions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SpikeEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case spikeevent.FieldNeuronIndices, spikeevent.FieldSpikeCounts, spikeevent.FieldMembranePotentials, spikeevent.FieldInputCurrents, spikeevent.FieldMetadata:
+			values[i] = new([]byte)
+		case spikeevent.FieldIsEmergent:
+			values[i] = new(sql.NullBool)
+		case spikeevent.FieldEntropy:
+			values[i] = new(sql.NullFloat64)
+		case spikeevent.FieldTimestampNs, spikeevent.FieldLayerIndex:
+			values[i] = new(sql.NullInt64)
+		case spikeevent.FieldID, spikeevent.FieldProvenanceHash, spikeevent.FieldPopulationID, spikeevent.FieldPatternHash, spikeevent.FieldInferenceID:
+			values[i] = new(sql.NullString)
+		case spikeevent.FieldTimestamp:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SpikeEvent fields.
+func (se *SpikeEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case spikeevent.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				se.ID = value.String
+			}
+		case spikeevent.FieldProvenanceHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provenance_hash", values[i])
+			} else if value.Valid {
+				se.ProvenanceHash = value.String
+			}
+		case spikeevent.FieldTimestamp:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
+			} else if value.Valid {
+				se.Timestamp = value.Time
+			}
+		case spikeevent.FieldTimestampNs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field timestamp_ns", values[i])
+			} else if value.Valid {
+				se.TimestampNs = value.Int64
+			}
+		case spikeevent.FieldPopulationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field population_id", values[i])
+			} else if value.Valid {
+				se.PopulationID = value.String
+			}
+		case spikeevent.FieldLayerIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field layer_index", values[i])
+			} else if value.Valid {
+				se.LayerIndex = int(value.Int64)
+			}
+		case spikeevent.FieldNeuronIndices:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field neuron_indices", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &se.NeuronIndices); err != nil {
+					return fmt.Errorf("unmarshal field neuron_indices: %w", err)
+				}
+			}
+		case spikeevent.FieldSpikeCounts:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field spike_counts", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &se.SpikeCounts); err != nil {
+					return fmt.Errorf("unmarshal field spike_counts: %w", err)
+				}
+			}
+		case spikeevent.FieldMembranePotentials:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field membrane_potentials", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &se.MembranePotentials); err != nil {
+					return fmt.Errorf("unmarshal field membrane_potentials: %w", err)
+				}
+			}
+		case spikeevent.FieldInputCurrents:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field input_currents", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &se.InputCurrents); err != nil {
+					return fmt.Errorf("unmarshal field input_currents: %w", err)
+				}
+			}
+		case spikeevent.FieldPatternHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pattern_hash", values[i])
+			} else if value.Valid {
+				se.PatternHash = value.String
+			}
+		case spikeevent.FieldInferenceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field inference_id", values[i])
+			} else if value.Valid {
+				se.InferenceID = value.String
+			}
+		case spikeevent.FieldIsEmergent:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_emergent", values[i])
+			} else if value.Valid {
+				se.IsEmergent = value.Bool
+			}
+		case spikeevent.FieldEntropy:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field entropy", values[i])
+			} else if value.Valid {
+				se.Entropy = value.Float64
+			}
+		case spikeevent.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &se.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		default:
+			se.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SpikeEvent.
+// This includes values selected through modifiers, order, etc.
+func (se *SpikeEvent) Value(name string) (ent.Value, error) {
+	return se.selectValues.Get(name)
+}
+
+// QueryDecisions queries the "decisions" edge of the SpikeEvent entity.
+func (se *SpikeEvent) QueryDecisions() *RoutingDecisionQuery {
+	return NewSpikeEventClient(se.config).QueryDecisions(se)
+}
+
+// Update returns a builder for updating this SpikeEvent.
+// Note that you need to call SpikeEvent.Unwrap() before calling this method if this SpikeEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (se *SpikeEvent) Update() *SpikeEventUpdateOne {
+	return NewSpikeEventClient(se.config).UpdateOne(se)
+}
+
+// Unwrap unwraps the SpikeEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (se *SpikeEvent) Unwrap() *SpikeEvent {
+	_tx, ok := se.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SpikeEvent is not a transactional entity")
+	}
+	se.config.driver = _tx.drv
+	return se
+}
+
+// String implements the fmt.Stringer.
+func (se *SpikeEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("SpikeEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", se.ID))
+	builder.WriteString("provenance_hash=")
+	builder.WriteString(se.ProvenanceHash)
+	builder.WriteString(", ")
+	builder.WriteString("timestamp=")
+	builder.WriteString(se.Timestamp.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("timestamp_ns=")
+	builder.WriteString(fmt.Sprintf("%v", se.TimestampNs))
+	builder.WriteString(", ")
+	builder.WriteString("population_id=")
+	builder.WriteString(se.PopulationID)
+	builder.WriteString(", ")
+	builder.WriteString("layer_index=")
+	builder.WriteString(fmt.Sprintf("%v", se.LayerIndex))
+	builder.WriteString(", ")
+	builder.WriteString("neuron_indices=")
+	builder.WriteString(fmt.Sprintf("%v", se.NeuronIndices))
+	builder.WriteString(", ")
+	builder.WriteString("spike_counts=")
+	builder.WriteString(fmt.Sprintf("%v", se.SpikeCounts))
+	builder.WriteString(", ")
+	builder.WriteString("membrane_potentials=")
+	builder.WriteString(fmt.Sprintf("%v", se.MembranePotentials))
+	builder.WriteString(", ")
+	builder.WriteString("input_currents=")
+	builder.WriteString(fmt.Sprintf("%v", se.InputCurrents))
+	builder.WriteString(", ")
+	builder.WriteString("pattern_hash=")
+	builder.WriteString(se.PatternHash)
+	builder.WriteString(", ")
+	builder.WriteString("inference_id=")
+	builder.WriteString(se.InferenceID)
+	builder.WriteString(", ")
+	builder.WriteString("is_emergent=")
+	builder.WriteString(fmt.Sprintf("%v", se.IsEmergent))
+	builder.WriteString(", ")
+	builder.WriteString("entropy=")
+	builder.WriteString(fmt.Sprintf("%v", se.Entropy))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", se.Metadata))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedDecisions returns the Decisions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (se *SpikeEvent) NamedDecisions(name string) ([]*RoutingDecision, error) {
+	if se.Edges.namedDecisions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := se.Edges.namedDecisions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (se *SpikeEvent) appendNamedDecisions(name string, edges ...*RoutingDecision) {
+	if se.Edges.namedDecisions == nil {
+		se.Edges.namedDecisions = make(map[string][]*RoutingDecision)
+	}
+	if len(edges) == 0 {
+		se.Edges.namedDecisions[name] = []*RoutingDecision{}
+	} else {
+		se.Edges.namedDecisions[name] = append(se.Edges.namedDecisions[name], edges...)
+	}
+}
+
+// SpikeEvents is a parsable slice of SpikeEvent.
+type SpikeEvents []*SpikeEvent
@@ -0,0 +1,178 @@
+// Code generated by ent, DO NOT EDIT.
+
+package spikeevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the spikeevent type in the database.
+	Label = "spike_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProvenanceHash holds the string denoting the provenance_hash field in the database.
+	FieldProvenanceHash = "provenance_hash"
+	// FieldTimestamp holds the string denoting the timestamp field in the database.
+	FieldTimestamp = "timestamp"
+	// FieldTimestampNs holds the string denoting the timestamp_ns field in the database.
+	FieldTimestampNs = "timestamp_ns"
+	// FieldPopulationID holds the string denoting the population_id field in the database.
+	FieldPopulationID = "population_id"
+	// FieldLayerIndex holds the string denoting the layer_index field in the database.
+	FieldLayerIndex = "layer_index"
+	// FieldNeuronIndices holds the string denoting the neuron_indices field in the database.
+	FieldNeuronIndices = "neuron_indices"
+	// FieldSpikeCounts holds the string denoting the spike_counts field in the database.
+	FieldSpikeCounts = "spike_counts"
+	// FieldMembranePotentials holds the string denoting the membrane_potentials field in the database.
+	FieldMembranePotentials = "membrane_potentials"
+	// FieldInputCurrents holds the string denoting the input_currents field in the database.
+	FieldInputCurrents = "input_currents"
+	// FieldPatternHash holds the string denoting the pattern_hash field in the database.
+	FieldPatternHash = "pattern_hash"
+	// FieldInferenceID holds the string denoting the inference_id field in the database.
+	FieldInferenceID = "inference_id"
+	// FieldIsEmergent holds the string denoting the is_emergent field in the database.
+	FieldIsEmergent = "is_emergent"
+	// FieldEntropy holds the string denoting the entropy field in the database.
+	FieldEntropy = "entropy"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// EdgeDecisions holds the string denoting the decisions edge name in mutations.
+	EdgeDecisions = "decisions"
+	// Table holds the table name of the spikeevent in the database.
+	Table = "spike_events"
+	// DecisionsTable is the table that holds the decisions relation/edge. The primary key declared below.
+	DecisionsTable = "spike_event_decisions"
+	// DecisionsInverseTable is the table name for the RoutingDecision entity.
+	// It exists in this package in order to avoid circular dependency with the "routingdecision" package.
+	DecisionsInverseTable = "routing_decisions"
+)
+
+// Columns holds all SQL columns for spikeevent fields.
+var Columns = []string{
+	FieldID,
+	FieldProvenanceHash,
+	FieldTimestamp,
+	FieldTimestampNs,
+	FieldPopulationID,
+	FieldLayerIndex,
+	FieldNeuronIndices,
+	FieldSpikeCounts,
+	FieldMembranePotentials,
+	FieldInputCurrents,
+	FieldPatternHash,
+	FieldInferenceID,
+	FieldIsEmergent,
+	FieldEntropy,
+	FieldMetadata,
+}
+
+var (
+	// DecisionsPrimaryKey and DecisionsColumn2 are the table columns denoting the
+	// primary key for the decisions relation (M2M).
+	DecisionsPrimaryKey = []string{"spike_event_id", "routing_decision_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultTimestamp holds the default value on creation for the "timestamp" field.
+	DefaultTimestamp func() time.Time
+	// PopulationIDValidator is a validator for the "population_id" field. It is called by the builders before save.
+	PopulationIDValidator func(string) error
+	// DefaultLayerIndex holds the default value on creation for the "layer_index" field.
+	DefaultLayerIndex int
+	// PatternHashValidator is a validator for the "pattern_hash" field. It is called by the builders before save.
+	PatternHashValidator func(string) error
+	// DefaultIsEmergent holds the default value on creation for the "is_emergent" field.
+	DefaultIsEmergent bool
+	// DefaultEntropy holds the default value on creation for the "entropy" field.
+	DefaultEntropy float64
+)
+
+// OrderOption defines the ordering options for the SpikeEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProvenanceHash orders the results by the provenance_hash field.
+func ByProvenanceHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvenanceHash, opts...).ToFunc()
+}
+
+// ByTimestamp orders the results by the timestamp field.
+func ByTimestamp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimestamp, opts...).ToFunc()
+}
+
+// ByTimestampNs orders the results by the timestamp_ns field.
+func ByTimestampNs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimestampNs, opts...).ToFunc()
+}
+
+// ByPopulationID orders the results by the population_id field.
+func ByPopulationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPopulationID, opts...).ToFunc()
+}
+
+// ByLayerIndex orders the results by the layer_index field.
+func ByLayerIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLayerIndex, opts...).ToFunc()
+}
+
+// ByPatternHash orders the results by the pattern_hash field.
+func ByPatternHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPatternHash, opts...).ToFunc()
+}
+
+// ByInferenceID orders the results by the inference_id field.
+func ByInferenceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInferenceID, opts...).ToFunc()
+}
+
+// ByIsEmergent orders the results by the is_emergent field.
+func ByIsEmergent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsEmergent, opts...).ToFunc()
+}
+
+// ByEntropy orders the results by the entropy field.
+func ByEntropy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntropy, opts...).ToFunc()
+}
+
+// ByDecisionsCount orders the results by decisions count.
+func ByDecisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDecisionsStep(), opts...)
+	}
+}
+
+// ByDecisions orders the results by decisions terms.
+func ByDecisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDecisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newDecisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DecisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, DecisionsTable, DecisionsPrimaryKey...),
+	)
+}
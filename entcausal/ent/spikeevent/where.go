@@ -0,0 +1,649 @@
+// Code generated by ent, DO NOT EDIT.
+
+package spikeevent
+
+import (
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldContainsFold(FieldID, id))
+}
+
+// ProvenanceHash applies equality check predicate on the "provenance_hash" field. It's identical to ProvenanceHashEQ.
+func ProvenanceHash(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEQ(FieldProvenanceHash, v))
+}
+
+// Timestamp applies equality check predicate on the "timestamp" field. It's identical to TimestampEQ.
+func Timestamp(v time.Time) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// TimestampNs applies equality check predicate on the "timestamp_ns" field. It's identical to TimestampNsEQ.
+func TimestampNs(v int64) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEQ(FieldTimestampNs, v))
+}
+
+// PopulationID applies equality check predicate on the "population_id" field. It's identical to PopulationIDEQ.
+func PopulationID(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEQ(FieldPopulationID, v))
+}
+
+// LayerIndex applies equality check predicate on the "layer_index" field. It's identical to LayerIndexEQ.
+func LayerIndex(v int) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEQ(FieldLayerIndex, v))
+}
+
+// PatternHash applies equality check predicate on the "pattern_hash" field. It's identical to PatternHashEQ.
+func PatternHash(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEQ(FieldPatternHash, v))
+}
+
+// InferenceID applies equality check predicate on the "inference_id" field. It's identical to InferenceIDEQ.
+func InferenceID(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEQ(FieldInferenceID, v))
+}
+
+// IsEmergent applies equality check predicate on the "is_emergent" field. It's identical to IsEmergentEQ.
+func IsEmergent(v bool) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEQ(FieldIsEmergent, v))
+}
+
+// Entropy applies equality check predicate on the "entropy" field. It's identical to EntropyEQ.
+func Entropy(v float64) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEQ(FieldEntropy, v))
+}
+
+// ProvenanceHashEQ applies the EQ predicate on the "provenance_hash" field.
+func ProvenanceHashEQ(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEQ(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashNEQ applies the NEQ predicate on the "provenance_hash" field.
+func ProvenanceHashNEQ(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNEQ(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashIn applies the In predicate on the "provenance_hash" field.
+func ProvenanceHashIn(vs ...string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldIn(FieldProvenanceHash, vs...))
+}
+
+// ProvenanceHashNotIn applies the NotIn predicate on the "provenance_hash" field.
+func ProvenanceHashNotIn(vs ...string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNotIn(FieldProvenanceHash, vs...))
+}
+
+// ProvenanceHashGT applies the GT predicate on the "provenance_hash" field.
+func ProvenanceHashGT(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldGT(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashGTE applies the GTE predicate on the "provenance_hash" field.
+func ProvenanceHashGTE(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldGTE(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashLT applies the LT predicate on the "provenance_hash" field.
+func ProvenanceHashLT(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldLT(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashLTE applies the LTE predicate on the "provenance_hash" field.
+func ProvenanceHashLTE(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldLTE(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashContains applies the Contains predicate on the "provenance_hash" field.
+func ProvenanceHashContains(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldContains(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashHasPrefix applies the HasPrefix predicate on the "provenance_hash" field.
+func ProvenanceHashHasPrefix(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldHasPrefix(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashHasSuffix applies the HasSuffix predicate on the "provenance_hash" field.
+func ProvenanceHashHasSuffix(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldHasSuffix(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashIsNil applies the IsNil predicate on the "provenance_hash" field.
+func ProvenanceHashIsNil() predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldIsNull(FieldProvenanceHash))
+}
+
+// ProvenanceHashNotNil applies the NotNil predicate on the "provenance_hash" field.
+func ProvenanceHashNotNil() predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNotNull(FieldProvenanceHash))
+}
+
+// ProvenanceHashEqualFold applies the EqualFold predicate on the "provenance_hash" field.
+func ProvenanceHashEqualFold(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEqualFold(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashContainsFold applies the ContainsFold predicate on the "provenance_hash" field.
+func ProvenanceHashContainsFold(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldContainsFold(FieldProvenanceHash, v))
+}
+
+// TimestampEQ applies the EQ predicate on the "timestamp" field.
+func TimestampEQ(v time.Time) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// TimestampNEQ applies the NEQ predicate on the "timestamp" field.
+func TimestampNEQ(v time.Time) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNEQ(FieldTimestamp, v))
+}
+
+// TimestampIn applies the In predicate on the "timestamp" field.
+func TimestampIn(vs ...time.Time) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldIn(FieldTimestamp, vs...))
+}
+
+// TimestampNotIn applies the NotIn predicate on the "timestamp" field.
+func TimestampNotIn(vs ...time.Time) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNotIn(FieldTimestamp, vs...))
+}
+
+// TimestampGT applies the GT predicate on the "timestamp" field.
+func TimestampGT(v time.Time) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldGT(FieldTimestamp, v))
+}
+
+// TimestampGTE applies the GTE predicate on the "timestamp" field.
+func TimestampGTE(v time.Time) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldGTE(FieldTimestamp, v))
+}
+
+// TimestampLT applies the LT predicate on the "timestamp" field.
+func TimestampLT(v time.Time) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldLT(FieldTimestamp, v))
+}
+
+// TimestampLTE applies the LTE predicate on the "timestamp" field.
+func TimestampLTE(v time.Time) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldLTE(FieldTimestamp, v))
+}
+
+// TimestampNsEQ applies the EQ predicate on the "timestamp_ns" field.
+func TimestampNsEQ(v int64) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEQ(FieldTimestampNs, v))
+}
+
+// TimestampNsNEQ applies the NEQ predicate on the "timestamp_ns" field.
+func TimestampNsNEQ(v int64) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNEQ(FieldTimestampNs, v))
+}
+
+// TimestampNsIn applies the In predicate on the "timestamp_ns" field.
+func TimestampNsIn(vs ...int64) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldIn(FieldTimestampNs, vs...))
+}
+
+// TimestampNsNotIn applies the NotIn predicate on the "timestamp_ns" field.
+func TimestampNsNotIn(vs ...int64) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNotIn(FieldTimestampNs, vs...))
+}
+
+// TimestampNsGT applies the GT predicate on the "timestamp_ns" field.
+func TimestampNsGT(v int64) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldGT(FieldTimestampNs, v))
+}
+
+// TimestampNsGTE applies the GTE predicate on the "timestamp_ns" field.
+func TimestampNsGTE(v int64) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldGTE(FieldTimestampNs, v))
+}
+
+// TimestampNsLT applies the LT predicate on the "timestamp_ns" field.
+func TimestampNsLT(v int64) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldLT(FieldTimestampNs, v))
+}
+
+// TimestampNsLTE applies the LTE predicate on the "timestamp_ns" field.
+func TimestampNsLTE(v int64) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldLTE(FieldTimestampNs, v))
+}
+
+// TimestampNsIsNil applies the IsNil predicate on the "timestamp_ns" field.
+func TimestampNsIsNil() predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldIsNull(FieldTimestampNs))
+}
+
+// TimestampNsNotNil applies the NotNil predicate on the "timestamp_ns" field.
+func TimestampNsNotNil() predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNotNull(FieldTimestampNs))
+}
+
+// PopulationIDEQ applies the EQ predicate on the "population_id" field.
+func PopulationIDEQ(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEQ(FieldPopulationID, v))
+}
+
+// PopulationIDNEQ applies the NEQ predicate on the "population_id" field.
+func PopulationIDNEQ(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNEQ(FieldPopulationID, v))
+}
+
+// PopulationIDIn applies the In predicate on the "population_id" field.
+func PopulationIDIn(vs ...string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldIn(FieldPopulationID, vs...))
+}
+
+// PopulationIDNotIn applies the NotIn predicate on the "population_id" field.
+func PopulationIDNotIn(vs ...string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNotIn(FieldPopulationID, vs...))
+}
+
+// PopulationIDGT applies the GT predicate on the "population_id" field.
+func PopulationIDGT(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldGT(FieldPopulationID, v))
+}
+
+// PopulationIDGTE applies the GTE predicate on the "population_id" field.
+func PopulationIDGTE(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldGTE(FieldPopulationID, v))
+}
+
+// PopulationIDLT applies the LT predicate on the "population_id" field.
+func PopulationIDLT(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldLT(FieldPopulationID, v))
+}
+
+// PopulationIDLTE applies the LTE predicate on the "population_id" field.
+func PopulationIDLTE(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldLTE(FieldPopulationID, v))
+}
+
+// PopulationIDContains applies the Contains predicate on the "population_id" field.
+func PopulationIDContains(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldContains(FieldPopulationID, v))
+}
+
+// PopulationIDHasPrefix applies the HasPrefix predicate on the "population_id" field.
+func PopulationIDHasPrefix(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldHasPrefix(FieldPopulationID, v))
+}
+
+// PopulationIDHasSuffix applies the HasSuffix predicate on the "population_id" field.
+func PopulationIDHasSuffix(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldHasSuffix(FieldPopulationID, v))
+}
+
+// PopulationIDEqualFold applies the EqualFold predicate on the "population_id" field.
+func PopulationIDEqualFold(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEqualFold(FieldPopulationID, v))
+}
+
+// PopulationIDContainsFold applies the ContainsFold predicate on the "population_id" field.
+func PopulationIDContainsFold(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldContainsFold(FieldPopulationID, v))
+}
+
+// LayerIndexEQ applies the EQ predicate on the "layer_index" field.
+func LayerIndexEQ(v int) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEQ(FieldLayerIndex, v))
+}
+
+// LayerIndexNEQ applies the NEQ predicate on the "layer_index" field.
+func LayerIndexNEQ(v int) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNEQ(FieldLayerIndex, v))
+}
+
+// LayerIndexIn applies the In predicate on the "layer_index" field.
+func LayerIndexIn(vs ...int) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldIn(FieldLayerIndex, vs...))
+}
+
+// LayerIndexNotIn applies the NotIn predicate on the "layer_index" field.
+func LayerIndexNotIn(vs ...int) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNotIn(FieldLayerIndex, vs...))
+}
+
+// LayerIndexGT applies the GT predicate on the "layer_index" field.
+func LayerIndexGT(v int) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldGT(FieldLayerIndex, v))
+}
+
+// LayerIndexGTE applies the GTE predicate on the "layer_index" field.
+func LayerIndexGTE(v int) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldGTE(FieldLayerIndex, v))
+}
+
+// LayerIndexLT applies the LT predicate on the "layer_index" field.
+func LayerIndexLT(v int) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldLT(FieldLayerIndex, v))
+}
+
+// LayerIndexLTE applies the LTE predicate on the "layer_index" field.
+func LayerIndexLTE(v int) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldLTE(FieldLayerIndex, v))
+}
+
+// SpikeCountsIsNil applies the IsNil predicate on the "spike_counts" field.
+func SpikeCountsIsNil() predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldIsNull(FieldSpikeCounts))
+}
+
+// SpikeCountsNotNil applies the NotNil predicate on the "spike_counts" field.
+func SpikeCountsNotNil() predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNotNull(FieldSpikeCounts))
+}
+
+// MembranePotentialsIsNil applies the IsNil predicate on the "membrane_potentials" field.
+func MembranePotentialsIsNil() predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldIsNull(FieldMembranePotentials))
+}
+
+// MembranePotentialsNotNil applies the NotNil predicate on the "membrane_potentials" field.
+func MembranePotentialsNotNil() predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNotNull(FieldMembranePotentials))
+}
+
+// InputCurrentsIsNil applies the IsNil predicate on the "input_currents" field.
+func InputCurrentsIsNil() predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldIsNull(FieldInputCurrents))
+}
+
+// InputCurrentsNotNil applies the NotNil predicate on the "input_currents" field.
+func InputCurrentsNotNil() predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNotNull(FieldInputCurrents))
+}
+
+// PatternHashEQ applies the EQ predicate on the "pattern_hash" field.
+func PatternHashEQ(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEQ(FieldPatternHash, v))
+}
+
+// PatternHashNEQ applies the NEQ predicate on the "pattern_hash" field.
+func PatternHashNEQ(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNEQ(FieldPatternHash, v))
+}
+
+// PatternHashIn applies the In predicate on the "pattern_hash" field.
+func PatternHashIn(vs ...string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldIn(FieldPatternHash, vs...))
+}
+
+// PatternHashNotIn applies the NotIn predicate on the "pattern_hash" field.
+func PatternHashNotIn(vs ...string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNotIn(FieldPatternHash, vs...))
+}
+
+// PatternHashGT applies the GT predicate on the "pattern_hash" field.
+func PatternHashGT(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldGT(FieldPatternHash, v))
+}
+
+// PatternHashGTE applies the GTE predicate on the "pattern_hash" field.
+func PatternHashGTE(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldGTE(FieldPatternHash, v))
+}
+
+// PatternHashLT applies the LT predicate on the "pattern_hash" field.
+func PatternHashLT(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldLT(FieldPatternHash, v))
+}
+
+// PatternHashLTE applies the LTE predicate on the "pattern_hash" field.
+func PatternHashLTE(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldLTE(FieldPatternHash, v))
+}
+
+// PatternHashContains applies the Contains predicate on the "pattern_hash" field.
+func PatternHashContains(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldContains(FieldPatternHash, v))
+}
+
+// PatternHashHasPrefix applies the HasPrefix predicate on the "pattern_hash" field.
+func PatternHashHasPrefix(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldHasPrefix(FieldPatternHash, v))
+}
+
+// PatternHashHasSuffix applies the HasSuffix predicate on the "pattern_hash" field.
+func PatternHashHasSuffix(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldHasSuffix(FieldPatternHash, v))
+}
+
+// PatternHashEqualFold applies the EqualFold predicate on the "pattern_hash" field.
+func PatternHashEqualFold(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEqualFold(FieldPatternHash, v))
+}
+
+// PatternHashContainsFold applies the ContainsFold predicate on the "pattern_hash" field.
+func PatternHashContainsFold(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldContainsFold(FieldPatternHash, v))
+}
+
+// InferenceIDEQ applies the EQ predicate on the "inference_id" field.
+func InferenceIDEQ(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEQ(FieldInferenceID, v))
+}
+
+// InferenceIDNEQ applies the NEQ predicate on the "inference_id" field.
+func InferenceIDNEQ(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNEQ(FieldInferenceID, v))
+}
+
+// InferenceIDIn applies the In predicate on the "inference_id" field.
+func InferenceIDIn(vs ...string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldIn(FieldInferenceID, vs...))
+}
+
+// InferenceIDNotIn applies the NotIn predicate on the "inference_id" field.
+func InferenceIDNotIn(vs ...string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNotIn(FieldInferenceID, vs...))
+}
+
+// InferenceIDGT applies the GT predicate on the "inference_id" field.
+func InferenceIDGT(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldGT(FieldInferenceID, v))
+}
+
+// InferenceIDGTE applies the GTE predicate on the "inference_id" field.
+func InferenceIDGTE(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldGTE(FieldInferenceID, v))
+}
+
+// InferenceIDLT applies the LT predicate on the "inference_id" field.
+func InferenceIDLT(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldLT(FieldInferenceID, v))
+}
+
+// InferenceIDLTE applies the LTE predicate on the "inference_id" field.
+func InferenceIDLTE(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldLTE(FieldInferenceID, v))
+}
+
+// InferenceIDContains applies the Contains predicate on the "inference_id" field.
+func InferenceIDContains(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldContains(FieldInferenceID, v))
+}
+
+// InferenceIDHasPrefix applies the HasPrefix predicate on the "inference_id" field.
+func InferenceIDHasPrefix(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldHasPrefix(FieldInferenceID, v))
+}
+
+// InferenceIDHasSuffix applies the HasSuffix predicate on the "inference_id" field.
+func InferenceIDHasSuffix(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldHasSuffix(FieldInferenceID, v))
+}
+
+// InferenceIDIsNil applies the IsNil predicate on the "inference_id" field.
+func InferenceIDIsNil() predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldIsNull(FieldInferenceID))
+}
+
+// InferenceIDNotNil applies the NotNil predicate on the "inference_id" field.
+func InferenceIDNotNil() predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNotNull(FieldInferenceID))
+}
+
+// InferenceIDEqualFold applies the EqualFold predicate on the "inference_id" field.
+func InferenceIDEqualFold(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEqualFold(FieldInferenceID, v))
+}
+
+// InferenceIDContainsFold applies the ContainsFold predicate on the "inference_id" field.
+func InferenceIDContainsFold(v string) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldContainsFold(FieldInferenceID, v))
+}
+
+// IsEmergentEQ applies the EQ predicate on the "is_emergent" field.
+func IsEmergentEQ(v bool) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEQ(FieldIsEmergent, v))
+}
+
+// IsEmergentNEQ applies the NEQ predicate on the "is_emergent" field.
+func IsEmergentNEQ(v bool) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNEQ(FieldIsEmergent, v))
+}
+
+// EntropyEQ applies the EQ predicate on the "entropy" field.
+func EntropyEQ(v float64) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldEQ(FieldEntropy, v))
+}
+
+// EntropyNEQ applies the NEQ predicate on the "entropy" field.
+func EntropyNEQ(v float64) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNEQ(FieldEntropy, v))
+}
+
+// EntropyIn applies the In predicate on the "entropy" field.
+func EntropyIn(vs ...float64) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldIn(FieldEntropy, vs...))
+}
+
+// EntropyNotIn applies the NotIn predicate on the "entropy" field.
+func EntropyNotIn(vs ...float64) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNotIn(FieldEntropy, vs...))
+}
+
+// EntropyGT applies the GT predicate on the "entropy" field.
+func EntropyGT(v float64) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldGT(FieldEntropy, v))
+}
+
+// EntropyGTE applies the GTE predicate on the "entropy" field.
+func EntropyGTE(v float64) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldGTE(FieldEntropy, v))
+}
+
+// EntropyLT applies the LT predicate on the "entropy" field.
+func EntropyLT(v float64) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldLT(FieldEntropy, v))
+}
+
+// EntropyLTE applies the LTE predicate on the "entropy" field.
+func EntropyLTE(v float64) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldLTE(FieldEntropy, v))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.FieldNotNull(FieldMetadata))
+}
+
+// HasDecisions applies the HasEdge predicate on the "decisions" edge.
+func HasDecisions() predicate.SpikeEvent {
+	return predicate.SpikeEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, DecisionsTable, DecisionsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDecisionsWith applies the HasEdge predicate on the "decisions" edge with a given conditions (other predicates).
+func HasDecisionsWith(preds ...predicate.RoutingDecision) predicate.SpikeEvent {
+	return predicate.SpikeEvent(func(s *sql.Selector) {
+		step := newDecisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SpikeEvent) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SpikeEvent) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SpikeEvent) predicate.SpikeEvent {
+	return predicate.SpikeEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,463 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/spikeevent"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SpikeEventCreate is the builder for creating a SpikeEvent entity.
+type SpikeEventCreate struct {
+	config
+	mutation *SpikeEventMutation
+	hooks    []Hook
+}
+
+// SetProvenanceHash sets the "provenance_hash" field.
+func (sec *SpikeEventCreate) SetProvenanceHash(s string) *SpikeEventCreate {
+	sec.mutation.SetProvenanceHash(s)
+	return sec
+}
+
+// SetNillableProvenanceHash sets the "provenance_hash" field if the given value is not nil.
+func (sec *SpikeEventCreate) SetNillableProvenanceHash(s *string) *SpikeEventCreate {
+	if s != nil {
+		sec.SetProvenanceHash(*s)
+	}
+	return sec
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (sec *SpikeEventCreate) SetTimestamp(t time.Time) *SpikeEventCreate {
+	sec.mutation.SetTimestamp(t)
+	return sec
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (sec *SpikeEventCreate) SetNillableTimestamp(t *time.Time) *SpikeEventCreate {
+	if t != nil {
+		sec.SetTimestamp(*t)
+	}
+	return sec
+}
+
+// SetTimestampNs sets the "timestamp_ns" field.
+func (sec *SpikeEventCreate) SetTimestampNs(i int64) *SpikeEventCreate {
+	sec.mutation.SetTimestampNs(i)
+	return sec
+}
+
+// SetNillableTimestampNs sets the "timestamp_ns" field if the given value is not nil.
+func (sec *SpikeEventCreate) SetNillableTimestampNs(i *int64) *SpikeEventCreate {
+	if i != nil {
+		sec.SetTimestampNs(*i)
+	}
+	return sec
+}
+
+// SetPopulationID sets the "population_id" field.
+func (sec *SpikeEventCreate) SetPopulationID(s string) *SpikeEventCreate {
+	sec.mutation.SetPopulationID(s)
+	return sec
+}
+
+// SetLayerIndex sets the "layer_index" field.
+func (sec *SpikeEventCreate) SetLayerIndex(i int) *SpikeEventCreate {
+	sec.mutation.SetLayerIndex(i)
+	return sec
+}
+
+// SetNillableLayerIndex sets the "layer_index" field if the given value is not nil.
+func (sec *SpikeEventCreate) SetNillableLayerIndex(i *int) *SpikeEventCreate {
+	if i != nil {
+		sec.SetLayerIndex(*i)
+	}
+	return sec
+}
+
+// SetNeuronIndices sets the "neuron_indices" field.
+func (sec *SpikeEventCreate) SetNeuronIndices(i []int) *SpikeEventCreate {
+	sec.mutation.SetNeuronIndices(i)
+	return sec
+}
+
+// SetSpikeCounts sets the "spike_counts" field.
+func (sec *SpikeEventCreate) SetSpikeCounts(i []int) *SpikeEventCreate {
+	sec.mutation.SetSpikeCounts(i)
+	return sec
+}
+
+// SetMembranePotentials sets the "membrane_potentials" field.
+func (sec *SpikeEventCreate) SetMembranePotentials(f []float64) *SpikeEventCreate {
+	sec.mutation.SetMembranePotentials(f)
+	return sec
+}
+
+// SetInputCurrents sets the "input_currents" field.
+func (sec *SpikeEventCreate) SetInputCurrents(f []float64) *SpikeEventCreate {
+	sec.mutation.SetInputCurrents(f)
+	return sec
+}
+
+// SetPatternHash sets the "pattern_hash" field.
+func (sec *SpikeEventCreate) SetPatternHash(s string) *SpikeEventCreate {
+	sec.mutation.SetPatternHash(s)
+	return sec
+}
+
+// SetInferenceID sets the "inference_id" field.
+func (sec *SpikeEventCreate) SetInferenceID(s string) *SpikeEventCreate {
+	sec.mutation.SetInferenceID(s)
+	return sec
+}
+
+// SetNillableInferenceID sets the "inference_id" field if the given value is not nil.
+func (sec *SpikeEventCreate) SetNillableInferenceID(s *string) *SpikeEventCreate {
+	if s != nil {
+		sec.SetInferenceID(*s)
+	}
+	return sec
+}
+
+// SetIsEmergent sets the "is_emergent" field.
+func (sec *SpikeEventCreate) SetIsEmergent(b bool) *SpikeEventCreate {
+	sec.mutation.SetIsEmergent(b)
+	return sec
+}
+
+// SetNillableIsEmergent sets the "is_emergent" field if the given value is not nil.
+func (sec *SpikeEventCreate) SetNillableIsEmergent(b *bool) *SpikeEventCreate {
+	if b != nil {
+		sec.SetIsEmergent(*b)
+	}
+	return sec
+}
+
+// SetEntropy sets the "entropy" field.
+func (sec *SpikeEventCreate) SetEntropy(f float64) *SpikeEventCreate {
+	sec.mutation.SetEntropy(f)
+	return sec
+}
+
+// SetNillableEntropy sets the "entropy" field if the given value is not nil.
+func (sec *SpikeEventCreate) SetNillableEntropy(f *float64) *SpikeEventCreate {
+	if f != nil {
+		sec.SetEntropy(*f)
+	}
+	return sec
+}
+
+// SetMetadata sets the "metadata" field.
+func (sec *SpikeEventCreate) SetMetadata(m map[string]interface{}) *SpikeEventCreate {
+	sec.mutation.SetMetadata(m)
+	return sec
+}
+
+// SetID sets the "id" field.
+func (sec *SpikeEventCreate) SetID(s string) *SpikeEventCreate {
+	sec.mutation.SetID(s)
+	return sec
+}
+
+// AddDecisionIDs adds the "decisions" edge to the RoutingDecision entity by IDs.
+func (sec *SpikeEventCreate) AddDecisionIDs(ids ...string) *SpikeEventCreate {
+	sec.mutation.AddDecisionIDs(ids...)
+	return sec
+}
+
+// AddDecisions adds the "decisions" edges to the RoutingDecision entity.
+func (sec *SpikeEventCreate) AddDecisions(r ...*RoutingDecision) *SpikeEventCreate {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return sec.AddDecisionIDs(ids...)
+}
+
+// Mutation returns the SpikeEventMutation object of the builder.
+func (sec *SpikeEventCreate) Mutation() *SpikeEventMutation {
+	return sec.mutation
+}
+
+// Save creates the SpikeEvent in the database.
+func (sec *SpikeEventCreate) Save(ctx context.Context) (*SpikeEvent, error) {
+	sec.defaults()
+	return withHooks(ctx, sec.sqlSave, sec.mutation, sec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sec *SpikeEventCreate) SaveX(ctx context.Context) *SpikeEvent {
+	v, err := sec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sec *SpikeEventCreate) Exec(ctx context.Context) error {
+	_, err := sec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sec *SpikeEventCreate) ExecX(ctx context.Context) {
+	if err := sec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sec *SpikeEventCreate) defaults() {
+	if _, ok := sec.mutation.Timestamp(); !ok {
+		v := spikeevent.DefaultTimestamp()
+		sec.mutation.SetTimestamp(v)
+	}
+	if _, ok := sec.mutation.LayerIndex(); !ok {
+		v := spikeevent.DefaultLayerIndex
+		sec.mutation.SetLayerIndex(v)
+	}
+	if _, ok := sec.mutation.IsEmergent(); !ok {
+		v := spikeevent.DefaultIsEmergent
+		sec.mutation.SetIsEmergent(v)
+	}
+	if _, ok := sec.mutation.Entropy(); !ok {
+		v := spikeevent.DefaultEntropy
+		sec.mutation.SetEntropy(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sec *SpikeEventCreate) check() error {
+	if _, ok := sec.mutation.Timestamp(); !ok {
+		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "SpikeEvent.timestamp"`)}
+	}
+	if _, ok := sec.mutation.PopulationID(); !ok {
+		return &ValidationError{Name: "population_id", err: errors.New(`ent: missing required field "SpikeEvent.population_id"`)}
+	}
+	if v, ok := sec.mutation.PopulationID(); ok {
+		if err := spikeevent.PopulationIDValidator(v); err != nil {
+			return &ValidationError{Name: "population_id", err: fmt.Errorf(`ent: validator failed for field "SpikeEvent.population_id": %w`, err)}
+		}
+	}
+	if _, ok := sec.mutation.LayerIndex(); !ok {
+		return &ValidationError{Name: "layer_index", err: errors.New(`ent: missing required field "SpikeEvent.layer_index"`)}
+	}
+	if _, ok := sec.mutation.NeuronIndices(); !ok {
+		return &ValidationError{Name: "neuron_indices", err: errors.New(`ent: missing required field "SpikeEvent.neuron_indices"`)}
+	}
+	if _, ok := sec.mutation.PatternHash(); !ok {
+		return &ValidationError{Name: "pattern_hash", err: errors.New(`ent: missing required field "SpikeEvent.pattern_hash"`)}
+	}
+	if v, ok := sec.mutation.PatternHash(); ok {
+		if err := spikeevent.PatternHashValidator(v); err != nil {
+			return &ValidationError{Name: "pattern_hash", err: fmt.Errorf(`ent: validator failed for field "SpikeEvent.pattern_hash": %w`, err)}
+		}
+	}
+	if _, ok := sec.mutation.IsEmergent(); !ok {
+		return &ValidationError{Name: "is_emergent", err: errors.New(`ent: missing required field "SpikeEvent.is_emergent"`)}
+	}
+	if _, ok := sec.mutation.Entropy(); !ok {
+		return &ValidationError{Name: "entropy", err: errors.New(`ent: missing required field "SpikeEvent.entropy"`)}
+	}
+	return nil
+}
+
+func (sec *SpikeEventCreate) sqlSave(ctx context.Context) (*SpikeEvent, error) {
+	if err := sec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SpikeEvent.ID type: %T", _spec.ID.Value)
+		}
+	}
+	sec.mutation.id = &_node.ID
+	sec.mutation.done = true
+	return _node, nil
+}
+
+func (sec *SpikeEventCreate) createSpec() (*SpikeEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SpikeEvent{config: sec.config}
+		_spec = sqlgraph.NewCreateSpec(spikeevent.Table, sqlgraph.NewFieldSpec(spikeevent.FieldID, field.TypeString))
+	)
+	if id, ok := sec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sec.mutation.ProvenanceHash(); ok {
+		_spec.SetField(spikeevent.FieldProvenanceHash, field.TypeString, value)
+		_node.ProvenanceHash = value
+	}
+	if value, ok := sec.mutation.Timestamp(); ok {
+		_spec.SetField(spikeevent.FieldTimestamp, field.TypeTime, value)
+		_node.Timestamp = value
+	}
+	if value, ok := sec.mutation.TimestampNs(); ok {
+		_spec.SetField(spikeevent.FieldTimestampNs, field.TypeInt64, value)
+		_node.TimestampNs = value
+	}
+	if value, ok := sec.mutation.PopulationID(); ok {
+		_spec.SetField(spikeevent.FieldPopulationID, field.TypeString, value)
+		_node.PopulationID = value
+	}
+	if value, ok := sec.mutation.LayerIndex(); ok {
+		_spec.SetField(spikeevent.FieldLayerIndex, field.TypeInt, value)
+		_node.LayerIndex = value
+	}
+	if value, ok := sec.mutation.NeuronIndices(); ok {
+		_spec.SetField(spikeevent.FieldNeuronIndices, field.TypeJSON, value)
+		_node.NeuronIndices = value
+	}
+	if value, ok := sec.mutation.SpikeCounts(); ok {
+		_spec.SetField(spikeevent.FieldSpikeCounts, field.TypeJSON, value)
+		_node.SpikeCounts = value
+	}
+	if value, ok := sec.mutation.MembranePotentials(); ok {
+		_spec.SetField(spikeevent.FieldMembranePotentials, field.TypeJSON, value)
+		_node.MembranePotentials = value
+	}
+	if value, ok := sec.mutation.InputCurrents(); ok {
+		_spec.SetField(spikeevent.FieldInputCurrents, field.TypeJSON, value)
+		_node.InputCurrents = value
+	}
+	if value, ok := sec.mutation.PatternHash(); ok {
+		_spec.SetField(spikeevent.FieldPatternHash, field.TypeString, value)
+		_node.PatternHash = value
+	}
+	if value, ok := sec.mutation.InferenceID(); ok {
+		_spec.SetField(spikeevent.FieldInferenceID, field.TypeString, value)
+		_node.InferenceID = value
+	}
+	if value, ok := sec.mutation.IsEmergent(); ok {
+		_spec.SetField(spikeevent.FieldIsEmergent, field.TypeBool, value)
+		_node.IsEmergent = value
+	}
+	if value, ok := sec.mutation.Entropy(); ok {
+		_spec.SetField(spikeevent.FieldEntropy, field.TypeFloat64, value)
+		_node.Entropy = value
+	}
+	if value, ok := sec.mutation.Metadata(); ok {
+		_spec.SetField(spikeevent.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if nodes := sec.mutation.DecisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   spikeevent.DecisionsTable,
+			Columns: spikeevent.DecisionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(routingdecision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SpikeEventCreateBulk is the builder for creating many SpikeEvent entities in bulk.
+type SpikeEventCreateBulk struct {
+	config
+	err      error
+	builders []*SpikeEventCreate
+}
+
+// Save creates the SpikeEvent entities in the database.
+func (secb *SpikeEventCreateBulk) Save(ctx context.Context) ([]*SpikeEvent, error) {
+	if secb.err != nil {
+		return nil, secb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(secb.builders))
+	nodes := make([]*SpikeEvent, len(secb.builders))
+	mutators := make([]Mutator, len(secb.builders))
+	for i := range secb.builders {
+		func(i int, root context.Context) {
+			builder := secb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SpikeEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, secb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, secb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, secb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (secb *SpikeEventCreateBulk) SaveX(ctx context.Context) []*SpikeEvent {
+	v, err := secb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (secb *SpikeEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := secb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (secb *SpikeEventCreateBulk) ExecX(ctx context.Context) {
+	if err := secb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
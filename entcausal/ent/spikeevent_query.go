@@ -0,0 +1,672 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/spikeevent"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SpikeEventQuery is the builder for querying SpikeEvent entities.
+type SpikeEventQuery struct {
+	config
+	ctx                *QueryContext
+	order              []spikeevent.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.SpikeEvent
+	withDecisions      *RoutingDecisionQuery
+	modifiers          []func(*sql.Selector)
+	loadTotal          []func(context.Context, []*SpikeEvent) error
+	withNamedDecisions map[string]*RoutingDecisionQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SpikeEventQuery builder.
+func (seq *SpikeEventQuery) Where(ps ...predicate.SpikeEvent) *SpikeEventQuery {
+	seq.predicates = append(seq.predicates, ps...)
+	return seq
+}
+
+// Limit the number of records to be returned by this query.
+func (seq *SpikeEventQuery) Limit(limit int) *SpikeEventQuery {
+	seq.ctx.Limit = &limit
+	return seq
+}
+
+// Offset to start from.
+func (seq *SpikeEventQuery) Offset(offset int) *SpikeEventQuery {
+	seq.ctx.Offset = &offset
+	return seq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (seq *SpikeEventQuery) Unique(unique bool) *SpikeEventQuery {
+	seq.ctx.Unique = &unique
+	return seq
+}
+
+// Order specifies how the records should be ordered.
+func (seq *SpikeEventQuery) Order(o ...spikeevent.OrderOption) *SpikeEventQuery {
+	seq.order = append(seq.order, o...)
+	return seq
+}
+
+// QueryDecisions chains the current query on the "decisions" edge.
+func (seq *SpikeEventQuery) QueryDecisions() *RoutingDecisionQuery {
+	query := (&RoutingDecisionClient{config: seq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := seq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := seq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(spikeevent.Table, spikeevent.FieldID, selector),
+			sqlgraph.To(routingdecision.Table, routingdecision.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, spikeevent.DecisionsTable, spikeevent.DecisionsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(seq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SpikeEvent entity from the query.
+// Returns a *NotFoundError when no SpikeEvent was found.
+func (seq *SpikeEventQuery) First(ctx context.Context) (*SpikeEvent, error) {
+	nodes, err := seq.Limit(1).All(setContextOp(ctx, seq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{spikeevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (seq *SpikeEventQuery) FirstX(ctx context.Context) *SpikeEvent {
+	node, err := seq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SpikeEvent ID from the query.
+// Returns a *NotFoundError when no SpikeEvent ID was found.
+func (seq *SpikeEventQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = seq.Limit(1).IDs(setContextOp(ctx, seq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{spikeevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (seq *SpikeEventQuery) FirstIDX(ctx context.Context) string {
+	id, err := seq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SpikeEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SpikeEvent entity is found.
+// Returns a *NotFoundError when no SpikeEvent entities are found.
+func (seq *SpikeEventQuery) Only(ctx context.Context) (*SpikeEvent, error) {
+	nodes, err := seq.Limit(2).All(setContextOp(ctx, seq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{spikeevent.Label}
+	default:
+		return nil, &NotSingularError{spikeevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (seq *SpikeEventQuery) OnlyX(ctx context.Context) *SpikeEvent {
+	node, err := seq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SpikeEvent ID in the query.
+// Returns a *NotSingularError when more than one SpikeEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (seq *SpikeEventQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = seq.Limit(2).IDs(setContextOp(ctx, seq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{spikeevent.Label}
+	default:
+		err = &NotSingularError{spikeevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (seq *SpikeEventQuery) OnlyIDX(ctx context.Context) string {
+	id, err := seq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SpikeEvents.
+func (seq *SpikeEventQuery) All(ctx context.Context) ([]*SpikeEvent, error) {
+	ctx = setContextOp(ctx, seq.ctx, ent.OpQueryAll)
+	if err := seq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SpikeEvent, *SpikeEventQuery]()
+	return withInterceptors[[]*SpikeEvent](ctx, seq, qr, seq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (seq *SpikeEventQuery) AllX(ctx context.Context) []*SpikeEvent {
+	nodes, err := seq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SpikeEvent IDs.
+func (seq *SpikeEventQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if seq.ctx.Unique == nil && seq.path != nil {
+		seq.Unique(true)
+	}
+	ctx = setContextOp(ctx, seq.ctx, ent.OpQueryIDs)
+	if err = seq.Select(spikeevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (seq *SpikeEventQuery) IDsX(ctx context.Context) []string {
+	ids, err := seq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (seq *SpikeEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, seq.ctx, ent.OpQueryCount)
+	if err := seq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, seq, querierCount[*SpikeEventQuery](), seq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (seq *SpikeEventQuery) CountX(ctx context.Context) int {
+	count, err := seq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (seq *SpikeEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, seq.ctx, ent.OpQueryExist)
+	switch _, err := seq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (seq *SpikeEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := seq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SpikeEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (seq *SpikeEventQuery) Clone() *SpikeEventQuery {
+	if seq == nil {
+		return nil
+	}
+	return &SpikeEventQuery{
+		config:        seq.config,
+		ctx:           seq.ctx.Clone(),
+		order:         append([]spikeevent.OrderOption{}, seq.order...),
+		inters:        append([]Interceptor{}, seq.inters...),
+		predicates:    append([]predicate.SpikeEvent{}, seq.predicates...),
+		withDecisions: seq.withDecisions.Clone(),
+		// clone intermediate query.
+		sql:  seq.sql.Clone(),
+		path: seq.path,
+	}
+}
+
+// WithDecisions tells the query-builder to eager-load the nodes that are connected to
+// the "decisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (seq *SpikeEventQuery) WithDecisions(opts ...func(*RoutingDecisionQuery)) *SpikeEventQuery {
+	query := (&RoutingDecisionClient{config: seq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	seq.withDecisions = query
+	return seq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ProvenanceHash string `json:"provenance_hash,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SpikeEvent.Query().
+//		GroupBy(spikeevent.FieldProvenanceHash).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (seq *SpikeEventQuery) GroupBy(field string, fields ...string) *SpikeEventGroupBy {
+	seq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SpikeEventGroupBy{build: seq}
+	grbuild.flds = &seq.ctx.Fields
+	grbuild.label = spikeevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ProvenanceHash string `json:"provenance_hash,omitempty"`
+//	}
+//
+//	client.SpikeEvent.Query().
+//		Select(spikeevent.FieldProvenanceHash).
+//		Scan(ctx, &v)
+func (seq *SpikeEventQuery) Select(fields ...string) *SpikeEventSelect {
+	seq.ctx.Fields = append(seq.ctx.Fields, fields...)
+	sbuild := &SpikeEventSelect{SpikeEventQuery: seq}
+	sbuild.label = spikeevent.Label
+	sbuild.flds, sbuild.scan = &seq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SpikeEventSelect configured with the given aggregations.
+func (seq *SpikeEventQuery) Aggregate(fns ...AggregateFunc) *SpikeEventSelect {
+	return seq.Select().Aggregate(fns...)
+}
+
+func (seq *SpikeEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range seq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, seq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range seq.ctx.Fields {
+		if !spikeevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if seq.path != nil {
+		prev, err := seq.path(ctx)
+		if err != nil {
+			return err
+		}
+		seq.sql = prev
+	}
+	return nil
+}
+
+func (seq *SpikeEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SpikeEvent, error) {
+	var (
+		nodes       = []*SpikeEvent{}
+		_spec       = seq.querySpec()
+		loadedTypes = [1]bool{
+			seq.withDecisions != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SpikeEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SpikeEvent{config: seq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(seq.modifiers) > 0 {
+		_spec.Modifiers = seq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, seq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := seq.withDecisions; query != nil {
+		if err := seq.loadDecisions(ctx, query, nodes,
+			func(n *SpikeEvent) { n.Edges.Decisions = []*RoutingDecision{} },
+			func(n *SpikeEvent, e *RoutingDecision) { n.Edges.Decisions = append(n.Edges.Decisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range seq.withNamedDecisions {
+		if err := seq.loadDecisions(ctx, query, nodes,
+			func(n *SpikeEvent) { n.appendNamedDecisions(name) },
+			func(n *SpikeEvent, e *RoutingDecision) { n.appendNamedDecisions(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range seq.loadTotal {
+		if err := seq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (seq *SpikeEventQuery) loadDecisions(ctx context.Context, query *RoutingDecisionQuery, nodes []*SpikeEvent, init func(*SpikeEvent), assign func(*SpikeEvent, *RoutingDecision)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*SpikeEvent)
+	nids := make(map[string]map[*SpikeEvent]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(spikeevent.DecisionsTable)
+		s.Join(joinT).On(s.C(routingdecision.FieldID), joinT.C(spikeevent.DecisionsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(spikeevent.DecisionsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(spikeevent.DecisionsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*SpikeEvent]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*RoutingDecision](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "decisions" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+
+func (seq *SpikeEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := seq.querySpec()
+	if len(seq.modifiers) > 0 {
+		_spec.Modifiers = seq.modifiers
+	}
+	_spec.Node.Columns = seq.ctx.Fields
+	if len(seq.ctx.Fields) > 0 {
+		_spec.Unique = seq.ctx.Unique != nil && *seq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, seq.driver, _spec)
+}
+
+func (seq *SpikeEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(spikeevent.Table, spikeevent.Columns, sqlgraph.NewFieldSpec(spikeevent.FieldID, field.TypeString))
+	_spec.From = seq.sql
+	if unique := seq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if seq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := seq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, spikeevent.FieldID)
+		for i := range fields {
+			if fields[i] != spikeevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := seq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := seq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := seq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := seq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (seq *SpikeEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(seq.driver.Dialect())
+	t1 := builder.Table(spikeevent.Table)
+	columns := seq.ctx.Fields
+	if len(columns) == 0 {
+		columns = spikeevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if seq.sql != nil {
+		selector = seq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if seq.ctx.Unique != nil && *seq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range seq.predicates {
+		p(selector)
+	}
+	for _, p := range seq.order {
+		p(selector)
+	}
+	if offset := seq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := seq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WithNamedDecisions tells the query-builder to eager-load the nodes that are connected to the "decisions"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (seq *SpikeEventQuery) WithNamedDecisions(name string, opts ...func(*RoutingDecisionQuery)) *SpikeEventQuery {
+	query := (&RoutingDecisionClient{config: seq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if seq.withNamedDecisions == nil {
+		seq.withNamedDecisions = make(map[string]*RoutingDecisionQuery)
+	}
+	seq.withNamedDecisions[name] = query
+	return seq
+}
+
+// SpikeEventGroupBy is the group-by builder for SpikeEvent entities.
+type SpikeEventGroupBy struct {
+	selector
+	build *SpikeEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (segb *SpikeEventGroupBy) Aggregate(fns ...AggregateFunc) *SpikeEventGroupBy {
+	segb.fns = append(segb.fns, fns...)
+	return segb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (segb *SpikeEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, segb.build.ctx, ent.OpQueryGroupBy)
+	if err := segb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SpikeEventQuery, *SpikeEventGroupBy](ctx, segb.build, segb, segb.build.inters, v)
+}
+
+func (segb *SpikeEventGroupBy) sqlScan(ctx context.Context, root *SpikeEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(segb.fns))
+	for _, fn := range segb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*segb.flds)+len(segb.fns))
+		for _, f := range *segb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*segb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := segb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SpikeEventSelect is the builder for selecting fields of SpikeEvent entities.
+type SpikeEventSelect struct {
+	*SpikeEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ses *SpikeEventSelect) Aggregate(fns ...AggregateFunc) *SpikeEventSelect {
+	ses.fns = append(ses.fns, fns...)
+	return ses
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ses *SpikeEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ses.ctx, ent.OpQuerySelect)
+	if err := ses.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SpikeEventQuery, *SpikeEventSelect](ctx, ses.SpikeEventQuery, ses, ses.inters, v)
+}
+
+func (ses *SpikeEventSelect) sqlScan(ctx context.Context, root *SpikeEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ses.fns))
+	for _, fn := range ses.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ses.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ses.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,965 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/spikeevent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// SpikeEventUpdate is the builder for updating SpikeEvent entities.
+type SpikeEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SpikeEventMutation
+}
+
+// Where appends a list predicates to the SpikeEventUpdate builder.
+func (seu *SpikeEventUpdate) Where(ps ...predicate.SpikeEvent) *SpikeEventUpdate {
+	seu.mutation.Where(ps...)
+	return seu
+}
+
+// SetTimestampNs sets the "timestamp_ns" field.
+func (seu *SpikeEventUpdate) SetTimestampNs(i int64) *SpikeEventUpdate {
+	seu.mutation.ResetTimestampNs()
+	seu.mutation.SetTimestampNs(i)
+	return seu
+}
+
+// SetNillableTimestampNs sets the "timestamp_ns" field if the given value is not nil.
+func (seu *SpikeEventUpdate) SetNillableTimestampNs(i *int64) *SpikeEventUpdate {
+	if i != nil {
+		seu.SetTimestampNs(*i)
+	}
+	return seu
+}
+
+// AddTimestampNs adds i to the "timestamp_ns" field.
+func (seu *SpikeEventUpdate) AddTimestampNs(i int64) *SpikeEventUpdate {
+	seu.mutation.AddTimestampNs(i)
+	return seu
+}
+
+// ClearTimestampNs clears the value of the "timestamp_ns" field.
+func (seu *SpikeEventUpdate) ClearTimestampNs() *SpikeEventUpdate {
+	seu.mutation.ClearTimestampNs()
+	return seu
+}
+
+// SetPopulationID sets the "population_id" field.
+func (seu *SpikeEventUpdate) SetPopulationID(s string) *SpikeEventUpdate {
+	seu.mutation.SetPopulationID(s)
+	return seu
+}
+
+// SetNillablePopulationID sets the "population_id" field if the given value is not nil.
+func (seu *SpikeEventUpdate) SetNillablePopulationID(s *string) *SpikeEventUpdate {
+	if s != nil {
+		seu.SetPopulationID(*s)
+	}
+	return seu
+}
+
+// SetLayerIndex sets the "layer_index" field.
+func (seu *SpikeEventUpdate) SetLayerIndex(i int) *SpikeEventUpdate {
+	seu.mutation.ResetLayerIndex()
+	seu.mutation.SetLayerIndex(i)
+	return seu
+}
+
+// SetNillableLayerIndex sets the "layer_index" field if the given value is not nil.
+func (seu *SpikeEventUpdate) SetNillableLayerIndex(i *int) *SpikeEventUpdate {
+	if i != nil {
+		seu.SetLayerIndex(*i)
+	}
+	return seu
+}
+
+// AddLayerIndex adds i to the "layer_index" field.
+func (seu *SpikeEventUpdate) AddLayerIndex(i int) *SpikeEventUpdate {
+	seu.mutation.AddLayerIndex(i)
+	return seu
+}
+
+// SetNeuronIndices sets the "neuron_indices" field.
+func (seu *SpikeEventUpdate) SetNeuronIndices(i []int) *SpikeEventUpdate {
+	seu.mutation.SetNeuronIndices(i)
+	return seu
+}
+
+// AppendNeuronIndices appends i to the "neuron_indices" field.
+func (seu *SpikeEventUpdate) AppendNeuronIndices(i []int) *SpikeEventUpdate {
+	seu.mutation.AppendNeuronIndices(i)
+	return seu
+}
+
+// SetSpikeCounts sets the "spike_counts" field.
+func (seu *SpikeEventUpdate) SetSpikeCounts(i []int) *SpikeEventUpdate {
+	seu.mutation.SetSpikeCounts(i)
+	return seu
+}
+
+// AppendSpikeCounts appends i to the "spike_counts" field.
+func (seu *SpikeEventUpdate) AppendSpikeCounts(i []int) *SpikeEventUpdate {
+	seu.mutation.AppendSpikeCounts(i)
+	return seu
+}
+
+// ClearSpikeCounts clears the value of the "spike_counts" field.
+func (seu *SpikeEventUpdate) ClearSpikeCounts() *SpikeEventUpdate {
+	seu.mutation.ClearSpikeCounts()
+	return seu
+}
+
+// SetMembranePotentials sets the "membrane_potentials" field.
+func (seu *SpikeEventUpdate) SetMembranePotentials(f []float64) *SpikeEventUpdate {
+	seu.mutation.SetMembranePotentials(f)
+	return seu
+}
+
+// AppendMembranePotentials appends f to the "membrane_potentials" field.
+func (seu *SpikeEventUpdate) AppendMembranePotentials(f []float64) *SpikeEventUpdate {
+	seu.mutation.AppendMembranePotentials(f)
+	return seu
+}
+
+// ClearMembranePotentials clears the value of the "membrane_potentials" field.
+func (seu *SpikeEventUpdate) ClearMembranePotentials() *SpikeEventUpdate {
+	seu.mutation.ClearMembranePotentials()
+	return seu
+}
+
+// SetInputCurrents sets the "input_currents" field.
+func (seu *SpikeEventUpdate) SetInputCurrents(f []float64) *SpikeEventUpdate {
+	seu.mutation.SetInputCurrents(f)
+	return seu
+}
+
+// AppendInputCurrents appends f to the "input_currents" field.
+func (seu *SpikeEventUpdate) AppendInputCurrents(f []float64) *SpikeEventUpdate {
+	seu.mutation.AppendInputCurrents(f)
+	return seu
+}
+
+// ClearInputCurrents clears the value of the "input_currents" field.
+func (seu *SpikeEventUpdate) ClearInputCurrents() *SpikeEventUpdate {
+	seu.mutation.ClearInputCurrents()
+	return seu
+}
+
+// SetPatternHash sets the "pattern_hash" field.
+func (seu *SpikeEventUpdate) SetPatternHash(s string) *SpikeEventUpdate {
+	seu.mutation.SetPatternHash(s)
+	return seu
+}
+
+// SetNillablePatternHash sets the "pattern_hash" field if the given value is not nil.
+func (seu *SpikeEventUpdate) SetNillablePatternHash(s *string) *SpikeEventUpdate {
+	if s != nil {
+		seu.SetPatternHash(*s)
+	}
+	return seu
+}
+
+// SetInferenceID sets the "inference_id" field.
+func (seu *SpikeEventUpdate) SetInferenceID(s string) *SpikeEventUpdate {
+	seu.mutation.SetInferenceID(s)
+	return seu
+}
+
+// SetNillableInferenceID sets the "inference_id" field if the given value is not nil.
+func (seu *SpikeEventUpdate) SetNillableInferenceID(s *string) *SpikeEventUpdate {
+	if s != nil {
+		seu.SetInferenceID(*s)
+	}
+	return seu
+}
+
+// ClearInferenceID clears the value of the "inference_id" field.
+func (seu *SpikeEventUpdate) ClearInferenceID() *SpikeEventUpdate {
+	seu.mutation.ClearInferenceID()
+	return seu
+}
+
+// SetIsEmergent sets the "is_emergent" field.
+func (seu *SpikeEventUpdate) SetIsEmergent(b bool) *SpikeEventUpdate {
+	seu.mutation.SetIsEmergent(b)
+	return seu
+}
+
+// SetNillableIsEmergent sets the "is_emergent" field if the given value is not nil.
+func (seu *SpikeEventUpdate) SetNillableIsEmergent(b *bool) *SpikeEventUpdate {
+	if b != nil {
+		seu.SetIsEmergent(*b)
+	}
+	return seu
+}
+
+// SetEntropy sets the "entropy" field.
+func (seu *SpikeEventUpdate) SetEntropy(f float64) *SpikeEventUpdate {
+	seu.mutation.ResetEntropy()
+	seu.mutation.SetEntropy(f)
+	return seu
+}
+
+// SetNillableEntropy sets the "entropy" field if the given value is not nil.
+func (seu *SpikeEventUpdate) SetNillableEntropy(f *float64) *SpikeEventUpdate {
+	if f != nil {
+		seu.SetEntropy(*f)
+	}
+	return seu
+}
+
+// AddEntropy adds f to the "entropy" field.
+func (seu *SpikeEventUpdate) AddEntropy(f float64) *SpikeEventUpdate {
+	seu.mutation.AddEntropy(f)
+	return seu
+}
+
+// SetMetadata sets the "metadata" field.
+func (seu *SpikeEventUpdate) SetMetadata(m map[string]interface{}) *SpikeEventUpdate {
+	seu.mutation.SetMetadata(m)
+	return seu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (seu *SpikeEventUpdate) ClearMetadata() *SpikeEventUpdate {
+	seu.mutation.ClearMetadata()
+	return seu
+}
+
+// AddDecisionIDs adds the "decisions" edge to the RoutingDecision entity by IDs.
+func (seu *SpikeEventUpdate) AddDecisionIDs(ids ...string) *SpikeEventUpdate {
+	seu.mutation.AddDecisionIDs(ids...)
+	return seu
+}
+
+// AddDecisions adds the "decisions" edges to the RoutingDecision entity.
+func (seu *SpikeEventUpdate) AddDecisions(r ...*RoutingDecision) *SpikeEventUpdate {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return seu.AddDecisionIDs(ids...)
+}
+
+// Mutation returns the SpikeEventMutation object of the builder.
+func (seu *SpikeEventUpdate) Mutation() *SpikeEventMutation {
+	return seu.mutation
+}
+
+// ClearDecisions clears all "decisions" edges to the RoutingDecision entity.
+func (seu *SpikeEventUpdate) ClearDecisions() *SpikeEventUpdate {
+	seu.mutation.ClearDecisions()
+	return seu
+}
+
+// RemoveDecisionIDs removes the "decisions" edge to RoutingDecision entities by IDs.
+func (seu *SpikeEventUpdate) RemoveDecisionIDs(ids ...string) *SpikeEventUpdate {
+	seu.mutation.RemoveDecisionIDs(ids...)
+	return seu
+}
+
+// RemoveDecisions removes "decisions" edges to RoutingDecision entities.
+func (seu *SpikeEventUpdate) RemoveDecisions(r ...*RoutingDecision) *SpikeEventUpdate {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return seu.RemoveDecisionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (seu *SpikeEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, seu.sqlSave, seu.mutation, seu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (seu *SpikeEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := seu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (seu *SpikeEventUpdate) Exec(ctx context.Context) error {
+	_, err := seu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (seu *SpikeEventUpdate) ExecX(ctx context.Context) {
+	if err := seu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (seu *SpikeEventUpdate) check() error {
+	if v, ok := seu.mutation.PopulationID(); ok {
+		if err := spikeevent.PopulationIDValidator(v); err != nil {
+			return &ValidationError{Name: "population_id", err: fmt.Errorf(`ent: validator failed for field "SpikeEvent.population_id": %w`, err)}
+		}
+	}
+	if v, ok := seu.mutation.PatternHash(); ok {
+		if err := spikeevent.PatternHashValidator(v); err != nil {
+			return &ValidationError{Name: "pattern_hash", err: fmt.Errorf(`ent: validator failed for field "SpikeEvent.pattern_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (seu *SpikeEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := seu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(spikeevent.Table, spikeevent.Columns, sqlgraph.NewFieldSpec(spikeevent.FieldID, field.TypeString))
+	if ps := seu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if seu.mutation.ProvenanceHashCleared() {
+		_spec.ClearField(spikeevent.FieldProvenanceHash, field.TypeString)
+	}
+	if value, ok := seu.mutation.TimestampNs(); ok {
+		_spec.SetField(spikeevent.FieldTimestampNs, field.TypeInt64, value)
+	}
+	if value, ok := seu.mutation.AddedTimestampNs(); ok {
+		_spec.AddField(spikeevent.FieldTimestampNs, field.TypeInt64, value)
+	}
+	if seu.mutation.TimestampNsCleared() {
+		_spec.ClearField(spikeevent.FieldTimestampNs, field.TypeInt64)
+	}
+	if value, ok := seu.mutation.PopulationID(); ok {
+		_spec.SetField(spikeevent.FieldPopulationID, field.TypeString, value)
+	}
+	if value, ok := seu.mutation.LayerIndex(); ok {
+		_spec.SetField(spikeevent.FieldLayerIndex, field.TypeInt, value)
+	}
+	if value, ok := seu.mutation.AddedLayerIndex(); ok {
+		_spec.AddField(spikeevent.FieldLayerIndex, field.TypeInt, value)
+	}
+	if value, ok := seu.mutation.NeuronIndices(); ok {
+		_spec.SetField(spikeevent.FieldNeuronIndices, field.TypeJSON, value)
+	}
+	if value, ok := seu.mutation.AppendedNeuronIndices(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, spikeevent.FieldNeuronIndices, value)
+		})
+	}
+	if value, ok := seu.mutation.SpikeCounts(); ok {
+		_spec.SetField(spikeevent.FieldSpikeCounts, field.TypeJSON, value)
+	}
+	if value, ok := seu.mutation.AppendedSpikeCounts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, spikeevent.FieldSpikeCounts, value)
+		})
+	}
+	if seu.mutation.SpikeCountsCleared() {
+		_spec.ClearField(spikeevent.FieldSpikeCounts, field.TypeJSON)
+	}
+	if value, ok := seu.mutation.MembranePotentials(); ok {
+		_spec.SetField(spikeevent.FieldMembranePotentials, field.TypeJSON, value)
+	}
+	if value, ok := seu.mutation.AppendedMembranePotentials(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, spikeevent.FieldMembranePotentials, value)
+		})
+	}
+	if seu.mutation.MembranePotentialsCleared() {
+		_spec.ClearField(spikeevent.FieldMembranePotentials, field.TypeJSON)
+	}
+	if value, ok := seu.mutation.InputCurrents(); ok {
+		_spec.SetField(spikeevent.FieldInputCurrents, field.TypeJSON, value)
+	}
+	if value, ok := seu.mutation.AppendedInputCurrents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, spikeevent.FieldInputCurrents, value)
+		})
+	}
+	if seu.mutation.InputCurrentsCleared() {
+		_spec.ClearField(spikeevent.FieldInputCurrents, field.TypeJSON)
+	}
+	if value, ok := seu.mutation.PatternHash(); ok {
+		_spec.SetField(spikeevent.FieldPatternHash, field.TypeString, value)
+	}
+	if value, ok := seu.mutation.InferenceID(); ok {
+		_spec.SetField(spikeevent.FieldInferenceID, field.TypeString, value)
+	}
+	if seu.mutation.InferenceIDCleared() {
+		_spec.ClearField(spikeevent.FieldInferenceID, field.TypeString)
+	}
+	if value, ok := seu.mutation.IsEmergent(); ok {
+		_spec.SetField(spikeevent.FieldIsEmergent, field.TypeBool, value)
+	}
+	if value, ok := seu.mutation.Entropy(); ok {
+		_spec.SetField(spikeevent.FieldEntropy, field.TypeFloat64, value)
+	}
+	if value, ok := seu.mutation.AddedEntropy(); ok {
+		_spec.AddField(spikeevent.FieldEntropy, field.TypeFloat64, value)
+	}
+	if value, ok := seu.mutation.Metadata(); ok {
+		_spec.SetField(spikeevent.FieldMetadata, field.TypeJSON, value)
+	}
+	if seu.mutation.MetadataCleared() {
+		_spec.ClearField(spikeevent.FieldMetadata, field.TypeJSON)
+	}
+	if seu.mutation.DecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   spikeevent.DecisionsTable,
+			Columns: spikeevent.DecisionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(routingdecision.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := seu.mutation.RemovedDecisionsIDs(); len(nodes) > 0 && !seu.mutation.DecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   spikeevent.DecisionsTable,
+			Columns: spikeevent.DecisionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(routingdecision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := seu.mutation.DecisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   spikeevent.DecisionsTable,
+			Columns: spikeevent.DecisionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(routingdecision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, seu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{spikeevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	seu.mutation.done = true
+	return n, nil
+}
+
+// SpikeEventUpdateOne is the builder for updating a single SpikeEvent entity.
+type SpikeEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SpikeEventMutation
+}
+
+// SetTimestampNs sets the "timestamp_ns" field.
+func (seuo *SpikeEventUpdateOne) SetTimestampNs(i int64) *SpikeEventUpdateOne {
+	seuo.mutation.ResetTimestampNs()
+	seuo.mutation.SetTimestampNs(i)
+	return seuo
+}
+
+// SetNillableTimestampNs sets the "timestamp_ns" field if the given value is not nil.
+func (seuo *SpikeEventUpdateOne) SetNillableTimestampNs(i *int64) *SpikeEventUpdateOne {
+	if i != nil {
+		seuo.SetTimestampNs(*i)
+	}
+	return seuo
+}
+
+// AddTimestampNs adds i to the "timestamp_ns" field.
+func (seuo *SpikeEventUpdateOne) AddTimestampNs(i int64) *SpikeEventUpdateOne {
+	seuo.mutation.AddTimestampNs(i)
+	return seuo
+}
+
+// ClearTimestampNs clears the value of the "timestamp_ns" field.
+func (seuo *SpikeEventUpdateOne) ClearTimestampNs() *SpikeEventUpdateOne {
+	seuo.mutation.ClearTimestampNs()
+	return seuo
+}
+
+// SetPopulationID sets the "population_id" field.
+func (seuo *SpikeEventUpdateOne) SetPopulationID(s string) *SpikeEventUpdateOne {
+	seuo.mutation.SetPopulationID(s)
+	return seuo
+}
+
+// SetNillablePopulationID sets the "population_id" field if the given value is not nil.
+func (seuo *SpikeEventUpdateOne) SetNillablePopulationID(s *string) *SpikeEventUpdateOne {
+	if s != nil {
+		seuo.SetPopulationID(*s)
+	}
+	return seuo
+}
+
+// SetLayerIndex sets the "layer_index" field.
+func (seuo *SpikeEventUpdateOne) SetLayerIndex(i int) *SpikeEventUpdateOne {
+	seuo.mutation.ResetLayerIndex()
+	seuo.mutation.SetLayerIndex(i)
+	return seuo
+}
+
+// SetNillableLayerIndex sets the "layer_index" field if the given value is not nil.
+func (seuo *SpikeEventUpdateOne) SetNillableLayerIndex(i *int) *SpikeEventUpdateOne {
+	if i != nil {
+		seuo.SetLayerIndex(*i)
+	}
+	return seuo
+}
+
+// AddLayerIndex adds i to the "layer_index" field.
+func (seuo *SpikeEventUpdateOne) AddLayerIndex(i int) *SpikeEventUpdateOne {
+	seuo.mutation.AddLayerIndex(i)
+	return seuo
+}
+
+// SetNeuronIndices sets the "neuron_indices" field.
+func (seuo *SpikeEventUpdateOne) SetNeuronIndices(i []int) *SpikeEventUpdateOne {
+	seuo.mutation.SetNeuronIndices(i)
+	return seuo
+}
+
+// AppendNeuronIndices appends i to the "neuron_indices" field.
+func (seuo *SpikeEventUpdateOne) AppendNeuronIndices(i []int) *SpikeEventUpdateOne {
+	seuo.mutation.AppendNeuronIndices(i)
+	return seuo
+}
+
+// SetSpikeCounts sets the "spike_counts" field.
+func (seuo *SpikeEventUpdateOne) SetSpikeCounts(i []int) *SpikeEventUpdateOne {
+	seuo.mutation.SetSpikeCounts(i)
+	return seuo
+}
+
+// AppendSpikeCounts appends i to the "spike_counts" field.
+func (seuo *SpikeEventUpdateOne) AppendSpikeCounts(i []int) *SpikeEventUpdateOne {
+	seuo.mutation.AppendSpikeCounts(i)
+	return seuo
+}
+
+// ClearSpikeCounts clears the value of the "spike_counts" field.
+func (seuo *SpikeEventUpdateOne) ClearSpikeCounts() *SpikeEventUpdateOne {
+	seuo.mutation.ClearSpikeCounts()
+	return seuo
+}
+
+// SetMembranePotentials sets the "membrane_potentials" field.
+func (seuo *SpikeEventUpdateOne) SetMembranePotentials(f []float64) *SpikeEventUpdateOne {
+	seuo.mutation.SetMembranePotentials(f)
+	return seuo
+}
+
+// AppendMembranePotentials appends f to the "membrane_potentials" field.
+func (seuo *SpikeEventUpdateOne) AppendMembranePotentials(f []float64) *SpikeEventUpdateOne {
+	seuo.mutation.AppendMembranePotentials(f)
+	return seuo
+}
+
+// ClearMembranePotentials clears the value of the "membrane_potentials" field.
+func (seuo *SpikeEventUpdateOne) ClearMembranePotentials() *SpikeEventUpdateOne {
+	seuo.mutation.ClearMembranePotentials()
+	return seuo
+}
+
+// SetInputCurrents sets the "input_currents" field.
+func (seuo *SpikeEventUpdateOne) SetInputCurrents(f []float64) *SpikeEventUpdateOne {
+	seuo.mutation.SetInputCurrents(f)
+	return seuo
+}
+
+// AppendInputCurrents appends f to the "input_currents" field.
+func (seuo *SpikeEventUpdateOne) AppendInputCurrents(f []float64) *SpikeEventUpdateOne {
+	seuo.mutation.AppendInputCurrents(f)
+	return seuo
+}
+
+// ClearInputCurrents clears the value of the "input_currents" field.
+func (seuo *SpikeEventUpdateOne) ClearInputCurrents() *SpikeEventUpdateOne {
+	seuo.mutation.ClearInputCurrents()
+	return seuo
+}
+
+// SetPatternHash sets the "pattern_hash" field.
+func (seuo *SpikeEventUpdateOne) SetPatternHash(s string) *SpikeEventUpdateOne {
+	seuo.mutation.SetPatternHash(s)
+	return seuo
+}
+
+// SetNillablePatternHash sets the "pattern_hash" field if the given value is not nil.
+func (seuo *SpikeEventUpdateOne) SetNillablePatternHash(s *string) *SpikeEventUpdateOne {
+	if s != nil {
+		seuo.SetPatternHash(*s)
+	}
+	return seuo
+}
+
+// SetInferenceID sets the "inference_id" field.
+func (seuo *SpikeEventUpdateOne) SetInferenceID(s string) *SpikeEventUpdateOne {
+	seuo.mutation.SetInferenceID(s)
+	return seuo
+}
+
+// SetNillableInferenceID sets the "inference_id" field if the given value is not nil.
+func (seuo *SpikeEventUpdateOne) SetNillableInferenceID(s *string) *SpikeEventUpdateOne {
+	if s != nil {
+		seuo.SetInferenceID(*s)
+	}
+	return seuo
+}
+
+// ClearInferenceID clears the value of the "inference_id" field.
+func (seuo *SpikeEventUpdateOne) ClearInferenceID() *SpikeEventUpdateOne {
+	seuo.mutation.ClearInferenceID()
+	return seuo
+}
+
+// SetIsEmergent sets the "is_emergent" field.
+func (seuo *SpikeEventUpdateOne) SetIsEmergent(b bool) *SpikeEventUpdateOne {
+	seuo.mutation.SetIsEmergent(b)
+	return seuo
+}
+
+// SetNillableIsEmergent sets the "is_emergent" field if the given value is not nil.
+func (seuo *SpikeEventUpdateOne) SetNillableIsEmergent(b *bool) *SpikeEventUpdateOne {
+	if b != nil {
+		seuo.SetIsEmergent(*b)
+	}
+	return seuo
+}
+
+// SetEntropy sets the "entropy" field.
+func (seuo *SpikeEventUpdateOne) SetEntropy(f float64) *SpikeEventUpdateOne {
+	seuo.mutation.ResetEntropy()
+	seuo.mutation.SetEntropy(f)
+	return seuo
+}
+
+// SetNillableEntropy sets the "entropy" field if the given value is not nil.
+func (seuo *SpikeEventUpdateOne) SetNillableEntropy(f *float64) *SpikeEventUpdateOne {
+	if f != nil {
+		seuo.SetEntropy(*f)
+	}
+	return seuo
+}
+
+// AddEntropy adds f to the "entropy" field.
+func (seuo *SpikeEventUpdateOne) AddEntropy(f float64) *SpikeEventUpdateOne {
+	seuo.mutation.AddEntropy(f)
+	return seuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (seuo *SpikeEventUpdateOne) SetMetadata(m map[string]interface{}) *SpikeEventUpdateOne {
+	seuo.mutation.SetMetadata(m)
+	return seuo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (seuo *SpikeEventUpdateOne) ClearMetadata() *SpikeEventUpdateOne {
+	seuo.mutation.ClearMetadata()
+	return seuo
+}
+
+// AddDecisionIDs adds the "decisions" edge to the RoutingDecision entity by IDs.
+func (seuo *SpikeEventUpdateOne) AddDecisionIDs(ids ...string) *SpikeEventUpdateOne {
+	seuo.mutation.AddDecisionIDs(ids...)
+	return seuo
+}
+
+// AddDecisions adds the "decisions" edges to the RoutingDecision entity.
+func (seuo *SpikeEventUpdateOne) AddDecisions(r ...*RoutingDecision) *SpikeEventUpdateOne {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return seuo.AddDecisionIDs(ids...)
+}
+
+// Mutation returns the SpikeEventMutation object of the builder.
+func (seuo *SpikeEventUpdateOne) Mutation() *SpikeEventMutation {
+	return seuo.mutation
+}
+
+// ClearDecisions clears all "decisions" edges to the RoutingDecision entity.
+func (seuo *SpikeEventUpdateOne) ClearDecisions() *SpikeEventUpdateOne {
+	seuo.mutation.ClearDecisions()
+	return seuo
+}
+
+// RemoveDecisionIDs removes the "decisions" edge to RoutingDecision entities by IDs.
+func (seuo *SpikeEventUpdateOne) RemoveDecisionIDs(ids ...string) *SpikeEventUpdateOne {
+	seuo.mutation.RemoveDecisionIDs(ids...)
+	return seuo
+}
+
+// RemoveDecisions removes "decisions" edges to RoutingDecision entities.
+func (seuo *SpikeEventUpdateOne) RemoveDecisions(r ...*RoutingDecision) *SpikeEventUpdateOne {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return seuo.RemoveDecisionIDs(ids...)
+}
+
+// Where appends a list predicates to the SpikeEventUpdate builder.
+func (seuo *SpikeEventUpdateOne) Where(ps ...predicate.SpikeEvent) *SpikeEventUpdateOne {
+	seuo.mutation.Where(ps...)
+	return seuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (seuo *SpikeEventUpdateOne) Select(field string, fields ...string) *SpikeEventUpdateOne {
+	seuo.fields = append([]string{field}, fields...)
+	return seuo
+}
+
+// Save executes the query and returns the updated SpikeEvent entity.
+func (seuo *SpikeEventUpdateOne) Save(ctx context.Context) (*SpikeEvent, error) {
+	return withHooks(ctx, seuo.sqlSave, seuo.mutation, seuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (seuo *SpikeEventUpdateOne) SaveX(ctx context.Context) *SpikeEvent {
+	node, err := seuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (seuo *SpikeEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := seuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (seuo *SpikeEventUpdateOne) ExecX(ctx context.Context) {
+	if err := seuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (seuo *SpikeEventUpdateOne) check() error {
+	if v, ok := seuo.mutation.PopulationID(); ok {
+		if err := spikeevent.PopulationIDValidator(v); err != nil {
+			return &ValidationError{Name: "population_id", err: fmt.Errorf(`ent: validator failed for field "SpikeEvent.population_id": %w`, err)}
+		}
+	}
+	if v, ok := seuo.mutation.PatternHash(); ok {
+		if err := spikeevent.PatternHashValidator(v); err != nil {
+			return &ValidationError{Name: "pattern_hash", err: fmt.Errorf(`ent: validator failed for field "SpikeEvent.pattern_hash": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (seuo *SpikeEventUpdateOne) sqlSave(ctx context.Context) (_node *SpikeEvent, err error) {
+	if err := seuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(spikeevent.Table, spikeevent.Columns, sqlgraph.NewFieldSpec(spikeevent.FieldID, field.TypeString))
+	id, ok := seuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SpikeEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := seuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, spikeevent.FieldID)
+		for _, f := range fields {
+			if !spikeevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != spikeevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := seuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if seuo.mutation.ProvenanceHashCleared() {
+		_spec.ClearField(spikeevent.FieldProvenanceHash, field.TypeString)
+	}
+	if value, ok := seuo.mutation.TimestampNs(); ok {
+		_spec.SetField(spikeevent.FieldTimestampNs, field.TypeInt64, value)
+	}
+	if value, ok := seuo.mutation.AddedTimestampNs(); ok {
+		_spec.AddField(spikeevent.FieldTimestampNs, field.TypeInt64, value)
+	}
+	if seuo.mutation.TimestampNsCleared() {
+		_spec.ClearField(spikeevent.FieldTimestampNs, field.TypeInt64)
+	}
+	if value, ok := seuo.mutation.PopulationID(); ok {
+		_spec.SetField(spikeevent.FieldPopulationID, field.TypeString, value)
+	}
+	if value, ok := seuo.mutation.LayerIndex(); ok {
+		_spec.SetField(spikeevent.FieldLayerIndex, field.TypeInt, value)
+	}
+	if value, ok := seuo.mutation.AddedLayerIndex(); ok {
+		_spec.AddField(spikeevent.FieldLayerIndex, field.TypeInt, value)
+	}
+	if value, ok := seuo.mutation.NeuronIndices(); ok {
+		_spec.SetField(spikeevent.FieldNeuronIndices, field.TypeJSON, value)
+	}
+	if value, ok := seuo.mutation.AppendedNeuronIndices(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, spikeevent.FieldNeuronIndices, value)
+		})
+	}
+	if value, ok := seuo.mutation.SpikeCounts(); ok {
+		_spec.SetField(spikeevent.FieldSpikeCounts, field.TypeJSON, value)
+	}
+	if value, ok := seuo.mutation.AppendedSpikeCounts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, spikeevent.FieldSpikeCounts, value)
+		})
+	}
+	if seuo.mutation.SpikeCountsCleared() {
+		_spec.ClearField(spikeevent.FieldSpikeCounts, field.TypeJSON)
+	}
+	if value, ok := seuo.mutation.MembranePotentials(); ok {
+		_spec.SetField(spikeevent.FieldMembranePotentials, field.TypeJSON, value)
+	}
+	if value, ok := seuo.mutation.AppendedMembranePotentials(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, spikeevent.FieldMembranePotentials, value)
+		})
+	}
+	if seuo.mutation.MembranePotentialsCleared() {
+		_spec.ClearField(spikeevent.FieldMembranePotentials, field.TypeJSON)
+	}
+	if value, ok := seuo.mutation.InputCurrents(); ok {
+		_spec.SetField(spikeevent.FieldInputCurrents, field.TypeJSON, value)
+	}
+	if value, ok := seuo.mutation.AppendedInputCurrents(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, spikeevent.FieldInputCurrents, value)
+		})
+	}
+	if seuo.mutation.InputCurrentsCleared() {
+		_spec.ClearField(spikeevent.FieldInputCurrents, field.TypeJSON)
+	}
+	if value, ok := seuo.mutation.PatternHash(); ok {
+		_spec.SetField(spikeevent.FieldPatternHash, field.TypeString, value)
+	}
+	if value, ok := seuo.mutation.InferenceID(); ok {
+		_spec.SetField(spikeevent.FieldInferenceID, field.TypeString, value)
+	}
+	if seuo.mutation.InferenceIDCleared() {
+		_spec.ClearField(spikeevent.FieldInferenceID, field.TypeString)
+	}
+	if value, ok := seuo.mutation.IsEmergent(); ok {
+		_spec.SetField(spikeevent.FieldIsEmergent, field.TypeBool, value)
+	}
+	if value, ok := seuo.mutation.Entropy(); ok {
+		_spec.SetField(spikeevent.FieldEntropy, field.TypeFloat64, value)
+	}
+	if value, ok := seuo.mutation.AddedEntropy(); ok {
+		_spec.AddField(spikeevent.FieldEntropy, field.TypeFloat64, value)
+	}
+	if value, ok := seuo.mutation.Metadata(); ok {
+		_spec.SetField(spikeevent.FieldMetadata, field.TypeJSON, value)
+	}
+	if seuo.mutation.MetadataCleared() {
+		_spec.ClearField(spikeevent.FieldMetadata, field.TypeJSON)
+	}
+	if seuo.mutation.DecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   spikeevent.DecisionsTable,
+			Columns: spikeevent.DecisionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(routingdecision.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := seuo.mutation.RemovedDecisionsIDs(); len(nodes) > 0 && !seuo.mutation.DecisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   spikeevent.DecisionsTable,
+			Columns: spikeevent.DecisionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(routingdecision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := seuo.mutation.DecisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   spikeevent.DecisionsTable,
+			Columns: spikeevent.DecisionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(routingdecision.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SpikeEvent{config: seuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, seuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{spikeevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	seuo.mutation.done = true
+	return _node, nil
+}
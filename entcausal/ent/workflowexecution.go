@@ -0,0 +1,429 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WorkflowExecution is the model entity for the WorkflowExecution schema.
+type WorkflowExecution struct {
+	config `json:"-"`
+	// ID of the ent.
+	// Unique execution identifier
+	ID string `json:"id,omitempty"`
+	// Tamper-evident digest over the record and its parents
+	ProvenanceHash string `json:"provenance_hash,omitempty"`
+	// When execution started
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// When execution completed
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// ID of the workflow definition
+	WorkflowID string `json:"workflow_id,omitempty"`
+	// Name of the workflow
+	WorkflowName string `json:"workflow_name,omitempty"`
+	// Current step identifier
+	StepID string `json:"step_id,omitempty"`
+	// Current step name
+	StepName string `json:"step_name,omitempty"`
+	// Index of current step
+	StepIndex int `json:"step_index,omitempty"`
+	// Current execution status
+	Status workflowexecution.Status `json:"status,omitempty"`
+	// Workflow inputs
+	Inputs map[string]interface{} `json:"inputs,omitempty"`
+	// Workflow outputs
+	OutputData map[string]interface{} `json:"output_data,omitempty"`
+	// Error message if failed
+	Error string `json:"error,omitempty"`
+	// Total duration in milliseconds
+	DurationMs float64 `json:"duration_ms,omitempty"`
+	// Parent execution for nested workflows
+	ParentExecutionID string `json:"parent_execution_id,omitempty"`
+	// Additional metadata
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WorkflowExecutionQuery when eager-loading is set.
+	Edges        WorkflowExecutionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WorkflowExecutionEdges holds the relations/edges for other nodes in the graph.
+type WorkflowExecutionEdges struct {
+	// Agent actions that executed this workflow
+	Actions []*AgentAction `json:"actions,omitempty"`
+	// External outputs produced by this workflow
+	Outputs []*ExternalOutput `json:"outputs,omitempty"`
+	// Child workflow executions
+	ParentExecution *WorkflowExecution `json:"parent_execution,omitempty"`
+	// ChildExecutions holds the value of the child_executions edge.
+	ChildExecutions []*WorkflowExecution `json:"child_executions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+	// totalCount holds the count of the edges above.
+	totalCount [4]map[string]int
+
+	namedActions         map[string][]*AgentAction
+	namedOutputs         map[string][]*ExternalOutput
+	namedChildExecutions map[string][]*WorkflowExecution
+}
+
+// ActionsOrErr returns the Actions value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkflowExecutionEdges) ActionsOrErr() ([]*AgentAction, error) {
+	if e.loadedTypes[0] {
+		return e.Actions, nil
+	}
+	return nil, &NotLoadedError{edge: "actions"}
+}
+
+// OutputsOrErr returns the Outputs value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkflowExecutionEdges) OutputsOrErr() ([]*ExternalOutput, error) {
+	if e.loadedTypes[1] {
+		return e.Outputs, nil
+	}
+	return nil, &NotLoadedError{edge: "outputs"}
+}
+
+// ParentExecutionOrErr returns the ParentExecution value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WorkflowExecutionEdges) ParentExecutionOrErr() (*WorkflowExecution, error) {
+	if e.ParentExecution != nil {
+		return e.ParentExecution, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: workflowexecution.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent_execution"}
+}
+
+// ChildExecutionsOrErr returns the ChildExecutions value or an error if the edge
+// was not loaded in eager-loading.
+func (e WorkflowExecutionEdges) ChildExecutionsOrErr() ([]*WorkflowExecution, error) {
+	if e.loadedTypes[3] {
+		return e.ChildExecutions, nil
+	}
+	return nil, &NotLoadedError{edge: "child_executions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WorkflowExecution) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case workflowexecution.FieldInputs, workflowexecution.FieldOutputData, workflowexecution.FieldMetadata:
+			values[i] = new([]byte)
+		case workflowexecution.FieldDurationMs:
+			values[i] = new(sql.NullFloat64)
+		case workflowexecution.FieldStepIndex:
+			values[i] = new(sql.NullInt64)
+		case workflowexecution.FieldID, workflowexecution.FieldProvenanceHash, workflowexecution.FieldWorkflowID, workflowexecution.FieldWorkflowName, workflowexecution.FieldStepID, workflowexecution.FieldStepName, workflowexecution.FieldStatus, workflowexecution.FieldError, workflowexecution.FieldParentExecutionID:
+			values[i] = new(sql.NullString)
+		case workflowexecution.FieldStartedAt, workflowexecution.FieldCompletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WorkflowExecution fields.
+func (we *WorkflowExecution) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case workflowexecution.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				we.ID = value.String
+			}
+		case workflowexecution.FieldProvenanceHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provenance_hash", values[i])
+			} else if value.Valid {
+				we.ProvenanceHash = value.String
+			}
+		case workflowexecution.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				we.StartedAt = value.Time
+			}
+		case workflowexecution.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				we.CompletedAt = new(time.Time)
+				*we.CompletedAt = value.Time
+			}
+		case workflowexecution.FieldWorkflowID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow_id", values[i])
+			} else if value.Valid {
+				we.WorkflowID = value.String
+			}
+		case workflowexecution.FieldWorkflowName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field workflow_name", values[i])
+			} else if value.Valid {
+				we.WorkflowName = value.String
+			}
+		case workflowexecution.FieldStepID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field step_id", values[i])
+			} else if value.Valid {
+				we.StepID = value.String
+			}
+		case workflowexecution.FieldStepName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field step_name", values[i])
+			} else if value.Valid {
+				we.StepName = value.String
+			}
+		case workflowexecution.FieldStepIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field step_index", values[i])
+			} else if value.Valid {
+				we.StepIndex = int(value.Int64)
+			}
+		case workflowexecution.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				we.Status = workflowexecution.Status(value.String)
+			}
+		case workflowexecution.FieldInputs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field inputs", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &we.Inputs); err != nil {
+					return fmt.Errorf("unmarshal field inputs: %w", err)
+				}
+			}
+		case workflowexecution.FieldOutputData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field output_data", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &we.OutputData); err != nil {
+					return fmt.Errorf("unmarshal field output_data: %w", err)
+				}
+			}
+		case workflowexecution.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				we.Error = value.String
+			}
+		case workflowexecution.FieldDurationMs:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field duration_ms", values[i])
+			} else if value.Valid {
+				we.DurationMs = value.Float64
+			}
+		case workflowexecution.FieldParentExecutionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_execution_id", values[i])
+			} else if value.Valid {
+				we.ParentExecutionID = value.String
+			}
+		case workflowexecution.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &we.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
+		default:
+			we.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WorkflowExecution.
+// This includes values selected through modifiers, order, etc.
+func (we *WorkflowExecution) Value(name string) (ent.Value, error) {
+	return we.selectValues.Get(name)
+}
+
+// QueryActions queries the "actions" edge of the WorkflowExecution entity.
+func (we *WorkflowExecution) QueryActions() *AgentActionQuery {
+	return NewWorkflowExecutionClient(we.config).QueryActions(we)
+}
+
+// QueryOutputs queries the "outputs" edge of the WorkflowExecution entity.
+func (we *WorkflowExecution) QueryOutputs() *ExternalOutputQuery {
+	return NewWorkflowExecutionClient(we.config).QueryOutputs(we)
+}
+
+// QueryParentExecution queries the "parent_execution" edge of the WorkflowExecution entity.
+func (we *WorkflowExecution) QueryParentExecution() *WorkflowExecutionQuery {
+	return NewWorkflowExecutionClient(we.config).QueryParentExecution(we)
+}
+
+// QueryChildExecutions queries the "child_executions" edge of the WorkflowExecution entity.
+func (we *WorkflowExecution) QueryChildExecutions() *WorkflowExecutionQuery {
+	return NewWorkflowExecutionClient(we.config).QueryChildExecutions(we)
+}
+
+// Update returns a builder for updating this WorkflowExecution.
+// Note that you need to call WorkflowExecution.Unwrap() before calling this method if this WorkflowExecution
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (we *WorkflowExecution) Update() *WorkflowExecutionUpdateOne {
+	return NewWorkflowExecutionClient(we.config).UpdateOne(we)
+}
+
+// Unwrap unwraps the WorkflowExecution entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (we *WorkflowExecution) Unwrap() *WorkflowExecution {
+	_tx, ok := we.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WorkflowExecution is not a transactional entity")
+	}
+	we.config.driver = _tx.drv
+	return we
+}
+
+// String implements the fmt.Stringer.
+func (we *WorkflowExecution) String() string {
+	var builder strings.Builder
+	builder.WriteString("WorkflowExecution(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", we.ID))
+	builder.WriteString("provenance_hash=")
+	builder.WriteString(we.ProvenanceHash)
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(we.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := we.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("workflow_id=")
+	builder.WriteString(we.WorkflowID)
+	builder.WriteString(", ")
+	builder.WriteString("workflow_name=")
+	builder.WriteString(we.WorkflowName)
+	builder.WriteString(", ")
+	builder.WriteString("step_id=")
+	builder.WriteString(we.StepID)
+	builder.WriteString(", ")
+	builder.WriteString("step_name=")
+	builder.WriteString(we.StepName)
+	builder.WriteString(", ")
+	builder.WriteString("step_index=")
+	builder.WriteString(fmt.Sprintf("%v", we.StepIndex))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", we.Status))
+	builder.WriteString(", ")
+	builder.WriteString("inputs=")
+	builder.WriteString(fmt.Sprintf("%v", we.Inputs))
+	builder.WriteString(", ")
+	builder.WriteString("output_data=")
+	builder.WriteString(fmt.Sprintf("%v", we.OutputData))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(we.Error)
+	builder.WriteString(", ")
+	builder.WriteString("duration_ms=")
+	builder.WriteString(fmt.Sprintf("%v", we.DurationMs))
+	builder.WriteString(", ")
+	builder.WriteString("parent_execution_id=")
+	builder.WriteString(we.ParentExecutionID)
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", we.Metadata))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// NamedActions returns the Actions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (we *WorkflowExecution) NamedActions(name string) ([]*AgentAction, error) {
+	if we.Edges.namedActions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := we.Edges.namedActions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (we *WorkflowExecution) appendNamedActions(name string, edges ...*AgentAction) {
+	if we.Edges.namedActions == nil {
+		we.Edges.namedActions = make(map[string][]*AgentAction)
+	}
+	if len(edges) == 0 {
+		we.Edges.namedActions[name] = []*AgentAction{}
+	} else {
+		we.Edges.namedActions[name] = append(we.Edges.namedActions[name], edges...)
+	}
+}
+
+// NamedOutputs returns the Outputs named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (we *WorkflowExecution) NamedOutputs(name string) ([]*ExternalOutput, error) {
+	if we.Edges.namedOutputs == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := we.Edges.namedOutputs[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (we *WorkflowExecution) appendNamedOutputs(name string, edges ...*ExternalOutput) {
+	if we.Edges.namedOutputs == nil {
+		we.Edges.namedOutputs = make(map[string][]*ExternalOutput)
+	}
+	if len(edges) == 0 {
+		we.Edges.namedOutputs[name] = []*ExternalOutput{}
+	} else {
+		we.Edges.namedOutputs[name] = append(we.Edges.namedOutputs[name], edges...)
+	}
+}
+
+// NamedChildExecutions returns the ChildExecutions named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (we *WorkflowExecution) NamedChildExecutions(name string) ([]*WorkflowExecution, error) {
+	if we.Edges.namedChildExecutions == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := we.Edges.namedChildExecutions[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (we *WorkflowExecution) appendNamedChildExecutions(name string, edges ...*WorkflowExecution) {
+	if we.Edges.namedChildExecutions == nil {
+		we.Edges.namedChildExecutions = make(map[string][]*WorkflowExecution)
+	}
+	if len(edges) == 0 {
+		we.Edges.namedChildExecutions[name] = []*WorkflowExecution{}
+	} else {
+		we.Edges.namedChildExecutions[name] = append(we.Edges.namedChildExecutions[name], edges...)
+	}
+}
+
+// WorkflowExecutions is a parsable slice of WorkflowExecution.
+type WorkflowExecutions []*WorkflowExecution
@@ -0,0 +1,973 @@
+// Code generated by ent, DO NOT EDIT.
+
+package workflowexecution
+
+import (
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldContainsFold(FieldID, id))
+}
+
+// ProvenanceHash applies equality check predicate on the "provenance_hash" field. It's identical to ProvenanceHashEQ.
+func ProvenanceHash(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldProvenanceHash, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// WorkflowID applies equality check predicate on the "workflow_id" field. It's identical to WorkflowIDEQ.
+func WorkflowID(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldWorkflowID, v))
+}
+
+// WorkflowName applies equality check predicate on the "workflow_name" field. It's identical to WorkflowNameEQ.
+func WorkflowName(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldWorkflowName, v))
+}
+
+// StepID applies equality check predicate on the "step_id" field. It's identical to StepIDEQ.
+func StepID(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldStepID, v))
+}
+
+// StepName applies equality check predicate on the "step_name" field. It's identical to StepNameEQ.
+func StepName(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldStepName, v))
+}
+
+// StepIndex applies equality check predicate on the "step_index" field. It's identical to StepIndexEQ.
+func StepIndex(v int) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldStepIndex, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldError, v))
+}
+
+// DurationMs applies equality check predicate on the "duration_ms" field. It's identical to DurationMsEQ.
+func DurationMs(v float64) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// ParentExecutionID applies equality check predicate on the "parent_execution_id" field. It's identical to ParentExecutionIDEQ.
+func ParentExecutionID(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldParentExecutionID, v))
+}
+
+// ProvenanceHashEQ applies the EQ predicate on the "provenance_hash" field.
+func ProvenanceHashEQ(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashNEQ applies the NEQ predicate on the "provenance_hash" field.
+func ProvenanceHashNEQ(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNEQ(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashIn applies the In predicate on the "provenance_hash" field.
+func ProvenanceHashIn(vs ...string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIn(FieldProvenanceHash, vs...))
+}
+
+// ProvenanceHashNotIn applies the NotIn predicate on the "provenance_hash" field.
+func ProvenanceHashNotIn(vs ...string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotIn(FieldProvenanceHash, vs...))
+}
+
+// ProvenanceHashGT applies the GT predicate on the "provenance_hash" field.
+func ProvenanceHashGT(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGT(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashGTE applies the GTE predicate on the "provenance_hash" field.
+func ProvenanceHashGTE(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGTE(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashLT applies the LT predicate on the "provenance_hash" field.
+func ProvenanceHashLT(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLT(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashLTE applies the LTE predicate on the "provenance_hash" field.
+func ProvenanceHashLTE(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLTE(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashContains applies the Contains predicate on the "provenance_hash" field.
+func ProvenanceHashContains(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldContains(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashHasPrefix applies the HasPrefix predicate on the "provenance_hash" field.
+func ProvenanceHashHasPrefix(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldHasPrefix(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashHasSuffix applies the HasSuffix predicate on the "provenance_hash" field.
+func ProvenanceHashHasSuffix(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldHasSuffix(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashIsNil applies the IsNil predicate on the "provenance_hash" field.
+func ProvenanceHashIsNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIsNull(FieldProvenanceHash))
+}
+
+// ProvenanceHashNotNil applies the NotNil predicate on the "provenance_hash" field.
+func ProvenanceHashNotNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotNull(FieldProvenanceHash))
+}
+
+// ProvenanceHashEqualFold applies the EqualFold predicate on the "provenance_hash" field.
+func ProvenanceHashEqualFold(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEqualFold(FieldProvenanceHash, v))
+}
+
+// ProvenanceHashContainsFold applies the ContainsFold predicate on the "provenance_hash" field.
+func ProvenanceHashContainsFold(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldContainsFold(FieldProvenanceHash, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// WorkflowIDEQ applies the EQ predicate on the "workflow_id" field.
+func WorkflowIDEQ(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldWorkflowID, v))
+}
+
+// WorkflowIDNEQ applies the NEQ predicate on the "workflow_id" field.
+func WorkflowIDNEQ(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNEQ(FieldWorkflowID, v))
+}
+
+// WorkflowIDIn applies the In predicate on the "workflow_id" field.
+func WorkflowIDIn(vs ...string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIn(FieldWorkflowID, vs...))
+}
+
+// WorkflowIDNotIn applies the NotIn predicate on the "workflow_id" field.
+func WorkflowIDNotIn(vs ...string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotIn(FieldWorkflowID, vs...))
+}
+
+// WorkflowIDGT applies the GT predicate on the "workflow_id" field.
+func WorkflowIDGT(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGT(FieldWorkflowID, v))
+}
+
+// WorkflowIDGTE applies the GTE predicate on the "workflow_id" field.
+func WorkflowIDGTE(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGTE(FieldWorkflowID, v))
+}
+
+// WorkflowIDLT applies the LT predicate on the "workflow_id" field.
+func WorkflowIDLT(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLT(FieldWorkflowID, v))
+}
+
+// WorkflowIDLTE applies the LTE predicate on the "workflow_id" field.
+func WorkflowIDLTE(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLTE(FieldWorkflowID, v))
+}
+
+// WorkflowIDContains applies the Contains predicate on the "workflow_id" field.
+func WorkflowIDContains(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldContains(FieldWorkflowID, v))
+}
+
+// WorkflowIDHasPrefix applies the HasPrefix predicate on the "workflow_id" field.
+func WorkflowIDHasPrefix(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldHasPrefix(FieldWorkflowID, v))
+}
+
+// WorkflowIDHasSuffix applies the HasSuffix predicate on the "workflow_id" field.
+func WorkflowIDHasSuffix(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldHasSuffix(FieldWorkflowID, v))
+}
+
+// WorkflowIDEqualFold applies the EqualFold predicate on the "workflow_id" field.
+func WorkflowIDEqualFold(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEqualFold(FieldWorkflowID, v))
+}
+
+// WorkflowIDContainsFold applies the ContainsFold predicate on the "workflow_id" field.
+func WorkflowIDContainsFold(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldContainsFold(FieldWorkflowID, v))
+}
+
+// WorkflowNameEQ applies the EQ predicate on the "workflow_name" field.
+func WorkflowNameEQ(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldWorkflowName, v))
+}
+
+// WorkflowNameNEQ applies the NEQ predicate on the "workflow_name" field.
+func WorkflowNameNEQ(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNEQ(FieldWorkflowName, v))
+}
+
+// WorkflowNameIn applies the In predicate on the "workflow_name" field.
+func WorkflowNameIn(vs ...string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIn(FieldWorkflowName, vs...))
+}
+
+// WorkflowNameNotIn applies the NotIn predicate on the "workflow_name" field.
+func WorkflowNameNotIn(vs ...string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotIn(FieldWorkflowName, vs...))
+}
+
+// WorkflowNameGT applies the GT predicate on the "workflow_name" field.
+func WorkflowNameGT(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGT(FieldWorkflowName, v))
+}
+
+// WorkflowNameGTE applies the GTE predicate on the "workflow_name" field.
+func WorkflowNameGTE(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGTE(FieldWorkflowName, v))
+}
+
+// WorkflowNameLT applies the LT predicate on the "workflow_name" field.
+func WorkflowNameLT(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLT(FieldWorkflowName, v))
+}
+
+// WorkflowNameLTE applies the LTE predicate on the "workflow_name" field.
+func WorkflowNameLTE(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLTE(FieldWorkflowName, v))
+}
+
+// WorkflowNameContains applies the Contains predicate on the "workflow_name" field.
+func WorkflowNameContains(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldContains(FieldWorkflowName, v))
+}
+
+// WorkflowNameHasPrefix applies the HasPrefix predicate on the "workflow_name" field.
+func WorkflowNameHasPrefix(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldHasPrefix(FieldWorkflowName, v))
+}
+
+// WorkflowNameHasSuffix applies the HasSuffix predicate on the "workflow_name" field.
+func WorkflowNameHasSuffix(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldHasSuffix(FieldWorkflowName, v))
+}
+
+// WorkflowNameIsNil applies the IsNil predicate on the "workflow_name" field.
+func WorkflowNameIsNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIsNull(FieldWorkflowName))
+}
+
+// WorkflowNameNotNil applies the NotNil predicate on the "workflow_name" field.
+func WorkflowNameNotNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotNull(FieldWorkflowName))
+}
+
+// WorkflowNameEqualFold applies the EqualFold predicate on the "workflow_name" field.
+func WorkflowNameEqualFold(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEqualFold(FieldWorkflowName, v))
+}
+
+// WorkflowNameContainsFold applies the ContainsFold predicate on the "workflow_name" field.
+func WorkflowNameContainsFold(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldContainsFold(FieldWorkflowName, v))
+}
+
+// StepIDEQ applies the EQ predicate on the "step_id" field.
+func StepIDEQ(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldStepID, v))
+}
+
+// StepIDNEQ applies the NEQ predicate on the "step_id" field.
+func StepIDNEQ(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNEQ(FieldStepID, v))
+}
+
+// StepIDIn applies the In predicate on the "step_id" field.
+func StepIDIn(vs ...string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIn(FieldStepID, vs...))
+}
+
+// StepIDNotIn applies the NotIn predicate on the "step_id" field.
+func StepIDNotIn(vs ...string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotIn(FieldStepID, vs...))
+}
+
+// StepIDGT applies the GT predicate on the "step_id" field.
+func StepIDGT(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGT(FieldStepID, v))
+}
+
+// StepIDGTE applies the GTE predicate on the "step_id" field.
+func StepIDGTE(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGTE(FieldStepID, v))
+}
+
+// StepIDLT applies the LT predicate on the "step_id" field.
+func StepIDLT(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLT(FieldStepID, v))
+}
+
+// StepIDLTE applies the LTE predicate on the "step_id" field.
+func StepIDLTE(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLTE(FieldStepID, v))
+}
+
+// StepIDContains applies the Contains predicate on the "step_id" field.
+func StepIDContains(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldContains(FieldStepID, v))
+}
+
+// StepIDHasPrefix applies the HasPrefix predicate on the "step_id" field.
+func StepIDHasPrefix(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldHasPrefix(FieldStepID, v))
+}
+
+// StepIDHasSuffix applies the HasSuffix predicate on the "step_id" field.
+func StepIDHasSuffix(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldHasSuffix(FieldStepID, v))
+}
+
+// StepIDIsNil applies the IsNil predicate on the "step_id" field.
+func StepIDIsNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIsNull(FieldStepID))
+}
+
+// StepIDNotNil applies the NotNil predicate on the "step_id" field.
+func StepIDNotNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotNull(FieldStepID))
+}
+
+// StepIDEqualFold applies the EqualFold predicate on the "step_id" field.
+func StepIDEqualFold(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEqualFold(FieldStepID, v))
+}
+
+// StepIDContainsFold applies the ContainsFold predicate on the "step_id" field.
+func StepIDContainsFold(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldContainsFold(FieldStepID, v))
+}
+
+// StepNameEQ applies the EQ predicate on the "step_name" field.
+func StepNameEQ(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldStepName, v))
+}
+
+// StepNameNEQ applies the NEQ predicate on the "step_name" field.
+func StepNameNEQ(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNEQ(FieldStepName, v))
+}
+
+// StepNameIn applies the In predicate on the "step_name" field.
+func StepNameIn(vs ...string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIn(FieldStepName, vs...))
+}
+
+// StepNameNotIn applies the NotIn predicate on the "step_name" field.
+func StepNameNotIn(vs ...string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotIn(FieldStepName, vs...))
+}
+
+// StepNameGT applies the GT predicate on the "step_name" field.
+func StepNameGT(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGT(FieldStepName, v))
+}
+
+// StepNameGTE applies the GTE predicate on the "step_name" field.
+func StepNameGTE(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGTE(FieldStepName, v))
+}
+
+// StepNameLT applies the LT predicate on the "step_name" field.
+func StepNameLT(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLT(FieldStepName, v))
+}
+
+// StepNameLTE applies the LTE predicate on the "step_name" field.
+func StepNameLTE(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLTE(FieldStepName, v))
+}
+
+// StepNameContains applies the Contains predicate on the "step_name" field.
+func StepNameContains(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldContains(FieldStepName, v))
+}
+
+// StepNameHasPrefix applies the HasPrefix predicate on the "step_name" field.
+func StepNameHasPrefix(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldHasPrefix(FieldStepName, v))
+}
+
+// StepNameHasSuffix applies the HasSuffix predicate on the "step_name" field.
+func StepNameHasSuffix(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldHasSuffix(FieldStepName, v))
+}
+
+// StepNameIsNil applies the IsNil predicate on the "step_name" field.
+func StepNameIsNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIsNull(FieldStepName))
+}
+
+// StepNameNotNil applies the NotNil predicate on the "step_name" field.
+func StepNameNotNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotNull(FieldStepName))
+}
+
+// StepNameEqualFold applies the EqualFold predicate on the "step_name" field.
+func StepNameEqualFold(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEqualFold(FieldStepName, v))
+}
+
+// StepNameContainsFold applies the ContainsFold predicate on the "step_name" field.
+func StepNameContainsFold(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldContainsFold(FieldStepName, v))
+}
+
+// StepIndexEQ applies the EQ predicate on the "step_index" field.
+func StepIndexEQ(v int) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldStepIndex, v))
+}
+
+// StepIndexNEQ applies the NEQ predicate on the "step_index" field.
+func StepIndexNEQ(v int) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNEQ(FieldStepIndex, v))
+}
+
+// StepIndexIn applies the In predicate on the "step_index" field.
+func StepIndexIn(vs ...int) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIn(FieldStepIndex, vs...))
+}
+
+// StepIndexNotIn applies the NotIn predicate on the "step_index" field.
+func StepIndexNotIn(vs ...int) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotIn(FieldStepIndex, vs...))
+}
+
+// StepIndexGT applies the GT predicate on the "step_index" field.
+func StepIndexGT(v int) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGT(FieldStepIndex, v))
+}
+
+// StepIndexGTE applies the GTE predicate on the "step_index" field.
+func StepIndexGTE(v int) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGTE(FieldStepIndex, v))
+}
+
+// StepIndexLT applies the LT predicate on the "step_index" field.
+func StepIndexLT(v int) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLT(FieldStepIndex, v))
+}
+
+// StepIndexLTE applies the LTE predicate on the "step_index" field.
+func StepIndexLTE(v int) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLTE(FieldStepIndex, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// InputsIsNil applies the IsNil predicate on the "inputs" field.
+func InputsIsNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIsNull(FieldInputs))
+}
+
+// InputsNotNil applies the NotNil predicate on the "inputs" field.
+func InputsNotNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotNull(FieldInputs))
+}
+
+// OutputDataIsNil applies the IsNil predicate on the "output_data" field.
+func OutputDataIsNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIsNull(FieldOutputData))
+}
+
+// OutputDataNotNil applies the NotNil predicate on the "output_data" field.
+func OutputDataNotNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotNull(FieldOutputData))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldContainsFold(FieldError, v))
+}
+
+// DurationMsEQ applies the EQ predicate on the "duration_ms" field.
+func DurationMsEQ(v float64) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// DurationMsNEQ applies the NEQ predicate on the "duration_ms" field.
+func DurationMsNEQ(v float64) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNEQ(FieldDurationMs, v))
+}
+
+// DurationMsIn applies the In predicate on the "duration_ms" field.
+func DurationMsIn(vs ...float64) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIn(FieldDurationMs, vs...))
+}
+
+// DurationMsNotIn applies the NotIn predicate on the "duration_ms" field.
+func DurationMsNotIn(vs ...float64) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotIn(FieldDurationMs, vs...))
+}
+
+// DurationMsGT applies the GT predicate on the "duration_ms" field.
+func DurationMsGT(v float64) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGT(FieldDurationMs, v))
+}
+
+// DurationMsGTE applies the GTE predicate on the "duration_ms" field.
+func DurationMsGTE(v float64) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGTE(FieldDurationMs, v))
+}
+
+// DurationMsLT applies the LT predicate on the "duration_ms" field.
+func DurationMsLT(v float64) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLT(FieldDurationMs, v))
+}
+
+// DurationMsLTE applies the LTE predicate on the "duration_ms" field.
+func DurationMsLTE(v float64) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLTE(FieldDurationMs, v))
+}
+
+// DurationMsIsNil applies the IsNil predicate on the "duration_ms" field.
+func DurationMsIsNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIsNull(FieldDurationMs))
+}
+
+// DurationMsNotNil applies the NotNil predicate on the "duration_ms" field.
+func DurationMsNotNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotNull(FieldDurationMs))
+}
+
+// ParentExecutionIDEQ applies the EQ predicate on the "parent_execution_id" field.
+func ParentExecutionIDEQ(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEQ(FieldParentExecutionID, v))
+}
+
+// ParentExecutionIDNEQ applies the NEQ predicate on the "parent_execution_id" field.
+func ParentExecutionIDNEQ(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNEQ(FieldParentExecutionID, v))
+}
+
+// ParentExecutionIDIn applies the In predicate on the "parent_execution_id" field.
+func ParentExecutionIDIn(vs ...string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIn(FieldParentExecutionID, vs...))
+}
+
+// ParentExecutionIDNotIn applies the NotIn predicate on the "parent_execution_id" field.
+func ParentExecutionIDNotIn(vs ...string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotIn(FieldParentExecutionID, vs...))
+}
+
+// ParentExecutionIDGT applies the GT predicate on the "parent_execution_id" field.
+func ParentExecutionIDGT(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGT(FieldParentExecutionID, v))
+}
+
+// ParentExecutionIDGTE applies the GTE predicate on the "parent_execution_id" field.
+func ParentExecutionIDGTE(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldGTE(FieldParentExecutionID, v))
+}
+
+// ParentExecutionIDLT applies the LT predicate on the "parent_execution_id" field.
+func ParentExecutionIDLT(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLT(FieldParentExecutionID, v))
+}
+
+// ParentExecutionIDLTE applies the LTE predicate on the "parent_execution_id" field.
+func ParentExecutionIDLTE(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldLTE(FieldParentExecutionID, v))
+}
+
+// ParentExecutionIDContains applies the Contains predicate on the "parent_execution_id" field.
+func ParentExecutionIDContains(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldContains(FieldParentExecutionID, v))
+}
+
+// ParentExecutionIDHasPrefix applies the HasPrefix predicate on the "parent_execution_id" field.
+func ParentExecutionIDHasPrefix(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldHasPrefix(FieldParentExecutionID, v))
+}
+
+// ParentExecutionIDHasSuffix applies the HasSuffix predicate on the "parent_execution_id" field.
+func ParentExecutionIDHasSuffix(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldHasSuffix(FieldParentExecutionID, v))
+}
+
+// ParentExecutionIDIsNil applies the IsNil predicate on the "parent_execution_id" field.
+func ParentExecutionIDIsNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIsNull(FieldParentExecutionID))
+}
+
+// ParentExecutionIDNotNil applies the NotNil predicate on the "parent_execution_id" field.
+func ParentExecutionIDNotNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotNull(FieldParentExecutionID))
+}
+
+// ParentExecutionIDEqualFold applies the EqualFold predicate on the "parent_execution_id" field.
+func ParentExecutionIDEqualFold(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldEqualFold(FieldParentExecutionID, v))
+}
+
+// ParentExecutionIDContainsFold applies the ContainsFold predicate on the "parent_execution_id" field.
+func ParentExecutionIDContainsFold(v string) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldContainsFold(FieldParentExecutionID, v))
+}
+
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.FieldNotNull(FieldMetadata))
+}
+
+// HasActions applies the HasEdge predicate on the "actions" edge.
+func HasActions() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, ActionsTable, ActionsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasActionsWith applies the HasEdge predicate on the "actions" edge with a given conditions (other predicates).
+func HasActionsWith(preds ...predicate.AgentAction) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(func(s *sql.Selector) {
+		step := newActionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOutputs applies the HasEdge predicate on the "outputs" edge.
+func HasOutputs() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, OutputsTable, OutputsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOutputsWith applies the HasEdge predicate on the "outputs" edge with a given conditions (other predicates).
+func HasOutputsWith(preds ...predicate.ExternalOutput) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(func(s *sql.Selector) {
+		step := newOutputsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParentExecution applies the HasEdge predicate on the "parent_execution" edge.
+func HasParentExecution() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentExecutionTable, ParentExecutionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentExecutionWith applies the HasEdge predicate on the "parent_execution" edge with a given conditions (other predicates).
+func HasParentExecutionWith(preds ...predicate.WorkflowExecution) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(func(s *sql.Selector) {
+		step := newParentExecutionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildExecutions applies the HasEdge predicate on the "child_executions" edge.
+func HasChildExecutions() predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildExecutionsTable, ChildExecutionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildExecutionsWith applies the HasEdge predicate on the "child_executions" edge with a given conditions (other predicates).
+func HasChildExecutionsWith(preds ...predicate.WorkflowExecution) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(func(s *sql.Selector) {
+		step := newChildExecutionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WorkflowExecution) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WorkflowExecution) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WorkflowExecution) predicate.WorkflowExecution {
+	return predicate.WorkflowExecution(sql.NotPredicates(p))
+}
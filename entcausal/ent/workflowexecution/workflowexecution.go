@@ -0,0 +1,319 @@
+// Code generated by ent, DO NOT EDIT.
+
+package workflowexecution
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the workflowexecution type in the database.
+	Label = "workflow_execution"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProvenanceHash holds the string denoting the provenance_hash field in the database.
+	FieldProvenanceHash = "provenance_hash"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
+	// FieldWorkflowID holds the string denoting the workflow_id field in the database.
+	FieldWorkflowID = "workflow_id"
+	// FieldWorkflowName holds the string denoting the workflow_name field in the database.
+	FieldWorkflowName = "workflow_name"
+	// FieldStepID holds the string denoting the step_id field in the database.
+	FieldStepID = "step_id"
+	// FieldStepName holds the string denoting the step_name field in the database.
+	FieldStepName = "step_name"
+	// FieldStepIndex holds the string denoting the step_index field in the database.
+	FieldStepIndex = "step_index"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldInputs holds the string denoting the inputs field in the database.
+	FieldInputs = "inputs"
+	// FieldOutputData holds the string denoting the output_data field in the database.
+	FieldOutputData = "outputs"
+	// FieldError holds the string denoting the error field in the database.
+	FieldError = "error"
+	// FieldDurationMs holds the string denoting the duration_ms field in the database.
+	FieldDurationMs = "duration_ms"
+	// FieldParentExecutionID holds the string denoting the parent_execution_id field in the database.
+	FieldParentExecutionID = "parent_execution_id"
+	// FieldMetadata holds the string denoting the metadata field in the database.
+	FieldMetadata = "metadata"
+	// EdgeActions holds the string denoting the actions edge name in mutations.
+	EdgeActions = "actions"
+	// EdgeOutputs holds the string denoting the outputs edge name in mutations.
+	EdgeOutputs = "outputs"
+	// EdgeParentExecution holds the string denoting the parent_execution edge name in mutations.
+	EdgeParentExecution = "parent_execution"
+	// EdgeChildExecutions holds the string denoting the child_executions edge name in mutations.
+	EdgeChildExecutions = "child_executions"
+	// Table holds the table name of the workflowexecution in the database.
+	Table = "workflow_executions"
+	// ActionsTable is the table that holds the actions relation/edge. The primary key declared below.
+	ActionsTable = "agent_action_workflows"
+	// ActionsInverseTable is the table name for the AgentAction entity.
+	// It exists in this package in order to avoid circular dependency with the "agentaction" package.
+	ActionsInverseTable = "agent_actions"
+	// OutputsTable is the table that holds the outputs relation/edge. The primary key declared below.
+	OutputsTable = "workflow_execution_outputs"
+	// OutputsInverseTable is the table name for the ExternalOutput entity.
+	// It exists in this package in order to avoid circular dependency with the "externaloutput" package.
+	OutputsInverseTable = "external_outputs"
+	// ParentExecutionTable is the table that holds the parent_execution relation/edge.
+	ParentExecutionTable = "workflow_executions"
+	// ParentExecutionColumn is the table column denoting the parent_execution relation/edge.
+	ParentExecutionColumn = "parent_execution_id"
+	// ChildExecutionsTable is the table that holds the child_executions relation/edge.
+	ChildExecutionsTable = "workflow_executions"
+	// ChildExecutionsColumn is the table column denoting the child_executions relation/edge.
+	ChildExecutionsColumn = "parent_execution_id"
+)
+
+// Columns holds all SQL columns for workflowexecution fields.
+var Columns = []string{
+	FieldID,
+	FieldProvenanceHash,
+	FieldStartedAt,
+	FieldCompletedAt,
+	FieldWorkflowID,
+	FieldWorkflowName,
+	FieldStepID,
+	FieldStepName,
+	FieldStepIndex,
+	FieldStatus,
+	FieldInputs,
+	FieldOutputData,
+	FieldError,
+	FieldDurationMs,
+	FieldParentExecutionID,
+	FieldMetadata,
+}
+
+var (
+	// ActionsPrimaryKey and ActionsColumn2 are the table columns denoting the
+	// primary key for the actions relation (M2M).
+	ActionsPrimaryKey = []string{"agent_action_id", "workflow_execution_id"}
+	// OutputsPrimaryKey and OutputsColumn2 are the table columns denoting the
+	// primary key for the outputs relation (M2M).
+	OutputsPrimaryKey = []string{"workflow_execution_id", "external_output_id"}
+)
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStartedAt holds the default value on creation for the "started_at" field.
+	DefaultStartedAt func() time.Time
+	// WorkflowIDValidator is a validator for the "workflow_id" field. It is called by the builders before save.
+	WorkflowIDValidator func(string) error
+	// DefaultStepIndex holds the default value on creation for the "step_index" field.
+	DefaultStepIndex int
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "pending"
+	StatusRunning   Status = "running"
+	StatusCompleted Status = "completed"
+	StatusFailed    Status = "failed"
+	StatusCancelled Status = "cancelled"
+	StatusPaused    Status = "paused"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusRunning, StatusCompleted, StatusFailed, StatusCancelled, StatusPaused:
+		return nil
+	default:
+		return fmt.Errorf("workflowexecution: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the WorkflowExecution queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProvenanceHash orders the results by the provenance_hash field.
+func ByProvenanceHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvenanceHash, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
+
+// ByWorkflowID orders the results by the workflow_id field.
+func ByWorkflowID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkflowID, opts...).ToFunc()
+}
+
+// ByWorkflowName orders the results by the workflow_name field.
+func ByWorkflowName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWorkflowName, opts...).ToFunc()
+}
+
+// ByStepID orders the results by the step_id field.
+func ByStepID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStepID, opts...).ToFunc()
+}
+
+// ByStepName orders the results by the step_name field.
+func ByStepName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStepName, opts...).ToFunc()
+}
+
+// ByStepIndex orders the results by the step_index field.
+func ByStepIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStepIndex, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByError orders the results by the error field.
+func ByError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldError, opts...).ToFunc()
+}
+
+// ByDurationMs orders the results by the duration_ms field.
+func ByDurationMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDurationMs, opts...).ToFunc()
+}
+
+// ByParentExecutionID orders the results by the parent_execution_id field.
+func ByParentExecutionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentExecutionID, opts...).ToFunc()
+}
+
+// ByActionsCount orders the results by actions count.
+func ByActionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newActionsStep(), opts...)
+	}
+}
+
+// ByActions orders the results by actions terms.
+func ByActions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newActionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOutputsCount orders the results by outputs count.
+func ByOutputsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOutputsStep(), opts...)
+	}
+}
+
+// ByOutputs orders the results by outputs terms.
+func ByOutputs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOutputsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByParentExecutionField orders the results by parent_execution field.
+func ByParentExecutionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParentExecutionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChildExecutionsCount orders the results by child_executions count.
+func ByChildExecutionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChildExecutionsStep(), opts...)
+	}
+}
+
+// ByChildExecutions orders the results by child_executions terms.
+func ByChildExecutions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChildExecutionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newActionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ActionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, ActionsTable, ActionsPrimaryKey...),
+	)
+}
+func newOutputsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OutputsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, OutputsTable, OutputsPrimaryKey...),
+	)
+}
+func newParentExecutionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ParentExecutionTable, ParentExecutionColumn),
+	)
+}
+func newChildExecutionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChildExecutionsTable, ChildExecutionsColumn),
+	)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e Status) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *Status) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("enum %T must be a string", val)
+	}
+	*e = Status(str)
+	if err := StatusValidator(*e); err != nil {
+		return fmt.Errorf("%s is not a valid Status", str)
+	}
+	return nil
+}
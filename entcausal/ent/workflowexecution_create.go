@@ -0,0 +1,573 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WorkflowExecutionCreate is the builder for creating a WorkflowExecution entity.
+type WorkflowExecutionCreate struct {
+	config
+	mutation *WorkflowExecutionMutation
+	hooks    []Hook
+}
+
+// SetProvenanceHash sets the "provenance_hash" field.
+func (wec *WorkflowExecutionCreate) SetProvenanceHash(s string) *WorkflowExecutionCreate {
+	wec.mutation.SetProvenanceHash(s)
+	return wec
+}
+
+// SetNillableProvenanceHash sets the "provenance_hash" field if the given value is not nil.
+func (wec *WorkflowExecutionCreate) SetNillableProvenanceHash(s *string) *WorkflowExecutionCreate {
+	if s != nil {
+		wec.SetProvenanceHash(*s)
+	}
+	return wec
+}
+
+// SetStartedAt sets the "started_at" field.
+func (wec *WorkflowExecutionCreate) SetStartedAt(t time.Time) *WorkflowExecutionCreate {
+	wec.mutation.SetStartedAt(t)
+	return wec
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (wec *WorkflowExecutionCreate) SetNillableStartedAt(t *time.Time) *WorkflowExecutionCreate {
+	if t != nil {
+		wec.SetStartedAt(*t)
+	}
+	return wec
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (wec *WorkflowExecutionCreate) SetCompletedAt(t time.Time) *WorkflowExecutionCreate {
+	wec.mutation.SetCompletedAt(t)
+	return wec
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (wec *WorkflowExecutionCreate) SetNillableCompletedAt(t *time.Time) *WorkflowExecutionCreate {
+	if t != nil {
+		wec.SetCompletedAt(*t)
+	}
+	return wec
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (wec *WorkflowExecutionCreate) SetWorkflowID(s string) *WorkflowExecutionCreate {
+	wec.mutation.SetWorkflowID(s)
+	return wec
+}
+
+// SetWorkflowName sets the "workflow_name" field.
+func (wec *WorkflowExecutionCreate) SetWorkflowName(s string) *WorkflowExecutionCreate {
+	wec.mutation.SetWorkflowName(s)
+	return wec
+}
+
+// SetNillableWorkflowName sets the "workflow_name" field if the given value is not nil.
+func (wec *WorkflowExecutionCreate) SetNillableWorkflowName(s *string) *WorkflowExecutionCreate {
+	if s != nil {
+		wec.SetWorkflowName(*s)
+	}
+	return wec
+}
+
+// SetStepID sets the "step_id" field.
+func (wec *WorkflowExecutionCreate) SetStepID(s string) *WorkflowExecutionCreate {
+	wec.mutation.SetStepID(s)
+	return wec
+}
+
+// SetNillableStepID sets the "step_id" field if the given value is not nil.
+func (wec *WorkflowExecutionCreate) SetNillableStepID(s *string) *WorkflowExecutionCreate {
+	if s != nil {
+		wec.SetStepID(*s)
+	}
+	return wec
+}
+
+// SetStepName sets the "step_name" field.
+func (wec *WorkflowExecutionCreate) SetStepName(s string) *WorkflowExecutionCreate {
+	wec.mutation.SetStepName(s)
+	return wec
+}
+
+// SetNillableStepName sets the "step_name" field if the given value is not nil.
+func (wec *WorkflowExecutionCreate) SetNillableStepName(s *string) *WorkflowExecutionCreate {
+	if s != nil {
+		wec.SetStepName(*s)
+	}
+	return wec
+}
+
+// SetStepIndex sets the "step_index" field.
+func (wec *WorkflowExecutionCreate) SetStepIndex(i int) *WorkflowExecutionCreate {
+	wec.mutation.SetStepIndex(i)
+	return wec
+}
+
+// SetNillableStepIndex sets the "step_index" field if the given value is not nil.
+func (wec *WorkflowExecutionCreate) SetNillableStepIndex(i *int) *WorkflowExecutionCreate {
+	if i != nil {
+		wec.SetStepIndex(*i)
+	}
+	return wec
+}
+
+// SetStatus sets the "status" field.
+func (wec *WorkflowExecutionCreate) SetStatus(w workflowexecution.Status) *WorkflowExecutionCreate {
+	wec.mutation.SetStatus(w)
+	return wec
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wec *WorkflowExecutionCreate) SetNillableStatus(w *workflowexecution.Status) *WorkflowExecutionCreate {
+	if w != nil {
+		wec.SetStatus(*w)
+	}
+	return wec
+}
+
+// SetInputs sets the "inputs" field.
+func (wec *WorkflowExecutionCreate) SetInputs(m map[string]interface{}) *WorkflowExecutionCreate {
+	wec.mutation.SetInputs(m)
+	return wec
+}
+
+// SetOutputData sets the "output_data" field.
+func (wec *WorkflowExecutionCreate) SetOutputData(m map[string]interface{}) *WorkflowExecutionCreate {
+	wec.mutation.SetOutputData(m)
+	return wec
+}
+
+// SetError sets the "error" field.
+func (wec *WorkflowExecutionCreate) SetError(s string) *WorkflowExecutionCreate {
+	wec.mutation.SetError(s)
+	return wec
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (wec *WorkflowExecutionCreate) SetNillableError(s *string) *WorkflowExecutionCreate {
+	if s != nil {
+		wec.SetError(*s)
+	}
+	return wec
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (wec *WorkflowExecutionCreate) SetDurationMs(f float64) *WorkflowExecutionCreate {
+	wec.mutation.SetDurationMs(f)
+	return wec
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (wec *WorkflowExecutionCreate) SetNillableDurationMs(f *float64) *WorkflowExecutionCreate {
+	if f != nil {
+		wec.SetDurationMs(*f)
+	}
+	return wec
+}
+
+// SetParentExecutionID sets the "parent_execution_id" field.
+func (wec *WorkflowExecutionCreate) SetParentExecutionID(s string) *WorkflowExecutionCreate {
+	wec.mutation.SetParentExecutionID(s)
+	return wec
+}
+
+// SetNillableParentExecutionID sets the "parent_execution_id" field if the given value is not nil.
+func (wec *WorkflowExecutionCreate) SetNillableParentExecutionID(s *string) *WorkflowExecutionCreate {
+	if s != nil {
+		wec.SetParentExecutionID(*s)
+	}
+	return wec
+}
+
+// SetMetadata sets the "metadata" field.
+func (wec *WorkflowExecutionCreate) SetMetadata(m map[string]interface{}) *WorkflowExecutionCreate {
+	wec.mutation.SetMetadata(m)
+	return wec
+}
+
+// SetID sets the "id" field.
+func (wec *WorkflowExecutionCreate) SetID(s string) *WorkflowExecutionCreate {
+	wec.mutation.SetID(s)
+	return wec
+}
+
+// AddActionIDs adds the "actions" edge to the AgentAction entity by IDs.
+func (wec *WorkflowExecutionCreate) AddActionIDs(ids ...string) *WorkflowExecutionCreate {
+	wec.mutation.AddActionIDs(ids...)
+	return wec
+}
+
+// AddActions adds the "actions" edges to the AgentAction entity.
+func (wec *WorkflowExecutionCreate) AddActions(a ...*AgentAction) *WorkflowExecutionCreate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return wec.AddActionIDs(ids...)
+}
+
+// AddOutputIDs adds the "outputs" edge to the ExternalOutput entity by IDs.
+func (wec *WorkflowExecutionCreate) AddOutputIDs(ids ...string) *WorkflowExecutionCreate {
+	wec.mutation.AddOutputIDs(ids...)
+	return wec
+}
+
+// AddOutputs adds the "outputs" edges to the ExternalOutput entity.
+func (wec *WorkflowExecutionCreate) AddOutputs(e ...*ExternalOutput) *WorkflowExecutionCreate {
+	ids := make([]string, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return wec.AddOutputIDs(ids...)
+}
+
+// SetParentExecution sets the "parent_execution" edge to the WorkflowExecution entity.
+func (wec *WorkflowExecutionCreate) SetParentExecution(w *WorkflowExecution) *WorkflowExecutionCreate {
+	return wec.SetParentExecutionID(w.ID)
+}
+
+// AddChildExecutionIDs adds the "child_executions" edge to the WorkflowExecution entity by IDs.
+func (wec *WorkflowExecutionCreate) AddChildExecutionIDs(ids ...string) *WorkflowExecutionCreate {
+	wec.mutation.AddChildExecutionIDs(ids...)
+	return wec
+}
+
+// AddChildExecutions adds the "child_executions" edges to the WorkflowExecution entity.
+func (wec *WorkflowExecutionCreate) AddChildExecutions(w ...*WorkflowExecution) *WorkflowExecutionCreate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wec.AddChildExecutionIDs(ids...)
+}
+
+// Mutation returns the WorkflowExecutionMutation object of the builder.
+func (wec *WorkflowExecutionCreate) Mutation() *WorkflowExecutionMutation {
+	return wec.mutation
+}
+
+// Save creates the WorkflowExecution in the database.
+func (wec *WorkflowExecutionCreate) Save(ctx context.Context) (*WorkflowExecution, error) {
+	wec.defaults()
+	return withHooks(ctx, wec.sqlSave, wec.mutation, wec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wec *WorkflowExecutionCreate) SaveX(ctx context.Context) *WorkflowExecution {
+	v, err := wec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wec *WorkflowExecutionCreate) Exec(ctx context.Context) error {
+	_, err := wec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wec *WorkflowExecutionCreate) ExecX(ctx context.Context) {
+	if err := wec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wec *WorkflowExecutionCreate) defaults() {
+	if _, ok := wec.mutation.StartedAt(); !ok {
+		v := workflowexecution.DefaultStartedAt()
+		wec.mutation.SetStartedAt(v)
+	}
+	if _, ok := wec.mutation.StepIndex(); !ok {
+		v := workflowexecution.DefaultStepIndex
+		wec.mutation.SetStepIndex(v)
+	}
+	if _, ok := wec.mutation.Status(); !ok {
+		v := workflowexecution.DefaultStatus
+		wec.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wec *WorkflowExecutionCreate) check() error {
+	if _, ok := wec.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "WorkflowExecution.started_at"`)}
+	}
+	if _, ok := wec.mutation.WorkflowID(); !ok {
+		return &ValidationError{Name: "workflow_id", err: errors.New(`ent: missing required field "WorkflowExecution.workflow_id"`)}
+	}
+	if v, ok := wec.mutation.WorkflowID(); ok {
+		if err := workflowexecution.WorkflowIDValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_id", err: fmt.Errorf(`ent: validator failed for field "WorkflowExecution.workflow_id": %w`, err)}
+		}
+	}
+	if _, ok := wec.mutation.StepIndex(); !ok {
+		return &ValidationError{Name: "step_index", err: errors.New(`ent: missing required field "WorkflowExecution.step_index"`)}
+	}
+	if _, ok := wec.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WorkflowExecution.status"`)}
+	}
+	if v, ok := wec.mutation.Status(); ok {
+		if err := workflowexecution.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WorkflowExecution.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (wec *WorkflowExecutionCreate) sqlSave(ctx context.Context) (*WorkflowExecution, error) {
+	if err := wec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected WorkflowExecution.ID type: %T", _spec.ID.Value)
+		}
+	}
+	wec.mutation.id = &_node.ID
+	wec.mutation.done = true
+	return _node, nil
+}
+
+func (wec *WorkflowExecutionCreate) createSpec() (*WorkflowExecution, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WorkflowExecution{config: wec.config}
+		_spec = sqlgraph.NewCreateSpec(workflowexecution.Table, sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString))
+	)
+	if id, ok := wec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wec.mutation.ProvenanceHash(); ok {
+		_spec.SetField(workflowexecution.FieldProvenanceHash, field.TypeString, value)
+		_node.ProvenanceHash = value
+	}
+	if value, ok := wec.mutation.StartedAt(); ok {
+		_spec.SetField(workflowexecution.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := wec.mutation.CompletedAt(); ok {
+		_spec.SetField(workflowexecution.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := wec.mutation.WorkflowID(); ok {
+		_spec.SetField(workflowexecution.FieldWorkflowID, field.TypeString, value)
+		_node.WorkflowID = value
+	}
+	if value, ok := wec.mutation.WorkflowName(); ok {
+		_spec.SetField(workflowexecution.FieldWorkflowName, field.TypeString, value)
+		_node.WorkflowName = value
+	}
+	if value, ok := wec.mutation.StepID(); ok {
+		_spec.SetField(workflowexecution.FieldStepID, field.TypeString, value)
+		_node.StepID = value
+	}
+	if value, ok := wec.mutation.StepName(); ok {
+		_spec.SetField(workflowexecution.FieldStepName, field.TypeString, value)
+		_node.StepName = value
+	}
+	if value, ok := wec.mutation.StepIndex(); ok {
+		_spec.SetField(workflowexecution.FieldStepIndex, field.TypeInt, value)
+		_node.StepIndex = value
+	}
+	if value, ok := wec.mutation.Status(); ok {
+		_spec.SetField(workflowexecution.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := wec.mutation.Inputs(); ok {
+		_spec.SetField(workflowexecution.FieldInputs, field.TypeJSON, value)
+		_node.Inputs = value
+	}
+	if value, ok := wec.mutation.OutputData(); ok {
+		_spec.SetField(workflowexecution.FieldOutputData, field.TypeJSON, value)
+		_node.OutputData = value
+	}
+	if value, ok := wec.mutation.Error(); ok {
+		_spec.SetField(workflowexecution.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := wec.mutation.DurationMs(); ok {
+		_spec.SetField(workflowexecution.FieldDurationMs, field.TypeFloat64, value)
+		_node.DurationMs = value
+	}
+	if value, ok := wec.mutation.Metadata(); ok {
+		_spec.SetField(workflowexecution.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
+	if nodes := wec.mutation.ActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   workflowexecution.ActionsTable,
+			Columns: workflowexecution.ActionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wec.mutation.OutputsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   workflowexecution.OutputsTable,
+			Columns: workflowexecution.OutputsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externaloutput.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wec.mutation.ParentExecutionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowexecution.ParentExecutionTable,
+			Columns: []string{workflowexecution.ParentExecutionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentExecutionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wec.mutation.ChildExecutionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowexecution.ChildExecutionsTable,
+			Columns: []string{workflowexecution.ChildExecutionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WorkflowExecutionCreateBulk is the builder for creating many WorkflowExecution entities in bulk.
+type WorkflowExecutionCreateBulk struct {
+	config
+	err      error
+	builders []*WorkflowExecutionCreate
+}
+
+// Save creates the WorkflowExecution entities in the database.
+func (wecb *WorkflowExecutionCreateBulk) Save(ctx context.Context) ([]*WorkflowExecution, error) {
+	if wecb.err != nil {
+		return nil, wecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wecb.builders))
+	nodes := make([]*WorkflowExecution, len(wecb.builders))
+	mutators := make([]Mutator, len(wecb.builders))
+	for i := range wecb.builders {
+		func(i int, root context.Context) {
+			builder := wecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WorkflowExecutionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wecb *WorkflowExecutionCreateBulk) SaveX(ctx context.Context) []*WorkflowExecution {
+	v, err := wecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wecb *WorkflowExecutionCreateBulk) Exec(ctx context.Context) error {
+	_, err := wecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wecb *WorkflowExecutionCreateBulk) ExecX(ctx context.Context) {
+	if err := wecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,970 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WorkflowExecutionQuery is the builder for querying WorkflowExecution entities.
+type WorkflowExecutionQuery struct {
+	config
+	ctx                      *QueryContext
+	order                    []workflowexecution.OrderOption
+	inters                   []Interceptor
+	predicates               []predicate.WorkflowExecution
+	withActions              *AgentActionQuery
+	withOutputs              *ExternalOutputQuery
+	withParentExecution      *WorkflowExecutionQuery
+	withChildExecutions      *WorkflowExecutionQuery
+	modifiers                []func(*sql.Selector)
+	loadTotal                []func(context.Context, []*WorkflowExecution) error
+	withNamedActions         map[string]*AgentActionQuery
+	withNamedOutputs         map[string]*ExternalOutputQuery
+	withNamedChildExecutions map[string]*WorkflowExecutionQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WorkflowExecutionQuery builder.
+func (weq *WorkflowExecutionQuery) Where(ps ...predicate.WorkflowExecution) *WorkflowExecutionQuery {
+	weq.predicates = append(weq.predicates, ps...)
+	return weq
+}
+
+// Limit the number of records to be returned by this query.
+func (weq *WorkflowExecutionQuery) Limit(limit int) *WorkflowExecutionQuery {
+	weq.ctx.Limit = &limit
+	return weq
+}
+
+// Offset to start from.
+func (weq *WorkflowExecutionQuery) Offset(offset int) *WorkflowExecutionQuery {
+	weq.ctx.Offset = &offset
+	return weq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (weq *WorkflowExecutionQuery) Unique(unique bool) *WorkflowExecutionQuery {
+	weq.ctx.Unique = &unique
+	return weq
+}
+
+// Order specifies how the records should be ordered.
+func (weq *WorkflowExecutionQuery) Order(o ...workflowexecution.OrderOption) *WorkflowExecutionQuery {
+	weq.order = append(weq.order, o...)
+	return weq
+}
+
+// QueryActions chains the current query on the "actions" edge.
+func (weq *WorkflowExecutionQuery) QueryActions() *AgentActionQuery {
+	query := (&AgentActionClient{config: weq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := weq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := weq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflowexecution.Table, workflowexecution.FieldID, selector),
+			sqlgraph.To(agentaction.Table, agentaction.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, workflowexecution.ActionsTable, workflowexecution.ActionsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(weq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOutputs chains the current query on the "outputs" edge.
+func (weq *WorkflowExecutionQuery) QueryOutputs() *ExternalOutputQuery {
+	query := (&ExternalOutputClient{config: weq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := weq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := weq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflowexecution.Table, workflowexecution.FieldID, selector),
+			sqlgraph.To(externaloutput.Table, externaloutput.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, workflowexecution.OutputsTable, workflowexecution.OutputsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(weq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParentExecution chains the current query on the "parent_execution" edge.
+func (weq *WorkflowExecutionQuery) QueryParentExecution() *WorkflowExecutionQuery {
+	query := (&WorkflowExecutionClient{config: weq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := weq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := weq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflowexecution.Table, workflowexecution.FieldID, selector),
+			sqlgraph.To(workflowexecution.Table, workflowexecution.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, workflowexecution.ParentExecutionTable, workflowexecution.ParentExecutionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(weq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChildExecutions chains the current query on the "child_executions" edge.
+func (weq *WorkflowExecutionQuery) QueryChildExecutions() *WorkflowExecutionQuery {
+	query := (&WorkflowExecutionClient{config: weq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := weq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := weq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(workflowexecution.Table, workflowexecution.FieldID, selector),
+			sqlgraph.To(workflowexecution.Table, workflowexecution.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, workflowexecution.ChildExecutionsTable, workflowexecution.ChildExecutionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(weq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WorkflowExecution entity from the query.
+// Returns a *NotFoundError when no WorkflowExecution was found.
+func (weq *WorkflowExecutionQuery) First(ctx context.Context) (*WorkflowExecution, error) {
+	nodes, err := weq.Limit(1).All(setContextOp(ctx, weq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{workflowexecution.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (weq *WorkflowExecutionQuery) FirstX(ctx context.Context) *WorkflowExecution {
+	node, err := weq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WorkflowExecution ID from the query.
+// Returns a *NotFoundError when no WorkflowExecution ID was found.
+func (weq *WorkflowExecutionQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = weq.Limit(1).IDs(setContextOp(ctx, weq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{workflowexecution.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (weq *WorkflowExecutionQuery) FirstIDX(ctx context.Context) string {
+	id, err := weq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WorkflowExecution entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WorkflowExecution entity is found.
+// Returns a *NotFoundError when no WorkflowExecution entities are found.
+func (weq *WorkflowExecutionQuery) Only(ctx context.Context) (*WorkflowExecution, error) {
+	nodes, err := weq.Limit(2).All(setContextOp(ctx, weq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{workflowexecution.Label}
+	default:
+		return nil, &NotSingularError{workflowexecution.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (weq *WorkflowExecutionQuery) OnlyX(ctx context.Context) *WorkflowExecution {
+	node, err := weq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WorkflowExecution ID in the query.
+// Returns a *NotSingularError when more than one WorkflowExecution ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (weq *WorkflowExecutionQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = weq.Limit(2).IDs(setContextOp(ctx, weq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{workflowexecution.Label}
+	default:
+		err = &NotSingularError{workflowexecution.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (weq *WorkflowExecutionQuery) OnlyIDX(ctx context.Context) string {
+	id, err := weq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WorkflowExecutions.
+func (weq *WorkflowExecutionQuery) All(ctx context.Context) ([]*WorkflowExecution, error) {
+	ctx = setContextOp(ctx, weq.ctx, ent.OpQueryAll)
+	if err := weq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WorkflowExecution, *WorkflowExecutionQuery]()
+	return withInterceptors[[]*WorkflowExecution](ctx, weq, qr, weq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (weq *WorkflowExecutionQuery) AllX(ctx context.Context) []*WorkflowExecution {
+	nodes, err := weq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WorkflowExecution IDs.
+func (weq *WorkflowExecutionQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if weq.ctx.Unique == nil && weq.path != nil {
+		weq.Unique(true)
+	}
+	ctx = setContextOp(ctx, weq.ctx, ent.OpQueryIDs)
+	if err = weq.Select(workflowexecution.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (weq *WorkflowExecutionQuery) IDsX(ctx context.Context) []string {
+	ids, err := weq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (weq *WorkflowExecutionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, weq.ctx, ent.OpQueryCount)
+	if err := weq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, weq, querierCount[*WorkflowExecutionQuery](), weq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (weq *WorkflowExecutionQuery) CountX(ctx context.Context) int {
+	count, err := weq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (weq *WorkflowExecutionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, weq.ctx, ent.OpQueryExist)
+	switch _, err := weq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (weq *WorkflowExecutionQuery) ExistX(ctx context.Context) bool {
+	exist, err := weq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WorkflowExecutionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (weq *WorkflowExecutionQuery) Clone() *WorkflowExecutionQuery {
+	if weq == nil {
+		return nil
+	}
+	return &WorkflowExecutionQuery{
+		config:              weq.config,
+		ctx:                 weq.ctx.Clone(),
+		order:               append([]workflowexecution.OrderOption{}, weq.order...),
+		inters:              append([]Interceptor{}, weq.inters...),
+		predicates:          append([]predicate.WorkflowExecution{}, weq.predicates...),
+		withActions:         weq.withActions.Clone(),
+		withOutputs:         weq.withOutputs.Clone(),
+		withParentExecution: weq.withParentExecution.Clone(),
+		withChildExecutions: weq.withChildExecutions.Clone(),
+		// clone intermediate query.
+		sql:  weq.sql.Clone(),
+		path: weq.path,
+	}
+}
+
+// WithActions tells the query-builder to eager-load the nodes that are connected to
+// the "actions" edge. The optional arguments are used to configure the query builder of the edge.
+func (weq *WorkflowExecutionQuery) WithActions(opts ...func(*AgentActionQuery)) *WorkflowExecutionQuery {
+	query := (&AgentActionClient{config: weq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	weq.withActions = query
+	return weq
+}
+
+// WithOutputs tells the query-builder to eager-load the nodes that are connected to
+// the "outputs" edge. The optional arguments are used to configure the query builder of the edge.
+func (weq *WorkflowExecutionQuery) WithOutputs(opts ...func(*ExternalOutputQuery)) *WorkflowExecutionQuery {
+	query := (&ExternalOutputClient{config: weq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	weq.withOutputs = query
+	return weq
+}
+
+// WithParentExecution tells the query-builder to eager-load the nodes that are connected to
+// the "parent_execution" edge. The optional arguments are used to configure the query builder of the edge.
+func (weq *WorkflowExecutionQuery) WithParentExecution(opts ...func(*WorkflowExecutionQuery)) *WorkflowExecutionQuery {
+	query := (&WorkflowExecutionClient{config: weq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	weq.withParentExecution = query
+	return weq
+}
+
+// WithChildExecutions tells the query-builder to eager-load the nodes that are connected to
+// the "child_executions" edge. The optional arguments are used to configure the query builder of the edge.
+func (weq *WorkflowExecutionQuery) WithChildExecutions(opts ...func(*WorkflowExecutionQuery)) *WorkflowExecutionQuery {
+	query := (&WorkflowExecutionClient{config: weq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	weq.withChildExecutions = query
+	return weq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ProvenanceHash string `json:"provenance_hash,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WorkflowExecution.Query().
+//		GroupBy(workflowexecution.FieldProvenanceHash).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (weq *WorkflowExecutionQuery) GroupBy(field string, fields ...string) *WorkflowExecutionGroupBy {
+	weq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WorkflowExecutionGroupBy{build: weq}
+	grbuild.flds = &weq.ctx.Fields
+	grbuild.label = workflowexecution.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ProvenanceHash string `json:"provenance_hash,omitempty"`
+//	}
+//
+//	client.WorkflowExecution.Query().
+//		Select(workflowexecution.FieldProvenanceHash).
+//		Scan(ctx, &v)
+func (weq *WorkflowExecutionQuery) Select(fields ...string) *WorkflowExecutionSelect {
+	weq.ctx.Fields = append(weq.ctx.Fields, fields...)
+	sbuild := &WorkflowExecutionSelect{WorkflowExecutionQuery: weq}
+	sbuild.label = workflowexecution.Label
+	sbuild.flds, sbuild.scan = &weq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WorkflowExecutionSelect configured with the given aggregations.
+func (weq *WorkflowExecutionQuery) Aggregate(fns ...AggregateFunc) *WorkflowExecutionSelect {
+	return weq.Select().Aggregate(fns...)
+}
+
+func (weq *WorkflowExecutionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range weq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, weq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range weq.ctx.Fields {
+		if !workflowexecution.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if weq.path != nil {
+		prev, err := weq.path(ctx)
+		if err != nil {
+			return err
+		}
+		weq.sql = prev
+	}
+	return nil
+}
+
+func (weq *WorkflowExecutionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WorkflowExecution, error) {
+	var (
+		nodes       = []*WorkflowExecution{}
+		_spec       = weq.querySpec()
+		loadedTypes = [4]bool{
+			weq.withActions != nil,
+			weq.withOutputs != nil,
+			weq.withParentExecution != nil,
+			weq.withChildExecutions != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WorkflowExecution).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WorkflowExecution{config: weq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(weq.modifiers) > 0 {
+		_spec.Modifiers = weq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, weq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := weq.withActions; query != nil {
+		if err := weq.loadActions(ctx, query, nodes,
+			func(n *WorkflowExecution) { n.Edges.Actions = []*AgentAction{} },
+			func(n *WorkflowExecution, e *AgentAction) { n.Edges.Actions = append(n.Edges.Actions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := weq.withOutputs; query != nil {
+		if err := weq.loadOutputs(ctx, query, nodes,
+			func(n *WorkflowExecution) { n.Edges.Outputs = []*ExternalOutput{} },
+			func(n *WorkflowExecution, e *ExternalOutput) { n.Edges.Outputs = append(n.Edges.Outputs, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := weq.withParentExecution; query != nil {
+		if err := weq.loadParentExecution(ctx, query, nodes, nil,
+			func(n *WorkflowExecution, e *WorkflowExecution) { n.Edges.ParentExecution = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := weq.withChildExecutions; query != nil {
+		if err := weq.loadChildExecutions(ctx, query, nodes,
+			func(n *WorkflowExecution) { n.Edges.ChildExecutions = []*WorkflowExecution{} },
+			func(n *WorkflowExecution, e *WorkflowExecution) {
+				n.Edges.ChildExecutions = append(n.Edges.ChildExecutions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range weq.withNamedActions {
+		if err := weq.loadActions(ctx, query, nodes,
+			func(n *WorkflowExecution) { n.appendNamedActions(name) },
+			func(n *WorkflowExecution, e *AgentAction) { n.appendNamedActions(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range weq.withNamedOutputs {
+		if err := weq.loadOutputs(ctx, query, nodes,
+			func(n *WorkflowExecution) { n.appendNamedOutputs(name) },
+			func(n *WorkflowExecution, e *ExternalOutput) { n.appendNamedOutputs(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range weq.withNamedChildExecutions {
+		if err := weq.loadChildExecutions(ctx, query, nodes,
+			func(n *WorkflowExecution) { n.appendNamedChildExecutions(name) },
+			func(n *WorkflowExecution, e *WorkflowExecution) { n.appendNamedChildExecutions(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for i := range weq.loadTotal {
+		if err := weq.loadTotal[i](ctx, nodes); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (weq *WorkflowExecutionQuery) loadActions(ctx context.Context, query *AgentActionQuery, nodes []*WorkflowExecution, init func(*WorkflowExecution), assign func(*WorkflowExecution, *AgentAction)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*WorkflowExecution)
+	nids := make(map[string]map[*WorkflowExecution]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(workflowexecution.ActionsTable)
+		s.Join(joinT).On(s.C(agentaction.FieldID), joinT.C(workflowexecution.ActionsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(workflowexecution.ActionsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(workflowexecution.ActionsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*WorkflowExecution]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*AgentAction](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "actions" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (weq *WorkflowExecutionQuery) loadOutputs(ctx context.Context, query *ExternalOutputQuery, nodes []*WorkflowExecution, init func(*WorkflowExecution), assign func(*WorkflowExecution, *ExternalOutput)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*WorkflowExecution)
+	nids := make(map[string]map[*WorkflowExecution]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(workflowexecution.OutputsTable)
+		s.Join(joinT).On(s.C(externaloutput.FieldID), joinT.C(workflowexecution.OutputsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(workflowexecution.OutputsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(workflowexecution.OutputsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*WorkflowExecution]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*ExternalOutput](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "outputs" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (weq *WorkflowExecutionQuery) loadParentExecution(ctx context.Context, query *WorkflowExecutionQuery, nodes []*WorkflowExecution, init func(*WorkflowExecution), assign func(*WorkflowExecution, *WorkflowExecution)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*WorkflowExecution)
+	for i := range nodes {
+		fk := nodes[i].ParentExecutionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(workflowexecution.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "parent_execution_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (weq *WorkflowExecutionQuery) loadChildExecutions(ctx context.Context, query *WorkflowExecutionQuery, nodes []*WorkflowExecution, init func(*WorkflowExecution), assign func(*WorkflowExecution, *WorkflowExecution)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*WorkflowExecution)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(workflowexecution.FieldParentExecutionID)
+	}
+	query.Where(predicate.WorkflowExecution(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(workflowexecution.ChildExecutionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ParentExecutionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "parent_execution_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (weq *WorkflowExecutionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := weq.querySpec()
+	if len(weq.modifiers) > 0 {
+		_spec.Modifiers = weq.modifiers
+	}
+	_spec.Node.Columns = weq.ctx.Fields
+	if len(weq.ctx.Fields) > 0 {
+		_spec.Unique = weq.ctx.Unique != nil && *weq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, weq.driver, _spec)
+}
+
+func (weq *WorkflowExecutionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(workflowexecution.Table, workflowexecution.Columns, sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString))
+	_spec.From = weq.sql
+	if unique := weq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if weq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := weq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, workflowexecution.FieldID)
+		for i := range fields {
+			if fields[i] != workflowexecution.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if weq.withParentExecution != nil {
+			_spec.Node.AddColumnOnce(workflowexecution.FieldParentExecutionID)
+		}
+	}
+	if ps := weq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := weq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := weq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := weq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (weq *WorkflowExecutionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(weq.driver.Dialect())
+	t1 := builder.Table(workflowexecution.Table)
+	columns := weq.ctx.Fields
+	if len(columns) == 0 {
+		columns = workflowexecution.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if weq.sql != nil {
+		selector = weq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if weq.ctx.Unique != nil && *weq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range weq.predicates {
+		p(selector)
+	}
+	for _, p := range weq.order {
+		p(selector)
+	}
+	if offset := weq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := weq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WithNamedActions tells the query-builder to eager-load the nodes that are connected to the "actions"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (weq *WorkflowExecutionQuery) WithNamedActions(name string, opts ...func(*AgentActionQuery)) *WorkflowExecutionQuery {
+	query := (&AgentActionClient{config: weq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if weq.withNamedActions == nil {
+		weq.withNamedActions = make(map[string]*AgentActionQuery)
+	}
+	weq.withNamedActions[name] = query
+	return weq
+}
+
+// WithNamedOutputs tells the query-builder to eager-load the nodes that are connected to the "outputs"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (weq *WorkflowExecutionQuery) WithNamedOutputs(name string, opts ...func(*ExternalOutputQuery)) *WorkflowExecutionQuery {
+	query := (&ExternalOutputClient{config: weq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if weq.withNamedOutputs == nil {
+		weq.withNamedOutputs = make(map[string]*ExternalOutputQuery)
+	}
+	weq.withNamedOutputs[name] = query
+	return weq
+}
+
+// WithNamedChildExecutions tells the query-builder to eager-load the nodes that are connected to the "child_executions"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (weq *WorkflowExecutionQuery) WithNamedChildExecutions(name string, opts ...func(*WorkflowExecutionQuery)) *WorkflowExecutionQuery {
+	query := (&WorkflowExecutionClient{config: weq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if weq.withNamedChildExecutions == nil {
+		weq.withNamedChildExecutions = make(map[string]*WorkflowExecutionQuery)
+	}
+	weq.withNamedChildExecutions[name] = query
+	return weq
+}
+
+// WorkflowExecutionGroupBy is the group-by builder for WorkflowExecution entities.
+type WorkflowExecutionGroupBy struct {
+	selector
+	build *WorkflowExecutionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wegb *WorkflowExecutionGroupBy) Aggregate(fns ...AggregateFunc) *WorkflowExecutionGroupBy {
+	wegb.fns = append(wegb.fns, fns...)
+	return wegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wegb *WorkflowExecutionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wegb.build.ctx, ent.OpQueryGroupBy)
+	if err := wegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WorkflowExecutionQuery, *WorkflowExecutionGroupBy](ctx, wegb.build, wegb, wegb.build.inters, v)
+}
+
+func (wegb *WorkflowExecutionGroupBy) sqlScan(ctx context.Context, root *WorkflowExecutionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wegb.fns))
+	for _, fn := range wegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wegb.flds)+len(wegb.fns))
+		for _, f := range *wegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WorkflowExecutionSelect is the builder for selecting fields of WorkflowExecution entities.
+type WorkflowExecutionSelect struct {
+	*WorkflowExecutionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (wes *WorkflowExecutionSelect) Aggregate(fns ...AggregateFunc) *WorkflowExecutionSelect {
+	wes.fns = append(wes.fns, fns...)
+	return wes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wes *WorkflowExecutionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wes.ctx, ent.OpQuerySelect)
+	if err := wes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WorkflowExecutionQuery, *WorkflowExecutionSelect](ctx, wes.WorkflowExecutionQuery, wes, wes.inters, v)
+}
+
+func (wes *WorkflowExecutionSelect) sqlScan(ctx context.Context, root *WorkflowExecutionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(wes.fns))
+	for _, fn := range wes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*wes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
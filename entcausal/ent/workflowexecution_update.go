@@ -0,0 +1,1422 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WorkflowExecutionUpdate is the builder for updating WorkflowExecution entities.
+type WorkflowExecutionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WorkflowExecutionMutation
+}
+
+// Where appends a list predicates to the WorkflowExecutionUpdate builder.
+func (weu *WorkflowExecutionUpdate) Where(ps ...predicate.WorkflowExecution) *WorkflowExecutionUpdate {
+	weu.mutation.Where(ps...)
+	return weu
+}
+
+// SetStartedAt sets the "started_at" field.
+func (weu *WorkflowExecutionUpdate) SetStartedAt(t time.Time) *WorkflowExecutionUpdate {
+	weu.mutation.SetStartedAt(t)
+	return weu
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (weu *WorkflowExecutionUpdate) SetNillableStartedAt(t *time.Time) *WorkflowExecutionUpdate {
+	if t != nil {
+		weu.SetStartedAt(*t)
+	}
+	return weu
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (weu *WorkflowExecutionUpdate) SetCompletedAt(t time.Time) *WorkflowExecutionUpdate {
+	weu.mutation.SetCompletedAt(t)
+	return weu
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (weu *WorkflowExecutionUpdate) SetNillableCompletedAt(t *time.Time) *WorkflowExecutionUpdate {
+	if t != nil {
+		weu.SetCompletedAt(*t)
+	}
+	return weu
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (weu *WorkflowExecutionUpdate) ClearCompletedAt() *WorkflowExecutionUpdate {
+	weu.mutation.ClearCompletedAt()
+	return weu
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (weu *WorkflowExecutionUpdate) SetWorkflowID(s string) *WorkflowExecutionUpdate {
+	weu.mutation.SetWorkflowID(s)
+	return weu
+}
+
+// SetNillableWorkflowID sets the "workflow_id" field if the given value is not nil.
+func (weu *WorkflowExecutionUpdate) SetNillableWorkflowID(s *string) *WorkflowExecutionUpdate {
+	if s != nil {
+		weu.SetWorkflowID(*s)
+	}
+	return weu
+}
+
+// SetWorkflowName sets the "workflow_name" field.
+func (weu *WorkflowExecutionUpdate) SetWorkflowName(s string) *WorkflowExecutionUpdate {
+	weu.mutation.SetWorkflowName(s)
+	return weu
+}
+
+// SetNillableWorkflowName sets the "workflow_name" field if the given value is not nil.
+func (weu *WorkflowExecutionUpdate) SetNillableWorkflowName(s *string) *WorkflowExecutionUpdate {
+	if s != nil {
+		weu.SetWorkflowName(*s)
+	}
+	return weu
+}
+
+// ClearWorkflowName clears the value of the "workflow_name" field.
+func (weu *WorkflowExecutionUpdate) ClearWorkflowName() *WorkflowExecutionUpdate {
+	weu.mutation.ClearWorkflowName()
+	return weu
+}
+
+// SetStepID sets the "step_id" field.
+func (weu *WorkflowExecutionUpdate) SetStepID(s string) *WorkflowExecutionUpdate {
+	weu.mutation.SetStepID(s)
+	return weu
+}
+
+// SetNillableStepID sets the "step_id" field if the given value is not nil.
+func (weu *WorkflowExecutionUpdate) SetNillableStepID(s *string) *WorkflowExecutionUpdate {
+	if s != nil {
+		weu.SetStepID(*s)
+	}
+	return weu
+}
+
+// ClearStepID clears the value of the "step_id" field.
+func (weu *WorkflowExecutionUpdate) ClearStepID() *WorkflowExecutionUpdate {
+	weu.mutation.ClearStepID()
+	return weu
+}
+
+// SetStepName sets the "step_name" field.
+func (weu *WorkflowExecutionUpdate) SetStepName(s string) *WorkflowExecutionUpdate {
+	weu.mutation.SetStepName(s)
+	return weu
+}
+
+// SetNillableStepName sets the "step_name" field if the given value is not nil.
+func (weu *WorkflowExecutionUpdate) SetNillableStepName(s *string) *WorkflowExecutionUpdate {
+	if s != nil {
+		weu.SetStepName(*s)
+	}
+	return weu
+}
+
+// ClearStepName clears the value of the "step_name" field.
+func (weu *WorkflowExecutionUpdate) ClearStepName() *WorkflowExecutionUpdate {
+	weu.mutation.ClearStepName()
+	return weu
+}
+
+// SetStepIndex sets the "step_index" field.
+func (weu *WorkflowExecutionUpdate) SetStepIndex(i int) *WorkflowExecutionUpdate {
+	weu.mutation.ResetStepIndex()
+	weu.mutation.SetStepIndex(i)
+	return weu
+}
+
+// SetNillableStepIndex sets the "step_index" field if the given value is not nil.
+func (weu *WorkflowExecutionUpdate) SetNillableStepIndex(i *int) *WorkflowExecutionUpdate {
+	if i != nil {
+		weu.SetStepIndex(*i)
+	}
+	return weu
+}
+
+// AddStepIndex adds i to the "step_index" field.
+func (weu *WorkflowExecutionUpdate) AddStepIndex(i int) *WorkflowExecutionUpdate {
+	weu.mutation.AddStepIndex(i)
+	return weu
+}
+
+// SetStatus sets the "status" field.
+func (weu *WorkflowExecutionUpdate) SetStatus(w workflowexecution.Status) *WorkflowExecutionUpdate {
+	weu.mutation.SetStatus(w)
+	return weu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (weu *WorkflowExecutionUpdate) SetNillableStatus(w *workflowexecution.Status) *WorkflowExecutionUpdate {
+	if w != nil {
+		weu.SetStatus(*w)
+	}
+	return weu
+}
+
+// SetInputs sets the "inputs" field.
+func (weu *WorkflowExecutionUpdate) SetInputs(m map[string]interface{}) *WorkflowExecutionUpdate {
+	weu.mutation.SetInputs(m)
+	return weu
+}
+
+// ClearInputs clears the value of the "inputs" field.
+func (weu *WorkflowExecutionUpdate) ClearInputs() *WorkflowExecutionUpdate {
+	weu.mutation.ClearInputs()
+	return weu
+}
+
+// SetOutputData sets the "output_data" field.
+func (weu *WorkflowExecutionUpdate) SetOutputData(m map[string]interface{}) *WorkflowExecutionUpdate {
+	weu.mutation.SetOutputData(m)
+	return weu
+}
+
+// ClearOutputData clears the value of the "output_data" field.
+func (weu *WorkflowExecutionUpdate) ClearOutputData() *WorkflowExecutionUpdate {
+	weu.mutation.ClearOutputData()
+	return weu
+}
+
+// SetError sets the "error" field.
+func (weu *WorkflowExecutionUpdate) SetError(s string) *WorkflowExecutionUpdate {
+	weu.mutation.SetError(s)
+	return weu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (weu *WorkflowExecutionUpdate) SetNillableError(s *string) *WorkflowExecutionUpdate {
+	if s != nil {
+		weu.SetError(*s)
+	}
+	return weu
+}
+
+// ClearError clears the value of the "error" field.
+func (weu *WorkflowExecutionUpdate) ClearError() *WorkflowExecutionUpdate {
+	weu.mutation.ClearError()
+	return weu
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (weu *WorkflowExecutionUpdate) SetDurationMs(f float64) *WorkflowExecutionUpdate {
+	weu.mutation.ResetDurationMs()
+	weu.mutation.SetDurationMs(f)
+	return weu
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (weu *WorkflowExecutionUpdate) SetNillableDurationMs(f *float64) *WorkflowExecutionUpdate {
+	if f != nil {
+		weu.SetDurationMs(*f)
+	}
+	return weu
+}
+
+// AddDurationMs adds f to the "duration_ms" field.
+func (weu *WorkflowExecutionUpdate) AddDurationMs(f float64) *WorkflowExecutionUpdate {
+	weu.mutation.AddDurationMs(f)
+	return weu
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (weu *WorkflowExecutionUpdate) ClearDurationMs() *WorkflowExecutionUpdate {
+	weu.mutation.ClearDurationMs()
+	return weu
+}
+
+// SetParentExecutionID sets the "parent_execution_id" field.
+func (weu *WorkflowExecutionUpdate) SetParentExecutionID(s string) *WorkflowExecutionUpdate {
+	weu.mutation.SetParentExecutionID(s)
+	return weu
+}
+
+// SetNillableParentExecutionID sets the "parent_execution_id" field if the given value is not nil.
+func (weu *WorkflowExecutionUpdate) SetNillableParentExecutionID(s *string) *WorkflowExecutionUpdate {
+	if s != nil {
+		weu.SetParentExecutionID(*s)
+	}
+	return weu
+}
+
+// ClearParentExecutionID clears the value of the "parent_execution_id" field.
+func (weu *WorkflowExecutionUpdate) ClearParentExecutionID() *WorkflowExecutionUpdate {
+	weu.mutation.ClearParentExecutionID()
+	return weu
+}
+
+// SetMetadata sets the "metadata" field.
+func (weu *WorkflowExecutionUpdate) SetMetadata(m map[string]interface{}) *WorkflowExecutionUpdate {
+	weu.mutation.SetMetadata(m)
+	return weu
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (weu *WorkflowExecutionUpdate) ClearMetadata() *WorkflowExecutionUpdate {
+	weu.mutation.ClearMetadata()
+	return weu
+}
+
+// AddActionIDs adds the "actions" edge to the AgentAction entity by IDs.
+func (weu *WorkflowExecutionUpdate) AddActionIDs(ids ...string) *WorkflowExecutionUpdate {
+	weu.mutation.AddActionIDs(ids...)
+	return weu
+}
+
+// AddActions adds the "actions" edges to the AgentAction entity.
+func (weu *WorkflowExecutionUpdate) AddActions(a ...*AgentAction) *WorkflowExecutionUpdate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return weu.AddActionIDs(ids...)
+}
+
+// AddOutputIDs adds the "outputs" edge to the ExternalOutput entity by IDs.
+func (weu *WorkflowExecutionUpdate) AddOutputIDs(ids ...string) *WorkflowExecutionUpdate {
+	weu.mutation.AddOutputIDs(ids...)
+	return weu
+}
+
+// AddOutputs adds the "outputs" edges to the ExternalOutput entity.
+func (weu *WorkflowExecutionUpdate) AddOutputs(e ...*ExternalOutput) *WorkflowExecutionUpdate {
+	ids := make([]string, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return weu.AddOutputIDs(ids...)
+}
+
+// SetParentExecution sets the "parent_execution" edge to the WorkflowExecution entity.
+func (weu *WorkflowExecutionUpdate) SetParentExecution(w *WorkflowExecution) *WorkflowExecutionUpdate {
+	return weu.SetParentExecutionID(w.ID)
+}
+
+// AddChildExecutionIDs adds the "child_executions" edge to the WorkflowExecution entity by IDs.
+func (weu *WorkflowExecutionUpdate) AddChildExecutionIDs(ids ...string) *WorkflowExecutionUpdate {
+	weu.mutation.AddChildExecutionIDs(ids...)
+	return weu
+}
+
+// AddChildExecutions adds the "child_executions" edges to the WorkflowExecution entity.
+func (weu *WorkflowExecutionUpdate) AddChildExecutions(w ...*WorkflowExecution) *WorkflowExecutionUpdate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return weu.AddChildExecutionIDs(ids...)
+}
+
+// Mutation returns the WorkflowExecutionMutation object of the builder.
+func (weu *WorkflowExecutionUpdate) Mutation() *WorkflowExecutionMutation {
+	return weu.mutation
+}
+
+// ClearActions clears all "actions" edges to the AgentAction entity.
+func (weu *WorkflowExecutionUpdate) ClearActions() *WorkflowExecutionUpdate {
+	weu.mutation.ClearActions()
+	return weu
+}
+
+// RemoveActionIDs removes the "actions" edge to AgentAction entities by IDs.
+func (weu *WorkflowExecutionUpdate) RemoveActionIDs(ids ...string) *WorkflowExecutionUpdate {
+	weu.mutation.RemoveActionIDs(ids...)
+	return weu
+}
+
+// RemoveActions removes "actions" edges to AgentAction entities.
+func (weu *WorkflowExecutionUpdate) RemoveActions(a ...*AgentAction) *WorkflowExecutionUpdate {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return weu.RemoveActionIDs(ids...)
+}
+
+// ClearOutputs clears all "outputs" edges to the ExternalOutput entity.
+func (weu *WorkflowExecutionUpdate) ClearOutputs() *WorkflowExecutionUpdate {
+	weu.mutation.ClearOutputs()
+	return weu
+}
+
+// RemoveOutputIDs removes the "outputs" edge to ExternalOutput entities by IDs.
+func (weu *WorkflowExecutionUpdate) RemoveOutputIDs(ids ...string) *WorkflowExecutionUpdate {
+	weu.mutation.RemoveOutputIDs(ids...)
+	return weu
+}
+
+// RemoveOutputs removes "outputs" edges to ExternalOutput entities.
+func (weu *WorkflowExecutionUpdate) RemoveOutputs(e ...*ExternalOutput) *WorkflowExecutionUpdate {
+	ids := make([]string, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return weu.RemoveOutputIDs(ids...)
+}
+
+// ClearParentExecution clears the "parent_execution" edge to the WorkflowExecution entity.
+func (weu *WorkflowExecutionUpdate) ClearParentExecution() *WorkflowExecutionUpdate {
+	weu.mutation.ClearParentExecution()
+	return weu
+}
+
+// ClearChildExecutions clears all "child_executions" edges to the WorkflowExecution entity.
+func (weu *WorkflowExecutionUpdate) ClearChildExecutions() *WorkflowExecutionUpdate {
+	weu.mutation.ClearChildExecutions()
+	return weu
+}
+
+// RemoveChildExecutionIDs removes the "child_executions" edge to WorkflowExecution entities by IDs.
+func (weu *WorkflowExecutionUpdate) RemoveChildExecutionIDs(ids ...string) *WorkflowExecutionUpdate {
+	weu.mutation.RemoveChildExecutionIDs(ids...)
+	return weu
+}
+
+// RemoveChildExecutions removes "child_executions" edges to WorkflowExecution entities.
+func (weu *WorkflowExecutionUpdate) RemoveChildExecutions(w ...*WorkflowExecution) *WorkflowExecutionUpdate {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return weu.RemoveChildExecutionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (weu *WorkflowExecutionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, weu.sqlSave, weu.mutation, weu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weu *WorkflowExecutionUpdate) SaveX(ctx context.Context) int {
+	affected, err := weu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (weu *WorkflowExecutionUpdate) Exec(ctx context.Context) error {
+	_, err := weu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weu *WorkflowExecutionUpdate) ExecX(ctx context.Context) {
+	if err := weu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (weu *WorkflowExecutionUpdate) check() error {
+	if v, ok := weu.mutation.WorkflowID(); ok {
+		if err := workflowexecution.WorkflowIDValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_id", err: fmt.Errorf(`ent: validator failed for field "WorkflowExecution.workflow_id": %w`, err)}
+		}
+	}
+	if v, ok := weu.mutation.Status(); ok {
+		if err := workflowexecution.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WorkflowExecution.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (weu *WorkflowExecutionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := weu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workflowexecution.Table, workflowexecution.Columns, sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString))
+	if ps := weu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if weu.mutation.ProvenanceHashCleared() {
+		_spec.ClearField(workflowexecution.FieldProvenanceHash, field.TypeString)
+	}
+	if value, ok := weu.mutation.StartedAt(); ok {
+		_spec.SetField(workflowexecution.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := weu.mutation.CompletedAt(); ok {
+		_spec.SetField(workflowexecution.FieldCompletedAt, field.TypeTime, value)
+	}
+	if weu.mutation.CompletedAtCleared() {
+		_spec.ClearField(workflowexecution.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := weu.mutation.WorkflowID(); ok {
+		_spec.SetField(workflowexecution.FieldWorkflowID, field.TypeString, value)
+	}
+	if value, ok := weu.mutation.WorkflowName(); ok {
+		_spec.SetField(workflowexecution.FieldWorkflowName, field.TypeString, value)
+	}
+	if weu.mutation.WorkflowNameCleared() {
+		_spec.ClearField(workflowexecution.FieldWorkflowName, field.TypeString)
+	}
+	if value, ok := weu.mutation.StepID(); ok {
+		_spec.SetField(workflowexecution.FieldStepID, field.TypeString, value)
+	}
+	if weu.mutation.StepIDCleared() {
+		_spec.ClearField(workflowexecution.FieldStepID, field.TypeString)
+	}
+	if value, ok := weu.mutation.StepName(); ok {
+		_spec.SetField(workflowexecution.FieldStepName, field.TypeString, value)
+	}
+	if weu.mutation.StepNameCleared() {
+		_spec.ClearField(workflowexecution.FieldStepName, field.TypeString)
+	}
+	if value, ok := weu.mutation.StepIndex(); ok {
+		_spec.SetField(workflowexecution.FieldStepIndex, field.TypeInt, value)
+	}
+	if value, ok := weu.mutation.AddedStepIndex(); ok {
+		_spec.AddField(workflowexecution.FieldStepIndex, field.TypeInt, value)
+	}
+	if value, ok := weu.mutation.Status(); ok {
+		_spec.SetField(workflowexecution.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := weu.mutation.Inputs(); ok {
+		_spec.SetField(workflowexecution.FieldInputs, field.TypeJSON, value)
+	}
+	if weu.mutation.InputsCleared() {
+		_spec.ClearField(workflowexecution.FieldInputs, field.TypeJSON)
+	}
+	if value, ok := weu.mutation.OutputData(); ok {
+		_spec.SetField(workflowexecution.FieldOutputData, field.TypeJSON, value)
+	}
+	if weu.mutation.OutputDataCleared() {
+		_spec.ClearField(workflowexecution.FieldOutputData, field.TypeJSON)
+	}
+	if value, ok := weu.mutation.Error(); ok {
+		_spec.SetField(workflowexecution.FieldError, field.TypeString, value)
+	}
+	if weu.mutation.ErrorCleared() {
+		_spec.ClearField(workflowexecution.FieldError, field.TypeString)
+	}
+	if value, ok := weu.mutation.DurationMs(); ok {
+		_spec.SetField(workflowexecution.FieldDurationMs, field.TypeFloat64, value)
+	}
+	if value, ok := weu.mutation.AddedDurationMs(); ok {
+		_spec.AddField(workflowexecution.FieldDurationMs, field.TypeFloat64, value)
+	}
+	if weu.mutation.DurationMsCleared() {
+		_spec.ClearField(workflowexecution.FieldDurationMs, field.TypeFloat64)
+	}
+	if value, ok := weu.mutation.Metadata(); ok {
+		_spec.SetField(workflowexecution.FieldMetadata, field.TypeJSON, value)
+	}
+	if weu.mutation.MetadataCleared() {
+		_spec.ClearField(workflowexecution.FieldMetadata, field.TypeJSON)
+	}
+	if weu.mutation.ActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   workflowexecution.ActionsTable,
+			Columns: workflowexecution.ActionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentaction.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weu.mutation.RemovedActionsIDs(); len(nodes) > 0 && !weu.mutation.ActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   workflowexecution.ActionsTable,
+			Columns: workflowexecution.ActionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weu.mutation.ActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   workflowexecution.ActionsTable,
+			Columns: workflowexecution.ActionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if weu.mutation.OutputsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   workflowexecution.OutputsTable,
+			Columns: workflowexecution.OutputsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externaloutput.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weu.mutation.RemovedOutputsIDs(); len(nodes) > 0 && !weu.mutation.OutputsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   workflowexecution.OutputsTable,
+			Columns: workflowexecution.OutputsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externaloutput.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weu.mutation.OutputsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   workflowexecution.OutputsTable,
+			Columns: workflowexecution.OutputsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externaloutput.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if weu.mutation.ParentExecutionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowexecution.ParentExecutionTable,
+			Columns: []string{workflowexecution.ParentExecutionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weu.mutation.ParentExecutionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowexecution.ParentExecutionTable,
+			Columns: []string{workflowexecution.ParentExecutionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if weu.mutation.ChildExecutionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowexecution.ChildExecutionsTable,
+			Columns: []string{workflowexecution.ChildExecutionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weu.mutation.RemovedChildExecutionsIDs(); len(nodes) > 0 && !weu.mutation.ChildExecutionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowexecution.ChildExecutionsTable,
+			Columns: []string{workflowexecution.ChildExecutionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weu.mutation.ChildExecutionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowexecution.ChildExecutionsTable,
+			Columns: []string{workflowexecution.ChildExecutionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, weu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workflowexecution.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	weu.mutation.done = true
+	return n, nil
+}
+
+// WorkflowExecutionUpdateOne is the builder for updating a single WorkflowExecution entity.
+type WorkflowExecutionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WorkflowExecutionMutation
+}
+
+// SetStartedAt sets the "started_at" field.
+func (weuo *WorkflowExecutionUpdateOne) SetStartedAt(t time.Time) *WorkflowExecutionUpdateOne {
+	weuo.mutation.SetStartedAt(t)
+	return weuo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (weuo *WorkflowExecutionUpdateOne) SetNillableStartedAt(t *time.Time) *WorkflowExecutionUpdateOne {
+	if t != nil {
+		weuo.SetStartedAt(*t)
+	}
+	return weuo
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (weuo *WorkflowExecutionUpdateOne) SetCompletedAt(t time.Time) *WorkflowExecutionUpdateOne {
+	weuo.mutation.SetCompletedAt(t)
+	return weuo
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (weuo *WorkflowExecutionUpdateOne) SetNillableCompletedAt(t *time.Time) *WorkflowExecutionUpdateOne {
+	if t != nil {
+		weuo.SetCompletedAt(*t)
+	}
+	return weuo
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (weuo *WorkflowExecutionUpdateOne) ClearCompletedAt() *WorkflowExecutionUpdateOne {
+	weuo.mutation.ClearCompletedAt()
+	return weuo
+}
+
+// SetWorkflowID sets the "workflow_id" field.
+func (weuo *WorkflowExecutionUpdateOne) SetWorkflowID(s string) *WorkflowExecutionUpdateOne {
+	weuo.mutation.SetWorkflowID(s)
+	return weuo
+}
+
+// SetNillableWorkflowID sets the "workflow_id" field if the given value is not nil.
+func (weuo *WorkflowExecutionUpdateOne) SetNillableWorkflowID(s *string) *WorkflowExecutionUpdateOne {
+	if s != nil {
+		weuo.SetWorkflowID(*s)
+	}
+	return weuo
+}
+
+// SetWorkflowName sets the "workflow_name" field.
+func (weuo *WorkflowExecutionUpdateOne) SetWorkflowName(s string) *WorkflowExecutionUpdateOne {
+	weuo.mutation.SetWorkflowName(s)
+	return weuo
+}
+
+// SetNillableWorkflowName sets the "workflow_name" field if the given value is not nil.
+func (weuo *WorkflowExecutionUpdateOne) SetNillableWorkflowName(s *string) *WorkflowExecutionUpdateOne {
+	if s != nil {
+		weuo.SetWorkflowName(*s)
+	}
+	return weuo
+}
+
+// ClearWorkflowName clears the value of the "workflow_name" field.
+func (weuo *WorkflowExecutionUpdateOne) ClearWorkflowName() *WorkflowExecutionUpdateOne {
+	weuo.mutation.ClearWorkflowName()
+	return weuo
+}
+
+// SetStepID sets the "step_id" field.
+func (weuo *WorkflowExecutionUpdateOne) SetStepID(s string) *WorkflowExecutionUpdateOne {
+	weuo.mutation.SetStepID(s)
+	return weuo
+}
+
+// SetNillableStepID sets the "step_id" field if the given value is not nil.
+func (weuo *WorkflowExecutionUpdateOne) SetNillableStepID(s *string) *WorkflowExecutionUpdateOne {
+	if s != nil {
+		weuo.SetStepID(*s)
+	}
+	return weuo
+}
+
+// ClearStepID clears the value of the "step_id" field.
+func (weuo *WorkflowExecutionUpdateOne) ClearStepID() *WorkflowExecutionUpdateOne {
+	weuo.mutation.ClearStepID()
+	return weuo
+}
+
+// SetStepName sets the "step_name" field.
+func (weuo *WorkflowExecutionUpdateOne) SetStepName(s string) *WorkflowExecutionUpdateOne {
+	weuo.mutation.SetStepName(s)
+	return weuo
+}
+
+// SetNillableStepName sets the "step_name" field if the given value is not nil.
+func (weuo *WorkflowExecutionUpdateOne) SetNillableStepName(s *string) *WorkflowExecutionUpdateOne {
+	if s != nil {
+		weuo.SetStepName(*s)
+	}
+	return weuo
+}
+
+// ClearStepName clears the value of the "step_name" field.
+func (weuo *WorkflowExecutionUpdateOne) ClearStepName() *WorkflowExecutionUpdateOne {
+	weuo.mutation.ClearStepName()
+	return weuo
+}
+
+// SetStepIndex sets the "step_index" field.
+func (weuo *WorkflowExecutionUpdateOne) SetStepIndex(i int) *WorkflowExecutionUpdateOne {
+	weuo.mutation.ResetStepIndex()
+	weuo.mutation.SetStepIndex(i)
+	return weuo
+}
+
+// SetNillableStepIndex sets the "step_index" field if the given value is not nil.
+func (weuo *WorkflowExecutionUpdateOne) SetNillableStepIndex(i *int) *WorkflowExecutionUpdateOne {
+	if i != nil {
+		weuo.SetStepIndex(*i)
+	}
+	return weuo
+}
+
+// AddStepIndex adds i to the "step_index" field.
+func (weuo *WorkflowExecutionUpdateOne) AddStepIndex(i int) *WorkflowExecutionUpdateOne {
+	weuo.mutation.AddStepIndex(i)
+	return weuo
+}
+
+// SetStatus sets the "status" field.
+func (weuo *WorkflowExecutionUpdateOne) SetStatus(w workflowexecution.Status) *WorkflowExecutionUpdateOne {
+	weuo.mutation.SetStatus(w)
+	return weuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (weuo *WorkflowExecutionUpdateOne) SetNillableStatus(w *workflowexecution.Status) *WorkflowExecutionUpdateOne {
+	if w != nil {
+		weuo.SetStatus(*w)
+	}
+	return weuo
+}
+
+// SetInputs sets the "inputs" field.
+func (weuo *WorkflowExecutionUpdateOne) SetInputs(m map[string]interface{}) *WorkflowExecutionUpdateOne {
+	weuo.mutation.SetInputs(m)
+	return weuo
+}
+
+// ClearInputs clears the value of the "inputs" field.
+func (weuo *WorkflowExecutionUpdateOne) ClearInputs() *WorkflowExecutionUpdateOne {
+	weuo.mutation.ClearInputs()
+	return weuo
+}
+
+// SetOutputData sets the "output_data" field.
+func (weuo *WorkflowExecutionUpdateOne) SetOutputData(m map[string]interface{}) *WorkflowExecutionUpdateOne {
+	weuo.mutation.SetOutputData(m)
+	return weuo
+}
+
+// ClearOutputData clears the value of the "output_data" field.
+func (weuo *WorkflowExecutionUpdateOne) ClearOutputData() *WorkflowExecutionUpdateOne {
+	weuo.mutation.ClearOutputData()
+	return weuo
+}
+
+// SetError sets the "error" field.
+func (weuo *WorkflowExecutionUpdateOne) SetError(s string) *WorkflowExecutionUpdateOne {
+	weuo.mutation.SetError(s)
+	return weuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (weuo *WorkflowExecutionUpdateOne) SetNillableError(s *string) *WorkflowExecutionUpdateOne {
+	if s != nil {
+		weuo.SetError(*s)
+	}
+	return weuo
+}
+
+// ClearError clears the value of the "error" field.
+func (weuo *WorkflowExecutionUpdateOne) ClearError() *WorkflowExecutionUpdateOne {
+	weuo.mutation.ClearError()
+	return weuo
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (weuo *WorkflowExecutionUpdateOne) SetDurationMs(f float64) *WorkflowExecutionUpdateOne {
+	weuo.mutation.ResetDurationMs()
+	weuo.mutation.SetDurationMs(f)
+	return weuo
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (weuo *WorkflowExecutionUpdateOne) SetNillableDurationMs(f *float64) *WorkflowExecutionUpdateOne {
+	if f != nil {
+		weuo.SetDurationMs(*f)
+	}
+	return weuo
+}
+
+// AddDurationMs adds f to the "duration_ms" field.
+func (weuo *WorkflowExecutionUpdateOne) AddDurationMs(f float64) *WorkflowExecutionUpdateOne {
+	weuo.mutation.AddDurationMs(f)
+	return weuo
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (weuo *WorkflowExecutionUpdateOne) ClearDurationMs() *WorkflowExecutionUpdateOne {
+	weuo.mutation.ClearDurationMs()
+	return weuo
+}
+
+// SetParentExecutionID sets the "parent_execution_id" field.
+func (weuo *WorkflowExecutionUpdateOne) SetParentExecutionID(s string) *WorkflowExecutionUpdateOne {
+	weuo.mutation.SetParentExecutionID(s)
+	return weuo
+}
+
+// SetNillableParentExecutionID sets the "parent_execution_id" field if the given value is not nil.
+func (weuo *WorkflowExecutionUpdateOne) SetNillableParentExecutionID(s *string) *WorkflowExecutionUpdateOne {
+	if s != nil {
+		weuo.SetParentExecutionID(*s)
+	}
+	return weuo
+}
+
+// ClearParentExecutionID clears the value of the "parent_execution_id" field.
+func (weuo *WorkflowExecutionUpdateOne) ClearParentExecutionID() *WorkflowExecutionUpdateOne {
+	weuo.mutation.ClearParentExecutionID()
+	return weuo
+}
+
+// SetMetadata sets the "metadata" field.
+func (weuo *WorkflowExecutionUpdateOne) SetMetadata(m map[string]interface{}) *WorkflowExecutionUpdateOne {
+	weuo.mutation.SetMetadata(m)
+	return weuo
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (weuo *WorkflowExecutionUpdateOne) ClearMetadata() *WorkflowExecutionUpdateOne {
+	weuo.mutation.ClearMetadata()
+	return weuo
+}
+
+// AddActionIDs adds the "actions" edge to the AgentAction entity by IDs.
+func (weuo *WorkflowExecutionUpdateOne) AddActionIDs(ids ...string) *WorkflowExecutionUpdateOne {
+	weuo.mutation.AddActionIDs(ids...)
+	return weuo
+}
+
+// AddActions adds the "actions" edges to the AgentAction entity.
+func (weuo *WorkflowExecutionUpdateOne) AddActions(a ...*AgentAction) *WorkflowExecutionUpdateOne {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return weuo.AddActionIDs(ids...)
+}
+
+// AddOutputIDs adds the "outputs" edge to the ExternalOutput entity by IDs.
+func (weuo *WorkflowExecutionUpdateOne) AddOutputIDs(ids ...string) *WorkflowExecutionUpdateOne {
+	weuo.mutation.AddOutputIDs(ids...)
+	return weuo
+}
+
+// AddOutputs adds the "outputs" edges to the ExternalOutput entity.
+func (weuo *WorkflowExecutionUpdateOne) AddOutputs(e ...*ExternalOutput) *WorkflowExecutionUpdateOne {
+	ids := make([]string, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return weuo.AddOutputIDs(ids...)
+}
+
+// SetParentExecution sets the "parent_execution" edge to the WorkflowExecution entity.
+func (weuo *WorkflowExecutionUpdateOne) SetParentExecution(w *WorkflowExecution) *WorkflowExecutionUpdateOne {
+	return weuo.SetParentExecutionID(w.ID)
+}
+
+// AddChildExecutionIDs adds the "child_executions" edge to the WorkflowExecution entity by IDs.
+func (weuo *WorkflowExecutionUpdateOne) AddChildExecutionIDs(ids ...string) *WorkflowExecutionUpdateOne {
+	weuo.mutation.AddChildExecutionIDs(ids...)
+	return weuo
+}
+
+// AddChildExecutions adds the "child_executions" edges to the WorkflowExecution entity.
+func (weuo *WorkflowExecutionUpdateOne) AddChildExecutions(w ...*WorkflowExecution) *WorkflowExecutionUpdateOne {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return weuo.AddChildExecutionIDs(ids...)
+}
+
+// Mutation returns the WorkflowExecutionMutation object of the builder.
+func (weuo *WorkflowExecutionUpdateOne) Mutation() *WorkflowExecutionMutation {
+	return weuo.mutation
+}
+
+// ClearActions clears all "actions" edges to the AgentAction entity.
+func (weuo *WorkflowExecutionUpdateOne) ClearActions() *WorkflowExecutionUpdateOne {
+	weuo.mutation.ClearActions()
+	return weuo
+}
+
+// RemoveActionIDs removes the "actions" edge to AgentAction entities by IDs.
+func (weuo *WorkflowExecutionUpdateOne) RemoveActionIDs(ids ...string) *WorkflowExecutionUpdateOne {
+	weuo.mutation.RemoveActionIDs(ids...)
+	return weuo
+}
+
+// RemoveActions removes "actions" edges to AgentAction entities.
+func (weuo *WorkflowExecutionUpdateOne) RemoveActions(a ...*AgentAction) *WorkflowExecutionUpdateOne {
+	ids := make([]string, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return weuo.RemoveActionIDs(ids...)
+}
+
+// ClearOutputs clears all "outputs" edges to the ExternalOutput entity.
+func (weuo *WorkflowExecutionUpdateOne) ClearOutputs() *WorkflowExecutionUpdateOne {
+	weuo.mutation.ClearOutputs()
+	return weuo
+}
+
+// RemoveOutputIDs removes the "outputs" edge to ExternalOutput entities by IDs.
+func (weuo *WorkflowExecutionUpdateOne) RemoveOutputIDs(ids ...string) *WorkflowExecutionUpdateOne {
+	weuo.mutation.RemoveOutputIDs(ids...)
+	return weuo
+}
+
+// RemoveOutputs removes "outputs" edges to ExternalOutput entities.
+func (weuo *WorkflowExecutionUpdateOne) RemoveOutputs(e ...*ExternalOutput) *WorkflowExecutionUpdateOne {
+	ids := make([]string, len(e))
+	for i := range e {
+		ids[i] = e[i].ID
+	}
+	return weuo.RemoveOutputIDs(ids...)
+}
+
+// ClearParentExecution clears the "parent_execution" edge to the WorkflowExecution entity.
+func (weuo *WorkflowExecutionUpdateOne) ClearParentExecution() *WorkflowExecutionUpdateOne {
+	weuo.mutation.ClearParentExecution()
+	return weuo
+}
+
+// ClearChildExecutions clears all "child_executions" edges to the WorkflowExecution entity.
+func (weuo *WorkflowExecutionUpdateOne) ClearChildExecutions() *WorkflowExecutionUpdateOne {
+	weuo.mutation.ClearChildExecutions()
+	return weuo
+}
+
+// RemoveChildExecutionIDs removes the "child_executions" edge to WorkflowExecution entities by IDs.
+func (weuo *WorkflowExecutionUpdateOne) RemoveChildExecutionIDs(ids ...string) *WorkflowExecutionUpdateOne {
+	weuo.mutation.RemoveChildExecutionIDs(ids...)
+	return weuo
+}
+
+// RemoveChildExecutions removes "child_executions" edges to WorkflowExecution entities.
+func (weuo *WorkflowExecutionUpdateOne) RemoveChildExecutions(w ...*WorkflowExecution) *WorkflowExecutionUpdateOne {
+	ids := make([]string, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return weuo.RemoveChildExecutionIDs(ids...)
+}
+
+// Where appends a list predicates to the WorkflowExecutionUpdate builder.
+func (weuo *WorkflowExecutionUpdateOne) Where(ps ...predicate.WorkflowExecution) *WorkflowExecutionUpdateOne {
+	weuo.mutation.Where(ps...)
+	return weuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (weuo *WorkflowExecutionUpdateOne) Select(field string, fields ...string) *WorkflowExecutionUpdateOne {
+	weuo.fields = append([]string{field}, fields...)
+	return weuo
+}
+
+// Save executes the query and returns the updated WorkflowExecution entity.
+func (weuo *WorkflowExecutionUpdateOne) Save(ctx context.Context) (*WorkflowExecution, error) {
+	return withHooks(ctx, weuo.sqlSave, weuo.mutation, weuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weuo *WorkflowExecutionUpdateOne) SaveX(ctx context.Context) *WorkflowExecution {
+	node, err := weuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (weuo *WorkflowExecutionUpdateOne) Exec(ctx context.Context) error {
+	_, err := weuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weuo *WorkflowExecutionUpdateOne) ExecX(ctx context.Context) {
+	if err := weuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (weuo *WorkflowExecutionUpdateOne) check() error {
+	if v, ok := weuo.mutation.WorkflowID(); ok {
+		if err := workflowexecution.WorkflowIDValidator(v); err != nil {
+			return &ValidationError{Name: "workflow_id", err: fmt.Errorf(`ent: validator failed for field "WorkflowExecution.workflow_id": %w`, err)}
+		}
+	}
+	if v, ok := weuo.mutation.Status(); ok {
+		if err := workflowexecution.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WorkflowExecution.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (weuo *WorkflowExecutionUpdateOne) sqlSave(ctx context.Context) (_node *WorkflowExecution, err error) {
+	if err := weuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(workflowexecution.Table, workflowexecution.Columns, sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString))
+	id, ok := weuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WorkflowExecution.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := weuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, workflowexecution.FieldID)
+		for _, f := range fields {
+			if !workflowexecution.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != workflowexecution.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := weuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if weuo.mutation.ProvenanceHashCleared() {
+		_spec.ClearField(workflowexecution.FieldProvenanceHash, field.TypeString)
+	}
+	if value, ok := weuo.mutation.StartedAt(); ok {
+		_spec.SetField(workflowexecution.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := weuo.mutation.CompletedAt(); ok {
+		_spec.SetField(workflowexecution.FieldCompletedAt, field.TypeTime, value)
+	}
+	if weuo.mutation.CompletedAtCleared() {
+		_spec.ClearField(workflowexecution.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := weuo.mutation.WorkflowID(); ok {
+		_spec.SetField(workflowexecution.FieldWorkflowID, field.TypeString, value)
+	}
+	if value, ok := weuo.mutation.WorkflowName(); ok {
+		_spec.SetField(workflowexecution.FieldWorkflowName, field.TypeString, value)
+	}
+	if weuo.mutation.WorkflowNameCleared() {
+		_spec.ClearField(workflowexecution.FieldWorkflowName, field.TypeString)
+	}
+	if value, ok := weuo.mutation.StepID(); ok {
+		_spec.SetField(workflowexecution.FieldStepID, field.TypeString, value)
+	}
+	if weuo.mutation.StepIDCleared() {
+		_spec.ClearField(workflowexecution.FieldStepID, field.TypeString)
+	}
+	if value, ok := weuo.mutation.StepName(); ok {
+		_spec.SetField(workflowexecution.FieldStepName, field.TypeString, value)
+	}
+	if weuo.mutation.StepNameCleared() {
+		_spec.ClearField(workflowexecution.FieldStepName, field.TypeString)
+	}
+	if value, ok := weuo.mutation.StepIndex(); ok {
+		_spec.SetField(workflowexecution.FieldStepIndex, field.TypeInt, value)
+	}
+	if value, ok := weuo.mutation.AddedStepIndex(); ok {
+		_spec.AddField(workflowexecution.FieldStepIndex, field.TypeInt, value)
+	}
+	if value, ok := weuo.mutation.Status(); ok {
+		_spec.SetField(workflowexecution.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := weuo.mutation.Inputs(); ok {
+		_spec.SetField(workflowexecution.FieldInputs, field.TypeJSON, value)
+	}
+	if weuo.mutation.InputsCleared() {
+		_spec.ClearField(workflowexecution.FieldInputs, field.TypeJSON)
+	}
+	if value, ok := weuo.mutation.OutputData(); ok {
+		_spec.SetField(workflowexecution.FieldOutputData, field.TypeJSON, value)
+	}
+	if weuo.mutation.OutputDataCleared() {
+		_spec.ClearField(workflowexecution.FieldOutputData, field.TypeJSON)
+	}
+	if value, ok := weuo.mutation.Error(); ok {
+		_spec.SetField(workflowexecution.FieldError, field.TypeString, value)
+	}
+	if weuo.mutation.ErrorCleared() {
+		_spec.ClearField(workflowexecution.FieldError, field.TypeString)
+	}
+	if value, ok := weuo.mutation.DurationMs(); ok {
+		_spec.SetField(workflowexecution.FieldDurationMs, field.TypeFloat64, value)
+	}
+	if value, ok := weuo.mutation.AddedDurationMs(); ok {
+		_spec.AddField(workflowexecution.FieldDurationMs, field.TypeFloat64, value)
+	}
+	if weuo.mutation.DurationMsCleared() {
+		_spec.ClearField(workflowexecution.FieldDurationMs, field.TypeFloat64)
+	}
+	if value, ok := weuo.mutation.Metadata(); ok {
+		_spec.SetField(workflowexecution.FieldMetadata, field.TypeJSON, value)
+	}
+	if weuo.mutation.MetadataCleared() {
+		_spec.ClearField(workflowexecution.FieldMetadata, field.TypeJSON)
+	}
+	if weuo.mutation.ActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   workflowexecution.ActionsTable,
+			Columns: workflowexecution.ActionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentaction.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weuo.mutation.RemovedActionsIDs(); len(nodes) > 0 && !weuo.mutation.ActionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   workflowexecution.ActionsTable,
+			Columns: workflowexecution.ActionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weuo.mutation.ActionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   workflowexecution.ActionsTable,
+			Columns: workflowexecution.ActionsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(agentaction.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if weuo.mutation.OutputsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   workflowexecution.OutputsTable,
+			Columns: workflowexecution.OutputsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externaloutput.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weuo.mutation.RemovedOutputsIDs(); len(nodes) > 0 && !weuo.mutation.OutputsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   workflowexecution.OutputsTable,
+			Columns: workflowexecution.OutputsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externaloutput.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weuo.mutation.OutputsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   workflowexecution.OutputsTable,
+			Columns: workflowexecution.OutputsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(externaloutput.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if weuo.mutation.ParentExecutionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowexecution.ParentExecutionTable,
+			Columns: []string{workflowexecution.ParentExecutionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weuo.mutation.ParentExecutionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   workflowexecution.ParentExecutionTable,
+			Columns: []string{workflowexecution.ParentExecutionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if weuo.mutation.ChildExecutionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowexecution.ChildExecutionsTable,
+			Columns: []string{workflowexecution.ChildExecutionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weuo.mutation.RemovedChildExecutionsIDs(); len(nodes) > 0 && !weuo.mutation.ChildExecutionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowexecution.ChildExecutionsTable,
+			Columns: []string{workflowexecution.ChildExecutionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := weuo.mutation.ChildExecutionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   workflowexecution.ChildExecutionsTable,
+			Columns: []string{workflowexecution.ChildExecutionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(workflowexecution.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WorkflowExecution{config: weuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, weuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{workflowexecution.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	weuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,68 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.63
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/entcausal/ent"
+	"entgo.io/contrib/entcausal/queries"
+)
+
+// SourceID is the resolver for the sourceId field.
+func (r *causalEdgeResolver) SourceID(ctx context.Context, obj *queries.CausalEdge) (string, error) {
+	return GlobalID(obj.SourceType, obj.SourceID), nil
+}
+
+// TargetID is the resolver for the targetId field.
+func (r *causalEdgeResolver) TargetID(ctx context.Context, obj *queries.CausalEdge) (string, error) {
+	return GlobalID(obj.TargetType, obj.TargetID), nil
+}
+
+// ID is the resolver for the id field.
+func (r *causalNodeResolver) ID(ctx context.Context, obj *queries.CausalNode) (string, error) {
+	return GlobalID(obj.Type, obj.ID), nil
+}
+
+// Entity is the resolver for the entity field.
+func (r *causalNodeResolver) Entity(ctx context.Context, obj *queries.CausalNode) (ent.Noder, error) {
+	table, ok := nodeTables[obj.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown causal node type %q", obj.Type)
+	}
+	return r.client.Noder(ctx, obj.ID, ent.WithFixedNodeType(table))
+}
+
+// OutputID is the resolver for the outputId field.
+func (r *causalPathResolver) OutputID(ctx context.Context, obj *queries.CausalPath) (string, error) {
+	return GlobalID(queries.NodeTypeExternalOutput, obj.OutputID), nil
+}
+
+// TraceCausality is the resolver for the traceCausality field.
+func (r *queryResolver) TraceCausality(ctx context.Context, outputID string, maxDepth *int) (*queries.CausalPath, error) {
+	id, err := parseTypedID(queries.NodeTypeExternalOutput, outputID)
+	if err != nil {
+		return nil, err
+	}
+	var depth int
+	if maxDepth != nil {
+		depth = *maxDepth
+	}
+	return r.service.TraceCausality(ctx, id, depth)
+}
+
+// CausalEdge returns CausalEdgeResolver implementation.
+func (r *Resolver) CausalEdge() CausalEdgeResolver { return &causalEdgeResolver{r} }
+
+// CausalNode returns CausalNodeResolver implementation.
+func (r *Resolver) CausalNode() CausalNodeResolver { return &causalNodeResolver{r} }
+
+// CausalPath returns CausalPathResolver implementation.
+func (r *Resolver) CausalPath() CausalPathResolver { return &causalPathResolver{r} }
+
+type causalEdgeResolver struct{ *Resolver }
+type causalNodeResolver struct{ *Resolver }
+type causalPathResolver struct{ *Resolver }
@@ -0,0 +1,144 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.63
+
+import (
+	"context"
+
+	"entgo.io/contrib/entcausal/ent"
+	"entgo.io/contrib/entcausal/queries"
+	"entgo.io/contrib/entgql"
+)
+
+// ID is the resolver for the id field.
+func (r *agentActionResolver) ID(ctx context.Context, obj *ent.AgentAction) (string, error) {
+	return GlobalID(queries.NodeTypeAgentAction, obj.ID), nil
+}
+
+// ID is the resolver for the id field.
+func (r *externalOutputResolver) ID(ctx context.Context, obj *ent.ExternalOutput) (string, error) {
+	return GlobalID(queries.NodeTypeExternalOutput, obj.ID), nil
+}
+
+// Node is the resolver for the node field.
+func (r *queryResolver) Node(ctx context.Context, id string) (ent.Noder, error) {
+	return r.noder(ctx, id)
+}
+
+// Nodes is the resolver for the nodes field.
+func (r *queryResolver) Nodes(ctx context.Context, ids []string) ([]ent.Noder, error) {
+	return r.noders(ctx, ids)
+}
+
+// AgentActions is the resolver for the agentActions field.
+func (r *queryResolver) AgentActions(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *ent.AgentActionOrder, where *ent.AgentActionWhereInput) (*ent.AgentActionConnection, error) {
+	if err := decodeWhere(where); err != nil {
+		return nil, err
+	}
+	return r.client.AgentAction.Query().
+		Paginate(ctx, after, first, before, last,
+			ent.WithAgentActionOrder(orderBy),
+			ent.WithAgentActionFilter(where.Filter),
+		)
+}
+
+// ExternalOutputs is the resolver for the externalOutputs field.
+func (r *queryResolver) ExternalOutputs(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *ent.ExternalOutputOrder, where *ent.ExternalOutputWhereInput) (*ent.ExternalOutputConnection, error) {
+	if err := decodeWhere(where); err != nil {
+		return nil, err
+	}
+	return r.client.ExternalOutput.Query().
+		Paginate(ctx, after, first, before, last,
+			ent.WithExternalOutputOrder(orderBy),
+			ent.WithExternalOutputFilter(where.Filter),
+		)
+}
+
+// RoutingDecisions is the resolver for the routingDecisions field.
+func (r *queryResolver) RoutingDecisions(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *ent.RoutingDecisionOrder, where *ent.RoutingDecisionWhereInput) (*ent.RoutingDecisionConnection, error) {
+	if err := decodeWhere(where); err != nil {
+		return nil, err
+	}
+	return r.client.RoutingDecision.Query().
+		Paginate(ctx, after, first, before, last,
+			ent.WithRoutingDecisionOrder(orderBy),
+			ent.WithRoutingDecisionFilter(where.Filter),
+		)
+}
+
+// SpikeEvents is the resolver for the spikeEvents field.
+func (r *queryResolver) SpikeEvents(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *ent.SpikeEventOrder, where *ent.SpikeEventWhereInput) (*ent.SpikeEventConnection, error) {
+	if err := decodeWhere(where); err != nil {
+		return nil, err
+	}
+	return r.client.SpikeEvent.Query().
+		Paginate(ctx, after, first, before, last,
+			ent.WithSpikeEventOrder(orderBy),
+			ent.WithSpikeEventFilter(where.Filter),
+		)
+}
+
+// WorkflowExecutions is the resolver for the workflowExecutions field.
+func (r *queryResolver) WorkflowExecutions(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *ent.WorkflowExecutionOrder, where *ent.WorkflowExecutionWhereInput) (*ent.WorkflowExecutionConnection, error) {
+	if err := decodeWhere(where); err != nil {
+		return nil, err
+	}
+	return r.client.WorkflowExecution.Query().
+		Paginate(ctx, after, first, before, last,
+			ent.WithWorkflowExecutionOrder(orderBy),
+			ent.WithWorkflowExecutionFilter(where.Filter),
+		)
+}
+
+// ID is the resolver for the id field.
+func (r *routingDecisionResolver) ID(ctx context.Context, obj *ent.RoutingDecision) (string, error) {
+	return GlobalID(queries.NodeTypeRoutingDecision, obj.ID), nil
+}
+
+// ID is the resolver for the id field.
+func (r *spikeEventResolver) ID(ctx context.Context, obj *ent.SpikeEvent) (string, error) {
+	return GlobalID(queries.NodeTypeSpikeEvent, obj.ID), nil
+}
+
+// ID is the resolver for the id field.
+func (r *workflowExecutionResolver) ID(ctx context.Context, obj *ent.WorkflowExecution) (string, error) {
+	return GlobalID(queries.NodeTypeWorkflowExecution, obj.ID), nil
+}
+
+// ParentExecutionID is the resolver for the parentExecutionID field.
+func (r *workflowExecutionResolver) ParentExecutionID(ctx context.Context, obj *ent.WorkflowExecution) (*string, error) {
+	if obj.ParentExecutionID == "" {
+		return nil, nil
+	}
+	id := GlobalID(queries.NodeTypeWorkflowExecution, obj.ParentExecutionID)
+	return &id, nil
+}
+
+// AgentAction returns AgentActionResolver implementation.
+func (r *Resolver) AgentAction() AgentActionResolver { return &agentActionResolver{r} }
+
+// ExternalOutput returns ExternalOutputResolver implementation.
+func (r *Resolver) ExternalOutput() ExternalOutputResolver { return &externalOutputResolver{r} }
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+// RoutingDecision returns RoutingDecisionResolver implementation.
+func (r *Resolver) RoutingDecision() RoutingDecisionResolver { return &routingDecisionResolver{r} }
+
+// SpikeEvent returns SpikeEventResolver implementation.
+func (r *Resolver) SpikeEvent() SpikeEventResolver { return &spikeEventResolver{r} }
+
+// WorkflowExecution returns WorkflowExecutionResolver implementation.
+func (r *Resolver) WorkflowExecution() WorkflowExecutionResolver {
+	return &workflowExecutionResolver{r}
+}
+
+type agentActionResolver struct{ *Resolver }
+type externalOutputResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
+type routingDecisionResolver struct{ *Resolver }
+type spikeEventResolver struct{ *Resolver }
+type workflowExecutionResolver struct{ *Resolver }
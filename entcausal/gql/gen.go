@@ -0,0 +1,15 @@
+// Package gql exposes the causal provenance records through GraphQL.
+//
+// The ent.graphql schema is generated by Ent from the annotations of the
+// entcausal schemas, and provides Relay connections, where-inputs and
+// ordering for all records. The causal.graphql schema extends it with the
+// traceCausality query, backed by queries.CausalQueryService.
+//
+// Records are Relay nodes with type-prefixed global IDs (see GlobalID), so
+// the node query resolves their tables without probing. The IDs of causal
+// paths, and the IDs accepted by traceCausality and the where-inputs, are
+// global IDs as well.
+package gql
+
+//go:generate go run -mod=mod ../ent/entc.go
+//go:generate go run -mod=mod github.com/99designs/gqlgen
@@ -0,0 +1,202 @@
+package gql_test
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/contrib/entcausal/ent"
+	"entgo.io/contrib/entcausal/gql"
+	"entgo.io/contrib/entcausal/internal/causaltest"
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/stretchr/testify/require"
+)
+
+func newClient(t *testing.T) (*client.Client, *ent.Client) {
+	ec := causaltest.Open(t)
+	causaltest.SeedChain(context.Background(), t, ec)
+	return client.New(handler.NewDefaultServer(gql.NewSchema(ec))), ec
+}
+
+func TestSpikeEvents(t *testing.T) {
+	gqlc, _ := newClient(t)
+	const query = `query ($after: Cursor) {
+		spikeEvents(first: 1, after: $after, orderBy: {field: TIMESTAMP, direction: DESC}) {
+			totalCount
+			edges { node { id patternHash decisions { id confidence } } }
+			pageInfo { hasNextPage endCursor }
+		}
+	}`
+	type page struct {
+		SpikeEvents struct {
+			TotalCount int
+			Edges      []struct {
+				Node struct {
+					ID          string
+					PatternHash string
+					Decisions   []struct {
+						ID         string
+						Confidence float64
+					}
+				}
+			}
+			PageInfo struct {
+				HasNextPage bool
+				EndCursor   *string
+			}
+		}
+	}
+	var rsp page
+	gqlc.MustPost(query, &rsp)
+	require.Equal(t, 2, rsp.SpikeEvents.TotalCount)
+	require.Len(t, rsp.SpikeEvents.Edges, 1)
+	require.Equal(t, "spike_event:spike-2", rsp.SpikeEvents.Edges[0].Node.ID)
+	require.Equal(t, "routing_decision:decision-1", rsp.SpikeEvents.Edges[0].Node.Decisions[0].ID)
+	require.True(t, rsp.SpikeEvents.PageInfo.HasNextPage)
+
+	var next page
+	gqlc.MustPost(query, &next, client.Var("after", rsp.SpikeEvents.PageInfo.EndCursor))
+	require.Len(t, next.SpikeEvents.Edges, 1)
+	require.Equal(t, "spike_event:spike-1", next.SpikeEvents.Edges[0].Node.ID)
+	require.False(t, next.SpikeEvents.PageInfo.HasNextPage)
+}
+
+func TestWhereGlobalIDs(t *testing.T) {
+	gqlc, ec := newClient(t)
+	ec.WorkflowExecution.Create().
+		SetID("workflow-2").
+		SetStartedAt(causaltest.Base).
+		SetWorkflowID("wf").
+		SetParentExecutionID("workflow-1").
+		ExecX(context.Background())
+	var rsp struct {
+		SpikeEvents struct {
+			Edges []struct{ Node struct{ ID string } }
+		}
+		WorkflowExecutions struct {
+			Edges []struct {
+				Node struct {
+					ID                string
+					ParentExecutionID *string
+				}
+			}
+		}
+	}
+	// The IDs returned by the API are accepted by the where-inputs.
+	gqlc.MustPost(`query {
+		spikeEvents(where: {idIn: ["spike_event:spike-2"], hasDecisionsWith: {id: "routing_decision:decision-1"}}) { edges { node { id } } }
+		workflowExecutions(where: {parentExecutionID: "workflow_execution:workflow-1"}) { edges { node { id parentExecutionID } } }
+	}`, &rsp)
+	require.Len(t, rsp.SpikeEvents.Edges, 1)
+	require.Equal(t, "spike_event:spike-2", rsp.SpikeEvents.Edges[0].Node.ID)
+	require.Len(t, rsp.WorkflowExecutions.Edges, 1)
+	require.Equal(t, "workflow_execution:workflow-2", rsp.WorkflowExecutions.Edges[0].Node.ID)
+	require.Equal(t, "workflow_execution:workflow-1", *rsp.WorkflowExecutions.Edges[0].Node.ParentExecutionID)
+
+	err := gqlc.Post(`query { spikeEvents(where: {id: "spike-1"}) { totalCount } }`, &rsp)
+	require.ErrorContains(t, err, `invalid global id \"spike-1\" of type spike_event`)
+	err = gqlc.Post(`query { spikeEvents(where: {not: {hasDecisionsWith: {id: "spike_event:decision-1"}}}) { totalCount } }`, &rsp)
+	require.ErrorContains(t, err, `invalid global id \"spike_event:decision-1\" of type routing_decision`)
+}
+
+func TestTraceCausality(t *testing.T) {
+	gqlc, _ := newClient(t)
+	var rsp struct {
+		ExternalOutputs struct {
+			Edges []struct{ Node struct{ ID string } }
+		}
+		TraceCausality struct {
+			OutputID string
+			Depth    int
+			Nodes    []struct {
+				ID     string
+				Type   string
+				Depth  int
+				Entity struct {
+					Typename string `json:"__typename"`
+					ID       string
+				}
+			}
+			Edges []struct {
+				SourceID   string
+				TargetID   string
+				EdgeType   string
+				Confidence float64
+			}
+		}
+	}
+	gqlc.MustPost(`query { externalOutputs { edges { node { id } } } }`, &rsp)
+	outputID := rsp.ExternalOutputs.Edges[0].Node.ID
+	require.Equal(t, "external_output:output-1", outputID)
+
+	// The ID of an output is accepted by traceCausality.
+	gqlc.MustPost(`query ($id: ID!) {
+		traceCausality(outputId: $id) {
+			outputId
+			depth
+			nodes { id type depth entity { __typename id } }
+			edges { sourceId targetId edgeType confidence }
+		}
+	}`, &rsp, client.Var("id", outputID))
+	path := rsp.TraceCausality
+	require.Equal(t, outputID, path.OutputID)
+	require.Equal(t, 4, path.Depth)
+	require.Len(t, path.Nodes, 6)
+	require.Len(t, path.Edges, 5)
+	typenames := make(map[string]string)
+	for _, n := range path.Nodes {
+		typenames[n.ID] = n.Entity.Typename
+		require.Equal(t, n.ID, n.Entity.ID)
+	}
+	require.Equal(t, map[string]string{
+		"external_output:output-1":      "ExternalOutput",
+		"workflow_execution:workflow-1": "WorkflowExecution",
+		"agent_action:action-1":         "AgentAction",
+		"routing_decision:decision-1":   "RoutingDecision",
+		"spike_event:spike-1":           "SpikeEvent",
+		"spike_event:spike-2":           "SpikeEvent",
+	}, typenames)
+	for _, e := range path.Edges {
+		require.Contains(t, typenames, e.SourceID)
+		require.Contains(t, typenames, e.TargetID)
+	}
+
+	rsp.TraceCausality.Nodes = nil
+	gqlc.MustPost(`query { traceCausality(outputId: "external_output:output-1", maxDepth: 2) { depth nodes { id } } }`, &rsp)
+	require.Equal(t, 2, rsp.TraceCausality.Depth)
+	require.Len(t, rsp.TraceCausality.Nodes, 3)
+
+	err := gqlc.Post(`query { traceCausality(outputId: "external_output:missing") { depth } }`, &rsp)
+	require.Error(t, err)
+	err = gqlc.Post(`query { traceCausality(outputId: "output-1") { depth } }`, &rsp)
+	require.ErrorContains(t, err, `invalid global id \"output-1\" of type external_output`)
+	err = gqlc.Post(`query { traceCausality(outputId: "spike_event:spike-1") { depth } }`, &rsp)
+	require.ErrorContains(t, err, `invalid global id \"spike_event:spike-1\" of type external_output`)
+}
+
+func TestNode(t *testing.T) {
+	gqlc, _ := newClient(t)
+	var rsp struct {
+		Node struct {
+			ID         string
+			Confidence float64
+			Actions    []struct{ ID string }
+		}
+		Nodes []*struct{ ID string }
+	}
+	gqlc.MustPost(`query {
+		node(id: "routing_decision:decision-1") { id ... on RoutingDecision { confidence actions { id } } }
+		nodes(ids: ["external_output:output-1", "spike_event:spike-2"]) { id }
+	}`, &rsp)
+	require.Equal(t, "routing_decision:decision-1", rsp.Node.ID)
+	require.Equal(t, 0.8, rsp.Node.Confidence)
+	require.Equal(t, "agent_action:action-1", rsp.Node.Actions[0].ID)
+	require.Len(t, rsp.Nodes, 2)
+	require.Equal(t, "external_output:output-1", rsp.Nodes[0].ID)
+	require.Equal(t, "spike_event:spike-2", rsp.Nodes[1].ID)
+
+	err := gqlc.Post(`query { node(id: "decision-1") { id } }`, &rsp)
+	require.ErrorContains(t, err, `invalid global id \"decision-1\"`)
+	err = gqlc.Post(`query { node(id: "spike_event:decision-1") { id } }`, &rsp)
+	require.ErrorContains(t, err, "global id of 'spike_event:decision-1'")
+}
@@ -0,0 +1,185 @@
+package gql
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"entgo.io/contrib/entcausal/ent"
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/spikeevent"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+	"entgo.io/contrib/entcausal/queries"
+	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Resolver is the resolver root.
+type Resolver struct {
+	client  *ent.Client
+	service *queries.CausalQueryService
+}
+
+// NewSchema creates a graphql executable schema.
+func NewSchema(client *ent.Client) graphql.ExecutableSchema {
+	return NewExecutableSchema(Config{
+		Resolvers: &Resolver{
+			client:  client,
+			service: queries.NewCausalQueryService(client),
+		},
+	})
+}
+
+// nodeTables maps the types of causal nodes to their tables.
+var nodeTables = map[string]string{
+	queries.NodeTypeExternalOutput:    externaloutput.Table,
+	queries.NodeTypeWorkflowExecution: workflowexecution.Table,
+	queries.NodeTypeAgentAction:       agentaction.Table,
+	queries.NodeTypeRoutingDecision:   routingdecision.Table,
+	queries.NodeTypeSpikeEvent:        spikeevent.Table,
+}
+
+// GlobalID returns the global ID of a causal record, which prefixes the
+// record ID with its type, e.g. "routing_decision:decision-1". All IDs
+// returned by the API are global IDs, and all ID arguments and where-input
+// ID predicates accept them.
+func GlobalID(typ, id string) string {
+	return typ + ":" + id
+}
+
+// parseGlobalID splits a global ID to the table and the ID of its record.
+func parseGlobalID(gid string) (table, id string, err error) {
+	typ, id, ok := strings.Cut(gid, ":")
+	if !ok {
+		return "", "", fmt.Errorf("invalid global id %q", gid)
+	}
+	if table, ok = nodeTables[typ]; !ok {
+		return "", "", fmt.Errorf("unknown type %q of global id %q", typ, gid)
+	}
+	return table, id, nil
+}
+
+// parseTypedID returns the record ID of a global ID of the given type.
+func parseTypedID(typ, gid string) (string, error) {
+	t, id, ok := strings.Cut(gid, ":")
+	if !ok || t != typ {
+		return "", fmt.Errorf("invalid global id %q of type %s", gid, typ)
+	}
+	return id, nil
+}
+
+// whereTypes maps the where-inputs to the types of the records they filter.
+var whereTypes = map[reflect.Type]string{
+	reflect.TypeOf(ent.ExternalOutputWhereInput{}):    queries.NodeTypeExternalOutput,
+	reflect.TypeOf(ent.WorkflowExecutionWhereInput{}): queries.NodeTypeWorkflowExecution,
+	reflect.TypeOf(ent.AgentActionWhereInput{}):       queries.NodeTypeAgentAction,
+	reflect.TypeOf(ent.RoutingDecisionWhereInput{}):   queries.NodeTypeRoutingDecision,
+	reflect.TypeOf(ent.SpikeEventWhereInput{}):        queries.NodeTypeSpikeEvent,
+}
+
+// idOps holds the suffixes of the where-input predicates that compare IDs
+// as a whole. Substring predicates, such as idContainsFold, match the
+// record IDs as is.
+var idOps = map[string]bool{
+	"": true, "NEQ": true, "In": true, "NotIn": true, "GT": true,
+	"GTE": true, "LT": true, "LTE": true, "EqualFold": true,
+}
+
+// decodeWhere replaces the global IDs in the ID predicates of a where-input,
+// and of the where-inputs nested in it, with the IDs of their records. The
+// where-input is a pointer to one of the generated where-inputs, and may be nil.
+func decodeWhere(where any) error {
+	v := reflect.ValueOf(where)
+	if v.Kind() != reflect.Pointer || v.IsNil() {
+		return nil
+	}
+	v = v.Elem()
+	typ, ok := whereTypes[v.Type()]
+	if !ok {
+		return fmt.Errorf("unexpected where input %T", where)
+	}
+	for i := 0; i < v.NumField(); i++ {
+		f, name := v.Field(i), v.Type().Field(i).Name
+		switch {
+		case strings.HasPrefix(name, "ParentExecutionID") && idOps[strings.TrimPrefix(name, "ParentExecutionID")]:
+			if err := decodeIDs(queries.NodeTypeWorkflowExecution, f); err != nil {
+				return err
+			}
+		case strings.HasPrefix(name, "ID") && idOps[strings.TrimPrefix(name, "ID")]:
+			if err := decodeIDs(typ, f); err != nil {
+				return err
+			}
+		case f.Kind() == reflect.Pointer:
+			if _, ok := whereTypes[f.Type().Elem()]; ok {
+				if err := decodeWhere(f.Interface()); err != nil {
+					return err
+				}
+			}
+		case f.Kind() == reflect.Slice && f.Type().Elem().Kind() == reflect.Pointer:
+			if _, ok := whereTypes[f.Type().Elem().Elem()]; !ok {
+				continue
+			}
+			for j := 0; j < f.Len(); j++ {
+				if err := decodeWhere(f.Index(j).Interface()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// decodeIDs replaces the global IDs of the given type stored
+// in a *string or a []string value with their record IDs.
+func decodeIDs(typ string, v reflect.Value) error {
+	switch {
+	case v.Kind() == reflect.Pointer && !v.IsNil():
+		id, err := parseTypedID(typ, v.Elem().String())
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(&id))
+	case v.Kind() == reflect.Slice:
+		ids := make([]string, v.Len())
+		for i := range ids {
+			id, err := parseTypedID(typ, v.Index(i).String())
+			if err != nil {
+				return err
+			}
+			ids[i] = id
+		}
+		v.Set(reflect.ValueOf(ids))
+	}
+	return nil
+}
+
+// noder returns the record of a global ID.
+func (r *Resolver) noder(ctx context.Context, gid string) (ent.Noder, error) {
+	table, id, err := parseGlobalID(gid)
+	if err != nil {
+		return nil, err
+	}
+	noder, err := r.client.Noder(ctx, id, ent.WithFixedNodeType(table))
+	if ent.IsNotFound(err) {
+		return nil, entgql.ErrNodeNotFound(gid)
+	}
+	return noder, err
+}
+
+// noders returns the records of global IDs. Errors are reported on the
+// positions of their IDs, and leave them empty.
+func (r *Resolver) noders(ctx context.Context, gids []string) ([]ent.Noder, error) {
+	noders := make([]ent.Noder, len(gids))
+	for i, gid := range gids {
+		noder, err := r.noder(ctx, gid)
+		if err != nil {
+			graphql.AddError(graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i)), err)
+			continue
+		}
+		noders[i] = noder
+	}
+	return noders, nil
+}
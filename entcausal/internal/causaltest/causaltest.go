@@ -0,0 +1,86 @@
+// Package causaltest provides the test fixtures shared by the entcausal packages.
+package causaltest
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent"
+	"entgo.io/contrib/entcausal/ent/enttest"
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/ent/dialect"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Base is the timestamp of the first record of the seeded chain.
+var Base = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// DSN returns the DSN of an in-memory SQLite database that is private to
+// the test, so that tests do not share records when run in parallel.
+func DSN(t testing.TB) string {
+	return fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", url.PathEscape(t.Name()))
+}
+
+// Open opens a client on the database of the test, and closes it when the test ends.
+func Open(t testing.TB, opts ...enttest.Option) *ent.Client {
+	client := enttest.Open(t, dialect.SQLite, DSN(t), opts...)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// SeedChain creates a single causal chain:
+//
+//	spike-1, spike-2 -> decision-1 -> action-1 -> workflow-1 -> output-1
+func SeedChain(ctx context.Context, t testing.TB, client *ent.Client) {
+	t.Helper()
+	s1 := client.SpikeEvent.Create().
+		SetID("spike-1").
+		SetTimestamp(Base).
+		SetPopulationID("pop-a").
+		SetNeuronIndices([]int{1, 2, 3}).
+		SetPatternHash("hash-a").
+		SetMetadata(map[string]interface{}{"region": "v1"}).
+		SaveX(ctx)
+	s2 := client.SpikeEvent.Create().
+		SetID("spike-2").
+		SetTimestamp(Base.Add(time.Millisecond)).
+		SetPopulationID("pop-b").
+		SetNeuronIndices([]int{4}).
+		SetPatternHash("hash-b").
+		SaveX(ctx)
+	d := client.RoutingDecision.Create().
+		SetID("decision-1").
+		SetTimestamp(Base.Add(time.Second)).
+		SetInferenceID("inference-1").
+		SetDecisionType(routingdecision.DecisionTypeRoute).
+		SetConfidence(0.8).
+		AddSpikeEvents(s1, s2).
+		SaveX(ctx)
+	a := client.AgentAction.Create().
+		SetID("action-1").
+		SetTimestamp(Base.Add(2 * time.Second)).
+		SetAgentID("aria-1").
+		SetAgentType("aria").
+		SetActionType("execute").
+		AddDecisions(d).
+		SaveX(ctx)
+	w := client.WorkflowExecution.Create().
+		SetID("workflow-1").
+		SetStartedAt(Base.Add(3 * time.Second)).
+		SetWorkflowID("wf").
+		AddActions(a).
+		SaveX(ctx)
+	client.ExternalOutput.Create().
+		SetID("output-1").
+		SetTimestamp(Base.Add(4 * time.Second)).
+		SetOutputType(externaloutput.OutputTypeDocument).
+		SetContentHash("sha256:abc").
+		SetMetadata(map[string]interface{}{"title": "report"}).
+		AddWorkflows(w).
+		SaveX(ctx)
+}
@@ -0,0 +1,420 @@
+// Package provenance seals the causal records of entcausal into a
+// tamper-evident hash chain.
+//
+// On insert, Hook computes a Merkle-style digest over the record and the IDs
+// and digests of its parents (the records that caused it), and stores it in
+// the provenance_hash field. Altering a record, its parent edges, or the digest
+// of any of its ancestors, makes the digest recomputed by Recompute differ from
+// the stored one.
+//
+// Only the parent edges set when a record is created are sealed. Parent edges
+// added or removed afterwards alter the record, and are reported as such.
+//
+//	client.Use(provenance.Hook())
+package provenance
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent"
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/spikeevent"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+)
+
+// FieldDigest is the name of the field that stores the digest of a record.
+const FieldDigest = "provenance_hash"
+
+type (
+	// spec describes what is sealed in the digest of a record type. Only fields
+	// that are not expected to change after insert are sealed. For example, the
+	// status of a workflow execution or the transaction of an output are not.
+	spec struct {
+		// typ is the struct type of the record, used to seal the
+		// zero values of the fields that are not set on insert.
+		typ     reflect.Type
+		fields  []string
+		parents []parent
+		// load loads a record with the ID and the digest of its parents.
+		load func(context.Context, *ent.Client, string) (any, error)
+	}
+	// parent describes an edge from a record to its causes.
+	parent struct {
+		edge string
+		typ  string
+	}
+	// ref is a reference to a parent record, as sealed in a digest.
+	ref struct {
+		Type   string `json:"type"`
+		ID     string `json:"id"`
+		Digest string `json:"digest"`
+	}
+)
+
+var specs = map[string]spec{
+	ent.TypeSpikeEvent: {
+		typ: reflect.TypeOf(ent.SpikeEvent{}),
+		fields: []string{
+			spikeevent.FieldTimestamp,
+			spikeevent.FieldTimestampNs,
+			spikeevent.FieldPopulationID,
+			spikeevent.FieldLayerIndex,
+			spikeevent.FieldNeuronIndices,
+			spikeevent.FieldSpikeCounts,
+			spikeevent.FieldPatternHash,
+			spikeevent.FieldInferenceID,
+			spikeevent.FieldEntropy,
+		},
+		load: func(ctx context.Context, client *ent.Client, id string) (any, error) {
+			return client.SpikeEvent.Get(ctx, id)
+		},
+	},
+	ent.TypeRoutingDecision: {
+		typ: reflect.TypeOf(ent.RoutingDecision{}),
+		fields: []string{
+			routingdecision.FieldTimestamp,
+			routingdecision.FieldInferenceID,
+			routingdecision.FieldDecisionType,
+			routingdecision.FieldLayerIndex,
+			routingdecision.FieldGateProbability,
+			routingdecision.FieldSelectedModel,
+			routingdecision.FieldConfidence,
+			routingdecision.FieldDomain,
+		},
+		parents: []parent{{routingdecision.EdgeSpikeEvents, ent.TypeSpikeEvent}},
+		load: func(ctx context.Context, client *ent.Client, id string) (any, error) {
+			return client.RoutingDecision.Query().
+				Where(routingdecision.ID(id)).
+				WithSpikeEvents(func(q *ent.SpikeEventQuery) {
+					q.Select(spikeevent.FieldID, spikeevent.FieldProvenanceHash)
+				}).
+				Only(ctx)
+		},
+	},
+	ent.TypeAgentAction: {
+		typ: reflect.TypeOf(ent.AgentAction{}),
+		fields: []string{
+			agentaction.FieldTimestamp,
+			agentaction.FieldAgentID,
+			agentaction.FieldAgentType,
+			agentaction.FieldActionType,
+			agentaction.FieldActionName,
+			agentaction.FieldParameters,
+			agentaction.FieldTargetResource,
+			agentaction.FieldSessionID,
+			agentaction.FieldUserID,
+		},
+		parents: []parent{{agentaction.EdgeDecisions, ent.TypeRoutingDecision}},
+		load: func(ctx context.Context, client *ent.Client, id string) (any, error) {
+			return client.AgentAction.Query().
+				Where(agentaction.ID(id)).
+				WithDecisions(func(q *ent.RoutingDecisionQuery) {
+					q.Select(routingdecision.FieldID, routingdecision.FieldProvenanceHash)
+				}).
+				Only(ctx)
+		},
+	},
+	ent.TypeWorkflowExecution: {
+		typ: reflect.TypeOf(ent.WorkflowExecution{}),
+		fields: []string{
+			workflowexecution.FieldStartedAt,
+			workflowexecution.FieldWorkflowID,
+			workflowexecution.FieldWorkflowName,
+			workflowexecution.FieldInputs,
+		},
+		parents: []parent{
+			{workflowexecution.EdgeActions, ent.TypeAgentAction},
+			{workflowexecution.EdgeParentExecution, ent.TypeWorkflowExecution},
+		},
+		load: func(ctx context.Context, client *ent.Client, id string) (any, error) {
+			return client.WorkflowExecution.Query().
+				Where(workflowexecution.ID(id)).
+				WithActions(func(q *ent.AgentActionQuery) {
+					q.Select(agentaction.FieldID, agentaction.FieldProvenanceHash)
+				}).
+				WithParentExecution(func(q *ent.WorkflowExecutionQuery) {
+					q.Select(workflowexecution.FieldID, workflowexecution.FieldProvenanceHash)
+				}).
+				Only(ctx)
+		},
+	},
+	ent.TypeExternalOutput: {
+		typ: reflect.TypeOf(ent.ExternalOutput{}),
+		fields: []string{
+			externaloutput.FieldTimestamp,
+			externaloutput.FieldOutputType,
+			externaloutput.FieldDestination,
+			externaloutput.FieldDestinationID,
+			externaloutput.FieldContentHash,
+			externaloutput.FieldContentSize,
+			externaloutput.FieldDomain,
+		},
+		parents: []parent{{externaloutput.EdgeWorkflows, ent.TypeWorkflowExecution}},
+		load: func(ctx context.Context, client *ent.Client, id string) (any, error) {
+			return client.ExternalOutput.Query().
+				Where(externaloutput.ID(id)).
+				WithWorkflows(func(q *ent.WorkflowExecutionQuery) {
+					q.Select(workflowexecution.FieldID, workflowexecution.FieldProvenanceHash)
+				}).
+				Only(ctx)
+		},
+	},
+}
+
+// Hook returns a hook that seals the causal records on insert.
+// Parents must be inserted before their children, and the edges to
+// the parents must be set when the child is created, as edges added
+// by later updates are not sealed, and break the record.
+func Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			s, ok := specs[m.Type()]
+			if !ok || !m.Op().Is(ent.OpCreate) {
+				return next.Mutate(ctx, m)
+			}
+			mc, ok := m.(interface {
+				ID() (string, bool)
+				Client() *ent.Client
+			})
+			if !ok {
+				return nil, fmt.Errorf("provenance: unexpected mutation type %T", m)
+			}
+			id, ok := mc.ID()
+			if !ok {
+				return nil, fmt.Errorf("provenance: missing id for %s", m.Type())
+			}
+			// Fields that are not set are stored as NULL, and read back
+			// as the zero values of the struct fields.
+			zero := jsonFields(reflect.New(s.typ).Interface())
+			fields := make(map[string]ent.Value, len(s.fields))
+			for _, f := range s.fields {
+				if v, ok := m.Field(f); ok {
+					fields[f] = v
+				} else {
+					fields[f] = zero[f].Interface()
+				}
+			}
+			var refs []ref
+			for _, p := range s.parents {
+				ids := make([]string, 0)
+				for _, v := range m.AddedIDs(p.edge) {
+					ids = append(ids, v.(string))
+				}
+				digests, err := lookup(ctx, mc.Client(), p.typ, ids)
+				if err != nil {
+					return nil, err
+				}
+				for _, id := range ids {
+					refs = append(refs, ref{Type: p.typ, ID: id, Digest: digests[id]})
+				}
+			}
+			d, err := digest(m.Type(), id, fields, refs)
+			if err != nil {
+				return nil, err
+			}
+			if err := m.SetField(FieldDigest, d); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// Recompute returns the digest stored in a record, and the digest recomputed
+// from its current fields and parents. The record type is one of the ent
+// type names, such as ent.TypeSpikeEvent. The digests differ if the record,
+// its parent edges, or the stored digests of its parents were altered.
+func Recompute(ctx context.Context, client *ent.Client, typ, id string) (stored, computed string, err error) {
+	s, ok := specs[typ]
+	if !ok {
+		return "", "", fmt.Errorf("provenance: unexpected record type %q", typ)
+	}
+	r, err := s.load(ctx, client, id)
+	if err != nil {
+		return "", "", fmt.Errorf("provenance: loading %s %q: %w", typ, id, err)
+	}
+	// The sealed fields and parent edges are read by their names in the spec,
+	// which are also the JSON names of the generated struct fields.
+	values := jsonFields(r)
+	fields := make(map[string]ent.Value, len(s.fields))
+	for _, f := range s.fields {
+		v, ok := values[f]
+		if !ok {
+			return "", "", fmt.Errorf("provenance: missing field %s of %s", f, typ)
+		}
+		fields[f] = v.Interface()
+	}
+	var (
+		refs  []ref
+		edges = jsonFields(values["edges"].Interface())
+	)
+	for _, p := range s.parents {
+		for _, pr := range records(edges[p.edge]) {
+			pv := jsonFields(pr)
+			refs = append(refs, ref{Type: p.typ, ID: pv["id"].String(), Digest: pv[FieldDigest].String()})
+		}
+	}
+	computed, err = digest(typ, id, fields, refs)
+	if err != nil {
+		return "", "", err
+	}
+	return values[FieldDigest].String(), computed, nil
+}
+
+// jsonFields returns the fields of a struct, or a pointer
+// to a struct, keyed by the names in their JSON tags.
+func jsonFields(v any) map[string]reflect.Value {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+	m := make(map[string]reflect.Value, rv.NumField())
+	for i := 0; i < rv.NumField(); i++ {
+		name, _, _ := strings.Cut(rv.Type().Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			m[name] = rv.Field(i)
+		}
+	}
+	return m
+}
+
+// records returns the records loaded on a unique or a non-unique edge.
+func records(v reflect.Value) []any {
+	switch {
+	case !v.IsValid():
+		return nil
+	case v.Kind() == reflect.Slice:
+		rs := make([]any, v.Len())
+		for i := range rs {
+			rs[i] = v.Index(i).Interface()
+		}
+		return rs
+	case v.Kind() == reflect.Pointer && !v.IsNil():
+		return []any{v.Interface()}
+	}
+	return nil
+}
+
+// lookup returns the stored digests of the given records.
+func lookup(ctx context.Context, client *ent.Client, typ string, ids []string) (map[string]string, error) {
+	digests := make(map[string]string, len(ids))
+	if len(ids) == 0 {
+		return digests, nil
+	}
+	var (
+		err  error
+		rows []struct {
+			ID     string `json:"id"`
+			Digest string `json:"provenance_hash"`
+		}
+	)
+	switch typ {
+	case ent.TypeSpikeEvent:
+		err = client.SpikeEvent.Query().
+			Where(spikeevent.IDIn(ids...)).
+			Select(spikeevent.FieldID, spikeevent.FieldProvenanceHash).
+			Scan(ctx, &rows)
+	case ent.TypeRoutingDecision:
+		err = client.RoutingDecision.Query().
+			Where(routingdecision.IDIn(ids...)).
+			Select(routingdecision.FieldID, routingdecision.FieldProvenanceHash).
+			Scan(ctx, &rows)
+	case ent.TypeAgentAction:
+		err = client.AgentAction.Query().
+			Where(agentaction.IDIn(ids...)).
+			Select(agentaction.FieldID, agentaction.FieldProvenanceHash).
+			Scan(ctx, &rows)
+	case ent.TypeWorkflowExecution:
+		err = client.WorkflowExecution.Query().
+			Where(workflowexecution.IDIn(ids...)).
+			Select(workflowexecution.FieldID, workflowexecution.FieldProvenanceHash).
+			Scan(ctx, &rows)
+	default:
+		return nil, fmt.Errorf("provenance: unexpected parent type %q", typ)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("provenance: loading digests of %s parents: %w", typ, err)
+	}
+	for _, r := range rows {
+		digests[r.ID] = r.Digest
+	}
+	return digests, nil
+}
+
+// digest computes the digest of a record from its canonical JSON encoding.
+func digest(typ, id string, fields map[string]ent.Value, refs []ref) (string, error) {
+	values := make(map[string]any, len(fields))
+	for k, v := range fields {
+		n, err := normalize(v)
+		if err != nil {
+			return "", fmt.Errorf("provenance: encoding field %s of %s %q: %w", k, typ, id, err)
+		}
+		values[k] = n
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].Type != refs[j].Type {
+			return refs[i].Type < refs[j].Type
+		}
+		return refs[i].ID < refs[j].ID
+	})
+	// encoding/json sorts map keys, which makes the encoding canonical.
+	b, err := json.Marshal(struct {
+		Type    string         `json:"type"`
+		ID      string         `json:"id"`
+		Fields  map[string]any `json:"fields"`
+		Parents []ref          `json:"parents"`
+	}{typ, id, values, refs})
+	if err != nil {
+		return "", fmt.Errorf("provenance: encoding %s %q: %w", typ, id, err)
+	}
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:]), nil
+}
+
+// normalize returns the canonical form of a field value, so that values set on
+// a mutation and values read back from the database are sealed identically.
+// Zero values are sealed as is, and only NULL values, or nil pointers of
+// Nillable fields, are sealed as null. Times are sealed in UTC with a
+// microsecond precision, the highest precision stored by PostgreSQL and by
+// MySQL, which requires fractional seconds on the time columns, e.g. datetime(6).
+func normalize(v ent.Value) (any, error) {
+	switch t := v.(type) {
+	case time.Time:
+		return t.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano), nil
+	case *time.Time:
+		if t == nil {
+			return nil, nil
+		}
+		return normalize(*t)
+	}
+	// A JSON round-trip unifies the representation of numbers and JSON fields.
+	// Numbers are decoded as json.Number to keep their full precision.
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var n any
+	if err := dec.Decode(&n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
@@ -0,0 +1,206 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/spikeevent"
+)
+
+type (
+	// Attribution ranks the spike events and routing decisions
+	// that contributed to an output by their contribution score.
+	Attribution struct {
+		OutputID         string         `json:"output_id"`
+		SpikeEvents      []Contribution `json:"spike_events"`
+		RoutingDecisions []Contribution `json:"routing_decisions"`
+		ComputedAt       time.Time      `json:"computed_at"`
+	}
+
+	// Contribution is the share of an output attributed to a node on its
+	// causal path. The scores of the nodes of the same type sum up to 1.
+	Contribution struct {
+		ID        string    `json:"id"`
+		Type      string    `json:"type"`
+		Timestamp time.Time `json:"timestamp"`
+		Depth     int       `json:"depth"`
+		Score     float64   `json:"score"`
+	}
+)
+
+// Attribute computes how much each spike event and routing decision on the
+// causal path of an output contributed to it.
+//
+// The output holds a contribution of 1, that is propagated from every effect to
+// its causes, and split between them in proportion to the confidence of their
+// edge, and to the weight of the cause: the gate probability of a routing
+// decision, or 1 + the entropy of a spike event. Zero confidences and gate
+// probabilities are treated as not recorded, and weigh as 1. The score of
+// a node is the share of the output that would lose its cause had the node
+// not occurred, normalized among the nodes of the same type.
+func (s *CausalQueryService) Attribute(ctx context.Context, outputID string) (*Attribution, error) {
+	path, err := s.TraceCausality(ctx, outputID, 0)
+	if err != nil {
+		return nil, err
+	}
+	weights, err := s.attributionWeights(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	causes := make(map[string][]CausalEdge)
+	for _, e := range path.Edges {
+		k := e.TargetType + ":" + e.TargetID
+		causes[k] = append(causes[k], e)
+	}
+	nodes := make(map[string]CausalNode, len(path.Nodes))
+	for _, n := range path.Nodes {
+		nodes[nodeKey(n)] = n
+	}
+	root := NodeTypeExternalOutput + ":" + outputID
+	mass := map[string]float64{root: 1}
+	for _, k := range effectsFirst(root, causes) {
+		edges := causes[k]
+		split := make([]float64, len(edges))
+		var total float64
+		for i, e := range edges {
+			split[i] = recorded(e.Confidence) * weights[e.SourceType+":"+e.SourceID]
+			total += split[i]
+		}
+		for i, e := range edges {
+			share := 1 / float64(len(edges))
+			if total > 0 {
+				share = split[i] / total
+			}
+			mass[e.SourceType+":"+e.SourceID] += mass[k] * share
+		}
+	}
+	a := &Attribution{
+		OutputID:   outputID,
+		ComputedAt: time.Now(),
+	}
+	for k, m := range mass {
+		n := nodes[k]
+		c := Contribution{ID: n.ID, Type: n.Type, Timestamp: n.Timestamp, Depth: n.Depth, Score: m}
+		switch n.Type {
+		case NodeTypeSpikeEvent:
+			a.SpikeEvents = append(a.SpikeEvents, c)
+		case NodeTypeRoutingDecision:
+			a.RoutingDecisions = append(a.RoutingDecisions, c)
+		}
+	}
+	rank(a.SpikeEvents)
+	rank(a.RoutingDecisions)
+	return a, nil
+}
+
+// attributionWeights returns the weights of the routing decisions
+// and spike events on the path. Other nodes weigh as 1.
+func (s *CausalQueryService) attributionWeights(ctx context.Context, path *CausalPath) (map[string]float64, error) {
+	var decisionIDs, spikeIDs []string
+	weights := make(map[string]float64, len(path.Nodes))
+	for _, n := range path.Nodes {
+		weights[nodeKey(n)] = 1
+		switch n.Type {
+		case NodeTypeRoutingDecision:
+			decisionIDs = append(decisionIDs, n.ID)
+		case NodeTypeSpikeEvent:
+			spikeIDs = append(spikeIDs, n.ID)
+		}
+	}
+	if len(decisionIDs) > 0 {
+		decisions, err := s.client.RoutingDecision.Query().
+			Where(routingdecision.IDIn(decisionIDs...)).
+			Select(routingdecision.FieldGateProbability).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("entcausal: querying routing decisions: %w", err)
+		}
+		for _, d := range decisions {
+			weights[NodeTypeRoutingDecision+":"+d.ID] = recorded(d.GateProbability)
+		}
+	}
+	if len(spikeIDs) > 0 {
+		spikes, err := s.client.SpikeEvent.Query().
+			Where(spikeevent.IDIn(spikeIDs...)).
+			Select(spikeevent.FieldEntropy).
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("entcausal: querying spike events: %w", err)
+		}
+		for _, e := range spikes {
+			weights[NodeTypeSpikeEvent+":"+e.ID] = 1 + math.Max(e.Entropy, 0)
+		}
+	}
+	return weights, nil
+}
+
+// effectsFirst orders the nodes reachable from root through the causes map
+// so that each node comes after all of its effects. Edges that close a cycle
+// between nested workflows are ignored.
+func effectsFirst(root string, causes map[string][]CausalEdge) []string {
+	var (
+		order   []string
+		visited = make(map[string]bool)
+		visit   func(string)
+	)
+	visit = func(k string) {
+		visited[k] = true
+		for _, e := range causes[k] {
+			if c := e.SourceType + ":" + e.SourceID; !visited[c] {
+				visit(c)
+			}
+		}
+		order = append(order, k)
+	}
+	visit(root)
+	// The reversed post-order puts effects first, except for
+	// the edges closing a cycle, that point to an earlier node.
+	for i, j := 0, len(order)-1; i < j; i, j = i+1, j-1 {
+		order[i], order[j] = order[j], order[i]
+	}
+	pos := make(map[string]int, len(order))
+	for i, k := range order {
+		pos[k] = i
+	}
+	for _, k := range order {
+		var edges []CausalEdge
+		for _, e := range causes[k] {
+			if pos[e.SourceType+":"+e.SourceID] > pos[k] {
+				edges = append(edges, e)
+			}
+		}
+		causes[k] = edges
+	}
+	return order
+}
+
+// rank normalizes the scores of the contributions, and sorts them by score.
+func rank(cs []Contribution) {
+	var total float64
+	for _, c := range cs {
+		total += c.Score
+	}
+	for i := range cs {
+		if total > 0 {
+			cs[i].Score /= total
+		}
+	}
+	sort.Slice(cs, func(i, j int) bool {
+		if cs[i].Score != cs[j].Score {
+			return cs[i].Score > cs[j].Score
+		}
+		return cs[i].ID < cs[j].ID
+	})
+}
+
+// recorded returns v, or 1 if v was not recorded.
+func recorded(v float64) float64 {
+	if v <= 0 {
+		return 1
+	}
+	return v
+}
@@ -0,0 +1,120 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/internal/causaltest"
+	"entgo.io/contrib/entcausal/queries"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttribute(t *testing.T) {
+	ctx := context.Background()
+	client := causaltest.Open(t)
+
+	// spike-x (entropy 3), spike-y (entropy 1) -> decision-a (confidence 0.9, gate 0.75)
+	// spike-y, spike-z (entropy 0) -> decision-b (confidence 0.5, gate 0.5)
+	// decision-a, decision-b -> action-1 -> workflow-1 -> output-1
+	spike := func(id string, entropy float64) {
+		client.SpikeEvent.Create().
+			SetID(id).
+			SetTimestamp(base).
+			SetPopulationID("pop").
+			SetNeuronIndices([]int{1}).
+			SetPatternHash("hash-" + id).
+			SetEntropy(entropy).
+			ExecX(ctx)
+	}
+	spike("spike-x", 3)
+	spike("spike-y", 1)
+	spike("spike-z", 0)
+	decision := func(id string, confidence, gate float64, spikes ...string) {
+		client.RoutingDecision.Create().
+			SetID(id).
+			SetTimestamp(base.Add(time.Second)).
+			SetInferenceID("inference").
+			SetDecisionType(routingdecision.DecisionTypeRoute).
+			SetConfidence(confidence).
+			SetGateProbability(gate).
+			AddSpikeEventIDs(spikes...).
+			ExecX(ctx)
+	}
+	decision("decision-a", 0.9, 0.75, "spike-x", "spike-y")
+	decision("decision-b", 0.5, 0.5, "spike-y", "spike-z")
+	client.AgentAction.Create().
+		SetID("action-1").
+		SetAgentID("aria-1").
+		SetAgentType("aria").
+		SetActionType("execute").
+		AddDecisionIDs("decision-a", "decision-b").
+		ExecX(ctx)
+	client.WorkflowExecution.Create().
+		SetID("workflow-1").
+		SetWorkflowID("wf").
+		AddActionIDs("action-1").
+		ExecX(ctx)
+	client.ExternalOutput.Create().
+		SetID("output-1").
+		SetOutputType(externaloutput.OutputTypeDocument).
+		SetContentHash("sha256:abc").
+		AddWorkflowIDs("workflow-1").
+		ExecX(ctx)
+
+	a, err := queries.NewCausalQueryService(client).Attribute(ctx, "output-1")
+	require.NoError(t, err)
+	require.Equal(t, "output-1", a.OutputID)
+
+	// decision-a weighs 0.9*0.75, and decision-b 0.5*0.5.
+	da, db := 0.675/0.925, 0.25/0.925
+	require.Len(t, a.RoutingDecisions, 2)
+	require.Equal(t, "decision-a", a.RoutingDecisions[0].ID)
+	require.Equal(t, 3, a.RoutingDecisions[0].Depth)
+	require.InDelta(t, da, a.RoutingDecisions[0].Score, 1e-9)
+	require.Equal(t, "decision-b", a.RoutingDecisions[1].ID)
+	require.InDelta(t, db, a.RoutingDecisions[1].Score, 1e-9)
+
+	// Spike events weigh 1 + their entropy.
+	require.Len(t, a.SpikeEvents, 3)
+	for i, expected := range []struct {
+		id    string
+		score float64
+	}{
+		{"spike-x", da * 4 / 6},
+		{"spike-y", da*2/6 + db*2/3},
+		{"spike-z", db * 1 / 3},
+	} {
+		require.Equal(t, expected.id, a.SpikeEvents[i].ID)
+		require.Equal(t, queries.NodeTypeSpikeEvent, a.SpikeEvents[i].Type)
+		require.InDelta(t, expected.score, a.SpikeEvents[i].Score, 1e-9)
+	}
+}
+
+func TestAttribute_Unrecorded(t *testing.T) {
+	ctx := context.Background()
+	client := causaltest.Open(t)
+	seedNested(ctx, t, client)
+	svc := queries.NewCausalQueryService(client)
+
+	// Without gate probabilities and entropies, spike events contribute equally.
+	a, err := svc.Attribute(ctx, "output-nested")
+	require.NoError(t, err)
+	require.Len(t, a.RoutingDecisions, 1)
+	require.Equal(t, 1.0, a.RoutingDecisions[0].Score)
+	require.Len(t, a.SpikeEvents, 2)
+	require.Equal(t, "spike-1", a.SpikeEvents[0].ID)
+	require.Equal(t, 0.5, a.SpikeEvents[0].Score)
+	require.Equal(t, 0.5, a.SpikeEvents[1].Score)
+
+	// Outputs of cyclic workflows have no contributions.
+	a, err = svc.Attribute(ctx, "output-cycle")
+	require.NoError(t, err)
+	require.Empty(t, a.SpikeEvents)
+	require.Empty(t, a.RoutingDecisions)
+
+	_, err = svc.Attribute(ctx, "missing")
+	require.Error(t, err)
+}
@@ -9,26 +9,56 @@ import (
 	"fmt"
 	"time"
 
-	"entgo.io/ent/dialect/sql"
+	"entgo.io/contrib/entcausal/ent"
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+)
+
+// Node types of the causal graph.
+const (
+	NodeTypeExternalOutput    = "external_output"
+	NodeTypeWorkflowExecution = "workflow_execution"
+	NodeTypeAgentAction       = "agent_action"
+	NodeTypeRoutingDecision   = "routing_decision"
+	NodeTypeSpikeEvent        = "spike_event"
+)
+
+// Edge types of the causal graph. An edge type is named after the
+// ent edge that points from the cause (source) to the effect (target).
+const (
+	EdgeTypeOutputs   = "outputs"
+	EdgeTypeWorkflows = "workflows"
+	EdgeTypeActions   = "actions"
+	EdgeTypeDecisions = "decisions"
+	// EdgeTypeChildExecutions links a parent workflow execution to a nested one.
+	EdgeTypeChildExecutions = "child_executions"
 )
 
 // CausalPath represents a path through the causal graph.
+// OutputID is set by backward traces, and SourceID by impact traces.
+// TotalLatencyMs is the summed duration of the nodes on the critical path.
 type CausalPath struct {
-	OutputID       string           `json:"output_id"`
-	Nodes          []CausalNode     `json:"nodes"`
-	Edges          []CausalEdge     `json:"edges"`
-	Depth          int              `json:"depth"`
-	TotalLatencyMs float64          `json:"total_latency_ms"`
-	TracedAt       time.Time        `json:"traced_at"`
+	OutputID       string       `json:"output_id"`
+	SourceID       string       `json:"source_id,omitempty"`
+	Nodes          []CausalNode `json:"nodes"`
+	Edges          []CausalEdge `json:"edges"`
+	Depth          int          `json:"depth"`
+	TotalLatencyMs float64      `json:"total_latency_ms"`
+	TracedAt       time.Time    `json:"traced_at"`
 }
 
-// CausalNode represents a node in the causal path.
+// CausalNode represents a node in the causal path. DurationMs is the
+// execution time of workflow executions and agent actions, and is zero
+// for instantaneous nodes, such as spike events and routing decisions.
 type CausalNode struct {
-	ID        string                 `json:"id"`
-	Type      string                 `json:"type"`
-	Timestamp time.Time              `json:"timestamp"`
-	Depth     int                    `json:"depth"`
-	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+	ID         string                 `json:"id"`
+	Type       string                 `json:"type"`
+	Timestamp  time.Time              `json:"timestamp"`
+	Depth      int                    `json:"depth"`
+	DurationMs float64                `json:"duration_ms,omitempty"`
+	Metadata   map[string]interface{} `json:"metadata,omitempty"`
 }
 
 // CausalEdge represents an edge in the causal path.
@@ -54,24 +84,22 @@ type EmergentPatternResult struct {
 
 // AgentDecisionPath represents the full path of an agent's decision.
 type AgentDecisionPath struct {
-	AgentID      string       `json:"agent_id"`
-	ActionID     string       `json:"action_id"`
-	SpikeEvents  []CausalNode `json:"spike_events"`
-	Decisions    []CausalNode `json:"decisions"`
-	Workflows    []CausalNode `json:"workflows"`
-	Outputs      []CausalNode `json:"outputs"`
-	TotalDepth   int          `json:"total_depth"`
+	AgentID     string       `json:"agent_id"`
+	ActionID    string       `json:"action_id"`
+	SpikeEvents []CausalNode `json:"spike_events"`
+	Decisions   []CausalNode `json:"decisions"`
+	Workflows   []CausalNode `json:"workflows"`
+	Outputs     []CausalNode `json:"outputs"`
+	TotalDepth  int          `json:"total_depth"`
 }
 
 // CausalQueryService provides causal graph query operations.
 type CausalQueryService struct {
-	// client would be the generated ent.Client
-	// For this example, we use interface{} as placeholder
-	client interface{}
+	client *ent.Client
 }
 
 // NewCausalQueryService creates a new causal query service.
-func NewCausalQueryService(client interface{}) *CausalQueryService {
+func NewCausalQueryService(client *ent.Client) *CausalQueryService {
 	return &CausalQueryService{client: client}
 }
 
@@ -79,6 +107,7 @@ func NewCausalQueryService(client interface{}) *CausalQueryService {
 //
 // This performs a breadth-first traversal of the causal graph, following
 // edges backwards from the output to find all contributing spike events.
+// An error is returned if the output does not exist.
 //
 // Example:
 //
@@ -93,119 +122,142 @@ func (s *CausalQueryService) TraceCausality(
 		maxDepth = 100
 	}
 
+	output, err := s.client.ExternalOutput.Get(ctx, outputID)
+	if err != nil {
+		return nil, fmt.Errorf("entcausal: loading output %q: %w", outputID, err)
+	}
+
 	path := &CausalPath{
 		OutputID: outputID,
 		Nodes:    make([]CausalNode, 0),
 		Edges:    make([]CausalEdge, 0),
 		TracedAt: time.Now(),
 	}
+	if err := s.traverse(ctx, path, []CausalNode{outputNode(output, 0)}, maxDepth, s.getParentNodes); err != nil {
+		return nil, err
+	}
+	path.TotalLatencyMs = path.criticalLatency()
+	return path, nil
+}
 
-	// Start with the output node
+// traverse performs a breadth-first traversal of the causal graph starting
+// from the given roots, and appends the visited nodes and edges to the path.
+// The next function returns the neighbors of a node in the traversal direction.
+func (s *CausalQueryService) traverse(
+	ctx context.Context,
+	path *CausalPath,
+	roots []CausalNode,
+	maxDepth int,
+	next func(context.Context, CausalNode) ([]CausalNode, []CausalEdge, error),
+) error {
+	// IDs are only unique per table, so nodes are keyed by their type and ID.
 	visited := make(map[string]bool)
-	queue := []struct {
-		id       string
-		nodeType string
-		depth    int
-	}{{outputID, "external_output", 0}}
+	queue := roots
 
-	for len(queue) > 0 && path.Depth <= maxDepth {
+	for len(queue) > 0 {
 		current := queue[0]
 		queue = queue[1:]
 
-		if visited[current.id] {
+		if visited[nodeKey(current)] {
 			continue
 		}
-		visited[current.id] = true
+		visited[nodeKey(current)] = true
 
 		// Add node to path
-		node := CausalNode{
-			ID:        current.id,
-			Type:      current.nodeType,
-			Timestamp: time.Now(), // Would be fetched from DB
-			Depth:     current.depth,
+		path.Nodes = append(path.Nodes, current)
+		path.Depth = max(path.Depth, current.Depth)
+		if current.Depth >= maxDepth {
+			continue
 		}
-		path.Nodes = append(path.Nodes, node)
-		path.Depth = max(path.Depth, current.depth)
 
-		// Get parent nodes based on type
-		parents, edges := s.getParentNodes(ctx, current.id, current.nodeType)
-		for _, edge := range edges {
-			path.Edges = append(path.Edges, edge)
+		neighbors, edges, err := next(ctx, current)
+		if err != nil {
+			return err
 		}
-		for _, parent := range parents {
-			if !visited[parent.ID] {
-				queue = append(queue, struct {
-					id       string
-					nodeType string
-					depth    int
-				}{parent.ID, parent.Type, current.depth + 1})
+		path.Edges = append(path.Edges, edges...)
+		for _, n := range neighbors {
+			if !visited[nodeKey(n)] {
+				queue = append(queue, n)
 			}
 		}
 	}
-
-	return path, nil
+	return nil
 }
 
-// getParentNodes returns parent nodes for a given node.
-// This is a placeholder - actual implementation would query the database.
+// getParentNodes returns the direct causes of the given node, along with
+// the edges pointing from each cause to the node.
 func (s *CausalQueryService) getParentNodes(
 	ctx context.Context,
-	nodeID string,
-	nodeType string,
-) ([]CausalNode, []CausalEdge) {
-	// In actual implementation, this would query the ent client
-	// based on the node type to find parent edges
-	//
-	// For example:
-	// switch nodeType {
-	// case "external_output":
-	//     workflows := client.ExternalOutput.Query().
-	//         Where(externaloutput.ID(nodeID)).
-	//         QueryWorkflows().
-	//         AllX(ctx)
-	// case "workflow":
-	//     actions := client.WorkflowExecution.Query().
-	//         Where(workflowexecution.ID(nodeID)).
-	//         QueryActions().
-	//         AllX(ctx)
-	// ...
-	// }
-
-	return nil, nil
-}
-
-// FindEmergentPatterns finds emergent spike patterns in a time range.
-//
-// Emergent patterns are groups of neurons that fire together more
-// frequently than expected by chance.
-func (s *CausalQueryService) FindEmergentPatterns(
-	ctx context.Context,
-	startTime time.Time,
-	endTime time.Time,
-	minOccurrences int,
-) ([]EmergentPatternResult, error) {
-	if minOccurrences <= 0 {
-		minOccurrences = 5
+	node CausalNode,
+) ([]CausalNode, []CausalEdge, error) {
+	var (
+		parents []CausalNode
+		edges   []CausalEdge
+		depth   = node.Depth + 1
+	)
+	switch node.Type {
+	case NodeTypeExternalOutput:
+		workflows, err := s.client.ExternalOutput.Query().
+			Where(externaloutput.ID(node.ID)).
+			QueryWorkflows().
+			All(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("entcausal: querying workflows of output %q: %w", node.ID, err)
+		}
+		for _, w := range workflows {
+			parent := workflowNode(w, depth)
+			parents = append(parents, parent)
+			edges = append(edges, newCausalEdge(parent, node, EdgeTypeOutputs, 1))
+		}
+	case NodeTypeWorkflowExecution:
+		workflow, err := s.client.WorkflowExecution.Query().
+			Where(workflowexecution.ID(node.ID)).
+			WithActions().
+			WithParentExecution().
+			Only(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("entcausal: querying actions of workflow execution %q: %w", node.ID, err)
+		}
+		for _, a := range workflow.Edges.Actions {
+			parent := actionNode(a, depth)
+			parents = append(parents, parent)
+			edges = append(edges, newCausalEdge(parent, node, EdgeTypeWorkflows, 1))
+		}
+		// Nested workflows are traced up to their parent execution, whose
+		// actions are causes of the nested one. Cycles are cut by traverse.
+		if w := workflow.Edges.ParentExecution; w != nil {
+			parent := workflowNode(w, depth)
+			parents = append(parents, parent)
+			edges = append(edges, newCausalEdge(parent, node, EdgeTypeChildExecutions, 1))
+		}
+	case NodeTypeAgentAction:
+		decisions, err := s.client.AgentAction.Query().
+			Where(agentaction.ID(node.ID)).
+			QueryDecisions().
+			All(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("entcausal: querying decisions of agent action %q: %w", node.ID, err)
+		}
+		for _, d := range decisions {
+			parent := decisionNode(d, depth)
+			parents = append(parents, parent)
+			edges = append(edges, newCausalEdge(parent, node, EdgeTypeActions, d.Confidence))
+		}
+	case NodeTypeRoutingDecision:
+		decision, err := s.client.RoutingDecision.Query().
+			Where(routingdecision.ID(node.ID)).
+			WithSpikeEvents().
+			Only(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("entcausal: querying spike events of routing decision %q: %w", node.ID, err)
+		}
+		for _, e := range decision.Edges.SpikeEvents {
+			parent := spikeNode(e, depth)
+			parents = append(parents, parent)
+			edges = append(edges, newCausalEdge(parent, node, EdgeTypeDecisions, decision.Confidence))
+		}
 	}
-
-	// In actual implementation, this would:
-	// 1. Query spike events in the time range
-	// 2. Group by pattern_hash
-	// 3. Filter by occurrence count
-	// 4. Calculate significance scores
-	//
-	// Example query:
-	// SELECT pattern_hash, COUNT(*) as count, 
-	//        MIN(timestamp) as first_seen, MAX(timestamp) as last_seen,
-	//        population_id, neuron_indices
-	// FROM spike_events
-	// WHERE timestamp BETWEEN ? AND ?
-	// GROUP BY pattern_hash
-	// HAVING COUNT(*) >= ?
-	// ORDER BY COUNT(*) DESC
-
-	results := make([]EmergentPatternResult, 0)
-	return results, nil
+	return parents, edges, nil
 }
 
 // GetAgentDecisionPath gets the full provenance path for an agent action.
@@ -297,7 +349,7 @@ func (s *CausalQueryService) buildInferencePath(
 func (p *CausalPath) GetSpikeEvents() []CausalNode {
 	events := make([]CausalNode, 0)
 	for _, node := range p.Nodes {
-		if node.Type == "spike_event" {
+		if node.Type == NodeTypeSpikeEvent {
 			events = append(events, node)
 		}
 	}
@@ -308,13 +360,24 @@ func (p *CausalPath) GetSpikeEvents() []CausalNode {
 func (p *CausalPath) GetDecisions() []CausalNode {
 	decisions := make([]CausalNode, 0)
 	for _, node := range p.Nodes {
-		if node.Type == "routing_decision" {
+		if node.Type == NodeTypeRoutingDecision {
 			decisions = append(decisions, node)
 		}
 	}
 	return decisions
 }
 
+// GetOutputs returns all external output nodes from a CausalPath.
+func (p *CausalPath) GetOutputs() []CausalNode {
+	outputs := make([]CausalNode, 0)
+	for _, node := range p.Nodes {
+		if node.Type == NodeTypeExternalOutput {
+			outputs = append(outputs, node)
+		}
+	}
+	return outputs
+}
+
 // CountByType returns counts of nodes by type.
 func (p *CausalPath) CountByType() map[string]int {
 	counts := make(map[string]int)
@@ -333,52 +396,74 @@ func max(a, b int) int {
 	return b
 }
 
-// SQL query helpers for raw queries if needed
-
-// TraceCausalitySQL returns the SQL for tracing causality.
-// This can be used with raw SQL queries for performance.
-func TraceCausalitySQL(outputID string, maxDepth int) string {
-	return fmt.Sprintf(`
-		WITH RECURSIVE causal_chain AS (
-			-- Base case: start with the output
-			SELECT 
-				id, 
-				'external_output' as node_type,
-				0 as depth
-			FROM external_outputs
-			WHERE id = '%s'
-			
-			UNION ALL
-			
-			-- Recursive case: follow edges backwards
-			SELECT 
-				CASE 
-					WHEN cc.node_type = 'external_output' THEN we.id
-					WHEN cc.node_type = 'workflow_execution' THEN aa.id
-					WHEN cc.node_type = 'agent_action' THEN rd.id
-					WHEN cc.node_type = 'routing_decision' THEN se.id
-				END as id,
-				CASE 
-					WHEN cc.node_type = 'external_output' THEN 'workflow_execution'
-					WHEN cc.node_type = 'workflow_execution' THEN 'agent_action'
-					WHEN cc.node_type = 'agent_action' THEN 'routing_decision'
-					WHEN cc.node_type = 'routing_decision' THEN 'spike_event'
-				END as node_type,
-				cc.depth + 1 as depth
-			FROM causal_chain cc
-			LEFT JOIN workflow_execution_outputs weo ON cc.id = weo.external_output_id
-			LEFT JOIN workflow_executions we ON weo.workflow_execution_id = we.id
-			LEFT JOIN agent_action_workflows aaw ON we.id = aaw.workflow_execution_id
-			LEFT JOIN agent_actions aa ON aaw.agent_action_id = aa.id
-			LEFT JOIN routing_decision_actions rda ON aa.id = rda.agent_action_id
-			LEFT JOIN routing_decisions rd ON rda.routing_decision_id = rd.id
-			LEFT JOIN spike_event_decisions sed ON rd.id = sed.routing_decision_id
-			LEFT JOIN spike_events se ON sed.spike_event_id = se.id
-			WHERE cc.depth < %d
-		)
-		SELECT DISTINCT id, node_type, depth
-		FROM causal_chain
-		WHERE id IS NOT NULL
-		ORDER BY depth, node_type
-	`, outputID, maxDepth)
+// nodeKey returns the key used to track visited nodes.
+func nodeKey(n CausalNode) string {
+	return n.Type + ":" + n.ID
+}
+
+func newCausalEdge(source, target CausalNode, edgeType string, confidence float64) CausalEdge {
+	return CausalEdge{
+		SourceID:   source.ID,
+		SourceType: source.Type,
+		TargetID:   target.ID,
+		TargetType: target.Type,
+		EdgeType:   edgeType,
+		Confidence: confidence,
+	}
+}
+
+func outputNode(o *ent.ExternalOutput, depth int) CausalNode {
+	return CausalNode{
+		ID:        o.ID,
+		Type:      NodeTypeExternalOutput,
+		Timestamp: o.Timestamp,
+		Depth:     depth,
+		Metadata:  o.Metadata,
+	}
+}
+
+func workflowNode(w *ent.WorkflowExecution, depth int) CausalNode {
+	n := CausalNode{
+		ID:         w.ID,
+		Type:       NodeTypeWorkflowExecution,
+		Timestamp:  w.StartedAt,
+		Depth:      depth,
+		DurationMs: w.DurationMs,
+		Metadata:   w.Metadata,
+	}
+	if w.CompletedAt != nil {
+		n.DurationMs = float64(w.CompletedAt.Sub(w.StartedAt)) / float64(time.Millisecond)
+	}
+	return n
+}
+
+func actionNode(a *ent.AgentAction, depth int) CausalNode {
+	return CausalNode{
+		ID:         a.ID,
+		Type:       NodeTypeAgentAction,
+		Timestamp:  a.Timestamp,
+		Depth:      depth,
+		DurationMs: a.LatencyMs,
+		Metadata:   a.Metadata,
+	}
+}
+
+func decisionNode(d *ent.RoutingDecision, depth int) CausalNode {
+	return CausalNode{
+		ID:        d.ID,
+		Type:      NodeTypeRoutingDecision,
+		Timestamp: d.Timestamp,
+		Depth:     depth,
+		Metadata:  d.Metadata,
+	}
+}
+
+func spikeNode(e *ent.SpikeEvent, depth int) CausalNode {
+	return CausalNode{
+		ID:        e.ID,
+		Type:      NodeTypeSpikeEvent,
+		Timestamp: e.Timestamp,
+		Depth:     depth,
+		Metadata:  e.Metadata,
+	}
 }
@@ -0,0 +1,154 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent"
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/internal/causaltest"
+	"entgo.io/contrib/entcausal/queries"
+	"github.com/stretchr/testify/require"
+)
+
+var base = causaltest.Base
+
+func TestTraceCausality(t *testing.T) {
+	ctx := context.Background()
+	client := causaltest.Open(t)
+	causaltest.SeedChain(ctx, t, client)
+
+	path, err := queries.NewCausalQueryService(client).TraceCausality(ctx, "output-1", 0)
+	require.NoError(t, err)
+	require.Equal(t, "output-1", path.OutputID)
+	require.Equal(t, 4, path.Depth)
+	require.Len(t, path.Nodes, 6)
+	require.Len(t, path.Edges, 5)
+	require.Equal(t, map[string]int{
+		queries.NodeTypeExternalOutput:    1,
+		queries.NodeTypeWorkflowExecution: 1,
+		queries.NodeTypeAgentAction:       1,
+		queries.NodeTypeRoutingDecision:   1,
+		queries.NodeTypeSpikeEvent:        2,
+	}, path.CountByType())
+
+	output := path.Nodes[0]
+	require.Equal(t, queries.NodeTypeExternalOutput, output.Type)
+	require.True(t, output.Timestamp.Equal(base.Add(4*time.Second)))
+	require.Equal(t, "report", output.Metadata["title"])
+
+	spikes := path.GetSpikeEvents()
+	require.Len(t, spikes, 2)
+	for _, n := range spikes {
+		require.Equal(t, 4, n.Depth)
+		if n.ID == "spike-1" {
+			require.True(t, n.Timestamp.Equal(base))
+			require.Equal(t, "v1", n.Metadata["region"])
+		}
+	}
+
+	for _, e := range path.Edges {
+		switch e.EdgeType {
+		case queries.EdgeTypeActions, queries.EdgeTypeDecisions:
+			require.Equal(t, 0.8, e.Confidence)
+		default:
+			require.Equal(t, 1.0, e.Confidence)
+		}
+	}
+	require.Contains(t, path.Edges, queries.CausalEdge{
+		SourceID:   "decision-1",
+		SourceType: queries.NodeTypeRoutingDecision,
+		TargetID:   "action-1",
+		TargetType: queries.NodeTypeAgentAction,
+		EdgeType:   queries.EdgeTypeActions,
+		Confidence: 0.8,
+	})
+}
+
+func TestTraceCausality_MaxDepth(t *testing.T) {
+	ctx := context.Background()
+	client := causaltest.Open(t)
+	causaltest.SeedChain(ctx, t, client)
+
+	path, err := queries.NewCausalQueryService(client).TraceCausality(ctx, "output-1", 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, path.Depth)
+	require.Len(t, path.Nodes, 3)
+	require.Len(t, path.Edges, 2)
+	require.Empty(t, path.GetDecisions())
+}
+
+func TestTraceCausality_NotFound(t *testing.T) {
+	ctx := context.Background()
+	client := causaltest.Open(t)
+
+	_, err := queries.NewCausalQueryService(client).TraceCausality(ctx, "missing", 0)
+	require.Error(t, err)
+	require.True(t, ent.IsNotFound(err))
+}
+
+// seedNested extends the chain created by causaltest.SeedChain with a nested workflow,
+// and with two workflows that are each other's parent:
+//
+//	workflow-1 -> workflow-child -> output-nested
+//	workflow-x <-> workflow-y -> output-cycle
+func seedNested(ctx context.Context, t *testing.T, client *ent.Client) {
+	t.Helper()
+	causaltest.SeedChain(ctx, t, client)
+	child := client.WorkflowExecution.Create().
+		SetID("workflow-child").
+		SetWorkflowID("sub-wf").
+		SetParentExecutionID("workflow-1").
+		SaveX(ctx)
+	client.ExternalOutput.Create().
+		SetID("output-nested").
+		SetOutputType(externaloutput.OutputTypeFile).
+		SetContentHash("sha256:nested").
+		AddWorkflows(child).
+		SaveX(ctx)
+	x := client.WorkflowExecution.Create().
+		SetID("workflow-x").
+		SetWorkflowID("wf").
+		SaveX(ctx)
+	y := client.WorkflowExecution.Create().
+		SetID("workflow-y").
+		SetWorkflowID("wf").
+		SetParentExecution(x).
+		SaveX(ctx)
+	x.Update().SetParentExecution(y).ExecX(ctx)
+	client.ExternalOutput.Create().
+		SetID("output-cycle").
+		SetOutputType(externaloutput.OutputTypeFile).
+		SetContentHash("sha256:cycle").
+		AddWorkflows(y).
+		SaveX(ctx)
+}
+
+func TestTraceCausality_NestedWorkflows(t *testing.T) {
+	ctx := context.Background()
+	client := causaltest.Open(t)
+	seedNested(ctx, t, client)
+	svc := queries.NewCausalQueryService(client)
+
+	path, err := svc.TraceCausality(ctx, "output-nested", 0)
+	require.NoError(t, err)
+	require.Equal(t, 5, path.Depth)
+	require.Len(t, path.GetSpikeEvents(), 2)
+	require.Equal(t, 2, path.CountByType()[queries.NodeTypeWorkflowExecution])
+	require.Contains(t, path.Edges, queries.CausalEdge{
+		SourceID:   "workflow-1",
+		SourceType: queries.NodeTypeWorkflowExecution,
+		TargetID:   "workflow-child",
+		TargetType: queries.NodeTypeWorkflowExecution,
+		EdgeType:   queries.EdgeTypeChildExecutions,
+		Confidence: 1,
+	})
+
+	// Each workflow of the cycle is visited once, and the
+	// edge closing the cycle is reported without following it.
+	path, err = svc.TraceCausality(ctx, "output-cycle", 0)
+	require.NoError(t, err)
+	require.Len(t, path.Nodes, 3)
+	require.Len(t, path.Edges, 3)
+}
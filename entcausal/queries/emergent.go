@@ -0,0 +1,235 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/contrib/entcausal/ent/spikeevent"
+)
+
+// FindEmergentPatterns finds emergent spike patterns in a time range.
+//
+// Emergent patterns are groups of neurons that fire together more
+// frequently than expected by chance. Spike events are grouped by the
+// (population, pattern hash) pair, so the same hash in two populations
+// yields two patterns, each scored against the baseline co-firing rate of
+// its neurons within its own population: assuming neurons of a population
+// fire independently, a pattern is expected to occur N·∏pᵢ times, where N
+// is the number of events of the population and pᵢ the fraction of those
+// events in which neuron i fired. The Significance of a pattern is the
+// surprise -log10(P) of observing at least its occurrence count under a
+// Poisson distribution with that expectation, and results are ranked by it.
+func (s *CausalQueryService) FindEmergentPatterns(
+	ctx context.Context,
+	startTime time.Time,
+	endTime time.Time,
+	minOccurrences int,
+) ([]EmergentPatternResult, error) {
+	if minOccurrences <= 0 {
+		minOccurrences = 5
+	}
+
+	events, err := s.client.SpikeEvent.Query().
+		Where(
+			spikeevent.TimestampGTE(startTime),
+			spikeevent.TimestampLTE(endTime),
+		).
+		Select(
+			spikeevent.FieldTimestamp,
+			spikeevent.FieldPopulationID,
+			spikeevent.FieldNeuronIndices,
+			spikeevent.FieldPatternHash,
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("entcausal: querying spike events: %w", err)
+	}
+
+	// The same hash may fire in several populations, and each
+	// occurrence is scored against the baseline of its own population.
+	type patternKey struct{ population, hash string }
+	var (
+		populations = make(map[string]*populationStats)
+		patterns    = make(map[patternKey]*EmergentPatternResult)
+		neurons     = make(map[patternKey]map[int]struct{})
+	)
+	for _, e := range events {
+		pop, ok := populations[e.PopulationID]
+		if !ok {
+			pop = &populationStats{fired: make(map[int]int)}
+			populations[e.PopulationID] = pop
+		}
+		pop.events++
+		for _, i := range unique(e.NeuronIndices) {
+			pop.fired[i]++
+		}
+		k := patternKey{population: e.PopulationID, hash: e.PatternHash}
+		p, ok := patterns[k]
+		if !ok {
+			p = &EmergentPatternResult{
+				PatternHash:  e.PatternHash,
+				PopulationID: e.PopulationID,
+				FirstSeen:    e.Timestamp,
+				LastSeen:     e.Timestamp,
+			}
+			patterns[k] = p
+			neurons[k] = make(map[int]struct{})
+		}
+		p.OccurrenceCount++
+		if e.Timestamp.Before(p.FirstSeen) {
+			p.FirstSeen = e.Timestamp
+		}
+		if e.Timestamp.After(p.LastSeen) {
+			p.LastSeen = e.Timestamp
+		}
+		for _, i := range e.NeuronIndices {
+			neurons[k][i] = struct{}{}
+		}
+	}
+
+	results := make([]EmergentPatternResult, 0)
+	for k, p := range patterns {
+		if p.OccurrenceCount < minOccurrences {
+			continue
+		}
+		p.NeuronIndices = make([]int, 0, len(neurons[k]))
+		for i := range neurons[k] {
+			p.NeuronIndices = append(p.NeuronIndices, i)
+		}
+		sort.Ints(p.NeuronIndices)
+		logExpected := populations[p.PopulationID].logExpected(p.NeuronIndices)
+		p.Significance = poissonSurprise(p.OccurrenceCount, logExpected)
+		results = append(results, *p)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.Significance != b.Significance {
+			return a.Significance > b.Significance
+		}
+		if a.OccurrenceCount != b.OccurrenceCount {
+			return a.OccurrenceCount > b.OccurrenceCount
+		}
+		if a.PatternHash != b.PatternHash {
+			return a.PatternHash < b.PatternHash
+		}
+		return a.PopulationID < b.PopulationID
+	})
+	return results, nil
+}
+
+// MarkEmergentPatterns sets is_emergent on all spike events in the time range
+// that match the population and the hash of one of the given patterns, and
+// returns the number of updated events.
+//
+//	patterns, err := service.FindEmergentPatterns(ctx, start, end, 5)
+//	if err != nil {
+//		return err
+//	}
+//	// Mark patterns with P(X >= k) < 0.001.
+//	i := sort.Search(len(patterns), func(i int) bool { return patterns[i].Significance < 3 })
+//	n, err := service.MarkEmergentPatterns(ctx, start, end, patterns[:i])
+func (s *CausalQueryService) MarkEmergentPatterns(
+	ctx context.Context,
+	startTime time.Time,
+	endTime time.Time,
+	patterns []EmergentPatternResult,
+) (int, error) {
+	if len(patterns) == 0 {
+		return 0, nil
+	}
+	matches := make([]predicate.SpikeEvent, len(patterns))
+	for i, p := range patterns {
+		matches[i] = spikeevent.And(
+			spikeevent.PopulationID(p.PopulationID),
+			spikeevent.PatternHash(p.PatternHash),
+		)
+	}
+	n, err := s.client.SpikeEvent.Update().
+		Where(
+			spikeevent.TimestampGTE(startTime),
+			spikeevent.TimestampLTE(endTime),
+			spikeevent.Or(matches...),
+			spikeevent.IsEmergent(false),
+		).
+		SetIsEmergent(true).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("entcausal: marking emergent spike events: %w", err)
+	}
+	return n, nil
+}
+
+// populationStats holds the firing statistics of a population within a window.
+type populationStats struct {
+	events int
+	fired  map[int]int
+}
+
+// logExpected returns the log of the expected number of events in which all
+// the given neurons fire together, assuming they fire independently. It is
+// computed as a sum of logs, as the product of the firing rates of large
+// neuron sets underflows float64.
+func (p *populationStats) logExpected(neurons []int) float64 {
+	n := math.Log(float64(p.events))
+	logExpected := n
+	for _, i := range neurons {
+		logExpected += math.Log(float64(p.fired[i])) - n
+	}
+	return logExpected
+}
+
+// poissonSurprise returns -log10(P(X >= k)) for X ~ Poisson(lambda),
+// given log(lambda). The result is finite for any k and lambda, as an
+// occurrence that is impossible under the baseline is clamped to the
+// largest float64 to keep it encodable in JSON.
+func poissonSurprise(k int, logLambda float64) float64 {
+	lambda := math.Exp(logLambda)
+	switch {
+	case k <= 0:
+		return 0
+	case math.IsInf(logLambda, -1) || math.IsNaN(logLambda):
+		return math.MaxFloat64
+	case float64(k) <= lambda:
+		// The tail holds most of the mass, so computing it
+		// as the complement of the CDF is accurate enough.
+		var cdf float64
+		for j := 0; j < k; j++ {
+			cdf += math.Exp(poissonLogPMF(j, logLambda))
+		}
+		return -math.Log10(math.Max(1-cdf, math.SmallestNonzeroFloat64))
+	}
+	// Sum the tail in log space, as it may underflow float64. Terms decrease
+	// monotonically for j > lambda, so stop once they become negligible.
+	logP := poissonLogPMF(k, logLambda)
+	for j := k + 1; ; j++ {
+		term := poissonLogPMF(j, logLambda)
+		if term-logP < -40 {
+			break
+		}
+		logP += math.Log1p(math.Exp(term - logP))
+	}
+	return -logP / math.Ln10
+}
+
+// poissonLogPMF returns log(P(X = k)) for X ~ Poisson(lambda), given log(lambda).
+func poissonLogPMF(k int, logLambda float64) float64 {
+	lg, _ := math.Lgamma(float64(k + 1))
+	return float64(k)*logLambda - math.Exp(logLambda) - lg
+}
+
+// unique returns the distinct values of s.
+func unique(s []int) []int {
+	seen := make(map[int]struct{}, len(s))
+	u := make([]int, 0, len(s))
+	for _, v := range s {
+		if _, ok := seen[v]; !ok {
+			seen[v] = struct{}{}
+			u = append(u, v)
+		}
+	}
+	return u
+}
@@ -0,0 +1,34 @@
+package queries
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoissonSurprise_LargePatterns(t *testing.T) {
+	// 64 neurons that each fired once in a million events are expected to
+	// co-fire 1e6·(1e-6)^64 = 1e-378 times, which underflows float64.
+	pop := &populationStats{events: 1_000_000, fired: make(map[int]int)}
+	neurons := make([]int, 64)
+	for i := range neurons {
+		neurons[i] = i
+		pop.fired[i] = 1
+	}
+	logExpected := pop.logExpected(neurons)
+	require.InDelta(t, -378*math.Ln10, logExpected, 1e-9)
+	s := poissonSurprise(1, logExpected)
+	require.False(t, math.IsInf(s, 0))
+	require.InDelta(t, 378, s, 1e-6)
+	_, err := json.Marshal(EmergentPatternResult{Significance: s})
+	require.NoError(t, err)
+
+	// A more frequent pattern is more surprising.
+	require.Greater(t, poissonSurprise(2, logExpected), s)
+	// An occurrence impossible under the baseline is clamped.
+	require.Equal(t, math.MaxFloat64, poissonSurprise(1, math.Inf(-1)))
+	// Small expectations match the direct computation.
+	require.InDelta(t, -math.Log10(1-math.Exp(-4)), poissonSurprise(1, math.Log(4)), 1e-9)
+}
@@ -0,0 +1,129 @@
+package queries_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent"
+	"entgo.io/contrib/entcausal/ent/spikeevent"
+	"entgo.io/contrib/entcausal/internal/causaltest"
+	"entgo.io/contrib/entcausal/queries"
+	"github.com/stretchr/testify/require"
+)
+
+// seedPatterns creates 100 spike events in a single population. Neurons 1
+// and 2 each fire in 20% of the events, so they are expected to co-fire in
+// 4 events, but they co-fire in 10. Neuron 3 fires alone in the remaining 70.
+func seedPatterns(ctx context.Context, t *testing.T, client *ent.Client) {
+	t.Helper()
+	var (
+		builders []*ent.SpikeEventCreate
+		patterns = []struct {
+			hash    string
+			neurons []int
+			count   int
+		}{
+			{"hash-co", []int{2, 1}, 10},
+			{"hash-1", []int{1}, 10},
+			{"hash-2", []int{2}, 10},
+			{"hash-3", []int{3}, 70},
+		}
+	)
+	for _, p := range patterns {
+		for i := 0; i < p.count; i++ {
+			builders = append(builders, client.SpikeEvent.Create().
+				SetID(fmt.Sprintf("%s-%d", p.hash, i)).
+				SetTimestamp(base.Add(time.Duration(len(builders))*time.Millisecond)).
+				SetPopulationID("pop").
+				SetNeuronIndices(p.neurons).
+				SetPatternHash(p.hash))
+		}
+	}
+	client.SpikeEvent.CreateBulk(builders...).ExecX(ctx)
+	// Outside of the queried window.
+	client.SpikeEvent.Create().
+		SetID("late").
+		SetTimestamp(base.Add(time.Hour)).
+		SetPopulationID("pop").
+		SetNeuronIndices([]int{1, 2}).
+		SetPatternHash("hash-co").
+		ExecX(ctx)
+	// The same hash in another population, where neurons 1 and 2 always
+	// co-fire, so it is expected there and must not use the baseline of "pop".
+	builders = builders[:0]
+	for i := 0; i < 10; i++ {
+		builders = append(builders, client.SpikeEvent.Create().
+			SetID(fmt.Sprintf("other-%d", i)).
+			SetTimestamp(base.Add(time.Duration(i)*time.Millisecond)).
+			SetPopulationID("other").
+			SetNeuronIndices([]int{1, 2}).
+			SetPatternHash("hash-co"))
+	}
+	client.SpikeEvent.CreateBulk(builders...).ExecX(ctx)
+}
+
+func TestFindEmergentPatterns(t *testing.T) {
+	ctx := context.Background()
+	client := causaltest.Open(t)
+	seedPatterns(ctx, t, client)
+	svc := queries.NewCausalQueryService(client)
+
+	patterns, err := svc.FindEmergentPatterns(ctx, base, base.Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, patterns, 5)
+
+	co := patterns[0]
+	require.Equal(t, "hash-co", co.PatternHash)
+	require.Equal(t, 10, co.OccurrenceCount)
+	require.Equal(t, []int{1, 2}, co.NeuronIndices)
+	require.Equal(t, "pop", co.PopulationID)
+	require.True(t, co.FirstSeen.Equal(base))
+	require.True(t, co.LastSeen.Equal(base.Add(9*time.Millisecond)))
+	require.InDelta(t, 2.0898, co.Significance, 1e-3)
+
+	// Ranked by significance rather than by raw count.
+	require.Equal(t, "hash-3", patterns[1].PatternHash)
+	require.InDelta(t, 0.2874, patterns[1].Significance, 1e-3)
+	// The same hash is scored against the baseline of each population.
+	other := patterns[2]
+	require.Equal(t, "hash-co", other.PatternHash)
+	require.Equal(t, "other", other.PopulationID)
+	require.Equal(t, 10, other.OccurrenceCount)
+	require.InDelta(t, 0.2659, other.Significance, 1e-3)
+	require.Less(t, patterns[3].Significance, 0.01)
+
+	patterns, err = svc.FindEmergentPatterns(ctx, base, base.Add(time.Minute), 11)
+	require.NoError(t, err)
+	require.Len(t, patterns, 1)
+	require.Equal(t, "hash-3", patterns[0].PatternHash)
+
+	patterns, err = svc.FindEmergentPatterns(ctx, base.Add(time.Minute), base.Add(2*time.Minute), 0)
+	require.NoError(t, err)
+	require.Empty(t, patterns)
+}
+
+func TestMarkEmergentPatterns(t *testing.T) {
+	ctx := context.Background()
+	client := causaltest.Open(t)
+	seedPatterns(ctx, t, client)
+	svc := queries.NewCausalQueryService(client)
+
+	end := base.Add(time.Minute)
+	patterns, err := svc.FindEmergentPatterns(ctx, base, end, 10)
+	require.NoError(t, err)
+	n, err := svc.MarkEmergentPatterns(ctx, base, end, patterns[:1])
+	require.NoError(t, err)
+	require.Equal(t, 10, n)
+
+	marked := client.SpikeEvent.Query().Where(spikeevent.IsEmergent(true)).IDsX(ctx)
+	require.Len(t, marked, 10)
+	require.NotContains(t, marked, "late")
+	// The same hash in another population is not marked.
+	require.NotContains(t, marked, "other-0")
+
+	n, err = svc.MarkEmergentPatterns(ctx, base, end, patterns[:1])
+	require.NoError(t, err)
+	require.Zero(t, n)
+}
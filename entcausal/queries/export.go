@@ -0,0 +1,358 @@
+package queries
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CriticalPath returns the chain of nodes, from cause to effect, with the
+// highest summed duration. Edges closing a cycle are ignored.
+//
+// If several chains have the highest duration, the one ending closest to
+// the effects is returned, and among chains ending at the same node, the
+// one through the cause listed first in the path's edges. The exporters
+// highlight all of these chains.
+func (p *CausalPath) CriticalPath() []CausalNode {
+	order, best, prev := p.critical()
+	nodes := make(map[string]CausalNode, len(order))
+	for _, n := range order {
+		nodes[nodeKey(n)] = n
+	}
+	var chain []CausalNode
+	for k := criticalEnd(order, best); k != ""; {
+		chain = append([]CausalNode{nodes[k]}, chain...)
+		if len(prev[k]) == 0 {
+			break
+		}
+		k = prev[k][0]
+	}
+	return chain
+}
+
+// criticalEnd returns the key of the last node of the critical path, or an
+// empty string if there are no nodes. Ties are broken in favor of the node
+// closest to the effects.
+func criticalEnd(order []CausalNode, best map[string]float64) string {
+	var end string
+	for i := len(order) - 1; i >= 0; i-- {
+		if k := nodeKey(order[i]); end == "" || best[k] > best[end] {
+			end = k
+		}
+	}
+	return end
+}
+
+// criticalLatency returns the summed duration of the critical path.
+func (p *CausalPath) criticalLatency() float64 {
+	var latency float64
+	for _, n := range p.CriticalPath() {
+		latency += n.DurationMs
+	}
+	return latency
+}
+
+// critical computes the longest path, by summed duration, ending at each node.
+// It returns the nodes ordered from causes to effects, the duration of the
+// longest path ending at each of them, and their predecessors on such paths,
+// in the order of the path's edges.
+func (p *CausalPath) critical() ([]CausalNode, map[string]float64, map[string][]string) {
+	nodes := make(map[string]CausalNode, len(p.Nodes))
+	for _, n := range p.Nodes {
+		nodes[nodeKey(n)] = n
+	}
+	// Backward traces discover causes at increasing depths, and impact
+	// traces discover effects at increasing depths. Causes are ranked
+	// first, and edges that go against the rank close a cycle.
+	rank := func(n CausalNode) int {
+		if p.OutputID != "" {
+			return -n.Depth
+		}
+		return n.Depth
+	}
+	order := make([]CausalNode, len(p.Nodes))
+	copy(order, p.Nodes)
+	sort.SliceStable(order, func(i, j int) bool {
+		return rank(order[i]) < rank(order[j])
+	})
+	causes := make(map[string][]string)
+	for _, e := range p.Edges {
+		src, dst := e.SourceType+":"+e.SourceID, e.TargetType+":"+e.TargetID
+		s, ok1 := nodes[src]
+		t, ok2 := nodes[dst]
+		if ok1 && ok2 && rank(s) < rank(t) {
+			causes[dst] = append(causes[dst], src)
+		}
+	}
+	best := make(map[string]float64, len(order))
+	prev := make(map[string][]string, len(order))
+	for _, n := range order {
+		k := nodeKey(n)
+		for _, c := range causes[k] {
+			v, ok := best[c]
+			switch {
+			case !ok:
+			case len(prev[k]) == 0 || v > best[k]:
+				best[k], prev[k] = v, []string{c}
+			case v == best[k] && !slices.Contains(prev[k], c):
+				prev[k] = append(prev[k], c)
+			}
+		}
+		best[k] += n.DurationMs
+	}
+	return order, best, prev
+}
+
+// onCritical returns the keys of the nodes and the edges on all chains
+// with the highest summed duration. Edges are keyed by their source and
+// target keys, joined by "->".
+func (p *CausalPath) onCritical() (nodes, edges map[string]bool) {
+	order, best, prev := p.critical()
+	nodes, edges = make(map[string]bool), make(map[string]bool)
+	end := criticalEnd(order, best)
+	if end == "" {
+		return nodes, edges
+	}
+	var stack []string
+	for _, n := range order {
+		if k := nodeKey(n); best[k] == best[end] {
+			stack = append(stack, k)
+		}
+	}
+	for len(stack) > 0 {
+		k := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if nodes[k] {
+			continue
+		}
+		nodes[k] = true
+		for _, c := range prev[k] {
+			edges[c+"->"+k] = true
+			stack = append(stack, c)
+		}
+	}
+	return nodes, edges
+}
+
+// WriteDOT writes the causal path as a Graphviz DOT digraph. Nodes and
+// edges on the critical paths, see CriticalPath, are highlighted.
+func (p *CausalPath) WriteDOT(w io.Writer) error {
+	var (
+		b              strings.Builder
+		critical, path = p.onCritical()
+	)
+	b.WriteString("digraph causal {\n")
+	b.WriteString("\trankdir=LR;\n")
+	b.WriteString("\tnode [shape=box, style=rounded];\n")
+	for _, n := range p.Nodes {
+		attrs := fmt.Sprintf("label=%s", dotQuote(nodeLabel(n, "\n")))
+		if critical[nodeKey(n)] {
+			attrs += ", color=red, penwidth=2"
+		}
+		fmt.Fprintf(&b, "\t%s [%s];\n", dotQuote(nodeKey(n)), attrs)
+	}
+	for _, e := range p.Edges {
+		src, dst := e.SourceType+":"+e.SourceID, e.TargetType+":"+e.TargetID
+		attrs := fmt.Sprintf("label=%s", dotQuote(edgeLabel(e)))
+		if path[src+"->"+dst] {
+			attrs += ", color=red, penwidth=2"
+		}
+		fmt.Fprintf(&b, "\t%s -> %s [%s];\n", dotQuote(src), dotQuote(dst), attrs)
+	}
+	b.WriteString("}\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteMermaid writes the causal path as a Mermaid flowchart. Nodes on
+// the critical paths are assigned the "critical" class.
+func (p *CausalPath) WriteMermaid(w io.Writer) error {
+	var (
+		b           strings.Builder
+		critical, _ = p.onCritical()
+		ids         = make(map[string]string, len(p.Nodes))
+		classed     []string
+	)
+	b.WriteString("flowchart LR\n")
+	for i, n := range p.Nodes {
+		id := "n" + strconv.Itoa(i)
+		ids[nodeKey(n)] = id
+		fmt.Fprintf(&b, "\t%s[\"%s\"]\n", id, mermaidEscape(nodeLabel(n, "<br/>")))
+		if critical[nodeKey(n)] {
+			classed = append(classed, id)
+		}
+	}
+	for _, e := range p.Edges {
+		src, ok1 := ids[e.SourceType+":"+e.SourceID]
+		dst, ok2 := ids[e.TargetType+":"+e.TargetID]
+		if ok1 && ok2 {
+			fmt.Fprintf(&b, "\t%s -->|\"%s\"| %s\n", src, mermaidEscape(edgeLabel(e)), dst)
+		}
+	}
+	if len(classed) > 0 {
+		b.WriteString("\tclassDef critical stroke:#d00,stroke-width:2px\n")
+		fmt.Fprintf(&b, "\tclass %s critical\n", strings.Join(classed, ","))
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteOTLP writes the causal path as OTLP-JSON trace data, as accepted by
+// the OpenTelemetry collector's OTLP/HTTP receiver and file exporter.
+//
+// Each node becomes a span starting at its timestamp and lasting its
+// duration. The parent of a span is the cause on the node's critical path,
+// and other causes are recorded as span links. Trace and span IDs are
+// derived from the path and node IDs, so exports are stable.
+func (p *CausalPath) WriteOTLP(w io.Writer) error {
+	_, best, prev := p.critical()
+	root := p.OutputID
+	if root == "" {
+		root = p.SourceID
+	}
+	traceID := hashID("trace:"+root, 16)
+	critical, _ := p.onCritical()
+	causes := make(map[string][]string)
+	for _, e := range p.Edges {
+		dst := e.TargetType + ":" + e.TargetID
+		causes[dst] = append(causes[dst], e.SourceType+":"+e.SourceID)
+	}
+	spans := make([]otlpSpan, 0, len(p.Nodes))
+	for _, n := range p.Nodes {
+		k := nodeKey(n)
+		start := n.Timestamp
+		end := start.Add(time.Duration(n.DurationMs * float64(time.Millisecond)))
+		span := otlpSpan{
+			TraceID:           traceID,
+			SpanID:            hashID(k, 8),
+			Name:              n.Type + " " + n.ID,
+			Kind:              1, // SPAN_KIND_INTERNAL
+			StartTimeUnixNano: strconv.FormatInt(start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(end.UnixNano(), 10),
+			Attributes: []otlpAttribute{
+				stringAttr("causal.node.id", n.ID),
+				stringAttr("causal.node.type", n.Type),
+				intAttr("causal.depth", n.Depth),
+				{Key: "causal.critical_path", Value: otlpValue{BoolValue: boolPtr(critical[k])}},
+			},
+		}
+		var parent string
+		if len(prev[k]) > 0 {
+			parent = prev[k][0]
+			span.ParentSpanID = hashID(parent, 8)
+		}
+		for _, c := range causes[k] {
+			if _, ok := best[c]; ok && c != parent {
+				span.Links = append(span.Links, otlpLink{TraceID: traceID, SpanID: hashID(c, 8)})
+			}
+		}
+		spans = append(spans, span)
+	}
+	return json.NewEncoder(w).Encode(otlpTraces{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: []otlpAttribute{stringAttr("service.name", "entcausal")},
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: "entgo.io/contrib/entcausal/queries"},
+				Spans: spans,
+			}},
+		}},
+	})
+}
+
+// OTLP-JSON types. See https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding.
+type (
+	otlpTraces struct {
+		ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+	}
+	otlpResourceSpans struct {
+		Resource   otlpResource     `json:"resource"`
+		ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+	}
+	otlpResource struct {
+		Attributes []otlpAttribute `json:"attributes"`
+	}
+	otlpScopeSpans struct {
+		Scope otlpScope  `json:"scope"`
+		Spans []otlpSpan `json:"spans"`
+	}
+	otlpScope struct {
+		Name string `json:"name"`
+	}
+	otlpSpan struct {
+		TraceID           string          `json:"traceId"`
+		SpanID            string          `json:"spanId"`
+		ParentSpanID      string          `json:"parentSpanId,omitempty"`
+		Name              string          `json:"name"`
+		Kind              int             `json:"kind"`
+		StartTimeUnixNano string          `json:"startTimeUnixNano"`
+		EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+		Attributes        []otlpAttribute `json:"attributes,omitempty"`
+		Links             []otlpLink      `json:"links,omitempty"`
+	}
+	otlpLink struct {
+		TraceID string `json:"traceId"`
+		SpanID  string `json:"spanId"`
+	}
+	otlpAttribute struct {
+		Key   string    `json:"key"`
+		Value otlpValue `json:"value"`
+	}
+	otlpValue struct {
+		StringValue *string `json:"stringValue,omitempty"`
+		IntValue    *string `json:"intValue,omitempty"`
+		BoolValue   *bool   `json:"boolValue,omitempty"`
+	}
+)
+
+func stringAttr(k, v string) otlpAttribute {
+	return otlpAttribute{Key: k, Value: otlpValue{StringValue: &v}}
+}
+
+// intAttr returns an integer attribute. OTLP-JSON encodes 64-bit integers as strings.
+func intAttr(k string, v int) otlpAttribute {
+	s := strconv.Itoa(v)
+	return otlpAttribute{Key: k, Value: otlpValue{IntValue: &s}}
+}
+
+func boolPtr(v bool) *bool {
+	return &v
+}
+
+// hashID returns a hex-encoded ID of n bytes derived from s.
+func hashID(s string, n int) string {
+	h := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(h[:n])
+}
+
+// nodeLabel returns the display label of a node, with lines joined by sep.
+func nodeLabel(n CausalNode, sep string) string {
+	label := n.Type + sep + n.ID
+	if n.DurationMs > 0 {
+		label += sep + strconv.FormatFloat(n.DurationMs, 'f', -1, 64) + "ms"
+	}
+	return label
+}
+
+func edgeLabel(e CausalEdge) string {
+	return fmt.Sprintf("%s (%.2f)", e.EdgeType, e.Confidence)
+}
+
+// dotQuote returns s as a DOT double-quoted string.
+func dotQuote(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+	return `"` + s + `"`
+}
+
+// mermaidEscape escapes s for use in a quoted Mermaid label.
+func mermaidEscape(s string) string {
+	return strings.ReplaceAll(s, `"`, "#quot;")
+}
@@ -0,0 +1,148 @@
+package queries_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entcausal/internal/causaltest"
+	"entgo.io/contrib/entcausal/queries"
+	"github.com/stretchr/testify/require"
+)
+
+func tracedPath(t *testing.T) *queries.CausalPath {
+	ctx := context.Background()
+	client := causaltest.Open(t)
+	causaltest.SeedChain(ctx, t, client)
+	client.AgentAction.UpdateOneID("action-1").SetLatencyMs(12.5).ExecX(ctx)
+	client.WorkflowExecution.UpdateOneID("workflow-1").SetCompletedAt(base.Add(3*time.Second + 250*time.Millisecond)).ExecX(ctx)
+	path, err := queries.NewCausalQueryService(client).TraceCausality(ctx, "output-1", 0)
+	require.NoError(t, err)
+	return path
+}
+
+func TestCausalPath_CriticalPath(t *testing.T) {
+	path := tracedPath(t)
+	require.Equal(t, 262.5, path.TotalLatencyMs)
+	chain := path.CriticalPath()
+	require.Len(t, chain, 5)
+	require.Equal(t, queries.NodeTypeSpikeEvent, chain[0].Type)
+	require.Equal(t, "decision-1", chain[1].ID)
+	require.Equal(t, "action-1", chain[2].ID)
+	require.Equal(t, 12.5, chain[2].DurationMs)
+	require.Equal(t, "workflow-1", chain[3].ID)
+	require.Equal(t, 250.0, chain[3].DurationMs)
+	require.Equal(t, "output-1", chain[4].ID)
+
+	// Impact traces rank causes by increasing depth.
+	impact := &queries.CausalPath{
+		SourceID: "spike-1",
+		Nodes: []queries.CausalNode{
+			{ID: "a", Type: queries.NodeTypeAgentAction, Depth: 0, DurationMs: 1},
+			{ID: "w1", Type: queries.NodeTypeWorkflowExecution, Depth: 1, DurationMs: 5},
+			{ID: "w2", Type: queries.NodeTypeWorkflowExecution, Depth: 1, DurationMs: 7},
+			{ID: "o", Type: queries.NodeTypeExternalOutput, Depth: 2},
+		},
+		Edges: []queries.CausalEdge{
+			{SourceID: "a", SourceType: queries.NodeTypeAgentAction, TargetID: "w1", TargetType: queries.NodeTypeWorkflowExecution},
+			{SourceID: "a", SourceType: queries.NodeTypeAgentAction, TargetID: "w2", TargetType: queries.NodeTypeWorkflowExecution},
+			{SourceID: "w1", SourceType: queries.NodeTypeWorkflowExecution, TargetID: "o", TargetType: queries.NodeTypeExternalOutput},
+			{SourceID: "w2", SourceType: queries.NodeTypeWorkflowExecution, TargetID: "o", TargetType: queries.NodeTypeExternalOutput},
+		},
+	}
+	var ids []string
+	for _, n := range impact.CriticalPath() {
+		ids = append(ids, n.ID)
+	}
+	require.Equal(t, []string{"a", "w2", "o"}, ids)
+	require.Empty(t, (&queries.CausalPath{}).CriticalPath())
+
+	// Equal-duration branches are all highlighted, and CriticalPath
+	// follows the cause listed first in the edges.
+	impact.Nodes[1].DurationMs = 7
+	ids = nil
+	for _, n := range impact.CriticalPath() {
+		ids = append(ids, n.ID)
+	}
+	require.Equal(t, []string{"a", "w1", "o"}, ids)
+	var b bytes.Buffer
+	require.NoError(t, impact.WriteDOT(&b))
+	for _, e := range []string{`"agent_action:a" -> "workflow_execution:w1"`, `"agent_action:a" -> "workflow_execution:w2"`, `"workflow_execution:w1" -> "external_output:o"`, `"workflow_execution:w2" -> "external_output:o"`} {
+		require.Regexp(t, regexp.QuoteMeta(e)+` \[label="[^"]*", color=red, penwidth=2\];`, b.String())
+	}
+}
+
+func TestCausalPath_WriteDOT(t *testing.T) {
+	var b bytes.Buffer
+	require.NoError(t, tracedPath(t).WriteDOT(&b))
+	out := b.String()
+	require.True(t, strings.HasPrefix(out, "digraph causal {\n"))
+	require.Contains(t, out, `"agent_action:action-1" [label="agent_action\naction-1\n12.5ms", color=red, penwidth=2];`)
+	require.Contains(t, out, `"workflow_execution:workflow-1" -> "external_output:output-1" [label="outputs (1.00)", color=red, penwidth=2];`)
+	require.Contains(t, out, `"routing_decision:decision-1" -> "agent_action:action-1" [label="actions (0.80)", color=red, penwidth=2];`)
+	require.Contains(t, out, `"spike_event:spike-1" -> "routing_decision:decision-1" [label="decisions (0.80)", color=red, penwidth=2];`)
+	require.Contains(t, out, `"spike_event:spike-2" -> "routing_decision:decision-1" [label="decisions (0.80)", color=red, penwidth=2];`)
+	require.True(t, strings.HasSuffix(out, "}\n"))
+}
+
+func TestCausalPath_WriteMermaid(t *testing.T) {
+	var b bytes.Buffer
+	require.NoError(t, tracedPath(t).WriteMermaid(&b))
+	out := b.String()
+	require.True(t, strings.HasPrefix(out, "flowchart LR\n"))
+	require.Contains(t, out, "\tn0[\"external_output<br/>output-1\"]\n")
+	require.Contains(t, out, "\tn1 -->|\"outputs (1.00)\"| n0\n")
+	require.Contains(t, out, "\tclassDef critical stroke:#d00,stroke-width:2px\n")
+	// Both spikes have the same (zero) duration, so both are on a critical path.
+	require.Contains(t, out, "\tclass n0,n1,n2,n3,n4,n5 critical\n")
+}
+
+func TestCausalPath_WriteOTLP(t *testing.T) {
+	var b bytes.Buffer
+	require.NoError(t, tracedPath(t).WriteOTLP(&b))
+	var traces struct {
+		ResourceSpans []struct {
+			ScopeSpans []struct {
+				Spans []struct {
+					TraceID           string `json:"traceId"`
+					SpanID            string `json:"spanId"`
+					ParentSpanID      string `json:"parentSpanId"`
+					Name              string `json:"name"`
+					StartTimeUnixNano string `json:"startTimeUnixNano"`
+					EndTimeUnixNano   string `json:"endTimeUnixNano"`
+					Links             []struct {
+						SpanID string `json:"spanId"`
+					} `json:"links"`
+				} `json:"spans"`
+			} `json:"scopeSpans"`
+		} `json:"resourceSpans"`
+	}
+	require.NoError(t, json.Unmarshal(b.Bytes(), &traces))
+	require.Len(t, traces.ResourceSpans, 1)
+	spans := traces.ResourceSpans[0].ScopeSpans[0].Spans
+	require.Len(t, spans, 6)
+
+	byName := make(map[string]int)
+	for i, s := range spans {
+		require.Len(t, s.TraceID, 32)
+		require.Len(t, s.SpanID, 16)
+		require.Equal(t, spans[0].TraceID, s.TraceID)
+		byName[s.Name] = i
+	}
+	output := spans[byName["external_output output-1"]]
+	workflow := spans[byName["workflow_execution workflow-1"]]
+	action := spans[byName["agent_action action-1"]]
+	decision := spans[byName["routing_decision decision-1"]]
+	require.Equal(t, workflow.SpanID, output.ParentSpanID)
+	require.Equal(t, action.SpanID, workflow.ParentSpanID)
+	require.Equal(t, decision.SpanID, action.ParentSpanID)
+	require.NotEmpty(t, decision.ParentSpanID)
+	require.Len(t, decision.Links, 1)
+	require.Equal(t, "1735689602000000000", action.StartTimeUnixNano)
+	require.Equal(t, "1735689602012500000", action.EndTimeUnixNano)
+	require.Equal(t, output.StartTimeUnixNano, output.EndTimeUnixNano)
+}
@@ -0,0 +1,250 @@
+package queries
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent"
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/spikeevent"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+)
+
+type (
+	// ImpactOption configures an impact trace.
+	ImpactOption func(*impactOptions)
+
+	impactOptions struct {
+		outputs []predicate.ExternalOutput
+	}
+)
+
+// WithOutputDomains restricts an impact trace to outputs in one of the given domains.
+func WithOutputDomains(domains ...string) ImpactOption {
+	return func(o *impactOptions) {
+		o.outputs = append(o.outputs, externaloutput.DomainIn(domains...))
+	}
+}
+
+// WithOutputTypes restricts an impact trace to outputs of one of the given types.
+func WithOutputTypes(types ...externaloutput.OutputType) ImpactOption {
+	return func(o *impactOptions) {
+		o.outputs = append(o.outputs, externaloutput.OutputTypeIn(types...))
+	}
+}
+
+// TraceImpact traces the causal chain forward from a spike event to every
+// downstream output.
+//
+// This performs a breadth-first traversal following the decisions, actions,
+// workflows and outputs edges, and the child_executions edges of nested
+// workflows. When output filters are given, only outputs matching all of
+// them are returned, and nodes that do not lead to any of those outputs are
+// pruned from the path. An error is returned if the spike event does not
+// exist.
+//
+// Example:
+//
+//	path, err := service.TraceImpact(ctx, "spike-123", 100, queries.WithOutputDomains("trading"))
+//	outputs := path.GetOutputs()
+func (s *CausalQueryService) TraceImpact(
+	ctx context.Context,
+	spikeEventID string,
+	maxDepth int,
+	opts ...ImpactOption,
+) (*CausalPath, error) {
+	event, err := s.client.SpikeEvent.Get(ctx, spikeEventID)
+	if err != nil {
+		return nil, fmt.Errorf("entcausal: loading spike event %q: %w", spikeEventID, err)
+	}
+	return s.traceImpact(ctx, spikeEventID, []*ent.SpikeEvent{event}, maxDepth, opts)
+}
+
+// TraceImpactByPatternHash traces the impact of all spike events with the given
+// pattern hash. See TraceImpact for more details.
+func (s *CausalQueryService) TraceImpactByPatternHash(
+	ctx context.Context,
+	patternHash string,
+	maxDepth int,
+	opts ...ImpactOption,
+) (*CausalPath, error) {
+	events, err := s.client.SpikeEvent.Query().
+		Where(spikeevent.PatternHash(patternHash)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("entcausal: querying spike events of pattern %q: %w", patternHash, err)
+	}
+	return s.traceImpact(ctx, patternHash, events, maxDepth, opts)
+}
+
+// TraceImpactByPopulation traces the impact of all spike events fired by the
+// given neuron population. See TraceImpact for more details.
+func (s *CausalQueryService) TraceImpactByPopulation(
+	ctx context.Context,
+	populationID string,
+	maxDepth int,
+	opts ...ImpactOption,
+) (*CausalPath, error) {
+	events, err := s.client.SpikeEvent.Query().
+		Where(spikeevent.PopulationID(populationID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("entcausal: querying spike events of population %q: %w", populationID, err)
+	}
+	return s.traceImpact(ctx, populationID, events, maxDepth, opts)
+}
+
+func (s *CausalQueryService) traceImpact(
+	ctx context.Context,
+	sourceID string,
+	events []*ent.SpikeEvent,
+	maxDepth int,
+	opts []ImpactOption,
+) (*CausalPath, error) {
+	if maxDepth <= 0 {
+		maxDepth = 100
+	}
+	o := &impactOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	path := &CausalPath{
+		SourceID: sourceID,
+		Nodes:    make([]CausalNode, 0),
+		Edges:    make([]CausalEdge, 0),
+		TracedAt: time.Now(),
+	}
+	roots := make([]CausalNode, len(events))
+	for i, e := range events {
+		roots[i] = spikeNode(e, 0)
+	}
+	next := func(ctx context.Context, node CausalNode) ([]CausalNode, []CausalEdge, error) {
+		return s.getChildNodes(ctx, node, o)
+	}
+	if err := s.traverse(ctx, path, roots, maxDepth, next); err != nil {
+		return nil, err
+	}
+	if len(o.outputs) > 0 {
+		path.prune(NodeTypeExternalOutput)
+	}
+	path.TotalLatencyMs = path.criticalLatency()
+	return path, nil
+}
+
+// getChildNodes returns the direct effects of the given node, along with
+// the edges pointing from the node to each effect.
+func (s *CausalQueryService) getChildNodes(
+	ctx context.Context,
+	node CausalNode,
+	o *impactOptions,
+) ([]CausalNode, []CausalEdge, error) {
+	var (
+		children []CausalNode
+		edges    []CausalEdge
+		depth    = node.Depth + 1
+	)
+	switch node.Type {
+	case NodeTypeSpikeEvent:
+		decisions, err := s.client.SpikeEvent.Query().
+			Where(spikeevent.ID(node.ID)).
+			QueryDecisions().
+			All(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("entcausal: querying decisions of spike event %q: %w", node.ID, err)
+		}
+		for _, d := range decisions {
+			child := decisionNode(d, depth)
+			children = append(children, child)
+			edges = append(edges, newCausalEdge(node, child, EdgeTypeDecisions, d.Confidence))
+		}
+	case NodeTypeRoutingDecision:
+		decision, err := s.client.RoutingDecision.Query().
+			Where(routingdecision.ID(node.ID)).
+			WithActions().
+			Only(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("entcausal: querying actions of routing decision %q: %w", node.ID, err)
+		}
+		for _, a := range decision.Edges.Actions {
+			child := actionNode(a, depth)
+			children = append(children, child)
+			edges = append(edges, newCausalEdge(node, child, EdgeTypeActions, decision.Confidence))
+		}
+	case NodeTypeAgentAction:
+		workflows, err := s.client.AgentAction.Query().
+			Where(agentaction.ID(node.ID)).
+			QueryWorkflows().
+			All(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("entcausal: querying workflows of agent action %q: %w", node.ID, err)
+		}
+		for _, w := range workflows {
+			child := workflowNode(w, depth)
+			children = append(children, child)
+			edges = append(edges, newCausalEdge(node, child, EdgeTypeWorkflows, 1))
+		}
+	case NodeTypeWorkflowExecution:
+		workflow, err := s.client.WorkflowExecution.Query().
+			Where(workflowexecution.ID(node.ID)).
+			WithOutputs(func(q *ent.ExternalOutputQuery) {
+				q.Where(o.outputs...)
+			}).
+			WithChildExecutions().
+			Only(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("entcausal: querying outputs of workflow execution %q: %w", node.ID, err)
+		}
+		for _, out := range workflow.Edges.Outputs {
+			child := outputNode(out, depth)
+			children = append(children, child)
+			edges = append(edges, newCausalEdge(node, child, EdgeTypeOutputs, 1))
+		}
+		for _, w := range workflow.Edges.ChildExecutions {
+			child := workflowNode(w, depth)
+			children = append(children, child)
+			edges = append(edges, newCausalEdge(node, child, EdgeTypeChildExecutions, 1))
+		}
+	}
+	return children, edges, nil
+}
+
+// prune removes all nodes and edges that do not lead to a node of the given type.
+func (p *CausalPath) prune(nodeType string) {
+	reach := make(map[string]bool)
+	for _, n := range p.Nodes {
+		if n.Type == nodeType {
+			reach[nodeKey(n)] = true
+		}
+	}
+	// Propagate reachability backwards until a fixed point is reached.
+	for changed := true; changed; {
+		changed = false
+		for _, e := range p.Edges {
+			src, dst := e.SourceType+":"+e.SourceID, e.TargetType+":"+e.TargetID
+			if reach[dst] && !reach[src] {
+				reach[src] = true
+				changed = true
+			}
+		}
+	}
+	nodes := make([]CausalNode, 0, len(reach))
+	p.Depth = 0
+	for _, n := range p.Nodes {
+		if reach[nodeKey(n)] {
+			nodes = append(nodes, n)
+			p.Depth = max(p.Depth, n.Depth)
+		}
+	}
+	edges := make([]CausalEdge, 0, len(p.Edges))
+	for _, e := range p.Edges {
+		if reach[e.TargetType+":"+e.TargetID] {
+			edges = append(edges, e)
+		}
+	}
+	p.Nodes, p.Edges = nodes, edges
+}
@@ -0,0 +1,150 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/contrib/entcausal/ent"
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/internal/causaltest"
+	"entgo.io/contrib/entcausal/queries"
+	"github.com/stretchr/testify/require"
+)
+
+// seedImpact extends the chain created by causaltest.SeedChain with a second workflow
+// and output, and with a spike event that feeds nothing:
+//
+//	action-1 -> workflow-2 -> output-2 (trading)
+//	spike-3
+func seedImpact(ctx context.Context, t *testing.T, client *ent.Client) {
+	t.Helper()
+	causaltest.SeedChain(ctx, t, client)
+	w := client.WorkflowExecution.Create().
+		SetID("workflow-2").
+		SetStartedAt(base).
+		SetWorkflowID("wf").
+		AddActionIDs("action-1").
+		SaveX(ctx)
+	client.ExternalOutput.Create().
+		SetID("output-2").
+		SetTimestamp(base).
+		SetOutputType(externaloutput.OutputTypeTradeExecution).
+		SetDomain("trading").
+		SetContentHash("sha256:def").
+		AddWorkflows(w).
+		SaveX(ctx)
+	client.SpikeEvent.Create().
+		SetID("spike-3").
+		SetTimestamp(base).
+		SetPopulationID("pop-a").
+		SetNeuronIndices([]int{1}).
+		SetPatternHash("hash-c").
+		SaveX(ctx)
+}
+
+func outputIDs(p *queries.CausalPath) []string {
+	var ids []string
+	for _, n := range p.GetOutputs() {
+		ids = append(ids, n.ID)
+	}
+	return ids
+}
+
+func TestTraceImpact(t *testing.T) {
+	ctx := context.Background()
+	client := causaltest.Open(t)
+	seedImpact(ctx, t, client)
+	svc := queries.NewCausalQueryService(client)
+
+	path, err := svc.TraceImpact(ctx, "spike-1", 0)
+	require.NoError(t, err)
+	require.Equal(t, "spike-1", path.SourceID)
+	require.Equal(t, 4, path.Depth)
+	require.ElementsMatch(t, []string{"output-1", "output-2"}, outputIDs(path))
+	require.Len(t, path.Nodes, 7)
+	require.Len(t, path.Edges, 6)
+	require.Contains(t, path.Edges, queries.CausalEdge{
+		SourceID:   "spike-1",
+		SourceType: queries.NodeTypeSpikeEvent,
+		TargetID:   "decision-1",
+		TargetType: queries.NodeTypeRoutingDecision,
+		EdgeType:   queries.EdgeTypeDecisions,
+		Confidence: 0.8,
+	})
+
+	path, err = svc.TraceImpact(ctx, "spike-1", 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, path.Depth)
+	require.Empty(t, path.GetOutputs())
+
+	_, err = svc.TraceImpact(ctx, "missing", 0)
+	require.True(t, ent.IsNotFound(err))
+}
+
+func TestTraceImpact_NestedWorkflows(t *testing.T) {
+	ctx := context.Background()
+	client := causaltest.Open(t)
+	seedNested(ctx, t, client)
+	svc := queries.NewCausalQueryService(client)
+
+	path, err := svc.TraceImpact(ctx, "spike-1", 0)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"output-1", "output-nested"}, outputIDs(path))
+	require.Equal(t, 5, path.Depth)
+}
+
+func TestTraceImpact_Filters(t *testing.T) {
+	ctx := context.Background()
+	client := causaltest.Open(t)
+	seedImpact(ctx, t, client)
+	svc := queries.NewCausalQueryService(client)
+
+	path, err := svc.TraceImpact(ctx, "spike-1", 0, queries.WithOutputDomains("trading"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"output-2"}, outputIDs(path))
+	require.Equal(t, map[string]int{
+		queries.NodeTypeSpikeEvent:        1,
+		queries.NodeTypeRoutingDecision:   1,
+		queries.NodeTypeAgentAction:       1,
+		queries.NodeTypeWorkflowExecution: 1,
+		queries.NodeTypeExternalOutput:    1,
+	}, path.CountByType())
+	require.Len(t, path.Edges, 4)
+
+	path, err = svc.TraceImpact(ctx, "spike-1", 0, queries.WithOutputTypes(externaloutput.OutputTypeDocument))
+	require.NoError(t, err)
+	require.Equal(t, []string{"output-1"}, outputIDs(path))
+
+	path, err = svc.TraceImpact(ctx, "spike-1", 0,
+		queries.WithOutputDomains("trading"),
+		queries.WithOutputTypes(externaloutput.OutputTypeDocument),
+	)
+	require.NoError(t, err)
+	require.Empty(t, path.Nodes)
+	require.Empty(t, path.Edges)
+}
+
+func TestTraceImpact_Sources(t *testing.T) {
+	ctx := context.Background()
+	client := causaltest.Open(t)
+	seedImpact(ctx, t, client)
+	svc := queries.NewCausalQueryService(client)
+
+	path, err := svc.TraceImpactByPatternHash(ctx, "hash-b", 0)
+	require.NoError(t, err)
+	require.Equal(t, "hash-b", path.SourceID)
+	require.ElementsMatch(t, []string{"output-1", "output-2"}, outputIDs(path))
+
+	path, err = svc.TraceImpactByPopulation(ctx, "pop-a", 0)
+	require.NoError(t, err)
+	require.Len(t, path.GetSpikeEvents(), 2)
+	require.ElementsMatch(t, []string{"output-1", "output-2"}, outputIDs(path))
+
+	path, err = svc.TraceImpactByPopulation(ctx, "pop-a", 0, queries.WithOutputDomains("trading"))
+	require.NoError(t, err)
+	require.Len(t, path.GetSpikeEvents(), 1)
+
+	path, err = svc.TraceImpactByPatternHash(ctx, "unknown", 0)
+	require.NoError(t, err)
+	require.Empty(t, path.Nodes)
+}
@@ -0,0 +1,112 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent"
+	"entgo.io/contrib/entcausal/ent/enttest"
+	"entgo.io/contrib/entcausal/internal/causaltest"
+	"entgo.io/contrib/entcausal/provenance"
+	"entgo.io/contrib/entcausal/queries"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyProvenance(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, causaltest.DSN(t))
+	require.NoError(t, err)
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(drv)))
+	defer client.Close()
+	client.Use(provenance.Hook())
+	seedNested(ctx, t, client)
+	svc := queries.NewCausalQueryService(client)
+
+	for _, id := range []string{"output-1", "output-nested"} {
+		report, err := svc.VerifyProvenance(ctx, id)
+		require.NoError(t, err)
+		require.True(t, report.Verified, id)
+		require.Nil(t, report.BrokenLink)
+	}
+	report, err := svc.VerifyProvenance(ctx, "output-1")
+	require.NoError(t, err)
+	require.Equal(t, 6, report.Checked)
+
+	// Updates of fields that are not sealed keep the record valid.
+	client.WorkflowExecution.UpdateOneID("workflow-1").SetStepName("done").ExecX(ctx)
+	client.SpikeEvent.UpdateOneID("spike-1").SetIsEmergent(true).ExecX(ctx)
+	report, err = svc.VerifyProvenance(ctx, "output-1")
+	require.NoError(t, err)
+	require.True(t, report.Verified)
+
+	exec := func(query string, args ...any) {
+		_, err := drv.DB().ExecContext(ctx, query, args...)
+		require.NoError(t, err)
+	}
+
+	// Altering a sealed field breaks the link of the altered record.
+	exec("UPDATE agent_actions SET agent_id = ? WHERE id = ?", "mallory", "action-1")
+	report, err = svc.VerifyProvenance(ctx, "output-1")
+	require.NoError(t, err)
+	require.False(t, report.Verified)
+	require.Equal(t, "action-1", report.BrokenLink.NodeID)
+	require.Equal(t, queries.NodeTypeAgentAction, report.BrokenLink.NodeType)
+	require.Equal(t, 2, report.BrokenLink.Depth)
+	require.Equal(t, 4, report.Checked)
+	require.NotEqual(t, report.BrokenLink.StoredDigest, report.BrokenLink.ComputedDigest)
+	// The nested output shares the altered ancestor.
+	report, err = svc.VerifyProvenance(ctx, "output-nested")
+	require.NoError(t, err)
+	require.Equal(t, "action-1", report.BrokenLink.NodeID)
+
+	// Re-sealing the altered record breaks the link of its children.
+	_, computed, err := provenance.Recompute(ctx, client, ent.TypeAgentAction, "action-1")
+	require.NoError(t, err)
+	exec("UPDATE agent_actions SET provenance_hash = ? WHERE id = ?", computed, "action-1")
+	report, err = svc.VerifyProvenance(ctx, "output-1")
+	require.NoError(t, err)
+	require.Equal(t, "workflow-1", report.BrokenLink.NodeID)
+
+	// Removing a parent edge breaks the link of the child.
+	exec("UPDATE agent_actions SET agent_id = ? WHERE id = ?", "aria-1", "action-1")
+	_, computed, err = provenance.Recompute(ctx, client, ent.TypeAgentAction, "action-1")
+	require.NoError(t, err)
+	exec("UPDATE agent_actions SET provenance_hash = ? WHERE id = ?", computed, "action-1")
+	report, err = svc.VerifyProvenance(ctx, "output-1")
+	require.NoError(t, err)
+	require.True(t, report.Verified)
+
+	// Sub-second edits, and edits to zero values, alter the record.
+	exec("UPDATE routing_decisions SET timestamp = ? WHERE id = ?", base.Add(time.Second+time.Millisecond), "decision-1")
+	report, err = svc.VerifyProvenance(ctx, "output-1")
+	require.NoError(t, err)
+	require.Equal(t, "decision-1", report.BrokenLink.NodeID)
+	exec("UPDATE routing_decisions SET timestamp = ?, confidence = ? WHERE id = ?", base.Add(time.Second), 0, "decision-1")
+	report, err = svc.VerifyProvenance(ctx, "output-1")
+	require.NoError(t, err)
+	require.Equal(t, "decision-1", report.BrokenLink.NodeID)
+	exec("UPDATE routing_decisions SET confidence = ? WHERE id = ?", 0.8, "decision-1")
+	report, err = svc.VerifyProvenance(ctx, "output-1")
+	require.NoError(t, err)
+	require.True(t, report.Verified)
+
+	exec("DELETE FROM spike_event_decisions WHERE spike_event_id = ?", "spike-2")
+	report, err = svc.VerifyProvenance(ctx, "output-1")
+	require.NoError(t, err)
+	require.Equal(t, "decision-1", report.BrokenLink.NodeID)
+}
+
+func TestVerifyProvenance_Unsealed(t *testing.T) {
+	ctx := context.Background()
+	client := causaltest.Open(t)
+	causaltest.SeedChain(ctx, t, client)
+
+	report, err := queries.NewCausalQueryService(client).VerifyProvenance(ctx, "output-1")
+	require.NoError(t, err)
+	require.False(t, report.Verified)
+	require.Equal(t, queries.NodeTypeSpikeEvent, report.BrokenLink.NodeType)
+	require.Empty(t, report.BrokenLink.StoredDigest)
+}
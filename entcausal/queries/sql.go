@@ -0,0 +1,134 @@
+package queries
+
+import (
+	"fmt"
+
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/spikeevent"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Names of the common table expressions used by TraceCausalitySQL.
+const (
+	causalEdgesView = "causal_edges"
+	causalChainView = "causal_chain"
+)
+
+// causalEdge describes an ent edge of the causal graph. The first column
+// of the table holds the cause (parent) and the second the effect (child).
+// The first column of a nullable edge is a foreign-key that may be NULL.
+type causalEdge struct {
+	table      string
+	columns    []string
+	parentType string
+	childType  string
+	nullable   bool
+}
+
+// causalEdges returns the edges followed by TraceCausalitySQL. Table and
+// column names are taken from the metadata generated by ent, so they stay
+// in sync with the schema.
+func causalEdges() []causalEdge {
+	return []causalEdge{
+		{workflowexecution.OutputsTable, workflowexecution.OutputsPrimaryKey, NodeTypeWorkflowExecution, NodeTypeExternalOutput, false},
+		{agentaction.WorkflowsTable, agentaction.WorkflowsPrimaryKey, NodeTypeAgentAction, NodeTypeWorkflowExecution, false},
+		{routingdecision.ActionsTable, routingdecision.ActionsPrimaryKey, NodeTypeRoutingDecision, NodeTypeAgentAction, false},
+		{spikeevent.DecisionsTable, spikeevent.DecisionsPrimaryKey, NodeTypeSpikeEvent, NodeTypeRoutingDecision, false},
+		{workflowexecution.ChildExecutionsTable, []string{workflowexecution.ChildExecutionsColumn, workflowexecution.FieldID}, NodeTypeWorkflowExecution, NodeTypeWorkflowExecution, true},
+	}
+}
+
+// TraceCausalitySQL returns the recursive query for tracing the causal
+// chain of an output back to spike events, and its arguments. It can be
+// used with raw SQL queries for performance on deep traces.
+//
+// The query is formatted for the given dialect (dialect.SQLite, dialect.MySQL
+// or dialect.Postgres) and returns rows of (id, node_type, depth).
+//
+//	query, args := queries.TraceCausalitySQL(dialect.Postgres, "output-123", 100)
+//	rows, err := db.QueryContext(ctx, query, args...)
+func TraceCausalitySQL(name, outputID string, maxDepth int) (string, []any) {
+	if maxDepth <= 0 {
+		maxDepth = 100
+	}
+	b := sql.Dialect(name)
+
+	// causal_edges flattens all join tables into (parent_id, parent_type, child_id, child_type)
+	// rows, so the recursive term references causal_chain exactly once, as required by Postgres.
+	var edges *sql.Selector
+	for _, e := range causalEdges() {
+		t := b.Table(e.table)
+		s := b.Select(t.C(e.columns[0])).
+			AppendSelectExpr(sql.Raw(quote(e.parentType))).
+			AppendSelect(t.C(e.columns[1])).
+			AppendSelectExpr(sql.Raw(quote(e.childType))).
+			From(t)
+		if e.nullable {
+			s.Where(sql.NotNull(t.C(e.columns[0])))
+		}
+		if edges == nil {
+			edges = s
+		} else {
+			edges.UnionAll(s)
+		}
+	}
+
+	// Base case: start with the output. MySQL derives the column types of a
+	// recursive CTE from its non-recursive part only, so node_type is widened.
+	outputs := b.Table(externaloutput.Table)
+	chain := b.Select(outputs.C(externaloutput.FieldID)).
+		AppendSelectExpr(sql.Raw(anchorType(name, NodeTypeExternalOutput)), sql.Raw("0")).
+		From(outputs).
+		Where(sql.EQ(outputs.C(externaloutput.FieldID), outputID))
+
+	// Recursive case: follow edges backwards. UNION drops the rows already
+	// reached at the same depth, so paths that join again (e.g. diamonds) do
+	// not multiply the rows. Cycles between nested workflows are cut by the
+	// depth limit.
+	prev, e := b.Table(causalChainView).As("cc"), b.Table(causalEdgesView).As("ce")
+	chain.Union(
+		b.Select(e.C("parent_id"), e.C("parent_type")).
+			AppendSelectExpr(b.Expr(func(b *sql.Builder) {
+				b.WriteString(prev.C("depth")).WriteString(" + 1")
+			})).
+			From(prev).
+			Join(e).
+			OnP(sql.And(
+				sql.ColumnsEQ(prev.C("id"), e.C("child_id")),
+				sql.ColumnsEQ(prev.C("node_type"), e.C("child_type")),
+			)).
+			Where(sql.LT(prev.C("depth"), maxDepth)),
+	)
+
+	with := sql.WithRecursive(causalEdgesView, "parent_id", "parent_type", "child_id", "child_type").
+		As(edges).
+		With(causalChainView, "id", "node_type", "depth").
+		As(chain)
+	with.SetDialect(name)
+	result := b.Table(causalChainView)
+	return b.Select(result.C("id"), result.C("node_type"), result.C("depth")).
+		Prefix(with).
+		Distinct().
+		From(result).
+		OrderBy(result.C("depth"), result.C("node_type")).
+		Query()
+}
+
+// quote returns a node type as an SQL string literal. Node types are
+// package constants, so they never come from user input.
+func quote(nodeType string) string {
+	return "'" + nodeType + "'"
+}
+
+// anchorType returns the node type literal used in the non-recursive
+// term of the causal chain.
+func anchorType(name, nodeType string) string {
+	if name == dialect.MySQL {
+		return fmt.Sprintf("CAST(%s AS CHAR(32))", quote(nodeType))
+	}
+	return quote(nodeType)
+}
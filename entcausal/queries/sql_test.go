@@ -0,0 +1,79 @@
+package queries_test
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/contrib/entcausal/ent"
+	"entgo.io/contrib/entcausal/ent/enttest"
+	"entgo.io/contrib/entcausal/internal/causaltest"
+	"entgo.io/contrib/entcausal/queries"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceCausalitySQL(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, causaltest.DSN(t))
+	require.NoError(t, err)
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(drv)))
+	defer client.Close()
+	seedNested(ctx, t, client)
+
+	trace := func(outputID string, maxDepth int) map[string]int {
+		query, args := queries.TraceCausalitySQL(dialect.SQLite, outputID, maxDepth)
+		rows, err := drv.DB().QueryContext(ctx, query, args...)
+		require.NoError(t, err)
+		defer rows.Close()
+		depths := make(map[string]int)
+		for rows.Next() {
+			var (
+				id, nodeType string
+				depth        int
+			)
+			require.NoError(t, rows.Scan(&id, &nodeType, &depth))
+			depths[nodeType+":"+id] = depth
+		}
+		require.NoError(t, rows.Err())
+		return depths
+	}
+
+	require.Equal(t, map[string]int{
+		"external_output:output-1":      0,
+		"workflow_execution:workflow-1": 1,
+		"agent_action:action-1":         2,
+		"routing_decision:decision-1":   3,
+		"spike_event:spike-1":           4,
+		"spike_event:spike-2":           4,
+	}, trace("output-1", 0))
+	require.Len(t, trace("output-1", 2), 3)
+	require.Empty(t, trace("output-1' OR '1'='1", 0))
+
+	nested := trace("output-nested", 0)
+	require.Equal(t, 2, nested["workflow_execution:workflow-1"])
+	require.Equal(t, 5, nested["spike_event:spike-1"])
+	// Cycles between nested workflows stop at the depth limit.
+	require.Len(t, trace("output-cycle", 10), 3)
+}
+
+func TestTraceCausalitySQL_Dialects(t *testing.T) {
+	query, args := queries.TraceCausalitySQL(dialect.Postgres, "output-1", 10)
+	require.Equal(t, []any{"output-1", 10}, args)
+	require.Contains(t, query, `WITH RECURSIVE "causal_edges"`)
+	require.Contains(t, query, `"external_outputs"."id" = $1`)
+	require.Contains(t, query, `"cc"."depth" < $2`)
+	// Rows reached over several paths are merged by the recursive term.
+	require.Contains(t, query, `UNION SELECT "ce"."parent_id"`)
+
+	query, args = queries.TraceCausalitySQL(dialect.MySQL, "output-1", 10)
+	require.Equal(t, []any{"output-1", 10}, args)
+	require.Contains(t, query, "WITH RECURSIVE `causal_edges`")
+	require.Contains(t, query, "CAST('external_output' AS CHAR(32))")
+	require.Contains(t, query, "`external_outputs`.`id` = ?")
+	require.Contains(t, query, "`spike_event_decisions`")
+
+	query, _ = queries.TraceCausalitySQL(dialect.SQLite, "output-1", 10)
+	require.NotContains(t, query, "CAST")
+	require.NotContains(t, query, "output-1")
+}
@@ -0,0 +1,365 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent"
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/spikeevent"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+)
+
+type (
+	// collector runs a single retention pass. Records are collected from
+	// outputs to spike events, so that a record is collected only after all
+	// of its effects were. All records are collected before any is deleted,
+	// so that the edges of a record to its collected effects can be queried.
+	collector struct {
+		*Engine
+		cutoff  time.Time
+		expired predicate.ExternalOutput
+		// Collected outputs, workflows, actions, decisions and spike events.
+		// Causes check their effects against these sets.
+		outputIDs                                          []string
+		workflowIDs, actionIDs, decisionIDs, spikeEventIDs set
+	}
+
+	// candidate is a record that may be collected, and the IDs
+	// of the records it leads to through the collected edge.
+	candidate struct {
+		id      string
+		effects []string
+	}
+
+	set map[string]struct{}
+)
+
+// collect collects the expired outputs and the causal records that reach
+// no retained output, without deleting them.
+func (c *collector) collect(ctx context.Context) error {
+	for _, collect := range []func(context.Context) error{c.outputs, c.workflows, c.actions, c.decisions, c.spikes} {
+		if err := collect(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// outputs collects the expired outputs.
+func (c *collector) outputs(ctx context.Context) error {
+	var after string
+	for {
+		page, err := c.client.ExternalOutput.Query().
+			Where(c.expired, externaloutput.IDGT(after)).
+			Order(ent.Asc(externaloutput.FieldID)).
+			Limit(c.batchSize).
+			IDs(ctx)
+		if err != nil {
+			return fmt.Errorf("retention: querying expired outputs: %w", err)
+		}
+		if len(page) == 0 {
+			return nil
+		}
+		c.outputIDs = append(c.outputIDs, page...)
+		after = page[len(page)-1]
+	}
+}
+
+// workflowCandidates matches the workflow executions older than the grace
+// period that produced no retained output, and that either produced an
+// expired output or finished. Executions that are still running, or that
+// never produced an output and did not finish, are never collected.
+func (c *collector) workflowCandidates() predicate.WorkflowExecution {
+	return workflowexecution.And(
+		workflowexecution.StartedAtLT(c.cutoff),
+		workflowexecution.Not(workflowexecution.HasOutputsWith(externaloutput.Not(c.expired))),
+		workflowexecution.Or(
+			workflowexecution.HasOutputs(),
+			workflowexecution.StatusIn(
+				workflowexecution.StatusCompleted,
+				workflowexecution.StatusFailed,
+				workflowexecution.StatusCancelled,
+			),
+		),
+	)
+}
+
+// workflows collects the workflow executions that do not reach a retained
+// output, either directly or through their child executions.
+func (c *collector) workflows(ctx context.Context) error {
+	var (
+		after string
+		cands []candidate
+	)
+	for {
+		batch, err := c.client.WorkflowExecution.Query().
+			Where(c.workflowCandidates(), workflowexecution.IDGT(after)).
+			Order(ent.Asc(workflowexecution.FieldID)).
+			Limit(c.batchSize).
+			Select(workflowexecution.FieldID).
+			WithChildExecutions(func(q *ent.WorkflowExecutionQuery) {
+				q.Select(workflowexecution.FieldID)
+			}).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("retention: querying orphaned workflow executions: %w", err)
+		}
+		if len(batch) == 0 {
+			break
+		}
+		for _, w := range batch {
+			cand := candidate{id: w.ID}
+			for _, child := range w.Edges.ChildExecutions {
+				cand.effects = append(cand.effects, child.ID)
+			}
+			cands = append(cands, cand)
+		}
+		after = batch[len(batch)-1].ID
+	}
+	// A candidate is collected only if all of its child executions are. Drop
+	// the candidates with a retained child until no more can be dropped, since
+	// dropping a candidate may retain its parent.
+	c.workflowIDs = make(set, len(cands))
+	for _, cand := range cands {
+		c.workflowIDs[cand.id] = struct{}{}
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, cand := range cands {
+			if _, ok := c.workflowIDs[cand.id]; ok && !c.workflowIDs.contains(cand.effects) {
+				delete(c.workflowIDs, cand.id)
+				changed = true
+			}
+		}
+	}
+	return nil
+}
+
+// actionCandidates matches the agent actions older than the grace period
+// that are executed only by candidate workflow executions, and that either
+// were executed by one, or finished.
+func (c *collector) actionCandidates() predicate.AgentAction {
+	return agentaction.And(
+		agentaction.TimestampLT(c.cutoff),
+		agentaction.Not(agentaction.HasWorkflowsWith(workflowexecution.Not(c.workflowCandidates()))),
+		agentaction.Or(
+			agentaction.HasWorkflows(),
+			agentaction.StatusIn(
+				agentaction.StatusCompleted,
+				agentaction.StatusFailed,
+				agentaction.StatusCancelled,
+			),
+		),
+	)
+}
+
+// actions collects the agent actions whose workflow executions were all collected.
+func (c *collector) actions(ctx context.Context) error {
+	c.actionIDs = make(set)
+	return c.sweep(ctx, "agent actions", c.actionIDs, c.workflowIDs,
+		func(ctx context.Context, after string) ([]candidate, error) {
+			batch, err := c.client.AgentAction.Query().
+				Where(c.actionCandidates(), agentaction.IDGT(after)).
+				Order(ent.Asc(agentaction.FieldID)).
+				Limit(c.batchSize).
+				Select(agentaction.FieldID).
+				WithWorkflows(func(q *ent.WorkflowExecutionQuery) {
+					q.Select(workflowexecution.FieldID)
+				}).
+				All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			cands := make([]candidate, len(batch))
+			for i, a := range batch {
+				cands[i].id = a.ID
+				for _, w := range a.Edges.Workflows {
+					cands[i].effects = append(cands[i].effects, w.ID)
+				}
+			}
+			return cands, nil
+		},
+	)
+}
+
+// decisionCandidates matches the routing decisions older than the grace
+// period that led only to candidate agent actions. Routing decisions have
+// no status, so those that never led to an agent action are never collected.
+func (c *collector) decisionCandidates() predicate.RoutingDecision {
+	return routingdecision.And(
+		routingdecision.TimestampLT(c.cutoff),
+		routingdecision.HasActions(),
+		routingdecision.Not(routingdecision.HasActionsWith(agentaction.Not(c.actionCandidates()))),
+	)
+}
+
+// decisions collects the routing decisions whose agent actions were all collected.
+func (c *collector) decisions(ctx context.Context) error {
+	c.decisionIDs = make(set)
+	return c.sweep(ctx, "routing decisions", c.decisionIDs, c.actionIDs,
+		func(ctx context.Context, after string) ([]candidate, error) {
+			batch, err := c.client.RoutingDecision.Query().
+				Where(c.decisionCandidates(), routingdecision.IDGT(after)).
+				Order(ent.Asc(routingdecision.FieldID)).
+				Limit(c.batchSize).
+				Select(routingdecision.FieldID).
+				WithActions(func(q *ent.AgentActionQuery) {
+					q.Select(agentaction.FieldID)
+				}).
+				All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			cands := make([]candidate, len(batch))
+			for i, d := range batch {
+				cands[i].id = d.ID
+				for _, a := range d.Edges.Actions {
+					cands[i].effects = append(cands[i].effects, a.ID)
+				}
+			}
+			return cands, nil
+		},
+	)
+}
+
+// spikes collects the spike events whose routing decisions were all collected,
+// including the spike events that never led to a routing decision.
+func (c *collector) spikes(ctx context.Context) error {
+	c.spikeEventIDs = make(set)
+	return c.sweep(ctx, "spike events", c.spikeEventIDs, c.decisionIDs,
+		func(ctx context.Context, after string) ([]candidate, error) {
+			batch, err := c.client.SpikeEvent.Query().
+				Where(
+					spikeevent.TimestampLT(c.cutoff),
+					spikeevent.Not(spikeevent.HasDecisionsWith(routingdecision.Not(c.decisionCandidates()))),
+					spikeevent.IDGT(after),
+				).
+				Order(ent.Asc(spikeevent.FieldID)).
+				Limit(c.batchSize).
+				Select(spikeevent.FieldID).
+				WithDecisions(func(q *ent.RoutingDecisionQuery) {
+					q.Select(routingdecision.FieldID)
+				}).
+				All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			cands := make([]candidate, len(batch))
+			for i, s := range batch {
+				cands[i].id = s.ID
+				for _, d := range s.Edges.Decisions {
+					cands[i].effects = append(cands[i].effects, d.ID)
+				}
+			}
+			return cands, nil
+		},
+	)
+}
+
+// sweep pages through the candidates returned by next, and
+// collects into collected those whose effects were all collected.
+func (c *collector) sweep(
+	ctx context.Context,
+	name string,
+	collected, effects set,
+	next func(context.Context, string) ([]candidate, error),
+) error {
+	var after string
+	for {
+		cands, err := next(ctx, after)
+		if err != nil {
+			return fmt.Errorf("retention: querying orphaned %s: %w", name, err)
+		}
+		if len(cands) == 0 {
+			return nil
+		}
+		after = cands[len(cands)-1].id
+		for _, cand := range cands {
+			if effects.contains(cand.effects) {
+				collected[cand.id] = struct{}{}
+			}
+		}
+	}
+}
+
+// delete archives and deletes the collected outputs, and then deletes the
+// collected records from effects to causes. Records are deleted in batches,
+// and expired outputs are not deleted if archiving them fails.
+func (c *collector) delete(ctx context.Context) (archived int, err error) {
+	for ids := c.outputIDs; len(ids) > 0; {
+		n := min(len(ids), c.batchSize)
+		page := ids[:n]
+		ids = ids[n:]
+		if c.archiver != nil {
+			batch, err := c.client.ExternalOutput.Query().
+				Where(externaloutput.IDIn(page...)).
+				Order(ent.Asc(externaloutput.FieldID)).
+				All(ctx)
+			if err != nil {
+				return archived, fmt.Errorf("retention: querying expired outputs: %w", err)
+			}
+			if err := c.archiver.Archive(ctx, batch); err != nil {
+				return archived, fmt.Errorf("retention: archiving outputs: %w", err)
+			}
+			archived += len(batch)
+		}
+		if _, err := c.client.ExternalOutput.Delete().Where(externaloutput.IDIn(page...)).Exec(ctx); err != nil {
+			return archived, fmt.Errorf("retention: deleting outputs: %w", err)
+		}
+	}
+	for _, r := range []struct {
+		name string
+		ids  set
+		del  func(context.Context, []string) error
+	}{
+		{"workflow executions", c.workflowIDs, func(ctx context.Context, ids []string) error {
+			_, err := c.client.WorkflowExecution.Delete().Where(workflowexecution.IDIn(ids...)).Exec(ctx)
+			return err
+		}},
+		{"agent actions", c.actionIDs, func(ctx context.Context, ids []string) error {
+			_, err := c.client.AgentAction.Delete().Where(agentaction.IDIn(ids...)).Exec(ctx)
+			return err
+		}},
+		{"routing decisions", c.decisionIDs, func(ctx context.Context, ids []string) error {
+			_, err := c.client.RoutingDecision.Delete().Where(routingdecision.IDIn(ids...)).Exec(ctx)
+			return err
+		}},
+		{"spike events", c.spikeEventIDs, func(ctx context.Context, ids []string) error {
+			_, err := c.client.SpikeEvent.Delete().Where(spikeevent.IDIn(ids...)).Exec(ctx)
+			return err
+		}},
+	} {
+		for ids := r.ids.ids(); len(ids) > 0; {
+			n := min(len(ids), c.batchSize)
+			if err := r.del(ctx, ids[:n]); err != nil {
+				return archived, fmt.Errorf("retention: deleting %s: %w", r.name, err)
+			}
+			ids = ids[n:]
+		}
+	}
+	return archived, nil
+}
+
+// contains reports if all the given IDs are in the set.
+func (s set) contains(ids []string) bool {
+	for _, id := range ids {
+		if _, ok := s[id]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ids returns the IDs in the set.
+func (s set) ids() []string {
+	ids := make([]string, 0, len(s))
+	for id := range s {
+		ids = append(ids, id)
+	}
+	return ids
+}
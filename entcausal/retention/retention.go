@@ -0,0 +1,206 @@
+// Package retention enforces the retention period of external outputs, and
+// garbage-collects the causal records that no longer lead to a retained output.
+//
+// An output expires once its retention_years have passed since it was produced,
+// unless its compliance metadata places it under legal hold:
+//
+//	{"legal_hold": true}
+//
+// After the expired outputs are removed, the causal records that no longer
+// reach any retained output are removed as well: workflow executions that
+// produced only expired outputs, or that finished without producing one,
+// agent actions and routing decisions whose effects were all removed, and
+// spike events that led only to removed decisions, or to none. Workflow
+// executions and agent actions that are still running are kept, and so are
+// records younger than the grace period, as their effects may not have been
+// recorded yet.
+//
+//	e := retention.NewEngine(client, retention.WithArchiver(archiver))
+//	report, err := e.DryRun(ctx)
+//	...
+//	report, err = e.Run(ctx)
+package retention
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent"
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/predicate"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+)
+
+// LegalHold is the key in the compliance metadata of an output
+// that exempts it from retention enforcement.
+const LegalHold = "legal_hold"
+
+const (
+	// DefaultBatchSize is the default number of records
+	// loaded or deleted by a single statement.
+	DefaultBatchSize = 500
+	// DefaultGracePeriod is the default age under which
+	// orphaned records are not collected.
+	DefaultGracePeriod = 24 * time.Hour
+)
+
+type (
+	// Engine enforces retention on an entcausal database.
+	Engine struct {
+		client    *ent.Client
+		batchSize int
+		grace     time.Duration
+		archiver  Archiver
+		now       func() time.Time
+	}
+
+	// Option configures the Engine.
+	Option func(*Engine)
+
+	// Archiver archives expired outputs before they are deleted.
+	// If Archive returns an error, the batch is not deleted.
+	Archiver interface {
+		Archive(context.Context, []*ent.ExternalOutput) error
+	}
+
+	// The ArchiveFunc type is an adapter to allow the use
+	// of ordinary functions as Archiver.
+	ArchiveFunc func(context.Context, []*ent.ExternalOutput) error
+
+	// Report describes the records removed by a retention run,
+	// or that would be removed by a dry run.
+	Report struct {
+		DryRun             bool      `json:"dry_run"`
+		RanAt              time.Time `json:"ran_at"`
+		Outputs            int       `json:"outputs"`
+		Archived           int       `json:"archived"`
+		WorkflowExecutions int       `json:"workflow_executions"`
+		AgentActions       int       `json:"agent_actions"`
+		RoutingDecisions   int       `json:"routing_decisions"`
+		SpikeEvents        int       `json:"spike_events"`
+		// OutputIDs lists the expired outputs.
+		OutputIDs []string `json:"output_ids,omitempty"`
+	}
+)
+
+// Archive calls f(ctx, outputs).
+func (f ArchiveFunc) Archive(ctx context.Context, outputs []*ent.ExternalOutput) error {
+	return f(ctx, outputs)
+}
+
+// WithBatchSize sets the number of records loaded or deleted by a single
+// statement, so that large tables are not locked for the whole run.
+func WithBatchSize(n int) Option {
+	return func(e *Engine) {
+		if n > 0 {
+			e.batchSize = n
+		}
+	}
+}
+
+// WithGracePeriod sets the age under which orphaned records are not collected.
+func WithGracePeriod(d time.Duration) Option {
+	return func(e *Engine) {
+		e.grace = d
+	}
+}
+
+// WithArchiver sets the Archiver that receives expired outputs before they
+// are deleted. Without an archiver, expired outputs are deleted.
+func WithArchiver(a Archiver) Option {
+	return func(e *Engine) {
+		e.archiver = a
+	}
+}
+
+// WithClock sets the function used to get the current time.
+func WithClock(now func() time.Time) Option {
+	return func(e *Engine) {
+		e.now = now
+	}
+}
+
+// NewEngine creates a retention Engine for the given client.
+func NewEngine(client *ent.Client, opts ...Option) *Engine {
+	e := &Engine{
+		client:    client,
+		batchSize: DefaultBatchSize,
+		grace:     DefaultGracePeriod,
+		now:       time.Now,
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// DryRun reports the records that Run would remove, without removing them.
+func (e *Engine) DryRun(ctx context.Context) (*Report, error) {
+	return e.run(ctx, true)
+}
+
+// Run archives and deletes the expired outputs, and deletes the records
+// that no longer reach a retained output. Records are deleted in batches,
+// each batch in its own statement.
+func (e *Engine) Run(ctx context.Context) (*Report, error) {
+	return e.run(ctx, false)
+}
+
+func (e *Engine) run(ctx context.Context, dryRun bool) (*Report, error) {
+	now := e.now()
+	r := &Report{DryRun: dryRun, RanAt: now}
+	expired, err := e.expired(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	c := &collector{Engine: e, cutoff: now.Add(-e.grace), expired: expired}
+	if err := c.collect(ctx); err != nil {
+		return nil, err
+	}
+	r.OutputIDs = c.outputIDs
+	r.Outputs = len(c.outputIDs)
+	r.WorkflowExecutions = len(c.workflowIDs)
+	r.AgentActions = len(c.actionIDs)
+	r.RoutingDecisions = len(c.decisionIDs)
+	r.SpikeEvents = len(c.spikeEventIDs)
+	if !dryRun {
+		if r.Archived, err = c.delete(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// expired returns the predicate matching the outputs whose retention
+// period has passed at the given time, and that are not under legal hold.
+func (e *Engine) expired(ctx context.Context, now time.Time) (predicate.ExternalOutput, error) {
+	var years []int
+	if err := e.client.ExternalOutput.Query().
+		Unique(true).
+		Select(externaloutput.FieldRetentionYears).
+		Scan(ctx, &years); err != nil {
+		return nil, fmt.Errorf("retention: querying retention periods: %w", err)
+	}
+	if len(years) == 0 {
+		return externaloutput.IDIn(), nil
+	}
+	periods := make([]predicate.ExternalOutput, 0, len(years))
+	for _, y := range years {
+		periods = append(periods, externaloutput.And(
+			externaloutput.RetentionYears(y),
+			externaloutput.TimestampLT(now.AddDate(-y, 0, 0)),
+		))
+	}
+	held := externaloutput.And(
+		externaloutput.ComplianceNotNil(),
+		func(s *sql.Selector) {
+			s.Where(sqljson.HasKey(s.C(externaloutput.FieldCompliance), sqljson.Path(LegalHold)))
+		},
+		func(s *sql.Selector) {
+			s.Where(sqljson.ValueEQ(s.C(externaloutput.FieldCompliance), true, sqljson.Path(LegalHold)))
+		},
+	)
+	return externaloutput.And(externaloutput.Or(periods...), externaloutput.Not(held)), nil
+}
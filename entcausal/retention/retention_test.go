@@ -0,0 +1,186 @@
+package retention_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entcausal/ent"
+	"entgo.io/contrib/entcausal/ent/agentaction"
+	"entgo.io/contrib/entcausal/ent/externaloutput"
+	"entgo.io/contrib/entcausal/ent/routingdecision"
+	"entgo.io/contrib/entcausal/ent/workflowexecution"
+	"entgo.io/contrib/entcausal/internal/causaltest"
+	"entgo.io/contrib/entcausal/retention"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	base = time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+	now  = base.AddDate(10, 0, 0)
+)
+
+// seed creates the following records, where the outputs
+// old, short and released are expired at now:
+//
+//	spike-gone, spike-shared -> decision-old -> action-old -> wf-old -> output-old
+//	spike-shared, spike-new -> decision-new -> action-new -> wf-new -> output-new
+//	spike-old -> decision-shared -> action-old, action-new
+//	wf-parent -> wf-child -> output-new
+//	wf-parent2 (completed) -> wf-child2 -> output-short
+//	wf-held -> output-held (under legal hold)
+//	output-released
+//	spike-stray, spike-fresh
+//
+// and the following records that never led to an output:
+//
+//	wf-running (running), action-pending, action-done (completed)
+//	spike-idle -> decision-idle
+func seed(ctx context.Context, t *testing.T, client *ent.Client) {
+	t.Helper()
+	spike := func(id string, ts time.Time) *ent.SpikeEvent {
+		return client.SpikeEvent.Create().
+			SetID(id).
+			SetTimestamp(ts).
+			SetPopulationID("pop").
+			SetNeuronIndices([]int{1}).
+			SetPatternHash("hash").
+			SaveX(ctx)
+	}
+	gone, shared, old, recent := spike("spike-gone", base), spike("spike-shared", base), spike("spike-old", base), spike("spike-new", base.AddDate(5, 0, 0))
+	spike("spike-stray", base)
+	spike("spike-fresh", now.Add(-time.Hour))
+	decision := func(id string, ts time.Time, spikes ...*ent.SpikeEvent) *ent.RoutingDecision {
+		return client.RoutingDecision.Create().
+			SetID(id).
+			SetTimestamp(ts).
+			SetInferenceID("inference").
+			SetDecisionType(routingdecision.DecisionTypeRoute).
+			AddSpikeEvents(spikes...).
+			SaveX(ctx)
+	}
+	dOld := decision("decision-old", base, gone, shared)
+	dNew := decision("decision-new", base.AddDate(5, 0, 0), shared, recent)
+	dShared := decision("decision-shared", base, old)
+	action := func(id string, ts time.Time, decisions ...*ent.RoutingDecision) *ent.AgentAction {
+		return client.AgentAction.Create().
+			SetID(id).
+			SetTimestamp(ts).
+			SetAgentID("agent").
+			SetAgentType("aria").
+			SetActionType("execute").
+			AddDecisions(decisions...).
+			SaveX(ctx)
+	}
+	aOld := action("action-old", base, dOld, dShared)
+	aNew := action("action-new", base.AddDate(5, 0, 0), dNew, dShared)
+	workflow := func(id string, ts time.Time, parent string, actions ...*ent.AgentAction) *ent.WorkflowExecution {
+		c := client.WorkflowExecution.Create().
+			SetID(id).
+			SetStartedAt(ts).
+			SetWorkflowID("wf").
+			AddActions(actions...)
+		if parent != "" {
+			c.SetParentExecutionID(parent)
+		}
+		return c.SaveX(ctx)
+	}
+	wOld := workflow("wf-old", base, "", aOld)
+	wNew := workflow("wf-new", base.AddDate(5, 0, 0), "", aNew)
+	workflow("wf-parent", base, "")
+	wChild := workflow("wf-child", base, "wf-parent")
+	workflow("wf-parent2", base, "")
+	wChild2 := workflow("wf-child2", base, "wf-parent2")
+	wHeld := workflow("wf-held", base, "")
+	client.WorkflowExecution.UpdateOneID("wf-parent2").SetStatus(workflowexecution.StatusCompleted).ExecX(ctx)
+	client.WorkflowExecution.UpdateOneID(workflow("wf-running", base, "").ID).SetStatus(workflowexecution.StatusRunning).ExecX(ctx)
+	action("action-pending", base)
+	client.AgentAction.UpdateOneID(action("action-done", base).ID).SetStatus(agentaction.StatusCompleted).ExecX(ctx)
+	decision("decision-idle", base, spike("spike-idle", base))
+	output := func(id string, ts time.Time, years int, compliance map[string]any, workflows ...*ent.WorkflowExecution) {
+		client.ExternalOutput.Create().
+			SetID(id).
+			SetTimestamp(ts).
+			SetOutputType(externaloutput.OutputTypeDocument).
+			SetContentHash("sha256:" + id).
+			SetRetentionYears(years).
+			SetCompliance(compliance).
+			AddWorkflows(workflows...).
+			SaveX(ctx)
+	}
+	output("output-old", base, 7, nil, wOld)
+	output("output-new", base.AddDate(5, 0, 0), 7, map[string]any{"sec": "17a-4"}, wNew, wChild)
+	output("output-short", base.AddDate(8, 0, 0), 1, nil, wChild2)
+	output("output-held", base, 1, map[string]any{retention.LegalHold: true}, wHeld)
+	output("output-released", base, 1, map[string]any{retention.LegalHold: false})
+}
+
+func TestEngine(t *testing.T) {
+	ctx := context.Background()
+	client := causaltest.Open(t)
+	seed(ctx, t, client)
+
+	var archived []string
+	e := retention.NewEngine(client,
+		retention.WithClock(func() time.Time { return now }),
+		retention.WithBatchSize(1),
+		retention.WithArchiver(retention.ArchiveFunc(func(_ context.Context, outputs []*ent.ExternalOutput) error {
+			for _, o := range outputs {
+				archived = append(archived, o.ID)
+			}
+			return nil
+		})),
+	)
+	expected := &retention.Report{
+		DryRun:             true,
+		RanAt:              now,
+		Outputs:            3,
+		WorkflowExecutions: 3,
+		AgentActions:       2,
+		RoutingDecisions:   1,
+		SpikeEvents:        2,
+		OutputIDs:          []string{"output-old", "output-released", "output-short"},
+	}
+	report, err := e.DryRun(ctx)
+	require.NoError(t, err)
+	require.Equal(t, expected, report)
+	require.Empty(t, archived)
+	require.Equal(t, 5, client.ExternalOutput.Query().CountX(ctx))
+
+	report, err = e.Run(ctx)
+	require.NoError(t, err)
+	expected.DryRun, expected.Archived = false, 3
+	require.Equal(t, expected, report)
+	require.Equal(t, expected.OutputIDs, archived)
+
+	ids := func(ids []string) []string {
+		sort.Strings(ids)
+		return ids
+	}
+	require.Equal(t, []string{"output-held", "output-new"}, ids(client.ExternalOutput.Query().IDsX(ctx)))
+	require.Equal(t, []string{"wf-child", "wf-held", "wf-new", "wf-parent", "wf-running"}, ids(client.WorkflowExecution.Query().IDsX(ctx)))
+	require.Equal(t, []string{"action-new", "action-pending"}, ids(client.AgentAction.Query().IDsX(ctx)))
+	require.Equal(t, []string{"decision-idle", "decision-new", "decision-shared"}, ids(client.RoutingDecision.Query().IDsX(ctx)))
+	require.Equal(t, []string{"spike-fresh", "spike-idle", "spike-new", "spike-old", "spike-shared"}, ids(client.SpikeEvent.Query().IDsX(ctx)))
+
+	// Nothing is left to collect.
+	report, err = e.Run(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &retention.Report{RanAt: now}, report)
+}
+
+func TestEngine_ArchiveError(t *testing.T) {
+	ctx := context.Background()
+	client := causaltest.Open(t)
+	seed(ctx, t, client)
+
+	_, err := retention.NewEngine(client,
+		retention.WithClock(func() time.Time { return now }),
+		retention.WithArchiver(retention.ArchiveFunc(func(context.Context, []*ent.ExternalOutput) error {
+			return context.DeadlineExceeded
+		})),
+	).Run(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, 5, client.ExternalOutput.Query().CountX(ctx))
+}
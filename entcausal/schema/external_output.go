@@ -6,7 +6,9 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -18,6 +20,21 @@ type ExternalOutput struct {
 	ent.Schema
 }
 
+// Mixin of the ExternalOutput.
+func (ExternalOutput) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		ProvenanceMixin{},
+	}
+}
+
+// Annotations of the ExternalOutput.
+func (ExternalOutput) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+	}
+}
+
 // Fields of the ExternalOutput.
 func (ExternalOutput) Fields() []ent.Field {
 	return []ent.Field{
@@ -29,6 +46,7 @@ func (ExternalOutput) Fields() []ent.Field {
 		field.Time("timestamp").
 			Default(time.Now).
 			Immutable().
+			Annotations(entgql.OrderField("TIMESTAMP")).
 			Comment("When output was produced"),
 
 		field.Enum("output_type").
@@ -0,0 +1,23 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+)
+
+// ProvenanceMixin adds the provenance_hash field, set by provenance.Hook,
+// to the causal records.
+type ProvenanceMixin struct {
+	mixin.Schema
+}
+
+// Fields of the ProvenanceMixin.
+func (ProvenanceMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("provenance_hash").
+			Optional().
+			Immutable().
+			Comment("Tamper-evident digest over the record and its parents"),
+	}
+}
@@ -6,7 +6,9 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -18,6 +20,21 @@ type RoutingDecision struct {
 	ent.Schema
 }
 
+// Mixin of the RoutingDecision.
+func (RoutingDecision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		ProvenanceMixin{},
+	}
+}
+
+// Annotations of the RoutingDecision.
+func (RoutingDecision) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+	}
+}
+
 // Fields of the RoutingDecision.
 func (RoutingDecision) Fields() []ent.Field {
 	return []ent.Field{
@@ -29,6 +46,7 @@ func (RoutingDecision) Fields() []ent.Field {
 		field.Time("timestamp").
 			Default(time.Now).
 			Immutable().
+			Annotations(entgql.OrderField("TIMESTAMP")).
 			Comment("Timestamp when decision was made"),
 
 		field.String("inference_id").
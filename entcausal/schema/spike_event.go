@@ -6,7 +6,9 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -18,6 +20,21 @@ type SpikeEvent struct {
 	ent.Schema
 }
 
+// Mixin of the SpikeEvent.
+func (SpikeEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		ProvenanceMixin{},
+	}
+}
+
+// Annotations of the SpikeEvent.
+func (SpikeEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+	}
+}
+
 // Fields of the SpikeEvent.
 func (SpikeEvent) Fields() []ent.Field {
 	return []ent.Field{
@@ -29,6 +46,7 @@ func (SpikeEvent) Fields() []ent.Field {
 		field.Time("timestamp").
 			Default(time.Now).
 			Immutable().
+			Annotations(entgql.OrderField("TIMESTAMP")).
 			Comment("Timestamp when spike occurred"),
 
 		field.Int64("timestamp_ns").
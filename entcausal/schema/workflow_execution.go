@@ -6,7 +6,9 @@ package schema
 import (
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -18,6 +20,21 @@ type WorkflowExecution struct {
 	ent.Schema
 }
 
+// Mixin of the WorkflowExecution.
+func (WorkflowExecution) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		ProvenanceMixin{},
+	}
+}
+
+// Annotations of the WorkflowExecution.
+func (WorkflowExecution) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.QueryField(),
+		entgql.RelayConnection(),
+	}
+}
+
 // Fields of the WorkflowExecution.
 func (WorkflowExecution) Fields() []ent.Field {
 	return []ent.Field{
@@ -28,6 +45,7 @@ func (WorkflowExecution) Fields() []ent.Field {
 
 		field.Time("started_at").
 			Default(time.Now).
+			Annotations(entgql.OrderField("STARTED_AT")).
 			Comment("When execution started"),
 
 		field.Time("completed_at").
@@ -64,7 +82,8 @@ func (WorkflowExecution) Fields() []ent.Field {
 			Optional().
 			Comment("Workflow inputs"),
 
-		field.JSON("outputs", map[string]interface{}{}).
+		field.JSON("output_data", map[string]interface{}{}).
+			StorageKey("outputs").
 			Optional().
 			Comment("Workflow outputs"),
 
@@ -102,6 +121,7 @@ func (WorkflowExecution) Edges() []ent.Edge {
 		edge.To("child_executions", WorkflowExecution.Type).
 			From("parent_execution").
 			Unique().
+			Field("parent_execution_id").
 			Comment("Child workflow executions"),
 	}
 }
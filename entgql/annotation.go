@@ -16,6 +16,7 @@ package entgql
 
 import (
 	"encoding/json"
+	"slices"
 
 	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/schema"
@@ -52,6 +53,16 @@ type (
 		// RelayConnection enables the Relay Connection specification for the entity.
 		// It's also can apply on an edge to create the Relay-style filter.
 		RelayConnection bool `json:"RelayConnection,omitempty"`
+		// Aggregate adds the aggregate field to the Relay Connection of the entity.
+		Aggregate bool `json:"Aggregate,omitempty"`
+		// Searchable includes the field in the full-text search of the WhereInput.
+		Searchable bool `json:"Searchable,omitempty"`
+		// JSONFilter adds the predicates of the JSON field to the WhereInput.
+		JSONFilter *JSONFilterConfig `json:"JSONFilter,omitempty"`
+		// Complexity is the weight of the type (or the edge) in the complexity
+		// functions generated for the GraphQL connection and edge fields. It is
+		// a pointer to allow an edge to override the weight of its type with 0.
+		Complexity *int `json:"Complexity,omitempty"`
 		// Implements defines a list of interfaces implemented by the type.
 		Implements []string `json:"Implements,omitempty"`
 		// Directives to add on the field/type.
@@ -60,6 +71,12 @@ type (
 		QueryField *FieldConfig `json:"QueryField,omitempty"`
 		// MutationInputs defines the input types for the mutation.
 		MutationInputs []MutationConfig `json:"MutationInputs,omitempty"`
+		// BulkMutations generates the createMany, updateMany and
+		// deleteMany mutations for the type.
+		BulkMutations bool `json:"BulkMutations,omitempty"`
+		// NestedMutations allows creating and updating the nodes of an
+		// edge from the mutation inputs of its parent type.
+		NestedMutations bool `json:"NestedMutations,omitempty"`
 		// CustomCollectedField adds a custom graphql field that will use field collection.
 		// You must implement query.WithNamedXXX
 		CustomCollectedFields []CustomCollectedField `json:"CustomCollectedFields,omitempty"`
@@ -88,6 +105,21 @@ type (
 		Directives []Directive `json:"Directives,omitempty"`
 	}
 
+	// JSONFilterConfig holds the config of the JSON field predicates.
+	JSONFilterConfig struct {
+		// Paths are the typed paths that can be compared in the WhereInput.
+		Paths []JSONPath `json:"Paths,omitempty"`
+	}
+
+	// JSONPath is a typed path of a JSON field.
+	JSONPath struct {
+		// Path in dot notation (e.g. "author.name").
+		Path string `json:"Path,omitempty"`
+		// Type is the GraphQL type of the value stored in the path. One of
+		// String, Int, Float, Boolean or ID, or a list of them (e.g. [String]).
+		Type string `json:"Type,omitempty"`
+	}
+
 	// MutationConfig hold config for mutation
 	MutationConfig struct {
 		IsCreate    bool   `json:"IsCreate,omitempty"`
@@ -124,6 +156,8 @@ const (
 	// SkipMutationUpdateInput skips generating GraphQL Update<Type>Input types.
 	// If defined on a field, the type will be generated without the field.
 	SkipMutationUpdateInput
+	// SkipSubscription skips generating GraphQL subscriptions for the type.
+	SkipSubscription
 
 	// SkipAll is default mode to skip all.
 	SkipAll = SkipType |
@@ -131,7 +165,8 @@ const (
 		SkipOrderField |
 		SkipWhereInput |
 		SkipMutationCreateInput |
-		SkipMutationUpdateInput
+		SkipMutationUpdateInput |
+		SkipSubscription
 )
 
 // Name implements ent.Annotation interface.
@@ -259,6 +294,10 @@ func CustomManualCollectedFields(fields ...CustomCollectedField) Annotation {
 //		Annotations(
 //			entgql.OrderField("STATUS"),
 //		)
+//
+// Optional fields must also be Nillable to paginate over their NULL values,
+// that are placed according to the ASC_NULLS_FIRST, ASC_NULLS_LAST, etc.
+// directions, or to the default of the database for ASC and DESC.
 func OrderField(name string) Annotation {
 	return Annotation{OrderField: name}
 }
@@ -408,6 +447,105 @@ func RelayConnection() Annotation {
 	return Annotation{RelayConnection: true}
 }
 
+// Aggregate returns an annotation indicating that the Relay Connection of the
+// entity exposes an aggregate field, computed over all items matched by the
+// connection filters, regardless of pagination. The annotation requires the
+// RelayConnection annotation.
+//
+//	func (Todo) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entgql.RelayConnection(),
+//			entgql.Aggregate(),
+//		}
+//	}
+//
+// The generated GraphQL schema will be:
+//
+//	type TodoConnection {
+//		edges: [TodoEdge]
+//		pageInfo: PageInfo!
+//		totalCount: Int!
+//		aggregate: TodoAggregate!
+//	}
+//
+//	type TodoAggregate {
+//		count: Int!
+//		sum: TodoAggregateNumbers
+//		avg: TodoAggregateNumbers
+//		min: TodoAggregateNumbers
+//		max: TodoAggregateNumbers
+//		groupBy(fields: [TodoAggregateField!]!): [TodoAggregateGroup!]!
+//	}
+//
+// The TodoAggregateNumbers type holds a nullable Float for each numeric field of
+// the entity, except for edge fields, and TodoAggregateField enumerates the fields
+// that can be grouped by: enums, strings, booleans and numbers. Only the aggregations
+// that were selected in the query are computed.
+func Aggregate() Annotation {
+	return Annotation{Aggregate: true}
+}
+
+// Searchable returns a field annotation that includes the field in the
+// full-text search of the generated WhereInput. Types with searchable
+// fields have a `search` field in their WhereInput, and a RELEVANCE
+// value in their OrderField enum.
+//
+//	func (Todo) Fields() []ent.Field {
+//		return []ent.Field{
+//			field.String("title").
+//				Annotations(entgql.Searchable()),
+//			field.Text("body").
+//				Annotations(entgql.Searchable()),
+//		}
+//	}
+//
+// The search is executed using to_tsvector and plainto_tsquery on Postgres,
+// and MATCH ... AGAINST on MySQL, which requires a FULLTEXT index on the
+// searchable columns. Other dialects fallback to case-insensitive LIKE. An
+// empty search, or a search of spaces, is ignored as if it was not set.
+func Searchable() Annotation {
+	return Annotation{Searchable: true}
+}
+
+// FilterJSON returns a field annotation that adds the predicates of
+// the JSON field to the generated WhereInput. The `<field>HasKey`
+// predicate is added for any JSON field, and the typed paths add the
+// `<field><Path>` equality predicate, the `<field><Path>Contains`
+// predicate for String paths, and `<field><Path>ArrayContains` for
+// list paths. Paths are named by their segments, and code generation
+// fails if two paths, or a path and another filter, share a name.
+//
+//	func (Todo) Fields() []ent.Field {
+//		return []ent.Field{
+//			field.JSON("metadata", map[string]any{}).
+//				Annotations(entgql.FilterJSON(
+//					entgql.JSONPath{Path: "author.name", Type: "String"},
+//					entgql.JSONPath{Path: "tags", Type: "[String]"},
+//				)),
+//		}
+//	}
+func FilterJSON(paths ...JSONPath) Annotation {
+	return Annotation{JSONFilter: &JSONFilterConfig{Paths: paths}}
+}
+
+// Complexity returns an annotation setting the weight of a type, or of an
+// edge, in the complexity functions generated by the WithComplexity option.
+// The weight is charged once for every node that a connection or an edge
+// field may return, and defaults to 1.
+//
+//	func (User) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entgql.RelayConnection(),
+//			entgql.Complexity(5),
+//		}
+//	}
+//
+// Annotating an edge overrides the weight of its type for this edge only.
+// A zero weight leaves the nodes of the type, or of the edge, out of the count.
+func Complexity(weight int) Annotation {
+	return Annotation{Complexity: &weight}
+}
+
 // Implements returns an Implements annotation.
 // The Implements() annotation is used to
 // add implements interfaces to a GraphQL type.
@@ -553,6 +691,89 @@ func Mutations(inputs ...MutationOption) Annotation {
 	return Annotation{MutationInputs: a}
 }
 
+// BulkMutations returns an annotation for generating the bulk mutations of a
+// type. The createMany mutation is generated for types with a create input,
+// updateMany for types with an update input and a WhereInput, and deleteMany
+// for types with a WhereInput:
+//
+//	func (Todo) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate()),
+//			entgql.BulkMutations(),
+//		}
+//	}
+//
+// The generated GraphQL schema will be:
+//
+//	extend type Mutation {
+//		createManyTodos(input: [CreateTodoInput!]!): [Todo!]!
+//		updateManyTodos(where: TodoWhereInput!, all: Boolean! = false, input: UpdateTodoInput!): Int!
+//		deleteManyTodos(where: TodoWhereInput!, all: Boolean! = false): Int!
+//	}
+//
+// The Mutation type is extended if it is defined by the user schema, and is defined by the
+// generated schema otherwise. Empty filters are rejected, unless the all argument is set to
+// mutate all the nodes of the type.
+//
+// The mutations are resolved by the generated CreateMany, UpdateMany and DeleteMany
+// methods of the type client. Resolvers should use the client stored in the context,
+// in order to run them in the transaction opened by the entgql.Transactioner:
+//
+//	func (r *mutationResolver) DeleteManyTodos(ctx context.Context, where ent.TodoWhereInput, all bool) (int, error) {
+//		return ent.FromContext(ctx).Todo.DeleteMany(ctx, &where, all)
+//	}
+//
+// The nested inputs of the NestedMutations annotation are accepted by createMany, and require
+// the transactional client. They are rejected by updateMany, as they would be attached to all
+// the updated nodes.
+func BulkMutations() Annotation {
+	return Annotation{BulkMutations: true}
+}
+
+// NestedMutations returns an edge annotation that adds nested inputs for the
+// edge to the mutation inputs of its parent type. The Create<T>Input accepts
+// the Create<E>Input of the nodes to create and attach to the edge, and the
+// Update<T>Input accepts Upsert<E>Input values, that either create a new node,
+// or update an existing node by its ID, and attach it to the edge.
+//
+//	func (Todo) Edges() []ent.Edge {
+//		return []ent.Edge{
+//			edge.To("children", Todo.Type).
+//				Annotations(entgql.NestedMutations()).
+//				From("parent").
+//				Unique(),
+//		}
+//	}
+//
+// The generated GraphQL schema will be:
+//
+//	input CreateTodoInput {
+//		childIDs: [ID!]
+//		createChildren: [CreateTodoInput!]
+//	}
+//
+//	input UpdateTodoInput {
+//		addChildIDs: [ID!]
+//		removeChildIDs: [ID!]
+//		upsertChildren: [UpsertTodoInput!]
+//	}
+//
+//	input UpsertTodoInput {
+//		id: ID
+//		create: CreateTodoInput
+//		update: UpdateTodoInput
+//	}
+//
+// The nested nodes are created, or updated, before their parent, by the generated
+// MutateContext and SetInputContext methods, using the client of the parent mutation.
+// Therefore, nested inputs require the parent mutation to run in a transaction, such
+// as the one opened by the entgql.Transactioner, that rolls them back if it fails.
+// The builders of inputs with nested inputs have a SetInputContext method instead of
+// SetInput, and the bulk update builder and UpdateMany do not accept nested inputs.
+func NestedMutations() Annotation {
+	return Annotation{NestedMutations: true}
+}
+
 // Merge implements the schema.Merger interface.
 func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 	var ant Annotation
@@ -593,9 +814,34 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 	if len(ant.MutationInputs) > 0 {
 		a.MutationInputs = append(a.MutationInputs, ant.MutationInputs...)
 	}
+	if ant.BulkMutations {
+		a.BulkMutations = true
+	}
+	if ant.NestedMutations {
+		a.NestedMutations = true
+	}
 	if ant.RelayConnection {
 		a.RelayConnection = true
 	}
+	if ant.Aggregate {
+		a.Aggregate = true
+	}
+	if ant.Searchable {
+		a.Searchable = true
+	}
+	if ant.JSONFilter != nil {
+		if a.JSONFilter == nil {
+			a.JSONFilter = &JSONFilterConfig{}
+		}
+		for _, p := range ant.JSONFilter.Paths {
+			if !slices.Contains(a.JSONFilter.Paths, p) {
+				a.JSONFilter.Paths = append(a.JSONFilter.Paths, p)
+			}
+		}
+	}
+	if ant.Complexity != nil {
+		a.Complexity = ant.Complexity
+	}
 	if len(ant.Implements) > 0 {
 		a.Implements = append(a.Implements, ant.Implements...)
 	}
@@ -36,6 +36,32 @@ func TestAnnotation(t *testing.T) {
 	annotation = entgql.MapsTo(names...)
 	require.True(t, annotation.Unbind)
 	require.ElementsMatch(t, names, annotation.Mapping)
+
+	annotation = entgql.Aggregate()
+	require.True(t, annotation.Aggregate)
+	annotation = entgql.RelayConnection().Merge(entgql.Aggregate()).(entgql.Annotation)
+	require.True(t, annotation.RelayConnection)
+	require.True(t, annotation.Aggregate)
+
+	annotation = entgql.RelayConnection().Merge(entgql.Complexity(5)).(entgql.Annotation)
+	require.True(t, annotation.RelayConnection)
+	require.Equal(t, 5, *annotation.Complexity)
+	annotation = annotation.Merge(entgql.Complexity(0)).(entgql.Annotation)
+	require.Equal(t, 0, *annotation.Complexity)
+	annotation = annotation.Merge(entgql.RelayConnection()).(entgql.Annotation)
+	require.Equal(t, 0, *annotation.Complexity)
+
+	annotation = entgql.Mutations().Merge(entgql.BulkMutations()).(entgql.Annotation)
+	require.Len(t, annotation.MutationInputs, 2)
+	require.True(t, annotation.BulkMutations)
+
+	annotation = entgql.OrderField("TITLE").Merge(entgql.Searchable()).(entgql.Annotation)
+	require.Equal(t, "TITLE", annotation.OrderField)
+	require.True(t, annotation.Searchable)
+
+	annotation = entgql.FilterJSON(entgql.JSONPath{Path: "a", Type: "String"}).
+		Merge(entgql.FilterJSON(entgql.JSONPath{Path: "b", Type: "[Int]"}, entgql.JSONPath{Path: "a", Type: "String"})).(entgql.Annotation)
+	require.Equal(t, []entgql.JSONPath{{Path: "a", Type: "String"}, {Path: "b", Type: "[Int]"}}, annotation.JSONFilter.Paths)
 }
 
 func TestAnnotationDecode(t *testing.T) {
@@ -0,0 +1,177 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// DefaultComplexityListSize is the number of nodes assumed for list
+// fields, and for connections queried without `first` or `last`.
+const DefaultComplexityListSize = 100
+
+const (
+	errComplexityLimit = "COMPLEXITY_LIMIT_EXCEEDED"
+	errDepthLimit      = "DEPTH_LIMIT_EXCEEDED"
+)
+
+// ComplexityFunc computes the complexity of a field from the
+// complexity of its selected children and from its arguments.
+type ComplexityFunc func(childComplexity int, args map[string]any) int
+
+// EdgeComplexity returns the ComplexityFunc of a field that
+// resolves to at most one node of the given weight.
+func EdgeComplexity(weight int) ComplexityFunc {
+	return func(childComplexity int, _ map[string]any) int {
+		return safeAdd(weight, childComplexity)
+	}
+}
+
+// ConnectionComplexity returns the ComplexityFunc of a list or connection
+// field of nodes of the given weight. The number of nodes is taken from the
+// `first` or `last` arguments, and defaults to DefaultComplexityListSize.
+func ConnectionComplexity(weight int) ComplexityFunc {
+	return func(childComplexity int, args map[string]any) int {
+		size := DefaultComplexityListSize
+		for _, name := range []string{"first", "last"} {
+			if v, ok := args[name]; ok && v != nil {
+				if n, err := graphql.UnmarshalInt(v); err == nil {
+					size = max(n, 0)
+				}
+			}
+		}
+		return safeMul(size, safeAdd(weight, childComplexity))
+	}
+}
+
+// ComplexityLimit is a GraphQL handler extension that rejects operations
+// whose complexity or depth exceed the configured limits. The complexity of
+// the fields found in Funcs, such as the generated ent.Complexity map, takes
+// precedence over the complexity functions configured in the executable schema.
+//
+//	srv.Use(&entgql.ComplexityLimit{
+//		Funcs:         ent.Complexity,
+//		MaxComplexity: 1000,
+//		MaxDepth:      10,
+//	})
+type ComplexityLimit struct {
+	// Funcs holds the complexity functions keyed by "<Type>.<field>".
+	Funcs map[string]ComplexityFunc
+	// MaxComplexity is the complexity budget of an operation.
+	// A zero value disables the complexity limit.
+	MaxComplexity int
+	// MaxDepth is the maximum nesting of fields in an operation.
+	// A zero value disables the depth limit.
+	MaxDepth int
+
+	es graphql.ExecutableSchema
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = (*ComplexityLimit)(nil)
+
+// ExtensionName returns the extension name.
+func (*ComplexityLimit) ExtensionName() string {
+	return "EntGQLComplexityLimit"
+}
+
+// Validate is called when adding an extension to the server, it allows validation against the servers schema.
+func (c *ComplexityLimit) Validate(es graphql.ExecutableSchema) error {
+	if c.MaxComplexity < 0 || c.MaxDepth < 0 {
+		return errors.New("entgql: complexity limits cannot be negative")
+	}
+	c.es = es
+	return nil
+}
+
+// MutateOperationContext rejects the operation if it exceeds the configured limits.
+func (c *ComplexityLimit) MutateOperationContext(_ context.Context, oc *graphql.OperationContext) *gqlerror.Error {
+	if c.MaxDepth > 0 {
+		if depth := selectionDepth(oc.Operation.SelectionSet); depth > c.MaxDepth {
+			err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, c.MaxDepth)
+			errcode.Set(err, errDepthLimit)
+			return err
+		}
+	}
+	if c.MaxComplexity > 0 {
+		es := &complexitySchema{ExecutableSchema: c.es, funcs: c.Funcs}
+		if n := complexity.Calculate(es, oc.Operation, oc.Variables); n > c.MaxComplexity {
+			err := gqlerror.Errorf("operation has complexity %d, which exceeds the limit of %d", n, c.MaxComplexity)
+			errcode.Set(err, errComplexityLimit)
+			return err
+		}
+	}
+	return nil
+}
+
+// complexitySchema wraps an executable schema with the complexity functions of a ComplexityLimit.
+type complexitySchema struct {
+	graphql.ExecutableSchema
+	funcs map[string]ComplexityFunc
+}
+
+// Complexity implements the graphql.ExecutableSchema interface.
+func (s *complexitySchema) Complexity(typeName, field string, childComplexity int, args map[string]any) (int, bool) {
+	if f, ok := s.funcs[typeName+"."+field]; ok {
+		return f(childComplexity, args), true
+	}
+	return s.ExecutableSchema.Complexity(typeName, field, childComplexity, args)
+}
+
+// selectionDepth returns the maximum nesting of fields in the selection set,
+// excluding the introspection fields.
+func selectionDepth(set ast.SelectionSet) int {
+	var depth int
+	for _, s := range set {
+		switch s := s.(type) {
+		case *ast.Field:
+			if !strings.HasPrefix(s.Name, "__") {
+				depth = max(depth, 1+selectionDepth(s.SelectionSet))
+			}
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				depth = max(depth, selectionDepth(s.Definition.SelectionSet))
+			}
+		case *ast.InlineFragment:
+			depth = max(depth, selectionDepth(s.SelectionSet))
+		}
+	}
+	return depth
+}
+
+func safeAdd(a, b int) int {
+	if a > 0 && b > math.MaxInt-a {
+		return math.MaxInt
+	}
+	return a + b
+}
+
+func safeMul(a, b int) int {
+	if a > 0 && b > math.MaxInt/a {
+		return math.MaxInt
+	}
+	return a * b
+}
@@ -0,0 +1,95 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const complexitySchema = `
+type Query {
+	users(first: Int, last: Int): UserConnection!
+}
+type UserConnection {
+	edges: [UserEdge]
+	totalCount: Int!
+}
+type UserEdge {
+	node: User
+}
+type User {
+	name: String
+	friends(first: Int, last: Int): UserConnection!
+}
+`
+
+func TestConnectionComplexity(t *testing.T) {
+	t.Parallel()
+	f := entgql.ConnectionComplexity(2)
+	require.Equal(t, 10*(2+3), f(3, map[string]any{"first": int64(10)}))
+	require.Equal(t, 5*(2+3), f(3, map[string]any{"last": json.Number("5")}))
+	require.Equal(t, entgql.DefaultComplexityListSize*(2+3), f(3, nil))
+	require.Equal(t, math.MaxInt, f(math.MaxInt, map[string]any{"first": int64(2)}))
+	require.Equal(t, 2+3, entgql.EdgeComplexity(2)(3, nil))
+}
+
+func TestComplexityLimit(t *testing.T) {
+	t.Parallel()
+	schema := gqlparser.MustLoadSchema(&ast.Source{Input: complexitySchema})
+	es := &graphql.ExecutableSchemaMock{
+		SchemaFunc: func() *ast.Schema { return schema },
+		ComplexityFunc: func(string, string, int, map[string]any) (int, bool) {
+			return 0, false
+		},
+	}
+	funcs := map[string]entgql.ComplexityFunc{
+		"Query.users":  entgql.ConnectionComplexity(1),
+		"User.friends": entgql.ConnectionComplexity(1),
+	}
+	doc := gqlparser.MustLoadQuery(schema, `query($n: Int) {
+		users(first: $n) {
+			edges { node { name friends(first: 5) { totalCount } } }
+		}
+	}`)
+	oc := &graphql.OperationContext{
+		Operation: doc.Operations[0],
+		Variables: map[string]any{"n": int64(10)},
+	}
+	mutate := func(limit *entgql.ComplexityLimit) error {
+		require.NoError(t, limit.Validate(es))
+		if err := limit.MutateOperationContext(context.Background(), oc); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	// users: 10 * (1 + edges), edges: 1 + node, node: 1 + name + friends,
+	// and friends: 5 * (1 + totalCount).
+	require.NoError(t, mutate(&entgql.ComplexityLimit{Funcs: funcs, MaxComplexity: 140, MaxDepth: 5}))
+	err := mutate(&entgql.ComplexityLimit{Funcs: funcs, MaxComplexity: 139})
+	require.EqualError(t, err, "input: operation has complexity 140, which exceeds the limit of 139")
+	err = mutate(&entgql.ComplexityLimit{Funcs: funcs, MaxDepth: 4})
+	require.EqualError(t, err, "input: operation has depth 5, which exceeds the limit of 4")
+	require.Error(t, (&entgql.ComplexityLimit{MaxDepth: -1}).Validate(es))
+}
@@ -0,0 +1,207 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+type (
+	// CursorCodec encodes the cursors sent to clients, and decodes the
+	// cursors received from them. Decode must return an error for cursors
+	// that were not encoded by the codec, or that were tampered with.
+	CursorCodec interface {
+		Encode([]byte) (string, error)
+		Decode(string) ([]byte, error)
+	}
+
+	// Base64Codec is the default CursorCodec. It encodes cursors in
+	// base64, and clients can therefore read and forge them.
+	Base64Codec struct{}
+
+	// HMACCodec is a CursorCodec that signs cursors with HMAC-SHA256.
+	// Clients can read signed cursors, but cannot forge them.
+	HMACCodec struct {
+		key []byte
+	}
+
+	// AESGCMCodec is a CursorCodec that encrypts cursors with AES-GCM.
+	// Clients can neither read encrypted cursors, nor forge them.
+	AESGCMCodec struct {
+		aead cipher.AEAD
+	}
+)
+
+// Encode implements the CursorCodec interface.
+func (Base64Codec) Encode(b []byte) (string, error) {
+	return base64.RawStdEncoding.EncodeToString(b), nil
+}
+
+// Decode implements the CursorCodec interface.
+func (Base64Codec) Decode(s string) ([]byte, error) {
+	return base64.RawStdEncoding.DecodeString(s)
+}
+
+// NewHMACCodec returns a CursorCodec that signs cursors with the given key.
+func NewHMACCodec(key []byte) *HMACCodec {
+	return &HMACCodec{key: key}
+}
+
+// Encode implements the CursorCodec interface.
+func (c *HMACCodec) Encode(b []byte) (string, error) {
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString(b) + "." + enc.EncodeToString(c.sign(b)), nil
+}
+
+// Decode implements the CursorCodec interface.
+func (c *HMACCodec) Decode(s string) ([]byte, error) {
+	payload, sig, ok := strings.Cut(s, ".")
+	if !ok {
+		return nil, errors.New("entgql: cursor is not signed")
+	}
+	enc := base64.RawURLEncoding
+	b, err := enc.DecodeString(payload)
+	if err != nil {
+		return nil, fmt.Errorf("entgql: decoding cursor payload: %w", err)
+	}
+	mac, err := enc.DecodeString(sig)
+	if err != nil {
+		return nil, fmt.Errorf("entgql: decoding cursor signature: %w", err)
+	}
+	if !hmac.Equal(mac, c.sign(b)) {
+		return nil, errors.New("entgql: cursor signature mismatch")
+	}
+	return b, nil
+}
+
+func (c *HMACCodec) sign(b []byte) []byte {
+	h := hmac.New(sha256.New, c.key)
+	h.Write(b)
+	return h.Sum(nil)
+}
+
+// NewAESGCMCodec returns a CursorCodec that encrypts cursors with the given
+// key. The key must be 16, 24 or 32 bytes long, to select AES-128, AES-192
+// or AES-256.
+func NewAESGCMCodec(key []byte) (*AESGCMCodec, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("entgql: creating cursor cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("entgql: creating cursor cipher: %w", err)
+	}
+	return &AESGCMCodec{aead: aead}, nil
+}
+
+// Encode implements the CursorCodec interface.
+func (c *AESGCMCodec) Encode(b []byte) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize(), c.aead.NonceSize()+len(b)+c.aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("entgql: generating cursor nonce: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(c.aead.Seal(nonce, nonce, b, nil)), nil
+}
+
+// Decode implements the CursorCodec interface.
+func (c *AESGCMCodec) Decode(s string) ([]byte, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("entgql: decoding cursor: %w", err)
+	}
+	if len(b) < c.aead.NonceSize() {
+		return nil, errors.New("entgql: cursor is too short")
+	}
+	nonce, ciphertext := b[:c.aead.NonceSize()], b[c.aead.NonceSize():]
+	b, err = c.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("entgql: decrypting cursor: %w", err)
+	}
+	return b, nil
+}
+
+// ErrCursorCodecMissing is returned by the pagination generated with the
+// WithCursorCodec option, for operations without a CursorCodec in their context.
+var ErrCursorCodecMissing = errors.New("entgql: cursor codec is missing from the context")
+
+type cursorCodecKey struct{}
+
+// NewCursorCodecContext returns a new context with the given CursorCodec attached.
+func NewCursorCodecContext(parent context.Context, c CursorCodec) context.Context {
+	return context.WithValue(parent, cursorCodecKey{}, c)
+}
+
+// CursorCodecFromContext returns the CursorCodec stored in a context,
+// or Base64Codec if there is no codec in the context.
+func CursorCodecFromContext(ctx context.Context) CursorCodec {
+	if c, ok := ctx.Value(cursorCodecKey{}).(CursorCodec); ok && c != nil {
+		return c
+	}
+	return Base64Codec{}
+}
+
+// HasCursorCodec reports if a CursorCodec is stored in the context.
+func HasCursorCodec(ctx context.Context) bool {
+	c, ok := ctx.Value(cursorCodecKey{}).(CursorCodec)
+	return ok && c != nil
+}
+
+// CursorCodecExtension is a GraphQL handler extension that sets
+// the CursorCodec used by the generated pagination to encode and
+// decode the cursors of the operations it handles.
+//
+//	codec, err := entgql.NewAESGCMCodec(key)
+//	if err != nil {
+//		return err
+//	}
+//	srv.Use(entgql.CursorCodecExtension{Codec: codec})
+type CursorCodecExtension struct {
+	Codec CursorCodec
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = CursorCodecExtension{}
+
+// ExtensionName returns the extension name.
+func (CursorCodecExtension) ExtensionName() string {
+	return "EntGQLCursorCodec"
+}
+
+// Validate is called when adding an extension to the server, it allows validation against the servers schema.
+func (e CursorCodecExtension) Validate(graphql.ExecutableSchema) error {
+	if e.Codec == nil {
+		return errors.New("entgql: cursor codec is nil")
+	}
+	return nil
+}
+
+// InterceptResponse attaches the CursorCodec to the context of the operation.
+func (e CursorCodecExtension) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	return next(NewCursorCodecContext(ctx, e.Codec))
+}
@@ -0,0 +1,113 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestCursorCodec(t *testing.T) {
+	t.Parallel()
+	aesgcm, err := entgql.NewAESGCMCodec(bytes.Repeat([]byte{1}, 32))
+	require.NoError(t, err)
+	_, err = entgql.NewAESGCMCodec([]byte("short"))
+	require.Error(t, err)
+	for name, codec := range map[string]entgql.CursorCodec{
+		"Base64": entgql.Base64Codec{},
+		"HMAC":   entgql.NewHMACCodec([]byte("secret")),
+		"AESGCM": aesgcm,
+	} {
+		codec := codec
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			s, err := codec.Encode([]byte("a8m@entgo.io"))
+			require.NoError(t, err)
+			b, err := codec.Decode(s)
+			require.NoError(t, err)
+			require.Equal(t, "a8m@entgo.io", string(b))
+			if _, ok := codec.(entgql.Base64Codec); ok {
+				return
+			}
+			require.NotContains(t, s, "a8m@entgo.io")
+			_, err = codec.Decode(tamper(s))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestCursorCodec_Forged(t *testing.T) {
+	t.Parallel()
+	s, err := entgql.NewHMACCodec([]byte("secret")).Encode([]byte("1"))
+	require.NoError(t, err)
+	_, err = entgql.NewHMACCodec([]byte("other")).Decode(s)
+	require.EqualError(t, err, "entgql: cursor signature mismatch")
+	_, err = entgql.NewHMACCodec([]byte("secret")).Decode(strings.Split(s, ".")[0])
+	require.EqualError(t, err, "entgql: cursor is not signed")
+}
+
+func TestCursor_MarshalGQLContext(t *testing.T) {
+	t.Parallel()
+	ctx := entgql.NewCursorCodecContext(context.Background(), entgql.NewHMACCodec([]byte("secret")))
+	var b bytes.Buffer
+	require.NoError(t, entgql.Cursor[int]{ID: 1, Value: "a8m"}.MarshalGQLContext(ctx, &b))
+	s, err := strconv.Unquote(b.String())
+	require.NoError(t, err)
+
+	var c entgql.Cursor[int]
+	require.NoError(t, c.UnmarshalGQLContext(ctx, s))
+	require.Equal(t, entgql.Cursor[int]{ID: 1, Value: "a8m"}, c)
+
+	// Cursors that were not signed by the codec are rejected.
+	b.Reset()
+	entgql.Cursor[int]{ID: 1, Value: "a8m"}.MarshalGQL(&b)
+	s, err = strconv.Unquote(b.String())
+	require.NoError(t, err)
+	err = c.UnmarshalGQLContext(ctx, s)
+	var gqlErr *gqlerror.Error
+	require.ErrorAs(t, err, &gqlErr)
+	require.Equal(t, "INVALID_CURSOR", gqlErr.Extensions["code"])
+	err = c.UnmarshalGQLContext(ctx, tamper(s))
+	require.ErrorAs(t, err, &gqlErr)
+	require.Equal(t, "INVALID_CURSOR", gqlErr.Extensions["code"])
+}
+
+func TestCursorCodecExtension(t *testing.T) {
+	t.Parallel()
+	require.Error(t, entgql.CursorCodecExtension{}.Validate(nil))
+	codec := entgql.NewHMACCodec([]byte("secret"))
+	ext := entgql.CursorCodecExtension{Codec: codec}
+	require.NoError(t, ext.Validate(nil))
+	require.IsType(t, entgql.Base64Codec{}, entgql.CursorCodecFromContext(context.Background()))
+	require.False(t, entgql.HasCursorCodec(context.Background()))
+	require.True(t, entgql.HasCursorCodec(entgql.NewCursorCodecContext(context.Background(), codec)))
+	require.Equal(t, codec, entgql.CursorCodecFromContext(entgql.NewCursorCodecContext(context.Background(), codec)))
+}
+
+// tamper flips the first character of the given cursor.
+func tamper(s string) string {
+	c := byte('A')
+	if s[0] == c {
+		c = 'B'
+	}
+	return string(c) + s[1:]
+}
@@ -0,0 +1,229 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// DefaultLoaderWait is the duration a Loader waits
+// for more keys before fetching the batch.
+const DefaultLoaderWait = time.Millisecond
+
+type (
+	// Loader batches the keys loaded concurrently, for example, by the
+	// resolvers of sibling GraphQL fields, and fetches them at once.
+	// Unlike the eager-loading of CollectFields, the results are not
+	// cached, and keys that are loaded again are fetched again.
+	Loader[K comparable, V any] struct {
+		fetch    func(context.Context, []K) (map[K]V, error)
+		wait     time.Duration
+		maxBatch int
+
+		mu    sync.Mutex
+		batch *loaderBatch[K, V]
+	}
+
+	// LoaderOption allows configuring a Loader.
+	LoaderOption func(*loaderConfig)
+
+	loaderConfig struct {
+		wait     time.Duration
+		maxBatch int
+	}
+
+	// loaderBatch holds the keys of a batch, and its results once fetched.
+	loaderBatch[K comparable, V any] struct {
+		keys   map[K]struct{}
+		full   chan struct{}
+		done   chan struct{}
+		values map[K]V
+		err    error
+	}
+)
+
+// WithLoaderWait sets the duration a Loader waits for more keys before
+// fetching the batch. The default is DefaultLoaderWait.
+func WithLoaderWait(d time.Duration) LoaderOption {
+	return func(c *loaderConfig) {
+		c.wait = d
+	}
+}
+
+// WithLoaderMaxBatch limits the number of keys fetched in a single batch.
+// A zero value, the default, means there is no limit.
+func WithLoaderMaxBatch(n int) LoaderOption {
+	return func(c *loaderConfig) {
+		c.maxBatch = n
+	}
+}
+
+// NewLoader returns a new Loader that fetches its batches using the given
+// function. Keys that are missing from the returned map are loaded as the
+// zero value of V.
+func NewLoader[K comparable, V any](fetch func(context.Context, []K) (map[K]V, error), opts ...LoaderOption) *Loader[K, V] {
+	l := &Loader[K, V]{fetch: fetch, wait: DefaultLoaderWait}
+	l.configure(opts)
+	return l
+}
+
+// configure applies the given options on the loader.
+func (l *Loader[K, V]) configure(opts []LoaderOption) {
+	c := &loaderConfig{wait: l.wait, maxBatch: l.maxBatch}
+	for _, opt := range opts {
+		opt(c)
+	}
+	l.wait, l.maxBatch = c.wait, c.maxBatch
+}
+
+// Load adds the key to the pending batch, and returns its value once the batch is fetched.
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	b := l.batch
+	if b == nil {
+		b = &loaderBatch[K, V]{
+			keys: make(map[K]struct{}),
+			full: make(chan struct{}),
+			done: make(chan struct{}),
+		}
+		l.batch = b
+		// The batch is fetched without the cancellation of the first
+		// caller, as it is shared with the rest of the callers.
+		go l.dispatch(context.WithoutCancel(ctx), b)
+	}
+	b.keys[key] = struct{}{}
+	if l.maxBatch > 0 && len(b.keys) >= l.maxBatch {
+		l.batch = nil
+		close(b.full)
+	}
+	l.mu.Unlock()
+	select {
+	case <-b.done:
+		return b.values[key], b.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// dispatch fetches the batch once it is full, or after the wait duration.
+func (l *Loader[K, V]) dispatch(ctx context.Context, b *loaderBatch[K, V]) {
+	timer := time.NewTimer(l.wait)
+	defer timer.Stop()
+	select {
+	case <-b.full:
+	case <-timer.C:
+		l.mu.Lock()
+		if l.batch == b {
+			l.batch = nil
+		}
+		l.mu.Unlock()
+	}
+	keys := make([]K, 0, len(b.keys))
+	for k := range b.keys {
+		keys = append(keys, k)
+	}
+	b.values, b.err = l.fetch(ctx, keys)
+	close(b.done)
+}
+
+// loadersCtxKey is the context key of the loaders registry.
+type loadersCtxKey struct{}
+
+// loaders holds the loaders of a context by their keys.
+type loaders struct {
+	mu   sync.Mutex
+	m    map[any]any
+	opts []LoaderOption
+}
+
+// NewLoadersContext returns a new context that scopes the loaders created by
+// LoaderFromContext, typically to a request. The given options are applied
+// on the loaders created in the context, after their own options.
+func NewLoadersContext(parent context.Context, opts ...LoaderOption) context.Context {
+	return context.WithValue(parent, loadersCtxKey{}, &loaders{m: make(map[any]any), opts: opts})
+}
+
+// LoaderFromContext returns the loader registered in the context under the given
+// key, and registers the loader returned by newLoader if it does not exist. A nil
+// Loader is returned if the context was not created using NewLoadersContext.
+//
+// The key must be comparable, and should identify everything the loader captures,
+// such as the client or the transaction it queries, because the loader is shared
+// by all callers using the same key. Batches are fetched using the context of the
+// caller that started them, without its cancellation.
+func LoaderFromContext[K comparable, V any](ctx context.Context, key any, newLoader func() *Loader[K, V]) *Loader[K, V] {
+	ls, ok := ctx.Value(loadersCtxKey{}).(*loaders)
+	if !ok {
+		return nil
+	}
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	if l, ok := ls.m[key].(*Loader[K, V]); ok {
+		return l
+	}
+	l := newLoader()
+	l.configure(ls.opts)
+	ls.m[key] = l
+	return l
+}
+
+// Dataloader is a GraphQL handler extension that scopes the loaders
+// of the generated edge resolvers to the operations it handles. Edges
+// that were not eager-loaded by CollectFields, such as edges resolved
+// from custom resolvers, interfaces, unions or federation entities, are
+// then loaded in batches instead of a query per node.
+//
+// The generated loaders are keyed by the edge and by the driver of the nodes,
+// so nodes queried in a transaction are loaded in that transaction. A batch
+// is fetched using the context of its first caller, and all callers are
+// expected to share the values of the operation context, such as the viewer.
+//
+//	srv.Use(entgql.Dataloader{})
+type Dataloader struct {
+	// Options are applied on the loaders of the operations, for
+	// example, to wait longer for the keys of a batch:
+	//
+	//	srv.Use(entgql.Dataloader{
+	//		Options: []entgql.LoaderOption{
+	//			entgql.WithLoaderWait(5 * time.Millisecond),
+	//		},
+	//	})
+	Options []LoaderOption
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = Dataloader{}
+
+// ExtensionName returns the extension name.
+func (Dataloader) ExtensionName() string {
+	return "EntGQLDataloader"
+}
+
+// Validate is called when adding an extension to the server, it allows validation against the servers schema.
+func (Dataloader) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptResponse scopes the loaders to the context of the operation.
+func (d Dataloader) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	return next(NewLoadersContext(ctx, d.Options...))
+}
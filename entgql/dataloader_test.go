@@ -0,0 +1,148 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/require"
+)
+
+// recorder records the batches fetched by a loader.
+type recorder struct {
+	mu      sync.Mutex
+	batches [][]int
+}
+
+func (r *recorder) fetch(_ context.Context, keys []int) (map[int]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	sort.Ints(keys)
+	r.batches = append(r.batches, keys)
+	m := make(map[int]string, len(keys))
+	for _, k := range keys {
+		if k > 0 {
+			m[k] = string(rune('a' + k))
+		}
+	}
+	return m, nil
+}
+
+func loadAll(t *testing.T, l *entgql.Loader[int, string], keys ...int) []string {
+	var (
+		wg     sync.WaitGroup
+		values = make([]string, len(keys))
+		errs   = make([]error, len(keys))
+	)
+	for i, k := range keys {
+		wg.Add(1)
+		go func(i, k int) {
+			defer wg.Done()
+			values[i], errs[i] = l.Load(context.Background(), k)
+		}(i, k)
+	}
+	wg.Wait()
+	require.NoError(t, errors.Join(errs...))
+	return values
+}
+
+func TestLoader(t *testing.T) {
+	t.Parallel()
+	r := &recorder{}
+	l := entgql.NewLoader(r.fetch, entgql.WithLoaderWait(50*time.Millisecond))
+	require.Equal(t, []string{"b", "c", "b", ""}, loadAll(t, l, 1, 2, 1, 0))
+	require.Equal(t, [][]int{{0, 1, 2}}, r.batches)
+
+	// Results are not cached between batches.
+	require.Equal(t, []string{"b"}, loadAll(t, l, 1))
+	require.Equal(t, [][]int{{0, 1, 2}, {1}}, r.batches)
+}
+
+func TestLoader_MaxBatch(t *testing.T) {
+	t.Parallel()
+	r := &recorder{}
+	l := entgql.NewLoader(r.fetch, entgql.WithLoaderWait(time.Hour), entgql.WithLoaderMaxBatch(2))
+	require.Equal(t, []string{"b", "c", "d", "e"}, loadAll(t, l, 1, 2, 3, 4))
+	require.Len(t, r.batches, 2)
+}
+
+func TestLoader_Error(t *testing.T) {
+	t.Parallel()
+	l := entgql.NewLoader(func(context.Context, []int) (map[int]string, error) {
+		return nil, errors.New("boom")
+	})
+	_, err := l.Load(context.Background(), 1)
+	require.EqualError(t, err, "boom")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	l = entgql.NewLoader((&recorder{}).fetch, entgql.WithLoaderWait(time.Hour))
+	_, err = l.Load(ctx, 1)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestLoaderFromContext(t *testing.T) {
+	t.Parallel()
+	newLoader := func() *entgql.Loader[int, string] {
+		return entgql.NewLoader((&recorder{}).fetch)
+	}
+	require.Nil(t, entgql.LoaderFromContext(context.Background(), "Todo.parent", newLoader))
+	ctx := entgql.NewLoadersContext(context.Background())
+	l := entgql.LoaderFromContext(ctx, "Todo.parent", newLoader)
+	require.NotNil(t, l)
+	require.True(t, l == entgql.LoaderFromContext(ctx, "Todo.parent", newLoader))
+	require.False(t, l == entgql.LoaderFromContext(ctx, "Todo.owner", newLoader))
+	require.False(t, l == entgql.LoaderFromContext(entgql.NewLoadersContext(context.Background()), "Todo.parent", newLoader))
+
+	// Loaders of different clients are registered under different keys.
+	type key struct {
+		edge   string
+		client *recorder
+	}
+	c1, c2 := &recorder{}, &recorder{}
+	l = entgql.LoaderFromContext(ctx, key{"Todo.parent", c1}, newLoader)
+	require.True(t, l == entgql.LoaderFromContext(ctx, key{"Todo.parent", c1}, newLoader))
+	require.False(t, l == entgql.LoaderFromContext(ctx, key{"Todo.parent", c2}, newLoader))
+}
+
+func TestDataloader(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, entgql.Dataloader{}.Validate(nil))
+	entgql.Dataloader{}.InterceptResponse(context.Background(), func(ctx context.Context) *graphql.Response {
+		require.NotNil(t, entgql.LoaderFromContext(ctx, "Todo.parent", func() *entgql.Loader[int, string] {
+			return entgql.NewLoader((&recorder{}).fetch)
+		}))
+		return &graphql.Response{}
+	})
+
+	// The options of the extension override the options of the loaders.
+	d := entgql.Dataloader{Options: []entgql.LoaderOption{entgql.WithLoaderMaxBatch(2)}}
+	d.InterceptResponse(context.Background(), func(ctx context.Context) *graphql.Response {
+		r := &recorder{}
+		l := entgql.LoaderFromContext(ctx, "Todo.parent", func() *entgql.Loader[int, string] {
+			return entgql.NewLoader(r.fetch, entgql.WithLoaderWait(time.Hour))
+		})
+		require.Equal(t, []string{"b", "c"}, loadAll(t, l, 1, 2))
+		require.Equal(t, [][]int{{1, 2}}, r.batches)
+		return &graphql.Response{}
+	})
+}
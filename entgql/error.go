@@ -25,3 +25,20 @@ func ErrNodeNotFound(id interface{}) *gqlerror.Error {
 	errcode.Set(err, "NOT_FOUND")
 	return err
 }
+
+// ErrInvalidCursor creates an invalid cursor graphql error, for cursors
+// that cannot be decoded, or that were tampered with.
+func ErrInvalidCursor(err error) *gqlerror.Error {
+	gqlErr := gqlerror.Errorf("Invalid cursor")
+	gqlErr.Err = err
+	errcode.Set(gqlErr, "INVALID_CURSOR")
+	return gqlErr
+}
+
+// ErrSearchRequired creates a graphql error for queries ordered by
+// the relevance of a search, without a search in their filter.
+func ErrSearchRequired() *gqlerror.Error {
+	err := gqlerror.Errorf("Ordering by %s requires a search", RelevanceOrderField)
+	errcode.Set(err, "SEARCH_REQUIRED")
+	return err
+}
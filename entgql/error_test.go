@@ -15,6 +15,7 @@
 package entgql_test
 
 import (
+	"errors"
 	"testing"
 
 	"entgo.io/contrib/entgql"
@@ -27,3 +28,19 @@ func TestErrNodeNotFound(t *testing.T) {
 	require.EqualError(t, err, "input: Could not resolve to a node with the global id of '42'")
 	require.Equal(t, "NOT_FOUND", err.Extensions["code"])
 }
+
+func TestErrInvalidCursor(t *testing.T) {
+	t.Parallel()
+	cause := errors.New("signature mismatch")
+	err := entgql.ErrInvalidCursor(cause)
+	require.EqualError(t, err, "input: Invalid cursor")
+	require.Equal(t, "INVALID_CURSOR", err.Extensions["code"])
+	require.ErrorIs(t, err, cause)
+}
+
+func TestErrSearchRequired(t *testing.T) {
+	t.Parallel()
+	err := entgql.ErrSearchRequired()
+	require.EqualError(t, err, "input: Ordering by RELEVANCE requires a search")
+	require.Equal(t, "SEARCH_REQUIRED", err.Extensions["code"])
+}
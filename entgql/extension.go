@@ -159,6 +159,80 @@ func WithNodeDescriptor(b bool) ExtensionOption {
 	}
 }
 
+// WithSubscriptions configures the extension to generate the GraphQL Subscription
+// type, with the <type>Created, <type>Updated and <type>Deleted fields for each
+// type, and adds the SubscriptionTemplate to the code generation templates.
+//
+// Subscribers are notified by the generated SubscriptionHook through a PubSub,
+// only after the mutations were committed:
+//
+//	pubsub := entgql.NewMemoryPubSub(0)
+//	client.Use(ent.SubscriptionHook(pubsub, entgql.WithSubscriptionLogger(logger)))
+//
+// Types can be excluded using the entgql.Skip(entgql.SkipSubscription) annotation.
+func WithSubscriptions() ExtensionOption {
+	return func(ex *Extension) error {
+		ex.genSubscriptions = true
+		if _, exists := ex.hasTemplate(SubscriptionTemplate); !exists {
+			ex.templates = append(ex.templates, SubscriptionTemplate)
+		}
+		return nil
+	}
+}
+
+// WithComplexity configures the extension to generate the complexity functions
+// of the GraphQL connection and edge fields, and adds the ComplexityTemplate to
+// the code generation templates. The generated ent.Complexity map is used by the
+// ComplexityLimit extension to reject operations over a budget or a depth:
+//
+//	srv.Use(&entgql.ComplexityLimit{Funcs: ent.Complexity, MaxComplexity: 1000})
+//
+// The weight of types and edges is set using the entgql.Complexity annotation.
+func WithComplexity() ExtensionOption {
+	return func(ex *Extension) error {
+		if _, exists := ex.hasTemplate(ComplexityTemplate); !exists {
+			ex.templates = append(ex.templates, ComplexityTemplate)
+		}
+		return nil
+	}
+}
+
+// WithDataloaders configures the extension to generate the batched loaders of the
+// edges, and adds the DataloaderTemplate to the code generation templates. Edge
+// resolvers fallback to the loaders when the edge was not eager-loaded by field
+// collection, for example, when the edge is resolved from a custom resolver, an
+// interface, a union or a federation entity. The loaders are scoped to requests
+// by the Dataloader extension:
+//
+//	srv.Use(entgql.Dataloader{})
+//
+// Note that Relay connection edges are not loaded in batches.
+func WithDataloaders() ExtensionOption {
+	return func(ex *Extension) error {
+		if _, exists := ex.hasTemplate(DataloaderTemplate); !exists {
+			ex.templates = append(ex.templates, DataloaderTemplate)
+		}
+		return nil
+	}
+}
+
+// WithCursorCodec configures the generated pagination to fail closed with
+// ErrCursorCodecMissing on operations without a CursorCodec in their context,
+// and adds the CursorCodecTemplate to the code generation templates. It is used
+// by servers that sign or encrypt their cursors, to reject the operations of a
+// handler that is missing the CursorCodecExtension, instead of accepting and
+// returning cursors encoded in plain base64:
+//
+//	srv.Use(entgql.CursorCodecExtension{Codec: codec})
+func WithCursorCodec() ExtensionOption {
+	return func(ex *Extension) error {
+		if _, exists := ex.hasTemplate(CursorCodecTemplate); !exists {
+			ex.templates = append(ex.templates, CursorCodecTemplate)
+		}
+		return nil
+	}
+}
+
 // WithRelaySpec enables or disables generating the Relay Node interface.
 func WithRelaySpec(enabled bool) ExtensionOption {
 	return func(e *Extension) error {
@@ -25,6 +25,21 @@ import (
 	"entgo.io/contrib/entgql/internal/todo/ent"
 )
 
+// CreateManyCategories is the resolver for the createManyCategories field.
+func (r *mutationResolver) CreateManyCategories(ctx context.Context, input []*ent.CreateCategoryInput) ([]*ent.Category, error) {
+	return ent.FromContext(ctx).Category.CreateMany(ctx, input)
+}
+
+// UpdateManyCategories is the resolver for the updateManyCategories field.
+func (r *mutationResolver) UpdateManyCategories(ctx context.Context, where ent.CategoryWhereInput, all bool, input ent.UpdateCategoryInput) (int, error) {
+	return ent.FromContext(ctx).Category.UpdateMany(ctx, &where, input, all)
+}
+
+// DeleteManyCategories is the resolver for the deleteManyCategories field.
+func (r *mutationResolver) DeleteManyCategories(ctx context.Context, where ent.CategoryWhereInput, all bool) (int, error) {
+	return ent.FromContext(ctx).Category.DeleteMany(ctx, &where, all)
+}
+
 // Node is the resolver for the node field.
 func (r *queryResolver) Node(ctx context.Context, id int) (ent.Noder, error) {
 	return r.client.Noder(ctx, id)
@@ -82,12 +97,150 @@ func (r *queryResolver) Users(ctx context.Context, after *entgql.Cursor[int], fi
 		)
 }
 
+// BillProductCreated is the resolver for the billProductCreated field.
+func (r *subscriptionResolver) BillProductCreated(ctx context.Context, where *ent.BillProductWhereInput) (<-chan *ent.BillProduct, error) {
+	return r.client.BillProduct.SubscribeCreated(ctx, r.pubsub, where)
+}
+
+// BillProductUpdated is the resolver for the billProductUpdated field.
+func (r *subscriptionResolver) BillProductUpdated(ctx context.Context, where *ent.BillProductWhereInput) (<-chan *ent.BillProduct, error) {
+	return r.client.BillProduct.SubscribeUpdated(ctx, r.pubsub, where)
+}
+
+// BillProductDeleted is the resolver for the billProductDeleted field.
+func (r *subscriptionResolver) BillProductDeleted(ctx context.Context) (<-chan int, error) {
+	return r.client.BillProduct.SubscribeDeleted(ctx, r.pubsub)
+}
+
+// CategoryCreated is the resolver for the categoryCreated field.
+func (r *subscriptionResolver) CategoryCreated(ctx context.Context, where *ent.CategoryWhereInput) (<-chan *ent.Category, error) {
+	return r.client.Category.SubscribeCreated(ctx, r.pubsub, where)
+}
+
+// CategoryUpdated is the resolver for the categoryUpdated field.
+func (r *subscriptionResolver) CategoryUpdated(ctx context.Context, where *ent.CategoryWhereInput) (<-chan *ent.Category, error) {
+	return r.client.Category.SubscribeUpdated(ctx, r.pubsub, where)
+}
+
+// CategoryDeleted is the resolver for the categoryDeleted field.
+func (r *subscriptionResolver) CategoryDeleted(ctx context.Context) (<-chan int, error) {
+	return r.client.Category.SubscribeDeleted(ctx, r.pubsub)
+}
+
+// FriendshipCreated is the resolver for the friendshipCreated field.
+func (r *subscriptionResolver) FriendshipCreated(ctx context.Context, where *ent.FriendshipWhereInput) (<-chan *ent.Friendship, error) {
+	return r.client.Friendship.SubscribeCreated(ctx, r.pubsub, where)
+}
+
+// FriendshipUpdated is the resolver for the friendshipUpdated field.
+func (r *subscriptionResolver) FriendshipUpdated(ctx context.Context, where *ent.FriendshipWhereInput) (<-chan *ent.Friendship, error) {
+	return r.client.Friendship.SubscribeUpdated(ctx, r.pubsub, where)
+}
+
+// FriendshipDeleted is the resolver for the friendshipDeleted field.
+func (r *subscriptionResolver) FriendshipDeleted(ctx context.Context) (<-chan int, error) {
+	return r.client.Friendship.SubscribeDeleted(ctx, r.pubsub)
+}
+
+// GroupCreated is the resolver for the groupCreated field.
+func (r *subscriptionResolver) GroupCreated(ctx context.Context, where *ent.GroupWhereInput) (<-chan *ent.Group, error) {
+	return r.client.Group.SubscribeCreated(ctx, r.pubsub, where)
+}
+
+// GroupUpdated is the resolver for the groupUpdated field.
+func (r *subscriptionResolver) GroupUpdated(ctx context.Context, where *ent.GroupWhereInput) (<-chan *ent.Group, error) {
+	return r.client.Group.SubscribeUpdated(ctx, r.pubsub, where)
+}
+
+// GroupDeleted is the resolver for the groupDeleted field.
+func (r *subscriptionResolver) GroupDeleted(ctx context.Context) (<-chan int, error) {
+	return r.client.Group.SubscribeDeleted(ctx, r.pubsub)
+}
+
+// OneToManyCreated is the resolver for the oneToManyCreated field.
+func (r *subscriptionResolver) OneToManyCreated(ctx context.Context, where *ent.OneToManyWhereInput) (<-chan *ent.OneToMany, error) {
+	return r.client.OneToMany.SubscribeCreated(ctx, r.pubsub, where)
+}
+
+// OneToManyUpdated is the resolver for the oneToManyUpdated field.
+func (r *subscriptionResolver) OneToManyUpdated(ctx context.Context, where *ent.OneToManyWhereInput) (<-chan *ent.OneToMany, error) {
+	return r.client.OneToMany.SubscribeUpdated(ctx, r.pubsub, where)
+}
+
+// OneToManyDeleted is the resolver for the oneToManyDeleted field.
+func (r *subscriptionResolver) OneToManyDeleted(ctx context.Context) (<-chan int, error) {
+	return r.client.OneToMany.SubscribeDeleted(ctx, r.pubsub)
+}
+
+// ProjectCreated is the resolver for the projectCreated field.
+func (r *subscriptionResolver) ProjectCreated(ctx context.Context, where *ent.ProjectWhereInput) (<-chan *ent.Project, error) {
+	return r.client.Project.SubscribeCreated(ctx, r.pubsub, where)
+}
+
+// ProjectUpdated is the resolver for the projectUpdated field.
+func (r *subscriptionResolver) ProjectUpdated(ctx context.Context, where *ent.ProjectWhereInput) (<-chan *ent.Project, error) {
+	return r.client.Project.SubscribeUpdated(ctx, r.pubsub, where)
+}
+
+// ProjectDeleted is the resolver for the projectDeleted field.
+func (r *subscriptionResolver) ProjectDeleted(ctx context.Context) (<-chan int, error) {
+	return r.client.Project.SubscribeDeleted(ctx, r.pubsub)
+}
+
+// TodoCreated is the resolver for the todoCreated field.
+func (r *subscriptionResolver) TodoCreated(ctx context.Context, where *ent.TodoWhereInput) (<-chan *ent.Todo, error) {
+	return r.client.Todo.SubscribeCreated(ctx, r.pubsub, where)
+}
+
+// TodoUpdated is the resolver for the todoUpdated field.
+func (r *subscriptionResolver) TodoUpdated(ctx context.Context, where *ent.TodoWhereInput) (<-chan *ent.Todo, error) {
+	return r.client.Todo.SubscribeUpdated(ctx, r.pubsub, where)
+}
+
+// TodoDeleted is the resolver for the todoDeleted field.
+func (r *subscriptionResolver) TodoDeleted(ctx context.Context) (<-chan int, error) {
+	return r.client.Todo.SubscribeDeleted(ctx, r.pubsub)
+}
+
+// UserCreated is the resolver for the userCreated field.
+func (r *subscriptionResolver) UserCreated(ctx context.Context, where *ent.UserWhereInput) (<-chan *ent.User, error) {
+	return r.client.User.SubscribeCreated(ctx, r.pubsub, where)
+}
+
+// UserUpdated is the resolver for the userUpdated field.
+func (r *subscriptionResolver) UserUpdated(ctx context.Context, where *ent.UserWhereInput) (<-chan *ent.User, error) {
+	return r.client.User.SubscribeUpdated(ctx, r.pubsub, where)
+}
+
+// UserDeleted is the resolver for the userDeleted field.
+func (r *subscriptionResolver) UserDeleted(ctx context.Context) (<-chan int, error) {
+	return r.client.User.SubscribeDeleted(ctx, r.pubsub)
+}
+
+// OrganizationCreated is the resolver for the organizationCreated field.
+func (r *subscriptionResolver) OrganizationCreated(ctx context.Context, where *ent.OrganizationWhereInput) (<-chan *ent.Workspace, error) {
+	return r.client.Workspace.SubscribeCreated(ctx, r.pubsub, where)
+}
+
+// OrganizationUpdated is the resolver for the organizationUpdated field.
+func (r *subscriptionResolver) OrganizationUpdated(ctx context.Context, where *ent.OrganizationWhereInput) (<-chan *ent.Workspace, error) {
+	return r.client.Workspace.SubscribeUpdated(ctx, r.pubsub, where)
+}
+
+// OrganizationDeleted is the resolver for the organizationDeleted field.
+func (r *subscriptionResolver) OrganizationDeleted(ctx context.Context) (<-chan int, error) {
+	return r.client.Workspace.SubscribeDeleted(ctx, r.pubsub)
+}
+
 // Category returns CategoryResolver implementation.
 func (r *Resolver) Category() CategoryResolver { return &categoryResolver{r} }
 
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
 // Todo returns TodoResolver implementation.
 func (r *Resolver) Todo() TodoResolver { return &todoResolver{r} }
 
@@ -101,6 +254,7 @@ func (r *Resolver) TodoWhereInput() TodoWhereInputResolver { return &todoWhereIn
 
 type categoryResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
 type todoResolver struct{ *Resolver }
 type createCategoryInputResolver struct{ *Resolver }
 type todoWhereInputResolver struct{ *Resolver }
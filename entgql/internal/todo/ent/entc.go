@@ -25,6 +25,9 @@ func main() {
 		entgql.WithSchemaPath("./ent.graphql"),
 		entgql.WithWhereInputs(true),
 		entgql.WithNodeDescriptor(true),
+		entgql.WithDataloaders(),
+		entgql.WithSubscriptions(),
+		entgql.WithComplexity(),
 	)
 	if err != nil {
 		log.Fatalf("creating entgql extension: %v", err)
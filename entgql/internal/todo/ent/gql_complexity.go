@@ -0,0 +1,54 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"entgo.io/contrib/entgql"
+)
+
+// Complexity holds the complexity functions of the GraphQL connection and edge
+// fields, keyed by "<Type>.<field>". The cost of a field is derived from its
+// `first` and `last` arguments, and from the weight of its type, as set by the
+// entgql.Complexity annotation.
+//
+//	srv.Use(&entgql.ComplexityLimit{
+//		Funcs:         ent.Complexity,
+//		MaxComplexity: 1000,
+//		MaxDepth:      10,
+//	})
+var Complexity = map[string]entgql.ComplexityFunc{
+	"Query.billProducts":     entgql.ConnectionComplexity(1),
+	"Query.categories":       entgql.ConnectionComplexity(1),
+	"Category.todos":         entgql.ConnectionComplexity(2),
+	"Category.subCategories": entgql.ConnectionComplexity(1),
+	"Friendship.user":        entgql.EdgeComplexity(1),
+	"Friendship.friend":      entgql.EdgeComplexity(1),
+	"Query.groups":           entgql.ConnectionComplexity(1),
+	"Group.users":            entgql.ConnectionComplexity(1),
+	"Query.oneToMany":        entgql.ConnectionComplexity(1),
+	"OneToMany.parent":       entgql.EdgeComplexity(1),
+	"OneToMany.children":     entgql.ConnectionComplexity(1),
+	"Project.todos":          entgql.ConnectionComplexity(2),
+	"Query.todos":            entgql.ConnectionComplexity(2),
+	"Todo.parent":            entgql.EdgeComplexity(0),
+	"Todo.children":          entgql.ConnectionComplexity(2),
+	"Todo.category":          entgql.EdgeComplexity(1),
+	"Query.users":            entgql.ConnectionComplexity(1),
+	"User.groups":            entgql.ConnectionComplexity(1),
+	"User.friends":           entgql.ConnectionComplexity(1),
+	"User.friendships":       entgql.ConnectionComplexity(1),
+}
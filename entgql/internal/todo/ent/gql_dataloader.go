@@ -0,0 +1,221 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/todo/ent/friendship"
+	"entgo.io/contrib/entgql/internal/todo/ent/onetomany"
+	"entgo.io/contrib/entgql/internal/todo/ent/todo"
+	"entgo.io/ent/dialect"
+)
+
+// edgeLoaderKey is the key of an edge loader in the context. The driver
+// is part of the key, because the nodes queried by different clients, or
+// in a transaction, are loaded using the driver they were queried with.
+type edgeLoaderKey struct {
+	edge   string
+	driver dialect.Driver
+}
+
+// loadUser loads the "user" edge of the Friendship in a batch with the other
+// friendships loading it in the context, or using a query if the context has no loaders.
+func (f *Friendship) loadUser(ctx context.Context) (*User, error) {
+	key := edgeLoaderKey{edge: "Friendship.user", driver: f.driver}
+	loader := entgql.LoaderFromContext(ctx, key, func() *entgql.Loader[int, *Friendship] {
+		client := NewFriendshipClient(f.config)
+		return entgql.NewLoader(func(ctx context.Context, ids []int) (map[int]*Friendship, error) {
+			nodes, err := client.Query().Where(friendship.IDIn(ids...)).WithUser().All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			m := make(map[int]*Friendship, len(nodes))
+			for _, n := range nodes {
+				m[n.ID] = n
+			}
+			return m, nil
+		})
+	})
+	if loader == nil {
+		return f.QueryUser().Only(ctx)
+	}
+	switch node, err := loader.Load(ctx, f.ID); {
+	case err != nil:
+		return nil, err
+	case node == nil:
+		return nil, &NotFoundError{friendship.Label}
+	default:
+		return node.Edges.UserOrErr()
+	}
+}
+
+// loadFriend loads the "friend" edge of the Friendship in a batch with the other
+// friendships loading it in the context, or using a query if the context has no loaders.
+func (f *Friendship) loadFriend(ctx context.Context) (*User, error) {
+	key := edgeLoaderKey{edge: "Friendship.friend", driver: f.driver}
+	loader := entgql.LoaderFromContext(ctx, key, func() *entgql.Loader[int, *Friendship] {
+		client := NewFriendshipClient(f.config)
+		return entgql.NewLoader(func(ctx context.Context, ids []int) (map[int]*Friendship, error) {
+			nodes, err := client.Query().Where(friendship.IDIn(ids...)).WithFriend().All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			m := make(map[int]*Friendship, len(nodes))
+			for _, n := range nodes {
+				m[n.ID] = n
+			}
+			return m, nil
+		})
+	})
+	if loader == nil {
+		return f.QueryFriend().Only(ctx)
+	}
+	switch node, err := loader.Load(ctx, f.ID); {
+	case err != nil:
+		return nil, err
+	case node == nil:
+		return nil, &NotFoundError{friendship.Label}
+	default:
+		return node.Edges.FriendOrErr()
+	}
+}
+
+// loadParent loads the "parent" edge of the OneToMany in a batch with the other
+// onetomanies loading it in the context, or using a query if the context has no loaders.
+func (otm *OneToMany) loadParent(ctx context.Context) (*OneToMany, error) {
+	key := edgeLoaderKey{edge: "OneToMany.parent", driver: otm.driver}
+	loader := entgql.LoaderFromContext(ctx, key, func() *entgql.Loader[int, *OneToMany] {
+		client := NewOneToManyClient(otm.config)
+		return entgql.NewLoader(func(ctx context.Context, ids []int) (map[int]*OneToMany, error) {
+			nodes, err := client.Query().Where(onetomany.IDIn(ids...)).WithParent().All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			m := make(map[int]*OneToMany, len(nodes))
+			for _, n := range nodes {
+				m[n.ID] = n
+			}
+			return m, nil
+		})
+	})
+	if loader == nil {
+		return otm.QueryParent().Only(ctx)
+	}
+	switch node, err := loader.Load(ctx, otm.ID); {
+	case err != nil:
+		return nil, err
+	case node == nil:
+		return nil, &NotFoundError{onetomany.Label}
+	default:
+		return node.Edges.ParentOrErr()
+	}
+}
+
+// loadChildren loads the "children" edge of the OneToMany in a batch with the other
+// onetomanies loading it in the context, or using a query if the context has no loaders.
+func (otm *OneToMany) loadChildren(ctx context.Context) ([]*OneToMany, error) {
+	key := edgeLoaderKey{edge: "OneToMany.children", driver: otm.driver}
+	loader := entgql.LoaderFromContext(ctx, key, func() *entgql.Loader[int, *OneToMany] {
+		client := NewOneToManyClient(otm.config)
+		return entgql.NewLoader(func(ctx context.Context, ids []int) (map[int]*OneToMany, error) {
+			nodes, err := client.Query().Where(onetomany.IDIn(ids...)).WithChildren().All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			m := make(map[int]*OneToMany, len(nodes))
+			for _, n := range nodes {
+				m[n.ID] = n
+			}
+			return m, nil
+		})
+	})
+	if loader == nil {
+		return otm.QueryChildren().All(ctx)
+	}
+	switch node, err := loader.Load(ctx, otm.ID); {
+	case err != nil:
+		return nil, err
+	case node == nil:
+		return nil, &NotFoundError{onetomany.Label}
+	default:
+		return node.Edges.ChildrenOrErr()
+	}
+}
+
+// loadParent loads the "parent" edge of the Todo in a batch with the other
+// todos loading it in the context, or using a query if the context has no loaders.
+func (t *Todo) loadParent(ctx context.Context) (*Todo, error) {
+	key := edgeLoaderKey{edge: "Todo.parent", driver: t.driver}
+	loader := entgql.LoaderFromContext(ctx, key, func() *entgql.Loader[int, *Todo] {
+		client := NewTodoClient(t.config)
+		return entgql.NewLoader(func(ctx context.Context, ids []int) (map[int]*Todo, error) {
+			nodes, err := client.Query().Where(todo.IDIn(ids...)).WithParent().All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			m := make(map[int]*Todo, len(nodes))
+			for _, n := range nodes {
+				m[n.ID] = n
+			}
+			return m, nil
+		})
+	})
+	if loader == nil {
+		return t.QueryParent().Only(ctx)
+	}
+	switch node, err := loader.Load(ctx, t.ID); {
+	case err != nil:
+		return nil, err
+	case node == nil:
+		return nil, &NotFoundError{todo.Label}
+	default:
+		return node.Edges.ParentOrErr()
+	}
+}
+
+// loadCategory loads the "category" edge of the Todo in a batch with the other
+// todos loading it in the context, or using a query if the context has no loaders.
+func (t *Todo) loadCategory(ctx context.Context) (*Category, error) {
+	key := edgeLoaderKey{edge: "Todo.category", driver: t.driver}
+	loader := entgql.LoaderFromContext(ctx, key, func() *entgql.Loader[int, *Todo] {
+		client := NewTodoClient(t.config)
+		return entgql.NewLoader(func(ctx context.Context, ids []int) (map[int]*Todo, error) {
+			nodes, err := client.Query().Where(todo.IDIn(ids...)).WithCategory().All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			m := make(map[int]*Todo, len(nodes))
+			for _, n := range nodes {
+				m[n.ID] = n
+			}
+			return m, nil
+		})
+	})
+	if loader == nil {
+		return t.QueryCategory().Only(ctx)
+	}
+	switch node, err := loader.Load(ctx, t.ID); {
+	case err != nil:
+		return nil, err
+	case node == nil:
+		return nil, &NotFoundError{todo.Label}
+	default:
+		return node.Edges.CategoryOrErr()
+	}
+}
@@ -37,6 +37,15 @@ func (c *Category) Todos(
 			return nil, err
 		}
 		conn := &TodoConnection{Edges: []*TodoEdge{}, TotalCount: totalCount}
+		if field := collectedField(ctx, aggregateField); field != nil {
+			query, err := pager.applyFilter(c.QueryTodos())
+			if err != nil {
+				return nil, err
+			}
+			if conn.Aggregate, err = query.aggregate(ctx, *field); err != nil {
+				return nil, err
+			}
+		}
 		conn.build(nodes, pager, after, first, before, last)
 		return conn, nil
 	}
@@ -67,7 +76,7 @@ func (c *Category) SubCategories(
 func (f *Friendship) User(ctx context.Context) (*User, error) {
 	result, err := f.Edges.UserOrErr()
 	if IsNotLoaded(err) {
-		result, err = f.QueryUser().Only(ctx)
+		result, err = f.loadUser(ctx)
 	}
 	return result, err
 }
@@ -75,7 +84,7 @@ func (f *Friendship) User(ctx context.Context) (*User, error) {
 func (f *Friendship) Friend(ctx context.Context) (*User, error) {
 	result, err := f.Edges.FriendOrErr()
 	if IsNotLoaded(err) {
-		result, err = f.QueryFriend().Only(ctx)
+		result, err = f.loadFriend(ctx)
 	}
 	return result, err
 }
@@ -104,7 +113,7 @@ func (gr *Group) Users(
 func (otm *OneToMany) Parent(ctx context.Context) (*OneToMany, error) {
 	result, err := otm.Edges.ParentOrErr()
 	if IsNotLoaded(err) {
-		result, err = otm.QueryParent().Only(ctx)
+		result, err = otm.loadParent(ctx)
 	}
 	return result, MaskNotFound(err)
 }
@@ -116,7 +125,7 @@ func (otm *OneToMany) Children(ctx context.Context) (result []*OneToMany, err er
 		result, err = otm.Edges.ChildrenOrErr()
 	}
 	if IsNotLoaded(err) {
-		result, err = otm.QueryChildren().All(ctx)
+		result, err = otm.loadChildren(ctx)
 	}
 	return result, err
 }
@@ -136,6 +145,15 @@ func (pr *Project) Todos(
 			return nil, err
 		}
 		conn := &TodoConnection{Edges: []*TodoEdge{}, TotalCount: totalCount}
+		if field := collectedField(ctx, aggregateField); field != nil {
+			query, err := pager.applyFilter(pr.QueryTodos())
+			if err != nil {
+				return nil, err
+			}
+			if conn.Aggregate, err = query.aggregate(ctx, *field); err != nil {
+				return nil, err
+			}
+		}
 		conn.build(nodes, pager, after, first, before, last)
 		return conn, nil
 	}
@@ -145,7 +163,7 @@ func (pr *Project) Todos(
 func (t *Todo) Parent(ctx context.Context) (*Todo, error) {
 	result, err := t.Edges.ParentOrErr()
 	if IsNotLoaded(err) {
-		result, err = t.QueryParent().Only(ctx)
+		result, err = t.loadParent(ctx)
 	}
 	return result, MaskNotFound(err)
 }
@@ -165,6 +183,15 @@ func (t *Todo) Children(
 			return nil, err
 		}
 		conn := &TodoConnection{Edges: []*TodoEdge{}, TotalCount: totalCount}
+		if field := collectedField(ctx, aggregateField); field != nil {
+			query, err := pager.applyFilter(t.QueryChildren())
+			if err != nil {
+				return nil, err
+			}
+			if conn.Aggregate, err = query.aggregate(ctx, *field); err != nil {
+				return nil, err
+			}
+		}
 		conn.build(nodes, pager, after, first, before, last)
 		return conn, nil
 	}
@@ -174,7 +201,7 @@ func (t *Todo) Children(
 func (t *Todo) Category(ctx context.Context) (*Category, error) {
 	result, err := t.Edges.CategoryOrErr()
 	if IsNotLoaded(err) {
-		result, err = t.QueryCategory().Only(ctx)
+		result, err = t.loadCategory(ctx)
 	}
 	return result, MaskNotFound(err)
 }
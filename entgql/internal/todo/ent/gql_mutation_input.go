@@ -17,6 +17,8 @@
 package ent
 
 import (
+	"context"
+	"errors"
 	"time"
 
 	"entgo.io/contrib/entgql/internal/todo/ent/category"
@@ -27,18 +29,20 @@ import (
 
 // CreateCategoryInput represents a mutation input for creating categories.
 type CreateCategoryInput struct {
-	Text           string
-	Status         category.Status
-	Config         *schematype.CategoryConfig
-	Types          *schematype.CategoryTypes
-	Duration       *time.Duration
-	Count          *uint64
-	Strings        []string
-	TodoIDs        []int
-	SubCategoryIDs []int
+	Text                string
+	Status              category.Status
+	Config              *schematype.CategoryConfig
+	Types               *schematype.CategoryTypes
+	Duration            *time.Duration
+	Count               *uint64
+	Strings             []string
+	TodoIDs             []int
+	SubCategoryIDs      []int
+	CreateSubCategories []*CreateCategoryInput
 }
 
 // Mutate applies the CreateCategoryInput on the CategoryMutation builder.
+// The nested inputs are ignored, use MutateContext to apply them.
 func (i *CreateCategoryInput) Mutate(m *CategoryMutation) {
 	m.SetText(i.Text)
 	m.SetStatus(i.Status)
@@ -65,10 +69,56 @@ func (i *CreateCategoryInput) Mutate(m *CategoryMutation) {
 	}
 }
 
-// SetInput applies the change-set in the CreateCategoryInput on the CategoryCreate builder.
-func (c *CategoryCreate) SetInput(i CreateCategoryInput) *CategoryCreate {
-	i.Mutate(c.Mutation())
-	return c
+// MutateContext applies the CreateCategoryInput on the CategoryMutation builder, and creates the nodes
+// of its nested inputs using the client of the mutation, in the transaction of the mutation.
+// The nested nodes are saved before the mutation, therefore, nested inputs are rejected if the
+// mutation does not run in a transaction, that rolls them back if the mutation fails.
+func (i *CreateCategoryInput) MutateContext(ctx context.Context, m *CategoryMutation) error {
+	i.Mutate(m)
+	if _, err := m.Tx(); err != nil && i.hasNestedInputs() {
+		return errors.New("ent: the nested inputs of CreateCategoryInput require a transaction")
+	}
+	client := m.Client()
+	for _, v := range i.CreateSubCategories {
+		c := client.Category.Create()
+		if err := v.MutateContext(ctx, c.Mutation()); err != nil {
+			return err
+		}
+		n, err := c.Save(ctx)
+		if err != nil {
+			return err
+		}
+		m.AddSubCategoryIDs(n.ID)
+	}
+	return nil
+}
+
+// hasNestedInputs reports if any of the nested inputs of the CreateCategoryInput is set.
+func (i *CreateCategoryInput) hasNestedInputs() bool {
+	return len(i.CreateSubCategories) > 0
+}
+
+// SetInputContext applies the change-set in the CreateCategoryInput on the CategoryCreate builder,
+// including its nested inputs. See CreateCategoryInput.MutateContext for more details.
+func (c *CategoryCreate) SetInputContext(ctx context.Context, i CreateCategoryInput) (*CategoryCreate, error) {
+	if err := i.MutateContext(ctx, c.Mutation()); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// CreateMany creates the categories of the given inputs in bulk, and returns them in the same order.
+// The nodes of the nested inputs are created before the categories, and require the client to be
+// a transactional client, such as the one stored in the context by the entgql.Transactioner.
+func (c *CategoryClient) CreateMany(ctx context.Context, inputs []*CreateCategoryInput) ([]*Category, error) {
+	builders := make([]*CategoryCreate, len(inputs))
+	for j, i := range inputs {
+		builders[j] = c.Create()
+		if err := i.MutateContext(ctx, builders[j].Mutation()); err != nil {
+			return nil, err
+		}
+	}
+	return c.CreateBulk(builders...).Save(ctx)
 }
 
 // UpdateCategoryInput represents a mutation input for updating categories.
@@ -92,9 +142,11 @@ type UpdateCategoryInput struct {
 	ClearSubCategories   bool
 	AddSubCategoryIDs    []int
 	RemoveSubCategoryIDs []int
+	UpsertSubCategories  []*UpsertCategoryInput
 }
 
 // Mutate applies the UpdateCategoryInput on the CategoryMutation builder.
+// The nested inputs are ignored, use MutateContext to apply them.
 func (i *UpdateCategoryInput) Mutate(m *CategoryMutation) {
 	if v := i.Text; v != nil {
 		m.SetText(*v)
@@ -155,16 +207,89 @@ func (i *UpdateCategoryInput) Mutate(m *CategoryMutation) {
 	}
 }
 
-// SetInput applies the change-set in the UpdateCategoryInput on the CategoryUpdate builder.
-func (c *CategoryUpdate) SetInput(i UpdateCategoryInput) *CategoryUpdate {
-	i.Mutate(c.Mutation())
-	return c
+// MutateContext applies the UpdateCategoryInput on the CategoryMutation builder, and creates or updates
+// the nodes of its nested inputs using the client of the mutation, in the transaction of the mutation.
+// The nested nodes are saved before the mutation, therefore, nested inputs are rejected if the
+// mutation does not run in a transaction, that rolls them back if the mutation fails.
+func (i *UpdateCategoryInput) MutateContext(ctx context.Context, m *CategoryMutation) error {
+	i.Mutate(m)
+	if _, err := m.Tx(); err != nil && i.hasNestedInputs() {
+		return errors.New("ent: the nested inputs of UpdateCategoryInput require a transaction")
+	}
+	client := m.Client()
+	for _, v := range i.UpsertSubCategories {
+		id, err := v.Upsert(ctx, client)
+		if err != nil {
+			return err
+		}
+		m.AddSubCategoryIDs(id)
+	}
+	return nil
 }
 
-// SetInput applies the change-set in the UpdateCategoryInput on the CategoryUpdateOne builder.
-func (c *CategoryUpdateOne) SetInput(i UpdateCategoryInput) *CategoryUpdateOne {
-	i.Mutate(c.Mutation())
-	return c
+// hasNestedInputs reports if any of the nested inputs of the UpdateCategoryInput is set.
+func (i *UpdateCategoryInput) hasNestedInputs() bool {
+	return len(i.UpsertSubCategories) > 0
+}
+
+// UpsertCategoryInput represents a mutation input for creating or updating categories through an edge.
+// A node is created from the Create input if the ID is not set. Otherwise, the node with the ID is updated with the
+// (optional) Update input.
+type UpsertCategoryInput struct {
+	ID     *int
+	Create *CreateCategoryInput
+	Update *UpdateCategoryInput
+}
+
+// Upsert creates or updates the node of the UpsertCategoryInput, and returns its ID.
+func (i *UpsertCategoryInput) Upsert(ctx context.Context, client *Client) (id int, err error) {
+	switch {
+	case i.ID == nil && i.Create != nil:
+		c := client.Category.Create()
+		if err := i.Create.MutateContext(ctx, c.Mutation()); err != nil {
+			return id, err
+		}
+		n, err := c.Save(ctx)
+		if err != nil {
+			return id, err
+		}
+		return n.ID, nil
+	case i.ID != nil && i.Create == nil:
+		u := client.Category.UpdateOneID(*i.ID)
+		if i.Update != nil {
+			if err := i.Update.MutateContext(ctx, u.Mutation()); err != nil {
+				return id, err
+			}
+		}
+		return *i.ID, u.Exec(ctx)
+	default:
+		return id, errors.New("ent: UpsertCategoryInput requires either an id or a create input")
+	}
+}
+
+// SetInputContext applies the change-set in the UpdateCategoryInput on the CategoryUpdateOne builder,
+// including its nested inputs. See UpdateCategoryInput.MutateContext for more details.
+func (c *CategoryUpdateOne) SetInputContext(ctx context.Context, i UpdateCategoryInput) (*CategoryUpdateOne, error) {
+	if err := i.MutateContext(ctx, c.Mutation()); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// UpdateMany applies the UpdateCategoryInput on the categories matching the CategoryWhereInput, and returns the
+// number of updated categories. An empty CategoryWhereInput is rejected, unless all is set to update all categories.
+// The nested inputs of the UpdateCategoryInput are rejected, as their nodes cannot be attached to many categories.
+func (c *CategoryClient) UpdateMany(ctx context.Context, where *CategoryWhereInput, i UpdateCategoryInput, all bool) (int, error) {
+	ps, err := where.bulkPredicates(all)
+	if err != nil {
+		return 0, err
+	}
+	if i.hasNestedInputs() {
+		return 0, errors.New("ent: the nested inputs of UpdateCategoryInput cannot be applied to many categories")
+	}
+	u := c.Update().Where(ps...)
+	i.Mutate(u.Mutation())
+	return u.Save(ctx)
 }
 
 // UpdateFriendshipInput represents a mutation input for updating friendships.
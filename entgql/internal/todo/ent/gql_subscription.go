@@ -0,0 +1,1150 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/todo/ent/billproduct"
+	"entgo.io/contrib/entgql/internal/todo/ent/category"
+	"entgo.io/contrib/entgql/internal/todo/ent/friendship"
+	"entgo.io/contrib/entgql/internal/todo/ent/group"
+	"entgo.io/contrib/entgql/internal/todo/ent/onetomany"
+	"entgo.io/contrib/entgql/internal/todo/ent/project"
+	"entgo.io/contrib/entgql/internal/todo/ent/todo"
+	"entgo.io/contrib/entgql/internal/todo/ent/user"
+	"entgo.io/contrib/entgql/internal/todo/ent/workspace"
+)
+
+// SubscriptionHook returns a hook that publishes the IDs of the created, updated
+// and deleted nodes through the given PubSub, for the generated GraphQL subscriptions.
+// Mutations executed in a transaction are published only after it was committed,
+// and are never published if it is rolled back.
+//
+// Publishing errors do not fail the mutations, as they were already committed. They
+// are passed to the handlers of the options, and are discarded if none was given.
+//
+//	client.Use(ent.SubscriptionHook(pubsub, entgql.WithSubscriptionLogger(logger)))
+func SubscriptionHook(ps entgql.PubSub, opts ...entgql.SubscriptionOption) Hook {
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	return func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			switch m := m.(type) {
+			case *BillProductMutation:
+				return publishMutation(ctx, ps, cfg, next, m, billproduct.Label)
+			case *CategoryMutation:
+				return publishMutation(ctx, ps, cfg, next, m, category.Label)
+			case *FriendshipMutation:
+				return publishMutation(ctx, ps, cfg, next, m, friendship.Label)
+			case *GroupMutation:
+				return publishMutation(ctx, ps, cfg, next, m, group.Label)
+			case *OneToManyMutation:
+				return publishMutation(ctx, ps, cfg, next, m, onetomany.Label)
+			case *ProjectMutation:
+				return publishMutation(ctx, ps, cfg, next, m, project.Label)
+			case *TodoMutation:
+				return publishMutation(ctx, ps, cfg, next, m, todo.Label)
+			case *UserMutation:
+				return publishMutation(ctx, ps, cfg, next, m, user.Label)
+			case *WorkspaceMutation:
+				return publishMutation(ctx, ps, cfg, next, m, workspace.Label)
+			default:
+				return next.Mutate(ctx, m)
+			}
+		})
+	}
+}
+
+// subscriptionMutation is the mutation of a node that has subscriptions.
+type subscriptionMutation[ID any] interface {
+	Mutation
+	ID() (ID, bool)
+	IDs(context.Context) ([]ID, error)
+	Tx() (*Tx, error)
+}
+
+// subscriptionTopic returns the topic of the subscription events of the given label and operation.
+func subscriptionTopic(label string, op Op) string {
+	switch {
+	case op.Is(OpCreate):
+		return label + ".created"
+	case op.Is(OpDelete | OpDeleteOne):
+		return label + ".deleted"
+	default:
+		return label + ".updated"
+	}
+}
+
+// publishMutation executes the mutation, and publishes the IDs of its nodes once it is committed.
+func publishMutation[ID any](ctx context.Context, ps entgql.PubSub, cfg *entgql.SubscriptionConfig, next Mutator, m subscriptionMutation[ID], label string) (Value, error) {
+	var ids []ID
+	if !m.Op().Is(OpCreate) {
+		var err error
+		if ids, err = m.IDs(ctx); err != nil {
+			return nil, err
+		}
+	}
+	v, err := next.Mutate(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	if id, ok := m.ID(); ok && m.Op().Is(OpCreate) {
+		ids = append(ids, id)
+	}
+	topic := subscriptionTopic(label, m.Op())
+	publish := func(ctx context.Context) {
+		for _, id := range ids {
+			if err := ps.Publish(ctx, topic, id); err != nil {
+				cfg.HandleError(ctx, topic, id, err)
+			}
+		}
+	}
+	tx, err := m.Tx()
+	if err != nil {
+		publish(ctx)
+		return v, nil
+	}
+	tx.OnCommit(func(next Committer) Committer {
+		return CommitFunc(func(ctx context.Context, tx *Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+			publish(ctx)
+			return nil
+		})
+	})
+	return v, nil
+}
+
+// SubscribeCreated returns a channel receiving the BillProduct nodes once they are created, if they match the given filter. The nodes are loaded once the
+// mutation was committed, and the channel is closed when ctx is done. Events that cannot be
+// resolved are passed to the error handlers of the options.
+func (bpc *BillProductClient) SubscribeCreated(ctx context.Context, ps entgql.PubSub, where *BillProductWhereInput, opts ...entgql.SubscriptionOption) (<-chan *BillProduct, error) {
+	if _, err := where.Filter(bpc.Query()); err != nil {
+		return nil, err
+	}
+	topic := subscriptionTopic(billproduct.Label, OpCreate)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	nodes := make(chan *BillProduct)
+	go func() {
+		defer close(nodes)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			query := bpc.Query().Where(billproduct.ID(id))
+			query, _ = where.Filter(query)
+			node, err := query.Only(ctx)
+			switch {
+			case IsNotFound(err):
+				continue
+			case err != nil:
+				cfg.HandleError(ctx, topic, e, err)
+				continue
+			}
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nodes, nil
+}
+
+// SubscribeUpdated returns a channel receiving the BillProduct nodes once they are updated, if they match the given filter. The nodes are loaded once the
+// mutation was committed, and the channel is closed when ctx is done. Events that cannot be
+// resolved are passed to the error handlers of the options.
+func (bpc *BillProductClient) SubscribeUpdated(ctx context.Context, ps entgql.PubSub, where *BillProductWhereInput, opts ...entgql.SubscriptionOption) (<-chan *BillProduct, error) {
+	if _, err := where.Filter(bpc.Query()); err != nil {
+		return nil, err
+	}
+	topic := subscriptionTopic(billproduct.Label, OpUpdateOne)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	nodes := make(chan *BillProduct)
+	go func() {
+		defer close(nodes)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			query := bpc.Query().Where(billproduct.ID(id))
+			query, _ = where.Filter(query)
+			node, err := query.Only(ctx)
+			switch {
+			case IsNotFound(err):
+				continue
+			case err != nil:
+				cfg.HandleError(ctx, topic, e, err)
+				continue
+			}
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nodes, nil
+}
+
+// SubscribeDeleted returns a channel receiving the IDs of the BillProduct nodes once
+// their deletion was committed. The channel is closed when ctx is done. Events that
+// cannot be resolved are passed to the error handlers of the options.
+func (bpc *BillProductClient) SubscribeDeleted(ctx context.Context, ps entgql.PubSub, opts ...entgql.SubscriptionOption) (<-chan int, error) {
+	topic := subscriptionTopic(billproduct.Label, OpDelete)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	ids := make(chan int)
+	go func() {
+		defer close(ids)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			select {
+			case ids <- id:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ids, nil
+}
+
+// SubscribeCreated returns a channel receiving the Category nodes once they are created, if they match the given filter. The nodes are loaded once the
+// mutation was committed, and the channel is closed when ctx is done. Events that cannot be
+// resolved are passed to the error handlers of the options.
+func (cc *CategoryClient) SubscribeCreated(ctx context.Context, ps entgql.PubSub, where *CategoryWhereInput, opts ...entgql.SubscriptionOption) (<-chan *Category, error) {
+	if _, err := where.Filter(cc.Query()); err != nil {
+		return nil, err
+	}
+	topic := subscriptionTopic(category.Label, OpCreate)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	nodes := make(chan *Category)
+	go func() {
+		defer close(nodes)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			query := cc.Query().Where(category.ID(id))
+			query, _ = where.Filter(query)
+			node, err := query.Only(ctx)
+			switch {
+			case IsNotFound(err):
+				continue
+			case err != nil:
+				cfg.HandleError(ctx, topic, e, err)
+				continue
+			}
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nodes, nil
+}
+
+// SubscribeUpdated returns a channel receiving the Category nodes once they are updated, if they match the given filter. The nodes are loaded once the
+// mutation was committed, and the channel is closed when ctx is done. Events that cannot be
+// resolved are passed to the error handlers of the options.
+func (cc *CategoryClient) SubscribeUpdated(ctx context.Context, ps entgql.PubSub, where *CategoryWhereInput, opts ...entgql.SubscriptionOption) (<-chan *Category, error) {
+	if _, err := where.Filter(cc.Query()); err != nil {
+		return nil, err
+	}
+	topic := subscriptionTopic(category.Label, OpUpdateOne)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	nodes := make(chan *Category)
+	go func() {
+		defer close(nodes)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			query := cc.Query().Where(category.ID(id))
+			query, _ = where.Filter(query)
+			node, err := query.Only(ctx)
+			switch {
+			case IsNotFound(err):
+				continue
+			case err != nil:
+				cfg.HandleError(ctx, topic, e, err)
+				continue
+			}
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nodes, nil
+}
+
+// SubscribeDeleted returns a channel receiving the IDs of the Category nodes once
+// their deletion was committed. The channel is closed when ctx is done. Events that
+// cannot be resolved are passed to the error handlers of the options.
+func (cc *CategoryClient) SubscribeDeleted(ctx context.Context, ps entgql.PubSub, opts ...entgql.SubscriptionOption) (<-chan int, error) {
+	topic := subscriptionTopic(category.Label, OpDelete)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	ids := make(chan int)
+	go func() {
+		defer close(ids)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			select {
+			case ids <- id:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ids, nil
+}
+
+// SubscribeCreated returns a channel receiving the Friendship nodes once they are created, if they match the given filter. The nodes are loaded once the
+// mutation was committed, and the channel is closed when ctx is done. Events that cannot be
+// resolved are passed to the error handlers of the options.
+func (fc *FriendshipClient) SubscribeCreated(ctx context.Context, ps entgql.PubSub, where *FriendshipWhereInput, opts ...entgql.SubscriptionOption) (<-chan *Friendship, error) {
+	if _, err := where.Filter(fc.Query()); err != nil {
+		return nil, err
+	}
+	topic := subscriptionTopic(friendship.Label, OpCreate)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	nodes := make(chan *Friendship)
+	go func() {
+		defer close(nodes)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			query := fc.Query().Where(friendship.ID(id))
+			query, _ = where.Filter(query)
+			node, err := query.Only(ctx)
+			switch {
+			case IsNotFound(err):
+				continue
+			case err != nil:
+				cfg.HandleError(ctx, topic, e, err)
+				continue
+			}
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nodes, nil
+}
+
+// SubscribeUpdated returns a channel receiving the Friendship nodes once they are updated, if they match the given filter. The nodes are loaded once the
+// mutation was committed, and the channel is closed when ctx is done. Events that cannot be
+// resolved are passed to the error handlers of the options.
+func (fc *FriendshipClient) SubscribeUpdated(ctx context.Context, ps entgql.PubSub, where *FriendshipWhereInput, opts ...entgql.SubscriptionOption) (<-chan *Friendship, error) {
+	if _, err := where.Filter(fc.Query()); err != nil {
+		return nil, err
+	}
+	topic := subscriptionTopic(friendship.Label, OpUpdateOne)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	nodes := make(chan *Friendship)
+	go func() {
+		defer close(nodes)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			query := fc.Query().Where(friendship.ID(id))
+			query, _ = where.Filter(query)
+			node, err := query.Only(ctx)
+			switch {
+			case IsNotFound(err):
+				continue
+			case err != nil:
+				cfg.HandleError(ctx, topic, e, err)
+				continue
+			}
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nodes, nil
+}
+
+// SubscribeDeleted returns a channel receiving the IDs of the Friendship nodes once
+// their deletion was committed. The channel is closed when ctx is done. Events that
+// cannot be resolved are passed to the error handlers of the options.
+func (fc *FriendshipClient) SubscribeDeleted(ctx context.Context, ps entgql.PubSub, opts ...entgql.SubscriptionOption) (<-chan int, error) {
+	topic := subscriptionTopic(friendship.Label, OpDelete)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	ids := make(chan int)
+	go func() {
+		defer close(ids)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			select {
+			case ids <- id:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ids, nil
+}
+
+// SubscribeCreated returns a channel receiving the Group nodes once they are created, if they match the given filter. The nodes are loaded once the
+// mutation was committed, and the channel is closed when ctx is done. Events that cannot be
+// resolved are passed to the error handlers of the options.
+func (gc *GroupClient) SubscribeCreated(ctx context.Context, ps entgql.PubSub, where *GroupWhereInput, opts ...entgql.SubscriptionOption) (<-chan *Group, error) {
+	if _, err := where.Filter(gc.Query()); err != nil {
+		return nil, err
+	}
+	topic := subscriptionTopic(group.Label, OpCreate)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	nodes := make(chan *Group)
+	go func() {
+		defer close(nodes)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			query := gc.Query().Where(group.ID(id))
+			query, _ = where.Filter(query)
+			node, err := query.Only(ctx)
+			switch {
+			case IsNotFound(err):
+				continue
+			case err != nil:
+				cfg.HandleError(ctx, topic, e, err)
+				continue
+			}
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nodes, nil
+}
+
+// SubscribeUpdated returns a channel receiving the Group nodes once they are updated, if they match the given filter. The nodes are loaded once the
+// mutation was committed, and the channel is closed when ctx is done. Events that cannot be
+// resolved are passed to the error handlers of the options.
+func (gc *GroupClient) SubscribeUpdated(ctx context.Context, ps entgql.PubSub, where *GroupWhereInput, opts ...entgql.SubscriptionOption) (<-chan *Group, error) {
+	if _, err := where.Filter(gc.Query()); err != nil {
+		return nil, err
+	}
+	topic := subscriptionTopic(group.Label, OpUpdateOne)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	nodes := make(chan *Group)
+	go func() {
+		defer close(nodes)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			query := gc.Query().Where(group.ID(id))
+			query, _ = where.Filter(query)
+			node, err := query.Only(ctx)
+			switch {
+			case IsNotFound(err):
+				continue
+			case err != nil:
+				cfg.HandleError(ctx, topic, e, err)
+				continue
+			}
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nodes, nil
+}
+
+// SubscribeDeleted returns a channel receiving the IDs of the Group nodes once
+// their deletion was committed. The channel is closed when ctx is done. Events that
+// cannot be resolved are passed to the error handlers of the options.
+func (gc *GroupClient) SubscribeDeleted(ctx context.Context, ps entgql.PubSub, opts ...entgql.SubscriptionOption) (<-chan int, error) {
+	topic := subscriptionTopic(group.Label, OpDelete)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	ids := make(chan int)
+	go func() {
+		defer close(ids)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			select {
+			case ids <- id:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ids, nil
+}
+
+// SubscribeCreated returns a channel receiving the OneToMany nodes once they are created, if they match the given filter. The nodes are loaded once the
+// mutation was committed, and the channel is closed when ctx is done. Events that cannot be
+// resolved are passed to the error handlers of the options.
+func (otmc *OneToManyClient) SubscribeCreated(ctx context.Context, ps entgql.PubSub, where *OneToManyWhereInput, opts ...entgql.SubscriptionOption) (<-chan *OneToMany, error) {
+	if _, err := where.Filter(otmc.Query()); err != nil {
+		return nil, err
+	}
+	topic := subscriptionTopic(onetomany.Label, OpCreate)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	nodes := make(chan *OneToMany)
+	go func() {
+		defer close(nodes)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			query := otmc.Query().Where(onetomany.ID(id))
+			query, _ = where.Filter(query)
+			node, err := query.Only(ctx)
+			switch {
+			case IsNotFound(err):
+				continue
+			case err != nil:
+				cfg.HandleError(ctx, topic, e, err)
+				continue
+			}
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nodes, nil
+}
+
+// SubscribeUpdated returns a channel receiving the OneToMany nodes once they are updated, if they match the given filter. The nodes are loaded once the
+// mutation was committed, and the channel is closed when ctx is done. Events that cannot be
+// resolved are passed to the error handlers of the options.
+func (otmc *OneToManyClient) SubscribeUpdated(ctx context.Context, ps entgql.PubSub, where *OneToManyWhereInput, opts ...entgql.SubscriptionOption) (<-chan *OneToMany, error) {
+	if _, err := where.Filter(otmc.Query()); err != nil {
+		return nil, err
+	}
+	topic := subscriptionTopic(onetomany.Label, OpUpdateOne)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	nodes := make(chan *OneToMany)
+	go func() {
+		defer close(nodes)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			query := otmc.Query().Where(onetomany.ID(id))
+			query, _ = where.Filter(query)
+			node, err := query.Only(ctx)
+			switch {
+			case IsNotFound(err):
+				continue
+			case err != nil:
+				cfg.HandleError(ctx, topic, e, err)
+				continue
+			}
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nodes, nil
+}
+
+// SubscribeDeleted returns a channel receiving the IDs of the OneToMany nodes once
+// their deletion was committed. The channel is closed when ctx is done. Events that
+// cannot be resolved are passed to the error handlers of the options.
+func (otmc *OneToManyClient) SubscribeDeleted(ctx context.Context, ps entgql.PubSub, opts ...entgql.SubscriptionOption) (<-chan int, error) {
+	topic := subscriptionTopic(onetomany.Label, OpDelete)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	ids := make(chan int)
+	go func() {
+		defer close(ids)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			select {
+			case ids <- id:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ids, nil
+}
+
+// SubscribeCreated returns a channel receiving the Project nodes once they are created, if they match the given filter. The nodes are loaded once the
+// mutation was committed, and the channel is closed when ctx is done. Events that cannot be
+// resolved are passed to the error handlers of the options.
+func (pc *ProjectClient) SubscribeCreated(ctx context.Context, ps entgql.PubSub, where *ProjectWhereInput, opts ...entgql.SubscriptionOption) (<-chan *Project, error) {
+	if _, err := where.Filter(pc.Query()); err != nil {
+		return nil, err
+	}
+	topic := subscriptionTopic(project.Label, OpCreate)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	nodes := make(chan *Project)
+	go func() {
+		defer close(nodes)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			query := pc.Query().Where(project.ID(id))
+			query, _ = where.Filter(query)
+			node, err := query.Only(ctx)
+			switch {
+			case IsNotFound(err):
+				continue
+			case err != nil:
+				cfg.HandleError(ctx, topic, e, err)
+				continue
+			}
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nodes, nil
+}
+
+// SubscribeUpdated returns a channel receiving the Project nodes once they are updated, if they match the given filter. The nodes are loaded once the
+// mutation was committed, and the channel is closed when ctx is done. Events that cannot be
+// resolved are passed to the error handlers of the options.
+func (pc *ProjectClient) SubscribeUpdated(ctx context.Context, ps entgql.PubSub, where *ProjectWhereInput, opts ...entgql.SubscriptionOption) (<-chan *Project, error) {
+	if _, err := where.Filter(pc.Query()); err != nil {
+		return nil, err
+	}
+	topic := subscriptionTopic(project.Label, OpUpdateOne)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	nodes := make(chan *Project)
+	go func() {
+		defer close(nodes)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			query := pc.Query().Where(project.ID(id))
+			query, _ = where.Filter(query)
+			node, err := query.Only(ctx)
+			switch {
+			case IsNotFound(err):
+				continue
+			case err != nil:
+				cfg.HandleError(ctx, topic, e, err)
+				continue
+			}
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nodes, nil
+}
+
+// SubscribeDeleted returns a channel receiving the IDs of the Project nodes once
+// their deletion was committed. The channel is closed when ctx is done. Events that
+// cannot be resolved are passed to the error handlers of the options.
+func (pc *ProjectClient) SubscribeDeleted(ctx context.Context, ps entgql.PubSub, opts ...entgql.SubscriptionOption) (<-chan int, error) {
+	topic := subscriptionTopic(project.Label, OpDelete)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	ids := make(chan int)
+	go func() {
+		defer close(ids)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			select {
+			case ids <- id:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ids, nil
+}
+
+// SubscribeCreated returns a channel receiving the Todo nodes once they are created, if they match the given filter. The nodes are loaded once the
+// mutation was committed, and the channel is closed when ctx is done. Events that cannot be
+// resolved are passed to the error handlers of the options.
+func (tc *TodoClient) SubscribeCreated(ctx context.Context, ps entgql.PubSub, where *TodoWhereInput, opts ...entgql.SubscriptionOption) (<-chan *Todo, error) {
+	if _, err := where.Filter(tc.Query()); err != nil {
+		return nil, err
+	}
+	topic := subscriptionTopic(todo.Label, OpCreate)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	nodes := make(chan *Todo)
+	go func() {
+		defer close(nodes)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			query := tc.Query().Where(todo.ID(id))
+			query, _ = where.Filter(query)
+			node, err := query.Only(ctx)
+			switch {
+			case IsNotFound(err):
+				continue
+			case err != nil:
+				cfg.HandleError(ctx, topic, e, err)
+				continue
+			}
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nodes, nil
+}
+
+// SubscribeUpdated returns a channel receiving the Todo nodes once they are updated, if they match the given filter. The nodes are loaded once the
+// mutation was committed, and the channel is closed when ctx is done. Events that cannot be
+// resolved are passed to the error handlers of the options.
+func (tc *TodoClient) SubscribeUpdated(ctx context.Context, ps entgql.PubSub, where *TodoWhereInput, opts ...entgql.SubscriptionOption) (<-chan *Todo, error) {
+	if _, err := where.Filter(tc.Query()); err != nil {
+		return nil, err
+	}
+	topic := subscriptionTopic(todo.Label, OpUpdateOne)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	nodes := make(chan *Todo)
+	go func() {
+		defer close(nodes)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			query := tc.Query().Where(todo.ID(id))
+			query, _ = where.Filter(query)
+			node, err := query.Only(ctx)
+			switch {
+			case IsNotFound(err):
+				continue
+			case err != nil:
+				cfg.HandleError(ctx, topic, e, err)
+				continue
+			}
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nodes, nil
+}
+
+// SubscribeDeleted returns a channel receiving the IDs of the Todo nodes once
+// their deletion was committed. The channel is closed when ctx is done. Events that
+// cannot be resolved are passed to the error handlers of the options.
+func (tc *TodoClient) SubscribeDeleted(ctx context.Context, ps entgql.PubSub, opts ...entgql.SubscriptionOption) (<-chan int, error) {
+	topic := subscriptionTopic(todo.Label, OpDelete)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	ids := make(chan int)
+	go func() {
+		defer close(ids)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			select {
+			case ids <- id:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ids, nil
+}
+
+// SubscribeCreated returns a channel receiving the User nodes once they are created, if they match the given filter. The nodes are loaded once the
+// mutation was committed, and the channel is closed when ctx is done. Events that cannot be
+// resolved are passed to the error handlers of the options.
+func (uc *UserClient) SubscribeCreated(ctx context.Context, ps entgql.PubSub, where *UserWhereInput, opts ...entgql.SubscriptionOption) (<-chan *User, error) {
+	if _, err := where.Filter(uc.Query()); err != nil {
+		return nil, err
+	}
+	topic := subscriptionTopic(user.Label, OpCreate)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	nodes := make(chan *User)
+	go func() {
+		defer close(nodes)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			query := uc.Query().Where(user.ID(id))
+			query, _ = where.Filter(query)
+			node, err := query.Only(ctx)
+			switch {
+			case IsNotFound(err):
+				continue
+			case err != nil:
+				cfg.HandleError(ctx, topic, e, err)
+				continue
+			}
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nodes, nil
+}
+
+// SubscribeUpdated returns a channel receiving the User nodes once they are updated, if they match the given filter. The nodes are loaded once the
+// mutation was committed, and the channel is closed when ctx is done. Events that cannot be
+// resolved are passed to the error handlers of the options.
+func (uc *UserClient) SubscribeUpdated(ctx context.Context, ps entgql.PubSub, where *UserWhereInput, opts ...entgql.SubscriptionOption) (<-chan *User, error) {
+	if _, err := where.Filter(uc.Query()); err != nil {
+		return nil, err
+	}
+	topic := subscriptionTopic(user.Label, OpUpdateOne)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	nodes := make(chan *User)
+	go func() {
+		defer close(nodes)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			query := uc.Query().Where(user.ID(id))
+			query, _ = where.Filter(query)
+			node, err := query.Only(ctx)
+			switch {
+			case IsNotFound(err):
+				continue
+			case err != nil:
+				cfg.HandleError(ctx, topic, e, err)
+				continue
+			}
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nodes, nil
+}
+
+// SubscribeDeleted returns a channel receiving the IDs of the User nodes once
+// their deletion was committed. The channel is closed when ctx is done. Events that
+// cannot be resolved are passed to the error handlers of the options.
+func (uc *UserClient) SubscribeDeleted(ctx context.Context, ps entgql.PubSub, opts ...entgql.SubscriptionOption) (<-chan int, error) {
+	topic := subscriptionTopic(user.Label, OpDelete)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	ids := make(chan int)
+	go func() {
+		defer close(ids)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			select {
+			case ids <- id:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ids, nil
+}
+
+// SubscribeCreated returns a channel receiving the Workspace nodes once they are created, if they match the given filter. The nodes are loaded once the
+// mutation was committed, and the channel is closed when ctx is done. Events that cannot be
+// resolved are passed to the error handlers of the options.
+func (wc *WorkspaceClient) SubscribeCreated(ctx context.Context, ps entgql.PubSub, where *OrganizationWhereInput, opts ...entgql.SubscriptionOption) (<-chan *Workspace, error) {
+	if _, err := where.Filter(wc.Query()); err != nil {
+		return nil, err
+	}
+	topic := subscriptionTopic(workspace.Label, OpCreate)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	nodes := make(chan *Workspace)
+	go func() {
+		defer close(nodes)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			query := wc.Query().Where(workspace.ID(id))
+			query, _ = where.Filter(query)
+			node, err := query.Only(ctx)
+			switch {
+			case IsNotFound(err):
+				continue
+			case err != nil:
+				cfg.HandleError(ctx, topic, e, err)
+				continue
+			}
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nodes, nil
+}
+
+// SubscribeUpdated returns a channel receiving the Workspace nodes once they are updated, if they match the given filter. The nodes are loaded once the
+// mutation was committed, and the channel is closed when ctx is done. Events that cannot be
+// resolved are passed to the error handlers of the options.
+func (wc *WorkspaceClient) SubscribeUpdated(ctx context.Context, ps entgql.PubSub, where *OrganizationWhereInput, opts ...entgql.SubscriptionOption) (<-chan *Workspace, error) {
+	if _, err := where.Filter(wc.Query()); err != nil {
+		return nil, err
+	}
+	topic := subscriptionTopic(workspace.Label, OpUpdateOne)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	nodes := make(chan *Workspace)
+	go func() {
+		defer close(nodes)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			query := wc.Query().Where(workspace.ID(id))
+			query, _ = where.Filter(query)
+			node, err := query.Only(ctx)
+			switch {
+			case IsNotFound(err):
+				continue
+			case err != nil:
+				cfg.HandleError(ctx, topic, e, err)
+				continue
+			}
+			select {
+			case nodes <- node:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nodes, nil
+}
+
+// SubscribeDeleted returns a channel receiving the IDs of the Workspace nodes once
+// their deletion was committed. The channel is closed when ctx is done. Events that
+// cannot be resolved are passed to the error handlers of the options.
+func (wc *WorkspaceClient) SubscribeDeleted(ctx context.Context, ps entgql.PubSub, opts ...entgql.SubscriptionOption) (<-chan int, error) {
+	topic := subscriptionTopic(workspace.Label, OpDelete)
+	events, err := ps.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	cfg := entgql.NewSubscriptionConfig(opts...)
+	ids := make(chan int)
+	go func() {
+		defer close(ids)
+		for e := range events {
+			id, ok := e.(int)
+			if !ok {
+				cfg.HandleError(ctx, topic, e, fmt.Errorf("unexpected event type %T, expected int", e))
+				continue
+			}
+			select {
+			case ids <- id:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ids, nil
+}
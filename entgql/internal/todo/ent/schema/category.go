@@ -90,7 +90,11 @@ func (Category) Edges() []ent.Edge {
 				entgql.OrderField("TODOS_COUNT"),
 			),
 		edge.To("sub_categories", Category.Type).
-			Annotations(entgql.RelayConnection()),
+			Annotations(
+				entgql.RelayConnection(),
+				// Sub-categories can be created with their category.
+				entgql.NestedMutations(),
+			),
 	}
 }
 
@@ -100,6 +104,7 @@ func (Category) Annotations() []schema.Annotation {
 		entgql.QueryField(),
 		entgql.RelayConnection(),
 		entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate()),
+		entgql.BulkMutations(),
 		entgql.MultiOrder(),
 	}
 }
@@ -59,6 +59,7 @@ func (Todo) Fields() []ent.Field {
 			NotEmpty().
 			Annotations(
 				entgql.OrderField("TEXT"),
+				entgql.Searchable(),
 			),
 		field.Bytes("blob").
 			Annotations(
@@ -73,7 +74,13 @@ func (Todo) Fields() []ent.Field {
 			),
 		field.JSON("init", map[string]any{}).
 			Optional().
-			Annotations(entgql.Type("Map")),
+			Annotations(
+				entgql.Type("Map"),
+				entgql.FilterJSON(
+					entgql.JSONPath{Path: "owner.name", Type: "String"},
+					entgql.JSONPath{Path: "labels", Type: "[String!]"},
+				),
+			),
 		field.JSON("custom", []customstruct.Custom{}).
 			Annotations(
 				entgql.Skip(entgql.SkipMutationCreateInput),
@@ -106,6 +113,8 @@ func (Todo) Edges() []ent.Edge {
 				// For unique edges, the order field can be on the edge field that is defined
 				// as entgql.OrderField. The convention is "UPPER(<edge-name>)_<gql-order-field>".
 				entgql.OrderField("PARENT_STATUS"),
+				// Parents are resolved one node at a time, and are not charged.
+				entgql.Complexity(0),
 			).
 			Unique(),
 		edge.From("category", Category.Type).
@@ -125,6 +134,8 @@ func (Todo) Edges() []ent.Edge {
 func (Todo) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entgql.RelayConnection(),
+		entgql.Aggregate(),
+		entgql.Complexity(2),
 		entgql.QueryField().Description("This is the todo item"),
 		entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate()),
 		entgql.MultiOrder(),
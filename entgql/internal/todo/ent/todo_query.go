@@ -47,6 +47,7 @@ type TodoQuery struct {
 	loadTotal         []func(context.Context, []*Todo) error
 	modifiers         []func(*sql.Selector)
 	withNamedChildren map[string]*TodoQuery
+	searchRank        predicate.Todo
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -31,12 +31,20 @@ func TestGeneratedSchema(t *testing.T) {
 	require.NoError(t, err)
 	err = os.WriteFile(filepath.Join(tempDir, "gqlgen.yml"), gqlcfg, 0644)
 	require.NoError(t, err)
+	// The user schema defines the Mutation type, that is extended by the generated schema.
+	schema, err := os.ReadFile("./todo.graphql")
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(tempDir, "todo.graphql"), schema, 0644)
+	require.NoError(t, err)
 	ex, err := entgql.NewExtension(
 		entgql.WithConfigPath(filepath.Join(tempDir, "gqlgen.yml")),
 		entgql.WithSchemaGenerator(),
 		entgql.WithSchemaPath(filepath.Join(tempDir, "ent.graphql")),
 		entgql.WithWhereInputs(true),
 		entgql.WithNodeDescriptor(true),
+		entgql.WithDataloaders(),
+		entgql.WithSubscriptions(),
+		entgql.WithComplexity(),
 	)
 	require.NoError(t, err)
 	err = entc.Generate("./ent/schema", &gen.Config{
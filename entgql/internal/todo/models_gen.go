@@ -5,3 +5,6 @@ package todo
 type NamedNode interface {
 	IsNamedNode()
 }
+
+type Subscription struct {
+}
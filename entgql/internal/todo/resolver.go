@@ -15,17 +15,24 @@
 package todo
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todo/ent"
 	"github.com/99designs/gqlgen/graphql"
 )
 
 // Resolver is the resolver root.
-type Resolver struct{ client *ent.Client }
+type Resolver struct {
+	client *ent.Client
+	pubsub entgql.PubSub
+}
 
-// NewSchema creates a graphql executable schema.
+// NewSchema creates a graphql executable schema. The subscriptions of the
+// schema receive the events of the ent.SubscriptionHook registered on the client.
 func NewSchema(client *ent.Client) graphql.ExecutableSchema {
+	ps := entgql.NewMemoryPubSub(0)
+	client.Use(ent.SubscriptionHook(ps))
 	return NewExecutableSchema(Config{
-		Resolvers: &Resolver{client},
+		Resolvers: &Resolver{client: client, pubsub: ps},
 		Directives: DirectiveRoot{
 			HasPermissions: HasPermission(),
 		},
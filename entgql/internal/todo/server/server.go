@@ -57,6 +57,7 @@ func main() {
 
 	srv := handler.NewDefaultServer(todo.NewSchema(client))
 	srv.Use(entgql.Transactioner{TxOpener: client})
+	srv.Use(entgql.Dataloader{})
 	if cli.Debug {
 		srv.Use(&debug.Tracer{})
 	}
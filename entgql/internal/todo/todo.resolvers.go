@@ -46,7 +46,11 @@ func (r *categoryResolver) TodosCount(ctx context.Context, obj *ent.Category) (*
 
 // CreateCategory is the resolver for the createCategory field.
 func (r *mutationResolver) CreateCategory(ctx context.Context, input ent.CreateCategoryInput) (*ent.Category, error) {
-	return ent.FromContext(ctx).Category.Create().SetInput(input).Save(ctx)
+	c, err := ent.FromContext(ctx).Category.Create().SetInputContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return c.Save(ctx)
 }
 
 // CreateTodo is the resolver for the createTodo field.
@@ -86,6 +90,13 @@ func (r *queryResolver) Ping(ctx context.Context) (string, error) {
 	return "pong", nil
 }
 
+// TodosByIDs is the resolver for the todosByIDs field.
+func (r *queryResolver) TodosByIDs(ctx context.Context, ids []int) ([]*ent.Todo, error) {
+	return r.client.Todo.Query().
+		Where(todo.IDIn(ids...)).
+		All(ctx)
+}
+
 // TodosWithJoins is the resolver for the todosWithJoins field.
 func (r *queryResolver) TodosWithJoins(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy []*ent.TodoOrder, where *ent.TodoWhereInput) (*ent.TodoConnection, error) {
 	return r.client.Todo.Query().
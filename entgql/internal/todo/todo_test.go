@@ -23,6 +23,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -1954,6 +1955,162 @@ func TestMutation_CreateCategory(t *testing.T) {
 	require.Equal(t, "c1.t2", n.Edges[1].Node.Text)
 }
 
+func TestMutation_NestedMutations(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	srv := handler.NewDefaultServer(gen.NewSchema(ec))
+	srv.Use(entgql.Transactioner{TxOpener: ec})
+	gqlc := client.New(srv)
+
+	var rsp struct {
+		CreateCategory struct {
+			Text          string
+			SubCategories struct {
+				Edges []struct {
+					Node struct {
+						Text          string
+						SubCategories struct{ TotalCount int }
+					}
+				}
+			}
+		}
+	}
+	// language=GraphQL
+	const mutation = `mutation CreateCategory($text: String!, $sub: String!) {
+		createCategory(input: {
+			text: $text
+			status: ENABLED
+			createSubCategories: [
+				{ text: $sub, status: ENABLED, createSubCategories: [{ text: "leaf", status: ENABLED }] }
+			]
+		}) {
+			text
+			subCategories { edges { node { text subCategories { totalCount } } } }
+		}
+	}`
+	err := gqlc.Post(mutation, &rsp, client.Var("text", "root"), client.Var("sub", "sub"))
+	require.NoError(t, err)
+	require.Equal(t, "root", rsp.CreateCategory.Text)
+	require.Len(t, rsp.CreateCategory.SubCategories.Edges, 1)
+	require.Equal(t, "sub", rsp.CreateCategory.SubCategories.Edges[0].Node.Text)
+	// The edge is bidirectional, and connects the sub-category to both its root and leaf.
+	require.Equal(t, 2, rsp.CreateCategory.SubCategories.Edges[0].Node.SubCategories.TotalCount)
+	require.Equal(t, 3, ec.Category.Query().CountX(ctx))
+
+	// The nested categories are rolled back with their parent,
+	// whether the parent or one of the nested categories fails.
+	err = gqlc.Post(mutation, &rsp, client.Var("text", ""), client.Var("sub", "sub"))
+	require.ErrorContains(t, err, "value is less than the required length")
+	err = gqlc.Post(mutation, &rsp, client.Var("text", "root"), client.Var("sub", ""))
+	require.ErrorContains(t, err, "value is less than the required length")
+	require.Equal(t, 3, ec.Category.Query().CountX(ctx))
+
+	// Nested inputs are rejected outside of a transaction.
+	input := ent.CreateCategoryInput{
+		Text:                "root",
+		Status:              category.StatusEnabled,
+		CreateSubCategories: []*ent.CreateCategoryInput{{Text: "sub", Status: category.StatusEnabled}},
+	}
+	_, err = ec.Category.Create().SetInputContext(ctx, input)
+	require.EqualError(t, err, "ent: the nested inputs of CreateCategoryInput require a transaction")
+	leaf := ec.Category.Query().Where(category.Text("leaf")).OnlyX(ctx)
+	_, err = ec.Category.UpdateOne(leaf).SetInputContext(ctx, ent.UpdateCategoryInput{
+		UpsertSubCategories: []*ent.UpsertCategoryInput{{Create: &input}},
+	})
+	require.EqualError(t, err, "ent: the nested inputs of UpdateCategoryInput require a transaction")
+	input.CreateSubCategories = nil
+	b, err := ec.Category.Create().SetInputContext(ctx, input)
+	require.NoError(t, err)
+	c := b.SaveX(ctx)
+	require.Equal(t, 4, ec.Category.Query().CountX(ctx))
+
+	// Upserts update existing categories by their ID, or create new ones.
+	tx, err := ec.Tx(ctx)
+	require.NoError(t, err)
+	text := "leaf.1"
+	u, err := tx.Category.UpdateOne(c).SetInputContext(ctx, ent.UpdateCategoryInput{
+		UpsertSubCategories: []*ent.UpsertCategoryInput{
+			{ID: &leaf.ID, Update: &ent.UpdateCategoryInput{Text: &text}},
+			{Create: &ent.CreateCategoryInput{Text: "leaf.2", Status: category.StatusDisabled}},
+		},
+	})
+	require.NoError(t, err)
+	u.ExecX(ctx)
+	require.NoError(t, tx.Commit())
+	subs := c.QuerySubCategories().Order(category.ByID()).AllX(ctx)
+	require.Len(t, subs, 2)
+	require.Equal(t, leaf.ID, subs[0].ID)
+	require.Equal(t, "leaf.1", subs[0].Text)
+	require.Equal(t, "leaf.2", subs[1].Text)
+}
+
+func TestMutation_BulkMutations(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	srv := handler.NewDefaultServer(gen.NewSchema(ec))
+	srv.Use(entgql.Transactioner{TxOpener: ec})
+	gqlc := client.New(srv)
+
+	var created struct {
+		CreateManyCategories []struct {
+			Text          string
+			SubCategories struct{ TotalCount int }
+		}
+	}
+	err := gqlc.Post(`mutation {
+		createManyCategories(input: [
+			{ text: "bulk-1", status: ENABLED },
+			{ text: "bulk-2", status: ENABLED, createSubCategories: [{ text: "nested", status: ENABLED }] },
+			{ text: "other", status: ENABLED }
+		]) {
+			text
+			subCategories { totalCount }
+		}
+	}`, &created)
+	require.NoError(t, err)
+	require.Len(t, created.CreateManyCategories, 3)
+	for i, text := range []string{"bulk-1", "bulk-2", "other"} {
+		require.Equal(t, text, created.CreateManyCategories[i].Text)
+	}
+	require.Equal(t, 1, created.CreateManyCategories[1].SubCategories.TotalCount)
+	require.Equal(t, 4, ec.Category.Query().CountX(ctx))
+
+	// Empty filters are rejected, unless all is set.
+	var rsp map[string]int
+	err = gqlc.Post(`mutation { deleteManyCategories(where: {}) }`, &rsp)
+	require.ErrorContains(t, err, "all must be set to mutate all categories")
+	err = gqlc.Post(`mutation { updateManyCategories(where: {}, input: { status: DISABLED }) }`, &rsp)
+	require.ErrorContains(t, err, "all must be set to mutate all categories")
+	require.Zero(t, ec.Category.Query().Where(category.StatusEQ(category.StatusDisabled)).CountX(ctx))
+
+	// Filtered mutations affect only the matched categories.
+	err = gqlc.Post(`mutation { updateManyCategories(where: { textHasPrefix: "bulk" }, input: { status: DISABLED }) }`, &rsp)
+	require.NoError(t, err)
+	require.Equal(t, 2, rsp["updateManyCategories"])
+	require.Equal(t, 2, ec.Category.Query().Where(category.StatusEQ(category.StatusDisabled)).CountX(ctx))
+	err = gqlc.Post(`mutation { updateManyCategories(where: { textHasPrefix: "bulk" }, input: { upsertSubCategories: [{ create: { text: "nested", status: ENABLED } }] }) }`, &rsp)
+	require.ErrorContains(t, err, "ent: the nested inputs of UpdateCategoryInput cannot be applied to many categories")
+	err = gqlc.Post(`mutation { deleteManyCategories(where: { status: DISABLED }) }`, &rsp)
+	require.NoError(t, err)
+	require.Equal(t, 2, rsp["deleteManyCategories"])
+	require.ElementsMatch(t, []string{"nested", "other"}, ec.Category.Query().Select(category.FieldText).StringsX(ctx))
+
+	// All categories are mutated with an empty filter, if all is set.
+	err = gqlc.Post(`mutation { updateManyCategories(where: {}, all: true, input: { status: DISABLED }) }`, &rsp)
+	require.NoError(t, err)
+	require.Equal(t, 2, rsp["updateManyCategories"])
+	err = gqlc.Post(`mutation { deleteManyCategories(where: {}, all: true) }`, &rsp)
+	require.NoError(t, err)
+	require.Equal(t, 2, rsp["deleteManyCategories"])
+	require.Zero(t, ec.Category.Query().CountX(ctx))
+}
+
 func TestMutation_ClearChildren(t *testing.T) {
 	ec := enttest.Open(t, dialect.SQLite,
 		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
@@ -2241,16 +2398,21 @@ func TestMultiFieldsOrder(t *testing.T) {
 }
 
 type queryRecorder struct {
+	mu      sync.Mutex
 	queries []string
 	dialect.Driver
 }
 
 func (r *queryRecorder) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	r.queries = nil
 }
 
 func (r *queryRecorder) Query(ctx context.Context, query string, args, v interface{}) error {
+	r.mu.Lock()
 	r.queries = append(r.queries, query)
+	r.mu.Unlock()
 	return r.Driver.Query(ctx, query, args, v)
 }
 
@@ -3145,3 +3307,638 @@ func TestPrivateFieldSelectionForPagination(t *testing.T) {
 		"SELECT `todos`.`id`, `todos`.`text`, `todos`.`status` FROM `todos` LEFT JOIN `categories` AS `t1` ON `todos`.`category_id` = `t1`.`id` WHERE `todos`.`status` < ? OR (`todos`.`status` = ? AND `todos`.`id` > ?) GROUP BY `todos`.`id` ORDER BY `todos`.`status` DESC, `todos`.`id` LIMIT 3",
 	}, rec.queries)
 }
+
+func TestNullsOrderPagination(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	srv := handler.NewDefaultServer(gen.NewSchema(ec))
+	srv.Use(entgql.Transactioner{TxOpener: ec})
+	p1 := ec.Todo.Create().SetText("p1").SetStatus(todo.StatusCompleted).SaveX(ctx)
+	p2 := ec.Todo.Create().SetText("p2").SetStatus(todo.StatusPending).SaveX(ctx)
+	ec.Todo.CreateBulk(
+		ec.Todo.Create().SetText("c1").SetStatus(todo.StatusPending).SetParent(p2),
+		ec.Todo.Create().SetText("c2").SetStatus(todo.StatusPending).SetParent(p1),
+		ec.Todo.Create().SetText("c3").SetStatus(todo.StatusPending),
+		ec.Todo.Create().SetText("c4").SetStatus(todo.StatusPending).SetParent(p2),
+		ec.Todo.Create().SetText("c5").SetStatus(todo.StatusPending),
+	).SaveX(ctx)
+
+	var (
+		gqlc = client.New(srv)
+		// language=GraphQL
+		query = `query ($direction: OrderDirection!, $first: Int, $after: Cursor) {
+			todos(orderBy: {field: PARENT_STATUS, direction: $direction}, first: $first, after: $after) {
+				edges {
+					node {
+						text
+						parent {
+							status
+						}
+					}
+					cursor
+				}
+			}
+		}`
+	)
+	type response struct {
+		Todos struct {
+			Edges []struct {
+				Node struct {
+					Text   string
+					Parent *struct {
+						Status todo.Status
+					}
+				}
+				Cursor string
+			}
+		}
+	}
+	for _, direction := range []entgql.OrderDirection{
+		entgql.OrderDirectionAsc,
+		entgql.OrderDirectionDesc,
+		entgql.OrderDirectionAscNullsFirst,
+		entgql.OrderDirectionAscNullsLast,
+		entgql.OrderDirectionDescNullsFirst,
+		entgql.OrderDirectionDescNullsLast,
+	} {
+		t.Run(direction.String(), func(t *testing.T) {
+			var all response
+			gqlc.MustPost(query, &all, client.Var("direction", direction))
+			require.Len(t, all.Todos.Edges, 7)
+			// Todos without a parent are ordered by the
+			// NULL values of their parent status.
+			nulls := all.Todos.Edges[:2]
+			if !direction.NullsFirst(dialect.SQLite) {
+				nulls = all.Todos.Edges[5:]
+			}
+			for _, e := range nulls {
+				require.Nil(t, e.Node.Parent, e.Node.Text)
+			}
+			var (
+				texts []string
+				after any
+			)
+			for {
+				var page response
+				gqlc.MustPost(query, &page, client.Var("direction", direction), client.Var("first", 2), client.Var("after", after))
+				if len(page.Todos.Edges) == 0 {
+					break
+				}
+				for _, e := range page.Todos.Edges {
+					texts = append(texts, e.Node.Text)
+				}
+				after = page.Todos.Edges[len(page.Todos.Edges)-1].Cursor
+			}
+			expected := make([]string, 0, len(all.Todos.Edges))
+			for _, e := range all.Todos.Edges {
+				expected = append(expected, e.Node.Text)
+			}
+			require.Equal(t, expected, texts)
+		})
+	}
+}
+
+func TestDataloaders(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	require.NoError(t, err)
+	count := &queryCount{Driver: drv}
+	ec := enttest.NewClient(t,
+		enttest.WithOptions(ent.Driver(count)),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	root := ec.Todo.Create().SetText("root").SetStatus(todo.StatusInProgress).SaveX(ctx)
+	for i := 0; i < 5; i++ {
+		ec.Todo.Create().SetText(strconv.Itoa(i)).SetParent(root).SetStatus(todo.StatusInProgress).SaveX(ctx)
+	}
+	children := ec.Todo.Query().Where(todo.HasParent()).AllX(ctx)
+	require.Len(t, children, 5)
+
+	parents := func(ctx context.Context) {
+		var (
+			wg   sync.WaitGroup
+			errs = make([]error, len(children))
+			ids  = make([]int, len(children))
+		)
+		for i, c := range children {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				p, err := c.Parent(ctx)
+				if errs[i] = err; err == nil {
+					ids[i] = p.ID
+				}
+			}()
+		}
+		wg.Wait()
+		for i := range children {
+			require.NoError(t, errs[i])
+			require.Equal(t, root.ID, ids[i])
+		}
+	}
+	// Without loaders, every edge is queried on its own.
+	count.reset()
+	parents(ctx)
+	require.EqualValues(t, 5, count.value())
+	// The loaders of the context fetch the children and
+	// their parents at once, using a query for each.
+	count.reset()
+	parents(entgql.NewLoadersContext(ctx))
+	require.EqualValues(t, 2, count.value())
+}
+
+func TestAggregate(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	root := ec.Todo.Create().SetText("root").SetStatus(todo.StatusPending).SetPriority(100).SaveX(ctx)
+	for i := 1; i <= 6; i++ {
+		status := todo.StatusCompleted
+		if i%2 == 0 {
+			status = todo.StatusInProgress
+		}
+		ec.Todo.Create().
+			SetText(strconv.Itoa(i)).
+			SetStatus(status).
+			SetPriority(i).
+			SetValue(10 * i).
+			SetParent(root).
+			SaveX(ctx)
+	}
+
+	type (
+		numbers struct {
+			Priority *float64
+			Value    *float64
+		}
+		connection struct {
+			TotalCount int
+			Edges      []struct {
+				Node   struct{ PriorityOrder int }
+				Cursor string
+			}
+			Aggregate struct {
+				Count   int
+				Sum     *numbers
+				Avg     *numbers
+				Min     *numbers
+				Max     *numbers
+				GroupBy []struct {
+					Key struct {
+						Status   *todo.Status
+						Priority *int
+					}
+					Count int
+					Sum   *numbers
+				}
+			}
+		}
+	)
+	var (
+		gqlc = client.New(handler.NewDefaultServer(gen.NewSchema(ec)))
+		// language=GraphQL
+		query = `query ($after: Cursor) {
+			todos(first: 1, after: $after, where: {priorityGT: 1, priorityLT: 100}, orderBy: {field: PRIORITY_ORDER}) {
+				totalCount
+				edges { node { priorityOrder } cursor }
+				aggregate {
+					count
+					sum { priority value }
+					avg { priority }
+					min { priority }
+					max { priority }
+					groupBy(fields: [STATUS]) { key { status priority } count sum { value } }
+				}
+			}
+		}`
+		rsp struct{ Todos connection }
+	)
+	// Aggregations run over the filtered todos, regardless of the page and its cursor.
+	var after any
+	for _, priority := range []int{2, 3} {
+		gqlc.MustPost(query, &rsp, client.Var("after", after))
+		conn := rsp.Todos
+		require.Equal(t, 5, conn.TotalCount)
+		require.Len(t, conn.Edges, 1)
+		require.Equal(t, priority, conn.Edges[0].Node.PriorityOrder)
+		after = conn.Edges[0].Cursor
+
+		agg := conn.Aggregate
+		require.Equal(t, 5, agg.Count)
+		require.Equal(t, 20.0, *agg.Sum.Priority)
+		require.Equal(t, 200.0, *agg.Sum.Value)
+		require.Equal(t, 4.0, *agg.Avg.Priority)
+		require.Nil(t, agg.Avg.Value, "not selected")
+		require.Equal(t, 2.0, *agg.Min.Priority)
+		require.Equal(t, 6.0, *agg.Max.Priority)
+		require.Len(t, agg.GroupBy, 2)
+		for _, g := range agg.GroupBy {
+			require.Nil(t, g.Key.Priority, "not grouped by")
+			switch *g.Key.Status {
+			case todo.StatusCompleted:
+				require.Equal(t, 2, g.Count)
+				require.Equal(t, 80.0, *g.Sum.Value)
+			case todo.StatusInProgress:
+				require.Equal(t, 3, g.Count)
+				require.Equal(t, 120.0, *g.Sum.Value)
+			default:
+				t.Fatalf("unexpected group %v", *g.Key.Status)
+			}
+		}
+	}
+
+	// Edge connections aggregate the nodes of the edge.
+	var edge struct {
+		Todo struct {
+			Children connection
+		}
+	}
+	gqlc.MustPost(`query ($id: ID!) {
+		todo: node(id: $id) {
+			... on Todo {
+				children(first: 1, where: {status: COMPLETED}) {
+					totalCount
+					aggregate { count sum { priority } }
+				}
+			}
+		}
+	}`, &edge, client.Var("id", root.ID))
+	require.Equal(t, 3, edge.Todo.Children.TotalCount)
+	require.Equal(t, 3, edge.Todo.Children.Aggregate.Count)
+	require.Equal(t, 9.0, *edge.Todo.Children.Aggregate.Sum.Priority)
+}
+
+func TestComplexityLimit(t *testing.T) {
+	ec := enttest.Open(t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	srv := handler.NewDefaultServer(gen.NewSchema(ec))
+	srv.Use(&entgql.ComplexityLimit{
+		Funcs:         ent.Complexity,
+		MaxComplexity: 10,
+		MaxDepth:      7,
+	})
+	gqlc := client.New(srv)
+	rejected := func(query, code, message string) {
+		t.Helper()
+		err := gqlc.Post(query, new(any))
+		var jerr client.RawJsonError
+		require.True(t, errors.As(err, &jerr), err)
+		var errs gqlerror.List
+		require.NoError(t, json.Unmarshal(jerr.RawMessage, &errs))
+		require.Len(t, errs, 1)
+		require.Equal(t, message, errs[0].Message)
+		require.Equal(t, code, errs[0].Extensions["code"])
+	}
+
+	// Todos weigh 2: 2 * (2 + edges{node{id}}).
+	require.NoError(t, gqlc.Post(`query { todos(first: 2) { edges { node { id } } } }`, new(any)))
+	rejected(
+		`query { todos(first: 10) { edges { node { children(first: 10) { edges { node { id } } } } } } }`,
+		"COMPLEXITY_LIMIT_EXCEEDED", "operation has complexity 540, which exceeds the limit of 10",
+	)
+	// The parent edge overrides the weight of todos with 0, but the category edge is charged.
+	require.NoError(t, gqlc.Post(`query { todos(first: 2) { edges { node { parent { parent { id } } } } } }`, new(any)))
+	rejected(
+		`query { todos(first: 2) { edges { node { category { id } } } } }`,
+		"COMPLEXITY_LIMIT_EXCEEDED", "operation has complexity 12, which exceeds the limit of 10",
+	)
+	rejected(
+		`query { todos(first: 1) { edges { node { parent { parent { parent { parent { id } } } } } } } }`,
+		"DEPTH_LIMIT_EXCEEDED", "operation has depth 8, which exceeds the limit of 7",
+	)
+}
+
+func TestSearch(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	require.NoError(t, err)
+	rec := &queryRecorder{Driver: drv}
+	ec := enttest.NewClient(t,
+		enttest.WithOptions(ent.Driver(rec)),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	for _, text := range []string{"Learn Ent", "Write GraphQL", "ent and graphql", "Other"} {
+		ec.Todo.Create().SetText(text).SetStatus(todo.StatusInProgress).ExecX(ctx)
+	}
+	gqlc := client.New(handler.NewDefaultServer(gen.NewSchema(ec)))
+	type page struct {
+		Todos struct {
+			TotalCount int
+			Edges      []struct {
+				Node   struct{ Text string }
+				Cursor string
+			}
+			PageInfo struct {
+				HasNextPage bool
+				EndCursor   *string
+			}
+		}
+	}
+	// language=GraphQL
+	const query = `query Todos($search: String, $field: TodoOrderField!, $after: Cursor) {
+		todos(first: 1, after: $after, where: { search: $search }, orderBy: [{ field: $field, direction: DESC }]) {
+			totalCount
+			edges { node { text } cursor }
+			pageInfo { hasNextPage endCursor }
+		}
+	}`
+
+	// The LIKE fallback ranks the todos by the number of searchable fields
+	// that contain the search, and ties are ordered by their IDs.
+	var (
+		texts []string
+		after *string
+	)
+	rec.reset()
+	for {
+		var rsp page
+		err := gqlc.Post(query, &rsp, client.Var("search", "ent"), client.Var("field", "RELEVANCE"), client.Var("after", after))
+		require.NoError(t, err)
+		require.Equal(t, 2, rsp.Todos.TotalCount)
+		for _, e := range rsp.Todos.Edges {
+			texts = append(texts, e.Node.Text)
+		}
+		if !rsp.Todos.PageInfo.HasNextPage {
+			break
+		}
+		after = rsp.Todos.PageInfo.EndCursor
+	}
+	require.Equal(t, []string{"Learn Ent", "ent and graphql"}, texts)
+	for _, q := range rec.queries {
+		require.Contains(t, q, "AS `search_rank` FROM `todos` WHERE LOWER(`todos`.`text`) LIKE ?) AS `todos`")
+	}
+
+	// The rank is computed only when ordering by the relevance.
+	rec.reset()
+	var rsp page
+	err = gqlc.Post(query, &rsp, client.Var("search", "graphql"), client.Var("field", "TEXT"))
+	require.NoError(t, err)
+	require.Equal(t, 2, rsp.Todos.TotalCount)
+	require.Equal(t, "ent and graphql", rsp.Todos.Edges[0].Node.Text)
+	for _, q := range rec.queries {
+		require.NotContains(t, q, "search_rank")
+	}
+
+	// Empty searches are ignored, and ordering by the relevance requires a search.
+	err = gqlc.Post(query, &rsp, client.Var("search", " "), client.Var("field", "TEXT"))
+	require.NoError(t, err)
+	require.Equal(t, 4, rsp.Todos.TotalCount)
+	for _, search := range []any{nil, "", " "} {
+		err = gqlc.Post(query, &rsp, client.Var("search", search), client.Var("field", "RELEVANCE"))
+		require.EqualError(t, err, `[{"message":"Ordering by RELEVANCE requires a search","path":["todos"],"extensions":{"code":"SEARCH_REQUIRED"}}]`)
+	}
+}
+
+func TestJSONFilter(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	for text, init := range map[string]map[string]any{
+		"a8m":       {"owner": map[string]any{"name": "a8m"}, "labels": []string{"ent", "graphql"}},
+		"masseelch": {"owner": map[string]any{"name": "masseelch"}, "labels": []string{"ent"}},
+		"nobody":    {"labels": []string{"graphql"}},
+	} {
+		ec.Todo.Create().SetText(text).SetStatus(todo.StatusInProgress).SetInit(init).ExecX(ctx)
+	}
+	ec.Todo.Create().SetText("empty").SetStatus(todo.StatusInProgress).ExecX(ctx)
+	gqlc := client.New(handler.NewDefaultServer(gen.NewSchema(ec)))
+	// language=GraphQL
+	const query = `query Todos($where: TodoWhereInput) {
+		todos(where: $where, orderBy: [{ field: TEXT }]) {
+			edges { node { text } }
+		}
+	}`
+	texts := func(where map[string]any) []string {
+		var rsp struct {
+			Todos struct {
+				Edges []struct {
+					Node struct{ Text string }
+				}
+			}
+		}
+		gqlc.MustPost(query, &rsp, client.Var("where", where))
+		texts := make([]string, 0, len(rsp.Todos.Edges))
+		for _, e := range rsp.Todos.Edges {
+			texts = append(texts, e.Node.Text)
+		}
+		return texts
+	}
+	require.Equal(t, []string{"a8m", "masseelch"}, texts(map[string]any{"initHasKey": "owner.name"}))
+	require.Equal(t, []string{"a8m", "masseelch", "nobody"}, texts(map[string]any{"initHasKey": "labels"}))
+	require.Equal(t, []string{"masseelch"}, texts(map[string]any{"initOwnerName": "masseelch"}))
+	require.Equal(t, []string{"a8m", "masseelch"}, texts(map[string]any{"initOwnerNameContains": "m"}))
+	require.Equal(t, []string{"a8m", "nobody"}, texts(map[string]any{"initLabelsArrayContains": "graphql"}))
+	require.Equal(t, []string{"a8m"}, texts(map[string]any{"initOwnerNameContains": "8", "initLabelsArrayContains": "ent"}))
+	require.Empty(t, texts(map[string]any{"initOwnerName": "unknown"}))
+
+	var rsp struct{}
+	err := gqlc.Post(query, &rsp, client.Var("where", map[string]any{"initHasKey": "$.owner"}))
+	require.ErrorContains(t, err, `entgql: invalid JSON path \"$.owner\"`)
+}
+
+func TestDataloader(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	require.NoError(t, err)
+	rec := &queryRecorder{Driver: drv}
+	ec := enttest.NewClient(t,
+		enttest.WithOptions(ent.Driver(rec)),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	cat := ec.Category.Create().SetText("category").SetStatus(category.StatusEnabled).SaveX(ctx)
+	root := ec.Todo.Create().SetText("root").SetStatus(todo.StatusInProgress).SaveX(ctx)
+	var ids []int
+	for i := 0; i < 5; i++ {
+		ids = append(ids, ec.Todo.Create().SetText(strconv.Itoa(i)).SetStatus(todo.StatusInProgress).SetParent(root).SetCategory(cat).SaveX(ctx).ID)
+	}
+	// language=GraphQL
+	const query = `query TodosByIDs($ids: [ID!]!) {
+		todosByIDs(ids: $ids) {
+			text
+			parent { text }
+			category { text }
+		}
+	}`
+	var rsp struct {
+		TodosByIDs []struct {
+			Text     string
+			Parent   *struct{ Text string }
+			Category *struct{ Text string }
+		}
+	}
+
+	// Without the Dataloader extension, the edges are queried once per todo.
+	gqlc := client.New(handler.NewDefaultServer(gen.NewSchema(ec)))
+	rec.reset()
+	gqlc.MustPost(query, &rsp, client.Var("ids", append(ids, root.ID)))
+	require.Len(t, rsp.TodosByIDs, 6)
+	require.Len(t, rec.queries, 1+2*6)
+
+	// The todos returned by the custom resolver were not collected, and
+	// their edges are loaded in a single batch per edge, that queries the
+	// todos of the batch and then their edge.
+	srv := handler.NewDefaultServer(gen.NewSchema(ec))
+	srv.Use(entgql.Dataloader{
+		// Wait for all todos to be resolved, also when the tests are run in parallel.
+		Options: []entgql.LoaderOption{entgql.WithLoaderWait(50 * time.Millisecond)},
+	})
+	gqlc = client.New(srv)
+	rec.reset()
+	gqlc.MustPost(query, &rsp, client.Var("ids", append(ids, root.ID)))
+	require.Len(t, rec.queries, 1+2*2)
+	require.Len(t, rsp.TodosByIDs, 6)
+	for _, n := range rsp.TodosByIDs {
+		if n.Text == "root" {
+			require.Nil(t, n.Parent)
+			require.Nil(t, n.Category)
+			continue
+		}
+		require.Equal(t, "root", n.Parent.Text)
+		require.Equal(t, "category", n.Category.Text)
+	}
+}
+
+// receive returns the next value of the channel, or fails the test after a timeout.
+func receive[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+	select {
+	case v, ok := <-ch:
+		require.True(t, ok, "channel was closed")
+		return v
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for event")
+		panic("unreachable")
+	}
+}
+
+func TestSubscriptions(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ec := enttest.Open(t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	ps := entgql.NewMemoryPubSub(0)
+	ec.Use(ent.SubscriptionHook(ps))
+	completed := todo.StatusCompleted
+	created, err := ec.Todo.SubscribeCreated(ctx, ps, &ent.TodoWhereInput{Status: &completed})
+	require.NoError(t, err)
+	updated, err := ec.Todo.SubscribeUpdated(ctx, ps, nil)
+	require.NoError(t, err)
+	deleted, err := ec.Todo.SubscribeDeleted(ctx, ps)
+	require.NoError(t, err)
+
+	// Mutations outside of a transaction are published once executed.
+	t1 := ec.Todo.Create().SetText("t1").SetStatus(todo.StatusCompleted).SaveX(ctx)
+	require.Equal(t, t1.ID, receive(t, created).ID)
+
+	// Mutations in a transaction are published only after it was committed.
+	tx, err := ec.Tx(ctx)
+	require.NoError(t, err)
+	t2 := tx.Todo.Create().SetText("t2").SetStatus(todo.StatusCompleted).SaveX(ctx)
+	select {
+	case n := <-created:
+		t.Fatalf("todo %d was published before commit", n.ID)
+	case <-time.After(50 * time.Millisecond):
+	}
+	require.NoError(t, tx.Commit())
+	require.Equal(t, t2.ID, receive(t, created).ID)
+
+	// Rolled back mutations are never published. Todos that do not
+	// match the filter of the subscription are not received either.
+	tx, err = ec.Tx(ctx)
+	require.NoError(t, err)
+	tx.Todo.Create().SetText("rolled back").SetStatus(todo.StatusCompleted).SaveX(ctx)
+	require.NoError(t, tx.Rollback())
+	ec.Todo.Create().SetText("pending").SetStatus(todo.StatusPending).SaveX(ctx)
+	t3 := ec.Todo.Create().SetText("t3").SetStatus(todo.StatusCompleted).SaveX(ctx)
+	require.Equal(t, t3.ID, receive(t, created).ID)
+
+	// The IDs of bulk updates and deletes are resolved before the mutation.
+	n := ec.Todo.Update().Where(todo.IDIn(t1.ID, t2.ID)).SetPriority(1).SaveX(ctx)
+	require.Equal(t, 2, n)
+	u1, u2 := receive(t, updated), receive(t, updated)
+	require.ElementsMatch(t, []int{t1.ID, t2.ID}, []int{u1.ID, u2.ID})
+	require.Equal(t, 1, u1.Priority, "updated nodes are loaded after the update")
+	n = ec.Todo.Delete().Where(todo.IDIn(t1.ID, t3.ID)).ExecX(ctx)
+	require.Equal(t, 2, n)
+	require.ElementsMatch(t, []int{t1.ID, t3.ID}, []int{receive(t, deleted), receive(t, deleted)})
+
+	// Events that cannot be resolved are passed to the error handler.
+	errs := make(chan error, 1)
+	badIDs, err := ec.Todo.SubscribeDeleted(ctx, ps, entgql.WithSubscriptionErrorHandler(func(_ context.Context, topic string, event any, err error) {
+		errs <- fmt.Errorf("%s %v: %w", topic, event, err)
+	}))
+	require.NoError(t, err)
+	require.NoError(t, ps.Publish(ctx, "todo.deleted", "t1"))
+	require.EqualError(t, receive(t, errs), "todo.deleted t1: unexpected event type string, expected int")
+	ec.Todo.DeleteOneID(t2.ID).ExecX(ctx)
+	require.Equal(t, t2.ID, receive(t, badIDs))
+
+	// Channels are closed once the context is done.
+	cancel()
+	for range created {
+	}
+}
+
+func TestSubscriptions_GraphQL(t *testing.T) {
+	ec := enttest.Open(t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	srv := handler.NewDefaultServer(gen.NewSchema(ec))
+	srv.Use(entgql.Transactioner{TxOpener: ec})
+	gqlc := client.New(srv)
+	sub := gqlc.Websocket(`subscription { todoCreated(where: {textHasPrefix: "sub-"}) { id text } }`)
+	defer sub.Close()
+
+	type event struct {
+		TodoCreated struct {
+			ID   string
+			Text string
+		}
+	}
+	events := make(chan event)
+	go func() {
+		for {
+			var e event
+			if err := sub.Next(&e); err != nil {
+				close(events)
+				return
+			}
+			events <- e
+		}
+	}()
+
+	// Mutations are published once the transaction of the Transactioner was committed.
+	// As the subscription is registered asynchronously, todos are created until one is
+	// received, and only the todos that match the filter of the subscription are received.
+	var (
+		rsp struct{ CreateTodo struct{ ID string } }
+		ids = make(map[string]string)
+	)
+	for i := 0; ; i++ {
+		gqlc.MustPost(`mutation { createTodo(input: {status: COMPLETED, text: "skipped"}) { id } }`, &rsp)
+		text := fmt.Sprint("sub-", i)
+		gqlc.MustPost(`mutation ($text: String!) { createTodo(input: {status: COMPLETED, text: $text}) { id } }`, &rsp, client.Var("text", text))
+		ids[text] = rsp.CreateTodo.ID
+		select {
+		case e, ok := <-events:
+			require.True(t, ok, "subscription was closed")
+			require.Contains(t, ids, e.TodoCreated.Text)
+			require.Equal(t, ids[e.TodoCreated.Text], e.TodoCreated.ID)
+			return
+		case <-time.After(10 * time.Millisecond):
+			require.Less(t, i, 500, "timeout waiting for event")
+		}
+	}
+}
@@ -18,6 +18,7 @@ import (
 func main() {
 	ex, err := entgql.NewExtension(
 		entgql.WithConfigPath("./gqlgen.yml"),
+		entgql.WithCursorCodec(),
 	)
 	if err != nil {
 		log.Fatalf("creating entgql extension: %v", err)
@@ -0,0 +1,33 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql"
+)
+
+// checkCursorCodec fails the pagination of operations without an entgql.CursorCodec in
+// their context, as the schema requires the cursors to be encoded by the codec of the
+// server, and not in plain base64. The codec is set by the entgql.CursorCodecExtension.
+func checkCursorCodec(ctx context.Context) error {
+	if !entgql.HasCursorCodec(ctx) {
+		return entgql.ErrCursorCodecMissing
+	}
+	return nil
+}
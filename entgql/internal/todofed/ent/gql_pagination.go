@@ -41,7 +41,7 @@ type (
 )
 
 func orderFunc(o OrderDirection, field string) func(*sql.Selector) {
-	if o == entgql.OrderDirectionDesc {
+	if o.Desc() {
 		return Desc(field)
 	}
 	return Asc(field)
@@ -101,6 +101,7 @@ const (
 	nodeField       = "node"
 	pageInfoField   = "pageInfo"
 	totalCountField = "totalCount"
+	aggregateField  = "aggregate"
 )
 
 func paginateLimit(first, last *int) int {
@@ -231,7 +232,11 @@ func (p *categoryPager) applyCursors(query *CategoryQuery, after, before *Cursor
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultCategoryOrder.Field.column, p.order.Field.column, direction) {
+	cursorsPredicate := entgql.CursorsPredicate[int]
+	if p.order.Field.nullable {
+		cursorsPredicate = entgql.NullableCursorsPredicate[int]
+	}
+	for _, predicate := range cursorsPredicate(after, before, DefaultCategoryOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
@@ -242,7 +247,7 @@ func (p *categoryPager) applyOrder(query *CategoryQuery) *CategoryQuery {
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	query = query.Order(entgql.OrderByTerm(direction, p.order.Field.column, p.order.Field.toTerm))
 	if p.order.Field != DefaultCategoryOrder.Field {
 		query = query.Order(DefaultCategoryOrder.Field.toTerm(direction.OrderTermOption()))
 	}
@@ -261,9 +266,9 @@ func (p *categoryPager) orderExpr(query *CategoryQuery) sql.Querier {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		b.Join(direction.OrderExpr(p.order.Field.column))
 		if p.order.Field != DefaultCategoryOrder.Field {
-			b.Comma().Ident(DefaultCategoryOrder.Field.column).Pad().WriteString(string(direction))
+			b.Comma().Join(direction.OrderExpr(DefaultCategoryOrder.Field.column))
 		}
 	})
 }
@@ -273,6 +278,9 @@ func (c *CategoryQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
 	before *Cursor, last *int, opts ...CategoryPaginateOption,
 ) (*CategoryConnection, error) {
+	if err := checkCursorCodec(ctx); err != nil {
+		return nil, err
+	}
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
@@ -391,6 +399,7 @@ type CategoryOrderField struct {
 	// Value extracts the ordering value from the given Category.
 	Value    func(*Category) (ent.Value, error)
 	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
 	toTerm   func(...sql.OrderTermOption) category.OrderOption
 	toCursor func(*Category) Cursor
 }
@@ -545,7 +554,11 @@ func (p *todoPager) applyCursors(query *TodoQuery, after, before *Cursor) (*Todo
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultTodoOrder.Field.column, p.order.Field.column, direction) {
+	cursorsPredicate := entgql.CursorsPredicate[int]
+	if p.order.Field.nullable {
+		cursorsPredicate = entgql.NullableCursorsPredicate[int]
+	}
+	for _, predicate := range cursorsPredicate(after, before, DefaultTodoOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
@@ -556,7 +569,7 @@ func (p *todoPager) applyOrder(query *TodoQuery) *TodoQuery {
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	query = query.Order(entgql.OrderByTerm(direction, p.order.Field.column, p.order.Field.toTerm))
 	if p.order.Field != DefaultTodoOrder.Field {
 		query = query.Order(DefaultTodoOrder.Field.toTerm(direction.OrderTermOption()))
 	}
@@ -575,9 +588,9 @@ func (p *todoPager) orderExpr(query *TodoQuery) sql.Querier {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		b.Join(direction.OrderExpr(p.order.Field.column))
 		if p.order.Field != DefaultTodoOrder.Field {
-			b.Comma().Ident(DefaultTodoOrder.Field.column).Pad().WriteString(string(direction))
+			b.Comma().Join(direction.OrderExpr(DefaultTodoOrder.Field.column))
 		}
 	})
 }
@@ -587,6 +600,9 @@ func (t *TodoQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
 	before *Cursor, last *int, opts ...TodoPaginateOption,
 ) (*TodoConnection, error) {
+	if err := checkCursorCodec(ctx); err != nil {
+		return nil, err
+	}
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
@@ -741,6 +757,7 @@ type TodoOrderField struct {
 	// Value extracts the ordering value from the given Todo.
 	Value    func(*Todo) (ent.Value, error)
 	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
 	toTerm   func(...sql.OrderTermOption) todo.OrderOption
 	toCursor func(*Todo) Cursor
 }
@@ -35,8 +35,9 @@ import (
 
 func main() {
 	var cli struct {
-		Addr  string `name:"address" default:":8081" help:"Address to listen on."`
-		Debug bool   `name:"debug" help:"Enable debugging mode."`
+		Addr      string `name:"address" default:":8081" help:"Address to listen on."`
+		Debug     bool   `name:"debug" help:"Enable debugging mode."`
+		CursorKey string `name:"cursor-key" env:"CURSOR_KEY" required:"" help:"Key used to sign the pagination cursors."`
 	}
 	kong.Parse(&cli)
 
@@ -57,6 +58,7 @@ func main() {
 
 	srv := handler.NewDefaultServer(todofed.NewSchema(client))
 	srv.Use(entgql.Transactioner{TxOpener: client})
+	srv.Use(entgql.CursorCodecExtension{Codec: entgql.NewHMACCodec([]byte(cli.CursorKey))})
 	if cli.Debug {
 		srv.Use(&debug.Tracer{})
 	}
@@ -72,6 +72,9 @@ const (
 	idOffset = 1 << 32
 )
 
+// codec signs the cursors of the test server.
+var codec = entgql.NewHMACCodec([]byte("secret"))
+
 func (s *todoTestSuite) SetupTest() {
 	s.ent = enttest.Open(s.T(), dialect.SQLite,
 		fmt.Sprintf("file:%s-%d?mode=memory&cache=shared&_fk=1",
@@ -82,6 +85,7 @@ func (s *todoTestSuite) SetupTest() {
 
 	srv := handler.NewDefaultServer(gen.NewSchema(s.ent))
 	srv.Use(entgql.Transactioner{TxOpener: s.ent})
+	srv.Use(entgql.CursorCodecExtension{Codec: codec})
 	s.Client = client.New(srv)
 
 	const mutation = `mutation($priority: Int!, $text: String!, $parent: ID) {
@@ -799,3 +803,45 @@ func (s *todoTestSuite) TestQueryJSONFields() {
 	s.Require().Equal(cat.Text, rsp.Node.Text)
 	s.Require().Equal(cat.Strings, rsp.Node.Strings)
 }
+
+func (s *todoTestSuite) TestCursorCodec() {
+	const query = `query($after: Cursor) {
+		todos(first: 2, after: $after) {
+			edges {
+				node {
+					id
+				}
+				cursor
+			}
+		}
+	}`
+	var rsp response
+	s.Require().NoError(s.Post(query, &rsp))
+	s.Require().Len(rsp.Todos.Edges, 2)
+	first, second := rsp.Todos.Edges[0].Cursor, rsp.Todos.Edges[1].Cursor
+	s.Require().NoError(s.Post(query, &rsp, client.Var("after", first)))
+	s.Require().Equal(strconv.Itoa(idOffset+2), rsp.Todos.Edges[0].Node.ID)
+
+	// Cursors are signed by the codec of the server, and the
+	// cursors that were tampered with are rejected.
+	payload, _, _ := strings.Cut(first, ".")
+	_, sig, _ := strings.Cut(second, ".")
+	b, err := entgql.Base64Codec{}.Encode([]byte("cursor"))
+	s.Require().NoError(err)
+	for _, cursor := range []string{payload + "." + sig, payload, b} {
+		err := s.Post(query, &rsp, client.Var("after", cursor))
+		s.Require().ErrorContains(err, `"message":"Invalid cursor"`)
+		s.Require().ErrorContains(err, `"code":"INVALID_CURSOR"`)
+	}
+
+	// The pagination fails closed if the server is missing a codec.
+	gqlc := client.New(handler.NewDefaultServer(gen.NewSchema(s.ent)))
+	err = gqlc.Post(query, &rsp)
+	s.Require().ErrorContains(err, entgql.ErrCursorCodecMissing.Error())
+	limit := 2
+	_, err = s.ent.Todo.Query().Paginate(context.Background(), nil, &limit, nil, nil)
+	s.Require().ErrorIs(err, entgql.ErrCursorCodecMissing)
+	conn, err := s.ent.Todo.Query().Paginate(entgql.NewCursorCodecContext(context.Background(), codec), nil, &limit, nil, nil)
+	s.Require().NoError(err)
+	s.Require().Len(conn.Edges, 2)
+}
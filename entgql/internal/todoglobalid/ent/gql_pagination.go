@@ -48,7 +48,7 @@ type (
 )
 
 func orderFunc(o OrderDirection, field string) func(*sql.Selector) {
-	if o == entgql.OrderDirectionDesc {
+	if o.Desc() {
 		return Desc(field)
 	}
 	return Asc(field)
@@ -108,6 +108,7 @@ const (
 	nodeField       = "node"
 	pageInfoField   = "pageInfo"
 	totalCountField = "totalCount"
+	aggregateField  = "aggregate"
 )
 
 func paginateLimit(first, last *int) int {
@@ -238,7 +239,11 @@ func (p *billproductPager) applyCursors(query *BillProductQuery, after, before *
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultBillProductOrder.Field.column, p.order.Field.column, direction) {
+	cursorsPredicate := entgql.CursorsPredicate[int]
+	if p.order.Field.nullable {
+		cursorsPredicate = entgql.NullableCursorsPredicate[int]
+	}
+	for _, predicate := range cursorsPredicate(after, before, DefaultBillProductOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
@@ -249,7 +254,7 @@ func (p *billproductPager) applyOrder(query *BillProductQuery) *BillProductQuery
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	query = query.Order(entgql.OrderByTerm(direction, p.order.Field.column, p.order.Field.toTerm))
 	if p.order.Field != DefaultBillProductOrder.Field {
 		query = query.Order(DefaultBillProductOrder.Field.toTerm(direction.OrderTermOption()))
 	}
@@ -268,9 +273,9 @@ func (p *billproductPager) orderExpr(query *BillProductQuery) sql.Querier {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		b.Join(direction.OrderExpr(p.order.Field.column))
 		if p.order.Field != DefaultBillProductOrder.Field {
-			b.Comma().Ident(DefaultBillProductOrder.Field.column).Pad().WriteString(string(direction))
+			b.Comma().Join(direction.OrderExpr(DefaultBillProductOrder.Field.column))
 		}
 	})
 }
@@ -333,6 +338,7 @@ type BillProductOrderField struct {
 	// Value extracts the ordering value from the given BillProduct.
 	Value    func(*BillProduct) (ent.Value, error)
 	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
 	toTerm   func(...sql.OrderTermOption) billproduct.OrderOption
 	toCursor func(*BillProduct) Cursor
 }
@@ -489,6 +495,7 @@ func (p *categoryPager) applyCursors(query *CategoryQuery, after, before *Cursor
 		idDirection = entgql.OrderDirectionDesc
 	}
 	fields, directions := make([]string, 0, len(p.order)), make([]OrderDirection, 0, len(p.order))
+	nullable := make([]bool, 0, len(p.order))
 	for _, o := range p.order {
 		fields = append(fields, o.Field.column)
 		direction := o.Direction
@@ -496,12 +503,14 @@ func (p *categoryPager) applyCursors(query *CategoryQuery, after, before *Cursor
 			direction = direction.Reverse()
 		}
 		directions = append(directions, direction)
+		nullable = append(nullable, o.Field.nullable)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
 		FieldID:     DefaultCategoryOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
+		Nullable:    nullable,
 	})
 	if err != nil {
 		return nil, err
@@ -519,7 +528,7 @@ func (p *categoryPager) applyOrder(query *CategoryQuery) *CategoryQuery {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(entgql.OrderByTerm(direction, o.Field.column, o.Field.toTerm))
 		if o.Field.column == DefaultCategoryOrder.Field.column {
 			defaultOrdered = true
 		}
@@ -549,7 +558,7 @@ func (p *categoryPager) orderExpr(query *CategoryQuery) sql.Querier {
 			if p.reverse {
 				direction = direction.Reverse()
 			}
-			query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
+			query = query.Order(entgql.OrderByTerm(direction, o.Field.column, o.Field.toTerm))
 		default:
 			if len(query.ctx.Fields) > 0 {
 				query.ctx.AppendFieldOnce(o.Field.column)
@@ -562,14 +571,13 @@ func (p *categoryPager) orderExpr(query *CategoryQuery) sql.Querier {
 			if p.reverse {
 				direction = direction.Reverse()
 			}
-			b.Ident(o.Field.column).Pad().WriteString(string(direction))
-			b.Comma()
+			b.Join(direction.OrderExpr(o.Field.column)).Comma()
 		}
 		direction := entgql.OrderDirectionAsc
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultCategoryOrder.Field.column).Pad().WriteString(string(direction))
+		b.Join(direction.OrderExpr(DefaultCategoryOrder.Field.column))
 	})
 }
 
@@ -773,6 +781,7 @@ type CategoryOrderField struct {
 	// Value extracts the ordering value from the given Category.
 	Value    func(*Category) (ent.Value, error)
 	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
 	toTerm   func(...sql.OrderTermOption) category.OrderOption
 	toCursor func(*Category) Cursor
 }
@@ -927,7 +936,11 @@ func (p *friendshipPager) applyCursors(query *FriendshipQuery, after, before *Cu
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultFriendshipOrder.Field.column, p.order.Field.column, direction) {
+	cursorsPredicate := entgql.CursorsPredicate[int]
+	if p.order.Field.nullable {
+		cursorsPredicate = entgql.NullableCursorsPredicate[int]
+	}
+	for _, predicate := range cursorsPredicate(after, before, DefaultFriendshipOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
@@ -938,7 +951,7 @@ func (p *friendshipPager) applyOrder(query *FriendshipQuery) *FriendshipQuery {
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	query = query.Order(entgql.OrderByTerm(direction, p.order.Field.column, p.order.Field.toTerm))
 	if p.order.Field != DefaultFriendshipOrder.Field {
 		query = query.Order(DefaultFriendshipOrder.Field.toTerm(direction.OrderTermOption()))
 	}
@@ -957,9 +970,9 @@ func (p *friendshipPager) orderExpr(query *FriendshipQuery) sql.Querier {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		b.Join(direction.OrderExpr(p.order.Field.column))
 		if p.order.Field != DefaultFriendshipOrder.Field {
-			b.Comma().Ident(DefaultFriendshipOrder.Field.column).Pad().WriteString(string(direction))
+			b.Comma().Join(direction.OrderExpr(DefaultFriendshipOrder.Field.column))
 		}
 	})
 }
@@ -1022,6 +1035,7 @@ type FriendshipOrderField struct {
 	// Value extracts the ordering value from the given Friendship.
 	Value    func(*Friendship) (ent.Value, error)
 	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
 	toTerm   func(...sql.OrderTermOption) friendship.OrderOption
 	toCursor func(*Friendship) Cursor
 }
@@ -1178,6 +1192,7 @@ func (p *groupPager) applyCursors(query *GroupQuery, after, before *Cursor) (*Gr
 		idDirection = entgql.OrderDirectionDesc
 	}
 	fields, directions := make([]string, 0, len(p.order)), make([]OrderDirection, 0, len(p.order))
+	nullable := make([]bool, 0, len(p.order))
 	for _, o := range p.order {
 		fields = append(fields, o.Field.column)
 		direction := o.Direction
@@ -1185,12 +1200,14 @@ func (p *groupPager) applyCursors(query *GroupQuery, after, before *Cursor) (*Gr
 			direction = direction.Reverse()
 		}
 		directions = append(directions, direction)
+		nullable = append(nullable, o.Field.nullable)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
 		FieldID:     DefaultGroupOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
+		Nullable:    nullable,
 	})
 	if err != nil {
 		return nil, err
@@ -1208,7 +1225,7 @@ func (p *groupPager) applyOrder(query *GroupQuery) *GroupQuery {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(entgql.OrderByTerm(direction, o.Field.column, o.Field.toTerm))
 		if o.Field.column == DefaultGroupOrder.Field.column {
 			defaultOrdered = true
 		}
@@ -1238,14 +1255,13 @@ func (p *groupPager) orderExpr(query *GroupQuery) sql.Querier {
 			if p.reverse {
 				direction = direction.Reverse()
 			}
-			b.Ident(o.Field.column).Pad().WriteString(string(direction))
-			b.Comma()
+			b.Join(direction.OrderExpr(o.Field.column)).Comma()
 		}
 		direction := entgql.OrderDirectionAsc
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultGroupOrder.Field.column).Pad().WriteString(string(direction))
+		b.Join(direction.OrderExpr(DefaultGroupOrder.Field.column))
 	})
 }
 
@@ -1307,6 +1323,7 @@ type GroupOrderField struct {
 	// Value extracts the ordering value from the given Group.
 	Value    func(*Group) (ent.Value, error)
 	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
 	toTerm   func(...sql.OrderTermOption) group.OrderOption
 	toCursor func(*Group) Cursor
 }
@@ -1461,7 +1478,11 @@ func (p *onetomanyPager) applyCursors(query *OneToManyQuery, after, before *Curs
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultOneToManyOrder.Field.column, p.order.Field.column, direction) {
+	cursorsPredicate := entgql.CursorsPredicate[int]
+	if p.order.Field.nullable {
+		cursorsPredicate = entgql.NullableCursorsPredicate[int]
+	}
+	for _, predicate := range cursorsPredicate(after, before, DefaultOneToManyOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
@@ -1472,7 +1493,7 @@ func (p *onetomanyPager) applyOrder(query *OneToManyQuery) *OneToManyQuery {
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	query = query.Order(entgql.OrderByTerm(direction, p.order.Field.column, p.order.Field.toTerm))
 	if p.order.Field != DefaultOneToManyOrder.Field {
 		query = query.Order(DefaultOneToManyOrder.Field.toTerm(direction.OrderTermOption()))
 	}
@@ -1491,9 +1512,9 @@ func (p *onetomanyPager) orderExpr(query *OneToManyQuery) sql.Querier {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		b.Join(direction.OrderExpr(p.order.Field.column))
 		if p.order.Field != DefaultOneToManyOrder.Field {
-			b.Comma().Ident(DefaultOneToManyOrder.Field.column).Pad().WriteString(string(direction))
+			b.Comma().Join(direction.OrderExpr(DefaultOneToManyOrder.Field.column))
 		}
 	})
 }
@@ -1603,6 +1624,7 @@ type OneToManyOrderField struct {
 	// Value extracts the ordering value from the given OneToMany.
 	Value    func(*OneToMany) (ent.Value, error)
 	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
 	toTerm   func(...sql.OrderTermOption) onetomany.OrderOption
 	toCursor func(*OneToMany) Cursor
 }
@@ -1757,7 +1779,11 @@ func (p *projectPager) applyCursors(query *ProjectQuery, after, before *Cursor)
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultProjectOrder.Field.column, p.order.Field.column, direction) {
+	cursorsPredicate := entgql.CursorsPredicate[int]
+	if p.order.Field.nullable {
+		cursorsPredicate = entgql.NullableCursorsPredicate[int]
+	}
+	for _, predicate := range cursorsPredicate(after, before, DefaultProjectOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
@@ -1768,7 +1794,7 @@ func (p *projectPager) applyOrder(query *ProjectQuery) *ProjectQuery {
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	query = query.Order(entgql.OrderByTerm(direction, p.order.Field.column, p.order.Field.toTerm))
 	if p.order.Field != DefaultProjectOrder.Field {
 		query = query.Order(DefaultProjectOrder.Field.toTerm(direction.OrderTermOption()))
 	}
@@ -1787,9 +1813,9 @@ func (p *projectPager) orderExpr(query *ProjectQuery) sql.Querier {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		b.Join(direction.OrderExpr(p.order.Field.column))
 		if p.order.Field != DefaultProjectOrder.Field {
-			b.Comma().Ident(DefaultProjectOrder.Field.column).Pad().WriteString(string(direction))
+			b.Comma().Join(direction.OrderExpr(DefaultProjectOrder.Field.column))
 		}
 	})
 }
@@ -1852,6 +1878,7 @@ type ProjectOrderField struct {
 	// Value extracts the ordering value from the given Project.
 	Value    func(*Project) (ent.Value, error)
 	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
 	toTerm   func(...sql.OrderTermOption) project.OrderOption
 	toCursor func(*Project) Cursor
 }
@@ -2008,6 +2035,7 @@ func (p *todoPager) applyCursors(query *TodoQuery, after, before *Cursor) (*Todo
 		idDirection = entgql.OrderDirectionDesc
 	}
 	fields, directions := make([]string, 0, len(p.order)), make([]OrderDirection, 0, len(p.order))
+	nullable := make([]bool, 0, len(p.order))
 	for _, o := range p.order {
 		fields = append(fields, o.Field.column)
 		direction := o.Direction
@@ -2015,12 +2043,14 @@ func (p *todoPager) applyCursors(query *TodoQuery, after, before *Cursor) (*Todo
 			direction = direction.Reverse()
 		}
 		directions = append(directions, direction)
+		nullable = append(nullable, o.Field.nullable)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
 		FieldID:     DefaultTodoOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
+		Nullable:    nullable,
 	})
 	if err != nil {
 		return nil, err
@@ -2038,7 +2068,7 @@ func (p *todoPager) applyOrder(query *TodoQuery) *TodoQuery {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(entgql.OrderByTerm(direction, o.Field.column, o.Field.toTerm))
 		if o.Field.column == DefaultTodoOrder.Field.column {
 			defaultOrdered = true
 		}
@@ -2068,7 +2098,7 @@ func (p *todoPager) orderExpr(query *TodoQuery) sql.Querier {
 			if p.reverse {
 				direction = direction.Reverse()
 			}
-			query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
+			query = query.Order(entgql.OrderByTerm(direction, o.Field.column, o.Field.toTerm))
 		default:
 			if len(query.ctx.Fields) > 0 {
 				query.ctx.AppendFieldOnce(o.Field.column)
@@ -2081,14 +2111,13 @@ func (p *todoPager) orderExpr(query *TodoQuery) sql.Querier {
 			if p.reverse {
 				direction = direction.Reverse()
 			}
-			b.Ident(o.Field.column).Pad().WriteString(string(direction))
-			b.Comma()
+			b.Join(direction.OrderExpr(o.Field.column)).Comma()
 		}
 		direction := entgql.OrderDirectionAsc
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultTodoOrder.Field.column).Pad().WriteString(string(direction))
+		b.Join(direction.OrderExpr(DefaultTodoOrder.Field.column))
 	})
 }
 
@@ -2207,7 +2236,8 @@ var (
 		Value: func(t *Todo) (ent.Value, error) {
 			return t.GetValue("parent_status")
 		},
-		column: "parent_status",
+		column:   "parent_status",
+		nullable: true,
 		toTerm: func(opts ...sql.OrderTermOption) todo.OrderOption {
 			return todo.ByParentField(
 				todo.FieldStatus,
@@ -2246,7 +2276,8 @@ var (
 		Value: func(t *Todo) (ent.Value, error) {
 			return t.GetValue("category_text")
 		},
-		column: "category_text",
+		column:   "category_text",
+		nullable: true,
 		toTerm: func(opts ...sql.OrderTermOption) todo.OrderOption {
 			return todo.ByCategoryField(
 				category.FieldText,
@@ -2322,6 +2353,7 @@ type TodoOrderField struct {
 	// Value extracts the ordering value from the given Todo.
 	Value    func(*Todo) (ent.Value, error)
 	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
 	toTerm   func(...sql.OrderTermOption) todo.OrderOption
 	toCursor func(*Todo) Cursor
 }
@@ -2476,7 +2508,11 @@ func (p *userPager) applyCursors(query *UserQuery, after, before *Cursor) (*User
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultUserOrder.Field.column, p.order.Field.column, direction) {
+	cursorsPredicate := entgql.CursorsPredicate[int]
+	if p.order.Field.nullable {
+		cursorsPredicate = entgql.NullableCursorsPredicate[int]
+	}
+	for _, predicate := range cursorsPredicate(after, before, DefaultUserOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
@@ -2487,7 +2523,7 @@ func (p *userPager) applyOrder(query *UserQuery) *UserQuery {
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	query = query.Order(entgql.OrderByTerm(direction, p.order.Field.column, p.order.Field.toTerm))
 	if p.order.Field != DefaultUserOrder.Field {
 		query = query.Order(DefaultUserOrder.Field.toTerm(direction.OrderTermOption()))
 	}
@@ -2508,16 +2544,16 @@ func (p *userPager) orderExpr(query *UserQuery) sql.Querier {
 	}
 	switch p.order.Field.column {
 	case UserOrderFieldGroupsCount.column:
-		query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(entgql.OrderByTerm(direction, p.order.Field.column, p.order.Field.toTerm))
 	default:
 		if len(query.ctx.Fields) > 0 {
 			query.ctx.AppendFieldOnce(p.order.Field.column)
 		}
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		b.Join(direction.OrderExpr(p.order.Field.column))
 		if p.order.Field != DefaultUserOrder.Field {
-			b.Comma().Ident(DefaultUserOrder.Field.column).Pad().WriteString(string(direction))
+			b.Comma().Join(direction.OrderExpr(DefaultUserOrder.Field.column))
 		}
 	})
 }
@@ -2632,6 +2668,7 @@ type UserOrderField struct {
 	// Value extracts the ordering value from the given User.
 	Value    func(*User) (ent.Value, error)
 	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
 	toTerm   func(...sql.OrderTermOption) user.OrderOption
 	toCursor func(*User) Cursor
 }
@@ -2789,7 +2826,11 @@ func (p *organizationPager) applyCursors(query *WorkspaceQuery, after, before *C
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultOrganizationOrder.Field.column, p.order.Field.column, direction) {
+	cursorsPredicate := entgql.CursorsPredicate[int]
+	if p.order.Field.nullable {
+		cursorsPredicate = entgql.NullableCursorsPredicate[int]
+	}
+	for _, predicate := range cursorsPredicate(after, before, DefaultOrganizationOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
@@ -2800,7 +2841,7 @@ func (p *organizationPager) applyOrder(query *WorkspaceQuery) *WorkspaceQuery {
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	query = query.Order(entgql.OrderByTerm(direction, p.order.Field.column, p.order.Field.toTerm))
 	if p.order.Field != DefaultOrganizationOrder.Field {
 		query = query.Order(DefaultOrganizationOrder.Field.toTerm(direction.OrderTermOption()))
 	}
@@ -2819,9 +2860,9 @@ func (p *organizationPager) orderExpr(query *WorkspaceQuery) sql.Querier {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		b.Join(direction.OrderExpr(p.order.Field.column))
 		if p.order.Field != DefaultOrganizationOrder.Field {
-			b.Comma().Ident(DefaultOrganizationOrder.Field.column).Pad().WriteString(string(direction))
+			b.Comma().Join(direction.OrderExpr(DefaultOrganizationOrder.Field.column))
 		}
 	})
 }
@@ -2884,6 +2925,7 @@ type OrganizationOrderField struct {
 	// Value extracts the ordering value from the given Workspace.
 	Value    func(*Organization) (ent.Value, error)
 	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
 	toTerm   func(...sql.OrderTermOption) workspace.OrderOption
 	toCursor func(*Organization) Cursor
 }
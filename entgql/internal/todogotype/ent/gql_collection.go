@@ -1002,6 +1002,37 @@ func newTodoPaginateArgs(rv map[string]any) *todoPaginateArgs {
 	return args
 }
 
+// collectAggregate returns the aggregation functions of the fields selected under the
+// given aggregate field. Aggregations that were not selected are not computed.
+func (t *TodoQuery) collectAggregate(opCtx *graphql.OperationContext, collected graphql.CollectedField) []AggregateFunc {
+	var (
+		fns  []AggregateFunc
+		seen = make(map[string]struct{})
+		add  = func(name, column string) {
+			if _, ok := seen[name+column]; !ok {
+				seen[name+column] = struct{}{}
+				fns = append(fns, aggregateFunc(name, column))
+			}
+		}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, nil) {
+		switch field.Name {
+		case "count":
+			add(field.Name, "")
+		case "sum", "avg", "min", "max":
+			for _, f := range graphql.CollectFields(opCtx, field.Selections, nil) {
+				switch f.Name {
+				case "priority":
+					add(field.Name, todo.FieldPriority)
+				case "value":
+					add(field.Name, todo.FieldValue)
+				}
+			}
+		}
+	}
+	return fns
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (u *UserQuery) CollectFields(ctx context.Context, satisfies ...string) (*UserQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -1210,6 +1241,25 @@ func newUserPaginateArgs(rv map[string]any) *userPaginateArgs {
 	return args
 }
 
+// aggregateFunc returns the aggregation function with the given
+// name applied on the column, and named after both of them.
+func aggregateFunc(name, column string) AggregateFunc {
+	var fn AggregateFunc
+	switch name {
+	case "count":
+		return As(Count(), "agg_count")
+	case "sum":
+		fn = Sum(column)
+	case "avg":
+		fn = Mean(column)
+	case "min":
+		fn = Min(column)
+	case "max":
+		fn = Max(column)
+	}
+	return As(fn, "agg_"+name+"_"+column)
+}
+
 const (
 	afterField     = "after"
 	firstField     = "first"
@@ -46,7 +46,7 @@ type (
 )
 
 func orderFunc(o OrderDirection, field string) func(*sql.Selector) {
-	if o == entgql.OrderDirectionDesc {
+	if o.Desc() {
 		return Desc(field)
 	}
 	return Asc(field)
@@ -106,6 +106,7 @@ const (
 	nodeField       = "node"
 	pageInfoField   = "pageInfo"
 	totalCountField = "totalCount"
+	aggregateField  = "aggregate"
 )
 
 func paginateLimit(first, last *int) int {
@@ -236,7 +237,11 @@ func (p *billproductPager) applyCursors(query *BillProductQuery, after, before *
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultBillProductOrder.Field.column, p.order.Field.column, direction) {
+	cursorsPredicate := entgql.CursorsPredicate[string]
+	if p.order.Field.nullable {
+		cursorsPredicate = entgql.NullableCursorsPredicate[string]
+	}
+	for _, predicate := range cursorsPredicate(after, before, DefaultBillProductOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
@@ -247,7 +252,7 @@ func (p *billproductPager) applyOrder(query *BillProductQuery) *BillProductQuery
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	query = query.Order(entgql.OrderByTerm(direction, p.order.Field.column, p.order.Field.toTerm))
 	if p.order.Field != DefaultBillProductOrder.Field {
 		query = query.Order(DefaultBillProductOrder.Field.toTerm(direction.OrderTermOption()))
 	}
@@ -266,9 +271,9 @@ func (p *billproductPager) orderExpr(query *BillProductQuery) sql.Querier {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		b.Join(direction.OrderExpr(p.order.Field.column))
 		if p.order.Field != DefaultBillProductOrder.Field {
-			b.Comma().Ident(DefaultBillProductOrder.Field.column).Pad().WriteString(string(direction))
+			b.Comma().Join(direction.OrderExpr(DefaultBillProductOrder.Field.column))
 		}
 	})
 }
@@ -331,6 +336,7 @@ type BillProductOrderField struct {
 	// Value extracts the ordering value from the given BillProduct.
 	Value    func(*BillProduct) (ent.Value, error)
 	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
 	toTerm   func(...sql.OrderTermOption) billproduct.OrderOption
 	toCursor func(*BillProduct) Cursor
 }
@@ -487,6 +493,7 @@ func (p *categoryPager) applyCursors(query *CategoryQuery, after, before *Cursor
 		idDirection = entgql.OrderDirectionDesc
 	}
 	fields, directions := make([]string, 0, len(p.order)), make([]OrderDirection, 0, len(p.order))
+	nullable := make([]bool, 0, len(p.order))
 	for _, o := range p.order {
 		fields = append(fields, o.Field.column)
 		direction := o.Direction
@@ -494,12 +501,14 @@ func (p *categoryPager) applyCursors(query *CategoryQuery, after, before *Cursor
 			direction = direction.Reverse()
 		}
 		directions = append(directions, direction)
+		nullable = append(nullable, o.Field.nullable)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
 		FieldID:     DefaultCategoryOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
+		Nullable:    nullable,
 	})
 	if err != nil {
 		return nil, err
@@ -517,7 +526,7 @@ func (p *categoryPager) applyOrder(query *CategoryQuery) *CategoryQuery {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(entgql.OrderByTerm(direction, o.Field.column, o.Field.toTerm))
 		if o.Field.column == DefaultCategoryOrder.Field.column {
 			defaultOrdered = true
 		}
@@ -547,7 +556,7 @@ func (p *categoryPager) orderExpr(query *CategoryQuery) sql.Querier {
 			if p.reverse {
 				direction = direction.Reverse()
 			}
-			query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
+			query = query.Order(entgql.OrderByTerm(direction, o.Field.column, o.Field.toTerm))
 		default:
 			if len(query.ctx.Fields) > 0 {
 				query.ctx.AppendFieldOnce(o.Field.column)
@@ -560,14 +569,13 @@ func (p *categoryPager) orderExpr(query *CategoryQuery) sql.Querier {
 			if p.reverse {
 				direction = direction.Reverse()
 			}
-			b.Ident(o.Field.column).Pad().WriteString(string(direction))
-			b.Comma()
+			b.Join(direction.OrderExpr(o.Field.column)).Comma()
 		}
 		direction := entgql.OrderDirectionAsc
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultCategoryOrder.Field.column).Pad().WriteString(string(direction))
+		b.Join(direction.OrderExpr(DefaultCategoryOrder.Field.column))
 	})
 }
 
@@ -753,6 +761,7 @@ type CategoryOrderField struct {
 	// Value extracts the ordering value from the given Category.
 	Value    func(*Category) (ent.Value, error)
 	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
 	toTerm   func(...sql.OrderTermOption) category.OrderOption
 	toCursor func(*Category) Cursor
 }
@@ -907,7 +916,11 @@ func (p *friendshipPager) applyCursors(query *FriendshipQuery, after, before *Cu
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultFriendshipOrder.Field.column, p.order.Field.column, direction) {
+	cursorsPredicate := entgql.CursorsPredicate[string]
+	if p.order.Field.nullable {
+		cursorsPredicate = entgql.NullableCursorsPredicate[string]
+	}
+	for _, predicate := range cursorsPredicate(after, before, DefaultFriendshipOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
@@ -918,7 +931,7 @@ func (p *friendshipPager) applyOrder(query *FriendshipQuery) *FriendshipQuery {
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	query = query.Order(entgql.OrderByTerm(direction, p.order.Field.column, p.order.Field.toTerm))
 	if p.order.Field != DefaultFriendshipOrder.Field {
 		query = query.Order(DefaultFriendshipOrder.Field.toTerm(direction.OrderTermOption()))
 	}
@@ -937,9 +950,9 @@ func (p *friendshipPager) orderExpr(query *FriendshipQuery) sql.Querier {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		b.Join(direction.OrderExpr(p.order.Field.column))
 		if p.order.Field != DefaultFriendshipOrder.Field {
-			b.Comma().Ident(DefaultFriendshipOrder.Field.column).Pad().WriteString(string(direction))
+			b.Comma().Join(direction.OrderExpr(DefaultFriendshipOrder.Field.column))
 		}
 	})
 }
@@ -1002,6 +1015,7 @@ type FriendshipOrderField struct {
 	// Value extracts the ordering value from the given Friendship.
 	Value    func(*Friendship) (ent.Value, error)
 	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
 	toTerm   func(...sql.OrderTermOption) friendship.OrderOption
 	toCursor func(*Friendship) Cursor
 }
@@ -1156,7 +1170,11 @@ func (p *groupPager) applyCursors(query *GroupQuery, after, before *Cursor) (*Gr
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultGroupOrder.Field.column, p.order.Field.column, direction) {
+	cursorsPredicate := entgql.CursorsPredicate[string]
+	if p.order.Field.nullable {
+		cursorsPredicate = entgql.NullableCursorsPredicate[string]
+	}
+	for _, predicate := range cursorsPredicate(after, before, DefaultGroupOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
@@ -1167,7 +1185,7 @@ func (p *groupPager) applyOrder(query *GroupQuery) *GroupQuery {
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	query = query.Order(entgql.OrderByTerm(direction, p.order.Field.column, p.order.Field.toTerm))
 	if p.order.Field != DefaultGroupOrder.Field {
 		query = query.Order(DefaultGroupOrder.Field.toTerm(direction.OrderTermOption()))
 	}
@@ -1186,9 +1204,9 @@ func (p *groupPager) orderExpr(query *GroupQuery) sql.Querier {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		b.Join(direction.OrderExpr(p.order.Field.column))
 		if p.order.Field != DefaultGroupOrder.Field {
-			b.Comma().Ident(DefaultGroupOrder.Field.column).Pad().WriteString(string(direction))
+			b.Comma().Join(direction.OrderExpr(DefaultGroupOrder.Field.column))
 		}
 	})
 }
@@ -1251,6 +1269,7 @@ type GroupOrderField struct {
 	// Value extracts the ordering value from the given Group.
 	Value    func(*Group) (ent.Value, error)
 	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
 	toTerm   func(...sql.OrderTermOption) group.OrderOption
 	toCursor func(*Group) Cursor
 }
@@ -1405,7 +1424,11 @@ func (p *petPager) applyCursors(query *PetQuery, after, before *Cursor) (*PetQue
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultPetOrder.Field.column, p.order.Field.column, direction) {
+	cursorsPredicate := entgql.CursorsPredicate[string]
+	if p.order.Field.nullable {
+		cursorsPredicate = entgql.NullableCursorsPredicate[string]
+	}
+	for _, predicate := range cursorsPredicate(after, before, DefaultPetOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
@@ -1416,7 +1439,7 @@ func (p *petPager) applyOrder(query *PetQuery) *PetQuery {
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	query = query.Order(entgql.OrderByTerm(direction, p.order.Field.column, p.order.Field.toTerm))
 	if p.order.Field != DefaultPetOrder.Field {
 		query = query.Order(DefaultPetOrder.Field.toTerm(direction.OrderTermOption()))
 	}
@@ -1435,9 +1458,9 @@ func (p *petPager) orderExpr(query *PetQuery) sql.Querier {
 		query.ctx.AppendFieldOnce(p.order.Field.column)
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		b.Join(direction.OrderExpr(p.order.Field.column))
 		if p.order.Field != DefaultPetOrder.Field {
-			b.Comma().Ident(DefaultPetOrder.Field.column).Pad().WriteString(string(direction))
+			b.Comma().Join(direction.OrderExpr(DefaultPetOrder.Field.column))
 		}
 	})
 }
@@ -1500,6 +1523,7 @@ type PetOrderField struct {
 	// Value extracts the ordering value from the given Pet.
 	Value    func(*Pet) (ent.Value, error)
 	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
 	toTerm   func(...sql.OrderTermOption) pet.OrderOption
 	toCursor func(*Pet) Cursor
 }
@@ -1544,9 +1568,201 @@ type TodoEdge struct {
 
 // TodoConnection is the connection containing edges to Todo.
 type TodoConnection struct {
-	Edges      []*TodoEdge `json:"edges"`
-	PageInfo   PageInfo    `json:"pageInfo"`
-	TotalCount int         `json:"totalCount"`
+	Edges      []*TodoEdge    `json:"edges"`
+	PageInfo   PageInfo       `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
+	Aggregate  *TodoAggregate `json:"aggregate"`
+}
+
+// TodoAggregate holds aggregations over Todo items.
+type TodoAggregate struct {
+	Count int                   `json:"count"`
+	Sum   *TodoAggregateNumbers `json:"sum"`
+	Avg   *TodoAggregateNumbers `json:"avg"`
+	Min   *TodoAggregateNumbers `json:"min"`
+	Max   *TodoAggregateNumbers `json:"max"`
+	// query is the filtered query that is used for grouping.
+	query *TodoQuery
+}
+
+// TodoAggregateNumbers holds the result of an aggregation
+// function applied on the numeric fields of Todo.
+type TodoAggregateNumbers struct {
+	Priority *float64 `json:"priority"`
+	Value    *float64 `json:"value"`
+}
+
+// TodoAggregateGroup holds aggregations over a group of Todo items.
+type TodoAggregateGroup struct {
+	Key   *TodoAggregateGroupKey `json:"key"`
+	Count int                    `json:"count"`
+	Sum   *TodoAggregateNumbers  `json:"sum"`
+	Avg   *TodoAggregateNumbers  `json:"avg"`
+	Min   *TodoAggregateNumbers  `json:"min"`
+	Max   *TodoAggregateNumbers  `json:"max"`
+}
+
+// TodoAggregateGroupKey holds the values of the fields a TodoAggregateGroup was
+// grouped by. Fields that were not grouped by are nil.
+type TodoAggregateGroupKey struct {
+	Status   *todo.Status `json:"status"`
+	Priority *int         `json:"priority"`
+	Text     *string      `json:"text"`
+	Value    *int         `json:"value"`
+}
+
+// TodoAggregateField defines the fields by which Todo aggregations can be grouped.
+type TodoAggregateField string
+
+// TodoAggregateField values.
+const (
+	TodoAggregateFieldStatus   TodoAggregateField = "STATUS"
+	TodoAggregateFieldPriority TodoAggregateField = "PRIORITY"
+	TodoAggregateFieldText     TodoAggregateField = "TEXT"
+	TodoAggregateFieldValue    TodoAggregateField = "VALUE"
+)
+
+// column returns the column of the field.
+func (f TodoAggregateField) column() string {
+	switch f {
+	case TodoAggregateFieldStatus:
+		return todo.FieldStatus
+	case TodoAggregateFieldPriority:
+		return todo.FieldPriority
+	case TodoAggregateFieldText:
+		return todo.FieldText
+	case TodoAggregateFieldValue:
+		return todo.FieldValue
+	default:
+		return ""
+	}
+}
+
+// String implement fmt.Stringer interface.
+func (f TodoAggregateField) String() string {
+	return string(f)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f TodoAggregateField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *TodoAggregateField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("TodoAggregateField %T must be a string", v)
+	}
+	if *f = TodoAggregateField(str); f.column() == "" {
+		return fmt.Errorf("%s is not a valid TodoAggregateField", str)
+	}
+	return nil
+}
+
+// GroupBy returns the aggregations over the groups of Todo
+// items that share the same values for the given fields.
+func (a *TodoAggregate) GroupBy(ctx context.Context, fields []TodoAggregateField) ([]*TodoAggregateGroup, error) {
+	if a.query == nil {
+		return nil, errors.New("ent: TodoAggregate was not loaded by a query")
+	}
+	if len(fields) == 0 {
+		return nil, errors.New("ent: TodoAggregate groupBy requires at least one field")
+	}
+	columns := make([]string, len(fields))
+	order := make([]todo.OrderOption, len(fields))
+	for i, f := range fields {
+		columns[i] = f.column()
+		order[i] = sql.OrderByField(columns[i]).ToFunc()
+	}
+	var fns []AggregateFunc
+	if fc := graphql.GetFieldContext(ctx); fc != nil {
+		fns = a.query.collectAggregate(graphql.GetOperationContext(ctx), fc.Field)
+	}
+	var rows []todoAggregateRow
+	if err := a.query.Clone().Order(order...).GroupBy(columns[0], columns[1:]...).Aggregate(fns...).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	groups := make([]*TodoAggregateGroup, len(rows))
+	for i, r := range rows {
+		v := r.aggregate()
+		groups[i] = &TodoAggregateGroup{
+			Key: &TodoAggregateGroupKey{
+				Status:   r.Status,
+				Priority: r.Priority,
+				Text:     r.Text,
+				Value:    r.Value,
+			},
+			Count: v.Count,
+			Sum:   v.Sum,
+			Avg:   v.Avg,
+			Min:   v.Min,
+			Max:   v.Max,
+		}
+	}
+	return groups, nil
+}
+
+// todoAggregateRow is a row returned by an aggregation query on Todo.
+type todoAggregateRow struct {
+	Status      *todo.Status `sql:"status"`
+	Priority    *int         `sql:"priority"`
+	Text        *string      `sql:"text"`
+	Value       *int         `sql:"value"`
+	Count       int          `sql:"agg_count"`
+	SumPriority *float64     `sql:"agg_sum_priority"`
+	SumValue    *float64     `sql:"agg_sum_value"`
+	AvgPriority *float64     `sql:"agg_avg_priority"`
+	AvgValue    *float64     `sql:"agg_avg_value"`
+	MinPriority *float64     `sql:"agg_min_priority"`
+	MinValue    *float64     `sql:"agg_min_value"`
+	MaxPriority *float64     `sql:"agg_max_priority"`
+	MaxValue    *float64     `sql:"agg_max_value"`
+}
+
+// aggregate returns the aggregations held by the row.
+func (r *todoAggregateRow) aggregate() *TodoAggregate {
+	return &TodoAggregate{
+		Count: r.Count,
+		Sum: &TodoAggregateNumbers{
+			Priority: r.SumPriority,
+			Value:    r.SumValue,
+		},
+		Avg: &TodoAggregateNumbers{
+			Priority: r.AvgPriority,
+			Value:    r.AvgValue,
+		},
+		Min: &TodoAggregateNumbers{
+			Priority: r.MinPriority,
+			Value:    r.MinValue,
+		},
+		Max: &TodoAggregateNumbers{
+			Priority: r.MaxPriority,
+			Value:    r.MaxValue,
+		},
+	}
+}
+
+// aggregate computes the aggregations selected by the given field
+// over the items matched by the query, regardless of its pagination.
+func (t *TodoQuery) aggregate(ctx context.Context, field graphql.CollectedField) (*TodoAggregate, error) {
+	query := t.Clone()
+	query.ctx.Fields = nil
+	query.order = nil
+	fns := query.collectAggregate(graphql.GetOperationContext(ctx), field)
+	if len(fns) == 0 {
+		return &TodoAggregate{query: query}, nil
+	}
+	var rows []todoAggregateRow
+	if err := query.Clone().Aggregate(fns...).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	if len(rows) != 1 {
+		return nil, fmt.Errorf("ent: unexpected number of aggregation rows: %d", len(rows))
+	}
+	v := rows[0].aggregate()
+	v.query = query
+	return v, nil
 }
 
 func (c *TodoConnection) build(nodes []*Todo, pager *todoPager, after *Cursor, first *int, before *Cursor, last *int) {
@@ -1637,7 +1853,20 @@ func newTodoPager(opts []TodoPaginateOption, reverse bool) (*todoPager, error) {
 
 func (p *todoPager) applyFilter(query *TodoQuery) (*TodoQuery, error) {
 	if p.filter != nil {
-		return p.filter(query)
+		var err error
+		if query, err = p.filter(query); err != nil {
+			return nil, err
+		}
+	}
+	var relevance bool
+	for _, o := range p.order {
+		relevance = relevance || o.Field.column == TodoOrderFieldRelevance.column
+	}
+	if relevance {
+		if query.searchRank == nil {
+			return nil, entgql.ErrSearchRequired()
+		}
+		query = query.Where(query.searchRank)
 	}
 	return query, nil
 }
@@ -1656,6 +1885,7 @@ func (p *todoPager) applyCursors(query *TodoQuery, after, before *Cursor) (*Todo
 		idDirection = entgql.OrderDirectionDesc
 	}
 	fields, directions := make([]string, 0, len(p.order)), make([]OrderDirection, 0, len(p.order))
+	nullable := make([]bool, 0, len(p.order))
 	for _, o := range p.order {
 		fields = append(fields, o.Field.column)
 		direction := o.Direction
@@ -1663,12 +1893,14 @@ func (p *todoPager) applyCursors(query *TodoQuery, after, before *Cursor) (*Todo
 			direction = direction.Reverse()
 		}
 		directions = append(directions, direction)
+		nullable = append(nullable, o.Field.nullable)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
 		FieldID:     DefaultTodoOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
+		Nullable:    nullable,
 	})
 	if err != nil {
 		return nil, err
@@ -1686,12 +1918,12 @@ func (p *todoPager) applyOrder(query *TodoQuery) *TodoQuery {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(entgql.OrderByTerm(direction, o.Field.column, o.Field.toTerm))
 		if o.Field.column == DefaultTodoOrder.Field.column {
 			defaultOrdered = true
 		}
 		switch o.Field.column {
-		case TodoOrderFieldParentStatus.column, TodoOrderFieldChildrenCount.column, TodoOrderFieldCategoryText.column:
+		case TodoOrderFieldParentStatus.column, TodoOrderFieldChildrenCount.column, TodoOrderFieldCategoryText.column, TodoOrderFieldRelevance.column:
 		default:
 			if len(query.ctx.Fields) > 0 {
 				query.ctx.AppendFieldOnce(o.Field.column)
@@ -1711,12 +1943,12 @@ func (p *todoPager) applyOrder(query *TodoQuery) *TodoQuery {
 func (p *todoPager) orderExpr(query *TodoQuery) sql.Querier {
 	for _, o := range p.order {
 		switch o.Field.column {
-		case TodoOrderFieldParentStatus.column, TodoOrderFieldChildrenCount.column, TodoOrderFieldCategoryText.column:
+		case TodoOrderFieldParentStatus.column, TodoOrderFieldChildrenCount.column, TodoOrderFieldCategoryText.column, TodoOrderFieldRelevance.column:
 			direction := o.Direction
 			if p.reverse {
 				direction = direction.Reverse()
 			}
-			query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
+			query = query.Order(entgql.OrderByTerm(direction, o.Field.column, o.Field.toTerm))
 		default:
 			if len(query.ctx.Fields) > 0 {
 				query.ctx.AppendFieldOnce(o.Field.column)
@@ -1729,14 +1961,13 @@ func (p *todoPager) orderExpr(query *TodoQuery) sql.Querier {
 			if p.reverse {
 				direction = direction.Reverse()
 			}
-			b.Ident(o.Field.column).Pad().WriteString(string(direction))
-			b.Comma()
+			b.Join(direction.OrderExpr(o.Field.column)).Comma()
 		}
 		direction := entgql.OrderDirectionAsc
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultTodoOrder.Field.column).Pad().WriteString(string(direction))
+		b.Join(direction.OrderExpr(DefaultTodoOrder.Field.column))
 	})
 }
 
@@ -1756,6 +1987,11 @@ func (t *TodoQuery) Paginate(
 		return nil, err
 	}
 	conn := &TodoConnection{Edges: []*TodoEdge{}}
+	if field := collectedField(ctx, aggregateField); field != nil {
+		if conn.Aggregate, err = t.aggregate(ctx, *field); err != nil {
+			return nil, err
+		}
+	}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -1855,7 +2091,8 @@ var (
 		Value: func(t *Todo) (ent.Value, error) {
 			return t.GetValue("parent_status")
 		},
-		column: "parent_status",
+		column:   "parent_status",
+		nullable: true,
 		toTerm: func(opts ...sql.OrderTermOption) todo.OrderOption {
 			return todo.ByParentField(
 				todo.FieldStatus,
@@ -1894,7 +2131,8 @@ var (
 		Value: func(t *Todo) (ent.Value, error) {
 			return t.GetValue("category_text")
 		},
-		column: "category_text",
+		column:   "category_text",
+		nullable: true,
 		toTerm: func(opts ...sql.OrderTermOption) todo.OrderOption {
 			return todo.ByCategoryField(
 				category.FieldText,
@@ -1909,6 +2147,24 @@ var (
 			}
 		},
 	}
+	// TodoOrderFieldRelevance orders Todo by the relevance of the search
+	// of its WhereInput, and requires the `search` field to be set.
+	TodoOrderFieldRelevance = &TodoOrderField{
+		Value: func(t *Todo) (ent.Value, error) {
+			return t.GetValue("search_rank")
+		},
+		column: "search_rank",
+		toTerm: func(opts ...sql.OrderTermOption) todo.OrderOption {
+			return entgql.OrderBySearchRank(opts...)
+		},
+		toCursor: func(t *Todo) Cursor {
+			cv, _ := t.GetValue("search_rank")
+			return Cursor{
+				ID:    t.ID,
+				Value: cv,
+			}
+		},
+	}
 )
 
 // String implement fmt.Stringer interface.
@@ -1929,6 +2185,8 @@ func (f TodoOrderField) String() string {
 		str = "CHILDREN_COUNT"
 	case TodoOrderFieldCategoryText.column:
 		str = "CATEGORY_TEXT"
+	case TodoOrderFieldRelevance.column:
+		str = "RELEVANCE"
 	}
 	return str
 }
@@ -1959,6 +2217,8 @@ func (f *TodoOrderField) UnmarshalGQL(v interface{}) error {
 		*f = *TodoOrderFieldChildrenCount
 	case "CATEGORY_TEXT":
 		*f = *TodoOrderFieldCategoryText
+	case "RELEVANCE":
+		*f = *TodoOrderFieldRelevance
 	default:
 		return fmt.Errorf("%s is not a valid TodoOrderField", str)
 	}
@@ -1970,6 +2230,7 @@ type TodoOrderField struct {
 	// Value extracts the ordering value from the given Todo.
 	Value    func(*Todo) (ent.Value, error)
 	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
 	toTerm   func(...sql.OrderTermOption) todo.OrderOption
 	toCursor func(*Todo) Cursor
 }
@@ -2124,7 +2385,11 @@ func (p *userPager) applyCursors(query *UserQuery, after, before *Cursor) (*User
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultUserOrder.Field.column, p.order.Field.column, direction) {
+	cursorsPredicate := entgql.CursorsPredicate[string]
+	if p.order.Field.nullable {
+		cursorsPredicate = entgql.NullableCursorsPredicate[string]
+	}
+	for _, predicate := range cursorsPredicate(after, before, DefaultUserOrder.Field.column, p.order.Field.column, direction) {
 		query = query.Where(predicate)
 	}
 	return query, nil
@@ -2135,7 +2400,7 @@ func (p *userPager) applyOrder(query *UserQuery) *UserQuery {
 	if p.reverse {
 		direction = direction.Reverse()
 	}
-	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	query = query.Order(entgql.OrderByTerm(direction, p.order.Field.column, p.order.Field.toTerm))
 	if p.order.Field != DefaultUserOrder.Field {
 		query = query.Order(DefaultUserOrder.Field.toTerm(direction.OrderTermOption()))
 	}
@@ -2156,16 +2421,16 @@ func (p *userPager) orderExpr(query *UserQuery) sql.Querier {
 	}
 	switch p.order.Field.column {
 	case UserOrderFieldGroupsCount.column:
-		query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(entgql.OrderByTerm(direction, p.order.Field.column, p.order.Field.toTerm))
 	default:
 		if len(query.ctx.Fields) > 0 {
 			query.ctx.AppendFieldOnce(p.order.Field.column)
 		}
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		b.Join(direction.OrderExpr(p.order.Field.column))
 		if p.order.Field != DefaultUserOrder.Field {
-			b.Comma().Ident(DefaultUserOrder.Field.column).Pad().WriteString(string(direction))
+			b.Comma().Join(direction.OrderExpr(DefaultUserOrder.Field.column))
 		}
 	})
 }
@@ -2280,6 +2545,7 @@ type UserOrderField struct {
 	// Value extracts the ordering value from the given User.
 	Value    func(*User) (ent.Value, error)
 	column   string // field or computed.
+	nullable bool   // column may hold NULL values.
 	toTerm   func(...sql.OrderTermOption) user.OrderOption
 	toCursor func(*User) Cursor
 }
@@ -17,10 +17,12 @@
 package ent
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todo/ent/schema/schematype"
 	"entgo.io/contrib/entgql/internal/todogotype/ent/billproduct"
 	"entgo.io/contrib/entgql/internal/todogotype/ent/category"
@@ -674,6 +676,33 @@ func (i *CategoryWhereInput) P() (predicate.Category, error) {
 	}
 }
 
+// bulkPredicates returns the predicates of the CategoryWhereInput for the bulk mutations.
+// A nil or an empty input matches all categories, and is rejected unless all is set.
+func (i *CategoryWhereInput) bulkPredicates(all bool) ([]predicate.Category, error) {
+	if i != nil {
+		switch p, err := i.P(); {
+		case err == nil:
+			return []predicate.Category{p}, nil
+		case err != ErrEmptyCategoryWhereInput:
+			return nil, err
+		}
+	}
+	if !all {
+		return nil, fmt.Errorf("%w: all must be set to mutate all categories", ErrEmptyCategoryWhereInput)
+	}
+	return nil, nil
+}
+
+// DeleteMany deletes the categories matching the CategoryWhereInput, and returns the number
+// of deleted categories. An empty input is rejected, unless all is set to delete all categories.
+func (c *CategoryClient) DeleteMany(ctx context.Context, where *CategoryWhereInput, all bool) (int, error) {
+	ps, err := where.bulkPredicates(all)
+	if err != nil {
+		return 0, err
+	}
+	return c.Delete().Where(ps...).Exec(ctx)
+}
+
 // FriendshipWhereInput represents a where input for filtering Friendship queries.
 type FriendshipWhereInput struct {
 	Predicates []predicate.Friendship  `json:"-"`
@@ -1385,6 +1414,9 @@ type TodoWhereInput struct {
 	Or         []*TodoWhereInput `json:"or,omitempty"`
 	And        []*TodoWhereInput `json:"and,omitempty"`
 
+	// Full-text search on the searchable fields.
+	Search *string `json:"search,omitempty"`
+
 	// "id" field predicates.
 	ID             *string  `json:"id,omitempty"`
 	IDNEQ          *string  `json:"idNEQ,omitempty"`
@@ -1465,6 +1497,12 @@ type TodoWhereInput struct {
 	CategoryIDEqualFold    *bigintgql.BigInt  `json:"categoryIDEqualFold,omitempty"`
 	CategoryIDContainsFold *bigintgql.BigInt  `json:"categoryIDContainsFold,omitempty"`
 
+	// "init" field predicates.
+	InitHasKey              *string `json:"initHasKey,omitempty"`
+	InitOwnerName           *string `json:"initOwnerName,omitempty"`
+	InitOwnerNameContains   *string `json:"initOwnerNameContains,omitempty"`
+	InitLabelsArrayContains *string `json:"initLabelsArrayContains,omitempty"`
+
 	// "parent" edge predicates.
 	HasParent     *bool             `json:"hasParent,omitempty"`
 	HasParentWith []*TodoWhereInput `json:"hasParentWith,omitempty"`
@@ -1495,6 +1533,9 @@ func (i *TodoWhereInput) Filter(q *TodoQuery) (*TodoQuery, error) {
 		}
 		return nil, err
 	}
+	if search, ok := entgql.SearchQuery(i.Search); ok {
+		q.searchRank = entgql.SearchRank(search, todo.FieldText)
+	}
 	return q.Where(p), nil
 }
 
@@ -1549,6 +1590,9 @@ func (i *TodoWhereInput) P() (predicate.Todo, error) {
 		predicates = append(predicates, todo.And(and...))
 	}
 	predicates = append(predicates, i.Predicates...)
+	if search, ok := entgql.SearchQuery(i.Search); ok {
+		predicates = append(predicates, predicate.Todo(entgql.SearchPredicate(search, todo.FieldText)))
+	}
 	if i.ID != nil {
 		predicates = append(predicates, todo.IDEQ(*i.ID))
 	}
@@ -1747,6 +1791,22 @@ func (i *TodoWhereInput) P() (predicate.Todo, error) {
 	if i.CategoryIDContainsFold != nil {
 		predicates = append(predicates, todo.CategoryIDContainsFold(*i.CategoryIDContainsFold))
 	}
+	if i.InitHasKey != nil {
+		p, err := entgql.JSONHasKey(todo.FieldInit, *i.InitHasKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'InitHasKey'", err)
+		}
+		predicates = append(predicates, predicate.Todo(p))
+	}
+	if i.InitOwnerName != nil {
+		predicates = append(predicates, predicate.Todo(entgql.JSONValueEQ(todo.FieldInit, "owner.name", *i.InitOwnerName)))
+	}
+	if i.InitOwnerNameContains != nil {
+		predicates = append(predicates, predicate.Todo(entgql.JSONContains(todo.FieldInit, "owner.name", *i.InitOwnerNameContains)))
+	}
+	if i.InitLabelsArrayContains != nil {
+		predicates = append(predicates, predicate.Todo(entgql.JSONArrayContains(todo.FieldInit, "labels", *i.InitLabelsArrayContains)))
+	}
 
 	if i.HasParent != nil {
 		p := todo.HasParent()
@@ -34,6 +34,21 @@ func (r *categoryResolver) Types(ctx context.Context, obj *ent.Category) (*Categ
 	panic(fmt.Errorf("not implemented"))
 }
 
+// CreateManyCategories is the resolver for the createManyCategories field.
+func (r *mutationResolver) CreateManyCategories(ctx context.Context, input []*ent.CreateCategoryInput) ([]*ent.Category, error) {
+	return ent.FromContext(ctx).Category.CreateMany(ctx, input)
+}
+
+// UpdateManyCategories is the resolver for the updateManyCategories field.
+func (r *mutationResolver) UpdateManyCategories(ctx context.Context, where ent.CategoryWhereInput, all bool, input ent.UpdateCategoryInput) (int, error) {
+	return ent.FromContext(ctx).Category.UpdateMany(ctx, &where, input, all)
+}
+
+// DeleteManyCategories is the resolver for the deleteManyCategories field.
+func (r *mutationResolver) DeleteManyCategories(ctx context.Context, where ent.CategoryWhereInput, all bool) (int, error) {
+	return ent.FromContext(ctx).Category.DeleteMany(ctx, &where, all)
+}
+
 // ID is the resolver for the id field.
 func (r *organizationResolver) ID(ctx context.Context, obj *ent1.Workspace) (uuid.UUID, error) {
 	panic(fmt.Errorf("not implemented"))
@@ -89,11 +104,156 @@ func (r *queryResolver) Users(ctx context.Context, after *entgql.Cursor[uuid.UUI
 		)
 }
 
+// BillProductCreated is the resolver for the billProductCreated field.
+func (r *subscriptionResolver) BillProductCreated(ctx context.Context, where *ent.BillProductWhereInput) (<-chan *ent.BillProduct, error) {
+	panic(fmt.Errorf("not implemented: BillProductCreated - billProductCreated"))
+}
+
+// BillProductUpdated is the resolver for the billProductUpdated field.
+func (r *subscriptionResolver) BillProductUpdated(ctx context.Context, where *ent.BillProductWhereInput) (<-chan *ent.BillProduct, error) {
+	panic(fmt.Errorf("not implemented: BillProductUpdated - billProductUpdated"))
+}
+
+// BillProductDeleted is the resolver for the billProductDeleted field.
+func (r *subscriptionResolver) BillProductDeleted(ctx context.Context) (<-chan uuid.UUID, error) {
+	panic(fmt.Errorf("not implemented: BillProductDeleted - billProductDeleted"))
+}
+
+// CategoryCreated is the resolver for the categoryCreated field.
+func (r *subscriptionResolver) CategoryCreated(ctx context.Context, where *ent.CategoryWhereInput) (<-chan *ent.Category, error) {
+	panic(fmt.Errorf("not implemented: CategoryCreated - categoryCreated"))
+}
+
+// CategoryUpdated is the resolver for the categoryUpdated field.
+func (r *subscriptionResolver) CategoryUpdated(ctx context.Context, where *ent.CategoryWhereInput) (<-chan *ent.Category, error) {
+	panic(fmt.Errorf("not implemented: CategoryUpdated - categoryUpdated"))
+}
+
+// CategoryDeleted is the resolver for the categoryDeleted field.
+func (r *subscriptionResolver) CategoryDeleted(ctx context.Context) (<-chan uuid.UUID, error) {
+	panic(fmt.Errorf("not implemented: CategoryDeleted - categoryDeleted"))
+}
+
+// FriendshipCreated is the resolver for the friendshipCreated field.
+func (r *subscriptionResolver) FriendshipCreated(ctx context.Context, where *ent.FriendshipWhereInput) (<-chan *ent.Friendship, error) {
+	panic(fmt.Errorf("not implemented: FriendshipCreated - friendshipCreated"))
+}
+
+// FriendshipUpdated is the resolver for the friendshipUpdated field.
+func (r *subscriptionResolver) FriendshipUpdated(ctx context.Context, where *ent.FriendshipWhereInput) (<-chan *ent.Friendship, error) {
+	panic(fmt.Errorf("not implemented: FriendshipUpdated - friendshipUpdated"))
+}
+
+// FriendshipDeleted is the resolver for the friendshipDeleted field.
+func (r *subscriptionResolver) FriendshipDeleted(ctx context.Context) (<-chan uuid.UUID, error) {
+	panic(fmt.Errorf("not implemented: FriendshipDeleted - friendshipDeleted"))
+}
+
+// GroupCreated is the resolver for the groupCreated field.
+func (r *subscriptionResolver) GroupCreated(ctx context.Context, where *ent.GroupWhereInput) (<-chan *ent.Group, error) {
+	panic(fmt.Errorf("not implemented: GroupCreated - groupCreated"))
+}
+
+// GroupUpdated is the resolver for the groupUpdated field.
+func (r *subscriptionResolver) GroupUpdated(ctx context.Context, where *ent.GroupWhereInput) (<-chan *ent.Group, error) {
+	panic(fmt.Errorf("not implemented: GroupUpdated - groupUpdated"))
+}
+
+// GroupDeleted is the resolver for the groupDeleted field.
+func (r *subscriptionResolver) GroupDeleted(ctx context.Context) (<-chan uuid.UUID, error) {
+	panic(fmt.Errorf("not implemented: GroupDeleted - groupDeleted"))
+}
+
+// OneToManyCreated is the resolver for the oneToManyCreated field.
+func (r *subscriptionResolver) OneToManyCreated(ctx context.Context, where *OneToManyWhereInput) (<-chan *OneToMany, error) {
+	panic(fmt.Errorf("not implemented: OneToManyCreated - oneToManyCreated"))
+}
+
+// OneToManyUpdated is the resolver for the oneToManyUpdated field.
+func (r *subscriptionResolver) OneToManyUpdated(ctx context.Context, where *OneToManyWhereInput) (<-chan *OneToMany, error) {
+	panic(fmt.Errorf("not implemented: OneToManyUpdated - oneToManyUpdated"))
+}
+
+// OneToManyDeleted is the resolver for the oneToManyDeleted field.
+func (r *subscriptionResolver) OneToManyDeleted(ctx context.Context) (<-chan uuid.UUID, error) {
+	panic(fmt.Errorf("not implemented: OneToManyDeleted - oneToManyDeleted"))
+}
+
+// ProjectCreated is the resolver for the projectCreated field.
+func (r *subscriptionResolver) ProjectCreated(ctx context.Context, where *ProjectWhereInput) (<-chan *Project, error) {
+	panic(fmt.Errorf("not implemented: ProjectCreated - projectCreated"))
+}
+
+// ProjectUpdated is the resolver for the projectUpdated field.
+func (r *subscriptionResolver) ProjectUpdated(ctx context.Context, where *ProjectWhereInput) (<-chan *Project, error) {
+	panic(fmt.Errorf("not implemented: ProjectUpdated - projectUpdated"))
+}
+
+// ProjectDeleted is the resolver for the projectDeleted field.
+func (r *subscriptionResolver) ProjectDeleted(ctx context.Context) (<-chan uuid.UUID, error) {
+	panic(fmt.Errorf("not implemented: ProjectDeleted - projectDeleted"))
+}
+
+// TodoCreated is the resolver for the todoCreated field.
+func (r *subscriptionResolver) TodoCreated(ctx context.Context, where *ent.TodoWhereInput) (<-chan *ent.Todo, error) {
+	panic(fmt.Errorf("not implemented: TodoCreated - todoCreated"))
+}
+
+// TodoUpdated is the resolver for the todoUpdated field.
+func (r *subscriptionResolver) TodoUpdated(ctx context.Context, where *ent.TodoWhereInput) (<-chan *ent.Todo, error) {
+	panic(fmt.Errorf("not implemented: TodoUpdated - todoUpdated"))
+}
+
+// TodoDeleted is the resolver for the todoDeleted field.
+func (r *subscriptionResolver) TodoDeleted(ctx context.Context) (<-chan uuid.UUID, error) {
+	panic(fmt.Errorf("not implemented: TodoDeleted - todoDeleted"))
+}
+
+// UserCreated is the resolver for the userCreated field.
+func (r *subscriptionResolver) UserCreated(ctx context.Context, where *ent.UserWhereInput) (<-chan *ent.User, error) {
+	panic(fmt.Errorf("not implemented: UserCreated - userCreated"))
+}
+
+// UserUpdated is the resolver for the userUpdated field.
+func (r *subscriptionResolver) UserUpdated(ctx context.Context, where *ent.UserWhereInput) (<-chan *ent.User, error) {
+	panic(fmt.Errorf("not implemented: UserUpdated - userUpdated"))
+}
+
+// UserDeleted is the resolver for the userDeleted field.
+func (r *subscriptionResolver) UserDeleted(ctx context.Context) (<-chan uuid.UUID, error) {
+	panic(fmt.Errorf("not implemented: UserDeleted - userDeleted"))
+}
+
+// OrganizationCreated is the resolver for the organizationCreated field.
+func (r *subscriptionResolver) OrganizationCreated(ctx context.Context, where *OrganizationWhereInput) (<-chan *ent1.Workspace, error) {
+	panic(fmt.Errorf("not implemented: OrganizationCreated - organizationCreated"))
+}
+
+// OrganizationUpdated is the resolver for the organizationUpdated field.
+func (r *subscriptionResolver) OrganizationUpdated(ctx context.Context, where *OrganizationWhereInput) (<-chan *ent1.Workspace, error) {
+	panic(fmt.Errorf("not implemented: OrganizationUpdated - organizationUpdated"))
+}
+
+// OrganizationDeleted is the resolver for the organizationDeleted field.
+func (r *subscriptionResolver) OrganizationDeleted(ctx context.Context) (<-chan uuid.UUID, error) {
+	panic(fmt.Errorf("not implemented: OrganizationDeleted - organizationDeleted"))
+}
+
 // Status is the resolver for the status field.
 func (r *todoResolver) Status(ctx context.Context, obj *ent.Todo) (todo.Status, error) {
 	panic(fmt.Errorf("not implemented"))
 }
 
+// Status is the resolver for the status field.
+func (r *todoAggregateGroupKeyResolver) Status(ctx context.Context, obj *ent.TodoAggregateGroupKey) (*todo.Status, error) {
+	panic(fmt.Errorf("not implemented: Status - status"))
+}
+
+// CategoryID is the resolver for the categoryID field.
+func (r *todoAggregateGroupKeyResolver) CategoryID(ctx context.Context, obj *ent.TodoAggregateGroupKey) (*uuid.UUID, error) {
+	panic(fmt.Errorf("not implemented: CategoryID - categoryID"))
+}
+
 // Username is the resolver for the username field.
 func (r *userResolver) Username(ctx context.Context, obj *ent.User) (string, error) {
 	panic(fmt.Errorf("not implemented"))
@@ -208,9 +368,17 @@ func (r *Resolver) Organization() OrganizationResolver { return &organizationRes
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
 // Todo returns TodoResolver implementation.
 func (r *Resolver) Todo() TodoResolver { return &todoResolver{r} }
 
+// TodoAggregateGroupKey returns TodoAggregateGroupKeyResolver implementation.
+func (r *Resolver) TodoAggregateGroupKey() TodoAggregateGroupKeyResolver {
+	return &todoAggregateGroupKeyResolver{r}
+}
+
 // User returns UserResolver implementation.
 func (r *Resolver) User() UserResolver { return &userResolver{r} }
 
@@ -245,7 +413,9 @@ func (r *Resolver) UserWhereInput() UserWhereInputResolver { return &userWhereIn
 type categoryResolver struct{ *Resolver }
 type organizationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
 type todoResolver struct{ *Resolver }
+type todoAggregateGroupKeyResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
 type createCategoryInputResolver struct{ *Resolver }
 type createTodoInputResolver struct{ *Resolver }
@@ -37,6 +37,15 @@ func (c *Category) Todos(
 			return nil, err
 		}
 		conn := &TodoConnection{Edges: []*TodoEdge{}, TotalCount: totalCount}
+		if field := collectedField(ctx, aggregateField); field != nil {
+			query, err := pager.applyFilter(c.QueryTodos())
+			if err != nil {
+				return nil, err
+			}
+			if conn.Aggregate, err = query.aggregate(ctx, *field); err != nil {
+				return nil, err
+			}
+		}
 		conn.build(nodes, pager, after, first, before, last)
 		return conn, nil
 	}
@@ -124,6 +133,15 @@ func (t *Todo) Children(
 			return nil, err
 		}
 		conn := &TodoConnection{Edges: []*TodoEdge{}, TotalCount: totalCount}
+		if field := collectedField(ctx, aggregateField); field != nil {
+			query, err := pager.applyFilter(t.QueryChildren())
+			if err != nil {
+				return nil, err
+			}
+			if conn.Aggregate, err = query.aggregate(ctx, *field); err != nil {
+				return nil, err
+			}
+		}
 		conn.build(nodes, pager, after, first, before, last)
 		return conn, nil
 	}
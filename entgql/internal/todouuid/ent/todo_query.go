@@ -48,6 +48,7 @@ type TodoQuery struct {
 	modifiers         []func(*sql.Selector)
 	loadTotal         []func(context.Context, []*Todo) error
 	withNamedChildren map[string]*TodoQuery
+	searchRank        predicate.Todo
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
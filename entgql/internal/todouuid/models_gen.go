@@ -172,6 +172,9 @@ type ProjectWhereInput struct {
 	HasTodosWith []*ent.TodoWhereInput `json:"hasTodosWith,omitempty"`
 }
 
+type Subscription struct {
+}
+
 // UpdateFriendshipInput is used for update Friendship object.
 // Input was generated by ent.
 type UpdateFriendshipInput struct {
@@ -0,0 +1,62 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"fmt"
+	"regexp"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+)
+
+// jsonPathRegexp matches the paths supported by the JSON predicates.
+var jsonPathRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+
+// JSONHasKey returns a predicate checking if the JSON column has a value in the
+// given path. Unlike the other JSON predicates, the path is usually provided by
+// the client, and an error is returned if it is not a valid dot-notation path.
+func JSONHasKey(column, path string) (func(*sql.Selector), error) {
+	if !jsonPathRegexp.MatchString(path) {
+		return nil, fmt.Errorf("entgql: invalid JSON path %q", path)
+	}
+	return func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(column), sqljson.DotPath(path)))
+	}, nil
+}
+
+// JSONValueEQ returns a predicate checking if the value
+// in the given path of the JSON column equals to v.
+func JSONValueEQ(column, path string, v any) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(column), v, sqljson.DotPath(path)))
+	}
+}
+
+// JSONContains returns a predicate checking if the string
+// in the given path of the JSON column contains substr.
+func JSONContains(column, path, substr string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(column), substr, sqljson.DotPath(path)))
+	}
+}
+
+// JSONArrayContains returns a predicate checking if the
+// array in the given path of the JSON column contains v.
+func JSONArrayContains(column, path string, v any) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(column), v, sqljson.DotPath(path)))
+	}
+}
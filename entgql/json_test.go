@@ -0,0 +1,79 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONHasKey(t *testing.T) {
+	for _, path := range []string{"", "a..b", "a.b'", "$.a", "a[0]"} {
+		_, err := entgql.JSONHasKey("metadata", path)
+		require.Errorf(t, err, "path %q", path)
+	}
+	p, err := entgql.JSONHasKey("metadata", "author.name")
+	require.NoError(t, err)
+	s := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("todos"))
+	p(s)
+	expected := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("todos"))
+	expected.Where(sqljson.HasKey(expected.C("metadata"), sqljson.DotPath("author.name")))
+	query, args := s.Query()
+	expectedQuery, expectedArgs := expected.Query()
+	require.Equal(t, expectedQuery, query)
+	require.Equal(t, expectedArgs, args)
+}
+
+func TestJSONPredicates(t *testing.T) {
+	for _, tt := range []struct {
+		pred     func(*sql.Selector)
+		expected func(*sql.Selector) *sql.Predicate
+	}{
+		{
+			pred: entgql.JSONValueEQ("metadata", "author.name", "a8m"),
+			expected: func(s *sql.Selector) *sql.Predicate {
+				return sqljson.ValueEQ(s.C("metadata"), "a8m", sqljson.DotPath("author.name"))
+			},
+		},
+		{
+			pred: entgql.JSONContains("metadata", "author.name", "a8"),
+			expected: func(s *sql.Selector) *sql.Predicate {
+				return sqljson.StringContains(s.C("metadata"), "a8", sqljson.DotPath("author.name"))
+			},
+		},
+		{
+			pred: entgql.JSONArrayContains("metadata", "tags", "ent"),
+			expected: func(s *sql.Selector) *sql.Predicate {
+				return sqljson.ValueContains(s.C("metadata"), "ent", sqljson.DotPath("tags"))
+			},
+		},
+	} {
+		for _, d := range []string{dialect.Postgres, dialect.MySQL, dialect.SQLite} {
+			s := sql.Dialect(d).Select("*").From(sql.Table("todos"))
+			tt.pred(s)
+			expected := sql.Dialect(d).Select("*").From(sql.Table("todos"))
+			expected.Where(tt.expected(expected))
+			query, args := s.Query()
+			expectedQuery, expectedArgs := expected.Query()
+			require.Equal(t, expectedQuery, query)
+			require.Equal(t, expectedArgs, args)
+		}
+	}
+}
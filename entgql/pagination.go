@@ -17,7 +17,7 @@
 package entgql
 
 import (
-	"encoding/base64"
+	"context"
 	"fmt"
 	"io"
 	"strconv"
@@ -26,6 +26,7 @@ import (
 	"golang.org/x/exp/slices"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"github.com/vmihailenco/msgpack/v5"
 )
@@ -36,16 +37,27 @@ type OrderDirection string
 const (
 	// OrderDirectionAsc specifies an ascending order.
 	OrderDirectionAsc OrderDirection = "ASC"
+	// OrderDirectionAscNullsFirst specifies an ascending order with NULL values first.
+	OrderDirectionAscNullsFirst OrderDirection = "ASC_NULLS_FIRST"
+	// OrderDirectionAscNullsLast specifies an ascending order with NULL values last.
+	OrderDirectionAscNullsLast OrderDirection = "ASC_NULLS_LAST"
 	// OrderDirectionDesc specifies a descending order.
 	OrderDirectionDesc OrderDirection = "DESC"
+	// OrderDirectionDescNullsFirst specifies a descending order with NULL values first.
+	OrderDirectionDescNullsFirst OrderDirection = "DESC_NULLS_FIRST"
+	// OrderDirectionDescNullsLast specifies a descending order with NULL values last.
+	OrderDirectionDescNullsLast OrderDirection = "DESC_NULLS_LAST"
 )
 
 // Validate the order direction value.
 func (o OrderDirection) Validate() error {
-	if o != OrderDirectionAsc && o != OrderDirectionDesc {
+	switch o {
+	case OrderDirectionAsc, OrderDirectionAscNullsFirst, OrderDirectionAscNullsLast,
+		OrderDirectionDesc, OrderDirectionDescNullsFirst, OrderDirectionDescNullsLast:
+		return nil
+	default:
 		return fmt.Errorf("%s is not a valid OrderDirection", o)
 	}
-	return nil
 }
 
 // String implements fmt.Stringer interface.
@@ -53,12 +65,100 @@ func (o OrderDirection) String() string {
 	return string(o)
 }
 
+// Desc reports if the direction is descending.
+func (o OrderDirection) Desc() bool {
+	return o == OrderDirectionDesc || o == OrderDirectionDescNullsFirst || o == OrderDirectionDescNullsLast
+}
+
+// nulls returns the placement of NULL values defined by the
+// direction, and false if it uses the default of the dialect.
+func (o OrderDirection) nulls() (first bool, ok bool) {
+	switch o {
+	case OrderDirectionAscNullsFirst, OrderDirectionDescNullsFirst:
+		return true, true
+	case OrderDirectionAscNullsLast, OrderDirectionDescNullsLast:
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// NullsFirst reports if NULL values are ordered first by the direction in the
+// given dialect. ASC and DESC follow the default of the dialect: NULL values are
+// the smallest values in MySQL and SQLite, and the largest ones in PostgreSQL.
+func (o OrderDirection) NullsFirst(name string) bool {
+	if first, ok := o.nulls(); ok {
+		return first
+	}
+	return o.Desc() == (name == dialect.Postgres)
+}
+
 // OrderTermOption returns the OrderTermOption for setting the order direction.
+// The placement of NULL values is set by OrderByTerm.
 func (o OrderDirection) OrderTermOption() sql.OrderTermOption {
-	if o == OrderDirectionAsc {
-		return sql.OrderAsc()
+	if o.Desc() {
+		return sql.OrderDesc()
+	}
+	return sql.OrderAsc()
+}
+
+// OrderExpr returns the ORDER BY expression of the column in the direction.
+// MySQL does not support the NULLS FIRST and NULLS LAST modifiers, and they
+// are emulated there by ordering by the nullness of the column first.
+func (o OrderDirection) OrderExpr(column string) sql.Querier {
+	return sql.ExprFunc(func(b *sql.Builder) {
+		first, ok := o.nulls()
+		if ok && b.Dialect() == dialect.MySQL {
+			b.Ident(column).WriteString(" IS NULL")
+			if first {
+				b.WriteString(" DESC")
+			}
+			b.Comma()
+		}
+		b.Ident(column).Pad()
+		if o.Desc() {
+			b.WriteString("DESC")
+		} else {
+			b.WriteString("ASC")
+		}
+		switch {
+		case ok && b.Dialect() == dialect.MySQL:
+		case ok && first:
+			b.WriteString(" NULLS FIRST")
+		case ok:
+			b.WriteString(" NULLS LAST")
+		}
+	})
+}
+
+// OrderByTerm returns the ordering by the term built by toTerm in the direction o.
+// The column is the field, or the selected alias, the term orders by. Like in
+// OrderExpr, the placement of NULL values is emulated on MySQL.
+func OrderByTerm[F ~func(*sql.Selector)](o OrderDirection, column string, toTerm func(...sql.OrderTermOption) F) F {
+	first, ok := o.nulls()
+	if !ok {
+		return toTerm(o.OrderTermOption())
+	}
+	return func(s *sql.Selector) {
+		if s.Dialect() != dialect.MySQL {
+			nulls := sql.OrderNullsLast()
+			if first {
+				nulls = sql.OrderNullsFirst()
+			}
+			toTerm(o.OrderTermOption(), nulls)(s)
+			return
+		}
+		s.OrderExprFunc(func(b *sql.Builder) {
+			// The expression is built on query generation time, after
+			// the term appended its joined column to the selection.
+			column, _ := selectedColumn(s, column)
+			b.WriteString(column).WriteString(" IS NULL")
+			if first {
+				b.WriteString(" DESC")
+			}
+		})
+		toTerm(o.OrderTermOption())(s)
 	}
-	return sql.OrderDesc()
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
@@ -76,12 +176,23 @@ func (o *OrderDirection) UnmarshalGQL(val interface{}) error {
 	return o.Validate()
 }
 
-// Reverse the direction.
+// Reverse the direction. The placement of NULL values is reversed
+// as well, so that a reversed ordering yields the rows backwards.
 func (o OrderDirection) Reverse() OrderDirection {
-	if o == OrderDirectionDesc {
+	switch o {
+	case OrderDirectionDesc:
 		return OrderDirectionAsc
+	case OrderDirectionAscNullsFirst:
+		return OrderDirectionDescNullsLast
+	case OrderDirectionAscNullsLast:
+		return OrderDirectionDescNullsFirst
+	case OrderDirectionDescNullsFirst:
+		return OrderDirectionAscNullsLast
+	case OrderDirectionDescNullsLast:
+		return OrderDirectionAscNullsFirst
+	default:
+		return OrderDirectionDesc
 	}
-	return OrderDirectionDesc
 }
 
 // PageInfo of a connection type.
@@ -100,27 +211,43 @@ type Cursor[T any] struct {
 
 // MarshalGQL implements graphql.Marshaler interface.
 func (c Cursor[T]) MarshalGQL(w io.Writer) {
-	quote := []byte{'"'}
-	w.Write(quote)
-	defer w.Write(quote)
-	wc := base64.NewEncoder(base64.RawStdEncoding, w)
-	defer wc.Close()
-	_ = msgpack.NewEncoder(wc).Encode(c)
+	_ = c.MarshalGQLContext(context.Background(), w)
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
 func (c *Cursor[T]) UnmarshalGQL(v interface{}) error {
+	return c.UnmarshalGQLContext(context.Background(), v)
+}
+
+// MarshalGQLContext implements graphql.ContextMarshaler interface.
+// The cursor is encoded with the CursorCodec stored in the context.
+func (c Cursor[T]) MarshalGQLContext(ctx context.Context, w io.Writer) error {
+	b, err := msgpack.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("cannot encode cursor: %w", err)
+	}
+	s, err := CursorCodecFromContext(ctx).Encode(b)
+	if err != nil {
+		return fmt.Errorf("cannot encode cursor: %w", err)
+	}
+	_, err = io.WriteString(w, strconv.Quote(s))
+	return err
+}
+
+// UnmarshalGQLContext implements graphql.ContextUnmarshaler interface.
+// The cursor is decoded with the CursorCodec stored in the context, and
+// cursors that fail to decode are rejected with ErrInvalidCursor.
+func (c *Cursor[T]) UnmarshalGQLContext(ctx context.Context, v interface{}) error {
 	s, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("%T is not a string", v)
 	}
-	if err := msgpack.NewDecoder(
-		base64.NewDecoder(
-			base64.RawStdEncoding,
-			strings.NewReader(s),
-		),
-	).Decode(c); err != nil {
-		return fmt.Errorf("cannot decode cursor: %w", err)
+	b, err := CursorCodecFromContext(ctx).Decode(s)
+	if err != nil {
+		return ErrInvalidCursor(err)
+	}
+	if err := msgpack.Unmarshal(b, c); err != nil {
+		return ErrInvalidCursor(fmt.Errorf("cannot decode cursor: %w", err))
 	}
 	return nil
 }
@@ -130,40 +257,63 @@ func CursorsPredicate[T any](
 	after, before *Cursor[T],
 	idField, field string,
 	direction OrderDirection,
+) []func(s *sql.Selector) {
+	return cursorsPredicate(after, before, idField, field, direction, false)
+}
+
+// NullableCursorsPredicate converts the given cursors to predicates, for a field
+// that may hold NULL values. A nil cursor value is a NULL value of the field, and
+// NULL values are matched according to their placement in the direction.
+func NullableCursorsPredicate[T any](
+	after, before *Cursor[T],
+	idField, field string,
+	direction OrderDirection,
+) []func(s *sql.Selector) {
+	return cursorsPredicate(after, before, idField, field, direction, true)
+}
+
+func cursorsPredicate[T any](
+	after, before *Cursor[T],
+	idField, field string,
+	direction OrderDirection,
+	nullable bool,
 ) []func(s *sql.Selector) {
 	var predicates []func(s *sql.Selector)
 	for _, cursor := range []*Cursor[T]{after, before} {
 		if cursor == nil {
 			continue
 		}
-		if cursor.Value != nil {
-			var predicate func([]string, ...interface{}) *sql.Predicate
-			if direction == OrderDirectionAsc {
-				predicate = sql.CompositeGT
-			} else {
+		// Scope the cursor of the current iteration
+		// because it will be used in the closure.
+		cursor := cursor
+		switch {
+		case nullable && field != idField:
+			predicates = append(predicates, func(s *sql.Selector) {
+				s.Where(sql.P(func(b *sql.Builder) {
+					// The predicate function is executed on query generation time.
+					column, joined := selectedColumn(s, field)
+					b.Join(keysetPredicate(s.Dialect(), []cursorTerm{
+						{column: column, value: cursor.Value, direction: direction, nullable: true, joined: joined},
+						{column: s.C(idField), value: cursor.ID, direction: direction},
+					}))
+				}))
+			})
+		case cursor.Value != nil:
+			predicate := sql.CompositeGT
+			if direction.Desc() {
 				predicate = sql.CompositeLT
 			}
-			// Scope the cursor of the current iteration
-			// because it will be used in the closure.
-			cursor := cursor
 			predicates = append(predicates, func(s *sql.Selector) {
 				s.Where(sql.P(func(b *sql.Builder) {
 					// The predicate function is executed on query generation time.
-					column := s.C(field)
-					// If there is a non-ambiguis match, we use it. That is because
-					// some order terms may append joined information to query selection.
-					if matches := s.FindSelection(field); len(matches) == 1 {
-						column = matches[0]
-					}
+					column, _ := selectedColumn(s, field)
 					b.Join(predicate([]string{column, s.C(idField)}, cursor.Value, cursor.ID))
 				}))
 			})
-		} else {
-			if direction == OrderDirectionAsc {
-				predicates = append(predicates, sql.FieldGT(idField, cursor.ID))
-			} else {
-				predicates = append(predicates, sql.FieldLT(idField, cursor.ID))
-			}
+		case direction.Desc():
+			predicates = append(predicates, sql.FieldLT(idField, cursor.ID))
+		default:
+			predicates = append(predicates, sql.FieldGT(idField, cursor.ID))
 		}
 	}
 	return predicates
@@ -175,6 +325,7 @@ type MultiCursorsOptions struct {
 	DirectionID OrderDirection   // ID field direction.
 	Fields      []string         // OrderBy fields used by the cursor.
 	Directions  []OrderDirection // OrderBy directions used by the cursor.
+	Nullable    []bool           // OrderBy fields that may hold NULL values.
 }
 
 // MultiCursorsPredicate returns a predicate that filters records by the given cursors.
@@ -194,10 +345,10 @@ func MultiCursorsPredicate[T any](
 			}
 			predicates = append(predicates, predicate)
 		} else {
-			if opts.DirectionID == OrderDirectionAsc {
-				predicates = append(predicates, sql.FieldGT(opts.FieldID, cursor.ID))
-			} else {
+			if opts.DirectionID.Desc() {
 				predicates = append(predicates, sql.FieldLT(opts.FieldID, cursor.ID))
+			} else {
+				predicates = append(predicates, sql.FieldGT(opts.FieldID, cursor.ID))
 			}
 		}
 	}
@@ -234,31 +385,85 @@ func multiPredicate[T any](
 		opts.Directions = append(opts.Directions, opts.DirectionID)
 	}
 	return func(s *sql.Selector) {
-		// Given the following terms: x DESC, y ASC, etc. The following predicate will be
-		// generated: (x < x1 OR (x = x1 AND y > y1) OR (x = x1 AND y = y1 AND id > last)).
-		var or []*sql.Predicate
-		for i := range opts.Fields {
-			var ands []*sql.Predicate
-			for j := 0; j < i; j++ {
-				field := s.C(opts.Fields[j])
-				if strings.HasPrefix(opts.Fields[j], "edgefield_") {
-					field = opts.Fields[j]
-				}
-				ands = append(ands, sql.EQ(field, values[j]))
-			}
-			field := s.C(opts.Fields[i])
-			if strings.HasPrefix(opts.Fields[i], "edgefield_") {
-				field = opts.Fields[i]
+		s.Where(sql.P(func(b *sql.Builder) {
+			terms := make([]cursorTerm, len(opts.Fields))
+			for i, f := range opts.Fields {
+				terms[i] = cursorTerm{value: values[i], direction: opts.Directions[i], nullable: i < len(opts.Nullable) && opts.Nullable[i]}
+				terms[i].column, terms[i].joined = selectedColumn(s, f)
 			}
-			if opts.Directions[i] == OrderDirectionAsc {
-				ands = append(ands, sql.GT(field, values[i]))
+			b.Join(keysetPredicate(s.Dialect(), terms))
+		}))
+	}, nil
+}
+
+// cursorTerm is an ordered column of a cursor, and its value in the cursor.
+type cursorTerm struct {
+	column    string
+	value     any
+	direction OrderDirection
+	nullable  bool
+	// joined reports if the column was selected from a joined table by an edge
+	// order term. ent orders NULL values of these columns first on ASC and last
+	// on DESC on all dialects, unless their placement is set explicitly.
+	joined bool
+}
+
+// keysetPredicate returns the predicate matching the rows ordered after the given
+// terms. Given the following terms: x DESC, y ASC, etc. The following predicate
+// will be generated: (x < x1 OR (x = x1 AND y > y1) OR (x = x1 AND y = y1 AND id > last)).
+// Nil values are matched by IS NULL, and NULL values of nullable terms are ordered
+// before or after the other values of the column according to their direction.
+func keysetPredicate(name string, terms []cursorTerm) *sql.Predicate {
+	var or []*sql.Predicate
+	for i, t := range terms {
+		var ands []*sql.Predicate
+		for _, prev := range terms[:i] {
+			if prev.value == nil {
+				ands = append(ands, sql.IsNull(prev.column))
 			} else {
-				ands = append(ands, sql.LT(field, values[i]))
+				ands = append(ands, sql.EQ(prev.column, prev.value))
 			}
-			or = append(or, sql.And(ands...))
 		}
-		s.Where(sql.Or(or...))
-	}, nil
+		nullsFirst := t.direction.NullsFirst(name)
+		if _, ok := t.direction.nulls(); !ok && t.joined {
+			nullsFirst = !t.direction.Desc()
+		}
+		switch {
+		case t.value == nil && nullsFirst:
+			ands = append(ands, sql.NotNull(t.column))
+		case t.value == nil:
+			// No value is ordered after NULL values ordered last.
+			continue
+		case t.direction.Desc():
+			ands = append(ands, sql.LT(t.column, t.value))
+		default:
+			ands = append(ands, sql.GT(t.column, t.value))
+		}
+		// NULL values are not matched by the comparison above.
+		if t.value != nil && t.nullable && !nullsFirst {
+			ands[len(ands)-1] = sql.Or(ands[len(ands)-1], sql.IsNull(t.column))
+		}
+		or = append(or, sql.And(ands...))
+	}
+	if len(or) == 0 {
+		return sql.False()
+	}
+	return sql.Or(or...)
+}
+
+// selectedColumn returns the column of the field in the selector, and reports
+// if it was selected from a joined table.
+func selectedColumn(s *sql.Selector, field string) (string, bool) {
+	column := s.C(field)
+	// If there is a non-ambiguis match, we use it. That is because
+	// some order terms may append joined information to query selection.
+	if matches := s.FindSelection(field); len(matches) == 1 {
+		return matches[0], matches[0] != column
+	}
+	if strings.HasPrefix(field, "edgefield_") {
+		return field, true
+	}
+	return column, false
 }
 
 // LimitPerRow returns a query modifier that limits the number of (edges) rows returned
@@ -0,0 +1,286 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"context"
+	stdsql "database/sql"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderDirection(t *testing.T) {
+	for _, d := range []entgql.OrderDirection{
+		entgql.OrderDirectionAsc,
+		entgql.OrderDirectionDesc,
+		entgql.OrderDirectionAscNullsFirst,
+		entgql.OrderDirectionAscNullsLast,
+		entgql.OrderDirectionDescNullsFirst,
+		entgql.OrderDirectionDescNullsLast,
+	} {
+		require.NoError(t, d.Validate())
+		require.Equal(t, d, d.Reverse().Reverse())
+		require.NotEqual(t, d.Desc(), d.Reverse().Desc())
+		for _, name := range []string{dialect.SQLite, dialect.MySQL, dialect.Postgres} {
+			require.NotEqual(t, d.NullsFirst(name), d.Reverse().NullsFirst(name), "%s on %s", d, name)
+		}
+	}
+	require.Error(t, entgql.OrderDirection("NULLS_FIRST").Validate())
+
+	require.True(t, entgql.OrderDirectionAsc.NullsFirst(dialect.SQLite))
+	require.True(t, entgql.OrderDirectionAsc.NullsFirst(dialect.MySQL))
+	require.False(t, entgql.OrderDirectionAsc.NullsFirst(dialect.Postgres))
+	require.True(t, entgql.OrderDirectionDescNullsFirst.NullsFirst(dialect.SQLite))
+	require.False(t, entgql.OrderDirectionAscNullsLast.NullsFirst(dialect.MySQL))
+}
+
+func TestOrderByTerm(t *testing.T) {
+	tests := []struct {
+		dialect   string
+		direction entgql.OrderDirection
+		query     string
+	}{
+		{dialect.SQLite, entgql.OrderDirectionDesc, "SELECT * FROM `users` ORDER BY `users`.`name` DESC"},
+		{dialect.SQLite, entgql.OrderDirectionAscNullsLast, "SELECT * FROM `users` ORDER BY `users`.`name` NULLS LAST"},
+		{dialect.Postgres, entgql.OrderDirectionDescNullsFirst, `SELECT * FROM "users" ORDER BY "users"."name" DESC NULLS FIRST`},
+		{dialect.MySQL, entgql.OrderDirectionAscNullsLast, "SELECT * FROM `users` ORDER BY `users`.`name` IS NULL, `users`.`name`"},
+		{dialect.MySQL, entgql.OrderDirectionDescNullsFirst, "SELECT * FROM `users` ORDER BY `users`.`name` IS NULL DESC, `users`.`name` DESC"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect+"/"+tt.direction.String(), func(t *testing.T) {
+			s := sql.Dialect(tt.dialect).Select("*").From(sql.Table("users"))
+			entgql.OrderByTerm(tt.direction, "name", orderByName)(s)
+			query, _ := s.Query()
+			require.Equal(t, tt.query, query)
+		})
+	}
+}
+
+func TestOrderDirection_OrderExpr(t *testing.T) {
+	b := sql.Dialect(dialect.MySQL).Select("*").From(sql.Table("users"))
+	b.OrderExpr(entgql.OrderDirectionDescNullsLast.OrderExpr("name"), entgql.OrderDirectionAsc.OrderExpr("id"))
+	query, _ := b.Query()
+	require.Equal(t, "SELECT * FROM `users` ORDER BY `name` IS NULL, `name` DESC, `id` ASC", query)
+
+	b = sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("users"))
+	b.OrderExpr(entgql.OrderDirectionDescNullsLast.OrderExpr("name"))
+	query, _ = b.Query()
+	require.Equal(t, `SELECT * FROM "users" ORDER BY "name" DESC NULLS LAST`, query)
+}
+
+func TestCursorsPredicate(t *testing.T) {
+	name := "a8m"
+	tests := []struct {
+		name      string
+		dialect   string
+		direction entgql.OrderDirection
+		cursor    *entgql.Cursor[int]
+		query     string
+		args      []any
+	}{
+		{
+			name:      "SQLite/NullsFirst/Value",
+			dialect:   dialect.SQLite,
+			direction: entgql.OrderDirectionAsc,
+			cursor:    &entgql.Cursor[int]{ID: 1, Value: name},
+			query:     "SELECT * FROM `users` WHERE `users`.`name` > ? OR (`users`.`name` = ? AND `users`.`id` > ?)",
+			args:      []any{name, name, 1},
+		},
+		{
+			name:      "SQLite/NullsFirst/Null",
+			dialect:   dialect.SQLite,
+			direction: entgql.OrderDirectionAsc,
+			cursor:    &entgql.Cursor[int]{ID: 1},
+			query:     "SELECT * FROM `users` WHERE `users`.`name` IS NOT NULL OR (`users`.`name` IS NULL AND `users`.`id` > ?)",
+			args:      []any{1},
+		},
+		{
+			name:      "MySQL/NullsLast/Value",
+			dialect:   dialect.MySQL,
+			direction: entgql.OrderDirectionDesc,
+			cursor:    &entgql.Cursor[int]{ID: 1, Value: name},
+			query:     "SELECT * FROM `users` WHERE `users`.`name` < ? OR `users`.`name` IS NULL OR (`users`.`name` = ? AND `users`.`id` < ?)",
+			args:      []any{name, name, 1},
+		},
+		{
+			name:      "MySQL/NullsLast/Null",
+			dialect:   dialect.MySQL,
+			direction: entgql.OrderDirectionDesc,
+			cursor:    &entgql.Cursor[int]{ID: 1},
+			query:     "SELECT * FROM `users` WHERE `users`.`name` IS NULL AND `users`.`id` < ?",
+			args:      []any{1},
+		},
+		{
+			name:      "Postgres/NullsLast/Value",
+			dialect:   dialect.Postgres,
+			direction: entgql.OrderDirectionAsc,
+			cursor:    &entgql.Cursor[int]{ID: 1, Value: name},
+			query:     `SELECT * FROM "users" WHERE "users"."name" > $1 OR "users"."name" IS NULL OR ("users"."name" = $2 AND "users"."id" > $3)`,
+			args:      []any{name, name, 1},
+		},
+		{
+			name:      "Postgres/NullsFirst/Null",
+			dialect:   dialect.Postgres,
+			direction: entgql.OrderDirectionAscNullsFirst,
+			cursor:    &entgql.Cursor[int]{ID: 1},
+			query:     `SELECT * FROM "users" WHERE "users"."name" IS NOT NULL OR ("users"."name" IS NULL AND "users"."id" > $1)`,
+			args:      []any{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := sql.Dialect(tt.dialect).Select("*").From(sql.Table("users"))
+			for _, p := range entgql.NullableCursorsPredicate(tt.cursor, nil, "id", "name", tt.direction) {
+				p(s)
+			}
+			query, args := s.Query()
+			require.Equal(t, tt.query, query)
+			require.Equal(t, tt.args, args)
+		})
+	}
+}
+
+func TestCursorsPredicate_NotNull(t *testing.T) {
+	s := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("users"))
+	for _, p := range entgql.CursorsPredicate(&entgql.Cursor[int]{ID: 1, Value: "a8m"}, nil, "id", "name", entgql.OrderDirectionAsc) {
+		p(s)
+	}
+	query, args := s.Query()
+	require.Equal(t, `SELECT * FROM "users" WHERE ("users"."name", "users"."id") > ($1, $2)`, query)
+	require.Equal(t, []any{"a8m", 1}, args)
+}
+
+func TestMultiCursorsPredicate(t *testing.T) {
+	tests := []struct {
+		dialect string
+		query   string
+	}{
+		{
+			dialect: dialect.SQLite,
+			query:   "SELECT * FROM `users` WHERE `users`.`age` < ? OR `users`.`age` IS NULL OR (`users`.`age` = ? AND `users`.`name` IS NOT NULL) OR (`users`.`age` = ? AND `users`.`name` IS NULL AND `users`.`id` > ?)",
+		},
+		{
+			dialect: dialect.Postgres,
+			query:   `SELECT * FROM "users" WHERE "users"."age" < $1 OR ("users"."age" = $2 AND "users"."name" IS NULL AND "users"."id" > $3)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect, func(t *testing.T) {
+			predicates, err := entgql.MultiCursorsPredicate(&entgql.Cursor[int]{ID: 1, Value: []any{30, nil}}, nil, &entgql.MultiCursorsOptions{
+				FieldID:     "id",
+				DirectionID: entgql.OrderDirectionAsc,
+				Fields:      []string{"age", "name"},
+				Directions:  []entgql.OrderDirection{entgql.OrderDirectionDesc, entgql.OrderDirectionAsc},
+				Nullable:    []bool{true, true},
+			})
+			require.NoError(t, err)
+			s := sql.Dialect(tt.dialect).Select("*").From(sql.Table("users"))
+			for _, p := range predicates {
+				p(s)
+			}
+			query, _ := s.Query()
+			require.Equal(t, tt.query, query)
+		})
+	}
+}
+
+// TestCursorsPredicate_Paginate pages through a table with NULL values in
+// all directions, and checks that no rows are skipped or duplicated.
+func TestCursorsPredicate_Paginate(t *testing.T) {
+	ctx := context.Background()
+	db, err := stdsql.Open("sqlite3", "file:pagination?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	defer db.Close()
+	_, err = db.ExecContext(ctx, "CREATE TABLE `users` (`id` INTEGER PRIMARY KEY, `name` TEXT NULL)")
+	require.NoError(t, err)
+	names := []any{"b", nil, "a", nil, "c", "a", nil}
+	for i, n := range names {
+		_, err = db.ExecContext(ctx, "INSERT INTO `users` (`id`, `name`) VALUES (?, ?)", i+1, n)
+		require.NoError(t, err)
+	}
+	drv := sql.OpenDB(dialect.SQLite, db)
+	for _, direction := range []entgql.OrderDirection{
+		entgql.OrderDirectionAsc,
+		entgql.OrderDirectionDesc,
+		entgql.OrderDirectionAscNullsFirst,
+		entgql.OrderDirectionAscNullsLast,
+		entgql.OrderDirectionDescNullsFirst,
+		entgql.OrderDirectionDescNullsLast,
+	} {
+		t.Run(direction.String(), func(t *testing.T) {
+			page := func(after *entgql.Cursor[int], limit int) []entgql.Cursor[int] {
+				s := sql.Dialect(dialect.SQLite).Select("id", "name").From(sql.Table("users"))
+				for _, p := range entgql.NullableCursorsPredicate(after, nil, "id", "name", direction) {
+					p(s)
+				}
+				entgql.OrderByTerm(direction, "name", orderByName)(s)
+				sql.OrderByField("id", direction.OrderTermOption()).ToFunc()(s)
+				if limit > 0 {
+					s.Limit(limit)
+				}
+				query, args := s.Query()
+				var rows sql.Rows
+				require.NoError(t, drv.Query(ctx, query, args, &rows))
+				defer rows.Close()
+				var cursors []entgql.Cursor[int]
+				for rows.Next() {
+					var (
+						id   int
+						name stdsql.NullString
+					)
+					require.NoError(t, rows.Scan(&id, &name))
+					c := entgql.Cursor[int]{ID: id}
+					if name.Valid {
+						c.Value = name.String
+					}
+					cursors = append(cursors, c)
+				}
+				require.NoError(t, rows.Err())
+				return cursors
+			}
+			all := page(nil, 0)
+			require.Len(t, all, len(names))
+			nulls := all[:3]
+			if !direction.NullsFirst(dialect.SQLite) {
+				nulls = all[len(all)-3:]
+			}
+			for _, c := range nulls {
+				require.Nil(t, c.Value)
+			}
+			var (
+				paged []entgql.Cursor[int]
+				after *entgql.Cursor[int]
+			)
+			for {
+				p := page(after, 2)
+				if len(p) == 0 {
+					break
+				}
+				paged = append(paged, p...)
+				after = &p[len(p)-1]
+			}
+			require.Equal(t, all, paged)
+		})
+	}
+}
+
+func orderByName(opts ...sql.OrderTermOption) func(*sql.Selector) {
+	return sql.OrderByField("name", opts...).ToFunc()
+}
@@ -17,6 +17,7 @@ package entgql
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"path/filepath"
 	"reflect"
 	"strings"
@@ -32,6 +33,10 @@ import (
 const (
 	// QueryType is the name of the root Query object.
 	QueryType = "Query"
+	// MutationType is the name of the root Mutation object.
+	MutationType = "Mutation"
+	// SubscriptionType is the name of the root Subscription object.
+	SubscriptionType = "Subscription"
 	// OrderDirectionEnum is the name of enum OrderDirection
 	OrderDirectionEnum = "OrderDirection"
 	// RelayCursor is the name of the cursor type
@@ -102,11 +107,12 @@ var (
 )
 
 type schemaGenerator struct {
-	path          string
-	relaySpec     bool
-	genSchema     bool
-	genWhereInput bool
-	genMutations  bool
+	path             string
+	relaySpec        bool
+	genSchema        bool
+	genWhereInput    bool
+	genMutations     bool
+	genSubscriptions bool
 
 	cfg         *config.Config
 	scalarFunc  func(*gen.Field, gen.Op) string
@@ -139,7 +145,7 @@ func (e *schemaGenerator) BuildSchema(g *gen.Graph) (s *ast.Schema, err error) {
 }
 
 func (e *schemaGenerator) buildTypes(g *gen.Graph, s *ast.Schema) error {
-	var queryFields ast.FieldList
+	var queryFields, mutationFields, subscriptionFields ast.FieldList
 	if e.relaySpec {
 		queryFields = relayBuiltinQueryFields()
 	}
@@ -213,6 +219,18 @@ func (e *schemaGenerator) buildTypes(g *gen.Graph, s *ast.Schema) error {
 					return ErrRelaySpecDisabled
 				}
 				s.AddTypes(names.TypeDefs()...)
+				defs, err := e.buildAggregate(node, gqlType, names)
+				if err != nil {
+					return err
+				}
+				if len(defs) > 0 {
+					s.Types[names.Connection].Fields = append(s.Types[names.Connection].Fields, &ast.FieldDefinition{
+						Name:        "aggregate",
+						Type:        ast.NonNullNamedType(names.Aggregate, nil),
+						Description: "Aggregations over the items matched by the connection filters, regardless of pagination.",
+					})
+					s.AddTypes(defs...)
+				}
 
 				if ant.QueryField != nil {
 					name := ant.QueryField.fieldName(gqlType)
@@ -236,6 +254,22 @@ func (e *schemaGenerator) buildTypes(g *gen.Graph, s *ast.Schema) error {
 			}
 		}
 
+		if e.genSchema && ant.BulkMutations {
+			ops, err := nodeBulkMutations(node)
+			if err != nil {
+				return err
+			}
+			ops.CreateMany = ops.CreateMany && e.genMutations
+			ops.UpdateMany = ops.UpdateMany && e.genMutations && e.genWhereInput
+			ops.DeleteMany = ops.DeleteMany && e.genWhereInput
+			mutationFields = append(mutationFields, bulkMutationFieldDefs(gqlType, names, ops)...)
+		}
+
+		if e.genSchema && e.genSubscriptions && !ant.Skip.Is(SkipType|SkipSubscription) {
+			hasWhereInput := e.genWhereInput && !ant.Skip.Is(SkipWhereInput)
+			subscriptionFields = append(subscriptionFields, subscriptionFieldDefs(gqlType, names, hasWhereInput)...)
+		}
+
 		if e.genWhereInput && !ant.Skip.Is(SkipWhereInput) {
 			def, err := e.buildWhereInput(node, gqlType, names.WhereInput)
 			if err != nil {
@@ -264,10 +298,116 @@ func (e *schemaGenerator) buildTypes(g *gen.Graph, s *ast.Schema) error {
 			Fields: queryFields,
 		})
 	}
+	if len(mutationFields) > 0 {
+		def := &ast.Definition{
+			Name:   MutationType,
+			Kind:   ast.Object,
+			Fields: mutationFields,
+		}
+		s.AddTypes(def)
+		// The Mutation type is extended when it is defined by the user schema,
+		// and is defined by the generated schema otherwise. See printSchema.
+		if !e.extendMutation() {
+			s.Mutation = def
+		}
+	}
+	if len(subscriptionFields) > 0 {
+		s.AddTypes(&ast.Definition{
+			Name:   SubscriptionType,
+			Kind:   ast.Object,
+			Fields: subscriptionFields,
+		})
+	}
 
 	return nil
 }
 
+// bulkMutationFieldDefs returns the bulk mutation fields of the Mutation type for the given type.
+func bulkMutationFieldDefs(gqlType string, names *PaginationNames, ops *bulkOps) ast.FieldList {
+	var (
+		fields ast.FieldList
+		types  = plural(gqlType)
+		where  = &ast.ArgumentDefinition{
+			Name:        "where",
+			Type:        ast.NonNullNamedType(names.WhereInput, nil),
+			Description: fmt.Sprintf("Filtering options for the %s to mutate.", types),
+		}
+		all = &ast.ArgumentDefinition{
+			Name:         "all",
+			Type:         ast.NonNullNamedType("Boolean", nil),
+			DefaultValue: &ast.Value{Kind: ast.BooleanValue, Raw: "false"},
+			Description:  fmt.Sprintf("Must be set to mutate all %s with an empty filter, that is rejected otherwise.", types),
+		}
+	)
+	if ops.CreateMany {
+		fields = append(fields, &ast.FieldDefinition{
+			Name:        "createMany" + types,
+			Description: fmt.Sprintf("Creates the %s of the given inputs in bulk.", types),
+			Arguments: ast.ArgumentDefinitionList{
+				{
+					Name:        "input",
+					Type:        ast.NonNullListType(ast.NonNullNamedType(fmt.Sprintf("Create%sInput", gqlType), nil), nil),
+					Description: fmt.Sprintf("The inputs of the %s to create.", types),
+				},
+			},
+			Type: listNamedType(gqlType, false),
+		})
+	}
+	if ops.UpdateMany {
+		fields = append(fields, &ast.FieldDefinition{
+			Name:        "updateMany" + types,
+			Description: fmt.Sprintf("Updates the %s matching the filter, and returns the number of updated %s.", types, types),
+			Arguments: ast.ArgumentDefinitionList{
+				where,
+				all,
+				{
+					Name:        "input",
+					Type:        ast.NonNullNamedType(fmt.Sprintf("Update%sInput", gqlType), nil),
+					Description: fmt.Sprintf("The changes to apply on the matched %s.", types),
+				},
+			},
+			Type: ast.NonNullNamedType("Int", nil),
+		})
+	}
+	if ops.DeleteMany {
+		fields = append(fields, &ast.FieldDefinition{
+			Name:        "deleteMany" + types,
+			Description: fmt.Sprintf("Deletes the %s matching the filter, and returns the number of deleted %s.", types, types),
+			Arguments:   ast.ArgumentDefinitionList{where, all},
+			Type:        ast.NonNullNamedType("Int", nil),
+		})
+	}
+	return fields
+}
+
+// subscriptionFieldDefs returns the fields of the Subscription type for the given type.
+func subscriptionFieldDefs(gqlType string, names *PaginationNames, hasWhereInput bool) ast.FieldList {
+	name := camel(snake(gqlType))
+	fields := make(ast.FieldList, 0, 3)
+	for _, op := range []string{"created", "updated"} {
+		def := &ast.FieldDefinition{
+			Name:        name + pascal(op),
+			Type:        ast.NonNullNamedType(gqlType, nil),
+			Description: fmt.Sprintf("Receives the %s once they are %s.", plural(gqlType), op),
+		}
+		if hasWhereInput {
+			def.Arguments = ast.ArgumentDefinitionList{
+				{
+					Name:        "where",
+					Type:        ast.NamedType(names.WhereInput, nil),
+					Description: fmt.Sprintf("Filtering options for the received %s.", plural(gqlType)),
+				},
+			}
+		}
+		fields = append(fields, def)
+	}
+	return append(fields, &ast.FieldDefinition{
+		Name:        name + "Deleted",
+		Type:        ast.NonNullNamedType("ID", nil),
+		Description: fmt.Sprintf("Receives the IDs of the %s once they are deleted.", plural(gqlType)),
+	})
+}
+
 func (e *schemaGenerator) mayAddScalars(s *ast.Schema, def *ast.Definition) {
 	var redeclareErr bool
 	// If there is a config file but the schema there was not loaded.
@@ -296,6 +436,19 @@ func (e *schemaGenerator) mayAddScalars(s *ast.Schema, def *ast.Definition) {
 	}
 }
 
+// extendMutation indicates if the Mutation type is defined in another schema. If the
+// schemas cannot be loaded, the Mutation type is assumed to be defined by the user.
+func (e *schemaGenerator) extendMutation() bool {
+	if e.cfg == nil {
+		return true
+	}
+	// Do not fail in case of error, as in mayAddScalars.
+	if e.cfg.Schema == nil && e.cfg.LoadSchema() != nil {
+		return true
+	}
+	return e.externalType(MutationType)
+}
+
 // externalType indicates if the given type name exists in another schema.
 func (e *schemaGenerator) externalType(name string) bool {
 	if e.cfg == nil || e.cfg.Schema == nil || e.cfg.Schema.Types[name] == nil {
@@ -396,6 +549,107 @@ func (e *schemaGenerator) enumOrderByValues(t *gen.Type, gqlType string) (*ast.D
 	}, nil
 }
 
+// buildAggregate returns the aggregation types of the given
+// node, or nil if it does not have the Aggregate annotation.
+func (e *schemaGenerator) buildAggregate(t *gen.Type, gqlType string, names *PaginationNames) ([]*ast.Definition, error) {
+	fields, err := aggregateFields(t)
+	if err != nil || fields == nil {
+		return nil, err
+	}
+	aggregations := ast.FieldList{
+		{
+			Name:        "count",
+			Type:        ast.NonNullNamedType("Int", nil),
+			Description: "The number of aggregated items.",
+		},
+	}
+	var defs []*ast.Definition
+	if len(fields.Numeric) > 0 {
+		numbers := &ast.Definition{
+			Name:        names.AggregateNumbers,
+			Kind:        ast.Object,
+			Description: fmt.Sprintf("The result of an aggregation function applied on the numeric fields of %s.", gqlType),
+		}
+		for _, f := range fields.Numeric {
+			numbers.Fields = append(numbers.Fields, &ast.FieldDefinition{
+				Name: camel(f.Name),
+				Type: ast.NamedType("Float", nil),
+			})
+		}
+		for _, fn := range [...]struct{ name, desc string }{
+			{"sum", "sum"}, {"avg", "average"}, {"min", "minimum"}, {"max", "maximum"},
+		} {
+			aggregations = append(aggregations, &ast.FieldDefinition{
+				Name:        fn.name,
+				Type:        ast.NamedType(names.AggregateNumbers, nil),
+				Description: fmt.Sprintf("The %s of the numeric fields.", fn.desc),
+			})
+		}
+		defs = append(defs, numbers)
+	}
+	aggregate := &ast.Definition{
+		Name:        names.Aggregate,
+		Kind:        ast.Object,
+		Description: fmt.Sprintf("Aggregations over %s.", plural(gqlType)),
+		Fields:      aggregations,
+	}
+	if len(fields.Group) > 0 {
+		key := &ast.Definition{
+			Name:        names.AggregateKey,
+			Kind:        ast.Object,
+			Description: fmt.Sprintf("The key of a %s group. Fields that were not grouped by are null.", gqlType),
+		}
+		enum := &ast.Definition{
+			Name:        names.AggregateField,
+			Kind:        ast.Enum,
+			Description: fmt.Sprintf("Properties by which %s aggregations can be grouped.", gqlType),
+		}
+		for _, f := range fields.Group {
+			ant, err := annotation(f.Annotations)
+			if err != nil {
+				return nil, err
+			}
+			ft, err := e.typeFromField(gqlType, f, ant)
+			if err != nil {
+				return nil, err
+			}
+			key.Fields = append(key.Fields, &ast.FieldDefinition{
+				Name: camel(f.Name),
+				Type: ast.NamedType(ft.Name(), nil),
+			})
+			enum.EnumValues = append(enum.EnumValues, &ast.EnumValueDefinition{
+				Name: fields.GroupValue(f),
+			})
+		}
+		group := &ast.Definition{
+			Name:        names.AggregateGroup,
+			Kind:        ast.Object,
+			Description: fmt.Sprintf("Aggregations over a group of %s.", plural(gqlType)),
+			Fields: append(ast.FieldList{
+				{
+					Name:        "key",
+					Type:        ast.NonNullNamedType(names.AggregateKey, nil),
+					Description: "The values of the grouped fields.",
+				},
+			}, aggregations...),
+		}
+		aggregate.Fields = append(aggregate.Fields, &ast.FieldDefinition{
+			Name: "groupBy",
+			Type: listNamedType(names.AggregateGroup, false),
+			Arguments: ast.ArgumentDefinitionList{
+				{
+					Name:        "fields",
+					Type:        listNamedType(names.AggregateField, false),
+					Description: "The fields to group by.",
+				},
+			},
+			Description: "Aggregations over the groups of items that share the same values for the given fields.",
+		})
+		defs = append(defs, key, enum, group)
+	}
+	return append([]*ast.Definition{aggregate}, defs...), nil
+}
+
 func (e *schemaGenerator) buildFieldEnum(f *gen.Field, gqlType, goType string) (*ast.Definition, error) {
 	enumValues := make(ast.EnumValueList, 0, len(f.Enums))
 	for _, v := range f.Enums {
@@ -493,6 +747,18 @@ func (e *schemaGenerator) buildWhereInput(t *gen.Type, nodeGQLType, gqlType stri
 		})
 	}
 
+	search, err := searchFields(t)
+	if err != nil {
+		return nil, err
+	}
+	if len(search) > 0 {
+		def.Fields = append(def.Fields, &ast.FieldDefinition{
+			Name:        "search",
+			Type:        namedType("String", true),
+			Description: "search matches the searchable fields against the given text",
+		})
+	}
+
 	fields := allFields(t)
 	for _, f := range fields {
 		if t.IsEdgeSchema() && f.IsEdgeField() || !f.Type.Comparable() || f.Sensitive() {
@@ -514,6 +780,38 @@ func (e *schemaGenerator) buildWhereInput(t *gen.Type, nodeGQLType, gqlType stri
 		}
 	}
 
+	filters, err := jsonFilters(t)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range filters {
+		def.Fields = append(def.Fields, &ast.FieldDefinition{
+			Name:        camel(f.Name + "_has_key"),
+			Type:        namedType("String", true),
+			Description: f.Name + " field predicates",
+		})
+		for _, p := range f.Paths {
+			name := camel(f.Name) + p.Name
+			if p.List {
+				def.Fields = append(def.Fields, &ast.FieldDefinition{
+					Name: name + "ArrayContains",
+					Type: namedType(p.GQLType, true),
+				})
+				continue
+			}
+			def.Fields = append(def.Fields, &ast.FieldDefinition{
+				Name: name,
+				Type: namedType(p.GQLType, true),
+			})
+			if p.GQLType == "String" {
+				def.Fields = append(def.Fields, &ast.FieldDefinition{
+					Name: name + "Contains",
+					Type: namedType(p.GQLType, true),
+				})
+			}
+		}
+	}
+
 	if t.IsEdgeSchema() {
 		return def, nil
 	}
@@ -637,12 +935,80 @@ func (e *schemaGenerator) buildMutationInputs(t *gen.Type, ant *Annotation, gqlT
 				})
 			}
 		}
+
+		nested, err := desc.NestedEdges()
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range nested {
+			input, err := e.Create.Input()
+			if err != nil {
+				return nil, err
+			}
+			name := "create" + pascal(e.Name)
+			if !i.IsCreate {
+				upsert, err := upsertInputDef(e)
+				if err != nil {
+					return nil, err
+				}
+				defs = append(defs, upsert)
+				input, name = upsert.Name, "upsert"+pascal(e.Name)
+			}
+			ft := namedType(input, true)
+			if !e.Unique {
+				ft = listNamedType(input, true)
+			}
+			def.Fields = append(def.Fields, &ast.FieldDefinition{
+				Name: name,
+				Type: ft,
+			})
+		}
 		defs = append(defs, def)
 	}
 
 	return defs, nil
 }
 
+// upsertInputDef returns the Upsert<T>Input used by the nested edge of an update input.
+func upsertInputDef(e *NestedEdgeDescriptor) (*ast.Definition, error) {
+	name, err := e.Update.UpsertInput()
+	if err != nil {
+		return nil, err
+	}
+	create, err := e.Create.Input()
+	if err != nil {
+		return nil, err
+	}
+	update, err := e.Update.Input()
+	if err != nil {
+		return nil, err
+	}
+	gqlType, _, err := gqlTypeFromNode(e.Type)
+	if err != nil {
+		return nil, err
+	}
+	return &ast.Definition{
+		Name:        name,
+		Kind:        ast.InputObject,
+		Description: fmt.Sprintf("%s is used for create or update %s object through an edge.\nInput was generated by ent.", name, gqlType),
+		Fields: ast.FieldList{
+			{
+				Name:        "id",
+				Type:        namedType("ID", true),
+				Description: "The ID of the node to update. If not set, a node is created from the create input.",
+			},
+			{
+				Name: "create",
+				Type: namedType(create, true),
+			},
+			{
+				Name: "update",
+				Type: namedType(update, true),
+			},
+		},
+	}, nil
+}
+
 func (e *schemaGenerator) fieldDefinitions(gqlType string, f *gen.Field, ant *Annotation) ([]*ast.FieldDefinition, error) {
 	ft, err := e.typeFromField(gqlType, f, ant)
 	if err != nil {
@@ -870,6 +1236,22 @@ func builtinTypes() []*ast.Definition {
 					Name:        "DESC",
 					Description: "Specifies a descending order for a given `orderBy` argument.",
 				},
+				{
+					Name:        "ASC_NULLS_FIRST",
+					Description: "Specifies an ascending order for a given `orderBy` argument, with null values first.",
+				},
+				{
+					Name:        "ASC_NULLS_LAST",
+					Description: "Specifies an ascending order for a given `orderBy` argument, with null values last.",
+				},
+				{
+					Name:        "DESC_NULLS_FIRST",
+					Description: "Specifies a descending order for a given `orderBy` argument, with null values first.",
+				},
+				{
+					Name:        "DESC_NULLS_LAST",
+					Description: "Specifies a descending order for a given `orderBy` argument, with null values last.",
+				},
 			},
 		},
 	}
@@ -986,11 +1368,23 @@ func listNamedType(name string, nullable bool) *ast.Type {
 	return ast.NonNullListType(t, nil)
 }
 
+// printSchema prints the schema. The Mutation type is printed as an extension of the Mutation type
+// of the user schema, unless it was set as the Mutation root of the schema by the schema generator.
 func printSchema(schema *ast.Schema) string {
 	sb := &strings.Builder{}
-	formatter.
-		NewFormatter(sb, formatter.WithIndent("  ")).
-		FormatSchema(schema)
+	f := formatter.NewFormatter(sb, formatter.WithIndent("  "))
+	mutation, ok := schema.Types[MutationType]
+	if !ok || schema.Mutation == mutation {
+		f.FormatSchema(schema)
+		return sb.String()
+	}
+	s := *schema
+	s.Types = maps.Clone(schema.Types)
+	delete(s.Types, MutationType)
+	f.FormatSchema(&s)
+	f.FormatSchemaDocument(&ast.SchemaDocument{
+		Extensions: ast.DefinitionList{mutation},
+	})
 	return sb.String()
 }
 
@@ -21,6 +21,8 @@ import (
 
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"github.com/99designs/gqlgen/codegen/config"
 	"github.com/stretchr/testify/require"
 	"github.com/vektah/gqlparser/v2/ast"
 )
@@ -185,6 +187,342 @@ type SuperTodoEdge {
 	}
 }
 
+func TestSchema_buildAggregate(t *testing.T) {
+	typ := &gen.Type{
+		Name: "Todo",
+		Annotations: map[string]interface{}{
+			annotationName: map[string]interface{}{
+				"RelayConnection": true,
+				"Aggregate":       true,
+			},
+		},
+		Fields: []*gen.Field{
+			{Name: "text", Type: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "priority", Type: &field.TypeInfo{Type: field.TypeInt}},
+			{Name: "estimate", Type: &field.TypeInfo{Type: field.TypeFloat64}, Optional: true},
+			{Name: "created_at", Type: &field.TypeInfo{Type: field.TypeTime}},
+			{
+				Name: "secret",
+				Type: &field.TypeInfo{Type: field.TypeInt},
+				Annotations: map[string]interface{}{
+					annotationName: map[string]interface{}{"Skip": SkipType},
+				},
+			},
+		},
+	}
+	e := &schemaGenerator{}
+	defs, err := e.buildAggregate(typ, "Todo", paginationNames("Todo"))
+	require.NoError(t, err)
+	s := &ast.Schema{}
+	s.AddTypes(defs...)
+	require.Equal(t, `"""
+Aggregations over Todos.
+"""
+type TodoAggregate {
+  """
+  The number of aggregated items.
+  """
+  count: Int!
+  """
+  The sum of the numeric fields.
+  """
+  sum: TodoAggregateNumbers
+  """
+  The average of the numeric fields.
+  """
+  avg: TodoAggregateNumbers
+  """
+  The minimum of the numeric fields.
+  """
+  min: TodoAggregateNumbers
+  """
+  The maximum of the numeric fields.
+  """
+  max: TodoAggregateNumbers
+  """
+  Aggregations over the groups of items that share the same values for the given fields.
+  """
+  groupBy(
+    """
+    The fields to group by.
+    """
+    fields: [TodoAggregateField!]!
+  ): [TodoAggregateGroup!]!
+}
+"""
+Properties by which Todo aggregations can be grouped.
+"""
+enum TodoAggregateField {
+  TEXT
+  PRIORITY
+  ESTIMATE
+}
+"""
+Aggregations over a group of Todos.
+"""
+type TodoAggregateGroup {
+  """
+  The values of the grouped fields.
+  """
+  key: TodoAggregateGroupKey!
+  """
+  The number of aggregated items.
+  """
+  count: Int!
+  """
+  The sum of the numeric fields.
+  """
+  sum: TodoAggregateNumbers
+  """
+  The average of the numeric fields.
+  """
+  avg: TodoAggregateNumbers
+  """
+  The minimum of the numeric fields.
+  """
+  min: TodoAggregateNumbers
+  """
+  The maximum of the numeric fields.
+  """
+  max: TodoAggregateNumbers
+}
+"""
+The key of a Todo group. Fields that were not grouped by are null.
+"""
+type TodoAggregateGroupKey {
+  text: String
+  priority: Int
+  estimate: Float
+}
+"""
+The result of an aggregation function applied on the numeric fields of Todo.
+"""
+type TodoAggregateNumbers {
+  priority: Float
+  estimate: Float
+}
+`, printSchema(s))
+
+	typ.Annotations[annotationName] = map[string]interface{}{"Aggregate": true}
+	_, err = e.buildAggregate(typ, "Todo", paginationNames("Todo"))
+	require.EqualError(t, err, "entgql: Aggregate annotation on type Todo requires the RelayConnection annotation")
+
+	typ.Annotations[annotationName] = map[string]interface{}{"RelayConnection": true}
+	defs, err = e.buildAggregate(typ, "Todo", paginationNames("Todo"))
+	require.NoError(t, err)
+	require.Empty(t, defs)
+}
+
+func TestSchema_subscriptionFields(t *testing.T) {
+	s := &ast.Schema{}
+	s.AddTypes(&ast.Definition{
+		Name:   SubscriptionType,
+		Kind:   ast.Object,
+		Fields: subscriptionFieldDefs("BillProduct", paginationNames("BillProduct"), true),
+	})
+	require.Equal(t, `type Subscription {
+  """
+  Receives the BillProducts once they are created.
+  """
+  billProductCreated(
+    """
+    Filtering options for the received BillProducts.
+    """
+    where: BillProductWhereInput
+  ): BillProduct!
+  """
+  Receives the BillProducts once they are updated.
+  """
+  billProductUpdated(
+    """
+    Filtering options for the received BillProducts.
+    """
+    where: BillProductWhereInput
+  ): BillProduct!
+  """
+  Receives the IDs of the BillProducts once they are deleted.
+  """
+  billProductDeleted: ID!
+}
+`, printSchema(s))
+
+	fields := subscriptionFieldDefs("Todo", paginationNames("Todo"), false)
+	require.Len(t, fields, 3)
+	for _, f := range fields {
+		require.Empty(t, f.Arguments)
+	}
+}
+
+func TestSchema_buildMutationInputs_nested(t *testing.T) {
+	parent := &gen.Edge{Name: "parent", Unique: true, Optional: true, Rel: gen.Relation{Type: gen.M2O}}
+	children := &gen.Edge{
+		Name:     "children",
+		Optional: true,
+		Rel:      gen.Relation{Type: gen.O2M},
+		Ref:      parent,
+		Annotations: map[string]interface{}{
+			annotationName: map[string]interface{}{"NestedMutations": true},
+		},
+	}
+	typ := &gen.Type{
+		Name:  "Todo",
+		Edges: []*gen.Edge{children},
+		Annotations: map[string]interface{}{
+			annotationName: map[string]interface{}{
+				"MutationInputs": []map[string]interface{}{{"IsCreate": true}, {}},
+			},
+		},
+	}
+	children.Type, parent.Type = typ, typ
+	ant, err := annotation(typ.Annotations)
+	require.NoError(t, err)
+	e := &schemaGenerator{}
+	defs, err := e.buildMutationInputs(typ, ant, "Todo")
+	require.NoError(t, err)
+	s := &ast.Schema{}
+	s.AddTypes(defs...)
+	require.Equal(t, `"""
+CreateTodoInput is used for create Todo object.
+Input was generated by ent.
+"""
+input CreateTodoInput {
+  childIDs: [ID!]
+  createChildren: [CreateTodoInput!]
+}
+"""
+UpdateTodoInput is used for update Todo object.
+Input was generated by ent.
+"""
+input UpdateTodoInput {
+  addChildIDs: [ID!]
+  removeChildIDs: [ID!]
+  clearChildren: Boolean
+  upsertChildren: [UpsertTodoInput!]
+}
+"""
+UpsertTodoInput is used for create or update Todo object through an edge.
+Input was generated by ent.
+"""
+input UpsertTodoInput {
+  """
+  The ID of the node to update. If not set, a node is created from the create input.
+  """
+  id: ID
+  create: CreateTodoInput
+  update: UpdateTodoInput
+}
+`, printSchema(s))
+
+	inputs, err := mutationInputs([]*gen.Type{typ})
+	require.NoError(t, err)
+	require.Len(t, inputs, 2)
+	for _, i := range inputs {
+		require.True(t, i.Nested)
+	}
+
+	parent.Optional = false
+	_, err = e.buildMutationInputs(typ, ant, "Todo")
+	require.EqualError(t, err, "entgql: nested mutations of edge Todo.children require the edge Todo.parent to be optional")
+}
+
+func TestSchema_bulkMutationFields(t *testing.T) {
+	typ := &gen.Type{
+		Name: "Todo",
+		Annotations: map[string]interface{}{
+			annotationName: map[string]interface{}{
+				"BulkMutations":  true,
+				"MutationInputs": []map[string]interface{}{{"IsCreate": true}, {}},
+			},
+		},
+	}
+	ops, err := nodeBulkMutations(typ)
+	require.NoError(t, err)
+	require.Equal(t, &bulkOps{CreateMany: true, UpdateMany: true, DeleteMany: true}, ops)
+	s := &ast.Schema{}
+	s.AddTypes(&ast.Definition{
+		Name:   MutationType,
+		Kind:   ast.Object,
+		Fields: bulkMutationFieldDefs("Todo", paginationNames("Todo"), ops),
+	})
+	require.Equal(t, `extend type Mutation {
+  """
+  Creates the Todos of the given inputs in bulk.
+  """
+  createManyTodos(
+    """
+    The inputs of the Todos to create.
+    """
+    input: [CreateTodoInput!]!
+  ): [Todo!]!
+  """
+  Updates the Todos matching the filter, and returns the number of updated Todos.
+  """
+  updateManyTodos(
+    """
+    Filtering options for the Todos to mutate.
+    """
+    where: TodoWhereInput!
+
+    """
+    Must be set to mutate all Todos with an empty filter, that is rejected otherwise.
+    """
+    all: Boolean! = false
+
+    """
+    The changes to apply on the matched Todos.
+    """
+    input: UpdateTodoInput!
+  ): Int!
+  """
+  Deletes the Todos matching the filter, and returns the number of deleted Todos.
+  """
+  deleteManyTodos(
+    """
+    Filtering options for the Todos to mutate.
+    """
+    where: TodoWhereInput!
+
+    """
+    Must be set to mutate all Todos with an empty filter, that is rejected otherwise.
+    """
+    all: Boolean! = false
+  ): Int!
+}
+`, printSchema(s))
+	// The Mutation root of the generated schema is defined, not extended.
+	s.Mutation = s.Types[MutationType]
+	require.Regexp(t, "^type Mutation {\n", printSchema(s))
+
+	typ.Annotations[annotationName] = map[string]interface{}{
+		"BulkMutations":  true,
+		"MutationInputs": []map[string]interface{}{{"IsCreate": true}, {}},
+		"Skip":           SkipWhereInput | SkipMutationCreateInput,
+	}
+	ops, err = nodeBulkMutations(typ)
+	require.NoError(t, err)
+	require.Equal(t, &bulkOps{}, ops)
+}
+
+func TestSchema_extendMutation(t *testing.T) {
+	e := &schemaGenerator{path: "ent.graphql"}
+	require.True(t, e.extendMutation())
+	mutation := func(src string) *config.Config {
+		return &config.Config{
+			Schema: &ast.Schema{
+				Types: map[string]*ast.Definition{
+					MutationType: {Name: MutationType, Position: &ast.Position{Src: &ast.Source{Name: src}}},
+				},
+			},
+		}
+	}
+	e.cfg = mutation("todo.graphql")
+	require.True(t, e.extendMutation())
+	e.cfg = mutation("ent.graphql")
+	require.False(t, e.extendMutation())
+	e.cfg = &config.Config{Schema: &ast.Schema{}}
+	require.False(t, e.extendMutation())
+}
+
 func TestSchema_relayBuiltinTypes(t *testing.T) {
 	tests := []struct {
 		name string
@@ -0,0 +1,161 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// SearchRankColumn is the column holding the relevance
+	// of the rows that match the full-text search.
+	SearchRankColumn = "search_rank"
+	// RelevanceOrderField is the OrderField value of types with searchable
+	// fields, that orders the results by the relevance of the search.
+	RelevanceOrderField = "RELEVANCE"
+)
+
+// SearchPredicate returns a predicate matching the rows whose columns
+// match the given search query. The query is executed using to_tsvector
+// and plainto_tsquery on Postgres, and MATCH ... AGAINST on MySQL. Other
+// dialects match the rows that contain the query in one of their columns,
+// ignoring case. An empty query, or a query of spaces, matches all rows
+// on all dialects.
+func SearchPredicate(query string, columns ...string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		query, ok := SearchQuery(&query)
+		if !ok {
+			return
+		}
+		switch s.Dialect() {
+		case dialect.Postgres, dialect.MySQL:
+			s.Where(sql.P(func(b *sql.Builder) {
+				searchExpr(b, s, query, columns)
+			}))
+		default:
+			preds := make([]*sql.Predicate, len(columns))
+			for i, c := range columns {
+				preds[i] = sql.ContainsFold(s.C(c), query)
+			}
+			s.Where(sql.Or(preds...))
+		}
+	}
+}
+
+// SearchRank returns a selector function that computes the relevance of the
+// rows to the given search query, and exposes it as the SearchRankColumn of
+// the selected table. The rank is computed using ts_rank on Postgres, MATCH
+// ... AGAINST on MySQL, and the number of columns that contain the query,
+// ignoring case, on other dialects. An empty query ranks all rows with 0.
+//
+// The table is replaced by a derived table of the same name that holds the
+// rank, and the predicates applied on the selector before SearchRank are
+// moved to the derived table. Hence, they are computed against the base table,
+// as required by MATCH ... AGAINST on MySQL. The generated pagination applies
+// SearchRank only when ordering by the relevance, using OrderBySearchRank.
+func SearchRank(query string, columns ...string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		t := s.Table()
+		if t == nil {
+			return
+		}
+		ranked := sql.Dialect(s.Dialect()).Select("*").From(t)
+		if query, ok := SearchQuery(&query); ok {
+			ranked.AppendSelectExprAs(rankExpr(ranked, query, columns), SearchRankColumn)
+		} else {
+			ranked.AppendSelectExprAs(sql.Expr("0"), SearchRankColumn)
+		}
+		if p := s.P(); p != nil {
+			ranked.Where(p)
+			s.SetP(nil)
+		}
+		s.From(ranked.As(s.TableName()))
+	}
+}
+
+// SearchQuery returns the search query without its surrounding spaces,
+// and reports if it is set and not empty.
+func SearchQuery(query *string) (string, bool) {
+	if query == nil {
+		return "", false
+	}
+	q := strings.TrimSpace(*query)
+	return q, q != ""
+}
+
+// OrderBySearchRank returns an order option that orders the rows by the
+// relevance of the search. It must be used with queries that were ranked
+// with SearchRank, as done by the generated pagination for the WhereInputs
+// with a search. The pagination rejects other queries with ErrSearchRequired.
+func OrderBySearchRank(opts ...sql.OrderTermOption) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		// Select the rank to encode it in the pagination cursors.
+		if len(s.FindSelection(SearchRankColumn)) == 0 {
+			s.AppendSelect(s.C(SearchRankColumn))
+		}
+		sql.OrderByField(SearchRankColumn, opts...).ToFunc()(s)
+	}
+}
+
+// searchExpr writes the full-text search expression of
+// Postgres or MySQL, for the given query and columns.
+func searchExpr(b *sql.Builder, s *sql.Selector, query string, columns []string) {
+	if b.Dialect() == dialect.Postgres {
+		b.WriteString("to_tsvector(concat_ws(' ', ")
+		searchColumns(b, s, columns)
+		b.WriteString(")) @@ plainto_tsquery(").Arg(query).WriteByte(')')
+		return
+	}
+	b.WriteString("MATCH (")
+	searchColumns(b, s, columns)
+	b.WriteString(") AGAINST (").Arg(query).WriteString(" IN NATURAL LANGUAGE MODE)")
+}
+
+// rankExpr returns the expression computing the relevance
+// of the rows to the given query in the given dialect.
+func rankExpr(s *sql.Selector, query string, columns []string) sql.Querier {
+	// Predicates are used as expressions, because
+	// they inherit the dialect of the query builder.
+	return sql.P(func(b *sql.Builder) {
+		switch b.Dialect() {
+		case dialect.Postgres:
+			b.WriteString("ts_rank(to_tsvector(concat_ws(' ', ")
+			searchColumns(b, s, columns)
+			b.WriteString(")), plainto_tsquery(").Arg(query).WriteString("))")
+		case dialect.MySQL:
+			searchExpr(b, s, query, columns)
+		default:
+			for i, c := range columns {
+				if i > 0 {
+					b.WriteString(" + ")
+				}
+				b.WriteString("CASE WHEN ").Join(sql.ContainsFold(s.C(c), query)).WriteString(" THEN 1 ELSE 0 END")
+			}
+		}
+	})
+}
+
+// searchColumns writes the comma-separated list of the qualified columns.
+func searchColumns(b *sql.Builder, s *sql.Selector, columns []string) {
+	for i, c := range columns {
+		if i > 0 {
+			b.Comma()
+		}
+		b.Ident(s.C(c))
+	}
+}
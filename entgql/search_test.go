@@ -0,0 +1,121 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchPredicate(t *testing.T) {
+	tests := []struct {
+		dialect string
+		query   string
+		args    []any
+	}{
+		{
+			dialect: dialect.Postgres,
+			query:   `SELECT * FROM "todos" WHERE to_tsvector(concat_ws(' ', "todos"."title", "todos"."body")) @@ plainto_tsquery($1)`,
+			args:    []any{"ent"},
+		},
+		{
+			dialect: dialect.MySQL,
+			query:   "SELECT * FROM `todos` WHERE MATCH (`todos`.`title`, `todos`.`body`) AGAINST (? IN NATURAL LANGUAGE MODE)",
+			args:    []any{"ent"},
+		},
+		{
+			dialect: dialect.SQLite,
+			query:   "SELECT * FROM `todos` WHERE LOWER(`todos`.`title`) LIKE ? OR LOWER(`todos`.`body`) LIKE ?",
+			args:    []any{"%ent%", "%ent%"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect, func(t *testing.T) {
+			s := sql.Dialect(tt.dialect).Select("*").From(sql.Table("todos"))
+			entgql.SearchPredicate(" ent ", "title", "body")(s)
+			query, args := s.Query()
+			require.Equal(t, tt.query, query)
+			require.Equal(t, tt.args, args)
+
+			// Empty searches match all rows.
+			s = sql.Dialect(tt.dialect).Select("*").From(sql.Table("todos"))
+			entgql.SearchPredicate("  ", "title", "body")(s)
+			query, args = s.Query()
+			require.NotContains(t, query, "WHERE")
+			require.Empty(t, args)
+		})
+	}
+}
+
+func TestSearchRank(t *testing.T) {
+	tests := []struct {
+		dialect string
+		table   string
+		column  string
+		rank    string
+		args    []any
+	}{
+		{
+			dialect: dialect.Postgres,
+			table:   `"todos"`,
+			column:  `"search_rank"`,
+			rank:    `ts_rank(to_tsvector(concat_ws(' ', "todos"."title", "todos"."body")), plainto_tsquery($1))`,
+			args:    []any{"ent", "ent"},
+		},
+		{
+			dialect: dialect.MySQL,
+			table:   "`todos`",
+			column:  "`search_rank`",
+			rank:    "MATCH (`todos`.`title`, `todos`.`body`) AGAINST (? IN NATURAL LANGUAGE MODE)",
+			args:    []any{"ent", "ent"},
+		},
+		{
+			dialect: dialect.SQLite,
+			table:   "`todos`",
+			column:  "`search_rank`",
+			rank:    "CASE WHEN LOWER(`todos`.`title`) LIKE ? THEN 1 ELSE 0 END + CASE WHEN LOWER(`todos`.`body`) LIKE ? THEN 1 ELSE 0 END",
+			args:    []any{"%ent%", "%ent%", "%ent%", "%ent%"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect, func(t *testing.T) {
+			b := sql.Dialect(tt.dialect)
+			s := b.Select(b.Table("todos").C("id")).From(b.Table("todos"))
+			entgql.SearchPredicate("ent", "title", "body")(s)
+			entgql.SearchRank("ent", "title", "body")(s)
+			s.Where(sql.GT(s.C(entgql.SearchRankColumn), 0))
+			entgql.OrderBySearchRank(sql.OrderDesc())(s)
+			query, args := s.Query()
+			// The rank is computed in a derived table named as the original table,
+			// that is filtered by the predicates applied before SearchRank, and used
+			// by the outer query.
+			require.Contains(t, query, "SELECT *, ("+tt.rank+") AS "+tt.column+" FROM "+tt.table+" WHERE ")
+			require.Contains(t, query, ") AS "+tt.table+" WHERE "+b.Table("todos").C(entgql.SearchRankColumn)+" > ")
+			require.Contains(t, query, "ORDER BY "+b.Table("todos").C(entgql.SearchRankColumn)+" DESC")
+			require.Equal(t, append(tt.args, 0), args)
+
+			// Empty searches rank all rows with 0.
+			s = b.Select("*").From(b.Table("todos"))
+			entgql.SearchRank(" ", "title", "body")(s)
+			query, args = s.Query()
+			require.Contains(t, query, "SELECT *, (0) AS "+tt.column+" FROM "+tt.table+") AS "+tt.table)
+			require.Empty(t, args)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+type (
+	// PubSub delivers the events of the generated GraphQL subscriptions
+	// from the mutation hook that publishes them to their subscribers.
+	//
+	// Events are the IDs of the mutated nodes, with their Go type. An
+	// implementation backed by an external broker must decode them back
+	// to the same type.
+	PubSub interface {
+		// Publish sends the event to the subscribers of the topic.
+		Publish(ctx context.Context, topic string, event any) error
+		// Subscribe returns a channel receiving the events published
+		// on the topic. The channel is closed once ctx is done.
+		Subscribe(ctx context.Context, topic string) (<-chan any, error)
+	}
+
+	// SubscriptionErrorHandler handles the errors of delivering the events of
+	// the generated GraphQL subscriptions. These are the errors of publishing
+	// the events of committed mutations, that are not returned to the callers
+	// of the mutations as their changes were already committed, and the errors
+	// of resolving the events received by the subscribers.
+	SubscriptionErrorHandler func(ctx context.Context, topic string, event any, err error)
+
+	// SubscriptionOption allows configuring the generated SubscriptionHook
+	// and the generated Subscribe methods.
+	SubscriptionOption func(*SubscriptionConfig)
+
+	// SubscriptionConfig holds the configuration of the generated
+	// subscriptions. It is built by NewSubscriptionConfig.
+	SubscriptionConfig struct {
+		onError []SubscriptionErrorHandler
+	}
+
+	// MemoryPubSub is an in-process PubSub.
+	MemoryPubSub struct {
+		buffer int
+		mu     sync.RWMutex
+		subs   map[string]map[chan any]struct{}
+	}
+)
+
+// DefaultSubscriptionBuffer is the default number of events
+// buffered for each subscriber of a MemoryPubSub.
+const DefaultSubscriptionBuffer = 64
+
+// NewMemoryPubSub returns an in-process PubSub that buffers up to
+// buffer events for each subscriber. Publish never blocks, and events
+// are dropped for subscribers whose buffer is full. A non-positive
+// buffer defaults to DefaultSubscriptionBuffer.
+func NewMemoryPubSub(buffer int) *MemoryPubSub {
+	if buffer <= 0 {
+		buffer = DefaultSubscriptionBuffer
+	}
+	return &MemoryPubSub{
+		buffer: buffer,
+		subs:   make(map[string]map[chan any]struct{}),
+	}
+}
+
+// Publish implements the PubSub interface.
+func (p *MemoryPubSub) Publish(_ context.Context, topic string, event any) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for ch := range p.subs[topic] {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+	return nil
+}
+
+// Subscribe implements the PubSub interface.
+func (p *MemoryPubSub) Subscribe(ctx context.Context, topic string) (<-chan any, error) {
+	ch := make(chan any, p.buffer)
+	p.mu.Lock()
+	if p.subs[topic] == nil {
+		p.subs[topic] = make(map[chan any]struct{})
+	}
+	p.subs[topic][ch] = struct{}{}
+	p.mu.Unlock()
+	go func() {
+		<-ctx.Done()
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		delete(p.subs[topic], ch)
+		if len(p.subs[topic]) == 0 {
+			delete(p.subs, topic)
+		}
+		close(ch)
+	}()
+	return ch, nil
+}
+
+// WithSubscriptionErrorHandler adds a handler for the errors of delivering
+// the subscription events. By default, these errors are discarded.
+func WithSubscriptionErrorHandler(h SubscriptionErrorHandler) SubscriptionOption {
+	return func(c *SubscriptionConfig) {
+		c.onError = append(c.onError, h)
+	}
+}
+
+// WithSubscriptionLogger logs the errors of delivering the subscription
+// events using the given logger.
+func WithSubscriptionLogger(l *slog.Logger) SubscriptionOption {
+	return WithSubscriptionErrorHandler(func(ctx context.Context, topic string, event any, err error) {
+		l.ErrorContext(ctx, "entgql: delivering subscription event", "topic", topic, "event", event, "error", err)
+	})
+}
+
+// NewSubscriptionConfig returns the SubscriptionConfig of the given options.
+// It is used by the generated code.
+func NewSubscriptionConfig(opts ...SubscriptionOption) *SubscriptionConfig {
+	c := &SubscriptionConfig{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// HandleError passes the error of delivering the event on the topic to the
+// handlers of the configuration.
+func (c *SubscriptionConfig) HandleError(ctx context.Context, topic string, event any, err error) {
+	for _, h := range c.onError {
+		h(ctx, topic, event, err)
+	}
+}
+
+var _ PubSub = (*MemoryPubSub)(nil)
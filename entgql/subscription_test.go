@@ -0,0 +1,67 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryPubSub(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ps := entgql.NewMemoryPubSub(2)
+	todos, err := ps.Subscribe(ctx, "Todo.created")
+	require.NoError(t, err)
+	users, err := ps.Subscribe(context.Background(), "User.created")
+	require.NoError(t, err)
+
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, ps.Publish(ctx, "Todo.created", i))
+	}
+	// Events are dropped once the buffer of the subscriber is full.
+	require.Equal(t, 1, <-todos)
+	require.Equal(t, 2, <-todos)
+	require.Empty(t, users)
+
+	cancel()
+	_, ok := <-todos
+	require.False(t, ok, "channel should be closed once the context is done")
+	require.NoError(t, ps.Publish(context.Background(), "Todo.created", 4))
+}
+
+func TestSubscriptionConfig(t *testing.T) {
+	// Errors are discarded by default.
+	entgql.NewSubscriptionConfig().HandleError(context.Background(), "Todo.created", 1, errors.New("broker is down"))
+
+	var (
+		buf    bytes.Buffer
+		topics []string
+		cfg    = entgql.NewSubscriptionConfig(
+			entgql.WithSubscriptionLogger(slog.New(slog.NewTextHandler(&buf, nil))),
+			entgql.WithSubscriptionErrorHandler(func(_ context.Context, topic string, _ any, _ error) {
+				topics = append(topics, topic)
+			}),
+		)
+	)
+	cfg.HandleError(context.Background(), "Todo.created", 1, errors.New("broker is down"))
+	require.Contains(t, buf.String(), `msg="entgql: delivering subscription event" topic=Todo.created event=1 error="broker is down"`)
+	require.Equal(t, []string{"Todo.created"}, topics)
+}
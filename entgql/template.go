@@ -61,6 +61,22 @@ var (
 	// WhereTemplate adds a template for generating <T>WhereInput filters for each schema type.
 	WhereTemplate = parseT("template/where_input.tmpl")
 
+	// SubscriptionTemplate adds a mutation hook publishing the committed mutations
+	// through an entgql.PubSub, and the helpers for resolving the GraphQL subscriptions.
+	SubscriptionTemplate = parseT("template/subscription.tmpl")
+
+	// ComplexityTemplate adds a template for generating the complexity functions
+	// of the GraphQL connection and edge fields, for the ComplexityLimit extension.
+	ComplexityTemplate = parseT("template/complexity.tmpl")
+
+	// DataloaderTemplate adds a template for generating the batched loaders of the
+	// edges, used by the edge resolvers when the edges were not eager-loaded.
+	DataloaderTemplate = parseT("template/dataloader.tmpl")
+
+	// CursorCodecTemplate adds a template for generating the check of the
+	// CursorCodec of the operations, used by the generated pagination.
+	CursorCodecTemplate = parseT("template/cursor_codec.tmpl")
+
 	// MutationInputTemplate adds a template for generating Create<T>Input and Update<T>Input for each schema type.
 	MutationInputTemplate = parseT("template/mutation_input.tmpl").SkipIf(skipMutationTemplate)
 
@@ -77,6 +93,9 @@ var (
 
 	// TemplateFuncs contains the extra template functions used by entgql.
 	TemplateFuncs = template.FuncMap{
+		"aggregateFields":     aggregateFields,
+		"bulkMutations":       nodeBulkMutations,
+		"complexityFields":    complexityFields,
 		"fieldCollections":    fieldCollections,
 		"fieldMapping":        fieldMapping,
 		"filterEdges":         filterEdges,
@@ -88,11 +107,13 @@ var (
 		"hasWhereInput":       hasWhereInput,
 		"isRelayConn":         isRelayConn,
 		"isSkipMode":          isSkipMode,
+		"jsonFilters":         jsonFilters,
 		"mutationInputs":      mutationInputs,
 		"nodeImplementors":    nodeImplementors,
 		"nodeImplementorsVar": nodeImplementorsVar,
 		"nodePaginationNames": nodePaginationNames,
 		"orderFields":         orderFields,
+		"searchFields":        searchFields,
 		"skipMode":            skipModeFromString,
 	}
 
@@ -197,6 +218,9 @@ func fieldCollections(edges []*gen.Edge) ([]*fieldCollection, error) {
 type MutationDescriptor struct {
 	*gen.Type
 	IsCreate bool
+	// Nested indicates that the input is used by the
+	// nested inputs of an edge of another input type.
+	Nested bool
 }
 
 // Input returns the input's name.
@@ -211,6 +235,16 @@ func (m *MutationDescriptor) Input() (string, error) {
 	return fmt.Sprintf("Update%sInput", gqlType), nil
 }
 
+// UpsertInput returns the name of the input for creating
+// or updating a node through the nested input of an edge.
+func (m *MutationDescriptor) UpsertInput() (string, error) {
+	gqlType, _, err := gqlTypeFromNode(m.Type)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Upsert%sInput", gqlType), nil
+}
+
 // Builders return the builder's names to apply the input.
 func (m *MutationDescriptor) Builders() []string {
 	if m.IsCreate {
@@ -283,6 +317,59 @@ func (m *MutationDescriptor) InputEdges() ([]*gen.Edge, error) {
 	return edges, nil
 }
 
+// NestedEdgeDescriptor holds information about an edge
+// that accepts nested inputs in the mutation input.
+type NestedEdgeDescriptor struct {
+	*gen.Edge
+	// Create is the input for creating the nodes of the edge.
+	Create *MutationDescriptor
+	// Update is the input for updating the nodes of the edge.
+	// It is set only for the edges of the update inputs.
+	Update *MutationDescriptor
+}
+
+// NestedEdges returns the edges of the input type that
+// were annotated with the NestedMutations annotation.
+func (m *MutationDescriptor) NestedEdges() ([]*NestedEdgeDescriptor, error) {
+	edges, err := m.InputEdges()
+	if err != nil {
+		return nil, err
+	}
+	var nested []*NestedEdgeDescriptor
+	for _, e := range edges {
+		ant, err := annotation(e.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if !ant.NestedMutations {
+			continue
+		}
+		// Nested nodes are created before their parent, and
+		// cannot require an edge back to the parent node.
+		if !e.OwnFK() && e.Rel.Type != gen.M2M && e.Ref != nil && !e.Ref.Optional {
+			return nil, fmt.Errorf("entgql: nested mutations of edge %s.%s require the edge %s.%s to be optional", m.Name, e.Name, e.Type.Name, e.Ref.Name)
+		}
+		typeAnt, err := annotation(e.Type.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		ne := &NestedEdgeDescriptor{Edge: e}
+		for _, i := range typeAnt.MutationInputs {
+			switch {
+			case i.IsCreate && !typeAnt.Skip.Is(SkipMutationCreateInput):
+				ne.Create = &MutationDescriptor{Type: e.Type, IsCreate: true, Nested: true}
+			case !i.IsCreate && !m.IsCreate && !typeAnt.Skip.Is(SkipMutationUpdateInput):
+				ne.Update = &MutationDescriptor{Type: e.Type, Nested: true}
+			}
+		}
+		if ne.Create == nil || !m.IsCreate && ne.Update == nil {
+			return nil, fmt.Errorf("entgql: nested mutations of edge %s.%s require the mutation inputs of type %s", m.Name, e.Name, e.Type.Name)
+		}
+		nested = append(nested, ne)
+	}
+	return nested, nil
+}
+
 func (m *MutationDescriptor) skip(immutable bool, skip SkipMode) bool {
 	if m.IsCreate {
 		return skip.Is(SkipMutationCreateInput)
@@ -311,9 +398,52 @@ func mutationInputs(nodes []*gen.Type) ([]*MutationDescriptor, error) {
 			})
 		}
 	}
+	// Mark the inputs that are used by the nested inputs of other types.
+	for _, d := range filteredNodes {
+		edges, err := d.NestedEdges()
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range edges {
+			for _, t := range filteredNodes {
+				if t.Type == e.Type && (t.IsCreate || !d.IsCreate) {
+					t.Nested = true
+				}
+			}
+		}
+	}
 	return filteredNodes, nil
 }
 
+// bulkOps describes the bulk mutations generated for a type.
+type bulkOps struct {
+	CreateMany bool
+	UpdateMany bool
+	DeleteMany bool
+}
+
+// nodeBulkMutations returns the bulk mutations of the type, according to
+// its BulkMutations annotation, its mutation inputs and its skip modes.
+func nodeBulkMutations(t *gen.Type) (*bulkOps, error) {
+	ant, err := annotation(t.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	ops := &bulkOps{}
+	if !ant.BulkMutations || ant.Skip.Is(SkipType) {
+		return ops, nil
+	}
+	ops.DeleteMany = !ant.Skip.Is(SkipWhereInput)
+	for _, i := range ant.MutationInputs {
+		if i.IsCreate {
+			ops.CreateMany = !ant.Skip.Is(SkipMutationCreateInput)
+		} else {
+			ops.UpdateMany = ops.DeleteMany && !ant.Skip.Is(SkipMutationUpdateInput)
+		}
+	}
+	return ops, nil
+}
+
 // filterNodes filters out nodes that should not be included in the GraphQL schema.
 func filterNodes(nodes []*gen.Type, skip SkipMode) ([]*gen.Type, error) {
 	filteredNodes := make([]*gen.Type, 0, len(nodes))
@@ -384,6 +514,8 @@ type OrderTerm struct {
 	Edge *gen.Edge
 	// True if it is a count field.
 	Count bool
+	// True if it is the relevance of the full-text search.
+	Search bool
 }
 
 // IsFieldTerm returns true if the order term is a type field term.
@@ -391,6 +523,11 @@ func (o *OrderTerm) IsFieldTerm() bool {
 	return o.Field != nil && o.Edge == nil
 }
 
+// IsSearchTerm returns true if the order term is the search relevance term.
+func (o *OrderTerm) IsSearchTerm() bool {
+	return o.Search
+}
+
 // IsEdgeFieldTerm returns true if the order term is an edge field term.
 func (o *OrderTerm) IsEdgeFieldTerm() bool {
 	return o.Field != nil && o.Edge != nil
@@ -404,6 +541,8 @@ func (o *OrderTerm) IsEdgeCountTerm() bool {
 // VarName returns the name of the variable holding the order term.
 func (o *OrderTerm) VarName() (string, error) {
 	switch prefix := paginationNames(o.Owner.Name).OrderField; {
+	case o.IsSearchTerm():
+		return prefix + "Relevance", nil
 	case o.IsFieldTerm():
 		return prefix + o.Field.StructField(), nil
 	case o.IsEdgeFieldTerm():
@@ -418,6 +557,8 @@ func (o *OrderTerm) VarName() (string, error) {
 // VarField returns the field name inside the variable holding the order term.
 func (o *OrderTerm) VarField() (string, error) {
 	switch {
+	case o.IsSearchTerm():
+		return strconv.Quote(SearchRankColumn), nil
 	case o.IsFieldTerm():
 		return fmt.Sprintf("%s.%s", o.Type.Package(), o.Field.Constant()), nil
 	case o.IsEdgeFieldTerm(), o.IsEdgeCountTerm():
@@ -470,12 +611,12 @@ func orderFields(n *gen.Type) ([]*OrderTerm, error) {
 				Edge:  e,
 				Count: true,
 			})
-		case strings.HasPrefix(ant.OrderField, "EDGEFIELD_"+name+"_"):
+		case strings.HasPrefix(ant.OrderField, name+"_"):
 			// Validate that the edge has a edge field ordering.
 			if _, err := e.OrderFieldName(); err != nil {
 				return nil, fmt.Errorf("entgql: invalid order field %s defined on edge %s.%s: %w", ant.OrderField, n.Name, e.Name, err)
 			}
-			ef := strings.TrimPrefix(ant.OrderField, "EDGEFIELD_"+name+"_")
+			ef := strings.TrimPrefix(ant.OrderField, name+"_")
 			idx := slices.IndexFunc(e.Type.Fields, func(f *gen.Field) bool {
 				ant, err := annotation(f.Annotations)
 				return err == nil && ant.OrderField == ef
@@ -494,6 +635,17 @@ func orderFields(n *gen.Type) ([]*OrderTerm, error) {
 			return nil, fmt.Errorf("entgql: invalid order field defined on edge %s.%s", n.Name, e.Name)
 		}
 	}
+	switch search, err := searchFields(n); {
+	case err != nil:
+		return nil, err
+	case len(search) > 0:
+		terms = append(terms, &OrderTerm{
+			Owner:  n,
+			GQL:    RelevanceOrderField,
+			Type:   n,
+			Search: true,
+		})
+	}
 	return terms, nil
 }
 
@@ -528,6 +680,8 @@ func skipModeFromString(modes ...string) (SkipMode, error) {
 			m |= SkipMutationCreateInput
 		case "mutation_update_input":
 			m |= SkipMutationUpdateInput
+		case "subscription":
+			m |= SkipSubscription
 		default:
 			return 0, fmt.Errorf("invalid skip mode: %s", s)
 		}
@@ -562,6 +716,13 @@ type PaginationNames struct {
 	Order      string
 	OrderField string
 	WhereInput string
+	// Aggregation types, generated for
+	// nodes with the Aggregate annotation.
+	Aggregate        string
+	AggregateNumbers string
+	AggregateGroup   string
+	AggregateKey     string
+	AggregateField   string
 }
 
 func (p *PaginationNames) TypeDefs() []*ast.Definition {
@@ -710,7 +871,290 @@ func paginationNames(node string) *PaginationNames {
 		Order:      fmt.Sprintf("%sOrder", node),
 		OrderField: fmt.Sprintf("%sOrderField", node),
 		WhereInput: fmt.Sprintf("%sWhereInput", node),
+
+		Aggregate:        fmt.Sprintf("%sAggregate", node),
+		AggregateNumbers: fmt.Sprintf("%sAggregateNumbers", node),
+		AggregateGroup:   fmt.Sprintf("%sAggregateGroup", node),
+		AggregateKey:     fmt.Sprintf("%sAggregateGroupKey", node),
+		AggregateField:   fmt.Sprintf("%sAggregateField", node),
+	}
+}
+
+// AggregateFields holds the fields of a node that can be aggregated.
+type AggregateFields struct {
+	// Numeric fields, other than edge fields, that can be summed,
+	// averaged and compared.
+	Numeric []*gen.Field
+	// Group fields, that can be used as group keys.
+	Group []*gen.Field
+}
+
+// aggregateFields returns the fields of the node that can be aggregated,
+// or nil if the node does not have the Aggregate annotation.
+func aggregateFields(t *gen.Type) (*AggregateFields, error) {
+	ant, err := annotation(t.Annotations)
+	if err != nil || !ant.Aggregate {
+		return nil, err
+	}
+	if !ant.RelayConnection {
+		return nil, fmt.Errorf("entgql: Aggregate annotation on type %s requires the RelayConnection annotation", t.Name)
+	}
+	fields := &AggregateFields{}
+	for _, f := range t.Fields {
+		switch ant, err := annotation(f.Annotations); {
+		case err != nil:
+			return nil, err
+		case ant.Skip.Is(SkipType), f.Sensitive():
+		default:
+			if f.Type.Numeric() && !f.IsEdgeField() {
+				fields.Numeric = append(fields.Numeric, f)
+			}
+			if f.IsEnum() || !f.HasGoType() && (f.IsString() || f.IsBool() || f.Type.Numeric()) {
+				fields.Group = append(fields.Group, f)
+			}
+		}
+	}
+	return fields, nil
+}
+
+// GroupValue returns the GraphQL enum value of a group field.
+func (*AggregateFields) GroupValue(f *gen.Field) string {
+	return strings.ToUpper(snake(f.Name))
+}
+
+// searchFields returns the fields of the node that are annotated with
+// Searchable, and included in the full-text search of its WhereInput.
+func searchFields(t *gen.Type) ([]*gen.Field, error) {
+	var fields []*gen.Field
+	for _, f := range t.Fields {
+		switch ant, err := annotation(f.Annotations); {
+		case err != nil:
+			return nil, err
+		case !ant.Searchable:
+		case !f.IsString() || f.Sensitive():
+			return nil, fmt.Errorf("entgql: searchable field %s.%s must be a non-sensitive string field", t.Name, f.Name)
+		case ant.Skip.Is(SkipWhereInput):
+			return nil, fmt.Errorf("entgql: searchable field %s.%s cannot skip the WhereInput", t.Name, f.Name)
+		default:
+			fields = append(fields, f)
+		}
+	}
+	return fields, nil
+}
+
+// JSONFilter describes the WhereInput predicates of a JSON field.
+type JSONFilter struct {
+	*gen.Field
+	// Paths are the typed paths of the field.
+	Paths []*JSONFilterPath
+}
+
+// JSONFilterPath describes a typed path of a JSONFilter.
+type JSONFilterPath struct {
+	// Path in dot notation.
+	Path string
+	// Name of the path in the Go and GraphQL fields (e.g. AuthorName).
+	Name string
+	// GQLType is the GraphQL type of the value, or of the list elements.
+	GQLType string
+	// GoType is the Go type of the value, or of the list elements.
+	GoType string
+	// List indicates the path holds a list of values.
+	List bool
+}
+
+// jsonPathTypes maps the GraphQL types of the JSON paths to Go types.
+var jsonPathTypes = map[string]string{
+	"String":  "string",
+	"ID":      "string",
+	"Int":     "int",
+	"Float":   "float64",
+	"Boolean": "bool",
+}
+
+// jsonFilters returns the JSON fields of the node that are annotated
+// with FilterJSON, and included in its WhereInput.
+func jsonFilters(t *gen.Type) ([]*JSONFilter, error) {
+	var (
+		filters []*JSONFilter
+		names   map[string]bool
+	)
+	// declare reports an error if the given WhereInput field
+	// is already generated for the type.
+	declare := func(f *gen.Field, p, name string) error {
+		if names == nil {
+			var err error
+			if names, err = whereInputFields(t); err != nil {
+				return err
+			}
+		}
+		if names[name] {
+			return fmt.Errorf("entgql: filter %s of JSON path %q of field %s.%s collides with another field of the WhereInput", name, p, t.Name, f.Name)
+		}
+		names[name] = true
+		return nil
+	}
+	for _, f := range t.Fields {
+		switch ant, err := annotation(f.Annotations); {
+		case err != nil:
+			return nil, err
+		case ant.JSONFilter == nil, ant.Skip.Is(SkipWhereInput):
+		case !f.IsJSON():
+			return nil, fmt.Errorf("entgql: FilterJSON annotation is not allowed on non-JSON field %s.%s", t.Name, f.Name)
+		default:
+			filter := &JSONFilter{Field: f}
+			if err := declare(f, "", f.StructField()+"HasKey"); err != nil {
+				return nil, err
+			}
+			for _, p := range ant.JSONFilter.Paths {
+				if !jsonPathRegexp.MatchString(p.Path) {
+					return nil, fmt.Errorf("entgql: invalid JSON path %q of field %s.%s", p.Path, t.Name, f.Name)
+				}
+				path := &JSONFilterPath{
+					Path:    p.Path,
+					Name:    pascal(strings.ReplaceAll(p.Path, ".", "_")),
+					GQLType: strings.TrimSuffix(p.Type, "!"),
+				}
+				if strings.HasPrefix(path.GQLType, "[") && strings.HasSuffix(path.GQLType, "]") {
+					path.List = true
+					path.GQLType = strings.TrimSuffix(strings.Trim(path.GQLType, "[]"), "!")
+				}
+				if path.GoType = jsonPathTypes[path.GQLType]; path.GoType == "" {
+					return nil, fmt.Errorf("entgql: unsupported type %q of JSON path %q of field %s.%s", p.Type, p.Path, t.Name, f.Name)
+				}
+				preds := []string{path.Name}
+				switch {
+				case path.List:
+					preds = []string{path.Name + "ArrayContains"}
+				case path.GQLType == "String":
+					preds = append(preds, path.Name+"Contains")
+				}
+				for _, name := range preds {
+					if err := declare(f, p.Path, f.StructField()+name); err != nil {
+						return nil, err
+					}
+				}
+				filter.Paths = append(filter.Paths, path)
+			}
+			filters = append(filters, filter)
+		}
+	}
+	return filters, nil
+}
+
+// whereInputFields returns the names of the Go fields generated
+// for the WhereInput of the given type, except the JSON filters.
+func whereInputFields(t *gen.Type) (map[string]bool, error) {
+	names := map[string]bool{"Predicates": true, "Not": true, "Or": true, "And": true, "Search": true}
+	var comparable []*gen.Field
+	if t.ID != nil {
+		ant, err := annotation(t.ID.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if !ant.Skip.Is(SkipWhereInput) {
+			comparable = append(comparable, t.ID)
+		}
+	}
+	fields, err := filterFields(t.Fields, SkipWhereInput)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range fields {
+		if f.Type.Comparable() {
+			comparable = append(comparable, f)
+		}
+	}
+	for _, f := range comparable {
+		for _, op := range f.Ops() {
+			if op == gen.EQ {
+				names[f.StructField()] = true
+			} else {
+				names[f.StructField()+op.Name()] = true
+			}
+		}
+	}
+	edges, err := filterEdges(t.Edges, SkipWhereInput)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range edges {
+		names["Has"+e.StructField()] = true
+		names["Has"+e.StructField()+"With"] = true
+	}
+	return names, nil
+}
+
+// complexityField describes a GraphQL field that resolves to
+// nodes, for the complexity functions of the ComplexityTemplate.
+type complexityField struct {
+	// Key of the field, formatted as "<Type>.<field>".
+	Key string
+	// Weight of a node returned by the field.
+	Weight int
+	// Unique indicates the field returns at most one node.
+	Unique bool
+}
+
+// complexityFields returns the query and edge fields of the
+// given nodes, as they are named in the GraphQL schema.
+func complexityFields(nodes []*gen.Type) ([]*complexityField, error) {
+	var fields []*complexityField
+	for _, n := range nodes {
+		if n.HasCompositeID() {
+			continue
+		}
+		gqlType, ant, err := gqlTypeFromNode(n)
+		if err != nil {
+			return nil, err
+		}
+		if ant.Skip.Is(SkipType) {
+			continue
+		}
+		if ant.QueryField != nil {
+			fields = append(fields, &complexityField{
+				Key:    QueryType + "." + ant.QueryField.fieldName(gqlType),
+				Weight: complexityWeight(ant),
+			})
+		}
+		edges, err := filterEdges(n.Edges, SkipType)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range edges {
+			edgeAnt, err := annotation(e.Annotations)
+			if err != nil {
+				return nil, err
+			}
+			typeAnt, err := annotation(e.Type.Annotations)
+			if err != nil {
+				return nil, err
+			}
+			mappings := []string{camel(e.Name)}
+			if len(edgeAnt.Mapping) > 0 {
+				mappings = edgeAnt.Mapping
+			}
+			for _, name := range mappings {
+				fields = append(fields, &complexityField{
+					Key:    gqlType + "." + name,
+					Weight: complexityWeight(typeAnt, edgeAnt),
+					Unique: e.Unique,
+				})
+			}
+		}
+	}
+	return fields, nil
+}
+
+// complexityWeight returns the last weight set by the given annotations, or 1.
+func complexityWeight(ants ...*Annotation) int {
+	weight := 1
+	for _, ant := range ants {
+		if ant.Complexity != nil {
+			weight = *ant.Complexity
+		}
 	}
+	return weight
 }
 
 // removeOldAssets removes files that were generated before v0.1.0.
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
 	"github.com/stretchr/testify/require"
 )
 
@@ -218,3 +219,185 @@ func TestFilterFields(t *testing.T) {
 		},
 	}, fields)
 }
+
+func TestComplexityFields(t *testing.T) {
+	user := &gen.Type{
+		Name: "User",
+		Annotations: map[string]interface{}{
+			annotationName: map[string]interface{}{
+				"Complexity": 3,
+				"QueryField": map[string]interface{}{},
+			},
+		},
+	}
+	user.Edges = []*gen.Edge{
+		{Name: "friends", Type: user},
+		{
+			Name:   "best_friend",
+			Type:   user,
+			Unique: true,
+			Annotations: map[string]interface{}{
+				annotationName: map[string]interface{}{"Complexity": 1},
+			},
+		},
+		{
+			Name: "followers",
+			Type: user,
+			Annotations: map[string]interface{}{
+				annotationName: map[string]interface{}{"Mapping": []string{"followers", "fans"}},
+			},
+		},
+		{
+			Name: "blocked",
+			Type: user,
+			Annotations: map[string]interface{}{
+				annotationName: map[string]interface{}{"Complexity": 0},
+			},
+		},
+		{
+			Name: "secrets",
+			Type: &gen.Type{
+				Name: "Secret",
+				Annotations: map[string]interface{}{
+					annotationName: map[string]interface{}{"Skip": SkipType},
+				},
+			},
+		},
+	}
+	fields, err := complexityFields([]*gen.Type{user})
+	require.NoError(t, err)
+	require.Equal(t, []*complexityField{
+		{Key: "Query.users", Weight: 3},
+		{Key: "User.friends", Weight: 3},
+		{Key: "User.bestFriend", Weight: 1, Unique: true},
+		{Key: "User.followers", Weight: 3},
+		{Key: "User.fans", Weight: 3},
+		{Key: "User.blocked", Weight: 0},
+	}, fields)
+}
+
+func TestOrderFields_Edges(t *testing.T) {
+	orderField := func(name string) map[string]interface{} {
+		return map[string]interface{}{
+			annotationName: map[string]interface{}{"OrderField": name},
+		}
+	}
+	typ := &gen.Type{
+		Name: "Todo",
+		ID:   &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}},
+		Fields: []*gen.Field{
+			{Name: "status", Type: &field.TypeInfo{Type: field.TypeEnum}, Annotations: orderField("STATUS")},
+		},
+	}
+	typ.Edges = []*gen.Edge{
+		{Name: "parent", Type: typ, Unique: true, Annotations: orderField("PARENT_STATUS")},
+		{Name: "children", Type: typ, Annotations: orderField("CHILDREN_COUNT")},
+	}
+	terms, err := orderFields(typ)
+	require.NoError(t, err)
+	require.Len(t, terms, 3)
+	require.True(t, terms[1].IsEdgeFieldTerm())
+	require.Equal(t, "PARENT_STATUS", terms[1].GQL)
+	require.Equal(t, typ.Fields[0], terms[1].Field)
+	require.True(t, terms[2].IsEdgeCountTerm())
+	require.Equal(t, "CHILDREN_COUNT", terms[2].GQL)
+
+	typ.Edges[0].Annotations = orderField("PARENT_TEXT")
+	_, err = orderFields(typ)
+	require.EqualError(t, err, "entgql: order field PARENT_TEXT defined on edge Todo.parent was not found on its reference")
+	typ.Edges[0].Annotations = orderField("EDGEFIELD_PARENT_STATUS")
+	_, err = orderFields(typ)
+	require.EqualError(t, err, "entgql: invalid order field defined on edge Todo.parent")
+	typ.Edges[0].Annotations = nil
+	typ.Edges[1].Annotations = orderField("CHILDREN_STATUS")
+	_, err = orderFields(typ)
+	require.ErrorContains(t, err, "entgql: invalid order field CHILDREN_STATUS defined on edge Todo.children")
+}
+
+func TestSearchFields(t *testing.T) {
+	searchable := map[string]interface{}{
+		annotationName: map[string]interface{}{"Searchable": true},
+	}
+	typ := &gen.Type{
+		Name: "Todo",
+		ID:   &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}},
+		Fields: []*gen.Field{
+			{Name: "title", Type: &field.TypeInfo{Type: field.TypeString}, Annotations: searchable},
+			{Name: "body", Type: &field.TypeInfo{Type: field.TypeString}, Annotations: searchable},
+			{Name: "priority", Type: &field.TypeInfo{Type: field.TypeInt}},
+		},
+	}
+	fields, err := searchFields(typ)
+	require.NoError(t, err)
+	require.Equal(t, []*gen.Field{typ.Fields[0], typ.Fields[1]}, fields)
+	terms, err := orderFields(typ)
+	require.NoError(t, err)
+	require.Len(t, terms, 1)
+	require.True(t, terms[0].IsSearchTerm())
+	require.Equal(t, RelevanceOrderField, terms[0].GQL)
+	name, err := terms[0].VarName()
+	require.NoError(t, err)
+	require.Equal(t, "TodoOrderFieldRelevance", name)
+
+	typ.Fields[2].Annotations = searchable
+	_, err = searchFields(typ)
+	require.EqualError(t, err, "entgql: searchable field Todo.priority must be a non-sensitive string field")
+}
+
+func TestJSONFilters(t *testing.T) {
+	filter := func(paths ...JSONPath) map[string]interface{} {
+		return map[string]interface{}{
+			annotationName: map[string]interface{}{
+				"JSONFilter": map[string]interface{}{"Paths": paths},
+			},
+		}
+	}
+	typ := &gen.Type{
+		Name: "Todo",
+		Fields: []*gen.Field{
+			{
+				Name: "metadata",
+				Type: &field.TypeInfo{Type: field.TypeJSON},
+				Annotations: filter(
+					JSONPath{Path: "author.name", Type: "String"},
+					JSONPath{Path: "tags", Type: "[String!]"},
+				),
+			},
+			{Name: "text", Type: &field.TypeInfo{Type: field.TypeString}},
+		},
+	}
+	filters, err := jsonFilters(typ)
+	require.NoError(t, err)
+	require.Len(t, filters, 1)
+	require.Equal(t, typ.Fields[0], filters[0].Field)
+	require.Equal(t, []*JSONFilterPath{
+		{Path: "author.name", Name: "AuthorName", GQLType: "String", GoType: "string"},
+		{Path: "tags", Name: "Tags", GQLType: "String", GoType: "string", List: true},
+	}, filters[0].Paths)
+
+	typ.Fields[0].Annotations = filter(
+		JSONPath{Path: "author.name", Type: "String"},
+		JSONPath{Path: "author_name", Type: "String"},
+	)
+	_, err = jsonFilters(typ)
+	require.EqualError(t, err, `entgql: filter MetadataAuthorName of JSON path "author_name" of field Todo.metadata collides with another field of the WhereInput`)
+	typ.Fields = append(typ.Fields, &gen.Field{Name: "metadata_tags", Type: &field.TypeInfo{Type: field.TypeString}})
+	typ.Fields[0].Annotations = filter(JSONPath{Path: "tags", Type: "[String]"})
+	_, err = jsonFilters(typ)
+	require.NoError(t, err)
+	typ.Fields[0].Annotations = filter(JSONPath{Path: "tags", Type: "String"})
+	_, err = jsonFilters(typ)
+	require.EqualError(t, err, `entgql: filter MetadataTags of JSON path "tags" of field Todo.metadata collides with another field of the WhereInput`)
+	typ.Fields = typ.Fields[:2]
+
+	typ.Fields[0].Annotations = filter(JSONPath{Path: "author", Type: "Author"})
+	_, err = jsonFilters(typ)
+	require.EqualError(t, err, `entgql: unsupported type "Author" of JSON path "author" of field Todo.metadata`)
+	typ.Fields[0].Annotations = filter(JSONPath{Path: "$.author", Type: "String"})
+	_, err = jsonFilters(typ)
+	require.EqualError(t, err, `entgql: invalid JSON path "$.author" of field Todo.metadata`)
+	typ.Fields[1].Annotations = filter()
+	typ.Fields[0].Annotations = nil
+	_, err = jsonFilters(typ)
+	require.EqualError(t, err, "entgql: FilterJSON annotation is not allowed on non-JSON field Todo.text")
+}
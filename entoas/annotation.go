@@ -42,20 +42,62 @@ type (
 		Delete OperationConfig
 		// List has meta information about a list operation.
 		List OperationConfig
+		// BulkCreate has meta information about a bulk create operation.
+		// Bulk operations are exposed only if their policy is PolicyExpose.
+		BulkCreate OperationConfig
+		// BulkUpdate has meta information about a bulk update operation.
+		BulkUpdate OperationConfig
+		// BulkDelete has meta information about a bulk delete operation.
+		BulkDelete OperationConfig
+		// Attach has meta information about an attach operation on an edge.
+		// Like the create operation on an edge, it is exposed only if its policy is PolicyExpose.
+		Attach OperationConfig
+		// Detach has meta information about a detach operation on an edge.
+		Detach OperationConfig
 		// ReadOnly specifies that the field/edge is read only (no create/update parameter)
 		ReadOnly bool
 		// Skip specifies that the field will be ignored in spec.
 		Skip bool
+		// Filter holds the filter query parameters of the field on list operations.
+		Filter FilterOp
+		// Sortable specifies that list operations can be sorted by the field.
+		Sortable bool
+		// Selectable specifies that the field can be omitted from the list operations
+		// responses using the fields query parameter.
+		Selectable bool
 	}
 	// OperationConfig holds meta information about a REST operation.
 	OperationConfig struct {
 		Policy Policy
 		Groups serialization.Groups
+		// MinItemsPerPage, MaxItemsPerPage and ItemsPerPage override the bounds and
+		// the default of the page size of list operations. Zero values are ignored.
+		MinItemsPerPage int64
+		MaxItemsPerPage int64
+		ItemsPerPage    int64
 	}
 	// OperationConfigOption allows managing OperationConfig using functional arguments.
 	OperationConfigOption func(*OperationConfig)
+	// FilterOp is a bit flag of the filter query parameters of a field.
+	FilterOp uint
 )
 
+const (
+	// FilterEQ adds the "<field>" query parameter, matching the given value.
+	FilterEQ FilterOp = 1 << iota
+	// FilterRange adds the "<field>Gt", "<field>Gte", "<field>Lt" and "<field>Lte"
+	// query parameters. It is allowed only on numeric and time fields.
+	FilterRange
+	// FilterIn adds the "<field>In" query parameter, matching any of the given values.
+	FilterIn
+	// FilterLike adds the "<field>Like" query parameter, matching the values
+	// containing the given substring. It is allowed only on string fields.
+	FilterLike
+)
+
+// Is reports whether o is set on f.
+func (f FilterOp) Is(o FilterOp) bool { return f&o != 0 }
+
 // Groups returns a OperationConfigOption that adds the given serialization groups to a OperationConfig.
 func Groups(gs ...string) Annotation {
 	return Annotation{Groups: gs}
@@ -71,6 +113,21 @@ func OperationPolicy(p Policy) OperationConfigOption {
 	return func(c *OperationConfig) { c.Policy = p }
 }
 
+// OperationMinItemsPerPage returns a OperationConfigOption that overrides the minimum page size of a list operation.
+func OperationMinItemsPerPage(n int) OperationConfigOption {
+	return func(c *OperationConfig) { c.MinItemsPerPage = int64(n) }
+}
+
+// OperationMaxItemsPerPage returns a OperationConfigOption that overrides the maximum page size of a list operation.
+func OperationMaxItemsPerPage(n int) OperationConfigOption {
+	return func(c *OperationConfig) { c.MaxItemsPerPage = int64(n) }
+}
+
+// OperationItemsPerPage returns a OperationConfigOption that sets the default page size of a list operation.
+func OperationItemsPerPage(n int) OperationConfigOption {
+	return func(c *OperationConfig) { c.ItemsPerPage = int64(n) }
+}
+
 // Example returns an example annotation.
 func Example(v interface{}) Annotation { return Annotation{Example: v} }
 
@@ -102,6 +159,33 @@ func ListOperation(opts ...OperationConfigOption) Annotation {
 	return Annotation{List: operationsConfig(opts)}
 }
 
+// BulkCreateOperation returns a bulk create operation annotation.
+func BulkCreateOperation(opts ...OperationConfigOption) Annotation {
+	return Annotation{BulkCreate: operationsConfig(opts)}
+}
+
+// BulkUpdateOperation returns a bulk update operation annotation.
+func BulkUpdateOperation(opts ...OperationConfigOption) Annotation {
+	return Annotation{BulkUpdate: operationsConfig(opts)}
+}
+
+// BulkDeleteOperation returns a bulk delete operation annotation.
+func BulkDeleteOperation(opts ...OperationConfigOption) Annotation {
+	return Annotation{BulkDelete: operationsConfig(opts)}
+}
+
+// AttachOperation returns an attach operation annotation.
+func AttachOperation(opts ...OperationConfigOption) Annotation {
+	return Annotation{Attach: operationsConfig(opts)}
+}
+
+// DetachOperation returns a detach operation annotation. Code generation fails if
+// the operation is exposed on a required unique edge, or on an O2M edge whose
+// inverse is required, as detaching would leave a required edge unset.
+func DetachOperation(opts ...OperationConfigOption) Annotation {
+	return Annotation{Detach: operationsConfig(opts)}
+}
+
 // ReadOnly returns a read only field/edge annotation
 func ReadOnly(readonly bool) Annotation {
 	return Annotation{ReadOnly: readonly}
@@ -112,6 +196,31 @@ func Skip(skip bool) Annotation {
 	return Annotation{Skip: skip}
 }
 
+// Filter returns a field annotation that adds the query parameters
+// of the given filter operations to the list operations. Filters are
+// only allowed on scalar fields, and their parameters must not collide
+// with each other or with the pagination, sort and fields parameters.
+func Filter(ops ...FilterOp) Annotation {
+	var f FilterOp
+	for _, op := range ops {
+		f |= op
+	}
+	return Annotation{Filter: f}
+}
+
+// Sortable returns a field annotation that adds the field to the
+// values of the sort query parameter of the list operations.
+func Sortable(sortable bool) Annotation {
+	return Annotation{Sortable: sortable}
+}
+
+// Selectable returns a field annotation that adds the field to the values of
+// the fields query parameter of the list operations. Since clients can omit
+// it from the responses, the field is an optional property of the schemas.
+func Selectable(selectable bool) Annotation {
+	return Annotation{Selectable: selectable}
+}
+
 func operationsConfig(opts []OperationConfigOption) OperationConfig {
 	c := OperationConfig{}
 	for _, opt := range opts {
@@ -147,12 +256,24 @@ func (a Annotation) Merge(o schema.Annotation) schema.Annotation {
 	a.Update.merge(ant.Update)
 	a.Delete.merge(ant.Delete)
 	a.List.merge(ant.List)
+	a.BulkCreate.merge(ant.BulkCreate)
+	a.BulkUpdate.merge(ant.BulkUpdate)
+	a.BulkDelete.merge(ant.BulkDelete)
+	a.Attach.merge(ant.Attach)
+	a.Detach.merge(ant.Detach)
 	if ant.ReadOnly {
 		a.ReadOnly = true
 	}
 	if ant.Skip {
 		a.Skip = true
 	}
+	a.Filter |= ant.Filter
+	if ant.Sortable {
+		a.Sortable = true
+	}
+	if ant.Selectable {
+		a.Selectable = true
+	}
 	return a
 }
 
@@ -163,6 +284,15 @@ func (op *OperationConfig) merge(other OperationConfig) {
 	if other.Groups != nil {
 		op.Groups = other.Groups
 	}
+	if other.MinItemsPerPage != 0 {
+		op.MinItemsPerPage = other.MinItemsPerPage
+	}
+	if other.MaxItemsPerPage != 0 {
+		op.MaxItemsPerPage = other.MaxItemsPerPage
+	}
+	if other.ItemsPerPage != 0 {
+		op.ItemsPerPage = other.ItemsPerPage
+	}
 }
 
 // Decode from ent.
@@ -36,19 +36,40 @@ func TestAnnotation(t *testing.T) {
 	require.Equal(t, serialization.Groups{"create", "groups"}, a.Groups)
 
 	a = CreateOperation(OperationGroups("create", "groups"), OperationPolicy(PolicyExpose))
-	require.Equal(t, OperationConfig{PolicyExpose, serialization.Groups{"create", "groups"}}, a.Create)
+	require.Equal(t, OperationConfig{Policy: PolicyExpose, Groups: serialization.Groups{"create", "groups"}}, a.Create)
 
 	a = ReadOperation(OperationGroups("read", "groups"), OperationPolicy(PolicyExpose))
-	require.Equal(t, OperationConfig{PolicyExpose, serialization.Groups{"read", "groups"}}, a.Read)
+	require.Equal(t, OperationConfig{Policy: PolicyExpose, Groups: serialization.Groups{"read", "groups"}}, a.Read)
 
 	a = UpdateOperation(OperationGroups("update", "groups"), OperationPolicy(PolicyExpose))
-	require.Equal(t, OperationConfig{PolicyExpose, serialization.Groups{"update", "groups"}}, a.Update)
+	require.Equal(t, OperationConfig{Policy: PolicyExpose, Groups: serialization.Groups{"update", "groups"}}, a.Update)
 
 	a = DeleteOperation(OperationGroups("delete", "groups"), OperationPolicy(PolicyExpose))
-	require.Equal(t, OperationConfig{PolicyExpose, serialization.Groups{"delete", "groups"}}, a.Delete)
+	require.Equal(t, OperationConfig{Policy: PolicyExpose, Groups: serialization.Groups{"delete", "groups"}}, a.Delete)
 
 	a = ListOperation(OperationGroups("list", "groups"), OperationPolicy(PolicyExpose))
-	require.Equal(t, OperationConfig{PolicyExpose, serialization.Groups{"list", "groups"}}, a.List)
+	require.Equal(t, OperationConfig{Policy: PolicyExpose, Groups: serialization.Groups{"list", "groups"}}, a.List)
+
+	f := Filter(FilterEQ, FilterIn)
+	require.True(t, f.Filter.Is(FilterEQ))
+	require.True(t, f.Filter.Is(FilterIn))
+	require.False(t, f.Filter.Is(FilterLike))
+	f = f.Merge(Filter(FilterLike)).(Annotation).Merge(Sortable(true)).(Annotation).Merge(Selectable(true)).(Annotation)
+	require.Equal(t, Annotation{Filter: FilterEQ | FilterIn | FilterLike, Sortable: true, Selectable: true}, f)
+
+	bk := BulkCreateOperation(OperationGroups("bulk", "groups"), OperationPolicy(PolicyExpose))
+	require.Equal(t, OperationConfig{Policy: PolicyExpose, Groups: serialization.Groups{"bulk", "groups"}}, bk.BulkCreate)
+	bk = bk.Merge(BulkUpdateOperation(OperationPolicy(PolicyExpose))).(Annotation).Merge(BulkDeleteOperation(OperationPolicy(PolicyExclude))).(Annotation)
+	require.Equal(t, OperationConfig{Policy: PolicyExpose}, bk.BulkUpdate)
+	require.Equal(t, OperationConfig{Policy: PolicyExclude}, bk.BulkDelete)
+
+	ad := AttachOperation(OperationPolicy(PolicyExpose)).Merge(DetachOperation(OperationPolicy(PolicyExclude))).(Annotation)
+	require.Equal(t, OperationConfig{Policy: PolicyExpose}, ad.Attach)
+	require.Equal(t, OperationConfig{Policy: PolicyExclude}, ad.Detach)
+
+	p := ListOperation(OperationMinItemsPerPage(10), OperationMaxItemsPerPage(100))
+	p = p.Merge(ListOperation(OperationItemsPerPage(50), OperationMaxItemsPerPage(500))).(Annotation)
+	require.Equal(t, OperationConfig{MinItemsPerPage: 10, MaxItemsPerPage: 500, ItemsPerPage: 50}, p.List)
 
 	b := Example("example")
 	require.Equal(t, "example", b.Example)
@@ -0,0 +1,162 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entoas
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// bulkCreateOp returns an ogen.Operation for a bulk create operation on the given node.
+func bulkCreateOp(spec *ogen.Spec, n *gen.Type, allowClientUUIDs bool) (*ogen.Operation, error) {
+	req, err := reqBody(n, OpBulkCreate, allowClientUUIDs)
+	if err != nil {
+		return nil, err
+	}
+	vn, err := ViewName(n, OpBulkCreate)
+	if err != nil {
+		return nil, err
+	}
+	op := ogen.NewOperation().
+		SetSummary(fmt.Sprintf("Create multiple %s", rules.Pluralize(n.Name))).
+		SetDescription(fmt.Sprintf("Creates multiple %s. Every item is created on its own, and the result of every item is returned in the order of the request.", rules.Pluralize(n.Name))).
+		AddTags(n.Name).
+		SetOperationID(string(OpBulkCreate)+n.Name).
+		SetRequestBody(req).
+		AddResponse(
+			strconv.Itoa(http.StatusOK),
+			ogen.NewResponse().
+				SetDescription(fmt.Sprintf("result of every %s to create", n.Name)).
+				SetJSONContent(bulkResultSchema(spec, n.Name+OpBulkCreate.Title(), nil, spec.RefSchema(vn).Schema).AsArray()),
+		).
+		AddNamedResponses(
+			spec.RefResponse(strconv.Itoa(http.StatusBadRequest)),
+			spec.RefResponse(strconv.Itoa(http.StatusInternalServerError)),
+		)
+	return op, nil
+}
+
+// bulkUpdateOp returns an ogen.Operation for a bulk update operation on the given node.
+func bulkUpdateOp(spec *ogen.Spec, n *gen.Type) (*ogen.Operation, error) {
+	req, err := reqBody(n, OpBulkUpdate, false)
+	if err != nil {
+		return nil, err
+	}
+	fps, err := bulkFilterParams(n, OpBulkUpdate)
+	if err != nil {
+		return nil, err
+	}
+	id, err := OgenSchema(n.ID)
+	if err != nil {
+		return nil, err
+	}
+	vn, err := ViewName(n, OpBulkUpdate)
+	if err != nil {
+		return nil, err
+	}
+	op := ogen.NewOperation().
+		SetSummary(fmt.Sprintf("Updates multiple %s", rules.Pluralize(n.Name))).
+		SetDescription(fmt.Sprintf("Updates all the %s matching the filters. At least one filter, or the all parameter, is required. Every item is updated on its own, and the result of every item is returned.", rules.Pluralize(n.Name))).
+		AddTags(n.Name).
+		SetOperationID(string(OpBulkUpdate)+n.Name).
+		AddParameters(fps...).
+		SetRequestBody(req).
+		AddResponse(
+			strconv.Itoa(http.StatusOK),
+			ogen.NewResponse().
+				SetDescription(fmt.Sprintf("result of every matching %s", n.Name)).
+				SetJSONContent(bulkResultSchema(spec, n.Name+OpBulkUpdate.Title(), id, spec.RefSchema(vn).Schema).AsArray()),
+		).
+		AddNamedResponses(
+			spec.RefResponse(strconv.Itoa(http.StatusBadRequest)),
+			spec.RefResponse(strconv.Itoa(http.StatusInternalServerError)),
+		)
+	return op, nil
+}
+
+// bulkDeleteOp returns an ogen.Operation for a bulk delete operation on the given node.
+func bulkDeleteOp(spec *ogen.Spec, n *gen.Type) (*ogen.Operation, error) {
+	fps, err := bulkFilterParams(n, OpBulkDelete)
+	if err != nil {
+		return nil, err
+	}
+	id, err := OgenSchema(n.ID)
+	if err != nil {
+		return nil, err
+	}
+	op := ogen.NewOperation().
+		SetSummary(fmt.Sprintf("Deletes multiple %s", rules.Pluralize(n.Name))).
+		SetDescription(fmt.Sprintf("Deletes all the %s matching the filters. At least one filter, or the all parameter, is required. Every item is deleted on its own, and the result of every item is returned.", rules.Pluralize(n.Name))).
+		AddTags(n.Name).
+		SetOperationID(string(OpBulkDelete)+n.Name).
+		AddParameters(fps...).
+		AddResponse(
+			strconv.Itoa(http.StatusOK),
+			ogen.NewResponse().
+				SetDescription(fmt.Sprintf("result of every matching %s", n.Name)).
+				SetJSONContent(bulkResultSchema(spec, n.Name+OpBulkDelete.Title(), id, nil).AsArray()),
+		).
+		AddNamedResponses(
+			spec.RefResponse(strconv.Itoa(http.StatusBadRequest)),
+			spec.RefResponse(strconv.Itoa(http.StatusInternalServerError)),
+		)
+	return op, nil
+}
+
+// AllParam is the name of the query parameter applying a bulk operation to all the items.
+const AllParam = "all"
+
+// bulkFilterParams returns the filter query parameters of a bulk operation on the given node.
+// Since a bulk operation without a filter would affect all the nodes, requests have to set at
+// least one of the filters, or opt in to affect all the nodes with the all parameter.
+func bulkFilterParams(n *gen.Type, op Operation) ([]*ogen.Parameter, error) {
+	ps, err := nodeFilterParams(n)
+	if err != nil {
+		return nil, err
+	}
+	if len(ps) == 0 {
+		return nil, fmt.Errorf("%s operation on %s requires at least one field annotated with Filter", op, n.Name)
+	}
+	return append(ps, ogen.NewParameter().
+		InQuery().
+		SetName(AllParam).
+		SetDescription(fmt.Sprintf("must be true to apply the operation to all the %s if no filter is given, such requests are rejected otherwise", rules.Pluralize(n.Name))).
+		SetSchema(ogen.Bool()),
+	), nil
+}
+
+// bulkResultSchema returns a reference to the per-item result of a bulk operation, and adds it
+// to the spec if it does not exist yet. The result holds the HTTP status of the item, the error
+// if the operation failed on it, and its ID and rendered view if they are given.
+func bulkResultSchema(spec *ogen.Spec, name string, id, item *ogen.Schema) *ogen.Schema {
+	rn := name + "Result"
+	if spec.Components == nil || spec.Components.Schemas[rn] == nil {
+		s := ogen.NewSchema().
+			AddRequiredProperties(ogen.Int().ToProperty("status")).
+			AddOptionalProperties(errorSchema().ToProperty("error"))
+		if id != nil {
+			s.AddRequiredProperties(id.ToProperty("id"))
+		}
+		if item != nil {
+			s.AddOptionalProperties(item.ToProperty("item"))
+		}
+		spec.AddSchema(rn, s)
+	}
+	return spec.RefSchema(rn).Schema
+}
@@ -0,0 +1,87 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entoas
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	entfield "entgo.io/ent/schema/field"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkOperations(t *testing.T) {
+	t.Parallel()
+	cfg := exposeConfig()
+	n := &gen.Type{Name: "Pet", Config: cfg}
+	ops, err := NodeOperations(n)
+	require.NoError(t, err)
+	require.Equal(t, []Operation{OpCreate, OpRead, OpUpdate, OpDelete, OpList}, ops)
+
+	// Bulk operations are not exposed by the default policy.
+	n.Annotations = gen.Annotations{Annotation{}.Name(): ListOperation(OperationGroups("list"))}
+	ops, err = NodeOperations(n)
+	require.NoError(t, err)
+	require.NotContains(t, ops, OpBulkCreate)
+	require.NotContains(t, ops, OpBulkUpdate)
+	require.NotContains(t, ops, OpBulkDelete)
+
+	n.Annotations = gen.Annotations{Annotation{}.Name(): BulkCreateOperation(OperationPolicy(PolicyExpose)).
+		Merge(BulkDeleteOperation(OperationPolicy(PolicyExpose)))}
+	ops, err = NodeOperations(n)
+	require.NoError(t, err)
+	require.Contains(t, ops, OpBulkCreate)
+	require.NotContains(t, ops, OpBulkUpdate)
+	require.Contains(t, ops, OpBulkDelete)
+}
+
+func TestBulkFilterParams(t *testing.T) {
+	t.Parallel()
+	n := &gen.Type{
+		Name: "Pet",
+		ID:   &gen.Field{Name: "id", Type: &entfield.TypeInfo{Type: entfield.TypeInt}},
+		Fields: []*gen.Field{
+			{Name: "name", Type: &entfield.TypeInfo{Type: entfield.TypeString}},
+		},
+	}
+	_, err := bulkFilterParams(n, OpBulkDelete)
+	require.EqualError(t, err, "bulkDelete operation on Pet requires at least one field annotated with Filter")
+
+	n.Fields[0].Annotations = gen.Annotations{Annotation{}.Name(): Filter(FilterEQ)}
+	ps, err := bulkFilterParams(n, OpBulkDelete)
+	require.NoError(t, err)
+	require.Len(t, ps, 2)
+	require.Equal(t, "name", ps[0].Name)
+	require.Equal(t, AllParam, ps[1].Name)
+	require.Equal(t, ogen.Bool(), ps[1].Schema)
+	require.False(t, ps[1].Required)
+}
+
+func TestBulkResultSchema(t *testing.T) {
+	t.Parallel()
+	spec := ogen.NewSpec()
+	spec.AddSchema("PetBulkUpdate", ogen.NewSchema())
+	s := bulkResultSchema(spec, "PetBulkUpdate", ogen.Int(), spec.RefSchema("PetBulkUpdate").Schema)
+	require.Equal(t, spec.RefSchema("PetBulkUpdateResult").Schema, s)
+	r := spec.Components.Schemas["PetBulkUpdateResult"]
+	require.ElementsMatch(t, []string{"status", "id"}, r.Required)
+	require.Len(t, r.Properties, 4)
+
+	bulkResultSchema(spec, "PetBulkDelete", ogen.Int(), nil)
+	r = spec.Components.Schemas["PetBulkDeleteResult"]
+	require.ElementsMatch(t, []string{"status", "id"}, r.Required)
+	require.Len(t, r.Properties, 3)
+}
@@ -46,6 +46,11 @@ type (
 		// When enabled, allows the built-in "id" field as part of the POST payload for entity creation, allowing the client to supply UUIDs as primary keys and for idempotency.
 		// Defaults to false.
 		AllowClientUUIDs bool
+		// When enabled, list and edge-list operations are paginated using opaque cursors instead of pages.
+		// The items are wrapped in an envelope with the "items", "nextCursor", "prevCursor" and the
+		// optional "totalCount" properties. The cursors follow the keyset cursors of entgql.
+		// Defaults to false.
+		CursorPagination bool
 		// Specify the minimum amount of itemsPerPage allowed in generated pagination.
 		// Defaults to 1.
 		MinItemsPerPage int64
@@ -147,6 +152,16 @@ func AllowClientUUIDs() ExtensionOption {
 	}
 }
 
+// CursorPagination enables the cursor based pagination of list operations.
+//
+// Further information can be found at Config.CursorPagination.
+func CursorPagination() ExtensionOption {
+	return func(ex *Extension) error {
+		ex.config.CursorPagination = true
+		return nil
+	}
+}
+
 // WriteTo writes the current specs content to the given io.Writer.
 func WriteTo(out io.Writer) ExtensionOption {
 	return func(ex *Extension) error {
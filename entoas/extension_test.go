@@ -29,6 +29,7 @@ func TestExtension(t *testing.T) {
 		DefaultPolicy(PolicyExpose),
 		MinItemsPerPage(20),
 		MaxItemsPerPage(40),
+		CursorPagination(),
 		Mutations(func(_ *gen.Graph, spec *ogen.Spec) error {
 			spec.Info.
 				SetTitle("Spec Title").
@@ -44,4 +45,5 @@ func TestExtension(t *testing.T) {
 	require.Equal(t, os.Stdout, ex.out)
 	require.Equal(t, int64(20), ex.config.MinItemsPerPage)
 	require.Equal(t, int64(40), ex.config.MaxItemsPerPage)
+	require.True(t, ex.config.CursorPagination)
 }
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"math"
 	"net/http"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -39,6 +40,13 @@ const (
 	OpUpdate Operation = "update"
 	OpDelete Operation = "delete"
 	OpList   Operation = "list"
+
+	OpBulkCreate Operation = "bulkCreate"
+	OpBulkUpdate Operation = "bulkUpdate"
+	OpBulkDelete Operation = "bulkDelete"
+
+	OpAttach Operation = "attach"
+	OpDetach Operation = "detach"
 )
 
 func generate(g *gen.Graph, spec *ogen.Spec) error {
@@ -54,10 +62,15 @@ func generate(g *gen.Graph, spec *ogen.Spec) error {
 
 // schemas adds schemas for every node to the spec.
 func schemas(g *gen.Graph, spec *ogen.Spec) error {
-	// Loop over every defined node and add it to the spec.
+	cfg, err := GetConfig(g.Config)
+	if err != nil {
+		return err
+	}
+	// Loop over every defined node and add it to the spec. The SimpleModels
+	// feature renders the list operations with these schemas too.
 	for _, n := range g.Nodes {
 		s := ogen.NewSchema()
-		if err := addSchemaFields(s, append([]*gen.Field{n.ID}, n.Fields...)); err != nil {
+		if err := addSchemaFields(s, append([]*gen.Field{n.ID}, n.Fields...), cfg.SimpleModels); err != nil {
 			return err
 		}
 		spec.AddSchema(n.Name, s)
@@ -81,10 +94,6 @@ func schemas(g *gen.Graph, spec *ogen.Spec) error {
 		}
 	}
 	// If the SimpleModels feature is enabled to not generate a schema per response.
-	cfg, err := GetConfig(g.Config)
-	if err != nil {
-		return err
-	}
 	if !cfg.SimpleModels {
 		// Add all the views for the paths to the schemas.
 		vs, err := Views(g)
@@ -93,7 +102,7 @@ func schemas(g *gen.Graph, spec *ogen.Spec) error {
 		}
 		for n, v := range vs {
 			s := ogen.NewSchema()
-			if err := addSchemaFields(s, v.Fields); err != nil {
+			if err := addSchemaFields(s, v.Fields, v.List); err != nil {
 				return err
 			}
 			spec.AddSchema(n, s)
@@ -124,8 +133,9 @@ func schemas(g *gen.Graph, spec *ogen.Spec) error {
 	return nil
 }
 
-// addSchemaFields adds the given gen.Field slice to the ogen.Schema.
-func addSchemaFields(s *ogen.Schema, fs []*gen.Field) error {
+// addSchemaFields adds the given gen.Field slice to the ogen.Schema. If list is set,
+// the schema is the item of a list operation and the selectable fields are optional.
+func addSchemaFields(s *ogen.Schema, fs []*gen.Field, list bool) error {
 	for _, f := range fs {
 		ant, err := FieldAnnotation(f)
 		if err != nil {
@@ -138,7 +148,8 @@ func addSchemaFields(s *ogen.Schema, fs []*gen.Field) error {
 		if err != nil {
 			return err
 		}
-		addProperty(s, p, !(f.Optional || f.Nillable))
+		// Selectable fields can be omitted from the list responses.
+		addProperty(s, p, !(f.Optional || f.Nillable || list && ant.Selectable))
 	}
 	return nil
 }
@@ -152,6 +163,18 @@ func addProperty(s *ogen.Schema, p *ogen.Property, req bool) {
 	}
 }
 
+// errorSchema returns the schema of an error.
+func errorSchema() *ogen.Schema {
+	return ogen.NewSchema().
+		AddRequiredProperties(
+			ogen.Int().ToProperty("code"),
+			ogen.String().ToProperty("status"),
+		).
+		AddOptionalProperties(
+			ogen.NewSchema().ToProperty("errors"),
+		)
+}
+
 // errResponses adds all responses to the spec responses.
 func errorResponses(s *ogen.Spec) {
 	for c, d := range map[int]string{
@@ -165,15 +188,7 @@ func errorResponses(s *ogen.Spec) {
 			strconv.Itoa(c),
 			ogen.NewResponse().
 				SetDescription(d).
-				SetJSONContent(ogen.NewSchema().
-					AddRequiredProperties(
-						ogen.Int().ToProperty("code"),
-						ogen.String().ToProperty("status"),
-					).
-					AddOptionalProperties(
-						ogen.NewSchema().ToProperty("errors"),
-					),
-				), // TODO(masseelch): Add examples once present https://github.com/ogen-go/ogen/issues/70
+				SetJSONContent(errorSchema()), // TODO(masseelch): Add examples once present https://github.com/ogen-go/ogen/issues/70
 		)
 	}
 }
@@ -230,6 +245,25 @@ func paths(g *gen.Graph, spec *ogen.Spec) error {
 				return err
 			}
 		}
+		// Bulk operations.
+		if contains(ops, OpBulkCreate) {
+			path(spec, root+"/bulk").Post, err = bulkCreateOp(spec, n, cfg.AllowClientUUIDs)
+			if err != nil {
+				return err
+			}
+		}
+		if contains(ops, OpBulkUpdate) {
+			path(spec, root).Patch, err = bulkUpdateOp(spec, n)
+			if err != nil {
+				return err
+			}
+		}
+		if contains(ops, OpBulkDelete) {
+			path(spec, root).Delete, err = bulkDeleteOp(spec, n)
+			if err != nil {
+				return err
+			}
+		}
 		// Sub-Resource operations.
 		for _, e := range n.Edges {
 			subRoot := root + "/{id}/" + strcase.KebabCase(e.Name)
@@ -251,6 +285,27 @@ func paths(g *gen.Graph, spec *ogen.Spec) error {
 					return err
 				}
 			}
+			// Create operation.
+			if contains(ops, OpCreate) {
+				path(spec, subRoot).Post, err = createEdgeOp(spec, n, e, cfg.AllowClientUUIDs)
+				if err != nil {
+					return err
+				}
+			}
+			// Attach operation.
+			if contains(ops, OpAttach) {
+				path(spec, subRoot+"/{edgeId}").Put, err = attachEdgeOp(spec, n, e)
+				if err != nil {
+					return err
+				}
+			}
+			// Detach operation.
+			if contains(ops, OpDetach) {
+				path(spec, subRoot+"/{edgeId}").Delete, err = detachEdgeOp(spec, n, e)
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
@@ -434,31 +489,30 @@ func listOp(spec *ogen.Spec, n *gen.Type) (*ogen.Operation, error) {
 	if err != nil {
 		return nil, err
 	}
+	qps, err := queryParams(n)
+	if err != nil {
+		return nil, err
+	}
+	ant, err := SchemaAnnotation(n)
+	if err != nil {
+		return nil, err
+	}
+	pps, res, err := paginationParams(spec, cfg, vn, ant.List)
+	if err != nil {
+		return nil, fmt.Errorf("list operation on %s: %w", n.Name, err)
+	}
 	op := ogen.NewOperation().
 		SetSummary(fmt.Sprintf("List %s", rules.Pluralize(n.Name))).
 		SetDescription(fmt.Sprintf("List %s.", rules.Pluralize(n.Name))).
 		AddTags(n.Name).
 		SetOperationID(string(OpList)+n.Name).
-		AddParameters( // TODO(masseelch): Add cursor based pagination to entoas and ogent.
-			ogen.NewParameter().
-				InQuery().
-				SetName("page").
-				SetDescription("what page to render").
-				SetSchema(ogen.Int().SetMinimum(&one)),
-			ogen.NewParameter().
-				InQuery().
-				SetName("itemsPerPage").
-				SetDescription("item count to render per page").
-				SetSchema(ogen.Int().
-					SetMinimum(&cfg.MinItemsPerPage).
-					SetMaximum(&cfg.MaxItemsPerPage),
-				),
-		).
+		AddParameters(pps...).
+		AddParameters(qps...).
 		AddResponse(
 			strconv.Itoa(http.StatusOK),
 			ogen.NewResponse().
 				SetDescription(fmt.Sprintf("result %s list", n.Name)).
-				SetJSONContent(spec.RefSchema(vn).Schema.AsArray()),
+				SetJSONContent(res),
 		).
 		AddNamedResponses(
 			spec.RefResponse(strconv.Itoa(http.StatusBadRequest)),
@@ -474,6 +528,10 @@ func listEdgeOp(spec *ogen.Spec, n *gen.Type, e *gen.Edge) (*ogen.Operation, err
 	if e.Unique {
 		return nil, errors.New("list operations are not allowed on unique edges")
 	}
+	cfg, err := GetConfig(n.Config)
+	if err != nil {
+		return nil, err
+	}
 	id, err := pathParam(n)
 	if err != nil {
 		return nil, err
@@ -482,29 +540,135 @@ func listEdgeOp(spec *ogen.Spec, n *gen.Type, e *gen.Edge) (*ogen.Operation, err
 	if err != nil {
 		return nil, err
 	}
+	qps, err := queryParams(e.Type)
+	if err != nil {
+		return nil, err
+	}
+	tant, err := SchemaAnnotation(e.Type)
+	if err != nil {
+		return nil, err
+	}
+	eant, err := EdgeAnnotation(e)
+	if err != nil {
+		return nil, err
+	}
+	// The bounds of the edge override the ones of the listed node.
+	pps, res, err := paginationParams(spec, cfg, vn, tant.List, eant.List)
+	if err != nil {
+		return nil, fmt.Errorf("list operation on edge %s of %s: %w", e.Name, n.Name, err)
+	}
 	op := ogen.NewOperation().
 		SetSummary(fmt.Sprintf("List attached %s", rules.Pluralize(strcase.UpperCamelCase(e.Name)))).
 		SetDescription(fmt.Sprintf("List attached %s.", rules.Pluralize(strcase.UpperCamelCase(e.Name)))).
 		AddTags(n.Name).
 		SetOperationID(string(OpList)+n.Name+strcase.UpperCamelCase(e.Name)).
-		AddParameters( // TODO(masseelch): Add cursor based pagination to entoas and ogent.
-			id,
-			ogen.NewParameter().
-				InQuery().
-				SetName("page").
-				SetDescription("what page to render").
-				SetSchema(ogen.Int()),
-			ogen.NewParameter().
-				InQuery().
-				SetName("itemsPerPage").
-				SetDescription("item count to render per page").
-				SetSchema(ogen.Int()),
-		).
+		AddParameters(id).
+		AddParameters(pps...).
+		AddParameters(qps...).
 		AddResponse(
 			strconv.Itoa(http.StatusOK),
 			ogen.NewResponse().
 				SetDescription(fmt.Sprintf("result %s list", rules.Pluralize(strcase.UpperCamelCase(n.Name)))).
-				SetJSONContent(spec.RefSchema(vn).Schema.AsArray()),
+				SetJSONContent(res),
+		).
+		AddNamedResponses(
+			spec.RefResponse(strconv.Itoa(http.StatusBadRequest)),
+			spec.RefResponse(strconv.Itoa(http.StatusConflict)),
+			spec.RefResponse(strconv.Itoa(http.StatusNotFound)),
+			spec.RefResponse(strconv.Itoa(http.StatusInternalServerError)),
+		)
+	return op, nil
+}
+
+// createEdgeOp returns the spec description for a create operation on a subresource.
+// The created entity is attached to the node with the given ID.
+func createEdgeOp(spec *ogen.Spec, n *gen.Type, e *gen.Edge, allowClientUUIDs bool) (*ogen.Operation, error) {
+	if err := writableEdge(n, e); err != nil {
+		return nil, err
+	}
+	// The back-reference to the node is set by the operation.
+	req, err := reqBody(e.Type, OpCreate, allowClientUUIDs, e.Ref)
+	if err != nil {
+		return nil, err
+	}
+	id, err := pathParam(n)
+	if err != nil {
+		return nil, err
+	}
+	vn, err := EdgeViewName(n, e, OpCreate)
+	if err != nil {
+		return nil, err
+	}
+	op := ogen.NewOperation().
+		SetSummary(fmt.Sprintf("Create a new %s and attach it", e.Type.Name)).
+		SetDescription(fmt.Sprintf("Creates a new %s and attaches it to the %s with the given ID.", e.Type.Name, n.Name)).
+		AddTags(n.Name).
+		SetOperationID(string(OpCreate)+n.Name+strcase.UpperCamelCase(e.Name)).
+		AddParameters(id).
+		SetRequestBody(req).
+		AddResponse(
+			strconv.Itoa(http.StatusOK),
+			ogen.NewResponse().
+				SetDescription(fmt.Sprintf("%s created and attached to %s with requested ID", e.Type.Name, n.Name)).
+				SetJSONContent(spec.RefSchema(vn).Schema),
+		).
+		AddNamedResponses(
+			spec.RefResponse(strconv.Itoa(http.StatusBadRequest)),
+			spec.RefResponse(strconv.Itoa(http.StatusConflict)),
+			spec.RefResponse(strconv.Itoa(http.StatusNotFound)),
+			spec.RefResponse(strconv.Itoa(http.StatusInternalServerError)),
+		)
+	return op, nil
+}
+
+// attachEdgeOp returns the spec description for an attach operation on a subresource.
+func attachEdgeOp(spec *ogen.Spec, n *gen.Type, e *gen.Edge) (*ogen.Operation, error) {
+	if err := writableEdge(n, e); err != nil {
+		return nil, err
+	}
+	ps, err := edgePathParams(n, e)
+	if err != nil {
+		return nil, err
+	}
+	op := ogen.NewOperation().
+		SetSummary(fmt.Sprintf("Attach a %s", e.Type.Name)).
+		SetDescription(fmt.Sprintf("Attaches the %s with the given edge ID to the %s with the given ID.", e.Type.Name, n.Name)).
+		AddTags(n.Name).
+		SetOperationID(string(OpAttach)+n.Name+strcase.UpperCamelCase(e.Name)).
+		AddParameters(ps...).
+		AddResponse(
+			strconv.Itoa(http.StatusNoContent),
+			ogen.NewResponse().
+				SetDescription(fmt.Sprintf("%s attached to %s with requested ID", e.Type.Name, n.Name)),
+		).
+		AddNamedResponses(
+			spec.RefResponse(strconv.Itoa(http.StatusBadRequest)),
+			spec.RefResponse(strconv.Itoa(http.StatusConflict)),
+			spec.RefResponse(strconv.Itoa(http.StatusNotFound)),
+			spec.RefResponse(strconv.Itoa(http.StatusInternalServerError)),
+		)
+	return op, nil
+}
+
+// detachEdgeOp returns the spec description for a detach operation on a subresource.
+func detachEdgeOp(spec *ogen.Spec, n *gen.Type, e *gen.Edge) (*ogen.Operation, error) {
+	if err := detachableEdge(n, e); err != nil {
+		return nil, err
+	}
+	ps, err := edgePathParams(n, e)
+	if err != nil {
+		return nil, err
+	}
+	op := ogen.NewOperation().
+		SetSummary(fmt.Sprintf("Detach a %s", e.Type.Name)).
+		SetDescription(fmt.Sprintf("Detaches the %s with the given edge ID from the %s with the given ID.", e.Type.Name, n.Name)).
+		AddTags(n.Name).
+		SetOperationID(string(OpDetach)+n.Name+strcase.UpperCamelCase(e.Name)).
+		AddParameters(ps...).
+		AddResponse(
+			strconv.Itoa(http.StatusNoContent),
+			ogen.NewResponse().
+				SetDescription(fmt.Sprintf("%s detached from %s with requested ID", e.Type.Name, n.Name)),
 		).
 		AddNamedResponses(
 			spec.RefResponse(strconv.Itoa(http.StatusBadRequest)),
@@ -515,6 +679,54 @@ func listEdgeOp(spec *ogen.Spec, n *gen.Type, e *gen.Edge) (*ogen.Operation, err
 	return op, nil
 }
 
+// writableEdge returns an error if the given edge does not allow write operations.
+func writableEdge(n *gen.Type, e *gen.Edge) error {
+	ant, err := EdgeAnnotation(e)
+	if err != nil {
+		return err
+	}
+	if ant.ReadOnly || e.Immutable {
+		return fmt.Errorf("write operations are not allowed on read only edge %s of %s", e.Name, n.Name)
+	}
+	return nil
+}
+
+// detachableEdge returns an error if the given edge does not allow detach operations,
+// as detaching would leave the node, or the detached entity, without a required edge.
+func detachableEdge(n *gen.Type, e *gen.Edge) error {
+	if err := writableEdge(n, e); err != nil {
+		return err
+	}
+	if e.Unique && !e.Optional {
+		return fmt.Errorf("detach operation is not allowed on required edge %s of %s", e.Name, n.Name)
+	}
+	if e.O2M() && e.Ref != nil && !e.Ref.Optional {
+		return fmt.Errorf("detach operation is not allowed on edge %s of %s, as its inverse edge %s of %s is required", e.Name, n.Name, e.Ref.Name, e.Type.Name)
+	}
+	return nil
+}
+
+// edgePathParams returns the path parameters identifying a node and an entity attached to it over the given edge.
+func edgePathParams(n *gen.Type, e *gen.Edge) ([]*ogen.Parameter, error) {
+	id, err := pathParam(n)
+	if err != nil {
+		return nil, err
+	}
+	t, err := OgenSchema(e.Type.ID)
+	if err != nil {
+		return nil, err
+	}
+	return []*ogen.Parameter{
+		id,
+		ogen.NewParameter().
+			InPath().
+			SetName("edgeId").
+			SetDescription(fmt.Sprintf("ID of the attached %s", e.Type.Name)).
+			SetRequired(true).
+			SetSchema(t),
+	}, nil
+}
+
 // property creates an ogen.Property out of an ent schema field.
 func property(f *gen.Field) (*ogen.Property, error) {
 	s, err := OgenSchema(f)
@@ -631,6 +843,16 @@ func NodeOperations(n *gen.Type) ([]Operation, error) {
 				continue
 			}
 		}
+		// Bulk operations are opt-in and exposed only if explicitly annotated.
+		for op, opn := range map[Operation]OperationConfig{
+			OpBulkCreate: ant.BulkCreate,
+			OpBulkUpdate: ant.BulkUpdate,
+			OpBulkDelete: ant.BulkDelete,
+		} {
+			if opn.Policy == PolicyExpose {
+				ops = append(ops, op)
+			}
+		}
 		sort.Slice(ops, func(i, j int) bool {
 			return ops[i] < ops[j]
 		})
@@ -675,6 +897,16 @@ func EdgeOperations(e *gen.Edge) ([]Operation, error) {
 				continue
 			}
 		}
+		// Write operations on edges are opt-in and exposed only if explicitly annotated.
+		for op, opn := range map[Operation]OperationConfig{
+			OpCreate: ant.Create,
+			OpAttach: ant.Attach,
+			OpDetach: ant.Detach,
+		} {
+			if opn.Policy == PolicyExpose {
+				ops = append(ops, op)
+			}
+		}
 		sort.Slice(ops, func(i, j int) bool {
 			return ops[i] < ops[j]
 		})
@@ -683,11 +915,13 @@ func EdgeOperations(e *gen.Edge) ([]Operation, error) {
 }
 
 // reqBody returns the request body for the given node and operation.
-func reqBody(n *gen.Type, op Operation, allowClientUUIDs bool) (*ogen.RequestBody, error) {
+// The given edges, if any, are not part of the request body.
+func reqBody(n *gen.Type, op Operation, allowClientUUIDs bool, skip ...*gen.Edge) (*ogen.RequestBody, error) {
 	req := ogen.NewRequestBody().SetRequired(true)
 	c := ogen.NewSchema()
+	create := op == OpCreate || op == OpBulkCreate
 	switch op {
-	case OpCreate:
+	case OpCreate, OpBulkCreate:
 		// add the ID field as client setable if it is a UUID.
 		if allowClientUUIDs && n.ID.Type.Type == field.TypeUUID {
 			p, err := property(n.ID)
@@ -698,8 +932,13 @@ func reqBody(n *gen.Type, op Operation, allowClientUUIDs bool) (*ogen.RequestBod
 		}
 
 		req.SetDescription(fmt.Sprintf("%s to create", n.Name))
+		if op == OpBulkCreate {
+			req.SetDescription(fmt.Sprintf("%s to create", rules.Pluralize(n.Name)))
+		}
 	case OpUpdate:
 		req.SetDescription(fmt.Sprintf("%s properties to update", n.Name))
+	case OpBulkUpdate:
+		req.SetDescription(fmt.Sprintf("%s properties to update on all the matching %s", n.Name, rules.Pluralize(n.Name)))
 	default:
 		return nil, fmt.Errorf("requestBody: unsupported operation %q", op)
 	}
@@ -711,15 +950,18 @@ func reqBody(n *gen.Type, op Operation, allowClientUUIDs bool) (*ogen.RequestBod
 		if a.ReadOnly || a.Skip {
 			continue
 		}
-		if op == OpCreate || !f.Immutable {
+		if create || !f.Immutable {
 			p, err := property(f)
 			if err != nil {
 				return nil, err
 			}
-			addProperty(c, p, op == OpCreate && !f.Optional)
+			addProperty(c, p, create && !f.Optional)
 		}
 	}
 	for _, e := range n.Edges {
+		if slices.Contains(skip, e) {
+			continue
+		}
 		s, err := OgenSchema(e.Type.ID)
 		if err != nil {
 			return nil, err
@@ -727,7 +969,10 @@ func reqBody(n *gen.Type, op Operation, allowClientUUIDs bool) (*ogen.RequestBod
 		if !e.Unique {
 			s = s.AsArray()
 		}
-		addProperty(c, s.ToProperty(e.Name), op == OpCreate && !e.Optional)
+		addProperty(c, s.ToProperty(e.Name), create && !e.Optional)
+	}
+	if op == OpBulkCreate {
+		c = c.AsArray()
 	}
 	req.SetJSONContent(c)
 	return req, nil
@@ -109,6 +109,28 @@ func TestOperation_Title(t *testing.T) {
 	require.Equal(t, "Update", OpUpdate.Title())
 	require.Equal(t, "Delete", OpDelete.Title())
 	require.Equal(t, "List", OpList.Title())
+	require.Equal(t, "BulkCreate", OpBulkCreate.Title())
+	require.Equal(t, "BulkUpdate", OpBulkUpdate.Title())
+	require.Equal(t, "BulkDelete", OpBulkDelete.Title())
+}
+
+func TestAddSchemaFields(t *testing.T) {
+	t.Parallel()
+	fs := []*gen.Field{
+		{Name: "name", Type: &entfield.TypeInfo{Type: entfield.TypeString}},
+		{
+			Name:        "age",
+			Type:        &entfield.TypeInfo{Type: entfield.TypeInt},
+			Annotations: gen.Annotations{Annotation{}.Name(): Selectable(true)},
+		},
+	}
+	s := ogen.NewSchema()
+	require.NoError(t, addSchemaFields(s, fs, false))
+	require.Equal(t, []string{"name", "age"}, s.Required)
+	s = ogen.NewSchema()
+	require.NoError(t, addSchemaFields(s, fs, true))
+	require.Equal(t, []string{"name"}, s.Required)
+	require.Len(t, s.Properties, 2)
 }
 
 type Link struct {
@@ -141,3 +163,57 @@ func (l Link) Value() (driver.Value, error) {
 	}
 	return l.String(), nil
 }
+
+// exposeConfig returns a codegen config exposing all operations by default.
+func exposeConfig() *gen.Config {
+	return &gen.Config{Annotations: gen.Annotations{Config{}.Name(): Config{DefaultPolicy: PolicyExpose}}}
+}
+
+func TestEdgeWriteOperations(t *testing.T) {
+	t.Parallel()
+	cfg := exposeConfig()
+	owner := &gen.Type{Name: "User", Config: cfg, ID: &gen.Field{Name: "id", Type: &entfield.TypeInfo{Type: entfield.TypeInt}}}
+	pet := &gen.Type{Name: "Pet", Config: cfg, ID: &gen.Field{Name: "id", Type: &entfield.TypeInfo{Type: entfield.TypeUUID, Ident: "uuid.UUID"}}}
+	e := &gen.Edge{Name: "pets", Type: pet}
+	ops, err := EdgeOperations(e)
+	require.NoError(t, err)
+	require.Equal(t, []Operation{OpList}, ops)
+
+	// Write operations are not exposed by the default policy.
+	e.Annotations = gen.Annotations{Annotation{}.Name(): ListOperation(OperationGroups("list"))}
+	ops, err = EdgeOperations(e)
+	require.NoError(t, err)
+	require.Equal(t, []Operation{OpList}, ops)
+
+	e.Annotations = gen.Annotations{Annotation{}.Name(): AttachOperation(OperationPolicy(PolicyExpose)).
+		Merge(DetachOperation(OperationPolicy(PolicyExpose)))}
+	ops, err = EdgeOperations(e)
+	require.NoError(t, err)
+	require.Equal(t, []Operation{OpAttach, OpDetach, OpList}, ops)
+
+	ps, err := edgePathParams(owner, e)
+	require.NoError(t, err)
+	require.Len(t, ps, 2)
+	require.Equal(t, "id", ps[0].Name)
+	require.Equal(t, ogen.Int(), ps[0].Schema)
+	require.Equal(t, "edgeId", ps[1].Name)
+	require.Equal(t, ogen.UUID(), ps[1].Schema)
+
+	require.NoError(t, writableEdge(owner, e))
+	e.Annotations = gen.Annotations{Annotation{}.Name(): ReadOnly(true)}
+	require.EqualError(t, writableEdge(owner, e), "write operations are not allowed on read only edge pets of User")
+	require.EqualError(t, detachableEdge(owner, e), "write operations are not allowed on read only edge pets of User")
+
+	// Detaching must not leave a required edge unset on either side.
+	e.Annotations = nil
+	e.Rel.Type = gen.O2M
+	e.Ref = &gen.Edge{Name: "owner", Type: owner, Unique: true, Optional: true, Ref: e, Rel: gen.Relation{Type: gen.M2O}}
+	require.NoError(t, detachableEdge(owner, e))
+	require.NoError(t, detachableEdge(pet, e.Ref))
+	e.Ref.Optional = false
+	require.EqualError(t, detachableEdge(owner, e), "detach operation is not allowed on edge pets of User, as its inverse edge owner of Pet is required")
+	require.EqualError(t, detachableEdge(pet, e.Ref), "detach operation is not allowed on required edge owner of Pet")
+	// Other write operations are allowed on required edges.
+	require.NoError(t, writableEdge(owner, e))
+	require.NoError(t, writableEdge(pet, e.Ref))
+}
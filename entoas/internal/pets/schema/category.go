@@ -15,7 +15,9 @@
 package schema
 
 import (
+	"entgo.io/contrib/entoas"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -28,7 +30,8 @@ type Category struct {
 // Fields of the Category.
 func (Category) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("name"),
+		field.String("name").
+			Annotations(entoas.Filter(entoas.FilterEQ, entoas.FilterIn)),
 	}
 }
 
@@ -38,3 +41,12 @@ func (Category) Edges() []ent.Edge {
 		edge.To("pets", Pet.Type),
 	}
 }
+
+// Annotations of the Category.
+func (Category) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entoas.BulkCreateOperation(entoas.OperationPolicy(entoas.PolicyExpose)),
+		entoas.BulkUpdateOperation(entoas.OperationPolicy(entoas.PolicyExpose)),
+		entoas.BulkDeleteOperation(entoas.OperationPolicy(entoas.PolicyExpose)),
+	}
+}
@@ -15,6 +15,7 @@
 package schema
 
 import (
+	"entgo.io/contrib/entoas"
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -36,6 +37,11 @@ func (User) Fields() []ent.Field {
 // Edges of the User.
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
-		edge.To("pets", Pet.Type),
+		edge.To("pets", Pet.Type).
+			Annotations(
+				entoas.CreateOperation(entoas.OperationPolicy(entoas.PolicyExpose)),
+				entoas.AttachOperation(entoas.OperationPolicy(entoas.PolicyExpose)),
+				entoas.DetachOperation(entoas.OperationPolicy(entoas.PolicyExpose)),
+			),
 	}
 }
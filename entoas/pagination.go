@@ -0,0 +1,138 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entoas
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ogen-go/ogen"
+)
+
+const (
+	// AfterParam is the name of the query parameter holding the cursor to list the items after.
+	AfterParam = "after"
+	// BeforeParam is the name of the query parameter holding the cursor to list the items before.
+	BeforeParam = "before"
+)
+
+// The properties of the envelope wrapping the items of cursor paginated list operations.
+const (
+	ItemsProperty      = "items"
+	NextCursorProperty = "nextCursor"
+	PrevCursorProperty = "prevCursor"
+	TotalCountProperty = "totalCount"
+)
+
+// cursorSchema returns the schema of a pagination cursor. The cursors are opaque
+// to the client and follow the keyset cursors of entgql: the base64 encoding of
+// the ID and the value of the ordering field of the item at the edge of the page.
+func cursorSchema() *ogen.Schema {
+	return ogen.String().SetDescription("opaque cursor pointing to an item of the list")
+}
+
+// itemsPerPageSchema returns the schema of the itemsPerPage query parameter. The bounds and
+// the default of the global Config are overridden by the given list operation configs, in order.
+func itemsPerPageSchema(cfg *Config, ops ...OperationConfig) (*ogen.Schema, error) {
+	c := OperationConfig{MinItemsPerPage: cfg.MinItemsPerPage, MaxItemsPerPage: cfg.MaxItemsPerPage}
+	for _, op := range ops {
+		c.merge(op)
+	}
+	if c.MinItemsPerPage > c.MaxItemsPerPage {
+		return nil, fmt.Errorf("minimum items per page %d is greater than the maximum %d", c.MinItemsPerPage, c.MaxItemsPerPage)
+	}
+	s := ogen.Int().SetMinimum(&c.MinItemsPerPage).SetMaximum(&c.MaxItemsPerPage)
+	if c.ItemsPerPage != 0 {
+		if c.ItemsPerPage < c.MinItemsPerPage || c.ItemsPerPage > c.MaxItemsPerPage {
+			return nil, fmt.Errorf("default items per page %d is out of range [%d, %d]", c.ItemsPerPage, c.MinItemsPerPage, c.MaxItemsPerPage)
+		}
+		d, err := json.Marshal(c.ItemsPerPage)
+		if err != nil {
+			return nil, err
+		}
+		s.Default = d
+	}
+	return s, nil
+}
+
+// pageParams returns the query parameters of a page paginated list operation.
+func pageParams(ipp *ogen.Schema) []*ogen.Parameter {
+	return []*ogen.Parameter{
+		ogen.NewParameter().
+			InQuery().
+			SetName("page").
+			SetDescription("what page to render").
+			SetSchema(ogen.Int().SetMinimum(&one)),
+		ogen.NewParameter().
+			InQuery().
+			SetName("itemsPerPage").
+			SetDescription("item count to render per page").
+			SetSchema(ipp),
+	}
+}
+
+// cursorParams returns the query parameters of a cursor paginated list operation.
+func cursorParams(ipp *ogen.Schema) []*ogen.Parameter {
+	return []*ogen.Parameter{
+		ogen.NewParameter().
+			InQuery().
+			SetName(AfterParam).
+			SetDescription("render the items after the given cursor").
+			SetSchema(cursorSchema()),
+		ogen.NewParameter().
+			InQuery().
+			SetName(BeforeParam).
+			SetDescription("render the items before the given cursor").
+			SetSchema(cursorSchema()),
+		ogen.NewParameter().
+			InQuery().
+			SetName("itemsPerPage").
+			SetDescription("item count to render per page").
+			SetSchema(ipp),
+	}
+}
+
+// paginationParams returns the pagination query parameters and the response schema
+// of a list operation rendering the given view, according to the global Config.
+func paginationParams(spec *ogen.Spec, cfg *Config, vn string, ops ...OperationConfig) ([]*ogen.Parameter, *ogen.Schema, error) {
+	ipp, err := itemsPerPageSchema(cfg, ops...)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cfg.CursorPagination {
+		return cursorParams(ipp), pageSchema(spec, vn), nil
+	}
+	return pageParams(ipp), spec.RefSchema(vn).Schema.AsArray(), nil
+}
+
+// pageSchema returns a reference to the envelope of a cursor paginated list
+// of the given view, and adds the envelope to the spec if it does not exist yet.
+func pageSchema(spec *ogen.Spec, vn string) *ogen.Schema {
+	pn := vn + "Page"
+	if spec.Components == nil || spec.Components.Schemas[pn] == nil {
+		spec.AddSchema(pn, ogen.NewSchema().
+			SetDescription(fmt.Sprintf("a page of %s items", vn)).
+			AddRequiredProperties(
+				spec.RefSchema(vn).Schema.AsArray().ToProperty(ItemsProperty),
+			).
+			AddOptionalProperties(
+				cursorSchema().ToProperty(NextCursorProperty),
+				cursorSchema().ToProperty(PrevCursorProperty),
+				ogen.Int().ToProperty(TotalCountProperty),
+			),
+		)
+	}
+	return spec.RefSchema(pn).Schema
+}
@@ -0,0 +1,83 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entoas
+
+import (
+	"testing"
+
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/require"
+)
+
+func TestItemsPerPageSchema(t *testing.T) {
+	t.Parallel()
+	cfg := &Config{MinItemsPerPage: 1, MaxItemsPerPage: 255}
+	s, err := itemsPerPageSchema(cfg)
+	require.NoError(t, err)
+	require.Equal(t, ogen.Int().SetMinimum(&cfg.MinItemsPerPage).SetMaximum(&cfg.MaxItemsPerPage), s)
+
+	// The node overrides the global bounds, and the edge overrides the node.
+	s, err = itemsPerPageSchema(cfg,
+		OperationConfig{MinItemsPerPage: 10, MaxItemsPerPage: 1000},
+		OperationConfig{MaxItemsPerPage: 500, ItemsPerPage: 100},
+	)
+	require.NoError(t, err)
+	minimum, maximum := int64(10), int64(500)
+	ex := ogen.Int().SetMinimum(&minimum).SetMaximum(&maximum)
+	ex.Default = ogen.Default("100")
+	require.Equal(t, ex, s)
+
+	_, err = itemsPerPageSchema(cfg, OperationConfig{MinItemsPerPage: 300})
+	require.EqualError(t, err, "minimum items per page 300 is greater than the maximum 255")
+	_, err = itemsPerPageSchema(cfg, OperationConfig{ItemsPerPage: 300})
+	require.EqualError(t, err, "default items per page 300 is out of range [1, 255]")
+}
+
+func TestPaginationParams(t *testing.T) {
+	t.Parallel()
+	spec := ogen.NewSpec()
+	spec.AddSchema("PetList", ogen.NewSchema())
+	cfg := &Config{MinItemsPerPage: 1, MaxItemsPerPage: 255}
+	ps, res, err := paginationParams(spec, cfg, "PetList")
+	require.NoError(t, err)
+	require.Len(t, ps, 2)
+	require.Equal(t, "page", ps[0].Name)
+	require.Equal(t, "itemsPerPage", ps[1].Name)
+	require.Equal(t, spec.RefSchema("PetList").Schema.AsArray(), res)
+
+	cfg.CursorPagination = true
+	ps, res, err = paginationParams(spec, cfg, "PetList")
+	require.NoError(t, err)
+	require.Len(t, ps, 3)
+	require.Equal(t, AfterParam, ps[0].Name)
+	require.Equal(t, BeforeParam, ps[1].Name)
+	require.Equal(t, "itemsPerPage", ps[2].Name)
+	require.Equal(t, spec.RefSchema("PetListPage").Schema, res)
+}
+
+func TestPageSchema(t *testing.T) {
+	t.Parallel()
+	spec := ogen.NewSpec()
+	spec.AddSchema("PetList", ogen.NewSchema())
+	s := pageSchema(spec, "PetList")
+	require.Equal(t, spec.RefSchema("PetListPage").Schema, s)
+	p, ok := spec.Components.Schemas["PetListPage"]
+	require.True(t, ok)
+	require.Equal(t, []string{ItemsProperty}, p.Required)
+	require.Len(t, p.Properties, 4)
+	// The envelope is shared by the operations rendering the same view.
+	require.Equal(t, s, pageSchema(spec, "PetList"))
+	require.Len(t, spec.Components.Schemas, 2)
+}
@@ -0,0 +1,189 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entoas
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stoewer/go-strcase"
+)
+
+const (
+	// SortParam is the name of the query parameter sorting list operations.
+	SortParam = "sort"
+	// FieldsParam is the name of the query parameter selecting the fields of list operations.
+	FieldsParam = "fields"
+)
+
+// reservedParams are the names of the query parameters of the
+// list and bulk operations that filters must not collide with.
+var reservedParams = map[string]bool{
+	"page":         true,
+	"itemsPerPage": true,
+	AfterParam:     true,
+	BeforeParam:    true,
+	SortParam:      true,
+	FieldsParam:    true,
+	AllParam:       true,
+}
+
+// SortAsc and SortDesc are the suffixes of the values of the sort query parameter.
+const (
+	SortAsc  = ".asc"
+	SortDesc = ".desc"
+)
+
+// queryParams returns the filter, sort and fields query parameters
+// of a list operation on the given node, as set by the annotations
+// of its fields.
+func queryParams(n *gen.Type) ([]*ogen.Parameter, error) {
+	ps, err := nodeFilterParams(n)
+	if err != nil {
+		return nil, err
+	}
+	var sorts, selection []json.RawMessage
+	for _, f := range append([]*gen.Field{n.ID}, n.Fields...) {
+		ant, err := FieldAnnotation(f)
+		if err != nil {
+			return nil, err
+		}
+		if ant.Skip || f.Sensitive() {
+			continue
+		}
+		if ant.Sortable {
+			for _, d := range []string{SortAsc, SortDesc} {
+				v, err := json.Marshal(f.Name + d)
+				if err != nil {
+					return nil, err
+				}
+				sorts = append(sorts, v)
+			}
+		}
+		if ant.Selectable {
+			if f == n.ID {
+				return nil, fmt.Errorf("selectable is not allowed on the ID field of %s", n.Name)
+			}
+			v, err := json.Marshal(f.Name)
+			if err != nil {
+				return nil, err
+			}
+			selection = append(selection, v)
+		}
+	}
+	if len(sorts) > 0 {
+		ps = append(ps, ogen.NewParameter().
+			InQuery().
+			SetName(SortParam).
+			SetDescription(fmt.Sprintf("the fields to sort the %s by, and their direction", rules.Pluralize(n.Name))).
+			SetSchema(ogen.String().AsEnum(nil, sorts...).AsArray()),
+		)
+	}
+	if len(selection) > 0 {
+		ps = append(ps, ogen.NewParameter().
+			InQuery().
+			SetName(FieldsParam).
+			SetDescription("the optional fields to render, all are rendered if omitted").
+			SetSchema(ogen.String().AsEnum(nil, selection...).AsArray()),
+		)
+	}
+	return ps, nil
+}
+
+// nodeFilterParams returns the filter query parameters
+// of the given node, as set by the annotations of its fields.
+func nodeFilterParams(n *gen.Type) ([]*ogen.Parameter, error) {
+	var (
+		ps    []*ogen.Parameter
+		names = make(map[string]string)
+	)
+	for _, f := range append([]*gen.Field{n.ID}, n.Fields...) {
+		ant, err := FieldAnnotation(f)
+		if err != nil {
+			return nil, err
+		}
+		if ant.Skip || f.Sensitive() {
+			continue
+		}
+		fps, err := filterParams(n, f, ant.Filter)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range fps {
+			switch other, ok := names[p.Name]; {
+			case reservedParams[p.Name]:
+				return nil, fmt.Errorf("filter parameter %q of field %s of %s collides with a reserved query parameter", p.Name, f.Name, n.Name)
+			case ok:
+				return nil, fmt.Errorf("filter parameter %q of field %s of %s collides with a filter of field %s", p.Name, f.Name, n.Name, other)
+			}
+			names[p.Name] = f.Name
+		}
+		ps = append(ps, fps...)
+	}
+	return ps, nil
+}
+
+// filterParams returns the query parameters of the given filter operations on the field.
+func filterParams(n *gen.Type, f *gen.Field, ops FilterOp) ([]*ogen.Parameter, error) {
+	if ops == 0 {
+		return nil, nil
+	}
+	s, err := OgenSchema(f)
+	if err != nil {
+		return nil, err
+	}
+	if f.IsJSON() || s.Type == "array" || s.Type == "object" {
+		return nil, fmt.Errorf("filter is not allowed on field %s of %s with non-scalar type %s", f.Name, n.Name, f.Type)
+	}
+	// Filters are optional, and the default of the field does not apply to them.
+	c := *s
+	c.Default = nil
+	s = &c
+	name := strcase.LowerCamelCase(f.Name)
+	param := func(suffix, desc string, s *ogen.Schema) *ogen.Parameter {
+		return ogen.NewParameter().
+			InQuery().
+			SetName(name + suffix).
+			SetDescription(fmt.Sprintf("%s %s", f.Name, desc)).
+			SetSchema(s)
+	}
+	var ps []*ogen.Parameter
+	if ops.Is(FilterEQ) {
+		ps = append(ps, param("", "equals the given value", s))
+	}
+	if ops.Is(FilterRange) {
+		if !f.Type.Numeric() && !f.IsTime() {
+			return nil, fmt.Errorf("range filter is not allowed on field %s of %s with type %s", f.Name, n.Name, f.Type)
+		}
+		ps = append(ps,
+			param("Gt", "is greater than the given value", s),
+			param("Gte", "is greater than or equal to the given value", s),
+			param("Lt", "is less than the given value", s),
+			param("Lte", "is less than or equal to the given value", s),
+		)
+	}
+	if ops.Is(FilterIn) {
+		ps = append(ps, param("In", "equals any of the given values", s.AsArray()))
+	}
+	if ops.Is(FilterLike) {
+		if !f.IsString() {
+			return nil, fmt.Errorf("like filter is not allowed on field %s of %s with type %s", f.Name, n.Name, f.Type)
+		}
+		ps = append(ps, param("Like", "contains the given substring", s))
+	}
+	return ps, nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entoas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	entfield "entgo.io/ent/schema/field"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryParams(t *testing.T) {
+	t.Parallel()
+	ants := func(as ...Annotation) gen.Annotations {
+		var a Annotation
+		for _, o := range as {
+			a = a.Merge(o).(Annotation)
+		}
+		return gen.Annotations{a.Name(): a}
+	}
+	n := &gen.Type{
+		Name: "Pet",
+		ID:   &gen.Field{Name: "id", Type: &entfield.TypeInfo{Type: entfield.TypeInt}},
+		Fields: []*gen.Field{
+			{
+				Name:        "name",
+				Type:        &entfield.TypeInfo{Type: entfield.TypeString},
+				Annotations: ants(Filter(FilterEQ, FilterLike), Sortable(true)),
+			},
+			{
+				Name:        "age",
+				Type:        &entfield.TypeInfo{Type: entfield.TypeInt},
+				Annotations: ants(Filter(FilterRange, FilterIn), Selectable(true)),
+			},
+			{Name: "nickname", Type: &entfield.TypeInfo{Type: entfield.TypeString}},
+		},
+	}
+	ps, err := queryParams(n)
+	require.NoError(t, err)
+	names := make([]string, len(ps))
+	for i, p := range ps {
+		names[i] = p.Name
+		require.Equal(t, "query", p.In)
+	}
+	require.Equal(t, []string{"name", "nameLike", "ageGt", "ageGte", "ageLt", "ageLte", "ageIn", "sort", "fields"}, names)
+	require.Equal(t, ogen.Int().AsArray(), ps[6].Schema)
+	require.Equal(t, ogen.String().AsEnum(nil, json.RawMessage(`"name.asc"`), json.RawMessage(`"name.desc"`)).AsArray(), ps[7].Schema)
+	require.Equal(t, ogen.String().AsEnum(nil, json.RawMessage(`"age"`)).AsArray(), ps[8].Schema)
+
+	n.Fields[0].Annotations = ants(Filter(FilterRange))
+	_, err = queryParams(n)
+	require.EqualError(t, err, "range filter is not allowed on field name of Pet with type string")
+	n.Fields[0].Annotations = nil
+	n.Fields[1].Annotations = ants(Filter(FilterLike))
+	_, err = queryParams(n)
+	require.EqualError(t, err, "like filter is not allowed on field age of Pet with type int")
+
+	// Filters do not inherit the default of the field.
+	s := ogen.Int().SetDefault(json.RawMessage("1"))
+	n.Fields[1].Annotations = ants(Filter(FilterEQ), Schema(s))
+	ps, err = queryParams(n)
+	require.NoError(t, err)
+	require.Nil(t, ps[0].Schema.Default)
+	require.NotNil(t, s.Default)
+
+	n.Fields[1].Annotations = nil
+	n.Fields[2] = &gen.Field{
+		Name:        "tags",
+		Type:        &entfield.TypeInfo{Type: entfield.TypeJSON, Ident: "[]string"},
+		Annotations: ants(Filter(FilterEQ)),
+	}
+	_, err = queryParams(n)
+	require.EqualError(t, err, "filter is not allowed on field tags of Pet with non-scalar type []string")
+
+	n.Fields[2] = &gen.Field{Name: "page", Type: &entfield.TypeInfo{Type: entfield.TypeInt}, Annotations: ants(Filter(FilterEQ))}
+	_, err = queryParams(n)
+	require.EqualError(t, err, `filter parameter "page" of field page of Pet collides with a reserved query parameter`)
+	n.Fields[0].Annotations = ants(Filter(FilterLike))
+	n.Fields[2] = &gen.Field{Name: "name_like", Type: &entfield.TypeInfo{Type: entfield.TypeString}, Annotations: ants(Filter(FilterEQ))}
+	_, err = queryParams(n)
+	require.EqualError(t, err, `filter parameter "nameLike" of field name_like of Pet collides with a filter of field name`)
+}
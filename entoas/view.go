@@ -27,6 +27,9 @@ type View struct {
 	Type   *gen.Type
 	Fields []*gen.Field
 	Edges  []*gen.Edge
+	// List reports if the view is the item of a list operation,
+	// which may omit the selectable fields.
+	List bool
 }
 
 // Views returns all views that are needed to fill the OAS.
@@ -39,8 +42,8 @@ func Views(g *gen.Graph) (map[string]*View, error) {
 		}
 		// For every operation add a schema to use.
 		for _, op := range ops {
-			// Skip the delete operation (of course).
-			if op == OpDelete {
+			// Skip the delete operations (of course).
+			if op == OpDelete || op == OpBulkDelete {
 				continue
 			}
 			gs, err := GroupsForOperation(n.Annotations, op)
@@ -51,6 +54,7 @@ func Views(g *gen.Graph) (map[string]*View, error) {
 			if err != nil {
 				return nil, err
 			}
+			v.List = op == OpList
 			vn, err := ViewName(n, op)
 			if err != nil {
 				return nil, err
@@ -82,8 +86,8 @@ func Views(g *gen.Graph) (map[string]*View, error) {
 			}
 			// For every operation add a schema to use.
 			for _, op := range ops {
-				// Skip the delete operation (of course).
-				if op == OpDelete {
+				// Skip the operations without a response body.
+				if op == OpDelete || op == OpAttach || op == OpDetach {
 					continue
 				}
 				gs, err := GroupsForOperation(e.Annotations, op)
@@ -94,6 +98,7 @@ func Views(g *gen.Graph) (map[string]*View, error) {
 				if err != nil {
 					return nil, err
 				}
+				v.List = op == OpList
 				vn, err := EdgeViewName(n, e, op)
 				if err != nil {
 					return nil, err
@@ -208,6 +213,10 @@ func GroupsForOperation(a gen.Annotations, op Operation) (serialization.Groups,
 		return ant.Update.Groups, nil
 	case OpList:
 		return ant.List.Groups, nil
+	case OpBulkCreate:
+		return ant.BulkCreate.Groups, nil
+	case OpBulkUpdate:
+		return ant.BulkUpdate.Groups, nil
 	}
 	return nil, fmt.Errorf("unknown operation %q", op)
 }